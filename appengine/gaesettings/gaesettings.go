@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/gae/filter/dscache"
@@ -44,7 +45,8 @@ import (
 
 // Storage knows how to store JSON blobs with settings in the datastore.
 //
-// It implements server/settings.EventualConsistentStorage interface.
+// It implements server/settings.EventualConsistentStorage and
+// server/settings.VersionedStorage interfaces.
 type Storage struct{}
 
 // settingsEntity is used to store all settings as JSON blob. Latest settings
@@ -114,10 +116,110 @@ func (s Storage) FetchAllSettings(ctx context.Context) (*settings.Bundle, time.D
 
 // UpdateSetting updates a setting at the given key.
 func (s Storage) UpdateSetting(ctx context.Context, key string, value json.RawMessage, who, why string) error {
+	return s.update(ctx, who, why, func(ctx context.Context, latest *settingsEntity, pairs map[string]*json.RawMessage) error {
+		pairs[key] = &value
+		return nil
+	})
+}
+
+// ListRevisions returns up to 'limit' most recent revisions.
+//
+// Part of server/settings.VersionedStorage interface.
+func (s Storage) ListRevisions(ctx context.Context, limit int) ([]*settings.Revision, error) {
+	ctx = defaultContext(ctx)
+
+	latest := latestSettings()
+	switch err := ds.Get(ctx, &latest); {
+	case err == ds.ErrNoSuchEntity:
+		return nil, nil
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	}
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	// Versions are sequential, fetch the log entries directly by their IDs.
+	var log []*settingsEntity
+	for v := latest.Version - 1; v >= 1 && len(log) < limit-1; v-- {
+		log = append(log, logEntry(ctx, &latest, v))
+	}
+	var missing errors.MultiError
+	if err := ds.Get(ctx, log); err != nil {
+		me, ok := err.(errors.MultiError)
+		if !ok {
+			return nil, transient.Tag.Apply(err)
+		}
+		for _, err := range me {
+			if err != nil && err != ds.ErrNoSuchEntity {
+				return nil, transient.Tag.Apply(err)
+			}
+		}
+		missing = me
+	}
+
+	out := make([]*settings.Revision, 0, len(log)+1)
+	rev, err := latest.revision()
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, rev)
+	for i, ent := range log {
+		if missing != nil && missing[i] != nil {
+			continue
+		}
+		rev, err := ent.revision()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rev)
+	}
+	return out, nil
+}
+
+// GetRevision returns a revision with the given version.
+//
+// Part of server/settings.VersionedStorage interface.
+func (s Storage) GetRevision(ctx context.Context, version int) (*settings.Revision, error) {
+	ctx = defaultContext(ctx)
+	ent, err := getRevision(ctx, version)
+	if err != nil {
+		return nil, err
+	}
+	return ent.revision()
+}
+
+// RestoreRevision makes values from the given revision current.
+//
+// Part of server/settings.VersionedStorage interface.
+func (s Storage) RestoreRevision(ctx context.Context, version int, who, why string) error {
+	return s.update(ctx, who, why, func(ctx context.Context, latest *settingsEntity, pairs map[string]*json.RawMessage) error {
+		ent, err := getRevision(ctx, version)
+		if err != nil {
+			return err
+		}
+		for k := range pairs {
+			delete(pairs, k)
+		}
+		if ent.Value != "" {
+			return json.Unmarshal([]byte(ent.Value), &pairs)
+		}
+		return nil
+	})
+}
+
+// update transactionally modifies settings, storing the previous version in
+// the log.
+//
+// Errors returned by `mutate` are considered fatal unless they are tagged as
+// transient.
+func (s Storage) update(ctx context.Context, who, why string, mutate func(ctx context.Context, latest *settingsEntity, pairs map[string]*json.RawMessage) error) error {
 	ctx = defaultContext(ctx)
 
 	var fatalFail error // set in transaction on fatal errors
 	err := ds.RunInTransaction(ctx, func(ctx context.Context) error {
+		fatalFail = nil
+
 		// Fetch the most recent values.
 		latest := latestSettings()
 		if err := ds.Get(ctx, &latest); err != nil && err != ds.ErrNoSuchEntity {
@@ -132,7 +234,12 @@ func (s Storage) UpdateSetting(ctx context.Context, key string, value json.RawMe
 				return err
 			}
 		}
-		pairs[key] = &value
+		if err := mutate(ctx, &latest, pairs); err != nil {
+			if !transient.Tag.In(err) {
+				fatalFail = err
+			}
+			return err
+		}
 
 		// Store the previous one in the log.
 		auditCopy := latest
@@ -170,6 +277,58 @@ func (s Storage) UpdateSetting(ctx context.Context, key string, value json.RawMe
 	return transient.Tag.Apply(err)
 }
 
+// logEntry returns settingsEntity with prefilled key pointing to the log entry
+// with the given version.
+func logEntry(ctx context.Context, latest *settingsEntity, version int) *settingsEntity {
+	return &settingsEntity{
+		Kind:   "gaesettings.SettingsLog",
+		ID:     strconv.Itoa(version),
+		Parent: ds.KeyForObj(ctx, latest),
+	}
+}
+
+// getRevision fetches either the latest settings or a log entry.
+//
+// Returns settings.ErrNoSuchRevision if there's no such version.
+func getRevision(ctx context.Context, version int) (*settingsEntity, error) {
+	latest := latestSettings()
+	switch err := ds.Get(ctx, &latest); {
+	case err == ds.ErrNoSuchEntity:
+		return nil, settings.ErrNoSuchRevision
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	case version == latest.Version:
+		return &latest, nil
+	case version < 1 || version > latest.Version:
+		return nil, settings.ErrNoSuchRevision
+	}
+	ent := logEntry(ctx, &latest, version)
+	switch err := ds.Get(ctx, ent); {
+	case err == ds.ErrNoSuchEntity:
+		return nil, settings.ErrNoSuchRevision
+	case err != nil:
+		return nil, transient.Tag.Apply(err)
+	}
+	return ent, nil
+}
+
+// revision converts the entity to settings.Revision.
+func (e *settingsEntity) revision() (*settings.Revision, error) {
+	pairs := map[string]*json.RawMessage{}
+	if e.Value != "" {
+		if err := json.Unmarshal([]byte(e.Value), &pairs); err != nil {
+			return nil, err
+		}
+	}
+	return &settings.Revision{
+		Version: e.Version,
+		Values:  pairs,
+		Who:     e.Who,
+		Why:     e.Why,
+		When:    e.When,
+	}, nil
+}
+
 // GetConsistencyTime returns "last modification time" + "expiration period".
 //
 // It indicates moment in time when last setting change is fully propagated to
@@ -17,6 +17,7 @@ package gaesettings
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"go.chromium.org/luci/gae/impl/memory"
 	ds "go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/gae/service/info"
+	"go.chromium.org/luci/server/settings"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -109,6 +111,61 @@ func TestWorks(t *testing.T) {
 		So(mcOps.DeleteMulti.Total(), ShouldEqual, 0)
 	})
 
+	Convey("History and rollback", t, func() {
+		ctx := memory.Use(context.Background())
+		ctx, tc := testclock.UseTime(ctx, time.Unix(1444945245, 0).UTC())
+
+		s := Storage{}
+
+		revs, err := s.ListRevisions(ctx, 10)
+		So(err, ShouldBeNil)
+		So(revs, ShouldBeEmpty)
+		_, err = s.GetRevision(ctx, 1)
+		So(err, ShouldEqual, settings.ErrNoSuchRevision)
+
+		for i := 1; i <= 3; i++ {
+			tc.Add(time.Minute)
+			val := json.RawMessage(fmt.Sprintf(`"val%d"`, i))
+			So(s.UpdateSetting(ctx, "key", val, fmt.Sprintf("who%d", i), "why"), ShouldBeNil)
+		}
+
+		versions := func(revs []*settings.Revision) (out []int) {
+			for _, r := range revs {
+				out = append(out, r.Version)
+			}
+			return
+		}
+
+		revs, err = s.ListRevisions(ctx, 10)
+		So(err, ShouldBeNil)
+		So(versions(revs), ShouldResemble, []int{3, 2, 1})
+		So(revs[1].Who, ShouldEqual, "who2")
+		So(revs[1].When, ShouldResemble, time.Unix(1444945245, 0).UTC().Add(2*time.Minute))
+		So(*revs[1].Values["key"], ShouldResemble, json.RawMessage(`"val2"`))
+
+		revs, err = s.ListRevisions(ctx, 2)
+		So(err, ShouldBeNil)
+		So(versions(revs), ShouldResemble, []int{3, 2})
+
+		rev, err := s.GetRevision(ctx, 1)
+		So(err, ShouldBeNil)
+		So(*rev.Values["key"], ShouldResemble, json.RawMessage(`"val1"`))
+		_, err = s.GetRevision(ctx, 4)
+		So(err, ShouldEqual, settings.ErrNoSuchRevision)
+
+		// Rollback creates a new version.
+		So(s.RestoreRevision(ctx, 1, "rollbacker", "oops"), ShouldBeNil)
+		bundle, _, err := s.FetchAllSettings(ctx)
+		So(err, ShouldBeNil)
+		So(*bundle.Values["key"], ShouldResemble, json.RawMessage(`"val1"`))
+		revs, err = s.ListRevisions(ctx, 1)
+		So(err, ShouldBeNil)
+		So(revs[0].Version, ShouldEqual, 4)
+		So(revs[0].Who, ShouldEqual, "rollbacker")
+
+		So(s.RestoreRevision(ctx, 10, "rollbacker", "oops"), ShouldEqual, settings.ErrNoSuchRevision)
+	})
+
 	Convey("Handles namespace switch", t, func() {
 		ctx := memory.Use(context.Background())
 		ctx = dscache.FilterRDS(ctx, nil)
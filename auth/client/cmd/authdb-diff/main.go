@@ -0,0 +1,210 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command authdb-diff reports permissions gained or lost between two AuthDB
+// snapshots.
+//
+// It allows to review realms.cfg and groups changes by their effect: groups are
+// expanded into identities and globs, and each granted permission is compared
+// per realm.
+//
+// Each snapshot is either an AuthDB revision number (or "latest") fetched from
+// the Auth Service via GetSnapshot RPC, or a path to a file with one of:
+//   - a deflated ReplicationPushRequest, e.g. auth_db_deflated of a Snapshot;
+//   - a SignedAuthDB, as stored in Google Storage dumps;
+//   - a wirepb-encoded AuthDB, e.g. produced by authdb-dump.
+//
+// Usage:
+//
+//	$ authdb-diff 1234 latest
+//	$ authdb-diff old.db new.db
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/auth/client/authcli"
+	"go.chromium.org/luci/auth_service/api/rpcpb"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/grpc/prpc"
+	"go.chromium.org/luci/hardcoded/chromeinfra"
+	"go.chromium.org/luci/server/auth/authdb"
+	"go.chromium.org/luci/server/auth/service/protocol"
+)
+
+var (
+	authServiceHost = flag.String("auth-service-host", "chrome-infra-auth.appspot.com",
+		"Host of the Auth Service to fetch AuthDB revisions from")
+)
+
+func main() {
+	ctx := context.Background()
+	ctx = gologger.StdConfig.Use(ctx)
+	if err := run(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	authFlags := authcli.Flags{}
+	authFlags.Register(flag.CommandLine, chromeinfra.DefaultAuthOptions())
+
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <old> <new>\n\n", os.Args[0])
+		fmt.Fprintf(flag.CommandLine.Output(), "<old> and <new> are AuthDB revisions, \"latest\" or paths to AuthDB files.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		return errors.Reason("expecting exactly two snapshots to compare").Err()
+	}
+
+	var client rpcpb.AuthDBClient
+	load := func(src string) (*protocol.AuthDB, error) {
+		rev, isRev := parseRevision(src)
+		if !isRev {
+			return loadFile(src)
+		}
+		if client == nil {
+			opts, err := authFlags.Options()
+			if err != nil {
+				return nil, err
+			}
+			httpClient, err := auth.NewAuthenticator(ctx, auth.SilentLogin, opts).Client()
+			if err != nil {
+				return nil, err
+			}
+			client = rpcpb.NewAuthDBClient(&prpc.Client{
+				C:    httpClient,
+				Host: *authServiceHost,
+			})
+		}
+		return fetchRevision(ctx, client, rev)
+	}
+
+	old, err := load(flag.Arg(0))
+	if err != nil {
+		return errors.Annotate(err, "failed to load %q", flag.Arg(0)).Err()
+	}
+	new, err := load(flag.Arg(1))
+	if err != nil {
+		return errors.Annotate(err, "failed to load %q", flag.Arg(1)).Err()
+	}
+
+	diff, err := authdb.DiffPermissions(old, new)
+	if err != nil {
+		return err
+	}
+	for _, g := range diff.Lost {
+		fmt.Printf("- %s\n", formatGrant(&g))
+	}
+	for _, g := range diff.Gained {
+		fmt.Printf("+ %s\n", formatGrant(&g))
+	}
+	logging.Infof(ctx, "%d grants lost, %d grants gained", len(diff.Lost), len(diff.Gained))
+	return nil
+}
+
+// parseRevision returns the revision number if the snapshot is given as
+// a revision, with 0 meaning the latest one.
+func parseRevision(src string) (int64, bool) {
+	if src == "latest" {
+		return 0, true
+	}
+	rev, err := strconv.ParseInt(src, 10, 64)
+	if err != nil || rev <= 0 {
+		return 0, false
+	}
+	return rev, true
+}
+
+func fetchRevision(ctx context.Context, client rpcpb.AuthDBClient, rev int64) (*protocol.AuthDB, error) {
+	logging.Infof(ctx, "Fetching AuthDB revision %d from %s...", rev, *authServiceHost)
+	snap, err := client.GetSnapshot(ctx, &rpcpb.GetSnapshotRequest{Revision: rev})
+	if err != nil {
+		return nil, errors.Annotate(err, "GetSnapshot RPC failed").Err()
+	}
+	logging.Infof(ctx, "Fetched AuthDB revision %d", snap.AuthDbRev)
+	return decodeAuthDB(snap.AuthDbDeflated)
+}
+
+func loadFile(path string) (*protocol.AuthDB, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return decodeAuthDB(blob)
+}
+
+// decodeAuthDB extracts AuthDB from any of the supported formats.
+func decodeAuthDB(blob []byte) (*protocol.AuthDB, error) {
+	// A deflated ReplicationPushRequest, as served by GetSnapshot.
+	if reader, err := zlib.NewReader(bytes.NewReader(blob)); err == nil {
+		defer reader.Close()
+		inflated, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to inflate").Err()
+		}
+		return unmarshalPushRequest(inflated)
+	}
+
+	// A SignedAuthDB, as stored in Google Storage dumps.
+	signed := protocol.SignedAuthDB{}
+	if err := proto.Unmarshal(blob, &signed); err == nil && len(signed.AuthDbBlob) != 0 {
+		if authDB, err := unmarshalPushRequest(signed.AuthDbBlob); err == nil {
+			return authDB, nil
+		}
+	}
+
+	// A raw AuthDB.
+	authDB := &protocol.AuthDB{}
+	if err := proto.Unmarshal(blob, authDB); err != nil {
+		return nil, errors.Annotate(err, "unrecognized AuthDB format").Err()
+	}
+	return authDB, nil
+}
+
+func unmarshalPushRequest(blob []byte) (*protocol.AuthDB, error) {
+	msg := protocol.ReplicationPushRequest{}
+	if err := proto.Unmarshal(blob, &msg); err != nil {
+		return nil, errors.Annotate(err, "failed to deserialize ReplicationPushRequest").Err()
+	}
+	if msg.AuthDb == nil {
+		return nil, errors.Reason("'auth_db' field is missing in ReplicationPushRequest").Err()
+	}
+	return msg.AuthDb, nil
+}
+
+func formatGrant(g *authdb.Grant) string {
+	out := fmt.Sprintf("%s %s %s", g.Realm, g.Permission, g.Principal)
+	if len(g.Conditions) != 0 {
+		out += " if " + strings.Join(g.Conditions, " and ")
+	}
+	return out
+}
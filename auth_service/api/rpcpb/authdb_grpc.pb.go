@@ -25,6 +25,9 @@ type AuthDBClient interface {
 	// GetSnapshot serves the deflated AuthDB proto
 	// message with snapshot of all groups.
 	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	// ExplainPermission explains why a principal has or doesn't have
+	// a permission in a realm according to the latest AuthDB snapshot.
+	ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*PermissionExplanation, error)
 }
 
 type authDBClient struct {
@@ -44,6 +47,15 @@ func (c *authDBClient) GetSnapshot(ctx context.Context, in *GetSnapshotRequest,
 	return out, nil
 }
 
+func (c *authDBClient) ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*PermissionExplanation, error) {
+	out := new(PermissionExplanation)
+	err := c.cc.Invoke(ctx, "/auth.service.AuthDB/ExplainPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthDBServer is the server API for AuthDB service.
 // All implementations must embed UnimplementedAuthDBServer
 // for forward compatibility
@@ -51,6 +63,9 @@ type AuthDBServer interface {
 	// GetSnapshot serves the deflated AuthDB proto
 	// message with snapshot of all groups.
 	GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error)
+	// ExplainPermission explains why a principal has or doesn't have
+	// a permission in a realm according to the latest AuthDB snapshot.
+	ExplainPermission(context.Context, *ExplainPermissionRequest) (*PermissionExplanation, error)
 	mustEmbedUnimplementedAuthDBServer()
 }
 
@@ -61,6 +76,9 @@ type UnimplementedAuthDBServer struct {
 func (UnimplementedAuthDBServer) GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
 }
+func (UnimplementedAuthDBServer) ExplainPermission(context.Context, *ExplainPermissionRequest) (*PermissionExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
 func (UnimplementedAuthDBServer) mustEmbedUnimplementedAuthDBServer() {}
 
 // UnsafeAuthDBServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +110,24 @@ func _AuthDB_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthDB_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthDBServer).ExplainPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.service.AuthDB/ExplainPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthDBServer).ExplainPermission(ctx, req.(*ExplainPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthDB_ServiceDesc is the grpc.ServiceDesc for AuthDB service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +139,10 @@ var AuthDB_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSnapshot",
 			Handler:    _AuthDB_GetSnapshot_Handler,
 		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _AuthDB_ExplainPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/auth_service/api/rpcpb/authdb.proto",
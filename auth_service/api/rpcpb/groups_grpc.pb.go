@@ -39,6 +39,20 @@ type GroupsClient interface {
 	// include a principal (perhaps indirectly or via globs). Here a principal is
 	// either an identity, a group or a glob (see PrincipalKind enum).
 	GetSubgraph(ctx context.Context, in *GetSubgraphRequest, opts ...grpc.CallOption) (*Subgraph, error)
+	// ProposeMembershipChange proposes a change to the membership of a group.
+	//
+	// The change is applied only once it is approved by an owner of the group
+	// via ReviewMembershipChange. This is the only way to change the members of
+	// a group that requires approval.
+	ProposeMembershipChange(ctx context.Context, in *ProposeMembershipChangeRequest, opts ...grpc.CallOption) (*MembershipChange, error)
+	// ListMembershipChanges returns the proposed membership changes of a group,
+	// most recent first.
+	ListMembershipChanges(ctx context.Context, in *ListMembershipChangesRequest, opts ...grpc.CallOption) (*ListMembershipChangesResponse, error)
+	// ReviewMembershipChange approves or rejects a pending membership change.
+	//
+	// Approving applies the change to the group. The reviewer must be an owner
+	// of the group and must not be the one who proposed the change.
+	ReviewMembershipChange(ctx context.Context, in *ReviewMembershipChangeRequest, opts ...grpc.CallOption) (*MembershipChange, error)
 }
 
 type groupsClient struct {
@@ -103,6 +117,33 @@ func (c *groupsClient) GetSubgraph(ctx context.Context, in *GetSubgraphRequest,
 	return out, nil
 }
 
+func (c *groupsClient) ProposeMembershipChange(ctx context.Context, in *ProposeMembershipChangeRequest, opts ...grpc.CallOption) (*MembershipChange, error) {
+	out := new(MembershipChange)
+	err := c.cc.Invoke(ctx, "/auth.service.Groups/ProposeMembershipChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) ListMembershipChanges(ctx context.Context, in *ListMembershipChangesRequest, opts ...grpc.CallOption) (*ListMembershipChangesResponse, error) {
+	out := new(ListMembershipChangesResponse)
+	err := c.cc.Invoke(ctx, "/auth.service.Groups/ListMembershipChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsClient) ReviewMembershipChange(ctx context.Context, in *ReviewMembershipChangeRequest, opts ...grpc.CallOption) (*MembershipChange, error) {
+	out := new(MembershipChange)
+	err := c.cc.Invoke(ctx, "/auth.service.Groups/ReviewMembershipChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupsServer is the server API for Groups service.
 // All implementations must embed UnimplementedGroupsServer
 // for forward compatibility
@@ -123,6 +164,20 @@ type GroupsServer interface {
 	// include a principal (perhaps indirectly or via globs). Here a principal is
 	// either an identity, a group or a glob (see PrincipalKind enum).
 	GetSubgraph(context.Context, *GetSubgraphRequest) (*Subgraph, error)
+	// ProposeMembershipChange proposes a change to the membership of a group.
+	//
+	// The change is applied only once it is approved by an owner of the group
+	// via ReviewMembershipChange. This is the only way to change the members of
+	// a group that requires approval.
+	ProposeMembershipChange(context.Context, *ProposeMembershipChangeRequest) (*MembershipChange, error)
+	// ListMembershipChanges returns the proposed membership changes of a group,
+	// most recent first.
+	ListMembershipChanges(context.Context, *ListMembershipChangesRequest) (*ListMembershipChangesResponse, error)
+	// ReviewMembershipChange approves or rejects a pending membership change.
+	//
+	// Approving applies the change to the group. The reviewer must be an owner
+	// of the group and must not be the one who proposed the change.
+	ReviewMembershipChange(context.Context, *ReviewMembershipChangeRequest) (*MembershipChange, error)
 	mustEmbedUnimplementedGroupsServer()
 }
 
@@ -148,6 +203,15 @@ func (UnimplementedGroupsServer) DeleteGroup(context.Context, *DeleteGroupReques
 func (UnimplementedGroupsServer) GetSubgraph(context.Context, *GetSubgraphRequest) (*Subgraph, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubgraph not implemented")
 }
+func (UnimplementedGroupsServer) ProposeMembershipChange(context.Context, *ProposeMembershipChangeRequest) (*MembershipChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeMembershipChange not implemented")
+}
+func (UnimplementedGroupsServer) ListMembershipChanges(context.Context, *ListMembershipChangesRequest) (*ListMembershipChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembershipChanges not implemented")
+}
+func (UnimplementedGroupsServer) ReviewMembershipChange(context.Context, *ReviewMembershipChangeRequest) (*MembershipChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewMembershipChange not implemented")
+}
 func (UnimplementedGroupsServer) mustEmbedUnimplementedGroupsServer() {}
 
 // UnsafeGroupsServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +333,60 @@ func _Groups_GetSubgraph_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Groups_ProposeMembershipChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeMembershipChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ProposeMembershipChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.service.Groups/ProposeMembershipChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ProposeMembershipChange(ctx, req.(*ProposeMembershipChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_ListMembershipChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMembershipChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ListMembershipChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.service.Groups/ListMembershipChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ListMembershipChanges(ctx, req.(*ListMembershipChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Groups_ReviewMembershipChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewMembershipChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupsServer).ReviewMembershipChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/auth.service.Groups/ReviewMembershipChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupsServer).ReviewMembershipChange(ctx, req.(*ReviewMembershipChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Groups_ServiceDesc is the grpc.ServiceDesc for Groups service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -300,6 +418,18 @@ var Groups_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubgraph",
 			Handler:    _Groups_GetSubgraph_Handler,
 		},
+		{
+			MethodName: "ProposeMembershipChange",
+			Handler:    _Groups_ProposeMembershipChange_Handler,
+		},
+		{
+			MethodName: "ListMembershipChanges",
+			Handler:    _Groups_ListMembershipChanges_Handler,
+		},
+		{
+			MethodName: "ReviewMembershipChange",
+			Handler:    _Groups_ReviewMembershipChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "go.chromium.org/luci/auth_service/api/rpcpb/groups.proto",
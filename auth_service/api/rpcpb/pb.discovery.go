@@ -12,2410 +12,1233 @@ func init() {
 			"auth.service.Accounts", "auth.service.Allowlists", "auth.service.AuthDB", "auth.service.ChangeLogs", "auth.service.Groups",
 		},
 		[]byte{31, 139,
-			8, 0, 0, 0, 0, 0, 0, 255, 236, 253, 125, 116, 92, 201,
-			117, 24, 136, 227, 125, 116, 163, 81, 0, 193, 70, 1, 36, 193,
-			230, 87, 177, 73, 14, 0, 178, 209, 248, 32, 135, 51, 36, 231,
-			67, 32, 0, 114, 122, 4, 2, 116, 3, 208, 104, 70, 30, 97,
-			30, 186, 171, 209, 111, 248, 250, 189, 214, 123, 175, 129, 193, 72,
-			19, 251, 23, 71, 78, 28, 157, 196, 63, 203, 146, 229, 149, 37,
-			203, 31, 218, 88, 182, 207, 90, 94, 91, 242, 218, 199, 231, 216,
-			235, 245, 250, 100, 189, 107, 39, 235, 181, 147, 236, 58, 123, 246,
-			67, 86, 178, 94, 39, 182, 35, 103, 21, 127, 197, 235, 61, 247,
-			214, 199, 123, 221, 0, 103, 56, 146, 165, 252, 51, 60, 51, 7,
-			125, 235, 85, 221, 186, 117, 235, 214, 173, 123, 171, 110, 85, 145,
-			191, 30, 33, 167, 118, 130, 96, 199, 227, 51, 237, 48, 136, 131,
-			237, 78, 99, 134, 183, 218, 241, 126, 25, 65, 122, 84, 124, 44,
-			171, 143, 197, 126, 146, 89, 134, 239, 183, 223, 32, 163, 181, 160,
-			85, 238, 249, 126, 155, 224, 215, 251, 0, 222, 55, 94, 82, 159,
-			119, 2, 207, 241, 119, 202, 65, 184, 147, 84, 19, 239, 183, 121,
-			52, 243, 192, 15, 246, 124, 81, 101, 123, 251, 207, 12, 227, 71,
-			76, 235, 238, 253, 219, 63, 97, 158, 189, 43, 74, 222, 151, 217,
-			203, 47, 112, 207, 123, 55, 100, 222, 128, 114, 207, 255, 82, 158,
-			100, 169, 125, 182, 111, 62, 79, 126, 99, 136, 24, 67, 212, 58,
-			219, 71, 231, 127, 101, 136, 97, 129, 90, 224, 177, 219, 157, 70,
-			131, 135, 17, 155, 102, 2, 213, 68, 196, 234, 78, 236, 48, 215,
-			143, 121, 88, 107, 58, 254, 14, 103, 141, 32, 108, 57, 49, 97,
-			139, 65, 123, 63, 116, 119, 154, 49, 155, 159, 157, 125, 82, 22,
-			96, 21, 191, 86, 102, 108, 193, 243, 24, 126, 139, 88, 200, 35,
-			30, 238, 242, 122, 153, 176, 102, 28, 183, 163, 155, 51, 51, 117,
-			190, 203, 189, 160, 205, 195, 72, 181, 181, 22, 180, 68, 35, 107,
-			129, 55, 189, 45, 136, 152, 33, 132, 85, 121, 221, 141, 226, 208,
-			221, 238, 196, 110, 224, 51, 199, 175, 179, 78, 196, 153, 235, 179,
-			40, 232, 132, 53, 142, 41, 219, 174, 239, 132, 251, 72, 87, 84,
-			98, 123, 110, 220, 100, 65, 136, 127, 131, 78, 76, 88, 43, 168,
-			187, 13, 183, 230, 0, 134, 18, 115, 66, 206, 218, 60, 108, 185,
-			113, 204, 235, 172, 29, 6, 187, 110, 157, 215, 89, 220, 116, 98,
-			22, 55, 161, 117, 158, 23, 236, 185, 254, 14, 171, 5, 126, 221,
-			133, 66, 17, 20, 34, 172, 197, 227, 155, 132, 48, 248, 119, 185,
-			135, 176, 136, 5, 13, 69, 81, 45, 168, 115, 214, 234, 68, 49,
-			11, 121, 236, 184, 62, 98, 117, 182, 131, 93, 248, 36, 57, 70,
-			152, 31, 196, 110, 141, 151, 88, 220, 116, 35, 230, 185, 81, 12,
-			24, 210, 53, 250, 245, 30, 114, 234, 110, 84, 243, 28, 183, 197,
-			195, 242, 195, 136, 112, 253, 52, 47, 20, 17, 237, 48, 168, 119,
-			106, 60, 161, 131, 36, 132, 124, 93, 116, 16, 38, 91, 87, 15,
-			106, 157, 22, 247, 99, 71, 117, 210, 76, 16, 178, 32, 110, 242,
-			144, 181, 156, 152, 135, 174, 227, 69, 9, 171, 177, 131, 226, 38,
-			39, 44, 77, 189, 110, 212, 42, 119, 177, 36, 32, 246, 157, 22,
-			7, 130, 210, 178, 229, 7, 201, 55, 228, 187, 27, 71, 208, 34,
-			95, 160, 10, 194, 136, 181, 156, 125, 182, 205, 65, 82, 234, 44,
-			14, 24, 247, 235, 65, 24, 113, 16, 138, 118, 24, 180, 130, 152,
-			51, 193, 147, 56, 98, 117, 30, 186, 187, 188, 206, 26, 97, 208,
-			34, 130, 11, 81, 208, 136, 247, 64, 76, 164, 4, 177, 168, 205,
-			107, 32, 65, 172, 29, 186, 32, 88, 33, 200, 142, 47, 164, 40,
-			138, 144, 118, 194, 54, 158, 171, 172, 179, 245, 181, 59, 27, 47,
-			44, 84, 151, 89, 101, 157, 221, 175, 174, 189, 167, 178, 180, 188,
-			196, 110, 191, 200, 54, 158, 91, 102, 139, 107, 247, 95, 172, 86,
-			238, 62, 183, 193, 158, 91, 91, 89, 90, 174, 174, 179, 133, 213,
-			37, 182, 184, 182, 186, 81, 173, 220, 222, 220, 88, 171, 174, 19,
-			86, 92, 88, 103, 149, 245, 34, 126, 89, 88, 125, 145, 45, 191,
-			247, 126, 117, 121, 125, 157, 173, 85, 89, 229, 222, 253, 149, 202,
-			242, 18, 123, 97, 161, 90, 93, 88, 221, 168, 44, 175, 151, 88,
-			101, 117, 113, 101, 115, 169, 178, 122, 183, 196, 110, 111, 110, 176,
-			213, 181, 13, 194, 86, 42, 247, 42, 27, 203, 75, 108, 99, 173,
-			132, 213, 30, 44, 199, 214, 238, 176, 123, 203, 213, 197, 231, 22,
-			86, 55, 22, 110, 87, 86, 42, 27, 47, 98, 133, 119, 42, 27,
-			171, 80, 217, 157, 181, 42, 97, 11, 236, 254, 66, 117, 163, 178,
-			184, 185, 178, 80, 101, 247, 55, 171, 247, 215, 214, 151, 25, 180,
-			108, 169, 178, 190, 184, 178, 80, 185, 183, 188, 84, 102, 149, 85,
-			182, 186, 198, 150, 223, 179, 188, 186, 193, 214, 159, 91, 88, 89,
-			233, 110, 40, 97, 107, 47, 172, 46, 87, 129, 250, 116, 51, 217,
-			237, 101, 182, 82, 89, 184, 189, 178, 12, 85, 97, 59, 151, 42,
-			213, 229, 197, 13, 104, 80, 242, 107, 177, 178, 180, 188, 186, 177,
-			176, 82, 34, 108, 253, 254, 242, 98, 101, 97, 165, 196, 150, 223,
-			187, 124, 239, 254, 202, 66, 245, 197, 146, 68, 186, 190, 252, 45,
-			155, 203, 171, 27, 149, 133, 21, 182, 180, 112, 111, 225, 238, 242,
-			58, 155, 124, 43, 174, 220, 175, 174, 45, 110, 86, 151, 239, 1,
-			213, 107, 119, 216, 250, 230, 237, 245, 141, 202, 198, 230, 198, 50,
-			187, 187, 182, 182, 132, 204, 94, 95, 174, 190, 167, 178, 184, 188,
-			126, 139, 173, 172, 173, 35, 195, 54, 215, 151, 75, 132, 45, 45,
-			108, 44, 96, 213, 247, 171, 107, 119, 42, 27, 235, 183, 224, 247,
-			237, 205, 245, 10, 50, 174, 178, 186, 177, 92, 173, 110, 222, 223,
-			168, 172, 173, 78, 177, 231, 214, 94, 88, 126, 207, 114, 149, 45,
-			46, 108, 174, 47, 47, 33, 135, 215, 86, 161, 181, 32, 43, 203,
-			107, 213, 23, 1, 45, 240, 1, 123, 160, 196, 94, 120, 110, 121,
-			227, 185, 229, 42, 48, 21, 185, 181, 0, 108, 88, 223, 168, 86,
-			22, 55, 210, 217, 214, 170, 108, 99, 173, 186, 65, 82, 237, 100,
-			171, 203, 119, 87, 42, 119, 151, 87, 23, 151, 225, 243, 26, 160,
-			121, 161, 178, 190, 60, 197, 22, 170, 149, 117, 200, 80, 193, 138,
-			217, 11, 11, 47, 178, 181, 77, 108, 53, 116, 212, 230, 250, 50,
-			17, 191, 83, 162, 91, 194, 254, 100, 149, 59, 108, 97, 233, 61,
-			21, 160, 92, 230, 190, 191, 182, 190, 94, 145, 226, 130, 108, 91,
-			124, 78, 242, 188, 76, 72, 142, 24, 38, 181, 88, 223, 56, 252,
-			202, 81, 171, 216, 119, 139, 12, 16, 51, 119, 73, 252, 20, 137,
-			23, 250, 150, 49, 113, 80, 252, 20, 137, 23, 251, 74, 152, 104,
-			136, 159, 34, 241, 82, 223, 21, 76, 148, 63, 69, 226, 99, 125,
-			69, 76, 36, 226, 167, 72, 156, 232, 59, 143, 137, 23, 197, 79,
-			145, 56, 217, 119, 14, 19, 207, 137, 159, 191, 104, 18, 211, 238,
-			163, 214, 124, 95, 190, 240, 121, 147, 45, 176, 29, 238, 243, 208,
-			173, 49, 156, 65, 89, 139, 71, 145, 179, 195, 197, 20, 176, 31,
-			116, 88, 205, 241, 89, 200, 167, 97, 162, 137, 3, 230, 236, 6,
-			110, 157, 213, 121, 195, 245, 81, 253, 117, 218, 30, 76, 38, 188,
-			78, 186, 203, 163, 250, 221, 15, 58, 33, 91, 184, 95, 137, 202,
-			108, 129, 197, 251, 109, 183, 230, 120, 140, 191, 230, 180, 218, 30,
-			103, 110, 4, 248, 112, 254, 138, 153, 19, 161, 22, 11, 249, 7,
-			58, 60, 138, 9, 147, 90, 45, 228, 81, 59, 240, 161, 230, 253,
-			54, 170, 62, 199, 7, 124, 48, 249, 52, 131, 122, 153, 221, 9,
-			66, 230, 250, 81, 236, 248, 53, 174, 102, 35, 152, 95, 221, 26,
-			103, 119, 130, 128, 125, 80, 36, 49, 22, 182, 107, 236, 182, 19,
-			78, 246, 216, 26, 101, 52, 53, 166, 96, 110, 234, 132, 126, 196,
-			30, 242, 253, 150, 64, 243, 6, 129, 127, 150, 221, 103, 80, 107,
-			62, 119, 100, 59, 139, 217, 174, 146, 255, 115, 144, 220, 220, 9,
-			202, 181, 102, 24, 180, 220, 78, 11, 109, 20, 175, 83, 115, 103,
-			156, 78, 220, 220, 146, 244, 204, 56, 109, 119, 38, 108, 215, 218,
-			219, 51, 78, 173, 22, 116, 252, 56, 146, 6, 210, 16, 100, 43,
-			203, 108, 133, 55, 179, 165, 138, 215, 73, 110, 157, 123, 141, 138,
-			223, 8, 104, 129, 228, 220, 58, 247, 99, 55, 222, 31, 55, 152,
-			49, 57, 80, 213, 48, 29, 38, 166, 219, 30, 55, 49, 213, 116,
-			219, 243, 203, 36, 183, 32, 43, 165, 55, 72, 255, 93, 30, 3,
-			26, 122, 188, 215, 246, 18, 237, 45, 28, 47, 167, 73, 42, 171,
-			42, 111, 79, 191, 116, 229, 109, 180, 243, 249, 95, 25, 0, 3,
-			107, 184, 239, 49, 131, 252, 183, 54, 26, 88, 195, 125, 116, 254,
-			23, 236, 46, 91, 105, 126, 142, 109, 52, 57, 91, 217, 92, 172,
-			176, 133, 78, 220, 12, 194, 8, 38, 144, 21, 183, 198, 125, 152,
-			177, 58, 126, 93, 78, 127, 11, 109, 167, 6, 57, 197, 151, 18,
-			123, 15, 15, 97, 186, 97, 243, 229, 89, 54, 9, 25, 138, 242,
-			83, 17, 58, 12, 68, 23, 102, 62, 63, 136, 81, 200, 112, 50,
-			107, 184, 30, 103, 252, 181, 26, 111, 199, 32, 159, 181, 160, 213,
-			246, 92, 16, 30, 61, 13, 43, 244, 101, 194, 94, 148, 24, 130,
-			109, 52, 92, 28, 180, 19, 64, 8, 83, 217, 152, 19, 75, 177,
-			67, 115, 238, 230, 204, 204, 222, 222, 94, 217, 65, 74, 5, 127,
-			68, 190, 104, 102, 165, 178, 184, 188, 186, 190, 60, 61, 95, 158,
-			37, 132, 109, 250, 30, 143, 34, 148, 119, 55, 228, 117, 182, 189,
-			207, 156, 54, 14, 165, 109, 143, 51, 207, 217, 131, 17, 224, 236,
-			132, 92, 204, 217, 174, 143, 243, 172, 235, 239, 148, 244, 132, 156,
-			50, 24, 186, 216, 164, 40, 115, 163, 174, 12, 104, 138, 232, 41,
-			245, 246, 194, 122, 101, 189, 68, 216, 11, 149, 141, 231, 64, 7,
-			166, 231, 67, 156, 74, 150, 42, 160, 183, 81, 217, 131, 174, 124,
-			119, 101, 117, 169, 196, 164, 45, 194, 95, 107, 135, 64, 61, 12,
-			62, 96, 32, 90, 179, 235, 156, 119, 85, 223, 144, 67, 88, 155,
-			11, 96, 190, 119, 64, 179, 236, 4, 187, 60, 68, 237, 145, 216,
-			12, 104, 90, 17, 230, 185, 45, 87, 216, 77, 209, 193, 22, 105,
-			197, 154, 239, 59, 38, 85, 27, 237, 187, 173, 116, 168, 252, 105,
-			245, 81, 107, 172, 239, 18, 89, 37, 102, 182, 143, 218, 227, 125,
-			231, 140, 194, 109, 166, 132, 95, 43, 7, 48, 144, 28, 215, 143,
-			164, 38, 65, 187, 232, 53, 167, 229, 250, 96, 77, 215, 221, 93,
-			183, 222, 113, 60, 166, 7, 42, 142, 251, 44, 140, 251, 241, 92,
-			158, 252, 182, 65, 236, 108, 159, 217, 71, 173, 179, 230, 147, 133,
-			95, 51, 152, 28, 79, 90, 141, 184, 190, 240, 10, 208, 254, 219,
-			6, 163, 9, 154, 81, 115, 60, 143, 135, 108, 219, 137, 68, 119,
-			180, 157, 40, 2, 189, 9, 163, 7, 198, 173, 176, 201, 89, 28,
-			60, 224, 62, 142, 129, 5, 47, 10, 52, 206, 4, 195, 68, 196,
-			42, 247, 153, 83, 175, 99, 39, 76, 58, 208, 42, 238, 131, 8,
-			33, 191, 69, 11, 167, 202, 108, 51, 226, 141, 142, 199, 246, 154,
-			220, 39, 172, 206, 183, 59, 59, 59, 192, 244, 158, 234, 220, 40,
-			234, 112, 168, 110, 136, 100, 160, 81, 6, 181, 206, 102, 143, 40,
-			200, 164, 214, 217, 225, 11, 10, 178, 168, 117, 182, 124, 157, 60,
-			129, 19, 136, 125, 161, 239, 49, 163, 112, 133, 41, 237, 0, 2,
-			247, 102, 13, 47, 39, 202, 243, 66, 46, 79, 202, 196, 182, 145,
-			135, 23, 205, 227, 197, 243, 140, 151, 119, 202, 172, 216, 137, 120,
-			120, 51, 10, 90, 60, 240, 249, 187, 228, 68, 1, 222, 80, 17,
-			201, 131, 252, 25, 40, 144, 83, 16, 76, 147, 3, 35, 10, 178,
-			168, 117, 113, 236, 24, 57, 143, 152, 13, 106, 93, 50, 243, 197,
-			49, 137, 121, 238, 198, 124, 121, 238, 250, 147, 229, 217, 242, 156,
-			70, 102, 100, 32, 143, 66, 102, 64, 137, 129, 65, 5, 89, 212,
-			186, 52, 124, 84, 235, 248, 127, 60, 71, 206, 245, 106, 230, 216,
-			109, 241, 40, 118, 90, 237, 135, 121, 186, 183, 200, 192, 134, 202,
-			67, 199, 73, 127, 196, 193, 151, 136, 80, 93, 91, 85, 5, 210,
-			49, 146, 241, 29, 63, 136, 80, 97, 103, 170, 2, 184, 253, 97,
-			227, 112, 247, 120, 88, 163, 84, 46, 242, 252, 35, 186, 200, 154,
-			222, 183, 229, 38, 255, 218, 12, 233, 167, 153, 179, 125, 31, 53,
-			140, 119, 252, 228, 119, 252, 228, 119, 252, 228, 119, 252, 228, 119,
-			252, 228, 119, 252, 228, 111, 162, 159, 172, 221, 87, 248, 169, 252,
-			228, 138, 50, 252, 224, 167, 242, 147, 181, 243, 124, 73, 59, 207,
-			143, 245, 205, 40, 231, 25, 126, 42, 63, 89, 59, 207, 19, 218,
-			121, 158, 76, 156, 103, 248, 249, 191, 157, 65, 51, 39, 251, 119,
-			13, 152, 250, 10, 255, 244, 12, 91, 96, 122, 234, 69, 5, 201,
-			35, 14, 54, 165, 195, 218, 129, 235, 163, 67, 1, 51, 44, 24,
-			144, 188, 205, 125, 240, 197, 132, 203, 186, 47, 210, 95, 15, 124,
-			212, 38, 94, 80, 115, 60, 2, 86, 17, 247, 235, 78, 88, 98,
-			220, 7, 237, 95, 7, 39, 24, 156, 140, 142, 40, 39, 173, 3,
-			212, 165, 141, 208, 169, 37, 51, 134, 250, 0, 19, 2, 152, 10,
-			8, 195, 140, 25, 120, 66, 41, 162, 71, 37, 16, 185, 48, 149,
-			122, 78, 236, 238, 10, 247, 221, 103, 188, 29, 212, 154, 204, 137,
-			217, 230, 198, 34, 107, 185, 117, 31, 53, 122, 224, 19, 246, 188,
-			227, 119, 96, 26, 152, 43, 177, 185, 27, 79, 204, 150, 148, 162,
-			110, 135, 129, 199, 219, 177, 91, 99, 119, 67, 190, 19, 132, 174,
-			227, 107, 234, 217, 94, 211, 173, 53, 25, 127, 45, 230, 64, 19,
-			42, 232, 67, 114, 109, 59, 181, 7, 123, 78, 88, 71, 159, 127,
-			159, 59, 33, 11, 124, 46, 108, 92, 143, 181, 92, 191, 19, 115,
-			156, 47, 217, 245, 89, 221, 62, 47, 240, 119, 202, 108, 133, 59,
-			237, 164, 201, 33, 103, 197, 168, 197, 157, 144, 215, 139, 44, 10,
-			196, 4, 236, 7, 204, 227, 78, 155, 200, 108, 44, 70, 39, 202,
-			141, 152, 207, 57, 240, 181, 129, 171, 4, 49, 15, 219, 48, 183,
-			138, 9, 189, 19, 161, 45, 204, 222, 55, 127, 109, 186, 25, 116,
-			66, 230, 185, 62, 119, 66, 194, 16, 251, 203, 147, 111, 110, 116,
-			64, 127, 206, 96, 206, 41, 212, 226, 77, 206, 66, 180, 114, 192,
-			199, 12, 131, 22, 155, 157, 157, 157, 155, 198, 255, 54, 102, 103,
-			111, 226, 127, 47, 65, 211, 111, 220, 184, 113, 99, 122, 110, 126,
-			250, 234, 220, 198, 252, 213, 155, 143, 223, 184, 249, 248, 141, 242,
-			13, 245, 239, 165, 50, 187, 189, 79, 160, 35, 227, 208, 173, 129,
-			183, 7, 69, 176, 137, 136, 189, 196, 246, 56, 227, 126, 212, 9,
-			229, 242, 204, 30, 199, 213, 153, 90, 224, 239, 242, 48, 22, 253,
-			43, 38, 37, 246, 190, 234, 157, 69, 194, 174, 94, 189, 122, 35,
-			105, 11, 184, 166, 46, 143, 27, 104, 33, 134, 141, 26, 252, 15,
-			57, 202, 241, 107, 241, 20, 88, 108, 156, 65, 205, 254, 14, 122,
-			31, 23, 216, 178, 48, 192, 35, 66, 212, 79, 54, 119, 147, 45,
-			6, 173, 118, 39, 230, 169, 177, 128, 21, 222, 95, 91, 175, 188,
-			151, 189, 2, 156, 153, 156, 122, 165, 44, 77, 158, 36, 147, 54,
-			62, 229, 98, 74, 98, 60, 71, 60, 222, 146, 29, 60, 137, 197,
-			87, 55, 87, 86, 166, 166, 14, 205, 135, 242, 62, 57, 59, 117,
-			43, 69, 211, 252, 91, 209, 180, 195, 99, 192, 18, 52, 234, 206,
-			126, 138, 182, 40, 14, 59, 181, 24, 43, 216, 117, 60, 22, 239,
-			202, 26, 187, 178, 63, 22, 239, 150, 24, 18, 116, 235, 107, 109,
-			210, 110, 57, 222, 5, 232, 205, 90, 36, 50, 117, 34, 94, 99,
-			151, 217, 220, 236, 108, 119, 11, 175, 62, 180, 133, 47, 184, 254,
-			213, 121, 246, 10, 56, 160, 251, 81, 204, 91, 240, 121, 33, 186,
-			227, 122, 124, 163, 187, 35, 238, 84, 86, 150, 55, 42, 247, 150,
-			89, 35, 150, 100, 60, 172, 204, 99, 141, 88, 81, 186, 89, 89,
-			221, 184, 126, 141, 197, 110, 237, 65, 196, 158, 102, 147, 147, 147,
-			34, 101, 170, 17, 151, 235, 123, 207, 185, 59, 205, 37, 39, 198,
-			82, 83, 236, 169, 167, 216, 213, 249, 41, 246, 33, 134, 223, 86,
-			130, 61, 245, 73, 241, 109, 102, 134, 45, 0, 189, 245, 96, 47,
-			66, 148, 48, 88, 230, 102, 103, 83, 58, 44, 42, 235, 12, 66,
-			75, 205, 93, 63, 56, 140, 52, 54, 40, 62, 119, 253, 218, 181,
-			107, 79, 92, 189, 62, 155, 168, 141, 109, 222, 8, 66, 206, 54,
-			125, 247, 53, 133, 229, 198, 19, 179, 189, 88, 202, 95, 91, 103,
-			78, 138, 246, 179, 201, 73, 193, 148, 25, 236, 44, 248, 55, 197,
-			166, 211, 228, 188, 133, 4, 3, 30, 96, 151, 194, 115, 41, 133,
-			7, 5, 96, 170, 75, 0, 174, 61, 84, 0, 158, 119, 118, 29,
-			246, 138, 232, 200, 114, 173, 19, 134, 220, 143, 33, 203, 61, 215,
-			243, 220, 40, 37, 0, 160, 77, 89, 11, 83, 217, 211, 236, 225,
-			5, 222, 68, 204, 217, 211, 73, 106, 217, 231, 123, 183, 59, 174,
-			87, 231, 225, 228, 20, 52, 108, 93, 114, 72, 86, 33, 24, 51,
-			165, 214, 95, 25, 131, 60, 171, 162, 237, 174, 31, 67, 203, 101,
-			78, 209, 116, 217, 108, 228, 192, 84, 121, 27, 48, 35, 45, 9,
-			15, 30, 127, 11, 30, 84, 112, 29, 56, 46, 251, 193, 94, 170,
-			217, 50, 149, 249, 193, 30, 123, 154, 117, 229, 121, 211, 150, 38,
-			132, 191, 117, 147, 253, 96, 175, 188, 195, 227, 101, 16, 54, 145,
-			54, 57, 149, 106, 121, 119, 235, 101, 102, 0, 38, 31, 210, 210,
-			235, 15, 109, 169, 236, 47, 101, 103, 176, 251, 251, 113, 83, 56,
-			18, 93, 130, 150, 238, 168, 201, 169, 94, 41, 188, 203, 227, 197,
-			164, 223, 39, 167, 80, 215, 63, 191, 190, 182, 202, 238, 57, 237,
-			182, 235, 239, 16, 194, 42, 190, 72, 17, 94, 123, 9, 205, 128,
-			20, 159, 246, 219, 56, 213, 117, 25, 46, 98, 234, 144, 54, 3,
-			193, 9, 232, 109, 205, 63, 162, 42, 176, 93, 28, 48, 91, 74,
-			2, 141, 72, 133, 202, 138, 31, 4, 187, 225, 141, 233, 15, 182,
-			2, 63, 110, 190, 49, 253, 193, 186, 179, 255, 198, 198, 7, 97,
-			242, 126, 227, 230, 7, 91, 174, 255, 198, 205, 15, 70, 188, 246,
-			198, 251, 202, 31, 4, 115, 9, 134, 236, 27, 47, 191, 84, 36,
-			108, 175, 201, 67, 206, 68, 105, 64, 228, 120, 123, 206, 126, 164,
-			86, 49, 121, 93, 218, 2, 13, 176, 2, 234, 238, 142, 27, 71,
-			96, 212, 120, 156, 201, 154, 74, 12, 171, 42, 17, 38, 42, 43,
-			49, 172, 173, 132, 147, 45, 86, 137, 118, 201, 235, 60, 12, 166,
-			219, 78, 189, 46, 220, 199, 120, 47, 80, 216, 184, 83, 107, 10,
-			155, 76, 217, 113, 96, 255, 73, 149, 82, 146, 22, 20, 76, 228,
-			59, 1, 235, 180, 209, 76, 80, 69, 39, 221, 50, 47, 203, 196,
-			185, 195, 173, 189, 169, 18, 193, 250, 131, 182, 192, 44, 106, 42,
-			190, 84, 100, 81, 167, 209, 112, 95, 195, 5, 205, 154, 3, 6,
-			22, 244, 34, 200, 1, 90, 162, 147, 197, 205, 141, 197, 226, 212,
-			173, 174, 84, 34, 12, 70, 177, 42, 93, 102, 11, 76, 44, 127,
-			9, 97, 136, 208, 39, 119, 95, 231, 33, 139, 154, 65, 199, 171,
-			43, 86, 118, 34, 142, 214, 228, 164, 19, 233, 218, 234, 108, 123,
-			159, 0, 25, 83, 184, 2, 9, 94, 176, 47, 76, 154, 131, 162,
-			4, 140, 116, 186, 170, 106, 59, 97, 148, 84, 179, 205, 9, 67,
-			155, 14, 44, 156, 26, 174, 222, 111, 7, 113, 19, 235, 132, 178,
-			98, 209, 64, 181, 33, 58, 64, 7, 152, 189, 65, 163, 17, 241,
-			24, 205, 181, 59, 65, 168, 118, 161, 74, 172, 56, 63, 59, 247,
-			4, 204, 14, 115, 143, 111, 204, 206, 221, 188, 58, 123, 115, 238,
-			241, 242, 236, 220, 75, 69, 41, 221, 17, 67, 88, 79, 47, 109,
-			39, 138, 9, 195, 156, 88, 127, 224, 39, 118, 243, 227, 37, 6,
-			216, 202, 114, 0, 57, 187, 206, 122, 45, 116, 219, 113, 9, 172,
-			221, 46, 83, 205, 97, 48, 61, 178, 96, 251, 85, 94, 139, 133,
-			149, 7, 166, 163, 16, 118, 33, 143, 40, 254, 160, 174, 234, 78,
-			88, 39, 236, 125, 113, 80, 89, 95, 91, 199, 65, 54, 57, 117,
-			136, 129, 90, 110, 5, 175, 187, 158, 231, 224, 232, 226, 254, 244,
-			230, 250, 76, 61, 168, 69, 51, 47, 240, 237, 153, 132, 148, 153,
-			42, 111, 240, 144, 251, 53, 62, 115, 215, 11, 182, 29, 111, 107,
-			13, 105, 136, 102, 128, 160, 153, 84, 37, 83, 68, 111, 179, 85,
-			148, 166, 41, 225, 56, 23, 36, 177, 87, 192, 98, 4, 166, 151,
-			213, 143, 87, 84, 131, 160, 169, 219, 92, 181, 150, 215, 201, 161,
-			77, 36, 236, 125, 175, 68, 113, 216, 192, 162, 169, 22, 5, 181,
-			168, 220, 22, 154, 13, 218, 50, 63, 227, 185, 219, 161, 19, 238,
-			163, 217, 93, 110, 198, 45, 239, 2, 254, 82, 101, 167, 112, 205,
-			133, 104, 65, 86, 149, 68, 109, 94, 99, 19, 151, 94, 156, 190,
-			212, 154, 190, 84, 223, 184, 244, 220, 205, 75, 247, 110, 94, 90,
-			47, 95, 106, 188, 52, 81, 102, 43, 238, 3, 190, 231, 70, 28,
-			221, 28, 96, 80, 210, 75, 157, 136, 11, 108, 207, 7, 117, 7,
-			133, 117, 34, 98, 239, 123, 165, 178, 190, 166, 140, 154, 59, 66,
-			89, 213, 37, 56, 57, 245, 202, 203, 147, 36, 189, 5, 244, 106,
-			80, 23, 61, 1, 63, 166, 209, 95, 112, 218, 46, 118, 136, 74,
-			21, 94, 132, 160, 117, 230, 32, 110, 108, 167, 170, 224, 210, 252,
-			210, 165, 249, 37, 194, 166, 128, 145, 122, 67, 74, 148, 141, 121,
-			200, 106, 78, 27, 7, 72, 208, 16, 219, 185, 142, 24, 106, 106,
-			152, 69, 66, 45, 107, 254, 227, 90, 252, 160, 88, 141, 183, 255,
-			174, 145, 27, 33, 159, 54, 228, 122, 188, 253, 17, 195, 28, 43,
-			252, 67, 131, 85, 19, 15, 87, 201, 126, 208, 64, 145, 71, 30,
-			71, 174, 95, 75, 91, 89, 228, 112, 51, 139, 221, 235, 68, 49,
-			200, 194, 155, 185, 69, 228, 48, 191, 232, 37, 230, 250, 53, 175,
-			19, 185, 187, 224, 40, 30, 81, 203, 255, 64, 95, 191, 2, 13,
-			0, 115, 71, 21, 104, 1, 72, 71, 201, 191, 50, 228, 22, 128,
-			253, 61, 134, 73, 11, 255, 220, 96, 171, 129, 63, 237, 243, 29,
-			225, 7, 119, 121, 211, 142, 242, 26, 193, 145, 60, 220, 155, 94,
-			149, 5, 181, 131, 185, 235, 120, 29, 30, 137, 37, 201, 4, 25,
-			46, 156, 70, 177, 235, 121, 172, 233, 236, 114, 230, 167, 235, 68,
-			212, 178, 32, 17, 222, 155, 112, 208, 27, 65, 8, 142, 177, 90,
-			61, 232, 101, 152, 116, 26, 75, 242, 127, 114, 8, 83, 140, 12,
-			182, 83, 49, 197, 192, 102, 231, 142, 40, 208, 2, 48, 63, 162,
-			119, 50, 254, 227, 24, 121, 234, 109, 237, 86, 123, 94, 176, 231,
-			185, 209, 215, 176, 95, 93, 120, 171, 45, 147, 226, 183, 144, 227,
-			43, 110, 20, 47, 232, 58, 170, 114, 199, 159, 62, 65, 72, 82,
-			243, 184, 193, 172, 201, 193, 249, 19, 221, 251, 210, 186, 84, 53,
-			149, 181, 56, 69, 70, 239, 242, 4, 99, 85, 132, 20, 80, 74,
-			108, 223, 105, 113, 185, 85, 142, 191, 139, 95, 52, 200, 128, 206,
-			120, 88, 14, 220, 180, 233, 108, 251, 60, 142, 198, 77, 102, 77,
-			14, 84, 21, 72, 25, 25, 172, 243, 8, 117, 170, 27, 248, 227,
-			22, 22, 74, 39, 209, 27, 132, 212, 66, 14, 83, 208, 86, 28,
-			141, 219, 204, 152, 28, 156, 47, 28, 216, 113, 215, 83, 97, 117,
-			64, 230, 222, 136, 232, 153, 164, 232, 246, 254, 120, 6, 113, 171,
-			207, 183, 247, 231, 63, 103, 16, 146, 176, 140, 174, 146, 225, 110,
-			38, 62, 116, 99, 255, 98, 55, 3, 31, 194, 250, 231, 201, 80,
-			154, 131, 244, 124, 119, 169, 67, 184, 91, 120, 88, 207, 188, 221,
-			144, 129, 127, 113, 84, 132, 12, 92, 123, 39, 100, 224, 157, 144,
-			129, 111, 114, 200, 0, 252, 52, 168, 117, 172, 111, 138, 108, 170,
-			232, 1, 102, 20, 42, 44, 25, 34, 15, 139, 31, 136, 210, 1,
-			4, 137, 46, 194, 22, 201, 34, 221, 65, 4, 148, 124, 81, 7,
-			17, 156, 54, 239, 21, 126, 204, 96, 221, 131, 81, 239, 251, 59,
-			158, 39, 246, 224, 146, 79, 210, 11, 244, 246, 89, 20, 7, 32,
-			10, 174, 159, 12, 6, 162, 105, 172, 59, 177, 131, 25, 132, 71,
-			144, 66, 176, 7, 115, 212, 54, 151, 117, 8, 4, 142, 215, 110,
-			58, 219, 60, 198, 24, 173, 32, 172, 243, 144, 36, 161, 10, 113,
-			147, 187, 33, 171, 44, 117, 133, 9, 156, 206, 142, 165, 194, 4,
-			78, 31, 187, 156, 10, 19, 56, 253, 248, 187, 201, 6, 54, 208,
-			160, 214, 57, 243, 169, 194, 93, 150, 86, 27, 186, 117, 73, 74,
-			58, 116, 0, 216, 230, 168, 96, 48, 94, 79, 114, 233, 250, 13,
-			64, 155, 165, 10, 50, 169, 117, 110, 244, 49, 5, 89, 212, 58,
-			55, 119, 147, 188, 55, 21, 166, 176, 194, 14, 215, 117, 194, 31,
-			60, 192, 97, 105, 249, 116, 49, 150, 173, 75, 229, 150, 142, 99,
-			56, 75, 30, 83, 113, 12, 151, 204, 139, 133, 147, 88, 13, 154,
-			21, 158, 151, 66, 88, 214, 241, 11, 54, 100, 212, 80, 150, 90,
-			151, 6, 199, 82, 209, 12, 151, 142, 157, 75, 69, 51, 92, 42,
-			94, 32, 171, 196, 180, 13, 106, 79, 245, 93, 49, 10, 183, 217,
-			33, 186, 87, 6, 210, 137, 65, 179, 223, 221, 18, 182, 205, 97,
-			208, 104, 78, 74, 218, 129, 123, 83, 185, 83, 228, 34, 177, 109,
-			3, 104, 191, 108, 210, 226, 9, 198, 203, 59, 55, 89, 49, 10,
-			90, 124, 90, 99, 144, 193, 18, 6, 70, 94, 92, 150, 193, 18,
-			6, 210, 122, 121, 224, 136, 130, 44, 106, 93, 206, 143, 144, 13,
-			98, 218, 38, 181, 203, 125, 215, 140, 194, 115, 169, 190, 197, 112,
-			65, 233, 127, 38, 180, 137, 94, 86, 210, 42, 156, 158, 135, 113,
-			27, 196, 168, 156, 27, 65, 138, 77, 160, 120, 230, 173, 40, 54,
-			145, 226, 25, 73, 177, 137, 20, 207, 72, 138, 77, 164, 120, 38,
-			175, 240, 25, 212, 154, 53, 207, 42, 124, 239, 43, 206, 205, 63,
-			81, 158, 45, 207, 150, 231, 102, 230, 175, 21, 95, 86, 248, 12,
-			27, 178, 105, 40, 67, 173, 217, 193, 17, 5, 1, 10, 122, 82,
-			65, 22, 181, 102, 79, 159, 33, 51, 136, 221, 164, 214, 156, 121,
-			178, 88, 148, 212, 110, 52, 133, 204, 41, 185, 23, 90, 162, 92,
-			46, 107, 194, 205, 12, 148, 80, 132, 3, 117, 115, 3, 99, 10,
-			178, 168, 53, 119, 98, 28, 195, 103, 16, 152, 55, 175, 136, 240,
-			153, 155, 172, 56, 119, 227, 137, 121, 105, 94, 207, 161, 121, 61,
-			63, 91, 158, 157, 159, 123, 73, 99, 182, 178, 80, 224, 148, 130,
-			12, 106, 205, 159, 126, 76, 65, 128, 108, 234, 50, 185, 130, 152,
-			109, 106, 93, 53, 199, 139, 103, 37, 102, 12, 204, 137, 121, 20,
-			31, 18, 149, 99, 154, 118, 6, 114, 43, 130, 109, 131, 90, 87,
-			7, 70, 21, 100, 81, 235, 234, 241, 19, 218, 252, 252, 97, 74,
-			158, 124, 91, 230, 103, 39, 110, 214, 183, 15, 53, 61, 223, 210,
-			186, 188, 71, 232, 93, 30, 175, 251, 78, 59, 106, 6, 218, 18,
-			44, 144, 92, 200, 119, 93, 152, 67, 100, 36, 142, 134, 233, 41,
-			50, 16, 61, 112, 219, 91, 219, 65, 125, 31, 195, 113, 114, 213,
-			28, 36, 220, 14, 234, 251, 96, 46, 230, 20, 50, 122, 150, 12,
-			34, 197, 245, 237, 173, 144, 239, 74, 68, 3, 144, 180, 180, 93,
-			229, 187, 244, 34, 25, 86, 223, 163, 166, 51, 255, 248, 117, 25,
-			142, 57, 36, 178, 172, 99, 26, 157, 36, 121, 149, 171, 206, 27,
-			30, 216, 119, 104, 74, 14, 85, 135, 69, 190, 37, 153, 250, 117,
-			88, 147, 243, 223, 66, 178, 48, 164, 150, 110, 211, 187, 100, 48,
-			197, 16, 202, 14, 24, 118, 61, 188, 58, 16, 9, 42, 63, 191,
-			93, 179, 238, 179, 195, 194, 172, 187, 250, 166, 102, 221, 252, 59,
-			102, 221, 59, 102, 221, 223, 176, 89, 55, 69, 110, 10, 91, 238,
-			68, 223, 41, 163, 80, 102, 98, 32, 28, 106, 191, 237, 5, 225,
-			3, 209, 251, 34, 83, 202, 96, 59, 145, 27, 38, 142, 178, 215,
-			10, 230, 141, 194, 6, 75, 13, 22, 156, 195, 228, 244, 166, 70,
-			177, 170, 8, 71, 38, 209, 81, 245, 136, 62, 82, 229, 164, 157,
-			176, 19, 6, 157, 118, 119, 8, 102, 33, 59, 146, 178, 173, 10,
-			244, 98, 202, 182, 42, 204, 60, 65, 158, 17, 182, 205, 89, 176,
-			109, 230, 217, 193, 129, 11, 125, 46, 226, 75, 161, 101, 105, 90,
-			195, 118, 45, 101, 193, 156, 205, 21, 200, 167, 212, 210, 143, 85,
-			52, 143, 21, 254, 161, 161, 135, 147, 20, 112, 77, 110, 28, 176,
-			29, 30, 67, 103, 207, 170, 223, 240, 29, 218, 11, 70, 143, 15,
-			67, 101, 53, 136, 177, 77, 187, 2, 135, 142, 170, 114, 118, 29,
-			215, 67, 145, 22, 75, 218, 49, 46, 202, 203, 143, 59, 142, 88,
-			161, 82, 106, 152, 48, 191, 211, 218, 230, 97, 52, 85, 78, 7,
-			126, 22, 205, 254, 148, 169, 84, 204, 229, 83, 166, 82, 113, 116,
-			140, 236, 38, 129, 159, 199, 10, 46, 171, 52, 88, 28, 118, 82,
-			135, 8, 208, 236, 5, 45, 32, 172, 79, 230, 212, 226, 142, 227,
-			233, 246, 149, 216, 171, 29, 224, 92, 28, 177, 245, 231, 22, 230,
-			31, 191, 78, 88, 211, 137, 154, 37, 77, 150, 164, 74, 68, 183,
-			233, 169, 166, 59, 154, 52, 155, 142, 38, 237, 207, 167, 163, 73,
-			71, 199, 200, 135, 13, 97, 205, 93, 238, 187, 106, 20, 246, 153,
-			238, 20, 45, 142, 7, 3, 104, 157, 100, 220, 72, 145, 82, 228,
-			148, 101, 224, 65, 15, 117, 110, 164, 67, 216, 196, 121, 0, 52,
-			10, 221, 72, 183, 51, 101, 4, 94, 206, 229, 201, 172, 50, 2,
-			75, 230, 120, 225, 2, 171, 246, 160, 67, 33, 112, 35, 77, 107,
-			57, 109, 16, 150, 100, 143, 8, 131, 176, 148, 27, 77, 25, 132,
-			165, 227, 39, 200, 52, 226, 6, 219, 205, 60, 93, 96, 146, 173,
-			200, 85, 169, 21, 14, 67, 12, 140, 44, 107, 75, 19, 200, 44,
-			15, 156, 80, 144, 69, 173, 114, 225, 20, 121, 55, 34, 54, 193,
-			32, 59, 83, 120, 134, 45, 29, 54, 234, 212, 160, 43, 177, 64,
-			198, 109, 186, 13, 166, 103, 119, 52, 155, 195, 14, 215, 213, 130,
-			213, 53, 171, 219, 131, 54, 97, 110, 92, 65, 96, 208, 157, 58,
-			77, 174, 98, 181, 194, 234, 42, 60, 134, 43, 195, 42, 6, 52,
-			213, 18, 182, 231, 68, 76, 78, 191, 26, 125, 218, 244, 50, 186,
-			76, 47, 67, 153, 94, 218, 70, 250, 226, 244, 219, 91, 162, 19,
-			33, 182, 94, 176, 115, 248, 18, 221, 91, 218, 73, 31, 49, 200,
-			49, 112, 92, 22, 17, 207, 74, 176, 19, 41, 91, 233, 173, 172,
-			156, 227, 36, 27, 59, 225, 14, 143, 165, 117, 35, 33, 122, 134,
-			144, 182, 179, 195, 183, 48, 152, 93, 46, 142, 13, 64, 202, 6,
-			36, 128, 153, 133, 159, 35, 247, 117, 142, 182, 76, 166, 154, 131,
-			132, 117, 247, 117, 94, 220, 21, 107, 130, 105, 98, 228, 194, 212,
-			53, 210, 47, 154, 170, 22, 4, 11, 61, 203, 78, 216, 251, 162,
-			96, 85, 101, 165, 143, 145, 163, 62, 127, 45, 222, 74, 17, 36,
-			136, 61, 2, 201, 247, 21, 81, 197, 191, 51, 64, 134, 210, 24,
-			232, 57, 50, 40, 112, 108, 197, 251, 109, 181, 46, 72, 68, 210,
-			198, 126, 155, 63, 180, 245, 61, 92, 179, 122, 185, 150, 39, 214,
-			94, 51, 192, 134, 15, 84, 225, 39, 45, 19, 123, 175, 201, 125,
-			92, 234, 123, 115, 187, 14, 243, 209, 113, 210, 95, 11, 90, 45,
-			238, 199, 227, 89, 196, 162, 64, 32, 218, 105, 183, 183, 164, 254,
-			29, 239, 23, 68, 59, 237, 182, 212, 234, 189, 11, 151, 185, 131,
-			11, 151, 19, 228, 104, 224, 213, 183, 210, 185, 6, 48, 215, 112,
-			224, 213, 151, 82, 25, 143, 147, 108, 176, 231, 243, 48, 26, 39,
-			162, 253, 2, 130, 222, 7, 4, 242, 219, 160, 232, 253, 192, 171,
-			175, 137, 207, 227, 164, 191, 197, 81, 191, 143, 15, 137, 69, 85,
-			9, 210, 49, 146, 217, 241, 130, 237, 104, 252, 8, 166, 11, 0,
-			170, 241, 209, 125, 29, 31, 198, 100, 9, 165, 23, 103, 143, 118,
-			47, 206, 166, 207, 70, 229, 123, 206, 70, 21, 201, 17, 183, 189,
-			133, 14, 216, 22, 120, 96, 227, 35, 130, 3, 110, 27, 29, 86,
-			16, 61, 16, 153, 0, 123, 176, 230, 185, 220, 143, 183, 220, 250,
-			56, 21, 34, 131, 201, 139, 152, 90, 169, 211, 50, 25, 237, 202,
-			23, 241, 90, 200, 227, 241, 81, 204, 59, 146, 202, 187, 142, 31,
-			232, 211, 228, 148, 200, 239, 212, 69, 168, 180, 227, 37, 85, 68,
-			227, 99, 216, 138, 113, 204, 178, 160, 115, 168, 218, 34, 58, 67,
-			198, 80, 126, 81, 5, 240, 112, 171, 19, 122, 91, 129, 87, 31,
-			63, 38, 234, 195, 111, 235, 248, 105, 51, 244, 214, 188, 250, 161,
-			5, 124, 190, 55, 126, 252, 176, 2, 171, 124, 15, 26, 20, 241,
-			90, 39, 116, 227, 253, 173, 90, 224, 55, 220, 29, 172, 224, 132,
-			200, 175, 62, 45, 226, 23, 168, 224, 144, 252, 128, 127, 252, 176,
-			252, 128, 255, 10, 25, 73, 153, 122, 91, 184, 91, 62, 126, 18,
-			155, 157, 79, 125, 88, 128, 116, 58, 67, 70, 211, 153, 197, 192,
-			171, 143, 23, 48, 59, 77, 125, 18, 227, 245, 64, 129, 144, 183,
-			130, 93, 94, 31, 63, 117, 160, 64, 85, 124, 1, 39, 77, 82,
-			29, 242, 93, 108, 233, 105, 225, 164, 137, 212, 42, 223, 133, 70,
-			118, 231, 130, 246, 157, 233, 201, 5, 77, 43, 146, 35, 80, 67,
-			164, 81, 157, 21, 114, 133, 137, 18, 83, 87, 30, 64, 116, 174,
-			59, 207, 42, 223, 155, 119, 9, 73, 84, 31, 125, 159, 88, 219,
-			79, 165, 92, 56, 184, 134, 127, 64, 111, 31, 182, 208, 127, 80,
-			159, 190, 93, 47, 238, 251, 152, 240, 226, 248, 59, 94, 220, 59,
-			94, 220, 55, 217, 139, 171, 40, 47, 174, 96, 20, 158, 102, 137,
-			44, 63, 210, 138, 188, 60, 130, 132, 6, 82, 218, 169, 163, 228,
-			174, 114, 234, 78, 154, 247, 10, 55, 89, 247, 56, 57, 176, 6,
-			159, 194, 3, 93, 184, 164, 23, 216, 211, 174, 219, 201, 174, 101,
-			241, 147, 93, 203, 226, 39, 31, 127, 55, 249, 14, 67, 249, 110,
-			87, 140, 194, 30, 59, 116, 252, 226, 178, 180, 90, 182, 85, 190,
-			150, 147, 156, 180, 73, 232, 72, 133, 15, 17, 220, 53, 118, 189,
-			152, 43, 9, 76, 108, 16, 117, 188, 70, 24, 40, 93, 14, 224,
-			25, 242, 148, 242, 255, 152, 57, 94, 152, 233, 117, 51, 146, 67,
-			78, 73, 181, 104, 225, 182, 156, 58, 239, 242, 207, 88, 151, 127,
-			198, 164, 55, 32, 90, 206, 142, 159, 32, 55, 148, 127, 118, 193,
-			28, 43, 148, 216, 50, 206, 199, 50, 146, 25, 44, 102, 161, 197,
-			85, 212, 121, 82, 91, 151, 139, 117, 161, 235, 192, 222, 133, 129,
-			163, 41, 23, 235, 2, 29, 85, 222, 172, 73, 173, 9, 115, 28,
-			188, 89, 208, 70, 184, 239, 14, 156, 235, 49, 4, 89, 200, 107,
-			28, 79, 242, 160, 66, 56, 92, 77, 226, 73, 74, 17, 194, 162,
-			92, 94, 64, 195, 0, 77, 79, 111, 148, 193, 233, 196, 13, 240,
-			18, 228, 21, 75, 2, 13, 55, 140, 68, 110, 221, 16, 240, 53,
-			38, 116, 67, 128, 37, 19, 3, 138, 91, 224, 94, 76, 28, 63,
-			65, 86, 176, 29, 22, 181, 46, 155, 199, 11, 207, 98, 51, 90,
-			206, 107, 110, 171, 211, 74, 185, 102, 105, 137, 68, 189, 82, 243,
-			58, 117, 174, 88, 168, 60, 95, 93, 175, 133, 139, 248, 170, 151,
-			44, 244, 0, 213, 241, 73, 112, 66, 46, 143, 29, 35, 239, 19,
-			46, 106, 185, 239, 113, 163, 176, 246, 16, 150, 36, 163, 237, 80,
-			161, 20, 125, 218, 114, 226, 90, 147, 139, 131, 96, 31, 232, 240,
-			112, 63, 229, 120, 150, 115, 103, 201, 37, 229, 120, 206, 154, 23,
-			11, 227, 108, 225, 48, 76, 137, 183, 153, 90, 124, 55, 112, 227,
-			100, 118, 240, 68, 202, 247, 156, 29, 63, 151, 242, 61, 103, 139,
-			23, 132, 32, 160, 243, 121, 205, 60, 211, 45, 8, 242, 222, 1,
-			121, 233, 64, 74, 28, 14, 149, 130, 174, 65, 136, 81, 89, 143,
-			210, 253, 98, 129, 195, 9, 57, 243, 3, 214, 10, 194, 94, 45,
-			148, 248, 186, 215, 186, 124, 221, 107, 3, 227, 41, 95, 247, 218,
-			169, 211, 228, 73, 177, 171, 242, 100, 31, 55, 10, 37, 150, 246,
-			80, 88, 200, 241, 220, 101, 28, 192, 108, 150, 140, 78, 238, 199,
-			154, 219, 192, 128, 39, 115, 99, 228, 113, 181, 115, 114, 211, 60,
-			89, 152, 100, 119, 92, 238, 213, 35, 152, 49, 91, 48, 147, 212,
-			194, 32, 18, 122, 78, 162, 193, 115, 163, 229, 244, 86, 202, 205,
-			174, 173, 148, 155, 122, 71, 2, 248, 125, 243, 196, 56, 25, 84,
-			91, 41, 183, 204, 177, 212, 30, 201, 45, 93, 12, 90, 119, 75,
-			142, 87, 177, 71, 114, 139, 142, 202, 98, 38, 181, 158, 50, 199,
-			83, 251, 31, 79, 73, 41, 21, 251, 31, 79, 229, 70, 83, 251,
-			31, 79, 29, 63, 33, 139, 89, 212, 122, 218, 84, 123, 48, 32,
-			220, 79, 235, 218, 64, 184, 159, 30, 24, 74, 109, 110, 60, 125,
-			52, 47, 139, 217, 212, 122, 198, 188, 164, 54, 40, 178, 0, 157,
-			74, 109, 94, 60, 115, 154, 165, 54, 47, 158, 185, 112, 81, 22,
-			203, 80, 235, 89, 243, 152, 252, 148, 65, 72, 213, 150, 49, 168,
-			245, 236, 64, 94, 65, 22, 181, 158, 29, 29, 147, 197, 178, 212,
-			122, 151, 169, 182, 134, 178, 25, 128, 84, 177, 172, 65, 173, 119,
-			105, 78, 102, 45, 106, 189, 75, 115, 178, 159, 90, 11, 186, 88,
-			127, 6, 32, 85, 172, 223, 160, 214, 130, 46, 214, 111, 81, 107,
-			65, 23, 203, 81, 235, 182, 121, 70, 126, 202, 101, 0, 82, 197,
-			114, 6, 181, 110, 15, 40, 46, 231, 44, 106, 221, 62, 117, 154,
-			92, 195, 98, 3, 212, 90, 54, 143, 21, 38, 148, 96, 232, 105,
-			62, 14, 164, 200, 221, 13, 131, 78, 91, 200, 157, 150, 139, 129,
-			12, 20, 83, 248, 7, 12, 106, 45, 235, 14, 30, 176, 168, 181,
-			76, 21, 19, 8, 181, 238, 232, 214, 144, 12, 64, 170, 24, 49,
-			168, 117, 71, 239, 23, 17, 139, 90, 119, 142, 171, 214, 12, 82,
-			235, 174, 121, 78, 126, 26, 180, 1, 82, 117, 15, 102, 168, 117,
-			87, 111, 192, 13, 26, 212, 186, 171, 55, 224, 6, 45, 106, 221,
-			61, 125, 86, 34, 25, 162, 214, 115, 154, 37, 67, 54, 64, 10,
-			201, 80, 134, 90, 207, 105, 36, 67, 6, 181, 158, 163, 39, 20,
-			100, 81, 235, 185, 194, 105, 137, 228, 8, 181, 42, 230, 89, 249,
-			233, 136, 13, 144, 66, 114, 36, 67, 173, 138, 70, 114, 196, 160,
-			86, 133, 42, 46, 31, 177, 168, 85, 57, 117, 134, 220, 68, 36,
-			195, 212, 90, 49, 207, 21, 166, 31, 206, 229, 202, 125, 189, 109,
-			218, 195, 235, 97, 27, 10, 107, 40, 67, 173, 21, 93, 231, 176,
-			65, 173, 21, 221, 250, 97, 139, 90, 43, 167, 207, 146, 37, 172,
-			243, 40, 181, 214, 204, 19, 133, 39, 30, 169, 206, 133, 40, 114,
-			119, 252, 22, 247, 123, 107, 63, 154, 1, 52, 170, 203, 142, 26,
-			212, 90, 27, 80, 181, 31, 181, 168, 181, 54, 118, 92, 50, 42,
-			79, 173, 251, 230, 105, 249, 41, 159, 1, 72, 21, 203, 27, 212,
-			186, 63, 112, 92, 65, 22, 181, 238, 159, 60, 37, 53, 211, 8,
-			181, 214, 205, 179, 137, 102, 58, 72, 166, 240, 34, 123, 232, 26,
-			201, 64, 57, 85, 193, 136, 65, 173, 117, 45, 225, 35, 22, 181,
-			214, 79, 157, 145, 116, 81, 106, 109, 152, 69, 249, 137, 102, 0,
-			82, 197, 168, 65, 173, 141, 1, 69, 51, 181, 168, 181, 113, 238,
-			188, 44, 54, 74, 173, 77, 243, 170, 252, 52, 106, 3, 164, 234,
-			30, 205, 80, 107, 83, 247, 193, 168, 65, 173, 77, 58, 173, 32,
-			139, 90, 155, 179, 243, 18, 201, 24, 181, 222, 99, 94, 144, 159,
-			198, 50, 0, 169, 186, 199, 12, 106, 189, 103, 64, 73, 231, 152,
-			69, 173, 247, 176, 162, 44, 118, 140, 90, 47, 232, 98, 199, 50,
-			0, 169, 98, 199, 12, 106, 189, 160, 139, 29, 179, 168, 245, 130,
-			46, 118, 156, 90, 239, 213, 45, 61, 158, 1, 72, 21, 59, 110,
-			80, 235, 189, 186, 165, 199, 45, 106, 189, 87, 183, 244, 4, 181,
-			94, 212, 197, 78, 100, 0, 82, 197, 78, 24, 212, 122, 81, 23,
-			59, 97, 81, 235, 197, 115, 231, 165, 76, 143, 83, 235, 91, 205,
-			169, 135, 203, 116, 149, 59, 94, 43, 18, 193, 198, 81, 79, 239,
-			141, 219, 80, 88, 67, 25, 106, 125, 171, 230, 231, 184, 65, 173,
-			111, 165, 170, 245, 227, 22, 181, 190, 245, 177, 73, 73, 234, 73,
-			106, 189, 108, 94, 145, 159, 78, 218, 0, 41, 36, 39, 51, 212,
-			122, 89, 35, 57, 105, 80, 235, 101, 170, 84, 253, 73, 139, 90,
-			47, 79, 94, 150, 72, 10, 212, 122, 191, 70, 82, 176, 1, 82,
-			72, 10, 25, 106, 189, 95, 35, 41, 24, 212, 122, 191, 70, 82,
-			176, 168, 245, 254, 201, 203, 178, 245, 167, 168, 229, 152, 103, 30,
-			222, 250, 251, 97, 240, 42, 175, 197, 130, 9, 61, 173, 63, 149,
-			129, 194, 138, 199, 167, 12, 106, 57, 3, 74, 249, 156, 178, 168,
-			229, 104, 229, 115, 154, 90, 219, 90, 131, 157, 206, 0, 164, 138,
-			157, 54, 168, 181, 173, 139, 157, 182, 168, 181, 173, 139, 157, 161,
-			86, 77, 15, 197, 51, 25, 128, 84, 177, 51, 6, 181, 106, 122,
-			40, 158, 177, 168, 85, 59, 121, 74, 22, 59, 75, 173, 186, 46,
-			118, 54, 3, 144, 42, 118, 214, 160, 86, 93, 23, 59, 107, 81,
-			171, 126, 242, 148, 94, 183, 254, 159, 190, 203, 34, 172, 119, 177,
-			89, 45, 35, 6, 225, 195, 174, 201, 184, 71, 70, 238, 184, 30,
-			95, 210, 25, 215, 121, 76, 159, 36, 54, 120, 247, 114, 161, 247,
-			226, 129, 21, 209, 238, 18, 120, 21, 69, 21, 75, 20, 191, 108,
-			147, 209, 67, 190, 62, 44, 190, 179, 237, 212, 30, 56, 59, 92,
-			46, 225, 42, 144, 158, 37, 68, 29, 227, 173, 237, 143, 91, 184,
-			132, 148, 74, 193, 149, 172, 206, 182, 231, 214, 182, 82, 217, 8,
-			179, 38, 51, 213, 188, 248, 176, 148, 100, 158, 32, 71, 247, 184,
-			243, 32, 157, 117, 16, 179, 14, 67, 114, 42, 227, 34, 25, 146,
-			187, 22, 98, 205, 217, 198, 214, 179, 3, 173, 239, 109, 249, 160,
-			44, 133, 203, 210, 11, 100, 128, 251, 157, 150, 192, 144, 121, 8,
-			255, 150, 253, 78, 171, 23, 75, 14, 138, 73, 20, 253, 210, 177,
-			30, 207, 34, 130, 137, 3, 8, 100, 128, 78, 47, 14, 85, 142,
-			46, 146, 1, 60, 21, 44, 151, 161, 1, 201, 165, 67, 122, 145,
-			39, 75, 202, 10, 69, 82, 142, 94, 39, 253, 226, 92, 75, 132,
-			11, 213, 131, 243, 167, 15, 21, 132, 53, 145, 167, 170, 50, 211,
-			10, 201, 139, 139, 53, 182, 106, 65, 157, 111, 185, 126, 35, 192,
-			53, 236, 193, 249, 115, 7, 27, 130, 25, 23, 131, 58, 175, 248,
-			141, 160, 58, 28, 117, 193, 244, 56, 201, 70, 251, 126, 236, 188,
-			54, 62, 36, 22, 185, 5, 84, 252, 133, 44, 57, 250, 40, 34,
-			118, 139, 100, 26, 208, 74, 12, 32, 126, 100, 30, 136, 50, 221,
-			76, 204, 126, 141, 76, 92, 32, 131, 98, 197, 92, 72, 132, 245,
-			136, 50, 69, 68, 161, 131, 34, 101, 127, 77, 34, 245, 94, 114,
-			84, 147, 180, 133, 231, 153, 165, 108, 206, 188, 21, 37, 229, 101,
-			85, 174, 138, 59, 59, 195, 188, 11, 166, 75, 132, 4, 62, 15,
-			26, 91, 117, 94, 243, 198, 115, 15, 225, 210, 26, 100, 57, 192,
-			165, 64, 164, 214, 60, 122, 35, 17, 181, 254, 135, 72, 202, 61,
-			49, 200, 14, 72, 219, 38, 25, 86, 247, 213, 200, 150, 13, 32,
-			17, 229, 183, 108, 89, 85, 22, 19, 13, 59, 18, 166, 65, 122,
-			129, 232, 132, 45, 20, 43, 130, 90, 104, 72, 37, 174, 58, 45,
-			94, 120, 157, 12, 119, 179, 135, 142, 145, 76, 20, 59, 97, 140,
-			82, 152, 169, 10, 128, 230, 137, 197, 253, 186, 188, 98, 8, 126,
-			210, 119, 37, 13, 182, 176, 193, 143, 29, 236, 209, 46, 204, 189,
-			237, 46, 60, 65, 142, 116, 53, 224, 81, 171, 46, 126, 136, 28,
-			59, 20, 53, 125, 47, 25, 235, 248, 250, 172, 62, 175, 111, 137,
-			170, 198, 255, 175, 254, 135, 200, 220, 102, 58, 183, 192, 82, 29,
-			237, 28, 76, 188, 60, 144, 251, 253, 254, 252, 183, 127, 251, 183,
-			127, 187, 89, 252, 149, 44, 25, 59, 108, 204, 28, 58, 124, 143,
-			147, 172, 88, 110, 65, 38, 101, 170, 18, 162, 11, 36, 227, 57,
-			219, 220, 195, 93, 188, 225, 249, 43, 143, 52, 42, 203, 43, 80,
-			164, 42, 74, 210, 103, 136, 45, 85, 52, 96, 184, 252, 104, 24,
-			96, 44, 85, 177, 28, 61, 69, 6, 224, 175, 144, 13, 177, 13,
-			152, 131, 4, 144, 11, 90, 32, 57, 113, 41, 3, 87, 83, 155,
-			134, 65, 176, 234, 188, 225, 116, 188, 120, 11, 23, 68, 228, 46,
-			225, 144, 76, 124, 15, 164, 209, 115, 100, 80, 140, 42, 215, 175,
-			243, 215, 80, 123, 102, 170, 98, 160, 85, 32, 5, 170, 127, 53,
-			10, 124, 37, 154, 88, 5, 36, 96, 245, 79, 244, 42, 238, 51,
-			135, 55, 239, 192, 88, 154, 32, 71, 133, 53, 177, 165, 14, 52,
-			226, 6, 93, 174, 58, 44, 146, 215, 100, 106, 241, 231, 76, 98,
-			163, 98, 57, 74, 6, 55, 94, 188, 191, 188, 181, 180, 182, 121,
-			123, 101, 57, 111, 208, 97, 66, 48, 225, 206, 202, 218, 194, 70,
-			222, 212, 48, 158, 221, 206, 91, 186, 128, 56, 204, 158, 183, 211,
-			25, 174, 206, 231, 51, 52, 79, 134, 4, 130, 202, 123, 151, 151,
-			174, 95, 203, 103, 187, 83, 174, 206, 231, 251, 233, 17, 50, 128,
-			41, 183, 215, 214, 86, 242, 57, 141, 115, 125, 163, 90, 89, 189,
-			155, 31, 208, 56, 239, 86, 215, 54, 239, 231, 137, 198, 112, 111,
-			121, 125, 125, 225, 238, 114, 126, 80, 231, 184, 253, 226, 198, 242,
-			122, 126, 168, 139, 172, 171, 243, 249, 35, 186, 138, 229, 213, 205,
-			123, 249, 97, 58, 66, 142, 136, 42, 20, 17, 71, 123, 146, 174,
-			95, 203, 231, 19, 66, 4, 150, 145, 174, 132, 235, 215, 242, 180,
-			184, 72, 50, 40, 134, 148, 146, 225, 149, 133, 219, 203, 43, 91,
-			107, 120, 249, 204, 194, 74, 222, 72, 210, 170, 203, 223, 178, 89,
-			169, 46, 47, 229, 205, 116, 218, 253, 229, 133, 141, 229, 165, 188,
-			85, 172, 145, 177, 195, 20, 234, 161, 67, 40, 37, 11, 230, 67,
-			100, 1, 113, 245, 202, 66, 241, 247, 76, 50, 122, 200, 164, 114,
-			104, 37, 207, 146, 140, 144, 101, 49, 205, 78, 29, 58, 59, 161,
-			100, 31, 152, 106, 177, 92, 218, 212, 176, 30, 98, 106, 0, 138,
-			3, 2, 251, 242, 1, 229, 47, 230, 199, 235, 143, 50, 63, 98,
-			218, 219, 155, 4, 50, 135, 76, 2, 183, 200, 200, 1, 68, 143,
-			172, 140, 191, 195, 32, 227, 15, 99, 206, 91, 168, 68, 179, 75,
-			37, 222, 234, 229, 224, 249, 135, 119, 194, 129, 190, 254, 49, 131,
-			28, 63, 220, 164, 60, 148, 134, 103, 72, 86, 108, 244, 200, 254,
-			62, 56, 119, 221, 195, 207, 189, 157, 45, 75, 165, 103, 123, 235,
-			97, 118, 161, 160, 230, 0, 165, 223, 101, 146, 99, 135, 34, 63,
-			148, 208, 51, 132, 184, 126, 187, 19, 11, 211, 73, 104, 226, 1,
-			76, 65, 229, 5, 90, 182, 19, 235, 239, 34, 82, 134, 136, 36,
-			204, 240, 100, 66, 168, 8, 250, 61, 251, 144, 150, 30, 16, 204,
-			89, 146, 87, 97, 9, 113, 200, 157, 150, 235, 239, 224, 84, 147,
-			187, 153, 105, 56, 94, 196, 171, 71, 197, 231, 117, 245, 21, 74,
-			200, 64, 129, 164, 68, 182, 171, 132, 248, 172, 75, 20, 191, 103,
-			128, 12, 166, 12, 112, 122, 158, 12, 189, 234, 236, 58, 91, 202,
-			169, 18, 156, 24, 132, 180, 251, 210, 177, 154, 37, 99, 152, 37,
-			232, 196, 60, 220, 170, 121, 78, 20, 33, 211, 68, 32, 10, 133,
-			111, 107, 240, 105, 81, 125, 161, 143, 147, 81, 44, 209, 234, 120,
-			177, 219, 246, 248, 22, 184, 121, 34, 230, 68, 83, 54, 2, 57,
-			238, 201, 12, 64, 81, 68, 151, 200, 25, 44, 38, 15, 196, 242,
-			45, 254, 129, 142, 227, 69, 91, 142, 95, 223, 106, 58, 81, 115,
-			124, 12, 16, 220, 54, 199, 141, 234, 73, 200, 120, 87, 230, 91,
-			198, 108, 11, 126, 253, 57, 39, 106, 210, 155, 228, 56, 98, 17,
-			215, 27, 108, 213, 154, 188, 246, 96, 171, 19, 55, 158, 28, 63,
-			149, 174, 31, 41, 20, 7, 167, 23, 33, 203, 102, 220, 120, 146,
-			174, 147, 33, 232, 140, 150, 251, 58, 223, 106, 4, 33, 206, 161,
-			195, 135, 168, 166, 20, 7, 203, 107, 178, 192, 189, 160, 206, 111,
-			102, 214, 239, 47, 47, 47, 85, 7, 21, 150, 59, 65, 8, 2,
-			181, 19, 104, 6, 203, 224, 154, 157, 64, 177, 247, 113, 50, 90,
-			171, 109, 201, 59, 157, 213, 38, 126, 132, 81, 48, 9, 179, 106,
-			181, 187, 34, 131, 148, 241, 136, 222, 32, 199, 18, 102, 165, 11,
-			142, 28, 104, 101, 111, 209, 199, 201, 104, 123, 255, 96, 65, 218,
-			85, 99, 123, 191, 183, 216, 19, 100, 172, 221, 108, 31, 44, 119,
-			57, 93, 142, 182, 155, 237, 222, 130, 151, 208, 51, 15, 57, 158,
-			238, 199, 208, 20, 157, 61, 245, 129, 150, 73, 190, 86, 219, 226,
-			190, 179, 237, 241, 45, 39, 228, 190, 19, 97, 184, 69, 238, 166,
-			29, 135, 29, 94, 29, 174, 213, 150, 241, 227, 2, 126, 163, 151,
-			201, 72, 176, 253, 106, 77, 72, 228, 86, 59, 228, 13, 247, 181,
-			241, 139, 200, 222, 163, 240, 1, 229, 241, 62, 38, 211, 41, 146,
-			175, 69, 77, 39, 108, 163, 74, 142, 218, 78, 141, 143, 95, 18,
-			89, 69, 250, 170, 74, 134, 17, 17, 237, 185, 141, 88, 97, 156,
-			16, 35, 2, 211, 36, 182, 73, 146, 7, 78, 116, 85, 60, 41,
-			2, 174, 218, 205, 118, 186, 222, 11, 228, 8, 228, 76, 42, 157,
-			18, 134, 91, 187, 153, 170, 241, 26, 57, 14, 153, 90, 60, 118,
-			234, 78, 236, 164, 114, 151, 48, 55, 176, 253, 158, 252, 216, 69,
-			103, 216, 217, 222, 215, 130, 53, 45, 232, 132, 52, 37, 90, 223,
-			48, 227, 188, 120, 147, 12, 165, 229, 158, 14, 16, 33, 249, 121,
-			3, 140, 160, 197, 181, 37, 48, 95, 94, 90, 206, 155, 96, 70,
-			173, 84, 54, 150, 183, 170, 155, 171, 27, 149, 123, 203, 121, 43,
-			101, 216, 63, 111, 231, 30, 203, 79, 128, 213, 48, 220, 237, 169,
-			209, 167, 200, 9, 181, 172, 18, 241, 120, 107, 207, 13, 113, 64,
-			182, 28, 49, 57, 106, 249, 25, 147, 185, 214, 121, 252, 130, 27,
-			202, 147, 248, 116, 133, 156, 243, 131, 45, 117, 239, 193, 86, 178,
-			160, 181, 229, 212, 106, 60, 138, 2, 49, 17, 106, 44, 167, 253,
-			96, 93, 102, 78, 102, 136, 5, 153, 181, 71, 124, 173, 135, 137,
-			239, 41, 50, 208, 114, 218, 91, 184, 149, 135, 246, 121, 174, 154,
-			107, 57, 237, 101, 128, 191, 41, 110, 210, 243, 118, 206, 206, 103,
-			158, 183, 115, 153, 124, 246, 121, 59, 151, 205, 247, 63, 111, 231,
-			114, 249, 129, 231, 237, 220, 64, 158, 20, 63, 106, 147, 161, 180,
-			5, 15, 14, 81, 77, 199, 73, 14, 207, 95, 120, 83, 123, 191,
-			188, 8, 147, 219, 205, 172, 48, 151, 171, 162, 36, 24, 22, 32,
-			126, 188, 46, 143, 222, 72, 136, 222, 37, 217, 87, 35, 196, 157,
-			69, 220, 135, 173, 6, 166, 112, 63, 191, 142, 200, 7, 158, 95,
-			223, 90, 93, 171, 222, 91, 88, 169, 202, 226, 244, 36, 177, 61,
-			231, 245, 253, 238, 105, 16, 147, 104, 153, 28, 237, 248, 187, 60,
-			116, 27, 46, 175, 111, 97, 174, 163, 233, 92, 195, 201, 215, 21,
-			200, 255, 136, 221, 120, 146, 216, 123, 220, 121, 208, 61, 89, 97,
-			210, 55, 112, 56, 205, 144, 12, 242, 151, 18, 34, 57, 156, 239,
-			163, 57, 98, 47, 174, 85, 97, 72, 229, 201, 144, 72, 221, 186,
-			95, 89, 94, 92, 206, 155, 197, 199, 73, 86, 48, 13, 134, 155,
-			102, 91, 190, 79, 130, 18, 135, 161, 190, 110, 222, 187, 189, 92,
-			205, 155, 7, 132, 165, 24, 145, 161, 180, 37, 255, 205, 113, 231,
-			255, 107, 131, 12, 166, 44, 115, 140, 128, 197, 232, 78, 199, 115,
-			157, 72, 138, 146, 184, 33, 96, 1, 82, 30, 181, 235, 190, 73,
-			131, 44, 147, 207, 22, 127, 208, 32, 249, 94, 211, 184, 135, 76,
-			227, 63, 37, 153, 197, 79, 25, 100, 184, 219, 30, 238, 33, 239,
-			252, 127, 82, 242, 254, 15, 147, 28, 233, 178, 130, 31, 149, 186,
-			15, 144, 17, 183, 206, 91, 237, 32, 230, 126, 109, 127, 203, 227,
-			187, 220, 27, 47, 162, 146, 57, 184, 44, 217, 85, 67, 185, 146,
-			148, 91, 129, 98, 55, 71, 43, 75, 203, 247, 238, 175, 109, 44,
-			175, 46, 190, 184, 181, 185, 250, 238, 213, 181, 23, 86, 171, 121,
-			183, 39, 219, 55, 112, 216, 223, 39, 249, 94, 162, 232, 9, 114,
-			24, 89, 249, 62, 58, 74, 142, 174, 174, 109, 173, 87, 150, 150,
-			183, 150, 239, 220, 89, 94, 220, 88, 23, 43, 39, 58, 247, 70,
-			215, 0, 47, 126, 210, 34, 163, 135, 80, 66, 23, 164, 207, 35,
-			220, 176, 233, 71, 161, 190, 12, 86, 199, 125, 39, 140, 165, 139,
-			52, 69, 242, 242, 164, 138, 203, 67, 185, 34, 37, 28, 161, 163,
-			73, 186, 88, 148, 42, 17, 218, 14, 34, 55, 118, 119, 249, 150,
-			235, 171, 229, 43, 112, 140, 236, 106, 94, 125, 169, 248, 177, 206,
-			173, 46, 96, 73, 229, 206, 96, 56, 126, 94, 125, 209, 185, 207,
-			147, 161, 122, 208, 1, 107, 81, 228, 131, 185, 198, 168, 14, 138,
-			52, 157, 69, 250, 1, 201, 186, 217, 80, 117, 80, 164, 137, 44,
-			19, 228, 168, 179, 179, 19, 2, 114, 133, 72, 120, 54, 195, 58,
-			25, 51, 22, 158, 39, 57, 197, 7, 152, 236, 129, 19, 91, 109,
-			225, 174, 155, 147, 3, 213, 156, 175, 62, 158, 39, 67, 110, 180,
-			149, 108, 3, 152, 204, 156, 204, 85, 7, 221, 72, 47, 161, 22,
-			127, 204, 36, 195, 221, 219, 24, 116, 137, 228, 188, 64, 92, 95,
-			46, 247, 208, 38, 223, 98, 231, 163, 188, 34, 243, 87, 117, 201,
-			194, 63, 49, 72, 78, 37, 211, 227, 196, 110, 59, 113, 19, 209,
-			101, 110, 155, 121, 163, 138, 48, 164, 71, 109, 199, 71, 17, 144,
-			233, 0, 67, 191, 122, 220, 169, 163, 219, 36, 78, 39, 68, 170,
-			95, 101, 250, 162, 76, 166, 87, 200, 72, 28, 58, 174, 215, 149,
-			87, 156, 143, 200, 171, 15, 58, 243, 77, 114, 82, 225, 173, 243,
-			216, 169, 53, 121, 61, 41, 148, 197, 229, 145, 19, 50, 195, 146,
-			252, 174, 202, 22, 127, 203, 32, 35, 202, 209, 171, 107, 102, 221,
-			35, 196, 241, 253, 32, 78, 179, 235, 160, 40, 31, 40, 87, 94,
-			208, 133, 170, 41, 4, 133, 22, 33, 201, 151, 135, 178, 237, 28,
-			25, 148, 123, 84, 184, 209, 41, 150, 6, 136, 72, 2, 143, 144,
-			142, 145, 204, 54, 223, 113, 125, 185, 242, 44, 0, 181, 128, 99,
-			235, 5, 156, 219, 127, 235, 240, 135, 2, 242, 61, 203, 19, 209,
-			115, 198, 75, 211, 111, 249, 84, 64, 98, 221, 118, 189, 18, 80,
-			232, 125, 37, 160, 202, 27, 30, 199, 155, 143, 158, 255, 151, 191,
-			105, 146, 126, 154, 153, 232, 251, 254, 126, 131, 252, 196, 81, 140,
-			12, 159, 120, 231, 137, 128, 119, 158, 8, 120, 231, 137, 128, 119,
-			158, 8, 120, 231, 137, 128, 119, 158, 8, 248, 218, 159, 8, 152,
-			255, 151, 166, 60, 69, 116, 147, 61, 224, 126, 28, 248, 239, 74,
-			20, 59, 155, 124, 55, 38, 177, 247, 56, 97, 221, 153, 34, 140,
-			221, 86, 23, 22, 5, 161, 187, 227, 250, 142, 119, 112, 2, 170,
-			243, 200, 221, 241, 241, 114, 82, 182, 238, 248, 175, 58, 251, 236,
-			110, 147, 183, 156, 61, 39, 46, 177, 231, 121, 163, 193, 150, 184,
-			227, 151, 146, 123, 69, 35, 117, 195, 123, 250, 101, 187, 228, 244,
-			145, 152, 47, 183, 185, 58, 253, 239, 250, 82, 193, 53, 130, 142,
-			143, 231, 10, 196, 140, 140, 185, 163, 50, 12, 128, 93, 199, 115,
-			235, 233, 100, 117, 95, 102, 28, 58, 126, 36, 14, 50, 215, 221,
-			144, 215, 98, 111, 95, 196, 118, 31, 18, 206, 68, 180, 22, 113,
-			252, 125, 169, 19, 211, 167, 199, 39, 241, 221, 35, 149, 39, 20,
-			230, 16, 30, 110, 119, 91, 237, 32, 140, 241, 108, 189, 56, 127,
-			51, 165, 31, 96, 184, 210, 183, 164, 206, 223, 192, 79, 145, 88,
-			74, 158, 85, 40, 233, 103, 21, 166, 251, 230, 212, 179, 10, 240,
-			83, 36, 150, 251, 158, 80, 239, 55, 192, 79, 145, 56, 147, 60,
-			171, 48, 163, 223, 36, 156, 77, 30, 117, 152, 213, 143, 58, 92,
-			235, 59, 77, 190, 141, 152, 185, 1, 252, 89, 136, 89, 111, 232,
-			152, 152, 120, 182, 197, 93, 183, 45, 247, 117, 121, 183, 127, 212,
-			230, 188, 206, 182, 121, 205, 129, 41, 60, 212, 134, 201, 52, 222,
-			95, 69, 152, 227, 237, 4, 161, 27, 55, 91, 17, 171, 7, 254,
-			68, 44, 110, 122, 168, 119, 240, 190, 226, 237, 32, 136, 163, 56,
-			20, 55, 31, 151, 9, 121, 85, 156, 206, 121, 178, 239, 166, 81,
-			120, 63, 246, 187, 50, 31, 240, 120, 153, 235, 225, 109, 155, 62,
-			19, 91, 52, 7, 250, 102, 157, 235, 211, 252, 234, 178, 219, 178,
-			188, 3, 2, 5, 128, 185, 177, 184, 220, 54, 74, 29, 194, 121,
-			50, 119, 18, 67, 239, 240, 16, 206, 13, 115, 82, 157, 155, 177,
-			1, 74, 95, 22, 117, 99, 240, 108, 234, 132, 205, 141, 115, 23,
-			82, 39, 108, 110, 60, 54, 65, 102, 196, 217, 141, 167, 251, 94,
-			50, 10, 23, 216, 146, 20, 77, 241, 172, 68, 171, 237, 241, 152,
-			167, 197, 46, 117, 30, 227, 233, 220, 41, 60, 158, 131, 231, 49,
-			158, 49, 79, 21, 75, 66, 48, 97, 46, 44, 117, 189, 32, 17,
-			6, 226, 226, 10, 105, 148, 196, 33, 231, 233, 51, 26, 207, 152,
-			233, 251, 1, 158, 145, 49, 148, 226, 140, 198, 51, 244, 120, 234,
-			140, 198, 51, 39, 11, 228, 178, 58, 162, 241, 172, 121, 182, 120,
-			70, 62, 213, 213, 8, 130, 98, 9, 255, 148, 183, 157, 176, 88,
-			98, 60, 174, 37, 7, 38, 108, 200, 156, 62, 62, 241, 172, 174,
-			3, 26, 242, 172, 140, 130, 22, 199, 39, 158, 61, 125, 6, 227,
-			219, 241, 170, 128, 219, 230, 249, 194, 4, 91, 85, 179, 187, 236,
-			14, 28, 12, 226, 188, 148, 30, 212, 201, 157, 0, 54, 20, 75,
-			223, 16, 112, 91, 215, 6, 100, 223, 166, 167, 21, 100, 81, 235,
-			246, 57, 70, 190, 69, 221, 16, 176, 100, 78, 22, 150, 24, 70,
-			74, 136, 250, 240, 168, 9, 6, 6, 38, 149, 74, 26, 164, 177,
-			163, 195, 0, 133, 189, 132, 38, 85, 114, 127, 128, 13, 56, 53,
-			148, 161, 214, 210, 96, 94, 65, 6, 181, 150, 70, 138, 10, 130,
-			218, 47, 77, 144, 215, 145, 20, 140, 153, 127, 172, 208, 234, 37,
-			101, 143, 59, 15, 30, 141, 144, 178, 184, 49, 89, 152, 74, 211,
-			104, 176, 131, 102, 109, 185, 59, 161, 80, 53, 129, 239, 237, 151,
-			217, 82, 160, 206, 102, 106, 154, 237, 84, 192, 190, 129, 183, 68,
-			221, 213, 52, 219, 6, 181, 238, 142, 48, 5, 89, 212, 186, 123,
-			225, 18, 185, 142, 52, 103, 168, 245, 188, 89, 42, 76, 161, 181,
-			31, 7, 237, 105, 92, 151, 233, 210, 174, 105, 29, 172, 235, 203,
-			216, 80, 80, 67, 89, 106, 61, 63, 88, 80, 144, 65, 173, 231,
-			79, 77, 40, 200, 162, 214, 243, 151, 175, 224, 168, 51, 204, 44,
-			181, 222, 109, 78, 203, 79, 89, 27, 32, 133, 36, 11, 223, 228,
-			168, 51, 240, 188, 198, 187, 207, 77, 42, 200, 162, 214, 187, 175,
-			148, 36, 146, 126, 106, 173, 152, 101, 249, 169, 63, 21, 172, 111,
-			152, 253, 89, 106, 173, 12, 158, 87, 144, 65, 173, 149, 226, 148,
-			130, 44, 106, 173, 148, 166, 37, 146, 28, 181, 238, 105, 36, 57,
-			27, 32, 133, 36, 151, 165, 214, 189, 65, 117, 202, 9, 180, 241,
-			61, 166, 144, 228, 44, 106, 221, 211, 72, 6, 168, 181, 38, 195,
-			198, 13, 115, 192, 6, 72, 33, 25, 200, 82, 107, 109, 80, 13,
-			195, 1, 131, 90, 107, 39, 84, 227, 6, 44, 106, 173, 157, 47,
-			146, 255, 71, 28, 156, 34, 212, 218, 52, 103, 10, 255, 218, 96,
-			27, 130, 209, 220, 171, 39, 231, 190, 84, 112, 206, 67, 158, 252,
-			211, 243, 111, 202, 119, 73, 29, 193, 141, 156, 6, 247, 246, 153,
-			56, 160, 45, 58, 146, 251, 177, 27, 114, 89, 141, 154, 182, 154,
-			78, 216, 194, 11, 236, 58, 126, 236, 182, 56, 97, 141, 142, 47,
-			47, 80, 119, 99, 125, 118, 55, 153, 38, 34, 54, 61, 141, 73,
-			105, 170, 146, 135, 96, 64, 80, 113, 230, 151, 126, 98, 11, 239,
-			210, 15, 2, 47, 57, 144, 69, 82, 17, 254, 134, 73, 178, 212,
-			218, 28, 84, 26, 133, 24, 212, 218, 44, 92, 86, 144, 69, 173,
-			205, 233, 50, 121, 25, 185, 53, 72, 173, 23, 205, 179, 133, 251,
-			56, 99, 136, 8, 81, 61, 232, 83, 10, 87, 124, 238, 180, 229,
-			176, 147, 23, 9, 227, 179, 54, 152, 109, 190, 136, 134, 135, 0,
-			174, 22, 53, 89, 131, 54, 224, 215, 80, 134, 90, 47, 106, 69,
-			52, 104, 80, 235, 69, 170, 78, 141, 13, 90, 212, 122, 241, 212,
-			25, 50, 33, 78, 141, 189, 220, 247, 134, 81, 56, 213, 53, 21,
-			232, 23, 126, 247, 219, 233, 235, 245, 94, 206, 169, 83, 85, 125,
-			212, 122, 191, 62, 16, 213, 215, 21, 24, 223, 215, 21, 24, 223,
-			135, 129, 241, 42, 26, 28, 148, 250, 251, 79, 22, 146, 131, 96,
-			175, 152, 151, 229, 39, 208, 218, 175, 36, 87, 231, 101, 169, 245,
-			202, 160, 58, 213, 3, 90, 251, 21, 166, 162, 235, 65, 107, 191,
-			50, 57, 149, 28, 11, 115, 228, 112, 48, 81, 25, 59, 26, 9,
-			12, 90, 71, 35, 129, 234, 28, 57, 28, 196, 33, 49, 71, 14,
-			7, 4, 106, 58, 206, 31, 212, 104, 205, 76, 223, 135, 87, 147,
-			42, 66, 28, 25, 171, 157, 74, 223, 135, 87, 155, 186, 156, 28,
-			25, 171, 155, 234, 112, 135, 141, 144, 190, 253, 46, 75, 173, 250,
-			160, 58, 26, 4, 122, 173, 46, 85, 132, 56, 64, 86, 191, 82,
-			34, 67, 128, 196, 234, 163, 118, 195, 124, 96, 137, 111, 22, 112,
-			175, 65, 198, 201, 41, 146, 5, 8, 216, 190, 99, 159, 41, 14,
-			129, 55, 170, 175, 120, 30, 38, 253, 226, 163, 13, 95, 135, 18,
-			56, 67, 173, 157, 35, 52, 129, 13, 106, 237, 140, 142, 39, 176,
-			69, 173, 157, 83, 167, 53, 114, 131, 90, 77, 251, 84, 113, 136,
-			45, 191, 118, 16, 57, 116, 79, 51, 133, 28, 166, 213, 102, 10,
-			57, 116, 81, 115, 244, 120, 2, 91, 212, 106, 158, 44, 144, 35,
-			18, 185, 73, 173, 87, 237, 25, 253, 25, 152, 245, 106, 10, 29,
-			116, 213, 171, 71, 138, 9, 108, 80, 235, 213, 11, 151, 19, 216,
-			162, 214, 171, 211, 229, 228, 148, 157, 167, 251, 28, 52, 186, 167,
-			57, 13, 26, 221, 27, 84, 199, 156, 64, 163, 123, 5, 213, 231,
-			160, 209, 61, 221, 231, 89, 106, 249, 230, 140, 252, 4, 26, 221,
-			215, 72, 64, 163, 251, 90, 112, 64, 163, 251, 76, 201, 41, 104,
-			116, 95, 83, 210, 79, 173, 182, 169, 196, 1, 52, 122, 91, 35,
-			1, 141, 222, 214, 148, 128, 70, 111, 23, 206, 43, 200, 162, 86,
-			251, 226, 37, 242, 19, 6, 118, 186, 65, 237, 142, 249, 154, 85,
-			248, 1, 131, 97, 92, 22, 168, 5, 181, 116, 197, 98, 103, 71,
-			221, 117, 85, 102, 213, 67, 82, 245, 157, 7, 106, 217, 1, 212,
-			87, 67, 156, 53, 193, 103, 12, 228, 18, 176, 120, 33, 74, 79,
-			225, 145, 211, 210, 14, 75, 10, 177, 204, 212, 114, 246, 113, 161,
-			136, 5, 187, 60, 244, 28, 117, 135, 149, 105, 65, 71, 119, 200,
-			9, 41, 53, 104, 12, 238, 62, 68, 36, 133, 185, 183, 171, 187,
-			89, 24, 124, 187, 90, 106, 132, 201, 183, 171, 69, 82, 24, 125,
-			187, 90, 36, 209, 236, 219, 123, 136, 72, 10, 59, 111, 47, 133,
-			28, 68, 114, 47, 133, 28, 40, 221, 211, 34, 41, 172, 189, 61,
-			173, 124, 114, 212, 218, 55, 75, 178, 63, 96, 26, 221, 215, 61,
-			7, 211, 232, 254, 160, 62, 4, 105, 80, 107, 255, 228, 132, 130,
-			44, 106, 237, 95, 190, 66, 254, 182, 161, 206, 68, 126, 200, 188,
-			84, 232, 36, 252, 19, 179, 19, 46, 16, 169, 131, 247, 7, 251,
-			71, 117, 207, 97, 93, 1, 238, 223, 142, 187, 203, 125, 177, 2,
-			133, 215, 81, 224, 164, 196, 19, 153, 8, 252, 90, 234, 132, 165,
-			13, 68, 164, 207, 91, 126, 72, 171, 95, 152, 190, 63, 68, 149,
-			36, 195, 244, 253, 161, 226, 69, 50, 72, 64, 235, 100, 190, 173,
-			239, 59, 13, 3, 149, 59, 168, 181, 111, 203, 157, 33, 107, 196,
-			182, 45, 179, 143, 218, 255, 63, 195, 188, 89, 88, 16, 190, 141,
-			124, 103, 35, 14, 66, 174, 38, 117, 244, 81, 234, 1, 143, 192,
-			85, 10, 121, 45, 216, 241, 221, 215, 57, 107, 242, 144, 151, 241,
-			90, 8, 101, 152, 30, 33, 25, 64, 104, 35, 70, 13, 102, 1,
-			28, 60, 171, 64, 3, 192, 115, 87, 21, 104, 1, 120, 253, 6,
-			121, 9, 8, 203, 80, 251, 195, 134, 121, 178, 112, 143, 137, 59,
-			110, 34, 244, 172, 196, 109, 177, 172, 214, 137, 226, 160, 149, 208,
-			228, 39, 194, 30, 233, 91, 201, 180, 136, 167, 233, 2, 101, 107,
-			101, 250, 0, 249, 145, 113, 81, 113, 6, 232, 248, 176, 113, 100,
-			68, 129, 38, 128, 199, 198, 201, 85, 2, 218, 60, 251, 93, 70,
-			223, 31, 26, 70, 225, 82, 215, 68, 153, 216, 34, 120, 46, 92,
-			247, 33, 190, 156, 0, 126, 214, 119, 25, 185, 211, 100, 152, 216,
-			182, 109, 247, 209, 236, 223, 55, 204, 31, 55, 44, 172, 192, 198,
-			135, 21, 254, 190, 209, 63, 72, 214, 73, 214, 150, 47, 43, 252,
-			3, 195, 30, 43, 44, 178, 89, 241, 198, 138, 18, 41, 24, 197,
-			97, 136, 215, 164, 176, 181, 176, 46, 110, 112, 219, 227, 110, 40,
-			190, 53, 93, 232, 28, 188, 149, 57, 228, 78, 20, 224, 211, 208,
-			71, 73, 191, 64, 106, 32, 214, 163, 73, 130, 9, 9, 116, 148,
-			12, 203, 106, 13, 106, 255, 67, 195, 30, 213, 25, 12, 145, 48,
-			156, 36, 152, 144, 48, 66, 201, 158, 44, 97, 82, 251, 123, 12,
-			123, 180, 176, 131, 119, 249, 189, 228, 238, 188, 228, 236, 168, 151,
-			126, 202, 76, 63, 134, 160, 21, 84, 236, 60, 224, 108, 110, 150,
-			109, 239, 199, 60, 42, 51, 182, 25, 113, 150, 10, 56, 102, 110,
-			131, 48, 253, 12, 66, 202, 224, 241, 220, 7, 220, 219, 79, 53,
-			70, 188, 215, 144, 34, 77, 144, 50, 66, 117, 99, 44, 106, 127,
-			212, 176, 199, 116, 6, 208, 173, 31, 77, 55, 223, 50, 33, 129,
-			142, 234, 198, 216, 212, 254, 248, 223, 88, 99, 174, 206, 63, 122,
-			99, 64, 60, 62, 158, 110, 12, 24, 99, 31, 79, 55, 38, 67,
-			237, 239, 51, 236, 99, 58, 67, 198, 192, 132, 124, 146, 96, 66,
-			194, 232, 152, 46, 145, 165, 246, 39, 210, 37, 178, 6, 38, 36,
-			37, 178, 38, 36, 164, 74, 244, 83, 251, 251, 13, 155, 234, 12,
-			253, 6, 38, 28, 73, 18, 76, 72, 200, 143, 232, 18, 57, 106,
-			255, 103, 105, 22, 231, 12, 76, 72, 88, 156, 51, 33, 129, 142,
-			146, 47, 25, 178, 200, 0, 181, 127, 16, 36, 251, 183, 12, 182,
-			225, 236, 76, 215, 57, 222, 250, 194, 235, 76, 111, 120, 150, 9,
-			195, 211, 227, 250, 225, 168, 100, 7, 30, 205, 93, 208, 159, 137,
-			81, 236, 250, 242, 41, 160, 50, 123, 46, 216, 227, 187, 60, 44,
-			137, 101, 188, 171, 4, 239, 167, 225, 122, 71, 32, 82, 15, 4,
-			137, 87, 55, 182, 185, 126, 37, 8, 245, 26, 170, 95, 188, 92,
-			147, 237, 161, 111, 33, 158, 133, 193, 155, 105, 226, 144, 59, 177,
-			252, 40, 245, 181, 19, 177, 142, 143, 47, 101, 203, 148, 84, 119,
-			14, 24, 216, 200, 164, 59, 7, 76, 72, 24, 25, 37, 211, 146,
-			11, 132, 218, 159, 49, 236, 227, 197, 51, 108, 133, 251, 59, 113,
-			243, 112, 62, 232, 242, 196, 192, 252, 73, 223, 17, 19, 18, 70,
-			143, 145, 11, 18, 225, 32, 181, 127, 4, 216, 58, 202, 86, 249,
-			30, 48, 101, 87, 223, 143, 148, 160, 25, 52, 48, 87, 66, 215,
-			160, 9, 9, 35, 137, 2, 24, 162, 246, 143, 166, 133, 102, 200,
-			192, 132, 164, 67, 135, 76, 72, 160, 137, 208, 28, 161, 246, 103,
-			211, 42, 227, 136, 129, 9, 137, 208, 28, 49, 33, 33, 159, 136,
-			242, 48, 181, 255, 115, 195, 62, 161, 51, 12, 27, 152, 48, 146,
-			36, 152, 144, 48, 118, 92, 151, 56, 74, 237, 127, 148, 46, 113,
-			212, 192, 132, 164, 196, 81, 19, 18, 198, 142, 147, 9, 89, 34,
-			79, 237, 31, 51, 236, 99, 197, 19, 48, 38, 163, 174, 161, 44,
-			22, 238, 84, 201, 188, 129, 57, 147, 6, 230, 77, 72, 160, 99,
-			26, 213, 8, 181, 63, 247, 72, 168, 70, 12, 204, 153, 160, 26,
-			49, 33, 1, 121, 5, 74, 223, 160, 217, 159, 52, 204, 159, 214,
-			74, 31, 148, 235, 79, 26, 253, 67, 228, 50, 214, 4, 246, 147,
-			253, 95, 24, 246, 137, 66, 225, 161, 74, 95, 85, 134, 230, 18,
-			100, 166, 73, 130, 9, 9, 199, 20, 211, 192, 96, 178, 127, 42,
-			97, 26, 218, 64, 144, 144, 148, 0, 93, 254, 83, 233, 18, 38,
-			181, 63, 159, 46, 1, 40, 62, 159, 46, 33, 114, 28, 59, 142,
-			115, 166, 13, 244, 254, 140, 97, 158, 18, 205, 193, 153, 253, 103,
-			212, 204, 110, 227, 227, 59, 63, 99, 12, 142, 40, 208, 0, 144,
-			30, 87, 160, 5, 224, 201, 130, 196, 100, 80, 251, 103, 13, 243,
-			180, 252, 104, 216, 8, 42, 76, 70, 6, 192, 193, 188, 2, 49,
-			243, 200, 9, 5, 90, 0, 22, 78, 73, 76, 38, 181, 191, 144,
-			208, 4, 26, 253, 11, 9, 38, 208, 137, 95, 72, 48, 65, 181,
-			95, 48, 70, 20, 77, 48, 97, 124, 1, 104, 194, 187, 96, 108,
-			0, 127, 30, 236, 141, 127, 96, 224, 229, 176, 234, 236, 20, 116,
-			77, 196, 99, 185, 87, 233, 115, 94, 87, 22, 93, 196, 227, 50,
-			131, 188, 248, 220, 24, 126, 198, 125, 75, 85, 82, 188, 188, 150,
-			148, 213, 235, 211, 62, 154, 249, 250, 244, 78, 137, 165, 207, 254,
-			128, 225, 158, 156, 13, 42, 171, 182, 88, 54, 146, 167, 193, 44,
-			128, 131, 71, 21, 104, 0, 152, 31, 83, 32, 182, 229, 196, 56,
-			249, 175, 76, 108, 154, 77, 237, 95, 54, 76, 86, 248, 156, 137,
-			139, 117, 202, 199, 7, 106, 185, 223, 105, 137, 251, 92, 36, 149,
-			110, 212, 181, 7, 138, 207, 102, 237, 183, 185, 104, 169, 250, 130,
-			111, 143, 133, 177, 124, 229, 200, 97, 19, 229, 137, 18, 24, 134,
-			110, 196, 26, 29, 207, 219, 159, 254, 64, 199, 241, 48, 28, 178,
-			204, 216, 90, 220, 228, 161, 120, 76, 107, 241, 202, 149, 105, 152,
-			13, 89, 84, 11, 240, 49, 65, 22, 118, 60, 57, 75, 170, 125,
-			211, 134, 43, 55, 127, 113, 62, 16, 23, 2, 139, 91, 146, 196,
-			229, 74, 248, 232, 3, 82, 172, 172, 47, 160, 59, 185, 69, 89,
-			48, 221, 9, 107, 77, 94, 199, 155, 118, 252, 36, 31, 218, 180,
-			220, 199, 55, 215, 228, 195, 121, 120, 21, 82, 16, 224, 115, 201,
-			50, 220, 121, 74, 115, 221, 22, 156, 211, 96, 6, 64, 45, 228,
-			48, 141, 255, 178, 65, 149, 244, 217, 22, 128, 103, 207, 145, 191,
-			133, 76, 207, 80, 251, 87, 13, 243, 92, 161, 45, 159, 148, 83,
-			230, 233, 155, 243, 89, 62, 202, 32, 79, 227, 1, 251, 42, 192,
-			86, 34, 222, 156, 218, 77, 110, 192, 18, 158, 131, 227, 251, 60,
-			196, 187, 138, 148, 212, 105, 218, 51, 54, 18, 160, 65, 164, 71,
-			211, 14, 6, 197, 175, 26, 180, 160, 64, 11, 192, 51, 103, 201,
-			247, 8, 137, 201, 82, 251, 215, 13, 243, 98, 225, 111, 11, 137,
-			241, 59, 45, 30, 186, 53, 37, 40, 122, 161, 175, 107, 53, 47,
-			230, 175, 197, 201, 211, 215, 114, 245, 87, 180, 12, 141, 33, 185,
-			84, 188, 29, 4, 30, 119, 128, 15, 197, 56, 236, 240, 34, 8,
-			124, 17, 131, 239, 138, 50, 135, 124, 103, 184, 167, 30, 121, 254,
-			80, 84, 3, 95, 208, 39, 152, 132, 193, 200, 163, 154, 211, 150,
-			247, 116, 249, 251, 108, 207, 217, 159, 82, 149, 129, 169, 214, 131,
-			104, 81, 231, 23, 100, 137, 184, 17, 204, 201, 158, 121, 154, 205,
-			205, 63, 137, 50, 36, 51, 105, 126, 102, 109, 228, 137, 6, 51,
-			0, 106, 126, 130, 185, 245, 235, 6, 61, 167, 64, 11, 192, 226,
-			5, 242, 119, 132, 114, 233, 167, 246, 111, 26, 230, 249, 194, 46,
-			140, 34, 84, 9, 224, 232, 69, 114, 57, 178, 206, 95, 19, 175,
-			141, 225, 1, 74, 125, 199, 89, 106, 167, 104, 191, 205, 39, 34,
-			150, 28, 100, 38, 98, 241, 157, 165, 151, 95, 93, 177, 126, 151,
-			92, 204, 236, 196, 162, 132, 110, 66, 191, 141, 100, 104, 48, 3,
-			160, 214, 143, 96, 255, 253, 166, 49, 162, 212, 114, 191, 5, 224,
-			57, 70, 254, 66, 52, 33, 71, 237, 223, 134, 38, 252, 27, 67,
-			188, 194, 152, 72, 174, 162, 160, 156, 186, 69, 75, 104, 77, 112,
-			120, 15, 236, 144, 149, 165, 38, 33, 48, 228, 67, 214, 116, 68,
-			86, 135, 21, 245, 137, 209, 162, 244, 236, 196, 243, 50, 10, 127,
-			73, 182, 9, 63, 77, 200, 7, 211, 136, 126, 176, 6, 20, 72,
-			57, 173, 109, 220, 120, 2, 172, 201, 122, 167, 38, 67, 45, 228,
-			141, 107, 220, 171, 79, 68, 130, 254, 237, 125, 245, 14, 33, 106,
-			35, 23, 175, 242, 170, 57, 45, 238, 45, 58, 81, 50, 150, 114,
-			54, 54, 94, 131, 25, 0, 117, 223, 131, 25, 252, 219, 137, 30,
-			200, 89, 0, 158, 101, 114, 138, 26, 160, 246, 63, 51, 204, 139,
-			242, 227, 128, 141, 160, 194, 52, 144, 5, 112, 80, 205, 110, 96,
-			73, 254, 51, 99, 92, 73, 209, 128, 5, 96, 241, 2, 249, 217,
-			1, 68, 69, 168, 253, 111, 13, 243, 82, 225, 179, 3, 234, 254,
-			242, 68, 153, 232, 39, 50, 139, 106, 245, 189, 88, 102, 47, 128,
-			246, 211, 95, 180, 152, 168, 28, 192, 34, 176, 118, 157, 218, 3,
-			245, 172, 77, 141, 51, 48, 71, 195, 58, 94, 89, 137, 59, 97,
-			80, 72, 46, 7, 11, 132, 61, 71, 112, 213, 101, 217, 165, 84,
-			87, 233, 201, 110, 155, 227, 219, 194, 184, 81, 45, 164, 59, 14,
-			8, 139, 220, 29, 212, 24, 1, 11, 188, 186, 34, 175, 38, 221,
-			124, 236, 100, 77, 13, 34, 199, 72, 255, 228, 86, 112, 45, 109,
-			160, 24, 229, 152, 137, 152, 176, 206, 241, 185, 217, 98, 180, 239,
-			199, 77, 30, 187, 181, 162, 248, 94, 146, 33, 69, 7, 232, 115,
-			227, 136, 69, 129, 135, 129, 86, 56, 114, 38, 185, 83, 107, 42,
-			146, 116, 19, 69, 33, 188, 181, 15, 74, 64, 69, 186, 10, 81,
-			195, 84, 153, 173, 171, 20, 73, 84, 196, 248, 107, 226, 245, 162,
-			158, 157, 8, 92, 214, 17, 36, 213, 197, 198, 152, 58, 134, 134,
-			250, 107, 225, 126, 229, 48, 100, 218, 126, 8, 235, 120, 137, 163,
-			211, 136, 65, 247, 123, 30, 43, 134, 220, 241, 100, 75, 35, 245,
-			136, 169, 154, 15, 133, 187, 82, 58, 208, 107, 106, 17, 71, 220,
-			69, 142, 21, 71, 188, 229, 248, 208, 34, 17, 115, 87, 34, 242,
-			33, 71, 63, 240, 167, 67, 222, 198, 59, 203, 123, 240, 170, 87,
-			94, 177, 143, 116, 175, 105, 231, 12, 70, 20, 250, 95, 164, 231,
-			165, 89, 93, 85, 157, 199, 142, 235, 129, 164, 237, 53, 185, 14,
-			193, 66, 205, 176, 23, 6, 49, 79, 201, 51, 204, 20, 126, 16,
-			227, 70, 137, 27, 169, 184, 136, 78, 196, 27, 29, 15, 133, 35,
-			12, 58, 126, 125, 58, 14, 93, 220, 175, 79, 237, 175, 139, 13,
-			22, 100, 75, 45, 240, 35, 55, 194, 32, 104, 182, 199, 9, 234,
-			225, 3, 109, 234, 237, 92, 230, 120, 81, 80, 98, 124, 151, 67,
-			87, 6, 157, 157, 166, 180, 118, 196, 131, 1, 226, 94, 87, 241,
-			142, 105, 15, 31, 54, 228, 240, 228, 24, 206, 229, 120, 222, 190,
-			220, 87, 117, 252, 88, 71, 37, 196, 201, 98, 91, 205, 241, 39,
-			96, 76, 114, 207, 99, 110, 67, 175, 51, 185, 233, 189, 153, 32,
-			100, 142, 143, 150, 91, 137, 69, 1, 80, 130, 162, 33, 123, 66,
-			245, 39, 233, 109, 4, 8, 6, 186, 202, 243, 61, 114, 29, 41,
-			41, 140, 184, 188, 172, 190, 225, 57, 59, 165, 52, 121, 251, 204,
-			241, 66, 238, 212, 247, 117, 55, 146, 4, 9, 26, 129, 175, 116,
-			159, 22, 127, 69, 235, 78, 98, 163, 214, 210, 96, 6, 64, 109,
-			185, 130, 171, 251, 111, 141, 188, 210, 120, 196, 2, 176, 120, 145,
-			20, 9, 88, 91, 217, 127, 103, 244, 253, 123, 195, 40, 140, 117,
-			45, 189, 169, 214, 12, 18, 203, 6, 59, 230, 223, 25, 185, 211,
-			168, 108, 51, 224, 163, 124, 69, 249, 3, 25, 244, 81, 190, 162,
-			170, 206, 160, 143, 242, 21, 165, 182, 51, 232, 163, 124, 69, 249,
-			40, 25, 244, 81, 190, 162, 124, 148, 12, 56, 11, 127, 162, 212,
-			118, 6, 125, 148, 63, 73, 48, 25, 89, 0, 165, 218, 206, 160,
-			143, 242, 39, 74, 109, 103, 208, 71, 249, 19, 80, 219, 19, 196,
-			180, 179, 52, 251, 31, 140, 190, 255, 191, 105, 20, 78, 166, 27,
-			225, 39, 150, 183, 108, 9, 88, 16, 255, 193, 200, 9, 207, 38,
-			11, 45, 249, 83, 213, 146, 44, 182, 228, 79, 85, 253, 89, 108,
-			201, 159, 170, 150, 100, 177, 37, 127, 170, 90, 146, 197, 150, 252,
-			169, 106, 73, 22, 90, 242, 231, 134, 89, 150, 31, 161, 37, 127,
-			158, 96, 130, 150, 252, 185, 49, 120, 65, 129, 152, 249, 226, 148,
-			2, 45, 0, 75, 211, 18, 147, 73, 237, 191, 52, 76, 149, 23,
-			220, 138, 191, 76, 48, 129, 205, 248, 151, 198, 160, 34, 2, 170,
-			253, 75, 227, 196, 89, 5, 90, 0, 158, 47, 146, 207, 131, 129,
-			153, 181, 250, 104, 246, 59, 76, 243, 59, 77, 171, 240, 131, 230,
-			33, 91, 31, 202, 224, 20, 171, 104, 169, 77, 10, 185, 172, 118,
-			216, 198, 7, 247, 227, 208, 237, 217, 229, 0, 38, 31, 186, 197,
-			209, 179, 195, 193, 86, 65, 213, 36, 175, 53, 200, 251, 148, 93,
-			191, 22, 11, 203, 225, 77, 239, 102, 17, 85, 58, 49, 78, 167,
-			80, 90, 109, 136, 176, 168, 83, 107, 170, 79, 168, 176, 156, 118,
-			59, 12, 218, 161, 235, 196, 98, 143, 91, 61, 38, 7, 20, 203,
-			93, 110, 215, 143, 175, 206, 19, 86, 15, 90, 142, 235, 203, 97,
-			148, 181, 160, 143, 191, 195, 36, 167, 200, 105, 146, 5, 16, 4,
-			228, 239, 152, 7, 246, 95, 192, 119, 207, 138, 45, 65, 248, 60,
-			148, 36, 100, 32, 225, 8, 77, 18, 12, 72, 24, 29, 79, 18,
-			44, 72, 56, 117, 90, 215, 96, 80, 251, 195, 38, 110, 194, 28,
-			86, 3, 8, 210, 135, 211, 53, 128, 227, 254, 225, 116, 13, 6,
-			34, 24, 61, 158, 36, 88, 144, 112, 178, 32, 118, 191, 178, 32,
-			19, 31, 49, 205, 217, 195, 119, 191, 30, 42, 2, 221, 31, 122,
-			69, 129, 128, 77, 135, 99, 75, 126, 239, 21, 7, 6, 246, 179,
-			35, 162, 83, 30, 42, 26, 36, 37, 27, 66, 128, 193, 1, 255,
-			136, 169, 165, 29, 28, 240, 143, 152, 131, 167, 21, 104, 0, 120,
-			230, 138, 2, 177, 101, 229, 25, 226, 97, 59, 109, 106, 127, 183,
-			105, 94, 44, 188, 63, 169, 46, 161, 240, 161, 59, 70, 33, 23,
-			22, 237, 161, 155, 66, 228, 176, 93, 33, 81, 185, 45, 170, 211,
-			96, 6, 64, 173, 43, 192, 105, 253, 110, 83, 58, 42, 89, 116,
-			90, 191, 219, 44, 94, 32, 115, 196, 180, 251, 105, 246, 123, 205,
-			190, 239, 55, 123, 227, 195, 4, 157, 106, 175, 67, 168, 46, 169,
-			181, 192, 105, 248, 94, 51, 39, 140, 221, 126, 16, 202, 143, 153,
-			82, 107, 245, 163, 16, 126, 76, 81, 210, 143, 34, 248, 49, 69,
-			73, 63, 10, 224, 199, 76, 169, 181, 250, 81, 252, 62, 102, 74,
-			173, 213, 15, 194, 247, 113, 83, 174, 17, 245, 163, 176, 125, 60,
-			193, 4, 162, 246, 113, 83, 122, 46, 253, 40, 104, 31, 55, 229,
-			26, 81, 63, 138, 217, 199, 77, 185, 70, 212, 15, 90, 235, 19,
-			166, 57, 41, 63, 66, 103, 124, 34, 193, 132, 235, 230, 230, 160,
-			162, 24, 170, 253, 132, 121, 250, 130, 2, 45, 0, 31, 155, 32,
-			23, 137, 105, 231, 104, 246, 7, 204, 190, 31, 50, 141, 194, 241,
-			46, 238, 68, 250, 49, 66, 96, 8, 56, 3, 63, 96, 230, 206,
-			98, 229, 57, 96, 200, 167, 20, 67, 114, 200, 144, 79, 169, 202,
-			115, 200, 144, 79, 41, 134, 228, 144, 33, 159, 82, 12, 201, 33,
-			67, 62, 165, 24, 146, 3, 202, 62, 109, 154, 37, 249, 17, 24,
-			242, 233, 4, 19, 168, 241, 79, 155, 131, 76, 129, 152, 249, 252,
-			132, 2, 45, 0, 47, 95, 145, 152, 76, 106, 127, 198, 52, 31,
-			147, 31, 129, 33, 159, 73, 48, 1, 67, 62, 99, 14, 158, 84,
-			160, 1, 96, 225, 188, 2, 45, 0, 47, 94, 34, 179, 196, 180,
-			7, 104, 246, 71, 205, 190, 255, 210, 52, 10, 197, 158, 24, 146,
-			184, 25, 212, 213, 251, 217, 105, 230, 128, 127, 243, 163, 102, 238,
-			12, 18, 50, 0, 204, 249, 172, 98, 206, 0, 50, 231, 179, 138,
-			144, 1, 100, 206, 103, 21, 115, 6, 144, 57, 159, 85, 204, 25,
-			64, 230, 124, 22, 152, 243, 9, 3, 81, 25, 212, 254, 156, 105,
-			158, 47, 252, 61, 131, 85, 124, 140, 189, 244, 235, 42, 12, 19,
-			215, 85, 112, 148, 161, 195, 204, 35, 177, 72, 116, 232, 138, 202,
-			158, 179, 207, 156, 136, 176, 67, 175, 87, 210, 139, 44, 37, 182,
-			221, 137, 213, 73, 135, 6, 216, 172, 193, 193, 216, 25, 65, 40,
-			116, 212, 231, 146, 86, 129, 228, 126, 46, 105, 149, 129, 116, 211,
-			211, 10, 180, 0, 60, 199, 36, 127, 76, 106, 255, 184, 105, 22,
-			229, 71, 232, 168, 31, 79, 48, 153, 25, 0, 53, 38, 224, 192,
-			143, 155, 244, 140, 2, 45, 0, 217, 121, 137, 201, 162, 246, 79,
-			154, 230, 37, 249, 17, 116, 217, 79, 38, 152, 64, 151, 253, 164,
-			57, 56, 174, 64, 3, 192, 147, 76, 129, 88, 246, 194, 69, 178,
-			140, 152, 108, 106, 255, 148, 105, 62, 81, 120, 130, 85, 212, 241,
-			187, 8, 44, 85, 225, 189, 49, 113, 55, 8, 56, 43, 226, 182,
-			13, 149, 174, 226, 163, 85, 157, 182, 192, 163, 193, 12, 128, 210,
-			42, 28, 64, 37, 245, 83, 102, 94, 145, 0, 74, 234, 167, 128,
-			4, 9, 230, 0, 188, 116, 93, 129, 253, 0, 206, 62, 46, 9,
-			204, 80, 251, 167, 15, 35, 80, 220, 78, 114, 144, 64, 153, 222,
-			75, 96, 198, 70, 60, 26, 68, 180, 154, 64, 48, 59, 127, 58,
-			33, 48, 99, 1, 168, 9, 204, 228, 0, 212, 4, 102, 250, 1,
-			156, 125, 156, 124, 126, 152, 152, 54, 161, 217, 223, 49, 251, 254,
-			169, 101, 204, 175, 178, 167, 191, 254, 127, 132, 201, 51, 167, 100,
-			254, 127, 60, 194, 150, 193, 121, 213, 145, 111, 73, 68, 164, 56,
-			9, 4, 147, 7, 190, 62, 95, 84, 215, 213, 48, 39, 22, 103,
-			224, 210, 35, 131, 136, 183, 206, 146, 51, 107, 81, 106, 114, 18,
-			46, 1, 30, 55, 138, 3, 124, 159, 77, 29, 124, 99, 145, 231,
-			238, 52, 99, 111, 159, 213, 221, 70, 131, 139, 87, 136, 9, 120,
-			43, 88, 78, 172, 102, 177, 166, 235, 203, 183, 143, 5, 14, 113,
-			25, 188, 239, 182, 59, 248, 84, 92, 178, 92, 164, 58, 4, 172,
-			51, 21, 1, 0, 136, 14, 143, 0, 112, 162, 3, 17, 0, 156,
-			93, 150, 172, 73, 225, 146, 173, 76, 229, 85, 51, 238, 62, 199,
-			89, 87, 174, 34, 136, 64, 108, 244, 34, 93, 24, 239, 81, 208,
-			227, 165, 161, 145, 128, 161, 17, 201, 10, 167, 50, 54, 90, 101,
-			198, 42, 126, 20, 115, 167, 46, 28, 96, 12, 160, 128, 15, 226,
-			18, 121, 233, 211, 249, 93, 68, 38, 43, 216, 53, 199, 243, 240,
-			149, 143, 131, 7, 129, 203, 233, 5, 63, 212, 63, 216, 159, 90,
-			125, 201, 125, 135, 228, 198, 246, 131, 44, 96, 47, 112, 177, 42,
-			158, 122, 40, 5, 151, 120, 2, 214, 14, 68, 47, 136, 213, 185,
-			20, 143, 246, 112, 21, 157, 179, 237, 142, 235, 213, 153, 147, 90,
-			202, 40, 1, 171, 132, 116, 180, 3, 215, 23, 239, 251, 97, 31,
-			70, 130, 180, 109, 206, 125, 34, 248, 38, 246, 115, 163, 0, 243,
-			164, 176, 131, 50, 70, 182, 67, 87, 235, 115, 171, 122, 219, 29,
-			101, 165, 187, 183, 229, 81, 171, 90, 51, 136, 56, 46, 249, 136,
-			131, 98, 209, 77, 194, 46, 163, 151, 175, 50, 10, 202, 112, 133,
-			80, 133, 210, 160, 191, 160, 227, 54, 160, 139, 61, 174, 222, 99,
-			17, 107, 142, 33, 97, 140, 5, 225, 142, 227, 187, 175, 203, 243,
-			123, 65, 40, 182, 229, 94, 107, 243, 208, 197, 45, 102, 79, 213,
-			81, 66, 70, 202, 224, 31, 73, 242, 227, 179, 179, 179, 179, 128,
-			37, 110, 134, 184, 90, 112, 3, 254, 169, 197, 124, 185, 243, 176,
-			31, 116, 196, 57, 177, 168, 19, 202, 129, 0, 73, 245, 32, 245,
-			140, 13, 7, 36, 162, 103, 197, 179, 14, 64, 133, 86, 93, 146,
-			130, 242, 155, 180, 122, 91, 198, 149, 71, 77, 201, 126, 108, 190,
-			8, 53, 199, 120, 87, 141, 13, 106, 114, 125, 21, 222, 29, 163,
-			55, 18, 187, 96, 153, 242, 233, 150, 227, 202, 94, 221, 238, 52,
-			166, 119, 240, 186, 230, 105, 221, 131, 211, 33, 223, 113, 163, 56,
-			220, 79, 29, 191, 193, 198, 7, 202, 66, 77, 133, 162, 233, 128,
-			182, 117, 183, 213, 246, 246, 213, 1, 61, 104, 58, 30, 148, 123,
-			149, 215, 98, 97, 234, 226, 33, 21, 192, 179, 182, 13, 137, 238,
-			46, 159, 94, 100, 109, 175, 179, 227, 250, 83, 216, 148, 174, 34,
-			123, 124, 59, 114, 99, 206, 38, 221, 70, 242, 158, 228, 148, 12,
-			249, 13, 249, 68, 196, 252, 0, 144, 249, 242, 149, 29, 254, 90,
-			219, 67, 125, 20, 236, 33, 219, 97, 172, 249, 117, 245, 250, 131,
-			24, 196, 155, 81, 7, 87, 108, 224, 59, 138, 15, 22, 14, 124,
-			228, 85, 111, 147, 202, 24, 192, 44, 98, 148, 192, 193, 224, 7,
-			250, 73, 30, 18, 5, 68, 129, 159, 226, 9, 146, 37, 186, 119,
-			123, 159, 181, 59, 113, 44, 87, 175, 164, 186, 136, 58, 219, 211,
-			93, 177, 76, 184, 139, 33, 70, 132, 26, 222, 145, 56, 213, 194,
-			130, 134, 16, 59, 60, 30, 25, 73, 161, 197, 71, 153, 97, 112,
-			176, 183, 123, 24, 22, 144, 136, 212, 11, 129, 82, 83, 114, 39,
-			208, 141, 152, 120, 0, 7, 195, 186, 113, 42, 16, 250, 3, 116,
-			2, 116, 135, 126, 125, 71, 9, 98, 196, 99, 214, 105, 75, 201,
-			112, 58, 113, 208, 114, 240, 69, 121, 15, 76, 46, 60, 120, 37,
-			87, 253, 149, 140, 8, 163, 145, 24, 212, 254, 29, 51, 55, 42,
-			98, 207, 9, 88, 141, 191, 107, 154, 23, 10, 255, 202, 96, 235,
-			234, 25, 149, 231, 157, 93, 135, 201, 219, 137, 64, 91, 133, 96,
-			120, 56, 81, 196, 163, 212, 252, 36, 183, 0, 220, 72, 173, 10,
-			74, 194, 8, 107, 123, 78, 13, 231, 192, 219, 251, 106, 159, 169,
-			148, 10, 207, 86, 136, 229, 235, 153, 194, 250, 83, 235, 4, 65,
-			35, 6, 13, 231, 250, 41, 215, 94, 47, 157, 117, 149, 215, 235,
-			108, 126, 16, 182, 176, 217, 184, 139, 42, 196, 98, 219, 169, 61,
-			216, 115, 194, 122, 164, 60, 127, 105, 177, 10, 3, 132, 160, 117,
-			252, 187, 202, 30, 33, 104, 29, 255, 174, 178, 254, 8, 90, 199,
-			191, 107, 210, 179, 10, 180, 0, 60, 95, 36, 95, 49, 145, 101,
-			6, 181, 191, 100, 154, 87, 10, 95, 50, 217, 98, 224, 199, 97,
-			224, 29, 220, 125, 220, 11, 157, 118, 155, 135, 130, 149, 200, 188,
-			52, 235, 228, 241, 215, 114, 79, 188, 186, 19, 203, 172, 200, 74,
-			181, 2, 41, 167, 250, 158, 2, 19, 128, 48, 78, 108, 234, 201,
-			41, 229, 41, 128, 193, 189, 199, 1, 65, 132, 235, 208, 201, 169,
-			142, 212, 44, 33, 230, 125, 61, 109, 118, 83, 82, 105, 176, 67,
-			110, 129, 147, 203, 56, 160, 9, 212, 86, 176, 122, 173, 73, 156,
-			137, 83, 50, 162, 54, 135, 72, 215, 193, 59, 37, 183, 114, 207,
-			217, 245, 35, 183, 46, 231, 90, 49, 107, 40, 150, 225, 109, 117,
-			2, 153, 238, 47, 176, 251, 191, 148, 244, 23, 216, 253, 95, 74,
-			250, 203, 192, 30, 161, 143, 41, 208, 2, 112, 234, 50, 249, 71,
-			22, 246, 151, 73, 237, 63, 48, 205, 91, 133, 79, 88, 248, 104,
-			140, 159, 110, 129, 22, 119, 180, 159, 100, 23, 225, 201, 115, 207,
-			75, 237, 35, 176, 136, 183, 29, 252, 89, 6, 206, 136, 19, 102,
-			66, 31, 128, 141, 152, 176, 88, 63, 132, 42, 22, 148, 113, 138,
-			150, 3, 247, 80, 150, 19, 185, 152, 206, 54, 154, 29, 220, 204,
-			6, 123, 74, 237, 199, 131, 197, 225, 7, 241, 229, 195, 217, 166,
-			248, 133, 156, 18, 27, 238, 24, 19, 123, 216, 149, 127, 101, 150,
-			4, 120, 29, 40, 43, 106, 82, 129, 93, 36, 37, 168, 113, 208,
-			37, 126, 111, 37, 119, 105, 177, 35, 143, 36, 119, 242, 76, 145,
-			232, 55, 240, 134, 254, 32, 233, 100, 240, 61, 254, 64, 57, 9,
-			4, 93, 178, 63, 48, 243, 23, 20, 104, 1, 248, 216, 164, 2,
-			115, 0, 78, 221, 84, 96, 63, 128, 215, 110, 144, 41, 148, 0,
-			139, 218, 127, 104, 154, 203, 133, 83, 194, 224, 147, 138, 177, 30,
-			112, 220, 115, 104, 138, 80, 36, 81, 18, 188, 185, 63, 76, 136,
-			176, 50, 0, 106, 34, 192, 155, 251, 67, 51, 63, 173, 64, 68,
-			60, 59, 175, 192, 28, 128, 87, 151, 48, 40, 8, 64, 252, 124,
-			109, 145, 252, 107, 161, 56, 108, 106, 127, 213, 52, 159, 41, 252,
-			11, 83, 110, 92, 235, 157, 190, 148, 36, 206, 191, 185, 40, 106,
-			59, 159, 160, 57, 180, 39, 86, 191, 241, 93, 62, 104, 19, 216,
-			150, 184, 85, 228, 128, 217, 29, 243, 86, 27, 109, 164, 150, 35,
-			60, 12, 57, 51, 56, 184, 9, 181, 185, 113, 103, 250, 73, 130,
-			123, 245, 44, 226, 31, 232, 224, 14, 33, 250, 222, 34, 102, 64,
-			238, 12, 18, 38, 175, 120, 75, 157, 247, 20, 68, 213, 3, 109,
-			43, 151, 73, 34, 96, 78, 42, 126, 88, 154, 114, 66, 184, 156,
-			26, 144, 25, 37, 149, 119, 215, 29, 169, 189, 40, 217, 63, 77,
-			7, 178, 50, 222, 104, 128, 73, 34, 27, 151, 88, 156, 226, 69,
-			108, 176, 82, 228, 153, 37, 221, 135, 182, 96, 180, 6, 51, 0,
-			234, 62, 4, 119, 248, 171, 102, 94, 105, 11, 112, 135, 191, 106,
-			78, 93, 81, 96, 14, 192, 210, 211, 10, 236, 7, 240, 137, 167,
-			240, 141, 48, 98, 247, 209, 236, 159, 155, 230, 255, 107, 90, 133,
-			167, 152, 190, 163, 68, 43, 62, 185, 135, 118, 216, 145, 89, 229,
-			161, 69, 238, 235, 154, 78, 140, 92, 254, 115, 179, 127, 148, 44,
-			129, 188, 136, 200, 229, 191, 48, 237, 225, 226, 53, 141, 60, 57,
-			77, 138, 197, 1, 161, 116, 165, 74, 160, 88, 92, 199, 83, 86,
-			181, 88, 75, 38, 50, 84, 249, 47, 76, 123, 32, 73, 48, 33,
-			97, 232, 8, 185, 43, 235, 49, 168, 253, 31, 77, 155, 22, 178,
-			226, 192, 103, 113, 6, 163, 111, 147, 123, 70, 214, 218, 226, 141,
-			51, 21, 2, 42, 245, 146, 124, 239, 175, 156, 84, 101, 8, 76,
-			71, 146, 4, 19, 18, 242, 35, 186, 42, 147, 218, 127, 101, 218,
-			199, 138, 79, 164, 155, 84, 7, 199, 1, 36, 76, 74, 214, 138,
-			27, 115, 125, 77, 69, 79, 151, 42, 204, 64, 244, 95, 153, 50,
-			140, 147, 200, 152, 229, 191, 50, 71, 199, 112, 81, 134, 128, 182,
-			248, 107, 211, 188, 37, 251, 45, 99, 35, 168, 68, 32, 147, 5,
-			80, 110, 49, 17, 92, 112, 248, 107, 115, 252, 162, 2, 45, 0,
-			39, 148, 46, 201, 228, 0, 212, 186, 36, 211, 15, 224, 181, 27,
-			228, 75, 98, 20, 103, 169, 253, 157, 150, 89, 44, 252, 182, 153,
-			88, 76, 119, 131, 30, 123, 41, 138, 67, 188, 155, 226, 237, 216,
-			75, 149, 134, 122, 62, 187, 116, 0, 169, 156, 60, 211, 87, 93,
-			116, 223, 233, 129, 246, 232, 52, 198, 140, 108, 59, 17, 79, 219,
-			34, 218, 224, 194, 173, 82, 214, 118, 226, 102, 137, 185, 13, 125,
-			155, 71, 89, 20, 77, 197, 122, 164, 75, 69, 177, 19, 11, 33,
-			56, 104, 43, 72, 52, 184, 233, 114, 56, 150, 94, 90, 186, 10,
-			171, 179, 135, 90, 91, 232, 17, 156, 181, 145, 201, 26, 204, 0,
-			168, 231, 251, 172, 1, 160, 92, 231, 35, 24, 30, 244, 157, 214,
-			185, 243, 228, 119, 108, 236, 160, 126, 106, 127, 220, 50, 111, 21,
-			126, 205, 102, 235, 34, 104, 89, 222, 124, 170, 166, 226, 168, 123,
-			165, 197, 245, 197, 44, 174, 158, 217, 124, 150, 177, 162, 188, 9,
-			181, 168, 139, 16, 249, 192, 92, 220, 245, 238, 12, 216, 87, 109,
-			39, 140, 221, 26, 152, 234, 172, 122, 127, 145, 69, 251, 81, 140,
-			107, 22, 27, 184, 209, 27, 166, 107, 194, 147, 207, 156, 48, 180,
-			72, 187, 213, 124, 116, 128, 12, 54, 169, 175, 29, 208, 15, 20,
-			75, 143, 45, 154, 42, 19, 118, 183, 183, 81, 123, 92, 46, 159,
-			160, 83, 244, 192, 245, 113, 213, 88, 153, 31, 178, 38, 80, 172,
-			73, 0, 56, 238, 244, 57, 161, 183, 175, 66, 158, 113, 181, 167,
-			247, 54, 38, 114, 72, 101, 130, 27, 123, 34, 2, 64, 132, 80,
-			164, 66, 205, 93, 159, 53, 156, 221, 0, 67, 167, 196, 72, 151,
-			132, 19, 225, 154, 119, 79, 103, 105, 142, 10, 55, 244, 225, 44,
-			13, 121, 35, 8, 121, 137, 72, 149, 164, 227, 216, 2, 134, 81,
-			111, 101, 198, 214, 240, 240, 108, 157, 75, 199, 93, 248, 224, 17,
-			204, 31, 7, 196, 64, 196, 180, 19, 116, 95, 221, 154, 27, 131,
-			19, 33, 94, 30, 108, 137, 240, 131, 78, 50, 169, 244, 219, 40,
-			86, 26, 204, 0, 168, 39, 149, 126, 3, 64, 109, 157, 244, 91,
-			0, 106, 235, 164, 63, 7, 160, 214, 40, 253, 40, 161, 215, 110,
-			72, 205, 149, 163, 246, 247, 89, 166, 154, 113, 114, 54, 130, 170,
-			158, 92, 6, 64, 93, 79, 206, 0, 48, 127, 73, 129, 22, 128,
-			147, 151, 21, 136, 168, 174, 60, 165, 192, 126, 0, 175, 223, 146,
-			245, 12, 80, 251, 19, 150, 214, 144, 3, 54, 130, 170, 158, 129,
-			12, 128, 186, 158, 1, 3, 64, 221, 158, 1, 11, 64, 221, 158,
-			129, 28, 128, 186, 61, 3, 253, 0, 234, 246, 16, 106, 127, 191,
-			101, 42, 34, 136, 141, 160, 170, 135, 100, 0, 212, 245, 128, 59,
-			250, 253, 86, 94, 105, 98, 98, 1, 56, 49, 165, 192, 28, 128,
-			151, 21, 201, 164, 31, 192, 199, 111, 146, 127, 47, 124, 215, 65,
-			106, 255, 160, 101, 206, 23, 190, 108, 176, 74, 212, 117, 81, 136,
-			18, 196, 103, 9, 19, 79, 254, 128, 8, 6, 66, 127, 137, 135,
-			89, 65, 235, 198, 141, 32, 108, 201, 64, 43, 152, 187, 121, 203,
-			141, 33, 127, 114, 96, 66, 175, 217, 18, 97, 236, 239, 242, 112,
-			31, 237, 197, 180, 1, 139, 11, 90, 110, 172, 149, 180, 154, 178,
-			189, 125, 230, 238, 248, 65, 200, 235, 183, 84, 118, 40, 79, 152,
-			199, 157, 40, 78, 7, 155, 225, 113, 9, 53, 147, 99, 77, 170,
-			9, 194, 12, 243, 82, 174, 235, 160, 141, 173, 214, 96, 6, 64,
-			205, 207, 65, 3, 192, 124, 65, 129, 22, 128, 103, 206, 41, 48,
-			7, 32, 155, 83, 96, 63, 128, 87, 102, 201, 183, 33, 59, 135,
-			168, 253, 35, 150, 249, 68, 225, 3, 76, 220, 214, 28, 169, 224,
-			33, 220, 124, 194, 171, 155, 181, 235, 42, 47, 9, 57, 236, 158,
-			22, 185, 170, 138, 139, 128, 28, 67, 202, 196, 221, 42, 59, 7,
-			236, 37, 64, 182, 120, 229, 138, 110, 218, 144, 141, 20, 104, 48,
-			3, 160, 110, 218, 144, 1, 160, 220, 37, 32, 230, 144, 5, 224,
-			5, 53, 18, 134, 114, 0, 62, 118, 93, 129, 253, 0, 206, 61,
-			78, 62, 42, 68, 229, 8, 181, 127, 204, 50, 167, 10, 223, 145,
-			90, 230, 8, 212, 74, 24, 171, 73, 71, 72, 220, 8, 45, 21,
-			135, 139, 48, 46, 224, 161, 39, 4, 206, 110, 170, 8, 57, 172,
-			65, 61, 115, 124, 89, 104, 44, 17, 219, 164, 116, 149, 110, 238,
-			17, 27, 137, 210, 96, 6, 64, 61, 201, 29, 49, 0, 164, 106,
-			4, 30, 177, 0, 124, 108, 146, 84, 176, 61, 195, 212, 254, 9,
-			203, 156, 44, 220, 98, 250, 94, 105, 100, 231, 1, 154, 110, 169,
-			106, 35, 21, 159, 45, 39, 118, 77, 198, 176, 141, 184, 52, 152,
-			1, 80, 147, 49, 108, 0, 72, 139, 10, 180, 0, 188, 52, 33,
-			66, 155, 137, 121, 148, 218, 63, 109, 137, 208, 230, 100, 181, 135,
-			173, 239, 185, 141, 56, 61, 183, 225, 208, 192, 19, 95, 7, 215,
-			129, 192, 238, 91, 84, 65, 162, 24, 244, 17, 114, 48, 137, 64,
-			244, 39, 202, 19, 194, 214, 199, 39, 173, 163, 90, 16, 114, 125,
-			129, 156, 136, 47, 9, 84, 167, 169, 152, 239, 104, 38, 218, 111,
-			109, 7, 94, 68, 148, 179, 41, 35, 55, 227, 196, 181, 136, 68,
-			231, 10, 243, 167, 36, 66, 178, 144, 68, 189, 138, 47, 227, 110,
-			197, 182, 3, 121, 179, 106, 116, 45, 138, 129, 71, 109, 228, 137,
-			6, 51, 0, 106, 126, 30, 53, 0, 212, 107, 75, 71, 45, 0,
-			207, 95, 192, 48, 119, 98, 230, 169, 253, 5, 232, 214, 118, 34,
-			165, 237, 102, 251, 81, 165, 19, 178, 30, 16, 0, 114, 136, 84,
-			46, 201, 126, 114, 35, 241, 134, 174, 166, 61, 111, 35, 1, 26,
-			204, 0, 168, 105, 207, 27, 0, 106, 89, 200, 91, 0, 94, 154,
-			32, 191, 36, 198, 216, 8, 181, 127, 193, 50, 47, 21, 62, 111,
-			160, 59, 145, 226, 55, 46, 36, 200, 215, 110, 213, 181, 111, 40,
-			178, 65, 227, 112, 162, 53, 137, 36, 161, 241, 64, 47, 234, 79,
-			221, 6, 43, 154, 155, 233, 48, 102, 24, 24, 32, 12, 49, 15,
-			91, 201, 93, 65, 154, 8, 221, 248, 17, 27, 27, 160, 193, 12,
-			128, 186, 241, 35, 6, 128, 84, 169, 210, 17, 11, 192, 226, 69,
-			242, 223, 139, 198, 83, 106, 255, 146, 101, 150, 11, 191, 248, 117,
-			52, 94, 221, 35, 175, 185, 64, 14, 246, 212, 91, 114, 33, 89,
-			113, 75, 51, 130, 104, 78, 60, 2, 35, 168, 141, 141, 209, 96,
-			6, 64, 205, 8, 106, 0, 72, 149, 45, 64, 45, 0, 175, 76,
-			147, 255, 70, 48, 98, 148, 218, 191, 10, 26, 225, 103, 223, 138,
-			17, 170, 191, 130, 6, 11, 59, 219, 251, 95, 135, 16, 200, 104,
-			203, 175, 73, 12, 176, 234, 94, 149, 56, 106, 99, 35, 52, 152,
-			1, 80, 51, 96, 212, 0, 80, 15, 225, 81, 11, 192, 243, 23,
-			200, 135, 176, 253, 99, 212, 254, 239, 44, 243, 102, 193, 255, 154,
-			78, 125, 19, 189, 37, 209, 125, 33, 163, 154, 119, 139, 107, 106,
-			175, 89, 237, 81, 164, 143, 136, 19, 115, 204, 198, 234, 53, 152,
-			5, 112, 80, 145, 58, 102, 0, 40, 143, 136, 19, 115, 204, 2,
-			240, 250, 13, 242, 119, 13, 98, 217, 96, 153, 253, 134, 101, 158,
-			44, 188, 254, 117, 159, 17, 255, 218, 91, 129, 22, 100, 166, 15,
-			40, 145, 7, 202, 9, 30, 40, 255, 13, 75, 30, 40, 39, 120,
-			160, 252, 55, 172, 99, 227, 98, 59, 99, 128, 218, 255, 196, 50,
-			135, 69, 193, 129, 62, 128, 6, 143, 136, 156, 3, 80, 48, 13,
-			154, 18, 28, 34, 166, 61, 72, 179, 191, 101, 245, 125, 175, 109,
-			32, 26, 48, 155, 126, 203, 202, 29, 39, 255, 115, 134, 216, 246,
-			160, 217, 71, 237, 47, 91, 230, 51, 133, 95, 207, 128, 34, 70,
-			71, 32, 181, 153, 149, 196, 217, 207, 169, 21, 12, 200, 149, 62,
-			249, 218, 232, 58, 165, 164, 22, 182, 82, 179, 14, 230, 208, 219,
-			20, 211, 96, 51, 58, 177, 187, 237, 226, 109, 59, 122, 121, 171,
-			7, 59, 145, 232, 203, 12, 183, 199, 228, 145, 220, 100, 131, 211,
-			21, 54, 85, 114, 77, 157, 56, 199, 126, 147, 177, 74, 60, 17,
-			49, 143, 71, 17, 97, 188, 209, 112, 107, 46, 158, 219, 106, 130,
-			69, 199, 247, 120, 200, 26, 220, 137, 59, 33, 143, 196, 146, 53,
-			116, 37, 190, 163, 14, 150, 44, 6, 40, 215, 123, 174, 230, 211,
-			241, 243, 106, 65, 151, 191, 230, 224, 37, 122, 93, 219, 199, 76,
-			103, 191, 19, 4, 236, 131, 226, 122, 80, 57, 110, 31, 242, 74,
-			4, 123, 26, 185, 125, 75, 228, 77, 201, 216, 53, 232, 128, 150,
-			243, 26, 126, 121, 163, 59, 198, 148, 167, 118, 251, 193, 102, 23,
-			155, 229, 192, 6, 69, 158, 8, 205, 190, 149, 98, 104, 36, 35,
-			255, 48, 107, 186, 171, 8, 158, 96, 234, 150, 110, 244, 74, 49,
-			242, 73, 181, 27, 156, 90, 116, 80, 149, 13, 124, 75, 220, 169,
-			134, 182, 132, 138, 104, 216, 150, 241, 167, 145, 216, 15, 136, 208,
-			83, 232, 141, 253, 199, 42, 111, 235, 232, 112, 233, 19, 168, 237,
-			59, 161, 212, 68, 196, 73, 188, 135, 123, 192, 113, 232, 214, 244,
-			157, 182, 216, 251, 220, 111, 4, 97, 77, 250, 243, 241, 97, 23,
-			233, 73, 5, 49, 136, 59, 95, 95, 86, 10, 98, 16, 119, 190,
-			190, 172, 108, 236, 65, 92, 58, 252, 178, 149, 159, 80, 160, 5,
-			224, 229, 43, 10, 204, 1, 40, 215, 70, 7, 205, 190, 126, 0,
-			159, 120, 138, 252, 27, 3, 7, 141, 65, 237, 255, 219, 50, 239,
-			20, 254, 87, 131, 45, 137, 125, 34, 97, 193, 164, 86, 30, 228,
-			42, 144, 122, 224, 131, 21, 235, 169, 141, 132, 34, 83, 143, 124,
-			168, 248, 205, 154, 227, 227, 181, 176, 13, 207, 173, 197, 234, 120,
-			164, 88, 81, 86, 152, 84, 156, 134, 58, 189, 133, 90, 200, 193,
-			203, 166, 88, 11, 12, 78, 125, 121, 154, 52, 132, 228, 184, 229,
-			78, 228, 242, 240, 22, 243, 249, 158, 92, 132, 16, 131, 201, 217,
-			13, 92, 37, 46, 114, 79, 37, 69, 100, 81, 243, 209, 176, 177,
-			181, 26, 204, 0, 168, 249, 104, 32, 47, 242, 51, 10, 180, 0,
-			156, 191, 170, 192, 28, 128, 215, 150, 21, 216, 15, 224, 179, 75,
-			228, 143, 4, 31, 77, 106, 255, 177, 101, 206, 21, 254, 247, 196,
-			173, 85, 226, 253, 13, 243, 108, 83, 99, 232, 109, 186, 179, 210,
-			155, 37, 143, 236, 206, 166, 196, 94, 180, 223, 180, 177, 193, 26,
-			204, 0, 168, 89, 9, 98, 245, 199, 202, 163, 29, 196, 125, 159,
-			63, 182, 206, 156, 85, 96, 14, 192, 115, 179, 10, 236, 7, 240,
-			242, 140, 80, 234, 3, 212, 254, 138, 101, 142, 226, 220, 48, 8,
-			115, 195, 87, 172, 193, 33, 145, 19, 231, 134, 52, 104, 74, 80,
-			228, 197, 143, 195, 146, 132, 1, 163, 7, 52, 37, 40, 242, 34,
-			52, 66, 229, 71, 211, 232, 6, 213, 215, 31, 202, 98, 223, 90,
-			212, 254, 123, 182, 121, 182, 240, 61, 89, 176, 100, 244, 9, 29,
-			213, 191, 98, 228, 119, 239, 230, 167, 236, 67, 167, 141, 113, 249,
-			251, 66, 21, 201, 126, 35, 144, 174, 142, 113, 169, 227, 74, 58,
-			69, 168, 97, 6, 41, 79, 189, 155, 239, 111, 236, 183, 121, 137,
-			225, 181, 248, 240, 243, 25, 72, 223, 18, 35, 234, 105, 54, 119,
-			139, 36, 70, 75, 61, 125, 196, 202, 11, 130, 7, 17, 94, 189,
-			161, 208, 73, 130, 239, 57, 109, 12, 238, 196, 199, 114, 148, 134,
-			79, 107, 121, 245, 176, 78, 183, 94, 79, 114, 56, 30, 147, 100,
-			177, 7, 124, 95, 18, 113, 32, 139, 38, 88, 58, 102, 79, 179,
-			121, 153, 237, 13, 241, 71, 43, 213, 110, 130, 122, 90, 71, 88,
-			165, 231, 86, 11, 12, 166, 107, 6, 65, 36, 20, 105, 106, 153,
-			66, 244, 139, 34, 255, 105, 52, 1, 244, 8, 217, 238, 196, 104,
-			81, 51, 135, 249, 226, 134, 18, 232, 27, 183, 107, 24, 234, 117,
-			220, 56, 96, 77, 176, 24, 224, 219, 3, 190, 47, 14, 182, 203,
-			64, 124, 193, 240, 212, 198, 218, 194, 253, 10, 90, 87, 120, 214,
-			225, 192, 29, 28, 184, 141, 166, 130, 110, 240, 110, 85, 39, 34,
-			204, 109, 36, 103, 49, 197, 8, 60, 252, 124, 25, 30, 205, 88,
-			219, 88, 190, 169, 110, 144, 148, 139, 157, 218, 148, 238, 185, 65,
-			151, 45, 136, 16, 4, 101, 246, 160, 84, 137, 27, 247, 136, 242,
-			141, 197, 49, 85, 137, 64, 234, 80, 21, 28, 230, 182, 186, 150,
-			85, 197, 234, 183, 244, 80, 212, 196, 36, 163, 217, 146, 9, 202,
-			178, 113, 136, 104, 48, 3, 160, 214, 6, 150, 1, 96, 254, 164,
-			2, 113, 60, 157, 62, 67, 46, 202, 241, 254, 247, 109, 243, 72,
-			241, 4, 238, 129, 123, 110, 12, 214, 133, 220, 35, 219, 246, 56,
-			145, 3, 214, 130, 108, 122, 228, 3, 198, 52, 104, 74, 240, 156,
-			196, 248, 17, 192, 72, 17, 163, 239, 248, 193, 150, 19, 109, 1,
-			102, 133, 204, 134, 28, 186, 180, 109, 116, 131, 166, 4, 215, 112,
-			240, 219, 212, 254, 110, 251, 111, 238, 66, 168, 65, 113, 56, 33,
-			225, 149, 157, 5, 112, 80, 233, 70, 60, 156, 96, 159, 83, 179,
-			14, 30, 78, 176, 229, 133, 80, 131, 25, 106, 127, 212, 254, 198,
-			92, 8, 53, 8, 246, 251, 71, 109, 105, 191, 15, 162, 253, 254,
-			81, 91, 218, 239, 131, 104, 191, 127, 212, 62, 54, 142, 102, 248,
-			16, 205, 126, 220, 238, 251, 75, 105, 134, 15, 25, 212, 254, 184,
-			157, 27, 35, 63, 106, 18, 219, 30, 2, 51, 252, 147, 182, 89,
-			46, 124, 204, 68, 142, 225, 211, 85, 137, 176, 170, 61, 54, 12,
-			224, 186, 114, 165, 119, 223, 92, 218, 235, 78, 18, 64, 75, 30,
-			114, 160, 94, 134, 108, 54, 29, 31, 216, 175, 227, 137, 246, 64,
-			152, 203, 76, 251, 50, 106, 155, 130, 104, 198, 108, 115, 47, 216,
-			83, 150, 71, 183, 63, 186, 207, 227, 100, 248, 38, 1, 15, 65,
-			155, 171, 119, 15, 128, 26, 152, 59, 57, 155, 158, 102, 81, 16,
-			134, 251, 37, 182, 199, 39, 60, 143, 161, 134, 15, 196, 193, 167,
-			58, 199, 195, 145, 24, 232, 218, 1, 19, 93, 237, 212, 156, 23,
-			189, 62, 132, 22, 221, 39, 149, 16, 12, 225, 173, 96, 159, 180,
-			229, 105, 142, 33, 180, 232, 62, 105, 203, 123, 58, 134, 208, 162,
-			251, 164, 125, 178, 160, 192, 28, 128, 167, 166, 21, 216, 15, 224,
-			99, 37, 188, 116, 101, 200, 238, 163, 217, 31, 176, 205, 31, 177,
-			197, 165, 43, 67, 184, 95, 253, 3, 118, 63, 140, 141, 44, 128,
-			208, 63, 159, 182, 237, 163, 133, 163, 122, 157, 162, 133, 183, 143,
-			226, 30, 237, 144, 220, 138, 254, 180, 109, 167, 18, 76, 72, 56,
-			50, 140, 33, 18, 67, 98, 43, 250, 51, 182, 220, 64, 30, 146,
-			59, 202, 159, 177, 237, 92, 146, 96, 66, 194, 224, 144, 46, 97,
-			82, 251, 135, 109, 121, 205, 206, 144, 220, 24, 254, 97, 91, 110,
-			12, 15, 201, 141, 225, 31, 182, 71, 199, 200, 239, 10, 41, 50,
-			168, 253, 57, 219, 60, 85, 248, 31, 76, 57, 238, 240, 252, 179,
-			236, 46, 185, 103, 47, 163, 130, 196, 193, 87, 165, 60, 219, 161,
-			219, 194, 103, 110, 148, 61, 136, 1, 166, 168, 74, 152, 35, 60,
-			36, 237, 76, 29, 16, 45, 209, 223, 224, 217, 148, 89, 213, 145,
-			51, 189, 227, 107, 236, 224, 123, 236, 133, 174, 138, 78, 196, 155,
-			8, 213, 141, 39, 73, 100, 17, 23, 2, 84, 74, 31, 105, 115,
-			194, 208, 217, 199, 37, 17, 113, 187, 22, 206, 1, 58, 248, 214,
-			235, 189, 19, 105, 219, 11, 182, 203, 172, 162, 78, 154, 151, 132,
-			122, 86, 91, 94, 160, 153, 99, 113, 95, 57, 30, 38, 199, 93,
-			52, 25, 128, 134, 166, 176, 220, 190, 19, 76, 75, 221, 220, 35,
-			36, 6, 15, 100, 36, 194, 135, 7, 50, 148, 182, 30, 18, 7,
-			50, 236, 188, 18, 62, 60, 144, 1, 194, 247, 201, 12, 118, 140,
-			73, 237, 47, 218, 230, 213, 194, 71, 50, 216, 49, 226, 229, 56,
-			29, 157, 35, 23, 106, 120, 18, 147, 184, 142, 230, 136, 224, 144,
-			94, 206, 146, 177, 226, 129, 188, 5, 65, 158, 102, 79, 207, 74,
-			224, 117, 235, 215, 66, 176, 241, 80, 238, 250, 53, 182, 141, 35,
-			43, 230, 59, 161, 227, 33, 239, 27, 238, 107, 234, 58, 20, 194,
-			38, 93, 63, 190, 126, 173, 196, 58, 242, 111, 36, 255, 98, 38,
-			76, 144, 191, 166, 202, 140, 45, 164, 174, 176, 83, 13, 209, 207,
-			185, 17, 113, 35, 145, 148, 15, 236, 176, 116, 123, 68, 180, 141,
-			242, 121, 144, 235, 17, 243, 2, 113, 99, 0, 216, 205, 46, 70,
-			210, 136, 160, 31, 144, 215, 166, 211, 6, 53, 178, 39, 238, 35,
-			240, 192, 220, 72, 46, 138, 144, 55, 48, 200, 85, 97, 214, 240,
-			2, 97, 118, 139, 128, 242, 164, 218, 50, 97, 235, 168, 208, 246,
-			225, 171, 126, 107, 78, 123, 3, 178, 17, 232, 150, 118, 249, 113,
-			188, 158, 38, 94, 158, 93, 32, 218, 72, 72, 125, 43, 138, 208,
-			211, 162, 186, 243, 6, 131, 19, 120, 211, 217, 117, 131, 48, 117,
-			174, 2, 21, 135, 232, 43, 194, 244, 147, 120, 120, 244, 179, 203,
-			254, 209, 183, 101, 199, 98, 49, 162, 75, 231, 234, 51, 192, 129,
-			236, 236, 244, 174, 185, 8, 168, 19, 97, 181, 78, 29, 215, 252,
-			69, 56, 244, 78, 16, 236, 148, 91, 78, 220, 44, 87, 64, 14,
-			180, 25, 50, 132, 78, 201, 23, 19, 193, 54, 179, 0, 202, 37,
-			192, 33, 212, 57, 95, 180, 233, 184, 2, 45, 0, 79, 157, 86,
-			96, 14, 192, 51, 243, 10, 236, 7, 112, 114, 78, 106, 85, 131,
-			102, 127, 206, 54, 127, 89, 107, 85, 24, 36, 63, 103, 247, 31,
-			193, 75, 179, 134, 196, 85, 86, 63, 111, 219, 180, 112, 66, 46,
-			160, 166, 118, 185, 197, 89, 40, 161, 232, 196, 61, 86, 63, 159,
-			40, 79, 113, 143, 213, 207, 219, 249, 17, 50, 37, 81, 25, 212,
-			254, 69, 64, 117, 18, 81, 29, 144, 185, 40, 133, 204, 16, 121,
-			19, 100, 160, 120, 127, 49, 141, 204, 164, 246, 47, 29, 138, 44,
-			137, 48, 86, 101, 161, 226, 95, 74, 35, 19, 133, 243, 35, 228,
-			159, 35, 19, 128, 97, 191, 103, 155, 87, 10, 255, 120, 88, 197,
-			104, 164, 14, 79, 108, 107, 23, 196, 115, 94, 119, 189, 253, 103,
-			25, 91, 113, 94, 223, 87, 91, 138, 122, 71, 81, 90, 32, 211,
-			192, 22, 117, 85, 171, 56, 22, 208, 226, 142, 47, 239, 190, 216,
-			83, 193, 117, 34, 174, 52, 229, 105, 225, 121, 30, 156, 233, 69,
-			109, 37, 161, 65, 92, 188, 5, 72, 230, 155, 136, 146, 219, 113,
-			80, 41, 202, 227, 164, 146, 190, 237, 78, 172, 12, 97, 97, 200,
-			137, 104, 18, 161, 151, 197, 106, 157, 148, 251, 46, 172, 82, 191,
-			214, 98, 17, 17, 159, 224, 67, 98, 229, 213, 79, 120, 75, 147,
-			88, 17, 81, 203, 111, 50, 70, 26, 154, 239, 224, 217, 31, 104,
-			109, 143, 143, 224, 132, 156, 53, 66, 206, 197, 106, 59, 122, 54,
-			250, 46, 8, 180, 136, 8, 227, 206, 14, 15, 193, 203, 247, 128,
-			171, 234, 104, 78, 247, 77, 37, 250, 24, 142, 182, 247, 212, 153,
-			25, 29, 232, 71, 244, 220, 209, 181, 200, 15, 142, 82, 212, 217,
-			217, 225, 145, 186, 126, 164, 107, 69, 202, 193, 55, 70, 192, 116,
-			114, 185, 184, 180, 199, 65, 95, 10, 240, 116, 209, 211, 117, 91,
-			13, 222, 212, 25, 132, 114, 89, 52, 53, 180, 183, 131, 224, 193,
-			3, 206, 197, 253, 87, 193, 46, 15, 155, 208, 23, 241, 126, 91,
-			122, 207, 242, 62, 242, 174, 104, 54, 247, 128, 2, 81, 161, 160,
-			204, 17, 193, 134, 113, 234, 5, 1, 63, 230, 97, 67, 238, 215,
-			56, 126, 215, 62, 69, 80, 7, 135, 214, 241, 60, 21, 3, 139,
-			87, 177, 224, 66, 42, 11, 121, 203, 73, 157, 133, 44, 51, 118,
-			167, 19, 66, 55, 128, 221, 0, 162, 22, 114, 167, 62, 29, 57,
-			13, 174, 175, 87, 39, 169, 202, 220, 52, 61, 169, 71, 19, 4,
-			193, 183, 48, 238, 38, 86, 17, 121, 170, 50, 192, 134, 202, 24,
-			218, 46, 150, 187, 244, 177, 9, 81, 33, 138, 115, 173, 19, 138,
-			243, 100, 56, 231, 120, 226, 74, 146, 110, 132, 32, 244, 174, 223,
-			225, 68, 156, 59, 193, 59, 57, 24, 87, 183, 7, 75, 177, 44,
-			147, 174, 179, 254, 189, 222, 234, 65, 223, 26, 159, 244, 214, 87,
-			124, 40, 171, 74, 28, 31, 34, 56, 149, 189, 190, 223, 125, 64,
-			67, 68, 197, 187, 81, 9, 155, 4, 98, 81, 137, 42, 98, 220,
-			186, 175, 243, 250, 228, 148, 50, 180, 186, 70, 55, 193, 186, 67,
-			30, 119, 66, 41, 144, 120, 217, 136, 244, 147, 187, 135, 98, 211,
-			137, 24, 190, 1, 133, 67, 160, 139, 178, 212, 178, 189, 207, 161,
-			193, 78, 184, 175, 15, 35, 4, 42, 208, 237, 16, 156, 232, 57,
-			200, 195, 100, 129, 136, 241, 19, 227, 219, 245, 197, 133, 51, 114,
-			154, 194, 39, 190, 240, 118, 42, 96, 76, 9, 244, 60, 119, 180,
-			69, 216, 238, 132, 237, 64, 68, 95, 0, 99, 136, 26, 25, 96,
-			110, 248, 189, 115, 163, 92, 48, 69, 118, 71, 111, 202, 111, 162,
-			215, 176, 245, 237, 45, 177, 124, 79, 192, 141, 211, 28, 87, 91,
-			9, 169, 104, 172, 84, 223, 40, 109, 217, 77, 6, 162, 150, 175,
-			127, 93, 22, 39, 24, 46, 75, 50, 92, 124, 72, 174, 139, 20,
-			92, 106, 188, 140, 1, 203, 151, 201, 155, 101, 235, 214, 77, 74,
-			159, 137, 27, 108, 186, 22, 208, 154, 96, 11, 111, 115, 238, 75,
-			142, 227, 114, 62, 22, 154, 159, 157, 159, 43, 9, 9, 147, 163,
-			157, 213, 130, 48, 228, 181, 216, 7, 180, 88, 121, 164, 164, 73,
-			196, 37, 227, 225, 86, 249, 122, 77, 154, 251, 40, 20, 32, 97,
-			30, 234, 154, 128, 213, 66, 39, 106, 138, 131, 177, 96, 70, 10,
-			195, 12, 92, 84, 241, 254, 80, 26, 155, 27, 193, 76, 229, 167,
-			166, 29, 214, 105, 7, 126, 50, 162, 216, 198, 218, 210, 218, 228,
-			246, 204, 252, 220, 220, 141, 217, 235, 115, 115, 87, 167, 110, 50,
-			25, 144, 35, 158, 51, 210, 254, 5, 182, 69, 223, 159, 42, 108,
-			13, 203, 198, 137, 85, 131, 25, 0, 181, 61, 110, 25, 0, 202,
-			155, 13, 135, 112, 245, 228, 247, 236, 19, 202, 138, 177, 114, 0,
-			158, 188, 172, 192, 126, 0, 47, 78, 145, 207, 27, 56, 101, 219,
-			212, 254, 125, 219, 124, 162, 240, 67, 6, 235, 121, 190, 249, 237,
-			49, 84, 198, 7, 169, 216, 187, 238, 147, 132, 24, 46, 131, 56,
-			209, 146, 22, 213, 212, 244, 235, 18, 237, 48, 104, 186, 219, 210,
-			39, 11, 66, 53, 134, 28, 95, 184, 211, 234, 210, 102, 209, 0,
-			91, 80, 172, 193, 12, 128, 154, 23, 182, 1, 160, 188, 43, 103,
-			8, 87, 71, 126, 223, 46, 94, 84, 96, 14, 64, 121, 232, 120,
-			8, 195, 192, 127, 223, 158, 125, 156, 252, 190, 224, 69, 134, 218,
-			127, 100, 155, 115, 133, 255, 37, 29, 121, 6, 211, 212, 55, 108,
-			129, 94, 109, 141, 68, 95, 219, 242, 188, 188, 214, 241, 145, 162,
-			205, 186, 4, 42, 99, 99, 83, 53, 136, 45, 215, 76, 204, 24,
-			0, 230, 213, 114, 66, 198, 2, 80, 46, 206, 15, 97, 32, 245,
-			31, 217, 114, 113, 126, 8, 3, 169, 255, 200, 190, 60, 67, 158,
-			69, 30, 102, 169, 253, 21, 219, 44, 21, 230, 222, 254, 155, 55,
-			2, 95, 214, 70, 12, 26, 204, 0, 168, 73, 203, 26, 0, 106,
-			89, 207, 90, 0, 158, 56, 169, 192, 28, 128, 133, 43, 10, 236,
-			7, 240, 210, 101, 92, 168, 27, 2, 131, 253, 171, 127, 131, 11,
-			117, 67, 24, 60, 250, 213, 132, 212, 254, 44, 128, 131, 138, 79,
-			253, 6, 128, 114, 161, 110, 8, 131, 71, 191, 170, 22, 234, 134,
-			50, 212, 254, 179, 111, 208, 66, 221, 80, 166, 15, 144, 203, 133,
-			186, 33, 92, 168, 251, 51, 181, 80, 55, 132, 11, 117, 127, 102,
-			31, 27, 39, 167, 129, 142, 1, 106, 255, 133, 109, 30, 41, 30,
-			253, 255, 216, 123, 255, 216, 200, 174, 235, 62, 124, 222, 175, 33,
-			247, 238, 46, 127, 220, 165, 180, 212, 236, 106, 117, 53, 90, 121,
-			73, 122, 56, 220, 165, 228, 200, 230, 74, 177, 134, 228, 236, 238,
-			88, 92, 14, 51, 28, 74, 94, 11, 210, 242, 205, 204, 35, 57,
-			222, 225, 188, 241, 188, 71, 81, 244, 55, 250, 182, 81, 106, 167,
-			86, 225, 40, 66, 84, 217, 72, 157, 10, 118, 224, 32, 78, 255,
-			105, 35, 192, 128, 211, 252, 209, 24, 5, 132, 52, 191, 234, 20,
-			14, 156, 244, 119, 208, 2, 78, 26, 180, 118, 148, 180, 65, 27,
-			215, 197, 61, 231, 220, 251, 238, 27, 114, 87, 182, 227, 45, 80,
-			180, 130, 32, 241, 190, 185, 239, 190, 123, 207, 61, 247, 220, 115,
-			207, 61, 231, 115, 40, 151, 76, 75, 124, 20, 244, 110, 108, 233,
-			88, 70, 254, 76, 134, 207, 19, 112, 187, 98, 22, 109, 42, 158,
-			96, 182, 123, 146, 103, 191, 227, 102, 126, 210, 67, 147, 223, 73,
-			139, 187, 223, 113, 135, 39, 128, 242, 39, 229, 217, 231, 187, 63,
-			68, 202, 159, 4, 235, 216, 119, 21, 229, 79, 130, 117, 236, 187,
-			138, 242, 39, 225, 4, 245, 93, 69, 249, 147, 96, 29, 251, 174,
-			162, 252, 73, 143, 187, 47, 123, 119, 135, 242, 39, 37, 229, 95,
-			246, 136, 242, 39, 129, 242, 47, 123, 68, 249, 147, 64, 249, 151,
-			61, 50, 145, 142, 240, 236, 39, 188, 204, 103, 137, 94, 35, 22,
-			119, 63, 225, 13, 159, 98, 155, 204, 117, 71, 36, 189, 62, 229,
-			217, 34, 87, 67, 63, 133, 180, 179, 141, 114, 91, 128, 132, 236,
-			98, 23, 19, 175, 37, 230, 80, 76, 126, 1, 73, 186, 226, 36,
-			146, 135, 41, 124, 81, 232, 203, 8, 16, 240, 83, 158, 173, 139,
-			158, 44, 210, 42, 27, 1, 2, 126, 202, 27, 59, 163, 138, 142,
-			44, 158, 123, 128, 253, 71, 11, 186, 103, 113, 247, 85, 207, 190,
-			148, 251, 253, 68, 78, 18, 106, 208, 93, 188, 199, 196, 208, 187,
-			187, 43, 37, 225, 58, 95, 211, 200, 114, 97, 156, 186, 232, 201,
-			162, 166, 145, 5, 84, 32, 33, 57, 2, 86, 176, 87, 61, 18,
-			146, 35, 112, 25, 252, 170, 71, 66, 114, 4, 46, 131, 95, 245,
-			102, 230, 224, 254, 97, 228, 24, 119, 95, 243, 238, 112, 255, 48,
-			34, 215, 221, 107, 30, 45, 180, 17, 88, 119, 102, 209, 166, 98,
-			21, 102, 195, 230, 238, 235, 222, 15, 111, 113, 141, 128, 145, 228,
-			245, 100, 220, 82, 164, 191, 238, 29, 87, 35, 147, 179, 255, 186,
-			71, 139, 107, 4, 140, 36, 175, 123, 180, 184, 70, 60, 238, 126,
-			230, 46, 45, 174, 17, 185, 184, 62, 163, 22, 215, 8, 44, 174,
-			207, 168, 197, 53, 2, 139, 235, 51, 106, 113, 141, 242, 236, 207,
-			121, 153, 95, 164, 197, 53, 106, 113, 247, 231, 188, 225, 73, 246,
-			223, 36, 251, 142, 202, 213, 245, 166, 100, 223, 111, 14, 176, 47,
-			30, 140, 239, 58, 19, 227, 119, 238, 246, 125, 188, 1, 239, 69,
-			19, 59, 10, 139, 254, 77, 53, 177, 163, 176, 232, 223, 84, 12,
-			61, 10, 139, 254, 77, 197, 208, 163, 176, 232, 223, 84, 12, 61,
-			10, 119, 10, 111, 42, 134, 30, 133, 59, 133, 55, 37, 67, 87,
-			129, 164, 22, 119, 191, 240, 67, 228, 193, 81, 88, 123, 95, 72,
-			186, 106, 101, 101, 241, 184, 234, 140, 5, 223, 35, 30, 28, 133,
-			181, 247, 5, 197, 131, 163, 30, 119, 191, 120, 151, 120, 112, 84,
-			242, 224, 23, 21, 15, 142, 2, 15, 126, 81, 241, 224, 40, 240,
-			224, 23, 21, 15, 142, 241, 236, 47, 121, 153, 95, 37, 30, 28,
-			179, 184, 251, 75, 222, 240, 189, 236, 15, 28, 230, 186, 99, 146,
-			7, 223, 242, 236, 121, 211, 27, 36, 137, 40, 190, 139, 12, 72,
-			31, 185, 219, 220, 167, 98, 113, 138, 108, 254, 155, 22, 24, 19,
-			22, 4, 226, 69, 105, 252, 143, 75, 26, 248, 227, 145, 121, 133,
-			53, 149, 128, 251, 235, 36, 231, 90, 117, 172, 173, 45, 49, 33,
-			196, 86, 223, 223, 13, 246, 195, 254, 173, 162, 16, 207, 4, 194,
-			239, 133, 157, 112, 91, 114, 18, 228, 122, 9, 253, 126, 139, 14,
-			218, 145, 145, 148, 36, 20, 225, 94, 63, 10, 58, 47, 4, 17,
-			221, 242, 11, 177, 31, 96, 104, 149, 2, 237, 68, 91, 21, 4,
-			9, 1, 28, 111, 3, 66, 146, 100, 181, 86, 208, 108, 147, 181,
-			73, 221, 237, 169, 172, 184, 178, 33, 74, 140, 75, 172, 59, 6,
-			171, 236, 45, 197, 186, 99, 176, 202, 222, 82, 171, 108, 12, 86,
-			217, 91, 106, 149, 141, 193, 42, 123, 203, 163, 80, 142, 49, 88,
-			101, 111, 121, 20, 202, 49, 6, 171, 236, 45, 239, 189, 23, 97,
-			149, 141, 201, 85, 246, 229, 31, 226, 42, 27, 131, 85, 246, 229,
-			164, 171, 114, 149, 125, 89, 173, 178, 49, 88, 101, 95, 86, 171,
-			108, 12, 86, 217, 151, 213, 42, 27, 243, 184, 251, 149, 187, 180,
-			202, 198, 228, 42, 251, 138, 90, 101, 99, 176, 202, 190, 162, 86,
-			217, 24, 172, 178, 175, 168, 85, 54, 206, 179, 191, 230, 101, 254,
-			128, 86, 217, 184, 197, 221, 95, 243, 134, 239, 97, 191, 47, 87,
-			217, 184, 92, 101, 111, 203, 85, 246, 175, 76, 159, 43, 48, 233,
-			221, 101, 151, 43, 249, 141, 187, 239, 113, 69, 113, 187, 255, 183,
-			45, 177, 113, 88, 98, 111, 43, 190, 29, 135, 37, 246, 182, 90,
-			98, 227, 176, 196, 222, 86, 75, 108, 28, 150, 216, 219, 106, 137,
-			141, 195, 18, 123, 91, 45, 177, 113, 88, 98, 111, 203, 37, 246,
-			45, 169, 28, 140, 187, 25, 158, 253, 45, 207, 254, 231, 158, 147,
-			118, 212, 35, 59, 112, 43, 152, 197, 192, 245, 89, 48, 198, 79,
-			133, 125, 180, 206, 182, 187, 226, 90, 189, 190, 38, 215, 100, 199,
-			239, 54, 131, 105, 156, 252, 86, 176, 219, 11, 227, 160, 43, 167,
-			53, 236, 139, 46, 154, 208, 62, 136, 117, 33, 185, 50, 68, 66,
-			14, 90, 219, 18, 11, 235, 213, 114, 93, 50, 71, 3, 99, 206,
-			253, 45, 192, 224, 134, 105, 71, 151, 222, 181, 13, 227, 247, 228,
-			115, 218, 224, 171, 110, 49, 6, 174, 229, 214, 170, 235, 117, 69,
-			76, 184, 255, 255, 45, 111, 232, 52, 92, 197, 143, 227, 253, 255,
-			111, 123, 238, 89, 184, 229, 25, 167, 235, 254, 223, 246, 40, 11,
-			202, 56, 93, 247, 255, 182, 151, 59, 195, 206, 211, 27, 22, 119,
-			127, 199, 115, 39, 243, 19, 232, 28, 20, 68, 70, 95, 152, 126,
-			205, 194, 106, 167, 146, 7, 182, 124, 112, 239, 105, 246, 24, 181,
-			99, 115, 247, 119, 61, 247, 84, 254, 130, 73, 58, 196, 17, 84,
-			176, 108, 0, 148, 129, 115, 16, 37, 77, 203, 30, 252, 174, 71,
-			105, 124, 198, 201, 91, 224, 119, 61, 200, 22, 37, 5, 129, 197,
-			179, 95, 243, 236, 223, 243, 222, 67, 179, 46, 5, 223, 215, 18,
-			6, 146, 130, 239, 107, 30, 33, 92, 142, 67, 71, 191, 230, 157,
-			157, 85, 69, 71, 22, 9, 15, 98, 28, 84, 251, 223, 243, 178,
-			15, 171, 226, 144, 44, 142, 159, 7, 25, 61, 46, 191, 251, 245,
-			31, 162, 140, 30, 7, 109, 252, 235, 73, 87, 165, 54, 254, 117,
-			37, 163, 199, 97, 224, 95, 87, 50, 122, 28, 180, 241, 175, 43,
-			25, 61, 238, 113, 247, 27, 119, 73, 70, 143, 75, 25, 253, 13,
-			37, 163, 199, 65, 70, 127, 67, 201, 232, 113, 144, 209, 223, 144,
-			50, 250, 239, 56, 204, 118, 57, 207, 254, 145, 151, 249, 175, 158,
-			149, 251, 43, 91, 148, 180, 85, 87, 223, 157, 75, 169, 224, 235,
-			19, 110, 66, 54, 125, 127, 163, 169, 68, 86, 70, 244, 241, 246,
-			123, 189, 192, 199, 16, 107, 53, 10, 66, 85, 67, 124, 111, 21,
-			153, 173, 189, 210, 22, 22, 214, 8, 155, 14, 97, 104, 76, 196,
-			226, 48, 236, 40, 180, 203, 136, 100, 27, 24, 124, 1, 206, 77,
-			118, 112, 217, 128, 166, 135, 120, 193, 168, 152, 138, 92, 30, 232,
-			66, 187, 155, 2, 179, 199, 55, 40, 169, 60, 94, 97, 96, 255,
-			146, 102, 23, 22, 168, 137, 169, 105, 20, 23, 189, 126, 136, 121,
-			25, 6, 170, 45, 133, 189, 131, 122, 56, 53, 61, 77, 119, 151,
-			128, 12, 2, 139, 99, 195, 132, 195, 211, 152, 121, 10, 112, 15,
-			161, 162, 184, 197, 221, 63, 242, 134, 207, 176, 223, 180, 153, 235,
-			114, 39, 195, 179, 223, 244, 236, 255, 228, 57, 185, 95, 69, 79,
-			26, 51, 176, 63, 133, 175, 151, 220, 21, 2, 132, 34, 193, 137,
-			232, 89, 68, 156, 215, 109, 2, 21, 96, 194, 23, 173, 48, 158,
-			85, 16, 59, 45, 229, 207, 221, 142, 110, 38, 56, 34, 148, 22,
-			65, 180, 183, 182, 140, 183, 205, 38, 187, 6, 200, 158, 152, 106,
-			5, 221, 48, 86, 72, 33, 152, 167, 70, 78, 85, 138, 7, 162,
-			94, 208, 140, 6, 189, 30, 167, 139, 76, 148, 139, 219, 197, 194,
-			255, 39, 158, 165, 4, 233, 224, 19, 243, 92, 65, 60, 155, 111,
-			248, 253, 98, 195, 255, 120, 190, 0, 157, 129, 71, 187, 70, 21,
-			241, 146, 209, 35, 134, 137, 213, 167, 232, 157, 233, 162, 172, 73,
-			139, 149, 3, 2, 245, 55, 61, 134, 153, 189, 56, 34, 80, 255,
-			177, 231, 230, 65, 46, 113, 194, 156, 254, 99, 143, 16, 161, 57,
-			97, 78, 255, 177, 119, 242, 84, 242, 192, 146, 15, 38, 238, 79,
-			30, 56, 242, 129, 120, 80, 183, 105, 113, 247, 79, 60, 247, 33,
-			93, 65, 10, 179, 63, 49, 219, 180, 60, 249, 224, 228, 120, 242,
-			0, 94, 225, 231, 146, 7, 142, 124, 240, 96, 30, 214, 50, 151,
-			189, 252, 83, 207, 70, 92, 84, 14, 125, 252, 83, 37, 113, 56,
-			24, 215, 254, 212, 59, 126, 74, 21, 45, 89, 156, 152, 84, 69,
-			71, 22, 207, 156, 197, 20, 81, 92, 118, 238, 91, 158, 125, 33,
-			247, 105, 203, 200, 116, 114, 7, 110, 42, 200, 153, 218, 223, 241,
-			99, 224, 98, 112, 78, 1, 13, 44, 188, 21, 200, 5, 223, 103,
-			114, 43, 64, 136, 82, 0, 138, 244, 35, 186, 186, 73, 110, 110,
-			202, 20, 164, 66, 73, 163, 112, 241, 146, 211, 42, 229, 160, 82,
-			99, 145, 164, 250, 86, 50, 52, 73, 168, 111, 121, 228, 255, 193,
-			129, 76, 223, 242, 40, 18, 146, 3, 145, 190, 229, 61, 252, 30,
-			34, 146, 205, 221, 111, 123, 246, 52, 253, 40, 197, 242, 183, 147,
-			150, 108, 79, 22, 117, 75, 224, 47, 238, 241, 243, 170, 232, 200,
-			226, 133, 41, 106, 201, 225, 238, 159, 121, 132, 182, 204, 225, 114,
-			231, 207, 146, 150, 28, 79, 22, 201, 211, 143, 195, 229, 206, 159,
-			121, 227, 15, 169, 34, 188, 251, 158, 11, 212, 146, 203, 221, 119,
-			60, 91, 253, 232, 98, 81, 181, 228, 122, 178, 168, 251, 228, 90,
-			178, 72, 1, 110, 28, 174, 70, 222, 73, 88, 192, 227, 238, 159,
-			123, 182, 26, 186, 231, 66, 81, 181, 228, 193, 175, 186, 79, 158,
-			37, 139, 227, 138, 95, 60, 71, 22, 9, 71, 151, 203, 13, 234,
-			47, 60, 130, 78, 230, 96, 206, 255, 139, 164, 165, 172, 39, 139,
-			186, 79, 89, 75, 22, 249, 131, 170, 232, 200, 226, 249, 135, 217,
-			31, 90, 204, 118, 79, 241, 236, 119, 188, 204, 255, 204, 90, 185,
-			143, 138, 114, 183, 233, 247, 34, 194, 63, 253, 222, 82, 166, 35,
-			152, 87, 63, 220, 85, 46, 88, 76, 92, 105, 119, 130, 1, 192,
-			98, 177, 239, 27, 120, 46, 69, 54, 191, 249, 195, 68, 156, 77,
-			39, 112, 135, 142, 163, 20, 62, 101, 113, 247, 59, 222, 240, 189,
-			236, 229, 113, 230, 186, 167, 228, 10, 252, 82, 214, 126, 48, 247,
-			206, 152, 40, 137, 149, 80, 221, 141, 37, 232, 188, 190, 232, 181,
-			3, 116, 87, 72, 183, 40, 252, 52, 32, 155, 28, 43, 195, 59,
-			187, 168, 23, 118, 209, 191, 209, 55, 175, 123, 19, 208, 91, 29,
-			94, 147, 206, 246, 142, 56, 143, 148, 173, 35, 73, 31, 18, 135,
-			162, 178, 92, 134, 84, 81, 45, 74, 201, 20, 244, 163, 194, 64,
-			52, 96, 18, 31, 78, 64, 105, 237, 221, 118, 199, 239, 51, 157,
-			39, 158, 114, 125, 1, 224, 98, 65, 68, 254, 129, 60, 0, 96,
-			104, 23, 14, 65, 7, 36, 220, 54, 204, 76, 146, 149, 192, 172,
-			194, 80, 71, 23, 188, 196, 196, 74, 0, 17, 113, 97, 120, 75,
-			248, 49, 66, 244, 38, 174, 192, 201, 184, 161, 245, 59, 53, 245,
-			60, 133, 42, 60, 255, 188, 254, 159, 252, 247, 249, 231, 229, 143,
-			62, 253, 216, 104, 194, 255, 90, 129, 16, 91, 66, 108, 239, 180,
-			153, 60, 46, 105, 176, 89, 13, 226, 35, 58, 52, 159, 24, 181,
-			17, 245, 252, 174, 16, 0, 216, 35, 210, 255, 152, 187, 140, 16,
-			207, 250, 133, 246, 180, 16, 207, 138, 71, 11, 226, 98, 65, 204,
-			23, 196, 69, 241, 28, 212, 147, 130, 117, 127, 39, 236, 28, 30,
-			88, 145, 94, 108, 12, 188, 88, 16, 143, 202, 119, 229, 139, 29,
-			191, 17, 116, 196, 148, 26, 253, 52, 190, 210, 44, 180, 14, 189,
-			242, 62, 245, 10, 102, 153, 67, 50, 81, 253, 160, 176, 117, 168,
-			254, 37, 85, 31, 161, 73, 183, 194, 144, 42, 111, 23, 118, 14,
-			85, 126, 68, 87, 70, 84, 207, 169, 75, 211, 42, 199, 132, 36,
-			211, 172, 40, 105, 178, 145, 219, 135, 198, 16, 215, 158, 188, 228,
-			67, 20, 71, 65, 103, 139, 178, 224, 145, 139, 4, 64, 213, 9,
-			147, 233, 49, 131, 24, 97, 218, 182, 227, 105, 35, 178, 76, 221,
-			81, 19, 196, 26, 68, 53, 132, 91, 202, 73, 55, 66, 184, 103,
-			33, 228, 33, 24, 189, 143, 130, 110, 179, 19, 146, 227, 135, 246,
-			208, 197, 112, 51, 212, 96, 138, 34, 205, 228, 224, 198, 24, 183,
-			251, 9, 40, 42, 56, 240, 54, 111, 137, 169, 94, 24, 69, 237,
-			70, 231, 192, 76, 101, 166, 189, 123, 18, 205, 199, 0, 170, 70,
-			181, 15, 128, 74, 49, 30, 146, 188, 100, 52, 185, 246, 119, 228,
-			209, 17, 248, 11, 168, 166, 47, 130, 242, 137, 134, 159, 215, 84,
-			132, 19, 191, 246, 114, 5, 96, 162, 46, 82, 171, 40, 167, 225,
-			186, 234, 139, 102, 226, 228, 44, 166, 67, 245, 228, 183, 20, 65,
-			209, 141, 53, 82, 126, 172, 72, 29, 131, 126, 102, 110, 9, 72,
-			129, 214, 235, 195, 9, 85, 126, 24, 49, 153, 245, 240, 1, 66,
-			139, 0, 233, 197, 110, 24, 129, 81, 33, 108, 188, 208, 14, 247,
-			34, 69, 92, 149, 36, 16, 199, 214, 202, 19, 93, 253, 109, 191,
-			221, 213, 200, 182, 10, 12, 217, 196, 241, 53, 167, 33, 157, 1,
-			35, 106, 134, 189, 160, 128, 158, 218, 224, 151, 162, 145, 130, 143,
-			24, 117, 154, 85, 47, 68, 184, 188, 149, 159, 23, 70, 218, 0,
-			60, 45, 113, 85, 59, 142, 72, 113, 165, 186, 200, 43, 216, 35,
-			98, 23, 99, 60, 65, 144, 71, 15, 181, 20, 47, 36, 4, 84,
-			103, 32, 244, 199, 147, 173, 52, 130, 237, 118, 23, 216, 136, 180,
-			174, 65, 202, 96, 36, 112, 180, 227, 247, 241, 104, 49, 128, 52,
-			173, 252, 182, 16, 33, 23, 222, 129, 65, 126, 8, 221, 139, 208,
-			77, 202, 63, 106, 196, 230, 48, 163, 112, 87, 1, 136, 14, 212,
-			148, 45, 235, 131, 221, 110, 224, 235, 180, 48, 216, 132, 60, 52,
-			5, 221, 150, 127, 196, 34, 18, 121, 72, 55, 156, 167, 19, 44,
-			8, 73, 200, 43, 234, 163, 132, 146, 35, 51, 128, 195, 245, 202,
-			76, 37, 51, 73, 32, 57, 19, 142, 150, 13, 37, 27, 102, 27,
-			115, 177, 41, 72, 16, 114, 33, 151, 141, 18, 150, 93, 187, 15,
-			39, 202, 176, 155, 248, 143, 234, 76, 40, 98, 86, 44, 37, 40,
-			85, 152, 108, 7, 130, 62, 72, 239, 53, 150, 17, 105, 168, 189,
-			126, 216, 240, 27, 232, 45, 211, 10, 162, 246, 118, 23, 236, 96,
-			0, 53, 13, 102, 66, 17, 195, 122, 86, 84, 82, 150, 3, 196,
-			78, 137, 253, 110, 171, 32, 149, 98, 136, 91, 64, 143, 232, 112,
-			203, 248, 74, 19, 253, 113, 4, 230, 66, 105, 134, 253, 150, 129,
-			211, 9, 161, 39, 164, 28, 159, 2, 189, 255, 75, 89, 91, 23,
-			179, 178, 72, 122, 255, 41, 208, 251, 191, 148, 157, 56, 171, 138,
-			142, 44, 62, 32, 192, 192, 114, 74, 158, 34, 127, 57, 107, 127,
-			39, 139, 238, 207, 167, 224, 32, 244, 203, 89, 198, 217, 167, 134,
-			88, 86, 150, 165, 134, 243, 213, 172, 91, 200, 253, 101, 214, 76,
-			58, 64, 176, 232, 126, 63, 86, 236, 122, 59, 29, 77, 197, 124,
-			83, 250, 33, 166, 199, 8, 136, 232, 70, 84, 5, 217, 78, 13,
-			75, 40, 101, 3, 67, 161, 70, 16, 110, 224, 164, 231, 163, 148,
-			148, 74, 34, 70, 88, 247, 195, 48, 62, 178, 7, 10, 101, 71,
-			74, 36, 242, 103, 138, 83, 249, 3, 68, 216, 108, 238, 65, 14,
-			250, 20, 211, 130, 21, 69, 126, 4, 182, 126, 216, 2, 31, 129,
-			45, 240, 49, 216, 47, 89, 34, 161, 23, 80, 16, 119, 130, 162,
-			138, 161, 151, 179, 57, 245, 200, 180, 16, 115, 115, 240, 158, 138,
-			160, 44, 194, 216, 166, 30, 155, 214, 138, 195, 220, 28, 52, 169,
-			43, 200, 13, 120, 106, 218, 208, 44, 230, 230, 196, 165, 196, 237,
-			81, 173, 226, 35, 6, 154, 250, 56, 34, 9, 152, 132, 124, 20,
-			122, 169, 55, 224, 65, 42, 165, 94, 126, 66, 60, 122, 153, 193,
-			154, 25, 252, 6, 54, 121, 168, 241, 249, 116, 227, 71, 165, 23,
-			17, 42, 236, 115, 158, 154, 62, 50, 7, 9, 232, 31, 135, 154,
-			191, 116, 164, 214, 7, 117, 41, 140, 52, 17, 13, 24, 153, 15,
-			204, 145, 36, 33, 213, 27, 108, 152, 36, 157, 35, 163, 70, 101,
-			75, 42, 177, 228, 66, 131, 172, 212, 241, 163, 88, 177, 228, 161,
-			201, 151, 51, 175, 89, 99, 96, 151, 78, 107, 118, 137, 168, 159,
-			210, 240, 144, 90, 196, 51, 181, 104, 80, 169, 83, 82, 11, 34,
-			108, 212, 69, 220, 110, 187, 25, 118, 194, 238, 52, 249, 241, 159,
-			34, 35, 196, 87, 179, 100, 48, 56, 69, 70, 136, 175, 102, 41,
-			45, 213, 41, 50, 66, 124, 53, 123, 234, 116, 242, 192, 145, 15,
-			114, 103, 146, 7, 195, 242, 193, 217, 247, 178, 49, 54, 76, 15,
-			108, 249, 228, 254, 25, 246, 123, 54, 45, 121, 139, 187, 191, 33,
-			151, 252, 175, 219, 42, 230, 116, 7, 114, 80, 224, 113, 61, 222,
-			233, 7, 1, 102, 13, 216, 235, 107, 109, 107, 129, 0, 191, 59,
-			237, 174, 60, 29, 192, 223, 205, 176, 179, 183, 219, 45, 48, 33,
-			55, 108, 249, 67, 162, 190, 22, 12, 143, 93, 63, 138, 246, 118,
-			131, 22, 110, 206, 126, 100, 52, 52, 93, 128, 87, 177, 29, 157,
-			224, 194, 239, 235, 176, 177, 118, 23, 17, 114, 113, 195, 64, 218,
-			67, 180, 22, 5, 130, 53, 15, 138, 194, 240, 137, 150, 109, 34,
-			255, 97, 147, 250, 26, 68, 54, 249, 241, 160, 31, 206, 162, 113,
-			95, 234, 31, 218, 103, 253, 32, 220, 195, 205, 98, 159, 128, 12,
-			252, 86, 139, 137, 75, 16, 126, 38, 197, 23, 221, 134, 180, 218,
-			81, 175, 227, 31, 180, 85, 70, 208, 61, 12, 163, 85, 116, 183,
-			92, 32, 106, 50, 119, 150, 39, 31, 24, 115, 103, 1, 217, 141,
-			185, 179, 28, 249, 192, 152, 59, 107, 88, 62, 48, 230, 206, 146,
-			115, 247, 27, 114, 238, 190, 120, 130, 230, 206, 230, 238, 127, 207,
-			186, 211, 185, 215, 79, 104, 40, 251, 117, 56, 119, 202, 237, 173,
-			210, 221, 10, 211, 134, 63, 13, 104, 107, 112, 172, 2, 190, 246,
-			1, 222, 235, 64, 30, 74, 119, 73, 163, 6, 173, 165, 157, 92,
-			1, 193, 214, 13, 185, 58, 81, 170, 38, 92, 79, 103, 119, 120,
-			65, 185, 93, 171, 132, 44, 138, 225, 205, 13, 158, 49, 81, 2,
-			12, 93, 218, 8, 193, 212, 125, 248, 187, 97, 23, 252, 164, 131,
-			230, 30, 120, 157, 202, 106, 17, 66, 151, 138, 110, 136, 60, 197,
-			208, 8, 53, 240, 22, 110, 198, 186, 62, 94, 237, 197, 100, 111,
-			134, 196, 170, 250, 60, 0, 31, 149, 29, 234, 4, 190, 212, 42,
-			110, 182, 2, 236, 247, 77, 221, 33, 104, 224, 86, 16, 244, 228,
-			22, 232, 111, 247, 253, 222, 14, 116, 91, 87, 0, 118, 195, 14,
-			48, 69, 172, 169, 198, 94, 12, 218, 83, 51, 236, 118, 49, 142,
-			32, 14, 167, 209, 210, 141, 49, 0, 106, 53, 21, 113, 99, 212,
-			109, 67, 112, 135, 178, 199, 54, 14, 16, 125, 105, 112, 48, 97,
-			66, 177, 100, 75, 69, 133, 33, 57, 51, 232, 200, 243, 42, 68,
-			237, 236, 36, 175, 80, 96, 141, 137, 138, 115, 89, 255, 184, 235,
-			247, 111, 201, 117, 130, 118, 240, 185, 185, 105, 60, 92, 69, 144,
-			143, 52, 128, 83, 0, 169, 125, 168, 172, 42, 58, 20, 20, 13,
-			37, 63, 196, 132, 180, 5, 76, 211, 21, 126, 20, 7, 253, 118,
-			116, 43, 201, 114, 172, 155, 59, 44, 49, 225, 96, 7, 120, 172,
-			146, 49, 194, 36, 128, 19, 205, 4, 253, 40, 46, 50, 177, 26,
-			236, 3, 77, 128, 115, 41, 94, 55, 137, 245, 133, 132, 89, 152,
-			134, 69, 37, 141, 72, 109, 44, 128, 13, 163, 173, 9, 176, 247,
-			46, 209, 240, 77, 198, 221, 10, 67, 56, 29, 223, 230, 231, 134,
-			223, 47, 30, 209, 108, 195, 239, 227, 238, 119, 212, 94, 214, 240,
-			63, 46, 158, 16, 143, 92, 190, 99, 179, 31, 87, 95, 45, 117,
-			73, 63, 151, 148, 56, 84, 231, 14, 109, 236, 234, 158, 191, 91,
-			75, 170, 166, 145, 80, 119, 175, 209, 145, 103, 185, 16, 213, 3,
-			106, 96, 153, 22, 134, 230, 19, 204, 125, 212, 223, 54, 178, 195,
-			74, 134, 87, 76, 16, 246, 69, 220, 247, 219, 16, 57, 162, 88,
-			132, 154, 194, 175, 10, 245, 190, 153, 160, 181, 143, 162, 168, 209,
-			241, 187, 183, 144, 233, 213, 106, 160, 72, 89, 212, 3, 161, 25,
-			121, 174, 40, 190, 123, 247, 146, 165, 37, 230, 139, 71, 206, 9,
-			86, 123, 66, 188, 15, 103, 101, 70, 44, 154, 140, 173, 169, 5,
-			170, 219, 12, 66, 243, 195, 176, 197, 10, 141, 85, 177, 119, 68,
-			85, 20, 147, 147, 178, 81, 20, 51, 115, 119, 108, 153, 206, 18,
-			98, 70, 108, 247, 1, 168, 146, 94, 24, 96, 44, 252, 81, 60,
-			33, 126, 68, 207, 10, 121, 41, 136, 214, 192, 240, 35, 99, 59,
-			178, 93, 216, 39, 146, 237, 200, 246, 228, 3, 186, 207, 128, 7,
-			150, 124, 48, 113, 62, 121, 224, 200, 7, 23, 166, 224, 62, 67,
-			62, 112, 184, 251, 63, 178, 238, 140, 174, 224, 184, 240, 32, 105,
-			211, 241, 228, 3, 163, 77, 199, 146, 15, 38, 30, 78, 30, 64,
-			27, 83, 211, 186, 77, 151, 187, 127, 149, 117, 231, 117, 5, 23,
-			31, 36, 109, 186, 158, 124, 96, 180, 233, 90, 242, 193, 196, 108,
-			242, 192, 145, 15, 46, 94, 98, 255, 217, 98, 182, 59, 193, 179,
-			63, 57, 148, 249, 236, 144, 149, 251, 183, 150, 145, 201, 15, 165,
-			98, 7, 15, 91, 59, 237, 158, 104, 4, 241, 126, 16, 116, 7,
-			226, 201, 240, 212, 29, 71, 131, 70, 106, 149, 40, 162, 148, 224,
-			206, 235, 253, 213, 68, 143, 137, 162, 176, 217, 246, 245, 205, 151,
-			206, 143, 163, 191, 194, 76, 171, 119, 114, 101, 174, 242, 60, 128,
-			134, 9, 104, 252, 146, 197, 18, 95, 97, 122, 41, 133, 15, 130,
-			6, 234, 9, 139, 187, 63, 57, 52, 124, 31, 123, 145, 185, 238,
-			132, 60, 189, 125, 114, 200, 126, 56, 247, 81, 81, 234, 138, 146,
-			118, 122, 81, 91, 80, 132, 7, 126, 176, 4, 72, 149, 52, 120,
-			17, 246, 140, 1, 34, 128, 97, 78, 237, 41, 76, 89, 67, 20,
-			144, 84, 119, 59, 157, 176, 4, 78, 146, 19, 160, 172, 126, 114,
-			200, 214, 197, 172, 44, 30, 191, 71, 21, 45, 89, 188, 87, 168,
-			162, 35, 139, 15, 157, 135, 83, 233, 132, 60, 149, 254, 212, 144,
-			253, 153, 33, 60, 149, 78, 192, 169, 244, 167, 134, 216, 4, 123,
-			213, 98, 89, 89, 150, 227, 122, 101, 200, 45, 228, 254, 134, 121,
-			40, 5, 79, 205, 244, 206, 55, 120, 185, 144, 202, 153, 157, 228,
-			27, 3, 47, 99, 180, 165, 195, 182, 172, 76, 69, 254, 160, 246,
-			84, 84, 54, 254, 34, 90, 145, 128, 235, 38, 72, 55, 127, 101,
-			136, 24, 117, 130, 116, 243, 87, 134, 72, 191, 155, 32, 221, 252,
-			149, 33, 210, 239, 38, 72, 55, 127, 101, 136, 244, 187, 9, 210,
-			205, 95, 25, 34, 253, 110, 66, 233, 230, 175, 12, 221, 63, 195,
-			170, 52, 110, 139, 187, 159, 30, 114, 207, 231, 62, 56, 56, 110,
-			224, 1, 64, 230, 198, 179, 9, 169, 88, 71, 143, 223, 232, 183,
-			212, 75, 63, 109, 246, 91, 234, 165, 159, 30, 162, 5, 54, 65,
-			122, 233, 167, 135, 38, 30, 72, 30, 56, 242, 65, 254, 33, 182,
-			79, 157, 178, 185, 251, 218, 144, 123, 127, 110, 123, 176, 83, 160,
-			201, 227, 238, 189, 21, 5, 48, 41, 141, 131, 56, 73, 92, 155,
-			230, 51, 66, 35, 135, 101, 153, 120, 193, 27, 215, 136, 120, 27,
-			111, 116, 94, 138, 139, 215, 204, 206, 75, 41, 246, 154, 73, 116,
-			73, 176, 215, 134, 40, 19, 240, 4, 73, 177, 215, 134, 206, 156,
-			101, 255, 82, 177, 146, 195, 221, 55, 134, 220, 51, 185, 127, 102,
-			29, 226, 37, 114, 46, 251, 94, 58, 79, 145, 179, 119, 232, 60,
-			180, 130, 0, 61, 65, 87, 149, 141, 251, 78, 41, 23, 122, 126,
-			20, 27, 199, 208, 126, 208, 9, 94, 128, 108, 234, 7, 113, 32,
-			166, 40, 81, 31, 98, 74, 168, 35, 36, 172, 217, 39, 160, 201,
-			89, 212, 151, 166, 13, 10, 73, 153, 252, 134, 73, 33, 41, 147,
-			223, 48, 41, 36, 101, 242, 27, 67, 148, 201, 120, 130, 100, 242,
-			27, 67, 247, 229, 216, 23, 206, 177, 7, 16, 124, 126, 206, 239,
-			181, 231, 96, 161, 220, 84, 136, 1, 200, 72, 156, 17, 58, 189,
-			223, 107, 231, 8, 169, 126, 78, 33, 213, 207, 37, 142, 20, 88,
-			123, 230, 23, 45, 118, 18, 78, 254, 139, 212, 10, 63, 199, 114,
-			87, 42, 229, 149, 229, 155, 139, 229, 107, 165, 167, 43, 213, 218,
-			205, 141, 213, 245, 181, 242, 82, 229, 74, 165, 188, 60, 150, 225,
-			39, 216, 176, 74, 187, 62, 102, 201, 82, 173, 252, 99, 27, 149,
-			90, 121, 121, 204, 230, 163, 236, 120, 117, 163, 190, 182, 81, 191,
-			89, 93, 93, 185, 49, 230, 240, 17, 198, 42, 171, 186, 236, 242,
-			147, 236, 88, 229, 250, 245, 141, 122, 105, 113, 165, 60, 230, 113,
-			206, 70, 54, 86, 171, 181, 229, 114, 173, 188, 124, 115, 165, 178,
-			94, 31, 203, 242, 123, 216, 248, 106, 117, 245, 102, 249, 250, 90,
-			253, 198, 205, 229, 242, 149, 210, 198, 74, 125, 108, 104, 97, 147,
-			141, 164, 135, 203, 239, 47, 14, 226, 240, 195, 64, 200, 89, 99,
-			242, 115, 195, 194, 153, 26, 153, 191, 175, 152, 208, 163, 152, 26,
-			105, 237, 228, 150, 89, 92, 236, 177, 145, 102, 184, 107, 84, 95,
-			228, 169, 250, 96, 17, 89, 179, 62, 82, 162, 26, 219, 97, 199,
-			239, 110, 23, 195, 254, 246, 220, 118, 208, 133, 78, 204, 225, 79,
-			126, 175, 29, 193, 4, 25, 62, 141, 151, 141, 191, 191, 96, 187,
-			87, 75, 107, 149, 15, 253, 102, 142, 101, 185, 59, 146, 185, 97,
-			177, 95, 115, 153, 117, 130, 59, 35, 25, 62, 255, 143, 92, 177,
-			20, 246, 14, 250, 237, 237, 157, 88, 204, 95, 188, 244, 126, 242,
-			47, 20, 43, 43, 75, 140, 137, 149, 118, 51, 232, 66, 88, 100,
-			183, 69, 167, 188, 82, 79, 106, 21, 234, 151, 130, 120, 26, 17,
-			112, 196, 124, 241, 162, 152, 2, 195, 53, 253, 148, 159, 190, 204,
-			224, 244, 172, 210, 80, 26, 89, 25, 225, 146, 164, 25, 244, 96,
-			89, 33, 176, 165, 223, 109, 6, 9, 214, 38, 181, 81, 100, 128,
-			173, 9, 169, 161, 27, 176, 37, 202, 51, 107, 79, 197, 104, 171,
-			106, 194, 143, 25, 90, 209, 118, 226, 184, 183, 48, 55, 183, 191,
-			191, 95, 244, 161, 163, 64, 178, 14, 86, 139, 230, 86, 42, 75,
-			229, 213, 245, 242, 236, 124, 241, 34, 99, 98, 163, 11, 65, 172,
-			58, 192, 181, 113, 160, 178, 37, 74, 109, 183, 227, 239, 131, 5,
-			114, 187, 79, 16, 95, 237, 174, 194, 136, 41, 136, 40, 220, 138,
-			247, 225, 16, 212, 106, 75, 149, 177, 177, 23, 167, 168, 164, 58,
-			70, 217, 214, 85, 133, 16, 66, 81, 243, 165, 117, 81, 89, 207,
-			139, 197, 210, 122, 101, 189, 192, 196, 51, 149, 250, 181, 234, 70,
-			93, 60, 83, 170, 213, 74, 171, 245, 74, 121, 93, 84, 107, 98,
-			169, 186, 186, 92, 145, 188, 191, 46, 170, 87, 68, 105, 245, 134,
-			120, 170, 178, 186, 92, 80, 1, 189, 193, 139, 242, 28, 31, 129,
-			195, 34, 120, 239, 181, 12, 164, 87, 245, 121, 237, 63, 174, 146,
-			58, 104, 28, 180, 237, 240, 133, 160, 15, 71, 43, 64, 232, 136,
-			40, 95, 101, 183, 197, 4, 96, 213, 144, 29, 250, 208, 136, 138,
-			140, 13, 51, 203, 230, 206, 88, 230, 20, 59, 198, 108, 39, 195,
-			29, 158, 153, 145, 15, 135, 185, 51, 145, 249, 176, 124, 56, 124,
-			28, 255, 196, 135, 247, 100, 242, 240, 144, 225, 159, 248, 240, 222,
-			204, 35, 240, 144, 254, 196, 135, 167, 51, 23, 224, 161, 133, 127,
-			226, 195, 73, 122, 253, 188, 250, 211, 26, 226, 110, 46, 51, 101,
-			177, 63, 116, 152, 61, 148, 225, 206, 5, 123, 33, 247, 59, 142,
-			40, 145, 33, 222, 176, 246, 233, 113, 43, 44, 11, 194, 59, 153,
-			82, 115, 94, 80, 153, 148, 165, 110, 86, 128, 12, 60, 211, 224,
-			122, 165, 214, 121, 10, 24, 52, 125, 116, 28, 48, 69, 138, 103,
-			167, 140, 213, 159, 150, 31, 211, 226, 9, 161, 68, 215, 115, 112,
-			164, 88, 143, 253, 152, 242, 185, 124, 47, 47, 27, 146, 14, 223,
-			31, 20, 71, 203, 123, 100, 121, 137, 227, 142, 108, 16, 151, 195,
-			187, 180, 154, 136, 203, 163, 27, 173, 183, 119, 131, 40, 246, 119,
-			123, 146, 219, 218, 253, 224, 102, 220, 198, 177, 126, 79, 173, 27,
-			125, 46, 144, 141, 251, 93, 186, 163, 164, 245, 115, 151, 25, 99,
-			204, 25, 202, 216, 220, 201, 13, 61, 132, 127, 187, 114, 162, 233,
-			121, 150, 59, 23, 142, 211, 115, 139, 59, 23, 206, 207, 227, 223,
-			14, 119, 46, 188, 239, 3, 236, 79, 108, 102, 123, 25, 238, 94,
-			204, 220, 176, 114, 255, 218, 134, 8, 253, 110, 171, 221, 4, 40,
-			50, 18, 29, 38, 246, 141, 79, 121, 232, 145, 75, 166, 182, 210,
-			87, 1, 126, 172, 236, 198, 132, 29, 68, 226, 2, 20, 244, 143,
-			237, 5, 81, 140, 113, 192, 216, 134, 31, 41, 150, 2, 60, 18,
-			58, 140, 249, 82, 111, 232, 237, 197, 211, 10, 198, 96, 102, 70,
-			221, 166, 205, 204, 152, 64, 220, 186, 91, 138, 7, 155, 97, 71,
-			80, 206, 74, 186, 51, 191, 44, 15, 189, 232, 75, 137, 142, 117,
-			81, 250, 77, 121, 104, 145, 18, 147, 224, 51, 118, 194, 125, 81,
-			90, 171, 128, 35, 135, 228, 215, 29, 191, 219, 234, 104, 181, 81,
-			33, 17, 130, 35, 122, 93, 7, 85, 205, 204, 236, 250, 7, 51,
-			51, 162, 31, 52, 131, 246, 11, 1, 32, 179, 166, 18, 1, 39,
-			183, 80, 140, 57, 158, 156, 132, 139, 30, 103, 31, 100, 174, 39,
-			181, 115, 103, 222, 126, 48, 55, 47, 150, 194, 238, 11, 82, 3,
-			66, 35, 2, 121, 49, 3, 117, 33, 98, 207, 136, 35, 134, 141,
-			1, 156, 84, 61, 15, 116, 101, 103, 222, 62, 171, 74, 54, 119,
-			230, 31, 16, 236, 243, 22, 180, 110, 113, 231, 49, 123, 52, 247,
-			179, 22, 65, 38, 145, 157, 86, 145, 66, 153, 248, 253, 72, 159,
-			149, 139, 76, 60, 3, 208, 26, 0, 196, 129, 56, 23, 71, 145,
-			215, 239, 7, 34, 177, 80, 199, 10, 88, 160, 161, 229, 39, 193,
-			200, 136, 96, 183, 183, 227, 71, 109, 196, 24, 72, 114, 99, 234,
-			254, 91, 208, 71, 93, 178, 185, 243, 216, 201, 17, 246, 79, 176,
-			255, 54, 119, 30, 183, 71, 115, 191, 34, 207, 163, 135, 186, 172,
-			152, 75, 163, 93, 32, 219, 6, 6, 120, 11, 214, 157, 153, 217,
-			221, 139, 36, 239, 52, 130, 4, 198, 218, 143, 82, 119, 114, 196,
-			157, 5, 180, 121, 111, 249, 237, 14, 164, 227, 13, 69, 43, 20,
-			17, 229, 188, 162, 139, 225, 174, 8, 250, 125, 41, 24, 247, 40,
-			49, 236, 102, 101, 245, 233, 210, 74, 101, 249, 102, 169, 118, 117,
-			227, 122, 121, 181, 190, 57, 173, 135, 39, 167, 224, 113, 61, 60,
-			24, 208, 201, 17, 246, 29, 28, 158, 195, 157, 69, 155, 231, 254,
-			203, 145, 195, 51, 132, 237, 187, 142, 208, 196, 231, 134, 165, 22,
-			245, 66, 185, 137, 23, 8, 232, 167, 217, 217, 83, 17, 13, 204,
-			188, 62, 166, 81, 167, 50, 10, 162, 102, 66, 249, 208, 145, 114,
-			234, 154, 182, 29, 227, 174, 7, 79, 97, 61, 98, 46, 191, 132,
-			38, 62, 130, 120, 70, 128, 140, 101, 128, 20, 94, 136, 40, 221,
-			86, 51, 72, 104, 227, 88, 114, 252, 39, 85, 201, 230, 206, 226,
-			216, 56, 251, 251, 72, 27, 151, 59, 87, 237, 241, 220, 207, 28,
-			73, 27, 144, 15, 63, 32, 105, 148, 20, 162, 171, 110, 54, 112,
-			47, 174, 223, 146, 171, 205, 180, 140, 106, 171, 40, 118, 215, 181,
-			100, 7, 79, 168, 146, 205, 157, 171, 163, 99, 236, 23, 176, 243,
-			30, 119, 86, 236, 177, 220, 223, 61, 186, 243, 187, 187, 123, 177,
-			84, 155, 222, 181, 239, 106, 69, 5, 114, 172, 205, 32, 61, 103,
-			0, 223, 17, 64, 150, 79, 6, 126, 221, 242, 212, 154, 216, 69,
-			8, 239, 9, 197, 101, 11, 141, 131, 112, 255, 160, 71, 224, 89,
-			178, 151, 199, 85, 201, 230, 206, 202, 200, 40, 251, 89, 27, 70,
-			144, 229, 78, 205, 190, 39, 247, 9, 91, 143, 128, 132, 251, 148,
-			178, 144, 79, 27, 184, 173, 93, 177, 215, 5, 124, 152, 160, 37,
-			58, 109, 176, 48, 223, 110, 100, 42, 6, 80, 246, 81, 101, 125,
-			54, 172, 17, 26, 195, 73, 54, 3, 218, 133, 223, 61, 16, 126,
-			191, 209, 142, 251, 126, 255, 64, 32, 12, 77, 65, 244, 253, 180,
-			97, 155, 208, 105, 48, 239, 79, 95, 31, 230, 165, 224, 215, 233,
-			226, 68, 73, 195, 47, 117, 40, 235, 132, 252, 202, 133, 136, 222,
-			6, 213, 185, 111, 82, 47, 162, 121, 34, 26, 101, 45, 73, 149,
-			49, 85, 178, 185, 83, 59, 53, 193, 126, 2, 41, 54, 196, 157,
-			15, 219, 147, 185, 119, 172, 52, 197, 12, 140, 13, 116, 138, 143,
-			40, 139, 37, 222, 76, 40, 73, 79, 48, 120, 91, 10, 222, 246,
-			54, 20, 36, 36, 32, 24, 35, 141, 139, 14, 224, 208, 154, 202,
-			254, 146, 176, 137, 20, 104, 198, 231, 176, 66, 98, 96, 69, 47,
-			125, 60, 106, 67, 163, 38, 86, 152, 191, 15, 82, 126, 11, 156,
-			151, 217, 161, 86, 48, 125, 54, 154, 25, 144, 34, 67, 150, 164,
-			193, 41, 85, 178, 185, 243, 225, 123, 79, 55, 178, 136, 223, 200,
-			94, 121, 130, 29, 58, 247, 162, 98, 179, 235, 71, 183, 232, 148,
-			60, 58, 160, 87, 229, 31, 100, 199, 224, 180, 119, 221, 143, 110,
-			241, 9, 230, 245, 252, 120, 39, 154, 180, 132, 51, 117, 172, 134,
-			133, 197, 79, 88, 236, 148, 113, 66, 84, 175, 46, 142, 232, 23,
-			213, 17, 113, 254, 240, 17, 81, 119, 5, 147, 227, 64, 6, 122,
-			236, 150, 236, 85, 175, 241, 151, 150, 245, 5, 219, 185, 186, 182,
-			248, 203, 246, 57, 60, 239, 21, 215, 148, 206, 247, 76, 208, 233,
-			60, 37, 95, 168, 203, 119, 63, 244, 249, 203, 108, 136, 123, 231,
-			50, 127, 110, 89, 236, 183, 78, 192, 185, 241, 92, 134, 207, 255,
-			250, 9, 29, 233, 165, 194, 188, 196, 108, 18, 155, 6, 137, 83,
-			192, 123, 134, 84, 27, 52, 174, 177, 212, 97, 243, 162, 62, 108,
-			86, 186, 205, 162, 0, 164, 127, 248, 45, 210, 17, 111, 69, 246,
-			253, 166, 245, 102, 76, 212, 2, 125, 228, 2, 95, 43, 74, 81,
-			212, 86, 88, 175, 240, 164, 209, 238, 202, 165, 39, 251, 165, 110,
-			16, 33, 189, 43, 248, 210, 49, 177, 27, 182, 52, 214, 77, 1,
-			175, 156, 53, 106, 165, 22, 189, 137, 100, 211, 62, 163, 77, 41,
-			113, 53, 246, 27, 132, 125, 45, 208, 209, 116, 102, 160, 99, 209,
-			160, 83, 48, 108, 64, 253, 64, 103, 248, 69, 111, 134, 166, 162,
-			152, 100, 214, 24, 130, 128, 97, 249, 201, 85, 142, 119, 142, 201,
-			23, 41, 105, 103, 210, 157, 86, 59, 106, 118, 252, 246, 110, 128,
-			183, 82, 71, 117, 162, 221, 53, 105, 161, 58, 65, 65, 44, 73,
-			63, 88, 210, 145, 191, 86, 63, 84, 92, 203, 128, 251, 177, 223,
-			109, 205, 73, 93, 28, 4, 224, 174, 31, 3, 82, 180, 177, 203,
-			41, 195, 128, 113, 222, 86, 126, 179, 114, 80, 171, 116, 40, 142,
-			141, 64, 24, 147, 183, 186, 97, 242, 91, 68, 22, 108, 72, 102,
-			128, 77, 133, 125, 173, 233, 129, 87, 41, 64, 203, 182, 194, 126,
-			20, 80, 64, 207, 110, 24, 7, 20, 216, 19, 71, 169, 196, 159,
-			228, 16, 162, 236, 1, 218, 27, 83, 159, 60, 123, 125, 169, 147,
-			239, 247, 37, 239, 116, 141, 195, 54, 160, 75, 93, 171, 172, 139,
-			245, 234, 149, 250, 51, 165, 90, 89, 84, 214, 197, 90, 173, 250,
-			116, 101, 185, 188, 44, 22, 111, 136, 250, 181, 178, 88, 170, 174,
-			221, 168, 85, 174, 94, 171, 139, 107, 213, 149, 229, 114, 109, 93,
-			148, 86, 151, 197, 82, 117, 181, 94, 171, 44, 110, 212, 171, 181,
-			117, 166, 173, 9, 242, 151, 210, 234, 13, 81, 254, 240, 90, 173,
-			188, 14, 38, 132, 202, 245, 181, 149, 74, 121, 217, 48, 44, 20,
-			68, 101, 117, 105, 101, 99, 185, 178, 122, 181, 32, 22, 55, 234,
-			98, 181, 90, 103, 98, 165, 114, 189, 82, 47, 47, 139, 122, 181,
-			0, 159, 61, 252, 158, 168, 94, 17, 215, 203, 181, 165, 107, 165,
-			213, 122, 105, 177, 178, 82, 169, 223, 128, 15, 94, 169, 212, 87,
-			229, 199, 174, 84, 107, 76, 148, 196, 90, 169, 86, 175, 44, 109,
-			172, 148, 106, 98, 109, 163, 182, 86, 93, 47, 11, 57, 178, 229,
-			202, 250, 210, 74, 169, 114, 189, 188, 92, 20, 149, 85, 177, 90,
-			21, 229, 167, 203, 171, 117, 177, 126, 173, 180, 178, 146, 30, 40,
-			19, 213, 103, 86, 203, 53, 50, 128, 232, 97, 138, 197, 178, 88,
-			169, 200, 179, 161, 252, 20, 140, 115, 185, 82, 43, 47, 213, 229,
-			128, 146, 191, 150, 42, 203, 229, 213, 122, 105, 165, 192, 4, 152,
-			17, 75, 43, 5, 81, 254, 112, 249, 250, 218, 74, 169, 118, 163,
-			64, 141, 174, 151, 127, 108, 163, 188, 90, 175, 148, 86, 196, 114,
-			233, 122, 233, 106, 121, 93, 76, 189, 27, 85, 214, 106, 213, 165,
-			141, 90, 89, 42, 194, 146, 20, 235, 27, 139, 235, 245, 74, 125,
-			163, 94, 22, 87, 171, 213, 101, 32, 246, 122, 185, 246, 116, 101,
-			169, 188, 126, 89, 172, 84, 215, 129, 96, 27, 235, 229, 2, 19,
-			203, 165, 122, 9, 62, 189, 86, 171, 94, 169, 212, 215, 47, 203,
-			191, 23, 55, 214, 43, 64, 184, 202, 106, 189, 92, 171, 109, 128,
-			145, 115, 90, 92, 171, 62, 83, 126, 186, 92, 19, 75, 165, 141,
-			245, 242, 50, 80, 184, 186, 42, 71, 43, 121, 165, 92, 173, 221,
-			144, 205, 74, 58, 192, 12, 20, 196, 51, 215, 202, 245, 107, 229,
-			154, 36, 42, 80, 171, 36, 201, 176, 94, 175, 85, 150, 234, 102,
-			181, 106, 77, 212, 171, 181, 58, 51, 198, 41, 86, 203, 87, 87,
-			42, 87, 203, 171, 75, 101, 249, 115, 85, 54, 243, 76, 101, 189,
-			60, 45, 74, 181, 202, 186, 172, 80, 129, 15, 139, 103, 74, 55,
-			228, 73, 94, 126, 88, 78, 212, 198, 122, 153, 225, 223, 6, 235,
-			22, 96, 62, 69, 229, 138, 40, 45, 63, 93, 145, 61, 167, 218,
-			107, 213, 245, 245, 10, 177, 11, 144, 109, 233, 26, 209, 92, 219,
-			145, 68, 102, 146, 12, 61, 249, 204, 101, 48, 244, 60, 140, 127,
-			226, 195, 135, 50, 5, 101, 18, 146, 127, 226, 195, 243, 153, 57,
-			101, 60, 146, 127, 226, 195, 135, 19, 51, 211, 195, 218, 204, 244,
-			158, 204, 131, 202, 120, 36, 255, 196, 135, 23, 50, 21, 101, 165,
-			146, 127, 226, 195, 169, 204, 3, 240, 240, 1, 252, 243, 87, 10,
-			204, 118, 51, 60, 251, 109, 75, 110, 125, 185, 95, 40, 136, 77,
-			189, 243, 110, 14, 70, 207, 161, 239, 44, 164, 160, 211, 182, 38,
-			216, 198, 11, 194, 176, 37, 168, 141, 0, 126, 89, 16, 249, 173,
-			162, 159, 31, 124, 210, 40, 182, 242, 140, 137, 107, 65, 63, 16,
-			155, 91, 3, 31, 210, 135, 28, 184, 181, 3, 167, 71, 141, 255,
-			183, 233, 111, 130, 220, 221, 108, 108, 50, 227, 140, 107, 194, 241,
-			109, 133, 123, 93, 120, 125, 115, 107, 19, 143, 10, 155, 173, 77,
-			221, 172, 254, 21, 179, 88, 168, 91, 76, 89, 187, 216, 216, 132,
-			0, 16, 210, 223, 163, 91, 120, 84, 86, 162, 18, 69, 221, 65,
-			202, 145, 88, 97, 116, 203, 29, 82, 223, 113, 176, 84, 8, 165,
-			47, 182, 101, 221, 158, 78, 71, 211, 167, 93, 151, 126, 238, 138,
-			189, 94, 75, 30, 5, 116, 149, 226, 64, 31, 58, 81, 168, 34,
-			80, 40, 44, 246, 67, 235, 213, 85, 141, 139, 45, 166, 162, 32,
-			64, 88, 120, 8, 82, 120, 136, 222, 190, 14, 111, 183, 187, 82,
-			131, 249, 40, 102, 122, 136, 24, 195, 100, 102, 123, 81, 226, 50,
-			2, 46, 49, 47, 198, 104, 32, 234, 233, 186, 5, 60, 13, 194,
-			97, 84, 211, 41, 236, 51, 19, 143, 17, 49, 99, 59, 113, 208,
-			79, 194, 89, 209, 6, 131, 151, 192, 73, 34, 255, 48, 210, 128,
-			230, 126, 196, 18, 67, 131, 158, 60, 169, 70, 166, 157, 83, 73,
-			95, 150, 191, 168, 90, 189, 126, 0, 238, 245, 204, 244, 175, 71,
-			132, 222, 150, 138, 190, 24, 232, 177, 153, 27, 10, 185, 112, 43,
-			73, 25, 226, 139, 5, 49, 63, 175, 74, 13, 51, 151, 72, 75,
-			44, 40, 251, 159, 252, 231, 69, 89, 83, 21, 95, 82, 127, 28,
-			200, 74, 143, 164, 146, 130, 124, 124, 65, 188, 159, 169, 12, 27,
-			112, 150, 214, 106, 185, 162, 134, 222, 51, 201, 210, 36, 215, 14,
-			209, 230, 197, 194, 1, 112, 236, 199, 25, 28, 229, 219, 253, 1,
-			173, 31, 146, 103, 132, 102, 180, 60, 114, 56, 229, 240, 214, 230,
-			30, 184, 47, 99, 116, 238, 157, 94, 96, 63, 248, 208, 95, 82,
-			163, 147, 251, 222, 96, 108, 139, 138, 35, 232, 132, 251, 144, 142,
-			11, 174, 58, 72, 89, 132, 171, 189, 94, 24, 181, 181, 153, 154,
-			161, 0, 32, 131, 178, 100, 213, 138, 100, 57, 45, 111, 232, 230,
-			83, 53, 75, 18, 1, 143, 68, 169, 53, 68, 48, 160, 201, 154,
-			82, 32, 205, 148, 147, 146, 136, 57, 229, 163, 229, 202, 252, 194,
-			150, 81, 129, 137, 29, 191, 133, 192, 157, 154, 31, 117, 152, 79,
-			202, 32, 138, 76, 168, 227, 2, 20, 48, 107, 187, 131, 55, 41,
-			7, 52, 39, 76, 196, 97, 111, 182, 35, 53, 248, 67, 140, 8,
-			104, 248, 77, 159, 18, 219, 138, 90, 121, 189, 126, 228, 160, 140,
-			239, 169, 81, 181, 218, 253, 0, 125, 82, 67, 178, 119, 97, 211,
-			202, 66, 52, 208, 44, 35, 93, 53, 213, 176, 90, 70, 8, 184,
-			96, 208, 11, 220, 205, 228, 73, 245, 133, 118, 107, 207, 239, 36,
-			104, 182, 218, 169, 142, 68, 153, 50, 83, 160, 145, 224, 136, 209,
-			144, 108, 34, 120, 17, 70, 74, 174, 17, 26, 100, 234, 158, 144,
-			84, 177, 31, 36, 93, 83, 93, 210, 185, 35, 155, 29, 76, 18,
-			174, 20, 104, 88, 225, 219, 8, 194, 10, 106, 114, 27, 84, 84,
-			35, 90, 167, 171, 228, 79, 81, 136, 74, 151, 129, 245, 65, 246,
-			145, 18, 25, 160, 153, 56, 188, 205, 176, 230, 212, 31, 145, 105,
-			252, 102, 137, 185, 89, 174, 210, 210, 90, 37, 58, 82, 196, 110,
-			160, 8, 175, 42, 154, 71, 176, 92, 140, 185, 108, 31, 22, 243,
-			145, 230, 58, 21, 104, 64, 124, 171, 128, 153, 17, 231, 197, 36,
-			61, 100, 118, 15, 201, 249, 86, 82, 18, 154, 36, 27, 128, 20,
-			188, 169, 174, 107, 41, 156, 152, 221, 83, 137, 11, 18, 129, 124,
-			180, 60, 70, 123, 106, 39, 80, 97, 69, 48, 161, 145, 216, 235,
-			198, 225, 94, 115, 71, 126, 22, 214, 175, 238, 29, 56, 140, 71,
-			106, 103, 9, 25, 65, 12, 52, 240, 109, 234, 43, 117, 161, 160,
-			247, 10, 180, 79, 70, 3, 221, 243, 59, 29, 189, 191, 163, 236,
-			124, 193, 220, 100, 96, 187, 80, 2, 228, 176, 84, 74, 134, 131,
-			120, 194, 135, 104, 95, 48, 45, 253, 24, 176, 214, 8, 192, 147,
-			85, 229, 182, 5, 150, 121, 177, 29, 197, 132, 34, 144, 250, 66,
-			10, 135, 71, 141, 191, 152, 200, 13, 118, 100, 175, 16, 67, 157,
-			132, 37, 181, 113, 88, 74, 138, 77, 144, 146, 155, 131, 98, 114,
-			96, 219, 61, 52, 99, 135, 90, 50, 109, 145, 209, 45, 162, 4,
-			59, 130, 20, 177, 212, 8, 52, 61, 244, 94, 179, 27, 244, 183,
-			149, 243, 121, 138, 26, 70, 79, 216, 237, 104, 49, 24, 72, 139,
-			151, 70, 70, 77, 106, 224, 136, 77, 57, 189, 23, 165, 119, 225,
-			163, 54, 225, 230, 130, 120, 246, 210, 115, 198, 54, 133, 142, 183,
-			52, 206, 239, 227, 59, 23, 143, 108, 122, 222, 104, 26, 40, 213,
-			62, 68, 218, 54, 234, 22, 164, 222, 62, 43, 245, 219, 124, 65,
-			170, 185, 205, 252, 115, 234, 173, 120, 64, 33, 104, 124, 127, 93,
-			186, 211, 216, 11, 98, 62, 53, 252, 163, 16, 123, 148, 185, 86,
-			193, 80, 72, 217, 240, 66, 208, 239, 163, 9, 183, 29, 105, 99,
-			166, 41, 239, 88, 194, 196, 112, 217, 102, 230, 245, 2, 57, 88,
-			49, 160, 197, 229, 110, 173, 55, 204, 11, 81, 98, 104, 76, 41,
-			43, 6, 229, 246, 34, 149, 173, 210, 216, 5, 186, 173, 35, 116,
-			156, 196, 54, 138, 74, 32, 25, 75, 18, 102, 19, 215, 130, 110,
-			51, 40, 164, 160, 206, 169, 63, 137, 58, 16, 154, 194, 170, 160,
-			41, 226, 171, 175, 96, 122, 49, 240, 207, 208, 23, 75, 134, 37,
-			109, 160, 53, 163, 215, 112, 43, 218, 10, 25, 105, 45, 170, 89,
-			28, 80, 164, 165, 96, 139, 82, 55, 169, 5, 157, 98, 160, 148,
-			198, 19, 42, 238, 69, 122, 29, 165, 231, 176, 35, 21, 29, 163,
-			201, 148, 166, 3, 145, 45, 135, 20, 29, 19, 70, 95, 235, 215,
-			112, 207, 3, 231, 188, 230, 78, 176, 235, 139, 224, 133, 176, 179,
-			167, 228, 4, 221, 20, 234, 184, 72, 150, 28, 128, 2, 209, 4,
-			20, 159, 68, 75, 186, 213, 133, 219, 37, 12, 165, 138, 21, 22,
-			13, 252, 180, 213, 238, 116, 72, 180, 48, 243, 10, 207, 176, 110,
-			39, 92, 208, 238, 235, 44, 246, 58, 216, 163, 45, 183, 161, 125,
-			191, 27, 155, 91, 116, 193, 244, 132, 48, 239, 42, 84, 46, 1,
-			95, 245, 17, 244, 68, 8, 249, 161, 67, 29, 75, 17, 175, 64,
-			54, 48, 112, 112, 86, 215, 99, 104, 223, 39, 108, 119, 80, 64,
-			82, 234, 219, 64, 212, 230, 33, 22, 34, 228, 131, 86, 202, 55,
-			55, 189, 39, 38, 42, 23, 18, 195, 72, 192, 25, 116, 91, 10,
-			214, 220, 212, 123, 111, 181, 187, 45, 80, 197, 142, 86, 117, 142,
-			16, 84, 44, 185, 215, 71, 21, 98, 39, 196, 155, 251, 228, 244,
-			6, 10, 206, 67, 98, 41, 236, 70, 237, 150, 214, 85, 228, 246,
-			1, 192, 96, 160, 98, 226, 1, 7, 202, 178, 15, 20, 4, 60,
-			184, 181, 144, 90, 3, 201, 124, 82, 204, 169, 215, 62, 205, 242,
-			90, 169, 190, 116, 205, 132, 3, 91, 219, 168, 167, 22, 115, 228,
-			199, 237, 104, 235, 0, 191, 24, 5, 187, 126, 55, 110, 55, 35,
-			38, 166, 100, 69, 176, 229, 166, 32, 221, 225, 68, 181, 215, 233,
-			80, 143, 34, 58, 23, 195, 177, 185, 172, 142, 205, 225, 150, 169,
-			197, 161, 52, 147, 53, 10, 135, 214, 102, 146, 243, 42, 9, 160,
-			33, 175, 24, 12, 182, 196, 115, 13, 6, 139, 152, 17, 44, 16,
-			121, 180, 235, 23, 241, 67, 152, 219, 21, 176, 172, 48, 238, 37,
-			222, 1, 141, 78, 103, 79, 146, 42, 219, 28, 68, 133, 72, 29,
-			161, 63, 219, 244, 119, 131, 142, 124, 137, 76, 238, 228, 100, 16,
-			17, 19, 66, 216, 53, 237, 175, 77, 154, 173, 1, 131, 116, 194,
-			66, 90, 79, 214, 231, 96, 245, 219, 90, 63, 4, 79, 53, 189,
-			245, 108, 68, 65, 31, 111, 148, 204, 204, 152, 107, 59, 242, 92,
-			217, 131, 255, 30, 74, 133, 169, 218, 130, 87, 117, 67, 68, 35,
-			138, 25, 187, 153, 4, 51, 166, 127, 247, 91, 45, 112, 241, 50,
-			91, 101, 58, 121, 88, 122, 54, 228, 204, 110, 82, 143, 55, 49,
-			31, 2, 192, 105, 68, 144, 188, 82, 15, 77, 86, 213, 221, 80,
-			102, 39, 136, 85, 51, 59, 147, 31, 172, 1, 163, 203, 167, 250,
-			128, 28, 161, 93, 165, 21, 75, 12, 100, 214, 2, 201, 174, 190,
-			174, 63, 44, 43, 15, 124, 119, 213, 223, 13, 10, 131, 159, 73,
-			31, 39, 164, 200, 172, 118, 3, 197, 158, 209, 128, 73, 10, 162,
-			185, 140, 93, 40, 148, 85, 35, 68, 19, 1, 207, 10, 76, 17,
-			173, 50, 19, 45, 25, 140, 193, 14, 115, 198, 32, 51, 172, 3,
-			63, 81, 198, 234, 100, 36, 240, 17, 17, 7, 81, 124, 19, 255,
-			52, 244, 148, 180, 127, 216, 163, 70, 42, 213, 245, 189, 134, 106,
-			41, 218, 107, 220, 84, 223, 120, 66, 124, 224, 242, 17, 230, 133,
-			122, 90, 86, 97, 146, 188, 59, 207, 168, 49, 137, 178, 133, 106,
-			255, 206, 213, 141, 78, 24, 111, 37, 187, 32, 141, 242, 160, 167,
-			110, 80, 166, 242, 201, 144, 243, 40, 158, 1, 105, 51, 10, 166,
-			85, 214, 111, 37, 114, 218, 93, 50, 111, 144, 248, 76, 102, 84,
-			60, 109, 36, 148, 160, 113, 14, 104, 104, 148, 229, 71, 30, 135,
-			8, 204, 17, 37, 231, 14, 72, 156, 196, 124, 129, 121, 250, 140,
-			51, 8, 211, 155, 5, 89, 31, 33, 121, 5, 138, 114, 237, 0,
-			145, 50, 212, 74, 238, 162, 36, 53, 242, 52, 112, 216, 255, 37,
-			217, 238, 48, 15, 139, 60, 105, 203, 221, 118, 215, 239, 245, 232,
-			70, 253, 56, 115, 0, 151, 241, 219, 214, 240, 56, 43, 48, 23,
-			81, 25, 223, 177, 236, 179, 185, 115, 48, 60, 211, 58, 138, 115,
-			161, 72, 115, 146, 121, 46, 134, 17, 188, 99, 217, 186, 232, 201,
-			34, 193, 46, 97, 8, 193, 59, 22, 63, 173, 138, 142, 44, 230,
-			206, 232, 203, 232, 127, 243, 36, 123, 255, 118, 88, 108, 238, 244,
-			195, 221, 246, 222, 46, 186, 190, 238, 53, 219, 115, 254, 94, 188,
-			115, 147, 54, 126, 240, 21, 238, 247, 154, 189, 198, 28, 224, 50,
-			68, 116, 73, 125, 66, 86, 42, 82, 165, 220, 187, 121, 126, 231,
-			206, 12, 222, 122, 195, 45, 58, 253, 248, 174, 87, 226, 186, 253,
-			228, 166, 90, 185, 26, 98, 133, 124, 153, 241, 149, 118, 20, 95,
-			133, 46, 214, 200, 176, 195, 231, 88, 22, 59, 13, 151, 229, 199,
-			231, 79, 23, 205, 94, 23, 75, 123, 241, 14, 188, 81, 163, 106,
-			249, 135, 217, 232, 213, 0, 91, 169, 33, 67, 112, 206, 92, 201,
-			196, 147, 150, 176, 166, 142, 213, 224, 239, 252, 53, 198, 151, 192,
-			239, 36, 85, 115, 158, 121, 208, 12, 84, 189, 253, 199, 22, 157,
-			127, 87, 178, 107, 88, 53, 255, 9, 139, 113, 52, 122, 252, 117,
-			155, 226, 151, 217, 113, 220, 166, 129, 112, 147, 54, 188, 153, 59,
-			218, 17, 93, 174, 130, 26, 195, 234, 242, 239, 124, 137, 241, 229,
-			160, 19, 12, 116, 227, 180, 57, 118, 252, 24, 60, 144, 68, 9,
-			98, 127, 27, 62, 114, 172, 6, 127, 231, 95, 181, 217, 49, 221,
-			179, 163, 200, 198, 39, 217, 208, 110, 0, 33, 212, 147, 54, 56,
-			47, 168, 34, 159, 96, 222, 118, 39, 108, 68, 147, 14, 58, 53,
-			64, 129, 223, 203, 178, 221, 32, 138, 131, 214, 164, 11, 143, 169,
-			196, 5, 59, 174, 130, 5, 218, 97, 119, 210, 131, 79, 152, 143,
-			228, 155, 225, 126, 87, 126, 40, 11, 63, 82, 137, 127, 128, 49,
-			66, 141, 188, 25, 71, 147, 67, 183, 33, 145, 246, 99, 173, 29,
-			163, 218, 245, 136, 223, 159, 188, 218, 56, 152, 28, 134, 102, 213,
-			207, 139, 7, 154, 34, 77, 131, 34, 79, 49, 126, 53, 136, 215,
-			247, 26, 16, 59, 168, 136, 250, 62, 118, 172, 215, 111, 119, 155,
-			237, 158, 223, 57, 122, 126, 215, 212, 207, 181, 164, 102, 254, 81,
-			54, 172, 90, 226, 83, 204, 235, 134, 173, 64, 177, 53, 79, 191,
-			190, 26, 182, 130, 26, 86, 200, 175, 177, 99, 186, 53, 62, 199,
-			92, 169, 101, 194, 71, 71, 230, 207, 220, 230, 163, 79, 181, 187,
-			173, 26, 84, 212, 147, 104, 27, 188, 255, 60, 115, 229, 7, 126,
-			192, 97, 240, 7, 216, 113, 37, 80, 37, 29, 37, 31, 120, 53,
-			166, 30, 45, 30, 204, 212, 216, 201, 84, 87, 248, 57, 150, 91,
-			171, 85, 86, 151, 42, 107, 165, 149, 155, 79, 85, 86, 151, 15,
-			7, 125, 192, 53, 110, 165, 126, 99, 204, 226, 199, 152, 119, 181,
-			86, 221, 88, 27, 179, 249, 48, 115, 175, 174, 84, 23, 199, 156,
-			249, 95, 117, 88, 22, 69, 3, 191, 194, 88, 34, 40, 248, 189,
-			135, 230, 190, 44, 229, 82, 78, 164, 71, 114, 132, 104, 89, 100,
-			195, 74, 82, 240, 251, 211, 181, 7, 36, 72, 238, 118, 171, 151,
-			95, 99, 199, 13, 49, 194, 7, 62, 122, 88, 194, 220, 177, 37,
-			67, 138, 12, 182, 116, 88, 192, 220, 190, 165, 171, 236, 184, 33,
-			8, 6, 91, 58, 44, 35, 114, 183, 33, 161, 108, 200, 96, 254,
-			193, 134, 14, 175, 139, 220, 189, 233, 26, 234, 231, 197, 217, 143,
-			188, 247, 251, 216, 165, 62, 244, 55, 31, 97, 67, 220, 27, 201,
-			252, 188, 101, 177, 127, 154, 68, 174, 124, 37, 29, 185, 50, 127,
-			9, 54, 216, 149, 141, 165, 138, 40, 65, 86, 67, 80, 56, 254,
-			143, 11, 96, 249, 127, 17, 44, 255, 27, 35, 88, 238, 161, 187,
-			127, 158, 89, 84, 14, 1, 244, 167, 147, 225, 206, 68, 102, 26,
-			254, 180, 184, 115, 79, 230, 97, 248, 211, 230, 206, 189, 153, 25,
-			248, 211, 225, 206, 233, 204, 52, 123, 146, 217, 217, 12, 119, 115,
-			153, 139, 86, 238, 81, 129, 50, 69, 219, 88, 232, 98, 51, 210,
-			192, 222, 224, 94, 40, 143, 173, 129, 32, 229, 11, 252, 231, 179,
-			25, 139, 59, 185, 225, 17, 246, 37, 139, 185, 89, 112, 160, 63,
-			103, 151, 115, 127, 207, 18, 137, 160, 74, 60, 45, 59, 29, 12,
-			64, 196, 199, 58, 127, 164, 145, 243, 84, 175, 2, 213, 19, 6,
-			30, 121, 80, 1, 239, 68, 232, 229, 65, 167, 73, 184, 83, 236,
-			244, 118, 252, 70, 16, 3, 234, 27, 26, 26, 16, 22, 5, 205,
-			44, 237, 62, 19, 149, 101, 116, 143, 204, 162, 171, 254, 185, 236,
-			152, 42, 217, 220, 57, 55, 254, 30, 85, 114, 184, 115, 238, 210,
-			18, 123, 26, 6, 101, 113, 231, 65, 251, 209, 92, 69, 40, 113,
-			170, 71, 116, 24, 24, 20, 97, 159, 212, 213, 27, 116, 214, 52,
-			149, 3, 124, 143, 234, 129, 37, 27, 206, 142, 168, 146, 205, 157,
-			7, 71, 207, 169, 146, 195, 157, 7, 167, 231, 217, 28, 244, 192,
-			230, 206, 121, 123, 33, 151, 23, 134, 36, 38, 127, 99, 112, 96,
-			13, 246, 241, 83, 186, 105, 217, 231, 243, 217, 113, 85, 146, 239,
-			243, 243, 170, 228, 112, 231, 252, 220, 251, 217, 251, 160, 105, 7,
-			163, 144, 166, 132, 33, 154, 149, 145, 5, 237, 17, 116, 71, 144,
-			254, 128, 228, 173, 11, 250, 3, 146, 189, 46, 232, 15, 72, 14,
-			187, 48, 247, 126, 246, 94, 248, 128, 203, 157, 25, 251, 74, 238,
-			156, 48, 36, 182, 104, 193, 223, 178, 239, 233, 102, 93, 139, 59,
-			51, 186, 89, 215, 230, 206, 140, 110, 214, 117, 184, 51, 51, 183,
-			204, 254, 61, 178, 154, 199, 157, 57, 251, 3, 185, 127, 97, 9,
-			67, 130, 27, 94, 189, 250, 145, 114, 108, 59, 60, 89, 196, 75,
-			104, 247, 84, 121, 242, 125, 161, 85, 4, 49, 213, 11, 250, 59,
-			126, 15, 29, 127, 233, 202, 54, 236, 139, 23, 218, 190, 0, 189,
-			112, 186, 136, 14, 47, 230, 59, 237, 136, 41, 57, 225, 43, 68,
-			213, 248, 160, 160, 134, 10, 114, 13, 222, 70, 47, 143, 148, 134,
-			1, 225, 37, 211, 154, 26, 158, 197, 157, 57, 77, 13, 207, 230,
-			206, 156, 166, 134, 231, 112, 103, 110, 238, 49, 86, 5, 111, 31,
-			247, 145, 204, 251, 173, 220, 146, 56, 172, 30, 128, 63, 69, 122,
-			225, 117, 218, 81, 60, 184, 220, 214, 105, 175, 131, 85, 45, 143,
-			130, 206, 35, 195, 57, 204, 217, 8, 171, 250, 49, 91, 228, 62,
-			143, 171, 90, 25, 162, 73, 14, 164, 174, 12, 0, 90, 198, 188,
-			209, 22, 29, 185, 205, 237, 7, 242, 191, 8, 113, 200, 132, 222,
-			226, 41, 144, 131, 156, 40, 192, 222, 183, 27, 196, 190, 92, 241,
-			5, 1, 240, 154, 232, 5, 4, 154, 249, 78, 187, 135, 215, 224,
-			58, 223, 43, 221, 241, 117, 130, 173, 24, 61, 174, 145, 112, 120,
-			26, 85, 97, 44, 178, 148, 229, 206, 99, 199, 39, 84, 201, 226,
-			206, 99, 247, 156, 81, 37, 135, 59, 143, 157, 123, 64, 74, 67,
-			215, 226, 238, 229, 204, 19, 32, 13, 211, 122, 19, 96, 62, 26,
-			238, 66, 135, 215, 56, 209, 77, 174, 232, 203, 195, 167, 217, 121,
-			230, 66, 6, 110, 231, 113, 155, 231, 79, 139, 160, 184, 189, 32,
-			242, 126, 107, 183, 221, 149, 27, 18, 128, 213, 230, 177, 119, 150,
-			60, 42, 59, 143, 219, 195, 170, 100, 113, 231, 241, 99, 39, 85,
-			201, 225, 206, 227, 99, 227, 236, 10, 147, 107, 193, 125, 50, 83,
-			182, 114, 11, 226, 176, 58, 166, 3, 41, 208, 108, 47, 127, 215,
-			151, 143, 166, 112, 144, 125, 148, 162, 225, 201, 225, 28, 251, 15,
-			114, 110, 109, 217, 201, 101, 187, 148, 251, 125, 11, 80, 71, 218,
-			29, 125, 149, 140, 220, 170, 227, 26, 224, 46, 212, 188, 128, 80,
-			18, 88, 57, 136, 210, 5, 79, 55, 216, 103, 248, 238, 130, 186,
-			87, 211, 6, 13, 125, 57, 126, 164, 147, 149, 240, 247, 226, 80,
-			174, 207, 38, 70, 10, 36, 113, 234, 136, 222, 67, 39, 156, 57,
-			237, 107, 165, 79, 222, 209, 180, 130, 126, 82, 157, 162, 232, 53,
-			98, 8, 27, 88, 96, 25, 163, 43, 100, 201, 226, 206, 242, 137,
-			113, 85, 114, 184, 179, 60, 113, 143, 42, 13, 115, 103, 249, 222,
-			39, 217, 40, 27, 134, 210, 231, 134, 37, 133, 38, 127, 148, 85,
-			24, 32, 116, 92, 203, 84, 173, 220, 19, 226, 176, 34, 155, 204,
-			65, 98, 55, 39, 0, 138, 65, 17, 42, 167, 65, 10, 208, 107,
-			195, 57, 246, 49, 230, 186, 142, 156, 133, 167, 236, 82, 174, 117,
-			219, 73, 192, 6, 141, 45, 240, 66, 36, 46, 200, 221, 228, 66,
-			114, 27, 77, 110, 109, 26, 193, 252, 224, 200, 38, 144, 36, 14,
-			144, 228, 41, 34, 137, 3, 36, 121, 138, 72, 226, 0, 73, 158,
-			34, 146, 56, 64, 146, 167, 136, 36, 142, 34, 201, 83, 147, 63,
-			202, 46, 66, 223, 45, 238, 172, 218, 133, 220, 67, 136, 246, 75,
-			2, 66, 205, 112, 202, 169, 65, 125, 218, 202, 202, 87, 206, 168,
-			146, 108, 224, 236, 5, 85, 114, 184, 179, 58, 243, 94, 182, 200,
-			0, 67, 165, 150, 249, 136, 149, 251, 17, 113, 88, 219, 79, 179,
-			60, 236, 39, 154, 229, 77, 58, 203, 29, 165, 54, 156, 147, 155,
-			145, 235, 74, 58, 215, 237, 39, 114, 231, 196, 170, 145, 116, 64,
-			83, 8, 119, 37, 234, 166, 11, 43, 179, 78, 43, 211, 5, 10,
-			213, 105, 101, 186, 64, 161, 250, 216, 184, 42, 13, 115, 167, 206,
-			31, 7, 10, 185, 138, 66, 245, 137, 5, 246, 21, 11, 62, 107,
-			113, 231, 134, 205, 115, 255, 0, 49, 234, 53, 104, 86, 236, 111,
-			167, 250, 80, 196, 123, 196, 46, 254, 98, 198, 74, 249, 221, 150,
-			1, 98, 234, 199, 77, 212, 213, 111, 219, 82, 129, 37, 36, 81,
-			107, 2, 16, 174, 168, 45, 191, 43, 74, 139, 213, 90, 189, 188,
-			76, 198, 193, 67, 65, 40, 138, 8, 150, 39, 251, 174, 136, 32,
-			231, 234, 134, 38, 130, 156, 171, 27, 99, 227, 236, 81, 6, 240,
-			54, 207, 101, 122, 86, 110, 202, 16, 239, 132, 155, 122, 7, 129,
-			41, 119, 184, 231, 134, 199, 217, 52, 115, 93, 79, 206, 206, 243,
-			54, 207, 159, 85, 2, 115, 47, 222, 153, 37, 61, 112, 22, 51,
-			215, 146, 212, 244, 96, 110, 158, 167, 110, 121, 48, 55, 207, 83,
-			183, 60, 152, 155, 231, 199, 198, 217, 12, 52, 106, 113, 231, 166,
-			125, 46, 127, 63, 54, 250, 44, 216, 238, 23, 226, 39, 233, 158,
-			165, 216, 12, 119, 243, 207, 169, 86, 45, 87, 86, 214, 37, 143,
-			59, 55, 143, 143, 171, 146, 108, 136, 223, 167, 74, 14, 119, 110,
-			158, 189, 159, 58, 110, 115, 103, 211, 62, 171, 58, 78, 223, 152,
-			73, 125, 67, 53, 42, 245, 161, 77, 253, 9, 169, 197, 108, 234,
-			79, 200, 190, 110, 242, 211, 170, 228, 112, 103, 51, 119, 134, 45,
-			192, 39, 28, 238, 248, 246, 253, 249, 89, 245, 9, 31, 49, 176,
-			102, 129, 154, 179, 23, 243, 5, 49, 240, 232, 82, 50, 44, 199,
-			149, 47, 235, 146, 199, 29, 95, 127, 83, 202, 33, 159, 79, 170,
-			146, 252, 204, 153, 179, 176, 178, 61, 217, 213, 134, 125, 95, 254,
-			33, 154, 15, 8, 129, 194, 197, 162, 164, 141, 60, 228, 20, 139,
-			69, 61, 58, 215, 147, 175, 168, 105, 145, 107, 175, 113, 108, 66,
-			149, 28, 238, 52, 78, 79, 194, 230, 232, 201, 129, 55, 237, 137,
-			59, 111, 142, 158, 237, 65, 53, 213, 158, 228, 150, 230, 177, 81,
-			85, 114, 184, 211, 228, 167, 88, 17, 218, 203, 114, 167, 101, 191,
-			55, 255, 32, 181, 119, 233, 3, 143, 205, 207, 94, 188, 52, 123,
-			241, 82, 253, 210, 197, 133, 139, 23, 23, 230, 47, 22, 47, 206,
-			95, 250, 136, 110, 57, 11, 47, 156, 81, 37, 139, 59, 173, 179,
-			239, 81, 37, 135, 59, 173, 233, 25, 144, 25, 158, 61, 196, 157,
-			192, 158, 204, 159, 163, 150, 145, 127, 130, 40, 62, 114, 122, 135,
-			60, 89, 91, 117, 120, 200, 226, 78, 112, 236, 148, 42, 57, 220,
-			9, 238, 61, 205, 126, 195, 133, 118, 135, 185, 19, 218, 167, 114,
-			255, 216, 21, 165, 174, 8, 123, 254, 199, 246, 244, 29, 36, 221,
-			224, 39, 193, 102, 144, 178, 43, 50, 110, 161, 113, 26, 26, 1,
-			164, 94, 108, 181, 227, 36, 240, 54, 113, 44, 237, 106, 175, 11,
-			186, 36, 79, 7, 163, 130, 11, 132, 145, 181, 162, 27, 139, 134,
-			223, 188, 197, 116, 26, 87, 12, 58, 149, 83, 76, 219, 25, 72,
-			33, 144, 145, 137, 244, 77, 229, 162, 239, 135, 113, 208, 140, 17,
-			12, 59, 138, 33, 78, 69, 137, 40, 51, 46, 9, 101, 78, 132,
-			89, 126, 84, 152, 148, 10, 176, 111, 247, 138, 173, 224, 133, 185,
-			75, 239, 123, 84, 142, 40, 104, 238, 116, 41, 78, 58, 201, 83,
-			150, 223, 15, 252, 91, 32, 244, 242, 5, 112, 93, 72, 104, 182,
-			37, 226, 253, 48, 17, 65, 145, 134, 208, 102, 120, 219, 39, 95,
-			2, 101, 225, 0, 46, 106, 165, 56, 221, 222, 243, 251, 126, 55,
-			14, 244, 5, 122, 227, 32, 14, 102, 183, 194, 254, 44, 160, 215,
-			224, 102, 218, 244, 59, 26, 26, 142, 105, 144, 183, 228, 136, 190,
-			19, 134, 45, 177, 159, 128, 235, 136, 118, 156, 58, 124, 130, 51,
-			215, 108, 74, 117, 1, 135, 96, 200, 150, 22, 39, 153, 204, 85,
-			180, 95, 63, 0, 15, 232, 16, 194, 242, 219, 4, 174, 133, 94,
-			127, 237, 46, 133, 176, 211, 65, 65, 178, 146, 39, 121, 73, 113,
-			221, 176, 197, 157, 80, 75, 195, 97, 135, 59, 225, 24, 103, 175,
-			90, 204, 118, 179, 220, 141, 51, 47, 88, 185, 151, 83, 167, 38,
-			181, 165, 234, 83, 191, 236, 176, 62, 152, 16, 101, 41, 86, 31,
-			162, 199, 137, 9, 163, 192, 239, 75, 133, 30, 146, 129, 201, 227,
-			83, 164, 194, 214, 168, 54, 120, 250, 83, 101, 138, 160, 142, 232,
-			155, 180, 1, 200, 133, 23, 15, 231, 216, 113, 230, 186, 89, 185,
-			1, 236, 217, 57, 232, 120, 22, 20, 148, 61, 82, 80, 178, 32,
-			226, 247, 78, 220, 163, 74, 14, 119, 246, 38, 239, 99, 175, 203,
-			65, 13, 113, 247, 199, 51, 255, 191, 149, 251, 41, 220, 94, 7,
-			206, 130, 104, 92, 50, 207, 136, 181, 181, 165, 34, 221, 218, 117,
-			195, 214, 161, 188, 80, 201, 81, 142, 124, 31, 227, 48, 245, 58,
-			28, 168, 192, 213, 132, 220, 123, 251, 145, 118, 183, 193, 112, 91,
-			24, 153, 92, 251, 63, 62, 60, 6, 35, 27, 146, 35, 123, 137,
-			70, 54, 4, 199, 147, 151, 72, 30, 15, 193, 56, 95, 58, 62,
-			170, 74, 22, 119, 94, 26, 187, 71, 149, 28, 238, 188, 52, 121,
-			31, 251, 49, 128, 133, 200, 254, 132, 149, 249, 148, 37, 207, 121,
-			233, 83, 163, 9, 166, 0, 23, 138, 225, 150, 49, 138, 52, 158,
-			8, 30, 71, 241, 202, 15, 178, 104, 253, 132, 229, 113, 118, 130,
-			224, 15, 220, 151, 45, 27, 146, 163, 32, 150, 129, 44, 158, 85,
-			69, 91, 22, 31, 16, 108, 149, 192, 12, 220, 191, 101, 217, 163,
-			185, 39, 69, 73, 57, 80, 24, 123, 124, 114, 236, 5, 213, 29,
-			133, 102, 20, 238, 6, 97, 55, 72, 201, 77, 188, 64, 68, 228,
-			1, 217, 160, 46, 218, 178, 120, 114, 68, 238, 68, 0, 61, 224,
-			126, 210, 178, 79, 228, 242, 162, 68, 162, 79, 234, 190, 170, 117,
-			217, 48, 238, 122, 73, 123, 178, 131, 159, 180, 236, 33, 85, 132,
-			22, 216, 113, 118, 153, 98, 253, 221, 191, 109, 217, 199, 115, 179,
-			224, 92, 71, 157, 133, 83, 185, 217, 227, 153, 163, 187, 234, 88,
-			240, 118, 86, 21, 109, 89, 60, 198, 216, 215, 36, 51, 14, 243,
-			236, 79, 91, 153, 215, 44, 43, 247, 85, 203, 88, 67, 24, 151,
-			77, 223, 129, 5, 133, 183, 225, 169, 232, 21, 205, 186, 69, 81,
-			50, 222, 109, 250, 93, 150, 48, 169, 210, 111, 11, 6, 145, 195,
-			62, 244, 29, 5, 106, 154, 55, 0, 58, 178, 227, 39, 247, 213,
-			34, 236, 50, 128, 214, 0, 151, 149, 228, 43, 224, 245, 147, 246,
-			131, 160, 139, 225, 97, 139, 187, 63, 109, 13, 163, 66, 53, 44,
-			185, 228, 85, 203, 190, 15, 181, 157, 162, 80, 55, 30, 5, 1,
-			183, 29, 5, 113, 117, 165, 186, 136, 247, 192, 195, 128, 158, 247,
-			170, 101, 143, 170, 162, 37, 139, 148, 77, 127, 24, 238, 129, 95,
-			181, 78, 79, 178, 235, 208, 176, 197, 221, 159, 177, 108, 158, 255,
-			224, 109, 167, 160, 144, 154, 235, 2, 241, 213, 238, 197, 129, 173,
-			24, 91, 183, 60, 104, 111, 88, 21, 161, 249, 99, 39, 85, 209,
-			145, 197, 177, 113, 246, 15, 229, 172, 29, 227, 217, 55, 172, 204,
-			207, 91, 86, 238, 243, 22, 98, 216, 174, 66, 206, 25, 4, 80,
-			32, 130, 24, 135, 106, 77, 182, 34, 97, 193, 71, 137, 181, 169,
-			211, 73, 135, 202, 132, 221, 0, 91, 235, 65, 152, 56, 189, 8,
-			185, 56, 209, 161, 126, 63, 236, 183, 34, 8, 13, 79, 22, 237,
-			94, 183, 253, 177, 61, 72, 221, 105, 166, 228, 145, 205, 208, 164,
-			28, 179, 184, 251, 134, 53, 124, 130, 61, 198, 92, 247, 152, 156,
-			148, 207, 90, 118, 46, 55, 141, 105, 0, 117, 59, 166, 87, 75,
-			131, 246, 208, 46, 182, 34, 233, 112, 12, 102, 232, 179, 150, 125,
-			92, 21, 45, 89, 60, 113, 143, 42, 58, 178, 56, 121, 31, 59,
-			128, 175, 88, 220, 253, 156, 101, 63, 152, 187, 5, 61, 161, 3,
-			188, 182, 140, 41, 11, 26, 2, 141, 39, 20, 34, 18, 181, 227,
-			96, 151, 214, 1, 38, 71, 1, 74, 174, 82, 86, 33, 197, 250,
-			23, 34, 177, 9, 23, 134, 155, 74, 152, 98, 79, 44, 23, 190,
-			173, 139, 158, 44, 82, 134, 168, 99, 48, 185, 159, 179, 198, 207,
-			170, 162, 35, 139, 15, 8, 229, 96, 240, 191, 2, 0, 0, 255,
-			255, 4, 197, 88, 200, 244, 100, 1, 0},
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 189, 107, 112, 36, 73,
+			122, 24, 134, 172, 172, 110, 116, 103, 3, 141, 70, 2, 3, 244,
+			20, 48, 152, 156, 158, 217, 121, 96, 49, 13, 12, 118, 118, 119,
+			22, 179, 187, 183, 120, 244, 96, 48, 139, 1, 112, 13, 96, 119,
+			231, 110, 247, 122, 11, 221, 9, 160, 118, 27, 85, 189, 85, 213,
+			192, 226, 24, 102, 156, 73, 157, 45, 249, 76, 234, 24, 164, 47,
+			78, 244, 137, 10, 157, 207, 60, 82, 54, 131, 150, 100, 74, 113,
+			182, 204, 16, 205, 59, 74, 226, 203, 86, 80, 180, 21, 22, 21,
+			65, 134, 44, 222, 249, 33, 158, 195, 182, 130, 140, 32, 29, 231,
+			248, 242, 81, 85, 221, 0, 102, 102, 215, 36, 131, 142, 184, 249,
+			49, 232, 47, 31, 95, 126, 249, 229, 151, 153, 223, 35, 43, 147,
+			252, 34, 34, 99, 123, 158, 183, 215, 228, 211, 45, 223, 11, 189,
+			157, 246, 238, 52, 63, 104, 133, 199, 101, 1, 210, 1, 153, 89,
+			214, 153, 165, 94, 146, 170, 64, 254, 194, 191, 67, 134, 234, 222,
+			65, 185, 43, 127, 129, 136, 220, 13, 0, 55, 208, 167, 116, 246,
+			158, 215, 180, 221, 189, 178, 231, 239, 197, 205, 132, 199, 45, 30,
+			76, 191, 239, 122, 71, 174, 108, 178, 181, 243, 71, 8, 125, 213,
+			192, 203, 27, 11, 127, 203, 152, 88, 150, 53, 55, 84, 241, 242,
+			155, 188, 217, 124, 29, 10, 111, 65, 189, 157, 180, 192, 243, 28,
+			249, 189, 28, 153, 219, 243, 202, 245, 125, 223, 59, 112, 218, 7,
+			162, 137, 102, 187, 238, 76, 219, 237, 112, 191, 22, 112, 255, 208,
+			169, 243, 105, 187, 229, 76, 251, 173, 122, 107, 103, 218, 174, 215,
+			189, 182, 27, 6, 170, 127, 125, 80, 172, 172, 138, 89, 143, 99,
+			69, 233, 5, 146, 217, 228, 205, 221, 21, 119, 215, 163, 22, 201,
+			56, 13, 238, 134, 78, 120, 92, 68, 12, 93, 207, 86, 35, 152,
+			230, 137, 225, 180, 138, 134, 72, 53, 156, 214, 108, 133, 100, 230,
+			85, 163, 244, 37, 210, 187, 204, 67, 64, 67, 71, 186, 89, 87,
+			22, 156, 179, 70, 202, 73, 146, 202, 186, 201, 133, 155, 159, 122,
+			246, 35, 244, 243, 193, 47, 101, 73, 154, 154, 249, 158, 171, 136,
+			124, 211, 36, 168, 143, 226, 124, 15, 157, 253, 134, 201, 22, 189,
+			214, 177, 239, 236, 237, 135, 108, 118, 102, 246, 22, 219, 218, 231,
+			108, 117, 123, 113, 133, 205, 183, 195, 125, 207, 15, 202, 132, 176,
+			85, 167, 206, 221, 128, 55, 88, 219, 109, 112, 159, 133, 251, 156,
+			205, 183, 236, 58, 148, 148, 57, 83, 236, 13, 238, 7, 142, 231,
+			178, 217, 242, 12, 187, 14, 5, 74, 42, 171, 116, 227, 46, 97,
+			199, 94, 155, 29, 216, 199, 204, 245, 66, 214, 14, 56, 11, 247,
+			157, 128, 237, 58, 77, 206, 248, 135, 117, 222, 10, 153, 227, 178,
+			186, 119, 208, 106, 58, 182, 91, 231, 236, 200, 9, 247, 89, 24,
+			163, 47, 19, 246, 72, 97, 240, 118, 66, 219, 113, 153, 205, 234,
+			94, 235, 152, 121, 187, 201, 98, 204, 14, 9, 97, 226, 223, 126,
+			24, 182, 230, 166, 167, 143, 142, 142, 202, 182, 160, 84, 202, 129,
+			44, 23, 76, 175, 174, 44, 86, 214, 54, 43, 55, 103, 203, 51,
+			132, 176, 109, 183, 201, 131, 128, 249, 252, 131, 182, 227, 243, 6,
+			219, 57, 102, 118, 171, 213, 116, 234, 246, 78, 147, 179, 166, 125,
+			196, 60, 159, 217, 123, 62, 231, 13, 22, 122, 64, 235, 145, 239,
+			132, 142, 187, 55, 197, 2, 111, 55, 60, 178, 125, 78, 88, 195,
+			9, 66, 223, 217, 105, 135, 29, 108, 210, 148, 57, 65, 71, 1,
+			207, 101, 182, 203, 74, 243, 155, 108, 101, 179, 196, 22, 230, 55,
+			87, 54, 167, 8, 123, 115, 101, 235, 254, 250, 246, 22, 123, 115,
+			190, 90, 157, 95, 219, 90, 169, 108, 178, 245, 42, 91, 92, 95,
+			91, 90, 217, 90, 89, 95, 219, 100, 235, 247, 216, 252, 218, 35,
+			246, 250, 202, 218, 210, 20, 227, 78, 184, 207, 125, 198, 63, 108,
+			249, 64, 189, 231, 51, 7, 24, 200, 27, 101, 194, 54, 57, 239,
+			104, 126, 215, 147, 163, 22, 180, 120, 221, 217, 117, 234, 12, 102,
+			95, 219, 222, 227, 108, 207, 59, 228, 190, 235, 184, 123, 172, 197,
+			253, 3, 39, 128, 65, 12, 152, 237, 54, 8, 107, 58, 7, 78,
+			104, 135, 34, 225, 68, 143, 202, 132, 100, 8, 50, 40, 46, 244,
+			156, 131, 95, 25, 138, 105, 207, 2, 201, 18, 35, 147, 139, 126,
+			226, 30, 138, 135, 123, 158, 33, 107, 196, 72, 247, 80, 179, 216,
+			115, 17, 89, 11, 76, 11, 63, 83, 66, 202, 234, 158, 11, 131,
+			26, 176, 3, 30, 238, 123, 130, 199, 252, 67, 251, 192, 113, 57,
+			115, 220, 134, 115, 232, 52, 218, 118, 147, 69, 19, 149, 16, 66,
+			112, 186, 7, 81, 92, 204, 20, 200, 111, 33, 98, 166, 123, 140,
+			30, 138, 39, 140, 59, 214, 183, 16, 83, 243, 137, 249, 60, 108,
+			251, 110, 192, 28, 119, 215, 243, 15, 68, 63, 152, 189, 227, 181,
+			67, 193, 137, 186, 221, 108, 114, 159, 237, 216, 32, 214, 158, 203,
+			90, 118, 16, 240, 6, 97, 48, 215, 96, 30, 215, 101, 133, 208,
+			123, 159, 187, 98, 14, 204, 55, 3, 47, 194, 25, 99, 184, 22,
+			176, 149, 13, 102, 55, 26, 98, 16, 174, 219, 1, 11, 56, 119,
+			65, 132, 160, 140, 234, 225, 141, 50, 219, 14, 248, 110, 187, 201,
+			142, 246, 185, 75, 88, 131, 239, 180, 247, 246, 28, 119, 175, 187,
+			57, 39, 8, 218, 28, 154, 235, 35, 41, 232, 20, 162, 120, 34,
+			221, 175, 33, 131, 226, 137, 252, 101, 13, 97, 138, 39, 202, 47,
+			144, 23, 137, 97, 246, 80, 243, 114, 207, 85, 100, 61, 203, 244,
+			234, 192, 156, 199, 119, 92, 50, 209, 4, 38, 94, 206, 20, 72,
+			153, 152, 166, 224, 225, 21, 99, 164, 116, 137, 241, 242, 94, 153,
+			149, 218, 1, 247, 231, 2, 239, 128, 123, 46, 127, 13, 6, 164,
+			213, 228, 229, 186, 119, 80, 18, 228, 65, 249, 20, 197, 87, 140,
+			140, 134, 16, 197, 87, 178, 131, 26, 194, 20, 95, 25, 62, 71,
+			46, 9, 204, 136, 226, 103, 140, 66, 105, 88, 97, 190, 245, 210,
+			108, 249, 214, 11, 119, 202, 51, 229, 91, 17, 50, 148, 130, 50,
+			26, 25, 130, 26, 217, 156, 134, 48, 197, 207, 228, 7, 162, 53,
+			254, 123, 136, 92, 236, 94, 153, 67, 231, 128, 7, 161, 125, 208,
+			58, 107, 163, 186, 75, 178, 91, 186, 12, 45, 146, 222, 128, 215,
+			61, 183, 17, 136, 229, 26, 87, 53, 72, 135, 73, 202, 181, 93,
+			47, 16, 11, 118, 170, 42, 129, 133, 207, 163, 211, 119, 183, 124,
+			132, 82, 239, 112, 179, 79, 185, 195, 69, 244, 126, 172, 93, 238,
+			79, 134, 201, 203, 31, 105, 151, 107, 54, 189, 163, 166, 19, 124,
+			140, 125, 206, 122, 18, 171, 75, 159, 36, 35, 171, 78, 16, 206,
+			71, 109, 84, 121, 208, 242, 220, 128, 211, 23, 9, 177, 163, 212,
+			34, 98, 248, 122, 110, 118, 180, 115, 63, 139, 106, 85, 19, 69,
+			75, 55, 200, 208, 50, 143, 49, 86, 249, 7, 109, 30, 132, 148,
+			18, 211, 181, 15, 184, 218, 98, 197, 239, 210, 47, 32, 146, 141,
+			10, 158, 86, 66, 12, 118, 123, 199, 229, 33, 12, 42, 190, 158,
+			173, 106, 144, 50, 146, 107, 240, 160, 238, 59, 45, 152, 237, 69,
+			44, 42, 37, 147, 232, 75, 132, 212, 125, 110, 135, 188, 81, 11,
+			131, 162, 201, 208, 245, 220, 172, 117, 98, 167, 142, 164, 160, 154,
+			85, 165, 183, 2, 122, 33, 174, 186, 115, 92, 76, 9, 220, 58,
+			123, 225, 120, 246, 103, 16, 33, 17, 221, 1, 93, 35, 249, 78,
+			38, 158, 169, 16, 92, 233, 100, 224, 25, 172, 127, 64, 250, 146,
+			28, 164, 151, 58, 107, 157, 194, 93, 235, 172, 145, 249, 168, 170,
+			198, 255, 56, 32, 85, 141, 219, 223, 87, 53, 190, 175, 106, 252,
+			57, 171, 26, 240, 19, 81, 124, 174, 231, 6, 217, 214, 90, 7,
+			67, 214, 10, 139, 164, 249, 76, 189, 35, 72, 42, 30, 241, 90,
+			36, 122, 164, 170, 116, 42, 31, 148, 252, 66, 164, 124, 140, 27,
+			15, 173, 175, 35, 214, 57, 25, 35, 125, 193, 110, 54, 69, 143,
+			18, 88, 235, 109, 223, 231, 110, 216, 60, 102, 65, 232, 129, 40,
+			56, 110, 60, 25, 72, 68, 99, 195, 14, 109, 81, 160, 204, 182,
+			58, 17, 28, 57, 205, 38, 219, 225, 170, 13, 137, 192, 110, 182,
+			246, 237, 29, 14, 234, 75, 147, 121, 126, 131, 251, 36, 86, 113,
+			194, 125, 238, 248, 108, 101, 169, 67, 189, 24, 79, 15, 107, 200,
+			160, 120, 252, 220, 164, 134, 48, 197, 227, 207, 191, 78, 182, 68,
+			7, 17, 197, 23, 141, 151, 173, 101, 150, 92, 54, 162, 222, 197,
+			41, 73, 149, 3, 216, 102, 11, 73, 231, 1, 8, 98, 84, 42,
+			106, 31, 54, 249, 139, 105, 170, 33, 131, 226, 139, 67, 87, 53,
+			132, 41, 190, 120, 107, 142, 188, 149, 80, 111, 86, 217, 233, 107,
+			29, 115, 78, 229, 48, 232, 197, 220, 13, 59, 24, 203, 54, 213,
+			226, 150, 212, 127, 38, 200, 85, 173, 255, 60, 99, 92, 177, 206,
+			139, 102, 192, 182, 0, 164, 49, 194, 114, 164, 247, 152, 80, 48,
+			130, 210, 20, 63, 147, 27, 214, 16, 40, 46, 231, 46, 106, 8,
+			20, 151, 210, 101, 80, 128, 77, 68, 205, 27, 61, 207, 130, 2,
+			124, 202, 218, 11, 93, 8, 61, 53, 105, 142, 59, 123, 194, 118,
+			56, 76, 154, 136, 147, 74, 119, 3, 238, 221, 200, 140, 145, 43,
+			196, 52, 17, 208, 62, 105, 208, 210, 40, 104, 88, 115, 172, 4,
+			106, 219, 205, 8, 131, 82, 178, 144, 208, 216, 38, 149, 146, 133,
+			196, 248, 79, 102, 251, 53, 132, 41, 158, 44, 12, 146, 45, 98,
+			152, 6, 53, 203, 61, 183, 145, 117, 63, 30, 53, 214, 224, 187,
+			142, 203, 131, 46, 218, 228, 40, 107, 105, 109, 7, 64, 233, 89,
+			220, 54, 16, 197, 229, 204, 160, 160, 216, 0, 138, 167, 159, 68,
+			177, 33, 40, 158, 86, 20, 27, 130, 226, 105, 69, 177, 33, 184,
+			59, 93, 208, 248, 16, 197, 51, 198, 132, 198, 247, 233, 210, 173,
+			217, 23, 203, 51, 160, 98, 78, 207, 222, 46, 189, 163, 241, 33,
+			19, 138, 69, 80, 138, 226, 153, 220, 160, 134, 0, 5, 61, 175,
+			33, 76, 241, 204, 248, 5, 50, 45, 168, 53, 40, 190, 101, 156,
+			47, 149, 20, 181, 91, 96, 192, 198, 92, 128, 45, 198, 243, 89,
+			185, 92, 142, 8, 55, 82, 80, 67, 19, 14, 93, 191, 149, 29,
+			214, 16, 166, 248, 214, 104, 81, 168, 221, 134, 129, 41, 158, 53,
+			158, 45, 93, 82, 168, 111, 189, 244, 226, 236, 205, 153, 91, 55,
+			103, 110, 109, 221, 154, 153, 155, 153, 153, 155, 157, 41, 207, 204,
+			222, 250, 84, 132, 25, 167, 161, 194, 152, 134, 16, 197, 179, 227,
+			87, 53, 4, 200, 110, 76, 146, 103, 5, 102, 147, 226, 231, 140,
+			98, 105, 66, 97, 22, 10, 125, 200, 131, 240, 20, 109, 222, 48,
+			204, 20, 148, 214, 4, 155, 136, 226, 231, 178, 67, 26, 194, 20,
+			63, 55, 50, 26, 169, 159, 191, 61, 75, 238, 124, 36, 245, 179,
+			29, 238, 55, 118, 78, 85, 61, 159, 168, 93, 62, 36, 20, 76,
+			58, 215, 110, 5, 251, 158, 158, 47, 224, 112, 241, 249, 161, 3,
+			123, 136, 210, 224, 35, 152, 142, 145, 108, 240, 190, 211, 170, 237,
+			120, 141, 99, 161, 198, 103, 170, 25, 72, 88, 240, 26, 199, 160,
+			46, 102, 52, 50, 58, 65, 114, 64, 76, 173, 177, 83, 243, 249,
+			161, 66, 148, 133, 164, 165, 157, 42, 63, 164, 87, 72, 94, 231,
+			7, 251, 246, 236, 243, 47, 40, 55, 142, 240, 18, 45, 237, 108,
+			138, 52, 122, 157, 20, 116, 169, 6, 223, 109, 130, 126, 39, 84,
+			201, 190, 170, 168, 189, 180, 179, 164, 82, 255, 63, 104, 147, 165,
+			207, 27, 164, 88, 249, 176, 213, 180, 29, 119, 35, 218, 62, 53,
+			55, 198, 73, 182, 229, 59, 110, 221, 105, 217, 77, 165, 250, 198,
+			9, 116, 130, 144, 120, 199, 85, 61, 72, 164, 128, 201, 227, 115,
+			187, 121, 160, 244, 95, 9, 208, 55, 8, 177, 67, 165, 70, 128,
+			230, 11, 186, 251, 11, 157, 26, 226, 89, 244, 148, 231, 163, 138,
+			21, 55, 244, 143, 171, 9, 76, 214, 43, 100, 160, 43, 155, 22,
+			8, 126, 159, 107, 199, 25, 252, 4, 146, 14, 237, 102, 155, 43,
+			106, 37, 48, 103, 220, 65, 165, 255, 211, 36, 231, 226, 6, 5,
+			5, 174, 216, 112, 64, 205, 223, 243, 109, 23, 184, 15, 152, 50,
+			85, 13, 118, 15, 179, 209, 61, 204, 167, 51, 0, 44, 65, 79,
+			247, 61, 91, 149, 0, 93, 38, 153, 29, 240, 74, 184, 123, 65,
+			49, 37, 12, 154, 103, 59, 153, 114, 42, 113, 229, 5, 89, 167,
+			26, 85, 182, 182, 8, 121, 200, 15, 118, 184, 31, 236, 59, 173,
+			39, 140, 224, 8, 73, 239, 249, 94, 187, 165, 13, 24, 5, 1,
+			227, 14, 29, 91, 145, 141, 15, 29, 219, 122, 157, 100, 23, 61,
+			183, 225, 8, 142, 116, 153, 55, 232, 164, 121, 51, 78, 178, 129,
+			29, 58, 193, 174, 195, 27, 106, 170, 196, 9, 214, 191, 103, 144,
+			94, 69, 184, 16, 34, 77, 15, 24, 205, 64, 70, 34, 5, 76,
+			169, 88, 164, 52, 157, 201, 36, 250, 144, 144, 186, 38, 45, 40,
+			98, 193, 187, 155, 79, 195, 187, 168, 67, 213, 4, 2, 186, 65,
+			114, 7, 17, 255, 180, 128, 150, 159, 6, 95, 204, 246, 106, 18,
+			69, 82, 128, 82, 29, 2, 52, 251, 119, 17, 73, 131, 250, 176,
+			180, 64, 151, 73, 46, 177, 28, 81, 214, 217, 224, 201, 149, 234,
+			132, 255, 86, 215, 124, 151, 12, 158, 152, 63, 244, 234, 211, 77,
+			48, 235, 114, 103, 185, 83, 251, 249, 81, 205, 182, 63, 186, 42,
+			205, 182, 240, 177, 102, 219, 236, 247, 205, 182, 239, 155, 109, 127,
+			202, 102, 219, 13, 50, 39, 109, 181, 81, 240, 16, 151, 133, 238,
+			184, 180, 112, 170, 125, 118, 228, 249, 239, 203, 209, 151, 133, 18,
+			6, 217, 104, 38, 79, 108, 109, 143, 89, 198, 75, 214, 22, 75,
+			76, 71, 97, 81, 41, 245, 85, 239, 210, 186, 33, 177, 243, 18,
+			118, 192, 131, 0, 122, 44, 208, 7, 186, 158, 178, 3, 228, 138,
+			219, 97, 59, 89, 233, 193, 132, 237, 100, 209, 43, 26, 194, 20,
+			91, 211, 47, 146, 47, 34, 109, 60, 77, 24, 85, 235, 135, 16,
+			59, 49, 157, 97, 108, 96, 71, 15, 216, 209, 254, 49, 179, 89,
+			180, 158, 178, 125, 91, 184, 244, 27, 30, 15, 220, 107, 33, 219,
+			183, 15, 57, 97, 118, 98, 8, 64, 200, 192, 188, 178, 155, 7,
+			194, 53, 238, 195, 174, 2, 22, 44, 140, 34, 104, 27, 65, 168,
+			187, 167, 187, 210, 97, 120, 77, 164, 71, 53, 100, 80, 60, 81,
+			156, 209, 16, 248, 149, 239, 110, 144, 87, 165, 225, 85, 234, 153,
+			68, 214, 44, 59, 185, 174, 129, 192, 74, 167, 57, 52, 154, 100,
+			180, 223, 170, 43, 133, 31, 212, 245, 82, 198, 34, 95, 65, 218,
+			190, 186, 106, 156, 179, 126, 20, 69, 107, 129, 154, 157, 17, 175,
+			67, 143, 237, 241, 16, 58, 62, 163, 127, 39, 186, 227, 185, 48,
+			207, 215, 188, 80, 12, 200, 161, 196, 17, 136, 176, 208, 14, 103,
+			246, 161, 237, 52, 69, 196, 230, 186, 83, 230, 101, 64, 236, 115,
+			157, 185, 103, 183, 192, 33, 206, 180, 142, 72, 152, 219, 22, 155,
+			192, 141, 216, 170, 75, 1, 121, 189, 26, 66, 20, 95, 205, 20,
+			52, 132, 41, 190, 58, 52, 76, 14, 181, 55, 251, 134, 113, 206,
+			114, 216, 202, 46, 11, 253, 54, 103, 190, 54, 68, 133, 77, 14,
+			75, 152, 52, 141, 153, 93, 15, 33, 116, 161, 251, 55, 197, 222,
+			107, 7, 33, 115, 194, 128, 109, 222, 159, 159, 125, 254, 5, 2,
+			227, 188, 63, 21, 145, 165, 168, 130, 248, 11, 139, 245, 96, 77,
+			33, 184, 200, 111, 24, 105, 13, 129, 253, 215, 171, 41, 68, 152,
+			226, 27, 67, 195, 228, 243, 72, 154, 154, 229, 158, 23, 145, 117,
+			204, 162, 65, 137, 230, 210, 201, 168, 128, 29, 79, 122, 37, 48,
+			154, 156, 50, 17, 139, 125, 55, 117, 78, 192, 218, 106, 220, 101,
+			144, 83, 88, 172, 78, 144, 20, 52, 109, 161, 150, 51, 5, 50,
+			163, 45, 212, 25, 163, 104, 93, 102, 213, 46, 116, 66, 8, 156,
+			32, 162, 85, 245, 87, 90, 171, 51, 106, 68, 164, 181, 58, 147,
+			25, 74, 88, 171, 51, 35, 163, 228, 166, 192, 13, 54, 144, 49,
+			110, 49, 197, 86, 193, 85, 181, 164, 157, 134, 24, 24, 57, 171,
+			76, 29, 36, 24, 57, 155, 29, 213, 16, 88, 80, 214, 24, 121,
+			93, 32, 54, 40, 190, 109, 92, 176, 94, 101, 90, 133, 215, 44,
+			18, 75, 134, 94, 49, 166, 152, 119, 224, 132, 176, 108, 59, 187,
+			44, 50, 61, 96, 134, 128, 120, 68, 205, 130, 73, 120, 59, 234,
+			15, 136, 209, 237, 76, 81, 67, 152, 226, 219, 99, 227, 228, 57,
+			209, 44, 166, 248, 5, 227, 89, 235, 42, 3, 199, 50, 11, 247,
+			237, 176, 179, 39, 236, 200, 14, 152, 178, 13, 34, 244, 56, 13,
+			181, 198, 52, 132, 40, 126, 65, 217, 133, 200, 192, 128, 241, 198,
+			36, 121, 32, 141, 251, 185, 158, 69, 100, 189, 202, 206, 82, 47,
+			58, 231, 246, 201, 85, 43, 158, 225, 208, 137, 185, 12, 35, 11,
+			218, 164, 127, 217, 24, 181, 158, 103, 43, 42, 246, 13, 213, 235,
+			251, 188, 254, 254, 212, 147, 98, 74, 170, 23, 210, 224, 127, 57,
+			50, 67, 97, 208, 95, 206, 82, 13, 97, 138, 95, 62, 55, 66,
+			94, 214, 6, 255, 171, 70, 209, 154, 102, 9, 202, 186, 219, 3,
+			125, 167, 220, 224, 135, 101, 88, 64, 28, 119, 47, 110, 7, 100,
+			224, 213, 168, 29, 144, 129, 87, 35, 115, 23, 38, 211, 171, 35,
+			163, 228, 182, 54, 253, 95, 51, 134, 172, 107, 172, 10, 54, 194,
+			137, 46, 181, 124, 239, 61, 94, 15, 231, 196, 82, 28, 227, 135,
+			193, 126, 45, 194, 15, 196, 190, 150, 205, 107, 8, 83, 252, 218,
+			32, 37, 175, 105, 251, 127, 193, 120, 198, 122, 142, 197, 102, 81,
+			52, 191, 56, 24, 62, 118, 40, 124, 149, 82, 241, 181, 155, 76,
+			155, 16, 81, 91, 48, 242, 11, 198, 57, 13, 33, 138, 23, 70,
+			152, 134, 48, 197, 11, 151, 175, 144, 42, 1, 43, 222, 188, 215,
+			19, 34, 235, 30, 59, 85, 97, 100, 50, 240, 177, 195, 3, 182,
+			239, 29, 117, 238, 54, 162, 203, 66, 236, 124, 30, 120, 205, 195,
+			200, 13, 133, 17, 197, 247, 50, 23, 200, 38, 49, 77, 140, 123,
+			168, 121, 223, 216, 192, 86, 133, 197, 74, 118, 23, 90, 88, 208,
+			227, 125, 238, 192, 14, 235, 251, 60, 96, 182, 238, 85, 156, 167,
+			186, 135, 49, 8, 193, 125, 50, 76, 22, 73, 26, 154, 0, 49,
+			123, 96, 158, 183, 110, 179, 141, 8, 141, 218, 72, 20, 14, 61,
+			52, 98, 207, 22, 33, 204, 155, 226, 39, 140, 78, 158, 244, 74,
+			36, 41, 192, 146, 128, 17, 197, 15, 114, 195, 49, 140, 41, 126,
+			48, 90, 36, 127, 13, 169, 86, 17, 197, 15, 205, 139, 214, 95,
+			65, 108, 113, 31, 142, 32, 120, 187, 204, 21, 254, 56, 38, 112,
+			7, 108, 215, 247, 14, 146, 84, 196, 61, 185, 22, 200, 50, 122,
+			123, 22, 0, 145, 243, 218, 113, 235, 205, 118, 67, 41, 38, 130,
+			203, 188, 145, 224, 1, 19, 135, 64, 196, 90, 214, 112, 124, 94,
+			15, 53, 246, 32, 209, 23, 112, 106, 61, 52, 251, 98, 56, 69,
+			241, 195, 254, 161, 24, 6, 218, 135, 173, 24, 198, 20, 63, 188,
+			48, 65, 222, 80, 93, 51, 40, 94, 55, 135, 172, 101, 53, 104,
+			176, 255, 238, 53, 189, 29, 173, 60, 55, 237, 32, 84, 29, 112,
+			92, 69, 39, 112, 64, 140, 157, 208, 58, 146, 67, 154, 160, 11,
+			230, 192, 122, 130, 199, 48, 11, 214, 115, 249, 24, 198, 20, 175,
+			15, 82, 50, 47, 100, 7, 81, 115, 211, 120, 11, 91, 207, 177,
+			200, 224, 131, 133, 200, 118, 163, 89, 208, 136, 167, 1, 208, 22,
+			201, 76, 36, 41, 48, 141, 55, 201, 16, 121, 69, 116, 76, 120,
+			69, 183, 205, 49, 171, 204, 238, 183, 15, 108, 23, 180, 165, 134,
+			208, 16, 18, 38, 176, 238, 100, 132, 57, 162, 95, 110, 64, 219,
+			17, 253, 114, 11, 218, 206, 141, 196, 48, 166, 120, 251, 188, 69,
+			238, 169, 230, 16, 197, 111, 154, 163, 214, 139, 108, 11, 180, 2,
+			167, 11, 49, 219, 247, 154, 141, 32, 210, 180, 247, 156, 67, 238,
+			178, 216, 49, 146, 104, 23, 214, 166, 55, 205, 76, 12, 3, 226,
+			44, 141, 97, 76, 241, 155, 231, 70, 200, 186, 224, 155, 65, 205,
+			79, 27, 123, 216, 154, 103, 202, 86, 23, 92, 211, 188, 209, 67,
+			38, 150, 39, 41, 114, 7, 176, 56, 123, 234, 24, 67, 60, 199,
+			35, 46, 194, 48, 125, 154, 12, 146, 138, 232, 150, 240, 212, 190,
+			99, 94, 182, 94, 136, 231, 91, 160, 185, 166, 27, 177, 5, 178,
+			99, 102, 251, 92, 55, 24, 233, 231, 146, 106, 67, 184, 205, 223,
+			137, 164, 84, 174, 244, 239, 68, 82, 42, 157, 187, 239, 12, 79,
+			196, 48, 166, 248, 157, 75, 37, 242, 227, 72, 209, 129, 40, 126,
+			215, 188, 98, 253, 48, 2, 175, 116, 130, 112, 152, 93, 194, 108,
+			215, 199, 46, 20, 85, 101, 86, 245, 154, 176, 182, 248, 96, 69,
+			182, 108, 183, 1, 59, 180, 27, 122, 164, 163, 50, 156, 201, 0,
+			209, 104, 30, 200, 162, 96, 102, 58, 77, 222, 128, 211, 61, 208,
+			147, 227, 164, 150, 195, 153, 239, 53, 121, 162, 87, 48, 247, 222,
+			77, 244, 10, 198, 238, 221, 68, 175, 64, 36, 223, 29, 190, 24,
+			195, 152, 226, 119, 75, 151, 201, 166, 234, 148, 65, 241, 142, 121,
+			213, 90, 138, 133, 190, 155, 185, 83, 66, 221, 61, 0, 205, 17,
+			36, 40, 18, 32, 149, 13, 139, 10, 28, 87, 58, 78, 16, 101,
+			152, 128, 53, 38, 202, 72, 83, 188, 211, 175, 5, 87, 186, 163,
+			119, 70, 47, 197, 48, 166, 120, 231, 202, 51, 100, 67, 17, 133,
+			41, 110, 152, 215, 173, 121, 118, 255, 204, 5, 187, 117, 150, 44,
+			76, 49, 103, 151, 217, 66, 71, 79, 80, 132, 77, 64, 25, 83,
+			4, 91, 86, 163, 127, 52, 134, 17, 197, 141, 226, 229, 24, 6,
+			18, 174, 94, 35, 171, 138, 34, 147, 226, 93, 243, 156, 245, 74,
+			199, 212, 210, 28, 16, 195, 223, 45, 206, 122, 177, 141, 8, 77,
+			80, 3, 190, 238, 221, 104, 130, 73, 111, 247, 110, 182, 16, 195,
+			152, 226, 221, 161, 97, 114, 7, 38, 24, 200, 255, 123, 198, 144,
+			245, 108, 71, 219, 17, 90, 80, 54, 207, 152, 73, 66, 196, 223,
+			83, 26, 59, 22, 2, 254, 94, 111, 94, 67, 152, 226, 247, 148,
+			18, 128, 97, 64, 154, 70, 209, 122, 46, 214, 142, 21, 87, 149,
+			190, 169, 21, 2, 181, 251, 198, 187, 69, 212, 22, 8, 94, 83,
+			105, 151, 88, 44, 25, 77, 165, 45, 99, 33, 116, 205, 145, 81,
+			242, 57, 48, 196, 48, 104, 52, 158, 49, 100, 5, 74, 187, 135,
+			133, 225, 104, 223, 11, 34, 134, 6, 236, 136, 251, 209, 110, 84,
+			102, 43, 34, 194, 1, 77, 250, 158, 48, 110, 160, 134, 164, 143,
+			48, 165, 255, 104, 190, 196, 1, 63, 49, 165, 34, 11, 150, 127,
+			24, 133, 254, 76, 44, 118, 6, 79, 105, 71, 88, 104, 71, 158,
+			210, 142, 176, 208, 142, 188, 65, 74, 94, 16, 180, 98, 138, 63,
+			48, 198, 173, 27, 221, 75, 184, 207, 15, 108, 255, 253, 32, 121,
+			178, 169, 131, 29, 32, 112, 31, 168, 64, 15, 54, 112, 138, 226,
+			15, 84, 160, 7, 11, 97, 251, 128, 142, 106, 8, 154, 176, 198,
+			228, 46, 4, 51, 39, 48, 152, 117, 91, 47, 166, 193, 217, 11,
+			232, 89, 163, 110, 10, 28, 17, 148, 166, 56, 200, 81, 13, 33,
+			138, 131, 161, 49, 13, 97, 138, 131, 137, 139, 81, 92, 229, 23,
+			110, 126, 180, 99, 61, 245, 125, 219, 221, 227, 77, 111, 47, 248,
+			120, 177, 149, 47, 32, 114, 14, 130, 157, 139, 2, 207, 170, 183,
+			23, 40, 11, 224, 137, 145, 145, 17, 146, 14, 109, 127, 143, 135,
+			202, 67, 175, 32, 56, 244, 210, 178, 247, 120, 77, 28, 156, 83,
+			142, 233, 44, 164, 108, 65, 2, 132, 102, 0, 168, 5, 206, 103,
+			185, 136, 127, 164, 170, 25, 72, 216, 116, 62, 203, 75, 135, 100,
+			164, 155, 24, 117, 152, 229, 54, 233, 149, 93, 213, 135, 136, 172,
+			78, 255, 167, 156, 36, 178, 98, 85, 23, 165, 87, 201, 128, 203,
+			63, 12, 107, 9, 130, 36, 177, 253, 144, 188, 161, 137, 42, 253,
+			165, 44, 233, 75, 98, 160, 23, 73, 78, 226, 168, 193, 153, 100,
+			229, 142, 39, 50, 105, 235, 184, 197, 207, 236, 125, 23, 215, 112,
+			55, 215, 10, 4, 31, 237, 123, 162, 227, 217, 42, 252, 164, 101,
+			98, 194, 254, 83, 76, 61, 49, 22, 36, 202, 129, 143, 186, 238,
+			29, 128, 0, 22, 211, 2, 139, 6, 129, 104, 187, 213, 170, 41,
+			183, 72, 177, 87, 228, 18, 187, 213, 82, 206, 150, 238, 195, 78,
+			153, 147, 209, 128, 107, 100, 192, 107, 54, 106, 201, 82, 89, 81,
+			42, 239, 53, 27, 75, 137, 130, 35, 36, 237, 29, 185, 220, 15,
+			138, 68, 228, 43, 8, 70, 31, 16, 168, 188, 156, 200, 203, 122,
+			205, 198, 186, 72, 0, 226, 149, 191, 189, 216, 39, 226, 3, 26,
+			132, 120, 15, 104, 156, 65, 177, 95, 164, 75, 0, 216, 44, 85,
+			236, 98, 94, 36, 43, 40, 121, 160, 107, 160, 243, 64, 87, 242,
+			28, 118, 161, 235, 28, 118, 137, 244, 59, 173, 154, 8, 218, 214,
+			32, 118, 93, 28, 20, 5, 114, 78, 75, 4, 185, 65, 244, 64,
+			100, 60, 144, 172, 90, 189, 233, 112, 55, 172, 57, 141, 34, 21,
+			165, 250, 69, 242, 162, 72, 93, 105, 208, 50, 25, 234, 40, 23,
+			240, 186, 207, 195, 226, 144, 40, 59, 152, 40, 187, 41, 50, 232,
+			43, 100, 76, 36, 214, 236, 134, 54, 227, 226, 38, 130, 226, 176,
+			232, 69, 81, 20, 153, 143, 74, 232, 214, 2, 58, 77, 134, 197,
+			132, 18, 231, 215, 185, 95, 107, 251, 205, 154, 215, 108, 20, 207,
+			201, 246, 68, 30, 196, 218, 185, 191, 237, 55, 215, 155, 141, 83,
+			43, 184, 252, 168, 56, 114, 90, 133, 53, 126, 4, 29, 10, 120,
+			189, 237, 59, 225, 113, 173, 238, 185, 187, 206, 158, 104, 96, 84,
+			150, 215, 89, 139, 34, 7, 26, 56, 165, 60, 224, 47, 158, 86,
+			30, 240, 63, 75, 6, 227, 149, 50, 0, 54, 240, 70, 241, 188,
+			232, 118, 33, 145, 49, 15, 233, 116, 154, 12, 37, 11, 203, 137,
+			215, 40, 90, 162, 56, 77, 100, 201, 249, 122, 162, 130, 207, 15,
+			188, 67, 222, 40, 142, 157, 168, 80, 149, 57, 16, 216, 85, 84,
+			251, 252, 80, 244, 116, 92, 80, 222, 39, 83, 171, 252, 112, 189,
+			217, 93, 10, 250, 119, 161, 171, 20, 116, 173, 68, 250, 161, 133,
+			32, 66, 53, 33, 10, 137, 216, 87, 160, 48, 117, 148, 1, 68,
+			23, 59, 203, 172, 241, 163, 89, 135, 144, 120, 233, 163, 159, 38,
+			249, 206, 197, 144, 118, 197, 124, 186, 151, 74, 177, 110, 91, 87,
+			30, 95, 72, 174, 167, 31, 53, 50, 244, 37, 38, 35, 67, 252,
+			251, 145, 161, 239, 71, 134, 254, 156, 35, 67, 43, 58, 50, 100,
+			33, 235, 21, 22, 203, 114, 116, 230, 232, 113, 167, 248, 228, 202,
+			193, 132, 130, 148, 12, 20, 81, 178, 172, 3, 69, 231, 141, 135,
+			214, 28, 235, 156, 39, 39, 206, 237, 37, 240, 192, 16, 46, 69,
+			135, 242, 146, 225, 160, 243, 29, 71, 233, 206, 119, 28, 165, 59,
+			255, 252, 235, 228, 135, 193, 53, 223, 67, 205, 9, 56, 5, 118,
+			196, 78, 157, 191, 48, 216, 209, 161, 57, 29, 2, 177, 89, 83,
+			29, 72, 75, 208, 49, 197, 142, 246, 157, 250, 62, 171, 219, 46,
+			129, 8, 199, 174, 211, 12, 185, 150, 192, 88, 7, 129, 80, 194,
+			52, 24, 140, 66, 65, 81, 62, 59, 160, 118, 34, 115, 65, 248,
+			81, 197, 233, 69, 38, 252, 168, 93, 222, 127, 237, 119, 78, 118,
+			95, 120, 0, 15, 236, 6, 239, 8, 155, 48, 101, 118, 72, 62,
+			48, 101, 118, 200, 158, 179, 145, 81, 242, 146, 14, 155, 92, 54,
+			134, 173, 41, 86, 81, 190, 97, 64, 15, 248, 212, 250, 174, 21,
+			237, 184, 181, 168, 17, 176, 109, 46, 43, 115, 65, 126, 28, 112,
+			57, 59, 160, 33, 76, 241, 101, 58, 164, 131, 76, 6, 197, 215,
+			140, 34, 4, 153, 192, 184, 1, 199, 17, 7, 206, 117, 41, 130,
+			204, 231, 117, 238, 28, 170, 83, 147, 39, 198, 66, 46, 147, 226,
+			171, 141, 6, 73, 70, 162, 0, 13, 3, 125, 178, 107, 52, 202,
+			108, 101, 151, 137, 67, 243, 83, 16, 193, 146, 182, 232, 174, 227,
+			7, 178, 116, 212, 17, 176, 123, 174, 69, 29, 1, 150, 92, 83,
+			94, 231, 30, 97, 247, 92, 27, 25, 37, 171, 130, 91, 112, 26,
+			207, 24, 177, 62, 33, 22, 213, 3, 251, 67, 231, 160, 125, 144,
+			136, 152, 36, 218, 6, 250, 148, 23, 49, 182, 85, 84, 15, 116,
+			187, 96, 255, 76, 70, 163, 4, 246, 207, 100, 70, 127, 170, 1,
+			177, 129, 201, 225, 115, 228, 211, 58, 114, 244, 60, 178, 214, 207,
+			96, 73, 28, 147, 61, 85, 40, 149, 121, 36, 92, 4, 13, 193,
+			131, 15, 218, 220, 63, 86, 98, 7, 227, 86, 206, 76, 144, 103,
+			226, 120, 208, 21, 171, 200, 230, 79, 195, 164, 56, 134, 140, 158,
+			196, 129, 61, 100, 244, 164, 225, 192, 158, 142, 213, 128, 180, 205,
+			20, 47, 106, 8, 14, 236, 149, 46, 75, 65, 64, 192, 91, 8,
+			221, 116, 8, 66, 232, 129, 103, 93, 121, 171, 88, 66, 28, 78,
+			149, 130, 142, 73, 40, 108, 221, 167, 25, 126, 88, 89, 185, 112,
+			36, 185, 30, 59, 240, 124, 126, 106, 183, 64, 162, 111, 43, 65,
+			144, 33, 168, 219, 89, 29, 11, 66, 42, 22, 116, 71, 6, 107,
+			238, 244, 112, 100, 77, 169, 192, 147, 92, 0, 153, 207, 119, 185,
+			47, 2, 226, 118, 2, 61, 227, 110, 24, 113, 27, 24, 112, 39,
+			51, 76, 158, 215, 161, 153, 57, 227, 188, 117, 157, 221, 115, 56,
+			120, 33, 193, 106, 128, 157, 164, 238, 123, 129, 56, 201, 168, 209,
+			128, 197, 163, 201, 148, 62, 186, 57, 69, 166, 244, 208, 205, 69,
+			167, 24, 97, 118, 207, 141, 22, 73, 78, 71, 99, 238, 26, 58,
+			11, 122, 119, 55, 170, 6, 189, 187, 171, 230, 171, 33, 230, 235,
+			93, 58, 164, 170, 25, 16, 237, 41, 170, 104, 15, 204, 142, 151,
+			149, 148, 74, 39, 213, 203, 106, 45, 145, 103, 38, 95, 30, 25,
+			85, 213, 48, 197, 175, 24, 250, 220, 38, 8, 247, 43, 81, 107,
+			32, 220, 175, 100, 251, 52, 4, 37, 7, 10, 170, 154, 9, 49,
+			159, 103, 84, 150, 153, 6, 72, 159, 163, 4, 23, 208, 171, 227,
+			58, 106, 98, 66, 4, 232, 242, 21, 85, 45, 69, 241, 39, 162,
+			240, 74, 74, 64, 186, 181, 20, 162, 248, 19, 217, 130, 106, 45,
+			133, 41, 254, 196, 208, 176, 170, 150, 134, 8, 144, 62, 78, 154,
+			238, 136, 7, 165, 69, 60, 72, 179, 43, 13, 241, 160, 136, 147,
+			189, 20, 207, 71, 213, 122, 83, 0, 233, 106, 189, 136, 226, 249,
+			104, 0, 122, 49, 197, 243, 81, 181, 12, 196, 128, 46, 168, 172,
+			76, 10, 32, 93, 45, 3, 17, 33, 37, 94, 134, 145, 129, 136,
+			208, 216, 184, 138, 110, 101, 41, 174, 24, 231, 172, 107, 90, 48,
+			162, 109, 62, 244, 148, 200, 45, 131, 231, 95, 202, 93, 36, 23,
+			217, 20, 84, 211, 248, 179, 136, 226, 74, 52, 192, 89, 76, 113,
+			133, 106, 38, 16, 138, 239, 69, 189, 33, 41, 128, 116, 53, 2,
+			161, 163, 40, 232, 70, 48, 197, 247, 70, 116, 111, 114, 20, 47,
+			27, 23, 85, 111, 114, 38, 64, 186, 237, 92, 138, 226, 101, 229,
+			203, 49, 140, 28, 162, 120, 57, 58, 180, 155, 195, 20, 47, 143,
+			79, 40, 36, 125, 20, 223, 143, 88, 210, 103, 2, 164, 145, 244,
+			165, 40, 190, 31, 33, 233, 131, 8, 147, 114, 8, 25, 70, 31,
+			166, 248, 190, 53, 174, 144, 244, 83, 188, 98, 76, 168, 172, 126,
+			19, 32, 141, 164, 63, 69, 241, 74, 132, 164, 31, 81, 188, 66,
+			53, 151, 251, 49, 197, 43, 99, 23, 200, 156, 64, 146, 167, 120,
+			213, 184, 104, 221, 60, 155, 203, 43, 27, 209, 81, 235, 46, 94,
+			231, 77, 168, 28, 65, 41, 138, 87, 163, 54, 243, 136, 226, 213,
+			168, 247, 121, 76, 241, 234, 248, 4, 89, 18, 109, 14, 80, 188,
+			110, 64, 52, 226, 105, 218, 156, 15, 2, 103, 207, 61, 224, 110,
+			119, 235, 3, 16, 195, 137, 134, 108, 0, 34, 56, 234, 35, 63,
+			195, 24, 128, 248, 205, 240, 136, 98, 84, 129, 226, 13, 99, 92,
+			101, 21, 82, 0, 233, 106, 5, 68, 241, 70, 118, 68, 67, 152,
+			226, 141, 243, 99, 106, 101, 26, 164, 120, 211, 152, 176, 174, 159,
+			77, 166, 180, 34, 187, 232, 26, 76, 65, 61, 221, 192, 32, 4,
+			126, 34, 9, 31, 196, 20, 111, 142, 93, 80, 116, 81, 138, 183,
+			140, 146, 202, 162, 41, 128, 116, 53, 138, 40, 222, 202, 106, 154,
+			41, 166, 120, 235, 226, 37, 85, 109, 136, 226, 109, 227, 57, 149,
+			53, 100, 2, 164, 219, 30, 74, 65, 28, 72, 115, 97, 8, 81,
+			188, 77, 111, 106, 8, 98, 66, 51, 179, 10, 201, 48, 197, 111,
+			24, 151, 21, 146, 225, 20, 64, 186, 237, 97, 68, 241, 27, 89,
+			45, 157, 195, 152, 226, 55, 88, 73, 85, 59, 71, 241, 155, 81,
+			181, 115, 16, 13, 138, 170, 157, 19, 177, 32, 93, 237, 28, 68,
+			130, 162, 106, 35, 20, 191, 21, 245, 116, 36, 5, 144, 174, 54,
+			130, 40, 126, 43, 234, 233, 8, 166, 248, 173, 168, 167, 163, 20,
+			63, 138, 170, 141, 166, 0, 210, 213, 70, 17, 197, 143, 162, 106,
+			163, 152, 226, 71, 23, 47, 41, 153, 46, 82, 252, 182, 113, 227,
+			108, 153, 22, 225, 242, 96, 185, 233, 237, 216, 205, 160, 107, 244,
+			138, 38, 84, 142, 160, 20, 197, 111, 71, 252, 44, 34, 138, 223,
+			166, 186, 247, 69, 76, 241, 219, 87, 175, 43, 82, 207, 83, 252,
+			142, 241, 172, 202, 58, 15, 1, 165, 8, 201, 249, 20, 197, 239,
+			68, 72, 206, 35, 138, 223, 161, 122, 169, 63, 15, 161, 164, 235,
+			147, 10, 137, 69, 241, 103, 34, 36, 150, 9, 144, 70, 98, 165,
+			40, 254, 76, 132, 196, 66, 20, 127, 38, 66, 98, 97, 138, 63,
+			115, 125, 82, 245, 126, 140, 98, 219, 184, 112, 118, 239, 55, 164,
+			91, 92, 50, 161, 171, 247, 99, 41, 168, 172, 121, 60, 134, 40,
+			182, 213, 249, 19, 195, 24, 195, 20, 219, 209, 226, 51, 78, 241,
+			78, 180, 130, 141, 167, 0, 210, 213, 198, 17, 197, 59, 81, 181,
+			113, 136, 224, 68, 213, 46, 80, 92, 143, 166, 226, 133, 20, 64,
+			186, 218, 5, 68, 113, 61, 154, 138, 23, 48, 197, 245, 243, 99,
+			170, 218, 4, 197, 141, 168, 218, 68, 10, 32, 93, 109, 2, 226,
+			51, 81, 181, 9, 136, 206, 156, 31, 139, 252, 214, 255, 123, 141,
+			176, 110, 95, 179, 246, 34, 122, 254, 89, 95, 228, 62, 36, 131,
+			247, 156, 38, 215, 142, 69, 207, 223, 228, 33, 189, 67, 76, 184,
+			24, 64, 249, 121, 175, 156, 112, 136, 118, 214, 16, 95, 221, 86,
+			69, 141, 210, 151, 83, 100, 232, 148, 220, 179, 62, 9, 109, 217,
+			245, 247, 237, 61, 125, 194, 92, 131, 116, 130, 144, 6, 111, 113,
+			183, 193, 221, 250, 177, 56, 165, 156, 173, 38, 82, 132, 35, 171,
+			189, 211, 116, 234, 181, 68, 49, 194, 240, 245, 84, 181, 32, 51,
+			150, 226, 194, 215, 200, 192, 17, 183, 223, 79, 22, 205, 137, 162,
+			121, 72, 78, 20, 92, 36, 125, 234, 44, 145, 116, 57, 203, 211,
+			204, 236, 68, 239, 187, 123, 158, 83, 181, 132, 87, 122, 158, 100,
+			185, 219, 62, 144, 24, 82, 103, 240, 175, 226, 182, 15, 186, 177,
+			100, 160, 154, 66, 209, 171, 236, 234, 98, 90, 32, 184, 118, 2,
+			129, 250, 166, 167, 27, 135, 174, 71, 23, 73, 150, 127, 24, 114,
+			87, 121, 161, 1, 201, 51, 39, 144, 136, 45, 169, 27, 69, 92,
+			143, 190, 64, 122, 61, 225, 128, 14, 132, 159, 58, 55, 59, 126,
+			10, 138, 38, 95, 151, 101, 170, 186, 48, 93, 33, 133, 192, 107,
+			251, 117, 94, 171, 123, 13, 94, 131, 195, 110, 197, 172, 64, 112,
+			241, 4, 130, 77, 81, 112, 209, 107, 112, 248, 98, 190, 154, 15,
+			58, 96, 112, 62, 7, 199, 110, 104, 127, 88, 236, 19, 130, 163,
+			32, 58, 75, 122, 185, 244, 208, 22, 243, 12, 93, 207, 207, 22,
+			79, 96, 174, 168, 227, 234, 186, 96, 233, 27, 105, 50, 240, 52,
+			98, 121, 151, 164, 118, 129, 51, 69, 227, 163, 240, 77, 214, 233,
+			100, 124, 250, 99, 50, 126, 158, 228, 164, 147, 93, 74, 17, 126,
+			74, 57, 36, 178, 210, 73, 49, 52, 63, 150, 24, 190, 69, 6,
+			34, 146, 106, 62, 172, 157, 74, 158, 167, 159, 68, 73, 185, 162,
+			235, 85, 161, 90, 53, 31, 225, 17, 48, 93, 34, 196, 115, 185,
+			183, 91, 107, 240, 122, 179, 152, 57, 131, 75, 235, 80, 164, 155,
+			188, 172, 168, 184, 196, 235, 77, 250, 82, 44, 158, 189, 103, 72,
+			215, 67, 57, 49, 79, 72, 232, 54, 201, 195, 119, 137, 254, 33,
+			111, 168, 158, 101, 213, 151, 11, 79, 234, 89, 85, 85, 19, 29,
+			169, 246, 107, 44, 2, 164, 151, 73, 148, 80, 131, 21, 78, 44,
+			73, 217, 106, 159, 78, 92, 179, 15, 184, 245, 89, 146, 239, 100,
+			15, 196, 93, 130, 208, 246, 67, 177, 56, 166, 170, 18, 128, 207,
+			74, 184, 219, 80, 55, 32, 96, 238, 54, 232, 107, 113, 135, 177,
+			232, 240, 213, 19, 29, 238, 196, 220, 221, 111, 235, 69, 210, 223,
+			209, 129, 167, 109, 186, 244, 235, 38, 57, 119, 42, 110, 250, 22,
+			25, 110, 187, 142, 27, 114, 191, 229, 115, 248, 166, 74, 146, 88,
+			252, 118, 239, 25, 66, 183, 157, 44, 45, 177, 84, 135, 58, 80,
+			200, 68, 250, 8, 66, 101, 245, 166, 237, 11, 23, 166, 154, 141,
+			179, 79, 215, 229, 242, 82, 92, 115, 1, 255, 101, 100, 84, 147,
+			184, 232, 139, 36, 179, 203, 237, 176, 237, 243, 160, 56, 43, 88,
+			57, 118, 2, 239, 61, 89, 96, 147, 135, 213, 168, 48, 61, 32,
+			125, 135, 220, 7, 21, 195, 142, 46, 43, 200, 207, 222, 121, 74,
+			162, 222, 72, 84, 221, 12, 237, 144, 207, 145, 237, 181, 55, 42,
+			213, 149, 123, 43, 149, 37, 73, 102, 7, 122, 235, 39, 16, 201,
+			37, 122, 2, 203, 161, 244, 62, 169, 241, 82, 16, 68, 116, 119,
+			219, 205, 166, 20, 58, 24, 182, 108, 53, 3, 9, 32, 112, 176,
+			245, 170, 101, 4, 210, 197, 111, 249, 229, 158, 20, 4, 245, 153,
+			77, 4, 203, 188, 150, 56, 214, 42, 194, 155, 153, 106, 4, 63,
+			48, 51, 102, 33, 85, 186, 77, 6, 79, 116, 133, 14, 144, 220,
+			82, 101, 113, 117, 190, 58, 15, 247, 228, 20, 122, 104, 158, 36,
+			122, 87, 64, 147, 217, 204, 119, 122, 11, 159, 251, 220, 231, 62,
+			103, 148, 126, 41, 77, 134, 79, 91, 4, 79, 93, 143, 227, 78,
+			227, 142, 78, 207, 147, 84, 211, 222, 225, 77, 17, 201, 205, 207,
+			62, 251, 84, 203, 108, 121, 21, 170, 84, 101, 77, 250, 170, 98,
+			13, 176, 32, 63, 59, 249, 116, 24, 96, 125, 85, 108, 28, 35,
+			89, 248, 43, 249, 158, 150, 124, 135, 4, 193, 119, 139, 100, 196,
+			186, 215, 224, 209, 152, 104, 24, 86, 138, 6, 223, 181, 219, 205,
+			176, 38, 188, 163, 42, 82, 220, 167, 18, 223, 128, 52, 8, 38,
+			139, 213, 174, 230, 184, 13, 254, 161, 216, 66, 83, 85, 185, 114,
+			174, 64, 10, 12, 251, 123, 129, 231, 234, 181, 70, 52, 1, 9,
+			162, 249, 23, 227, 213, 66, 238, 222, 23, 78, 239, 94, 247, 34,
+			65, 175, 145, 1, 81, 226, 57, 53, 149, 237, 166, 8, 210, 102,
+			170, 121, 153, 188, 174, 82, 75, 127, 207, 32, 38, 48, 3, 134,
+			126, 235, 209, 70, 165, 182, 180, 190, 189, 176, 90, 41, 32, 24,
+			122, 145, 112, 111, 117, 125, 126, 171, 96, 68, 240, 202, 218, 214,
+			11, 183, 11, 56, 170, 176, 45, 19, 204, 100, 129, 231, 102, 11,
+			41, 90, 32, 125, 2, 190, 183, 242, 86, 101, 233, 133, 219, 133,
+			116, 103, 202, 115, 179, 133, 94, 218, 79, 178, 34, 101, 97, 125,
+			125, 181, 144, 137, 112, 110, 110, 85, 87, 214, 150, 11, 217, 8,
+			231, 114, 117, 125, 123, 163, 64, 34, 12, 15, 43, 155, 155, 243,
+			203, 149, 66, 46, 42, 177, 240, 104, 171, 178, 89, 232, 235, 32,
+			235, 185, 217, 66, 127, 212, 68, 101, 109, 251, 97, 33, 79, 7,
+			73, 191, 0, 55, 53, 17, 3, 93, 73, 47, 220, 46, 20, 98,
+			66, 36, 150, 193, 142, 132, 23, 110, 23, 104, 105, 145, 164, 132,
+			24, 82, 74, 242, 171, 243, 11, 149, 213, 218, 250, 6, 76, 154,
+			249, 213, 2, 138, 211, 170, 149, 141, 202, 252, 86, 101, 169, 128,
+			147, 105, 159, 220, 94, 169, 86, 150, 10, 70, 169, 78, 134, 79,
+			219, 33, 79, 157, 66, 9, 89, 48, 206, 144, 5, 129, 171, 91,
+			22, 74, 255, 202, 32, 67, 167, 104, 9, 167, 54, 242, 137, 248,
+			115, 81, 88, 249, 111, 156, 104, 2, 16, 9, 201, 238, 194, 86,
+			149, 245, 146, 250, 38, 62, 67, 223, 4, 20, 39, 4, 246, 157,
+			19, 187, 185, 254, 80, 246, 180, 234, 93, 141, 139, 180, 143, 182,
+			171, 167, 78, 217, 213, 239, 146, 193, 19, 136, 158, 122, 119, 253,
+			97, 68, 138, 103, 49, 231, 9, 75, 162, 209, 177, 36, 222, 237,
+			230, 224, 165, 83, 89, 32, 218, 57, 49, 214, 95, 71, 100, 228,
+			116, 187, 226, 84, 26, 94, 37, 105, 25, 236, 83, 227, 125, 82,
+			25, 121, 40, 178, 187, 112, 85, 85, 173, 164, 250, 134, 207, 80,
+			223, 20, 53, 39, 40, 253, 43, 6, 57, 119, 42, 242, 83, 9,
+			189, 64, 136, 227, 182, 218, 161, 212, 133, 129, 97, 217, 106, 86,
+			164, 136, 197, 11, 86, 217, 118, 24, 229, 99, 145, 79, 100, 146,
+			40, 112, 39, 38, 212, 20, 132, 78, 156, 209, 211, 110, 58, 233,
+			12, 41, 232, 163, 41, 161, 207, 33, 26, 186, 39, 119, 219, 185,
+			212, 174, 221, 12, 120, 117, 64, 102, 111, 234, 92, 168, 161, 14,
+			139, 196, 53, 210, 29, 53, 100, 118, 84, 163, 244, 115, 89, 146,
+			75, 88, 97, 244, 18, 233, 123, 207, 62, 180, 107, 218, 178, 86,
+			223, 27, 67, 218, 134, 178, 174, 103, 200, 48, 128, 53, 175, 29,
+			114, 191, 86, 111, 218, 65, 0, 140, 82, 135, 145, 40, 228, 173,
+			67, 214, 162, 206, 161, 207, 147, 33, 72, 173, 29, 180, 155, 161,
+			211, 106, 242, 26, 216, 250, 242, 220, 81, 68, 217, 32, 148, 120,
+			168, 10, 0, 69, 1, 93, 34, 23, 32, 177, 182, 199, 93, 238,
+			219, 33, 175, 241, 15, 218, 118, 51, 168, 217, 110, 163, 6, 95,
+			7, 21, 135, 161, 107, 11, 70, 17, 85, 207, 67, 193, 101, 85,
+			174, 34, 138, 205, 187, 141, 251, 118, 176, 79, 231, 200, 8, 100,
+			2, 15, 29, 119, 175, 38, 14, 68, 214, 218, 225, 238, 157, 226,
+			88, 178, 125, 65, 225, 166, 40, 179, 8, 69, 182, 195, 221, 59,
+			116, 147, 244, 193, 216, 29, 56, 159, 229, 181, 93, 207, 23, 123,
+			104, 126, 246, 198, 227, 236, 216, 242, 186, 170, 240, 208, 107, 240,
+			185, 212, 230, 70, 165, 178, 84, 205, 105, 44, 247, 60, 31, 14,
+			88, 237, 121, 17, 131, 213, 1, 171, 61, 79, 179, 247, 121, 50,
+			84, 175, 203, 62, 59, 117, 125, 144, 35, 40, 22, 58, 152, 85,
+			175, 139, 206, 58, 117, 37, 227, 1, 125, 137, 156, 139, 153, 149,
+			172, 56, 152, 172, 56, 20, 241, 41, 81, 245, 121, 50, 212, 58,
+			62, 89, 145, 118, 180, 216, 58, 238, 174, 246, 140, 240, 178, 248,
+			188, 46, 84, 189, 209, 100, 233, 68, 6, 45, 147, 66, 189, 94,
+			227, 174, 189, 211, 228, 53, 219, 231, 174, 29, 136, 147, 51, 153,
+			57, 19, 190, 172, 170, 230, 235, 245, 138, 200, 156, 23, 121, 116,
+			146, 12, 122, 59, 239, 213, 165, 96, 213, 90, 62, 223, 117, 62,
+			44, 94, 17, 92, 26, 128, 12, 33, 86, 27, 34, 153, 222, 32,
+			133, 122, 176, 111, 251, 45, 177, 178, 6, 45, 187, 206, 139, 207,
+			200, 162, 50, 125, 77, 39, 131, 96, 7, 71, 206, 110, 168, 49,
+			94, 19, 197, 114, 34, 77, 97, 187, 78, 10, 173, 253, 86, 103,
+			195, 215, 69, 177, 124, 107, 191, 149, 108, 247, 50, 233, 111, 237,
+			39, 27, 189, 33, 138, 245, 181, 246, 19, 45, 222, 38, 35, 80,
+			232, 128, 135, 54, 220, 0, 148, 40, 61, 37, 74, 15, 183, 246,
+			91, 15, 85, 102, 7, 157, 126, 123, 231, 56, 146, 143, 155, 162,
+			108, 14, 210, 180, 132, 124, 108, 243, 227, 207, 204, 216, 42, 205,
+			145, 190, 164, 220, 211, 44, 145, 146, 95, 64, 160, 4, 45, 174,
+			47, 85, 106, 155, 43, 159, 170, 20, 12, 80, 163, 86, 87, 182,
+			42, 181, 234, 246, 218, 214, 202, 195, 74, 1, 39, 20, 251, 7,
+			102, 102, 178, 240, 236, 3, 51, 115, 181, 112, 77, 176, 231, 132,
+			80, 150, 254, 111, 76, 242, 157, 102, 57, 125, 153, 140, 106, 191,
+			91, 192, 195, 218, 145, 227, 139, 201, 122, 96, 203, 141, 51, 18,
+			202, 97, 85, 106, 147, 135, 111, 58, 62, 191, 39, 110, 148, 164,
+			171, 228, 162, 235, 213, 130, 208, 118, 27, 182, 31, 159, 155, 244,
+			252, 154, 93, 175, 243, 32, 240, 252, 162, 145, 196, 50, 238, 122,
+			155, 170, 112, 188, 123, 204, 171, 162, 93, 115, 2, 159, 53, 39,
+			198, 72, 246, 192, 110, 213, 68, 168, 87, 232, 238, 153, 106, 230,
+			192, 110, 85, 0, 166, 111, 144, 171, 113, 209, 90, 147, 239, 217,
+			245, 227, 26, 232, 229, 53, 225, 35, 18, 71, 251, 154, 78, 61,
+			12, 138, 185, 104, 253, 43, 197, 53, 86, 69, 133, 7, 129, 231,
+			10, 19, 105, 81, 151, 238, 176, 90, 251, 254, 66, 136, 77, 231,
+			208, 155, 133, 212, 3, 51, 147, 42, 164, 31, 152, 153, 116, 161,
+			247, 129, 153, 201, 20, 178, 15, 204, 76, 182, 64, 74, 127, 189,
+			159, 244, 37, 205, 13, 58, 79, 82, 245, 232, 96, 111, 126, 246,
+			242, 99, 141, 147, 242, 34, 236, 196, 115, 105, 169, 219, 87, 101,
+			77, 208, 130, 96, 146, 69, 151, 102, 40, 136, 46, 147, 244, 123,
+			1, 148, 16, 230, 107, 126, 246, 202, 227, 113, 63, 216, 20, 200,
+			179, 15, 54, 107, 107, 235, 213, 135, 243, 171, 85, 85, 157, 158,
+			39, 102, 211, 254, 236, 113, 231, 158, 45, 146, 104, 153, 12, 180,
+			93, 105, 171, 195, 24, 67, 169, 129, 100, 169, 124, 156, 187, 10,
+			229, 159, 82, 174, 206, 19, 19, 156, 210, 157, 59, 171, 72, 162,
+			215, 73, 159, 184, 178, 180, 230, 243, 134, 93, 15, 59, 247, 147,
+			156, 200, 170, 138, 28, 250, 58, 201, 194, 192, 137, 227, 241, 194,
+			116, 203, 207, 222, 124, 60, 11, 212, 16, 235, 74, 213, 184, 62,
+			189, 79, 122, 229, 169, 242, 160, 56, 196, 240, 245, 252, 108, 249,
+			105, 80, 109, 137, 42, 192, 215, 170, 174, 78, 223, 36, 5, 229,
+			138, 173, 41, 51, 87, 158, 185, 205, 205, 78, 61, 30, 165, 242,
+			228, 46, 201, 74, 213, 1, 222, 1, 119, 206, 139, 115, 31, 101,
+			94, 108, 147, 1, 245, 187, 22, 180, 91, 45, 207, 15, 197, 217,
+			220, 39, 18, 164, 145, 201, 58, 213, 252, 110, 7, 252, 103, 55,
+			221, 172, 79, 145, 124, 39, 51, 146, 142, 112, 252, 148, 142, 240,
+			211, 239, 245, 177, 190, 100, 144, 124, 103, 199, 232, 50, 161, 170,
+			78, 205, 113, 67, 223, 107, 180, 235, 234, 94, 159, 199, 181, 51,
+			168, 234, 172, 68, 85, 146, 136, 18, 179, 192, 120, 74, 68, 75,
+			241, 252, 152, 38, 67, 26, 1, 32, 59, 178, 197, 209, 71, 229,
+			218, 162, 137, 172, 55, 101, 14, 157, 39, 90, 92, 162, 3, 201,
+			230, 19, 154, 205, 171, 10, 234, 152, 114, 105, 154, 164, 196, 242,
+			67, 9, 81, 11, 80, 161, 135, 102, 136, 185, 184, 94, 93, 42,
+			32, 216, 15, 101, 106, 109, 99, 165, 178, 88, 41, 24, 165, 231,
+			73, 90, 174, 41, 176, 117, 70, 171, 74, 161, 71, 129, 10, 7,
+			210, 185, 219, 15, 23, 42, 213, 130, 81, 218, 38, 3, 93, 243,
+			144, 158, 35, 131, 213, 202, 86, 101, 13, 156, 3, 181, 237, 181,
+			215, 215, 214, 223, 92, 43, 244, 116, 38, 235, 125, 24, 209, 97,
+			82, 136, 147, 55, 215, 183, 171, 130, 154, 255, 208, 32, 133, 238,
+			73, 73, 71, 201, 208, 214, 124, 117, 185, 178, 85, 19, 158, 137,
+			24, 245, 48, 41, 36, 51, 238, 173, 8, 127, 206, 69, 50, 150,
+			76, 173, 188, 181, 85, 89, 219, 132, 86, 170, 243, 107, 203, 160,
+			20, 116, 225, 211, 46, 22, 12, 164, 38, 51, 238, 173, 84, 86,
+			151, 10, 102, 119, 242, 250, 90, 101, 253, 94, 33, 213, 221, 186,
+			112, 187, 164, 169, 69, 70, 186, 83, 107, 149, 181, 173, 234, 163,
+			66, 111, 119, 195, 155, 149, 234, 27, 43, 139, 149, 66, 134, 142,
+			16, 154, 204, 120, 88, 217, 186, 191, 190, 84, 200, 158, 182, 99,
+			209, 194, 80, 233, 63, 71, 164, 47, 233, 2, 233, 88, 84, 208,
+			95, 180, 205, 182, 244, 79, 13, 146, 75, 248, 66, 192, 85, 104,
+			195, 41, 146, 154, 221, 116, 236, 64, 237, 135, 242, 46, 223, 121,
+			72, 121, 218, 253, 231, 233, 85, 151, 244, 199, 86, 93, 122, 255,
+			2, 170, 46, 169, 66, 186, 244, 207, 13, 82, 232, 246, 142, 116,
+			241, 13, 157, 197, 183, 100, 255, 140, 143, 210, 191, 238, 93, 29,
+			159, 185, 171, 159, 178, 89, 153, 127, 145, 55, 171, 164, 184, 254,
+			54, 34, 121, 101, 118, 106, 198, 38, 57, 86, 250, 40, 28, 235,
+			28, 145, 75, 103, 141, 200, 159, 75, 191, 254, 26, 38, 253, 29,
+			190, 159, 167, 165, 238, 3, 50, 232, 52, 248, 65, 203, 11, 225,
+			228, 65, 173, 201, 15, 121, 83, 176, 33, 63, 59, 253, 120, 239,
+			82, 121, 37, 174, 183, 10, 213, 230, 134, 86, 150, 42, 15, 55,
+			214, 183, 42, 107, 139, 143, 244, 38, 81, 45, 36, 208, 139, 98,
+			29, 83, 240, 242, 71, 97, 248, 159, 25, 39, 75, 27, 164, 208,
+			221, 27, 88, 208, 79, 233, 79, 161, 135, 14, 145, 129, 181, 245,
+			218, 230, 202, 82, 165, 86, 185, 119, 175, 178, 184, 181, 41, 3,
+			13, 81, 233, 173, 130, 145, 28, 155, 159, 196, 100, 232, 20, 74,
+			232, 188, 114, 17, 26, 234, 238, 187, 167, 160, 190, 12, 214, 253,
+			134, 237, 135, 202, 163, 120, 131, 20, 212, 215, 232, 14, 247, 85,
+			0, 7, 139, 0, 206, 64, 156, 46, 150, 17, 58, 69, 104, 203,
+			11, 156, 208, 57, 132, 131, 16, 58, 218, 3, 19, 215, 172, 22,
+			116, 206, 138, 27, 70, 165, 93, 190, 103, 119, 149, 6, 243, 3,
+			87, 11, 58, 39, 42, 125, 137, 244, 53, 188, 54, 120, 101, 36,
+			86, 88, 146, 81, 53, 39, 211, 162, 34, 202, 109, 22, 135, 153,
+			250, 170, 57, 153, 38, 139, 92, 35, 3, 246, 222, 158, 15, 200,
+			53, 34, 233, 8, 204, 71, 201, 162, 160, 245, 128, 100, 52, 31,
+			32, 242, 4, 156, 168, 181, 164, 119, 219, 128, 200, 147, 171, 51,
+			47, 145, 62, 39, 168, 69, 49, 255, 162, 193, 140, 235, 153, 106,
+			206, 9, 162, 168, 104, 233, 235, 132, 144, 88, 216, 232, 143, 33,
+			146, 151, 27, 140, 188, 47, 184, 174, 205, 194, 83, 60, 117, 81,
+			45, 105, 36, 108, 168, 10, 11, 159, 248, 203, 8, 125, 25, 153,
+			95, 70, 232, 171, 168, 159, 102, 42, 111, 109, 172, 174, 44, 174,
+			108, 21, 127, 191, 87, 192, 43, 15, 21, 252, 237, 222, 206, 252,
+			239, 244, 254, 29, 132, 51, 223, 233, 173, 246, 239, 38, 241, 209,
+			102, 242, 4, 133, 113, 150, 33, 25, 83, 83, 81, 231, 38, 22,
+			110, 8, 66, 210, 130, 144, 28, 77, 47, 174, 174, 111, 86, 150,
+			4, 25, 89, 106, 174, 111, 84, 214, 138, 223, 214, 77, 198, 135,
+			45, 190, 140, 200, 168, 142, 178, 170, 189, 150, 187, 117, 175, 161,
+			181, 219, 252, 236, 173, 199, 53, 94, 85, 85, 5, 75, 42, 170,
+			226, 194, 205, 19, 44, 153, 95, 91, 82, 180, 228, 104, 122, 99,
+			126, 241, 245, 202, 82, 76, 205, 57, 255, 52, 44, 244, 7, 201,
+			0, 120, 91, 65, 54, 156, 134, 80, 174, 139, 230, 89, 241, 210,
+			152, 34, 112, 191, 190, 17, 213, 80, 76, 145, 163, 147, 165, 230,
+			218, 250, 90, 69, 147, 33, 2, 224, 143, 98, 50, 242, 237, 142,
+			170, 244, 7, 73, 65, 187, 135, 34, 150, 164, 206, 10, 249, 198,
+			4, 40, 39, 83, 196, 140, 171, 9, 10, 134, 233, 192, 106, 101,
+			109, 121, 235, 126, 109, 163, 90, 17, 145, 187, 226, 239, 235, 230,
+			7, 14, 58, 43, 210, 31, 66, 36, 39, 189, 55, 194, 225, 164,
+			156, 10, 87, 31, 215, 121, 161, 1, 137, 210, 11, 47, 137, 102,
+			177, 22, 136, 81, 74, 87, 43, 203, 243, 139, 143, 106, 11, 149,
+			205, 45, 88, 201, 214, 171, 82, 70, 9, 77, 205, 175, 174, 174,
+			191, 25, 51, 130, 188, 23, 161, 41, 189, 77, 250, 59, 196, 29,
+			148, 98, 161, 76, 67, 15, 54, 43, 107, 139, 73, 37, 190, 143,
+			68, 226, 93, 64, 180, 143, 68, 194, 95, 48, 96, 25, 85, 4,
+			68, 177, 68, 92, 122, 145, 100, 180, 248, 130, 106, 46, 52, 236,
+			46, 195, 32, 67, 132, 236, 22, 16, 152, 65, 82, 166, 11, 70,
+			233, 13, 114, 238, 84, 209, 163, 151, 201, 69, 29, 191, 172, 73,
+			58, 43, 107, 139, 235, 75, 43, 107, 203, 9, 156, 132, 40, 25,
+			148, 84, 106, 249, 44, 24, 165, 21, 146, 239, 20, 32, 58, 70,
+			70, 183, 183, 238, 221, 169, 189, 49, 191, 186, 178, 52, 223, 101,
+			16, 17, 162, 164, 168, 96, 128, 101, 6, 210, 85, 192, 37, 51,
+			131, 10, 168, 180, 73, 6, 186, 68, 129, 142, 147, 162, 178, 80,
+			78, 163, 106, 136, 116, 11, 135, 116, 130, 46, 85, 86, 87, 30,
+			174, 64, 64, 214, 40, 221, 39, 36, 30, 99, 216, 179, 30, 108,
+			174, 175, 213, 238, 129, 161, 183, 149, 64, 149, 37, 114, 76, 11,
+			8, 236, 145, 147, 3, 95, 48, 38, 211, 176, 99, 125, 97, 109,
+			50, 157, 249, 194, 90, 225, 139, 240, 247, 139, 107, 133, 31, 91,
+			123, 144, 206, 124, 187, 183, 240, 157, 222, 210, 119, 49, 161, 177,
+			100, 69, 62, 143, 183, 72, 38, 114, 162, 200, 83, 154, 47, 63,
+			70, 32, 117, 181, 68, 146, 178, 118, 85, 78, 53, 194, 6, 22,
+			243, 129, 227, 194, 151, 78, 53, 101, 8, 63, 217, 98, 86, 21,
+			20, 44, 80, 216, 31, 118, 160, 72, 61, 17, 133, 253, 97, 2,
+			133, 245, 71, 136, 20, 207, 34, 246, 99, 57, 61, 214, 200, 176,
+			119, 200, 125, 223, 17, 55, 87, 212, 34, 85, 200, 124, 178, 42,
+			52, 148, 168, 168, 146, 3, 186, 0, 59, 214, 135, 188, 17, 99,
+			74, 61, 25, 83, 191, 168, 162, 113, 60, 0, 1, 5, 235, 195,
+			40, 224, 88, 223, 42, 125, 221, 32, 249, 206, 99, 145, 116, 137,
+			100, 154, 158, 58, 114, 36, 71, 251, 250, 19, 78, 82, 150, 87,
+			85, 249, 106, 84, 211, 250, 77, 68, 50, 58, 153, 142, 16, 179,
+			101, 135, 251, 226, 136, 111, 106, 193, 40, 160, 170, 128, 33, 61,
+			104, 217, 110, 209, 136, 211, 1, 134, 72, 77, 147, 219, 176, 146,
+			214, 212, 101, 7, 129, 114, 187, 12, 168, 244, 69, 149, 12, 167,
+			115, 67, 223, 118, 154, 29, 101, 77, 81, 182, 160, 51, 162, 194,
+			115, 228, 188, 198, 219, 224, 33, 124, 233, 219, 136, 43, 193, 1,
+			202, 108, 117, 84, 21, 88, 82, 249, 186, 110, 233, 191, 55, 200,
+			160, 142, 25, 54, 34, 102, 61, 36, 196, 118, 93, 47, 76, 178,
+			235, 164, 154, 119, 162, 94, 121, 62, 170, 84, 77, 32, 176, 254,
+			55, 120, 34, 38, 2, 207, 228, 219, 69, 146, 83, 135, 94, 33,
+			54, 170, 92, 107, 68, 38, 221, 115, 154, 28, 188, 110, 59, 124,
+			207, 113, 213, 41, 38, 9, 232, 195, 0, 102, 116, 24, 128, 86,
+			73, 38, 224, 7, 182, 27, 58, 117, 33, 82, 249, 217, 23, 62,
+			18, 241, 229, 77, 85, 187, 26, 225, 41, 93, 135, 87, 242, 100,
+			106, 180, 62, 246, 208, 94, 130, 55, 43, 91, 5, 4, 193, 158,
+			249, 213, 149, 249, 205, 130, 49, 249, 117, 131, 244, 170, 185, 3,
+			91, 69, 69, 190, 122, 214, 177, 58, 230, 117, 162, 92, 207, 10,
+			127, 169, 55, 153, 184, 81, 93, 223, 90, 159, 45, 252, 254, 201,
+			196, 231, 10, 223, 238, 165, 131, 164, 79, 39, 206, 206, 204, 62,
+			87, 248, 78, 119, 210, 237, 194, 255, 34, 188, 58, 58, 233, 86,
+			109, 11, 214, 203, 245, 181, 213, 71, 5, 148, 204, 152, 77, 100,
+			24, 244, 2, 25, 213, 25, 47, 189, 244, 210, 75, 47, 38, 50,
+			255, 250, 143, 164, 187, 179, 239, 36, 178, 127, 234, 100, 246, 75,
+			137, 236, 191, 241, 35, 105, 248, 78, 78, 103, 63, 156, 127, 171,
+			240, 189, 239, 125, 239, 123, 189, 11, 63, 120, 250, 99, 84, 133,
+			174, 35, 9, 193, 125, 244, 169, 155, 79, 124, 142, 42, 142, 90,
+			117, 188, 68, 101, 117, 191, 68, 85, 229, 187, 77, 94, 7, 193,
+			38, 95, 157, 136, 222, 223, 178, 91, 206, 180, 212, 25, 119, 248,
+			190, 125, 232, 68, 167, 253, 137, 106, 216, 110, 57, 214, 19, 191,
+			13, 152, 252, 105, 164, 148, 140, 5, 133, 133, 78, 16, 75, 110,
+			222, 11, 149, 251, 243, 111, 172, 172, 87, 107, 219, 107, 155, 27,
+			149, 69, 121, 164, 79, 40, 26, 137, 147, 75, 125, 36, 19, 159,
+			79, 130, 83, 79, 235, 219, 91, 27, 219, 138, 143, 88, 216, 106,
+			107, 17, 108, 194, 150, 186, 242, 240, 225, 246, 214, 60, 156, 25,
+			75, 193, 25, 167, 237, 181, 245, 234, 82, 165, 90, 89, 170, 173,
+			174, 108, 110, 21, 210, 160, 139, 172, 173, 175, 213, 42, 15, 55,
+			182, 30, 213, 150, 42, 247, 230, 183, 87, 183, 10, 189, 115, 239,
+			146, 124, 103, 119, 233, 227, 207, 182, 21, 191, 2, 103, 140, 243,
+			179, 231, 117, 41, 187, 229, 148, 59, 122, 170, 148, 127, 13, 46,
+			180, 72, 62, 49, 184, 118, 203, 89, 160, 29, 229, 245, 67, 99,
+			243, 39, 71, 118, 143, 187, 130, 157, 211, 50, 203, 110, 57, 129,
+			120, 233, 50, 94, 82, 130, 187, 137, 223, 95, 53, 204, 229, 249,
+			141, 149, 7, 191, 102, 201, 235, 29, 30, 33, 242, 15, 226, 235,
+			29, 254, 118, 231, 245, 14, 183, 238, 48, 41, 17, 108, 117, 117,
+			241, 255, 143, 247, 58, 124, 255, 90, 135, 63, 191, 107, 29, 134,
+			244, 13, 14, 180, 103, 82, 221, 240, 48, 220, 243, 150, 190, 225,
+			1, 126, 202, 196, 115, 61, 37, 145, 72, 228, 79, 153, 56, 210,
+			243, 156, 72, 84, 63, 101, 226, 104, 207, 53, 145, 8, 247, 124,
+			247, 92, 83, 37, 139, 170, 250, 21, 253, 19, 245, 82, 211, 234,
+			185, 142, 200, 255, 132, 137, 209, 219, 3, 223, 218, 207, 89, 191,
+			137, 217, 60, 220, 65, 234, 236, 169, 107, 78, 197, 213, 145, 81,
+			191, 197, 236, 99, 122, 58, 179, 235, 122, 204, 167, 152, 60, 136,
+			197, 60, 183, 121, 60, 197, 120, 88, 47, 223, 32, 32, 148, 122,
+			158, 235, 219, 127, 197, 243, 144, 21, 249, 162, 74, 48, 39, 94,
+			32, 133, 113, 119, 247, 152, 107, 31, 112, 246, 10, 187, 197, 62,
+			125, 61, 158, 206, 229, 206, 245, 227, 6, 123, 133, 233, 165, 235,
+			157, 187, 80, 89, 156, 105, 102, 129, 248, 255, 41, 42, 39, 86,
+			58, 89, 191, 123, 57, 90, 106, 251, 234, 49, 203, 176, 9, 212,
+			64, 25, 246, 36, 172, 43, 107, 143, 71, 26, 221, 75, 5, 151,
+			136, 192, 169, 9, 184, 174, 250, 169, 177, 39, 104, 158, 146, 21,
+			216, 19, 200, 209, 171, 245, 59, 119, 197, 93, 2, 189, 61, 6,
+			197, 86, 239, 101, 249, 219, 132, 129, 86, 233, 105, 138, 175, 229,
+			84, 58, 92, 175, 112, 101, 86, 254, 134, 203, 21, 158, 127, 137,
+			252, 175, 6, 49, 82, 61, 212, 156, 233, 121, 132, 172, 127, 105,
+			176, 121, 248, 246, 191, 1, 135, 222, 61, 117, 19, 53, 143, 101,
+			65, 8, 138, 188, 167, 83, 144, 196, 174, 195, 101, 40, 234, 218,
+			98, 248, 210, 31, 174, 57, 148, 89, 132, 57, 137, 229, 66, 220,
+			52, 46, 174, 15, 8, 166, 196, 109, 170, 2, 135, 29, 104, 145,
+			218, 105, 135, 204, 217, 115, 197, 187, 95, 54, 220, 48, 215, 106,
+			135, 55, 196, 109, 219, 78, 192, 38, 39, 225, 206, 60, 230, 122,
+			225, 228, 100, 244, 85, 126, 146, 44, 45, 131, 117, 175, 201, 118,
+			218, 187, 226, 38, 0, 39, 12, 120, 115, 247, 46, 115, 164, 188,
+			194, 27, 163, 226, 189, 146, 206, 154, 112, 175, 56, 172, 152, 246,
+			238, 46, 92, 217, 7, 183, 252, 206, 111, 172, 176, 208, 243, 64,
+			49, 102, 251, 182, 219, 104, 170, 58, 162, 87, 32, 217, 107, 94,
+			200, 231, 36, 101, 224, 50, 98, 147, 147, 7, 246, 241, 228, 164,
+			190, 65, 131, 185, 252, 72, 222, 174, 16, 221, 148, 183, 219, 6,
+			171, 68, 94, 67, 144, 130, 59, 3, 102, 82, 148, 124, 130, 152,
+			41, 113, 215, 200, 172, 113, 201, 154, 133, 219, 46, 15, 193, 93,
+			9, 167, 185, 153, 50, 0, 197, 154, 4, 109, 4, 101, 182, 228,
+			117, 108, 12, 242, 211, 73, 64, 0, 151, 117, 203, 111, 20, 1,
+			50, 40, 158, 189, 200, 200, 223, 68, 2, 59, 162, 248, 69, 99,
+			192, 250, 113, 196, 54, 213, 236, 182, 155, 205, 227, 136, 21, 106,
+			168, 224, 250, 7, 125, 188, 188, 76, 216, 155, 251, 176, 231, 216,
+			205, 166, 204, 13, 78, 101, 47, 92, 231, 160, 235, 192, 192, 59,
+			129, 190, 89, 94, 173, 35, 240, 118, 172, 32, 255, 160, 181, 111,
+			7, 240, 250, 234, 46, 92, 4, 228, 123, 45, 223, 177, 67, 245,
+			233, 39, 144, 40, 104, 140, 32, 131, 226, 23, 251, 243, 228, 31,
+			74, 250, 197, 101, 8, 3, 214, 223, 65, 108, 233, 36, 201, 90,
+			184, 180, 152, 40, 177, 229, 65, 124, 79, 139, 232, 0, 12, 80,
+			59, 0, 217, 217, 129, 203, 47, 189, 67, 7, 174, 61, 181, 225,
+			70, 111, 63, 212, 34, 174, 164, 115, 138, 192, 186, 206, 118, 109,
+			167, 217, 246, 57, 236, 100, 13, 15, 110, 61, 21, 247, 216, 215,
+			109, 216, 150, 225, 250, 93, 223, 135, 133, 177, 29, 180, 5, 59,
+			223, 93, 89, 19, 158, 144, 218, 124, 117, 121, 251, 97, 101, 109,
+			235, 93, 117, 137, 126, 170, 71, 222, 217, 96, 68, 16, 116, 168,
+			63, 79, 254, 31, 217, 61, 184, 119, 192, 160, 214, 119, 79, 237,
+			94, 98, 177, 125, 98, 15, 157, 32, 238, 152, 152, 106, 242, 182,
+			147, 96, 74, 78, 44, 113, 23, 138, 186, 151, 152, 232, 42, 110,
+			226, 94, 29, 61, 191, 246, 161, 208, 117, 253, 80, 47, 247, 153,
+			228, 156, 154, 154, 48, 151, 196, 101, 70, 34, 21, 202, 79, 178,
+			112, 223, 247, 142, 98, 158, 216, 208, 5, 159, 7, 237, 102, 196,
+			89, 209, 220, 53, 253, 250, 92, 157, 199, 188, 129, 139, 41, 22,
+			140, 126, 13, 25, 20, 47, 20, 6, 201, 79, 73, 222, 136, 27,
+			14, 6, 173, 47, 158, 202, 27, 199, 253, 248, 172, 209, 171, 16,
+			140, 179, 224, 71, 221, 243, 229, 245, 48, 96, 80, 199, 181, 92,
+			47, 186, 139, 90, 48, 85, 142, 71, 68, 60, 92, 143, 177, 108,
+			244, 105, 200, 160, 120, 121, 160, 64, 254, 19, 73, 60, 92, 73,
+			96, 20, 172, 255, 232, 116, 226, 15, 14, 218, 33, 168, 77, 79,
+			164, 93, 207, 40, 14, 125, 173, 243, 206, 49, 131, 107, 215, 197,
+			133, 247, 204, 38, 48, 226, 194, 186, 149, 3, 174, 181, 200, 29,
+			125, 213, 138, 232, 169, 207, 237, 221, 80, 188, 116, 44, 105, 134,
+			155, 58, 86, 141, 156, 234, 65, 202, 160, 120, 53, 63, 64, 126,
+			220, 16, 61, 72, 83, 92, 53, 206, 89, 159, 55, 162, 30, 168,
+			197, 253, 186, 118, 76, 223, 80, 68, 194, 5, 77, 46, 107, 187,
+			226, 117, 69, 222, 16, 119, 215, 60, 166, 103, 234, 240, 161, 88,
+			45, 212, 192, 136, 116, 222, 228, 194, 207, 160, 197, 6, 208, 8,
+			237, 194, 118, 143, 153, 237, 239, 56, 161, 111, 251, 199, 140, 137,
+			102, 166, 152, 111, 67, 143, 96, 166, 203, 11, 126, 68, 178, 248,
+			5, 143, 186, 49, 207, 119, 246, 28, 23, 102, 39, 137, 134, 191,
+			204, 226, 155, 4, 65, 129, 209, 173, 92, 11, 84, 109, 32, 201,
+			243, 147, 220, 11, 212, 56, 41, 30, 165, 17, 112, 165, 160, 33,
+			131, 226, 234, 208, 48, 249, 119, 37, 199, 122, 225, 118, 129, 162,
+			245, 127, 197, 99, 174, 250, 44, 180, 120, 24, 251, 232, 18, 45,
+			230, 122, 238, 77, 113, 57, 82, 180, 210, 139, 13, 3, 214, 72,
+			104, 57, 224, 103, 113, 80, 221, 41, 43, 250, 168, 250, 5, 57,
+			156, 73, 108, 10, 75, 66, 76, 166, 72, 71, 115, 178, 64, 247,
+			75, 153, 101, 182, 165, 145, 70, 15, 118, 236, 112, 102, 131, 214,
+			14, 227, 113, 180, 111, 135, 228, 4, 22, 184, 141, 255, 195, 22,
+			175, 199, 115, 2, 110, 99, 121, 203, 24, 210, 144, 65, 241, 91,
+			137, 55, 242, 126, 31, 157, 252, 38, 94, 136, 80, 237, 192, 14,
+			222, 63, 235, 155, 248, 75, 36, 43, 172, 189, 135, 118, 240, 62,
+			248, 109, 192, 193, 163, 159, 219, 146, 192, 217, 111, 81, 71, 21,
+			63, 234, 91, 212, 130, 44, 160, 234, 99, 189, 69, 253, 211, 238,
+			71, 123, 12, 80, 189, 164, 243, 184, 11, 107, 207, 242, 42, 60,
+			254, 161, 234, 39, 178, 219, 186, 216, 93, 162, 251, 66, 220, 10,
+			161, 112, 241, 213, 178, 32, 81, 95, 122, 69, 167, 163, 231, 215,
+			78, 127, 194, 186, 29, 238, 139, 26, 250, 93, 182, 210, 51, 100,
+			96, 153, 135, 50, 77, 93, 168, 123, 202, 231, 33, 165, 251, 132,
+			46, 138, 53, 173, 163, 228, 44, 73, 9, 52, 34, 14, 121, 118,
+			99, 11, 248, 119, 231, 141, 170, 44, 90, 250, 60, 34, 116, 187,
+			213, 248, 83, 64, 69, 239, 146, 92, 91, 96, 18, 140, 43, 26,
+			103, 92, 76, 27, 9, 91, 149, 200, 226, 32, 177, 165, 121, 66,
+			151, 120, 147, 119, 145, 49, 154, 236, 187, 164, 91, 36, 192, 55,
+			51, 60, 180, 247, 148, 203, 82, 252, 46, 125, 1, 147, 108, 68,
+			217, 169, 95, 213, 36, 174, 145, 53, 206, 184, 70, 22, 159, 126,
+			141, 172, 217, 113, 141, 108, 215, 133, 184, 169, 147, 23, 226, 198,
+			247, 220, 166, 59, 238, 185, 237, 124, 199, 177, 247, 227, 191, 10,
+			158, 233, 122, 21, 156, 62, 36, 84, 222, 137, 91, 19, 118, 149,
+			48, 220, 2, 245, 137, 248, 68, 231, 240, 201, 199, 26, 42, 81,
+			177, 234, 224, 65, 87, 138, 240, 135, 43, 189, 49, 168, 9, 117,
+			244, 208, 110, 202, 19, 190, 213, 130, 206, 152, 87, 233, 209, 104,
+			212, 19, 163, 113, 64, 10, 221, 237, 60, 225, 173, 194, 187, 36,
+			151, 48, 9, 139, 198, 19, 153, 67, 100, 113, 224, 86, 233, 159,
+			35, 50, 177, 225, 123, 45, 47, 224, 241, 3, 34, 234, 94, 103,
+			37, 76, 231, 147, 50, 157, 237, 16, 221, 139, 36, 103, 55, 26,
+			181, 78, 225, 32, 118, 163, 161, 112, 209, 103, 72, 222, 23, 71,
+			43, 163, 50, 82, 80, 250, 101, 170, 46, 214, 213, 5, 243, 163,
+			116, 1, 164, 205, 231, 118, 16, 9, 148, 130, 74, 47, 145, 113,
+			88, 90, 186, 187, 165, 239, 153, 123, 76, 191, 74, 143, 200, 133,
+			51, 170, 170, 5, 234, 78, 247, 253, 216, 167, 138, 74, 92, 51,
+			186, 35, 187, 180, 79, 46, 192, 141, 243, 252, 232, 44, 118, 15,
+			17, 195, 145, 7, 111, 177, 164, 201, 112, 26, 112, 125, 138, 148,
+			38, 174, 206, 251, 105, 48, 121, 63, 53, 238, 184, 159, 186, 244,
+			223, 152, 164, 208, 221, 8, 205, 199, 216, 5, 226, 97, 205, 4,
+			117, 62, 248, 212, 113, 197, 79, 49, 174, 230, 83, 140, 107, 234,
+			99, 142, 171, 90, 11, 228, 184, 210, 57, 241, 165, 101, 40, 15,
+			184, 192, 65, 141, 199, 114, 190, 44, 188, 59, 226, 162, 133, 144,
+			195, 217, 201, 150, 148, 246, 196, 106, 64, 116, 210, 194, 49, 189,
+			155, 40, 16, 6, 197, 236, 147, 41, 214, 197, 183, 2, 192, 14,
+			79, 119, 241, 35, 137, 157, 72, 236, 58, 73, 98, 143, 10, 168,
+			79, 66, 158, 128, 93, 23, 223, 82, 60, 7, 72, 199, 204, 212,
+			61, 41, 253, 178, 204, 162, 26, 246, 101, 146, 18, 93, 6, 135,
+			250, 230, 214, 252, 86, 165, 203, 143, 159, 35, 189, 27, 149, 53,
+			136, 206, 75, 55, 254, 252, 198, 70, 117, 253, 13, 225, 198, 23,
+			78, 253, 7, 149, 69, 136, 123, 227, 210, 235, 242, 29, 224, 246,
+			206, 158, 111, 183, 246, 181, 120, 62, 223, 189, 22, 157, 216, 229,
+			162, 71, 75, 18, 139, 84, 233, 54, 201, 104, 76, 244, 58, 188,
+			243, 218, 136, 166, 14, 237, 172, 190, 230, 53, 56, 188, 253, 218,
+			224, 65, 105, 131, 100, 35, 108, 116, 154, 152, 239, 59, 174, 62,
+			147, 62, 118, 70, 163, 175, 59, 110, 163, 42, 10, 70, 91, 153,
+			218, 236, 224, 119, 233, 51, 196, 132, 6, 62, 102, 55, 96, 132,
+			181, 205, 6, 35, 12, 11, 94, 170, 74, 116, 210, 194, 241, 100,
+			149, 244, 119, 144, 2, 97, 149, 141, 234, 202, 218, 226, 202, 198,
+			252, 106, 13, 46, 3, 62, 25, 86, 89, 89, 170, 172, 109, 173,
+			108, 65, 204, 43, 75, 82, 203, 213, 245, 237, 13, 121, 182, 97,
+			121, 117, 125, 161, 128, 103, 255, 36, 69, 210, 98, 115, 14, 232,
+			61, 66, 98, 117, 137, 142, 156, 144, 156, 10, 232, 207, 86, 215,
+			27, 169, 167, 40, 88, 11, 36, 163, 245, 37, 122, 161, 179, 180,
+			78, 87, 3, 110, 157, 165, 195, 208, 251, 36, 151, 80, 166, 186,
+			31, 102, 61, 169, 103, 61, 22, 83, 66, 151, 234, 198, 116, 82,
+			205, 58, 27, 211, 50, 201, 37, 212, 161, 110, 76, 39, 53, 37,
+			235, 12, 22, 234, 87, 103, 181, 200, 118, 33, 58, 57, 47, 78,
+			188, 58, 171, 107, 238, 145, 209, 51, 246, 87, 58, 213, 89, 229,
+			241, 219, 176, 245, 132, 29, 134, 182, 228, 203, 18, 221, 233, 1,
+			157, 236, 172, 120, 198, 198, 38, 7, 251, 217, 167, 42, 171, 132,
+			136, 147, 145, 211, 183, 50, 218, 133, 230, 177, 27, 222, 147, 58,
+			246, 81, 239, 78, 255, 220, 22, 233, 165, 169, 124, 207, 159, 160,
+			199, 94, 158, 126, 235, 251, 151, 167, 127, 255, 242, 244, 63, 245,
+			203, 211, 225, 39, 130, 216, 218, 51, 80, 0, 27, 16, 81, 155,
+			20, 63, 49, 197, 163, 61, 55, 200, 107, 242, 118, 117, 171, 103,
+			30, 89, 183, 153, 88, 138, 158, 238, 102, 245, 232, 57, 92, 125,
+			169, 186, 149, 201, 147, 159, 69, 250, 86, 245, 9, 163, 98, 253,
+			199, 136, 197, 75, 125, 236, 13, 82, 87, 170, 171, 71, 243, 234,
+			109, 223, 231, 110, 216, 60, 102, 65, 232, 169, 208, 73, 52, 11,
+			52, 37, 132, 53, 162, 27, 215, 217, 86, 92, 185, 219, 177, 3,
+			149, 237, 102, 107, 223, 222, 225, 161, 83, 183, 155, 202, 209, 181,
+			99, 195, 20, 130, 23, 161, 246, 185, 227, 19, 182, 178, 212, 241,
+			140, 239, 68, 186, 144, 120, 198, 119, 98, 240, 106, 226, 25, 223,
+			137, 91, 139, 228, 13, 253, 138, 239, 37, 227, 182, 181, 194, 244,
+			134, 20, 245, 232, 148, 23, 85, 101, 80, 233, 208, 105, 192, 235,
+			175, 162, 167, 83, 42, 6, 4, 194, 0, 59, 127, 199, 91, 188,
+			151, 210, 249, 196, 91, 188, 151, 6, 38, 18, 111, 241, 94, 186,
+			49, 75, 166, 5, 5, 6, 197, 87, 140, 57, 171, 196, 18, 123,
+			153, 242, 137, 10, 39, 27, 63, 146, 124, 137, 80, 3, 205, 87,
+			162, 55, 138, 69, 253, 232, 141, 98, 3, 83, 124, 101, 250, 14,
+			220, 193, 154, 22, 158, 249, 107, 198, 156, 117, 157, 37, 54, 55,
+			38, 205, 122, 144, 73, 249, 120, 19, 200, 106, 103, 3, 224, 209,
+			190, 22, 53, 0, 226, 117, 45, 106, 0, 110, 21, 191, 54, 125,
+			135, 60, 43, 26, 48, 225, 250, 241, 123, 214, 4, 75, 236, 121,
+			172, 33, 126, 3, 237, 157, 104, 193, 215, 60, 25, 161, 53, 13,
+			138, 39, 35, 180, 112, 49, 243, 228, 244, 18, 249, 61, 41, 106,
+			41, 138, 167, 141, 151, 172, 223, 66, 44, 177, 7, 70, 35, 99,
+			179, 40, 233, 200, 9, 247, 97, 104, 78, 14, 150, 146, 37, 240,
+			55, 18, 237, 1, 239, 120, 138, 249, 122, 139, 251, 251, 242, 253,
+			96, 249, 4, 99, 83, 248, 77, 15, 29, 155, 9, 103, 195, 141,
+			50, 187, 207, 253, 206, 58, 78, 64, 244, 58, 97, 187, 234, 253,
+			56, 184, 28, 92, 117, 21, 234, 203, 218, 236, 122, 192, 57, 235,
+			208, 209, 24, 119, 219, 7, 42, 108, 144, 150, 126, 235, 233, 136,
+			27, 224, 183, 158, 142, 184, 145, 194, 20, 79, 79, 191, 72, 126,
+			196, 16, 220, 16, 15, 192, 62, 178, 190, 135, 216, 25, 155, 55,
+			83, 214, 1, 240, 92, 71, 22, 229, 43, 105, 202, 110, 218, 119,
+			90, 42, 234, 169, 70, 68, 76, 55, 85, 20, 92, 223, 176, 60,
+			139, 201, 4, 76, 16, 78, 250, 16, 214, 88, 101, 254, 201, 37,
+			220, 101, 194, 77, 162, 55, 9, 209, 99, 34, 248, 117, 250, 206,
+			91, 86, 190, 95, 233, 218, 133, 48, 7, 59, 178, 143, 129, 50,
+			77, 99, 76, 32, 243, 118, 73, 196, 69, 24, 51, 189, 123, 104,
+			26, 244, 187, 161, 192, 14, 120, 2, 55, 125, 65, 67, 6, 197,
+			47, 76, 188, 172, 33, 120, 16, 119, 249, 77, 210, 20, 140, 235,
+			133, 91, 206, 119, 172, 26, 59, 85, 195, 136, 228, 41, 220, 143,
+			56, 216, 72, 114, 76, 25, 211, 9, 206, 77, 17, 118, 224, 5,
+			240, 84, 90, 157, 187, 161, 124, 9, 32, 162, 11, 252, 198, 115,
+			233, 49, 13, 25, 20, 207, 141, 223, 209, 16, 92, 170, 190, 248,
+			174, 150, 239, 12, 92, 26, 254, 38, 200, 247, 233, 172, 211, 140,
+			7, 159, 62, 243, 57, 220, 56, 11, 99, 11, 247, 138, 194, 116,
+			61, 65, 35, 140, 168, 244, 247, 64, 182, 56, 210, 162, 226, 189,
+			154, 213, 137, 23, 74, 203, 209, 235, 207, 252, 136, 251, 76, 68,
+			193, 118, 120, 52, 190, 164, 99, 128, 69, 32, 81, 7, 202, 96,
+			81, 134, 28, 207, 229, 236, 104, 223, 139, 153, 22, 183, 20, 113,
+			3, 46, 39, 127, 45, 61, 174, 33, 131, 226, 215, 46, 204, 105,
+			8, 46, 70, 175, 108, 147, 117, 249, 114, 198, 82, 207, 10, 178,
+			22, 217, 73, 3, 130, 57, 39, 54, 22, 8, 117, 116, 111, 39,
+			234, 59, 48, 117, 65, 62, 68, 166, 151, 50, 22, 249, 249, 232,
+			117, 242, 251, 6, 179, 254, 166, 220, 181, 186, 158, 125, 103, 43,
+			174, 218, 76, 66, 143, 189, 207, 121, 171, 227, 153, 5, 214, 116,
+			246, 246, 195, 35, 14, 255, 79, 49, 110, 215, 247, 9, 139, 140,
+			0, 21, 76, 149, 175, 148, 200, 137, 163, 175, 245, 152, 98, 250,
+			165, 242, 228, 72, 1, 229, 58, 22, 13, 117, 197, 243, 30, 77,
+			190, 27, 202, 168, 135, 186, 69, 184, 199, 232, 73, 92, 104, 222,
+			35, 94, 70, 184, 159, 27, 214, 16, 162, 248, 254, 185, 49, 13,
+			193, 133, 230, 19, 23, 97, 183, 135, 23, 30, 86, 123, 214, 196,
+			110, 223, 105, 89, 1, 15, 67, 79, 105, 42, 199, 167, 237, 97,
+			138, 111, 112, 157, 255, 106, 102, 148, 92, 209, 207, 56, 60, 52,
+			104, 105, 20, 158, 220, 157, 99, 37, 187, 1, 7, 178, 131, 208,
+			135, 3, 21, 65, 41, 122, 183, 1, 94, 164, 85, 151, 9, 35,
+			65, 221, 195, 108, 191, 134, 224, 53, 218, 194, 32, 185, 71, 32,
+			174, 104, 126, 178, 231, 13, 100, 205, 37, 55, 57, 77, 160, 138,
+			241, 40, 121, 245, 121, 226, 52, 79, 114, 243, 211, 143, 31, 124,
+			50, 99, 145, 255, 25, 233, 215, 15, 182, 141, 121, 235, 127, 128,
+			96, 85, 104, 59, 241, 155, 149, 209, 179, 188, 114, 31, 45, 71,
+			175, 203, 39, 248, 15, 236, 7, 189, 51, 228, 209, 155, 146, 46,
+			63, 34, 146, 39, 115, 93, 239, 30, 106, 77, 143, 171, 40, 152,
+			10, 100, 5, 251, 94, 187, 217, 0, 76, 118, 59, 244, 14, 108,
+			161, 158, 64, 180, 78, 223, 79, 212, 96, 215, 197, 155, 197, 202,
+			19, 60, 125, 224, 53, 196, 169, 130, 248, 25, 248, 224, 134, 136,
+			225, 233, 87, 230, 119, 184, 62, 65, 18, 93, 43, 13, 34, 176,
+			109, 228, 52, 4, 215, 146, 247, 233, 171, 172, 65, 153, 217, 30,
+			214, 111, 24, 244, 100, 40, 222, 30, 121, 141, 12, 144, 12, 212,
+			203, 124, 37, 3, 28, 42, 190, 74, 86, 228, 43, 209, 143, 122,
+			222, 133, 215, 118, 78, 154, 186, 241, 24, 216, 174, 82, 16, 128,
+			39, 167, 169, 8, 250, 113, 232, 71, 25, 139, 124, 160, 223, 209,
+			124, 219, 152, 183, 26, 103, 14, 130, 68, 152, 80, 241, 174, 5,
+			236, 26, 104, 75, 215, 226, 224, 171, 122, 8, 147, 116, 62, 202,
+			218, 141, 66, 63, 190, 8, 44, 121, 91, 177, 68, 62, 192, 249,
+			182, 98, 137, 124, 128, 243, 109, 197, 18, 44, 88, 242, 182, 98,
+			9, 214, 44, 121, 187, 248, 170, 120, 189, 94, 188, 207, 89, 51,
+			166, 172, 203, 130, 54, 253, 94, 137, 30, 97, 15, 6, 86, 182,
+			172, 71, 3, 27, 40, 77, 113, 77, 61, 47, 33, 223, 227, 172,
+			141, 95, 211, 16, 166, 184, 54, 249, 44, 89, 32, 134, 105, 82,
+			179, 222, 243, 62, 178, 94, 72, 234, 70, 167, 138, 188, 208, 151,
+			34, 145, 79, 242, 25, 52, 166, 122, 198, 2, 101, 203, 52, 129,
+			207, 220, 120, 197, 154, 96, 240, 205, 220, 9, 38, 11, 44, 154,
+			67, 166, 120, 225, 131, 171, 153, 105, 10, 14, 113, 53, 51, 77,
+			193, 33, 94, 24, 212, 80, 134, 98, 78, 95, 22, 28, 50, 53,
+			135, 248, 240, 28, 249, 6, 76, 50, 19, 88, 244, 158, 65, 173,
+			255, 12, 9, 30, 41, 197, 158, 65, 20, 161, 131, 134, 50, 97,
+			43, 187, 176, 188, 136, 156, 228, 121, 5, 216, 65, 244, 209, 12,
+			249, 184, 52, 11, 31, 135, 105, 138, 196, 44, 209, 115, 98, 167,
+			233, 193, 181, 44, 98, 55, 178, 93, 54, 191, 176, 94, 221, 170,
+			44, 169, 227, 26, 221, 246, 66, 196, 4, 120, 175, 228, 189, 136,
+			9, 48, 86, 239, 69, 76, 0, 37, 252, 189, 194, 32, 121, 158,
+			24, 102, 138, 166, 220, 158, 47, 32, 100, 93, 79, 172, 239, 13,
+			190, 235, 184, 60, 120, 204, 138, 9, 42, 156, 155, 25, 36, 55,
+			136, 105, 166, 96, 120, 60, 131, 150, 198, 245, 138, 217, 14, 247,
+			111, 42, 67, 231, 166, 188, 55, 72, 45, 155, 41, 163, 39, 241,
+			76, 106, 74, 12, 142, 167, 232, 74, 137, 193, 241, 10, 131, 100,
+			82, 32, 69, 20, 183, 140, 137, 210, 5, 137, 244, 211, 242, 161,
+			253, 176, 227, 137, 253, 119, 52, 86, 120, 178, 184, 165, 54, 142,
+			148, 232, 123, 75, 93, 121, 159, 18, 114, 218, 82, 15, 74, 164,
+			132, 156, 182, 198, 47, 40, 194, 13, 120, 81, 117, 188, 52, 222,
+			209, 198, 100, 71, 27, 26, 169, 145, 120, 125, 53, 101, 24, 137,
+			215, 87, 83, 134, 145, 120, 125, 53, 37, 222, 117, 249, 192, 26,
+			19, 183, 234, 167, 0, 240, 141, 11, 165, 155, 186, 9, 219, 245,
+			192, 216, 190, 41, 36, 231, 230, 76, 105, 138, 117, 37, 221, 138,
+			187, 5, 47, 190, 250, 81, 155, 240, 40, 140, 31, 181, 9, 11,
+			145, 175, 222, 230, 72, 25, 24, 154, 25, 27, 23, 83, 59, 5,
+			164, 6, 198, 249, 210, 101, 53, 30, 66, 23, 85, 175, 157, 171,
+			229, 6, 172, 248, 114, 185, 28, 13, 11, 60, 31, 28, 68, 195,
+			2, 147, 47, 80, 143, 178, 164, 96, 249, 196, 193, 104, 81, 236,
+			142, 41, 232, 120, 104, 12, 63, 126, 119, 76, 137, 39, 101, 194,
+			8, 31, 72, 75, 168, 94, 83, 73, 9, 21, 31, 162, 48, 101,
+			129, 47, 77, 113, 219, 120, 182, 116, 73, 225, 187, 245, 210, 139,
+			179, 55, 103, 110, 221, 156, 185, 181, 117, 107, 102, 110, 102, 102,
+			110, 118, 166, 60, 51, 123, 235, 83, 17, 230, 180, 168, 48, 166,
+			33, 68, 113, 123, 252, 170, 134, 48, 197, 237, 27, 147, 98, 209,
+			72, 25, 189, 20, 31, 26, 197, 210, 132, 194, 44, 198, 54, 228,
+			65, 167, 8, 105, 180, 240, 42, 205, 97, 68, 48, 232, 179, 135,
+			234, 29, 151, 148, 208, 96, 15, 71, 70, 201, 79, 195, 170, 144,
+			50, 50, 20, 255, 128, 241, 156, 245, 101, 196, 226, 8, 162, 220,
+			212, 96, 85, 128, 183, 249, 245, 156, 86, 42, 80, 153, 85, 236,
+			250, 190, 124, 202, 72, 234, 156, 226, 177, 35, 88, 101, 225, 169,
+			51, 109, 75, 129, 102, 247, 174, 170, 242, 110, 89, 34, 143, 181,
+			114, 241, 254, 146, 186, 117, 37, 126, 152, 95, 89, 34, 45, 238,
+			59, 30, 156, 51, 105, 54, 181, 54, 149, 50, 50, 38, 16, 26,
+			65, 105, 138, 127, 32, 167, 249, 6, 42, 234, 15, 140, 151, 53,
+			132, 41, 254, 129, 91, 179, 228, 239, 201, 14, 102, 169, 249, 57,
+			100, 76, 88, 95, 71, 176, 174, 133, 126, 155, 79, 37, 187, 211,
+			177, 98, 193, 107, 109, 82, 5, 236, 56, 171, 228, 123, 237, 189,
+			125, 173, 202, 55, 200, 73, 181, 61, 208, 102, 226, 169, 86, 221,
+			141, 50, 91, 7, 156, 157, 210, 5, 109, 17, 85, 95, 251, 253,
+			120, 19, 246, 167, 126, 209, 141, 108, 74, 16, 158, 214, 32, 2,
+			176, 183, 168, 65, 12, 224, 216, 5, 242, 171, 166, 232, 37, 161,
+			230, 127, 128, 140, 33, 235, 191, 54, 225, 200, 172, 215, 178, 63,
+			104, 115, 125, 204, 89, 104, 56, 201, 147, 59, 156, 169, 87, 104,
+			59, 59, 191, 195, 65, 63, 128, 207, 231, 226, 83, 140, 122, 73,
+			14, 96, 175, 80, 218, 149, 188, 153, 178, 235, 100, 31, 44, 232,
+			177, 22, 37, 138, 239, 216, 245, 247, 137, 174, 164, 78, 240, 193,
+			84, 85, 122, 9, 212, 16, 251, 67, 164, 156, 129, 9, 31, 171,
+			241, 16, 165, 128, 83, 176, 246, 30, 60, 31, 24, 18, 240, 119,
+			233, 189, 70, 170, 95, 242, 123, 53, 185, 121, 4, 101, 225, 220,
+			131, 175, 8, 130, 185, 105, 245, 133, 69, 217, 118, 90, 229, 6,
+			63, 156, 190, 245, 252, 109, 232, 17, 175, 239, 187, 234, 208, 105,
+			168, 76, 90, 155, 149, 224, 14, 45, 177, 123, 149, 166, 216, 1,
+			183, 221, 152, 103, 187, 44, 60, 242, 226, 173, 36, 96, 251, 246,
+			161, 176, 156, 8, 11, 96, 239, 134, 74, 66, 154, 142, 213, 131,
+			98, 33, 219, 107, 219, 240, 218, 185, 114, 137, 238, 112, 182, 115,
+			28, 242, 155, 187, 158, 127, 19, 126, 168, 233, 81, 183, 155, 145,
+			81, 13, 230, 131, 60, 202, 25, 251, 18, 247, 61, 175, 193, 142,
+			120, 164, 125, 130, 21, 159, 244, 146, 177, 166, 29, 132, 55, 59,
+			116, 80, 194, 174, 131, 7, 101, 15, 252, 195, 78, 160, 135, 66,
+			217, 122, 62, 87, 94, 1, 56, 168, 234, 200, 19, 121, 74, 242,
+			28, 87, 157, 7, 190, 17, 73, 30, 73, 9, 97, 202, 104, 16,
+			1, 152, 237, 215, 32, 6, 176, 64, 201, 3, 98, 152, 105, 154,
+			254, 81, 212, 243, 99, 8, 89, 47, 179, 238, 115, 8, 209, 198,
+			11, 15, 35, 51, 91, 205, 154, 132, 114, 164, 142, 166, 131, 39,
+			61, 71, 176, 153, 70, 212, 252, 81, 148, 41, 138, 221, 44, 109,
+			244, 80, 243, 175, 34, 99, 180, 52, 214, 177, 226, 117, 45, 119,
+			64, 82, 26, 182, 97, 40, 43, 9, 78, 195, 62, 108, 254, 85,
+			148, 165, 26, 196, 128, 233, 220, 8, 233, 19, 120, 17, 53, 191,
+			136, 140, 41, 149, 137, 210, 2, 28, 211, 160, 200, 29, 191, 166,
+			65, 12, 224, 228, 179, 226, 237, 189, 94, 154, 254, 9, 212, 243,
+			55, 16, 178, 30, 158, 53, 211, 181, 90, 248, 36, 55, 78, 167,
+			134, 8, 157, 239, 69, 212, 252, 9, 148, 185, 74, 166, 136, 105,
+			246, 66, 231, 191, 132, 140, 87, 207, 210, 17, 35, 19, 29, 200,
+			236, 21, 253, 255, 146, 238, 127, 175, 232, 255, 151, 80, 54, 175,
+			65, 12, 200, 6, 169, 6, 51, 0, 14, 189, 66, 10, 36, 3,
+			185, 194, 184, 48, 191, 132, 206, 221, 21, 47, 27, 246, 26, 136,
+			154, 95, 70, 70, 201, 122, 149, 173, 8, 97, 13, 133, 11, 194,
+			99, 118, 163, 161, 187, 161, 92, 166, 143, 209, 101, 34, 218, 144,
+			41, 208, 69, 96, 10, 192, 220, 160, 6, 69, 99, 244, 130, 6,
+			49, 128, 236, 146, 120, 251, 172, 215, 48, 168, 249, 147, 200, 120,
+			198, 186, 218, 69, 137, 220, 56, 186, 246, 141, 168, 69, 195, 20,
+			213, 34, 48, 5, 96, 212, 34, 116, 239, 39, 17, 101, 26, 196,
+			0, 94, 190, 66, 94, 21, 45, 98, 106, 126, 5, 25, 83, 214,
+			12, 236, 21, 1, 15, 197, 228, 134, 158, 39, 182, 46, 41, 181,
+			76, 31, 166, 132, 189, 50, 106, 27, 167, 5, 130, 49, 13, 34,
+			0, 149, 56, 245, 130, 94, 99, 126, 5, 77, 62, 43, 30, 0,
+			235, 5, 58, 127, 10, 25, 195, 214, 11, 236, 65, 59, 8, 227,
+			245, 76, 141, 182, 28, 228, 41, 152, 202, 71, 145, 101, 171, 189,
+			61, 65, 212, 164, 153, 18, 104, 244, 224, 131, 3, 225, 167, 80,
+			118, 64, 131, 24, 64, 58, 68, 170, 196, 48, 51, 52, 253, 85,
+			212, 243, 159, 34, 100, 45, 157, 238, 73, 171, 158, 48, 146, 245,
+			84, 13, 61, 113, 146, 86, 73, 30, 216, 231, 74, 112, 51, 136,
+			154, 95, 69, 153, 43, 164, 68, 76, 51, 3, 130, 251, 53, 16,
+			220, 225, 147, 130, 171, 40, 206, 8, 113, 253, 154, 166, 56, 35,
+			196, 245, 107, 90, 92, 51, 66, 92, 191, 166, 197, 53, 3, 118,
+			159, 249, 53, 45, 174, 25, 45, 174, 95, 3, 113, 125, 68, 12,
+			51, 75, 211, 63, 131, 122, 254, 22, 66, 214, 235, 103, 117, 169,
+			251, 101, 204, 196, 60, 76, 238, 224, 39, 166, 100, 22, 81, 243,
+			103, 80, 230, 25, 177, 110, 100, 161, 103, 63, 139, 140, 235, 162,
+			15, 89, 112, 238, 152, 63, 171, 133, 44, 11, 222, 29, 243, 103,
+			81, 110, 76, 131, 8, 10, 143, 95, 214, 32, 6, 240, 234, 53,
+			242, 6, 49, 76, 66, 211, 63, 135, 122, 254, 75, 132, 172, 251,
+			103, 56, 13, 245, 40, 156, 238, 59, 60, 65, 186, 34, 151, 32,
+			106, 254, 28, 144, 11, 43, 8, 1, 114, 127, 30, 25, 115, 214,
+			4, 91, 89, 210, 195, 112, 90, 85, 160, 144, 136, 33, 249, 121,
+			100, 244, 106, 16, 65, 237, 76, 148, 139, 1, 236, 207, 107, 48,
+			3, 224, 192, 75, 98, 5, 33, 122, 5, 249, 121, 52, 248, 34,
+			89, 17, 109, 35, 106, 254, 109, 80, 70, 238, 178, 45, 95, 158,
+			204, 85, 93, 145, 102, 95, 171, 213, 60, 238, 144, 112, 113, 165,
+			21, 20, 147, 189, 100, 78, 24, 17, 134, 82, 2, 87, 90, 131,
+			2, 117, 175, 166, 4, 97, 0, 7, 41, 185, 37, 218, 53, 168,
+			249, 119, 145, 113, 206, 186, 204, 244, 67, 4, 76, 157, 125, 209,
+			28, 208, 211, 39, 194, 111, 164, 68, 157, 140, 6, 17, 128, 217,
+			130, 6, 49, 128, 67, 195, 100, 131, 24, 102, 142, 166, 255, 62,
+			234, 249, 239, 16, 178, 22, 88, 247, 144, 73, 77, 34, 114, 163,
+			62, 229, 162, 159, 67, 212, 252, 251, 176, 227, 77, 19, 211, 204,
+			153, 61, 52, 253, 13, 100, 252, 18, 194, 214, 69, 245, 145, 153,
+			168, 113, 214, 168, 229, 76, 152, 57, 223, 64, 189, 125, 36, 79,
+			210, 80, 31, 6, 253, 191, 66, 166, 69, 6, 72, 175, 132, 145,
+			72, 56, 23, 39, 24, 144, 80, 60, 79, 110, 170, 26, 136, 154,
+			255, 0, 153, 5, 235, 66, 50, 144, 96, 31, 217, 78, 8, 29,
+			146, 236, 42, 147, 168, 62, 146, 229, 115, 113, 130, 1, 9, 249,
+			1, 178, 168, 16, 26, 212, 252, 69, 100, 14, 90, 207, 37, 17,
+			194, 43, 194, 74, 4, 148, 233, 175, 226, 20, 138, 67, 154, 41,
+			26, 43, 44, 210, 191, 136, 204, 190, 56, 65, 160, 29, 40, 144,
+			73, 213, 12, 166, 230, 63, 132, 102, 172, 238, 102, 164, 8, 241,
+			70, 2, 27, 70, 162, 112, 140, 13, 27, 144, 48, 0, 97, 69,
+			211, 204, 1, 215, 126, 25, 25, 114, 197, 204, 137, 169, 240, 203,
+			122, 42, 228, 4, 11, 127, 89, 79, 133, 156, 152, 202, 191, 140,
+			250, 243, 170, 42, 162, 230, 55, 145, 49, 164, 50, 65, 88, 191,
+			169, 133, 41, 39, 54, 183, 111, 234, 133, 45, 39, 132, 245, 155,
+			176, 176, 201, 170, 6, 53, 191, 133, 140, 146, 202, 52, 76, 1,
+			234, 102, 64, 44, 191, 165, 55, 173, 28, 152, 228, 230, 183, 244,
+			54, 153, 19, 98, 249, 45, 196, 46, 41, 76, 152, 154, 191, 130,
+			140, 103, 84, 38, 54, 5, 168, 49, 225, 20, 128, 17, 38, 224,
+			197, 175, 232, 237, 47, 39, 118, 164, 95, 65, 151, 175, 40, 76,
+			38, 53, 255, 145, 214, 141, 114, 160, 221, 1, 56, 166, 65, 4,
+			185, 106, 51, 203, 137, 157, 229, 31, 193, 102, 38, 171, 166, 168,
+			249, 143, 145, 49, 172, 50, 83, 18, 212, 156, 72, 33, 0, 213,
+			166, 148, 3, 155, 217, 252, 199, 136, 14, 169, 170, 105, 106, 254,
+			19, 100, 80, 85, 53, 45, 65, 205, 127, 208, 10, 255, 9, 202,
+			244, 107, 16, 3, 88, 24, 20, 122, 82, 206, 232, 165, 230, 175,
+			34, 227, 252, 19, 205, 98, 89, 185, 55, 37, 138, 107, 170, 64,
+			231, 250, 85, 148, 213, 52, 247, 98, 0, 71, 139, 138, 170, 12,
+			53, 127, 45, 230, 69, 38, 45, 64, 205, 11, 216, 245, 126, 45,
+			230, 69, 6, 3, 24, 241, 34, 75, 205, 95, 71, 134, 165, 50,
+			193, 144, 251, 245, 184, 85, 216, 86, 126, 61, 110, 53, 139, 1,
+			28, 61, 175, 170, 18, 106, 254, 6, 50, 110, 170, 76, 248, 238,
+			251, 55, 226, 86, 97, 137, 255, 141, 184, 85, 130, 1, 156, 156,
+			82, 85, 115, 212, 252, 77, 100, 104, 25, 201, 165, 4, 168, 91,
+			133, 165, 230, 55, 81, 118, 84, 131, 24, 64, 107, 156, 252, 24,
+			60, 122, 222, 71, 211, 191, 133, 122, 126, 27, 33, 235, 135, 58,
+			2, 181, 122, 35, 234, 216, 58, 163, 88, 168, 178, 145, 212, 39,
+			140, 226, 163, 58, 181, 202, 6, 220, 246, 33, 198, 2, 198, 158,
+			136, 216, 194, 225, 9, 49, 239, 85, 12, 209, 247, 188, 232, 131,
+			44, 245, 97, 89, 160, 218, 84, 75, 99, 31, 162, 230, 111, 161,
+			140, 37, 250, 214, 7, 83, 244, 159, 105, 142, 246, 137, 221, 246,
+			159, 33, 35, 167, 65, 4, 185, 125, 231, 52, 136, 1, 44, 158,
+			39, 95, 130, 190, 245, 211, 244, 191, 64, 61, 255, 18, 33, 235,
+			223, 151, 142, 207, 40, 228, 28, 157, 74, 216, 57, 238, 136, 78,
+			87, 55, 22, 117, 96, 21, 142, 21, 50, 31, 238, 230, 3, 131,
+			22, 236, 194, 100, 16, 185, 101, 7, 129, 92, 195, 146, 213, 97,
+			39, 104, 30, 217, 199, 129, 254, 188, 13, 30, 25, 87, 95, 94,
+			130, 10, 165, 58, 216, 143, 168, 249, 47, 80, 70, 174, 65, 253,
+			208, 193, 223, 209, 29, 236, 23, 218, 197, 239, 232, 57, 220, 47,
+			250, 251, 59, 40, 55, 160, 65, 4, 133, 11, 231, 116, 97, 12,
+			96, 241, 60, 249, 164, 248, 116, 54, 253, 187, 168, 231, 59, 8,
+			226, 112, 157, 81, 235, 70, 244, 25, 144, 124, 68, 26, 248, 31,
+			247, 165, 243, 155, 107, 232, 172, 136, 100, 229, 228, 23, 162, 230,
+			239, 162, 148, 88, 183, 82, 16, 135, 51, 127, 15, 25, 151, 160,
+			113, 128, 144, 0, 199, 53, 104, 0, 120, 145, 145, 53, 245, 193,
+			167, 249, 175, 144, 49, 96, 189, 198, 230, 89, 224, 184, 123, 77,
+			158, 116, 193, 198, 97, 119, 17, 90, 145, 147, 23, 60, 78, 158,
+			203, 59, 230, 175, 220, 173, 1, 159, 68, 24, 129, 6, 128, 253,
+			121, 112, 20, 194, 231, 140, 212, 252, 215, 200, 232, 179, 74, 108,
+			94, 110, 179, 226, 3, 111, 141, 29, 16, 75, 167, 100, 140, 15,
+			8, 252, 215, 106, 173, 1, 208, 0, 144, 228, 200, 93, 245, 61,
+			164, 249, 109, 100, 228, 172, 155, 224, 82, 137, 252, 90, 112, 42,
+			32, 73, 241, 228, 233, 164, 194, 66, 251, 109, 165, 184, 0, 104,
+			0, 152, 37, 228, 159, 130, 80, 230, 105, 250, 223, 160, 158, 255,
+			3, 33, 235, 191, 69, 241, 48, 9, 37, 194, 85, 204, 151, 179,
+			171, 110, 187, 224, 78, 217, 245, 218, 48, 121, 164, 16, 105, 73,
+			43, 179, 249, 68, 93, 225, 77, 138, 68, 85, 43, 26, 83, 17,
+			221, 242, 59, 231, 166, 183, 35, 253, 36, 81, 69, 113, 162, 1,
+			38, 106, 189, 105, 199, 239, 118, 49, 207, 37, 226, 243, 99, 8,
+			150, 38, 90, 129, 131, 178, 64, 102, 212, 144, 216, 104, 193, 128,
+			205, 35, 106, 254, 27, 148, 145, 254, 238, 60, 72, 201, 31, 192,
+			194, 60, 46, 57, 165, 207, 172, 78, 49, 113, 94, 117, 138, 45,
+			175, 174, 47, 136, 81, 48, 243, 66, 188, 255, 64, 111, 192, 121,
+			33, 82, 127, 128, 10, 195, 26, 196, 128, 106, 180, 72, 30, 10,
+			196, 136, 154, 223, 69, 6, 45, 125, 226, 204, 33, 152, 234, 24,
+			235, 41, 181, 41, 28, 204, 116, 20, 210, 109, 195, 150, 253, 93,
+			189, 76, 230, 133, 128, 125, 87, 251, 58, 242, 98, 203, 254, 46,
+			42, 12, 146, 255, 2, 70, 109, 128, 166, 255, 45, 234, 249, 19,
+			132, 32, 8, 45, 156, 160, 112, 54, 24, 216, 97, 199, 12, 137,
+			76, 56, 59, 102, 91, 153, 200, 226, 122, 141, 147, 17, 66, 225,
+			103, 84, 75, 43, 243, 92, 46, 177, 181, 184, 31, 79, 203, 187,
+			48, 230, 194, 195, 206, 142, 60, 191, 17, 136, 207, 231, 226, 73,
+			219, 118, 157, 15, 218, 188, 121, 172, 6, 89, 25, 108, 128, 70,
+			13, 202, 0, 162, 230, 191, 69, 153, 62, 242, 34, 49, 205, 1,
+			24, 148, 63, 68, 134, 101, 221, 16, 11, 97, 140, 39, 49, 154,
+			112, 64, 68, 56, 144, 96, 233, 83, 138, 230, 128, 24, 161, 63,
+			212, 11, 238, 128, 24, 161, 63, 212, 11, 238, 128, 24, 161, 63,
+			68, 197, 243, 228, 88, 180, 130, 168, 249, 199, 200, 184, 100, 189,
+			47, 58, 164, 2, 172, 209, 201, 28, 125, 130, 71, 52, 18, 145,
+			0, 11, 174, 96, 145, 19, 242, 3, 53, 15, 196, 211, 102, 176,
+			74, 217, 2, 17, 176, 66, 139, 254, 181, 128, 189, 11, 4, 6,
+			239, 170, 239, 59, 21, 37, 224, 108, 248, 99, 189, 110, 14, 64,
+			232, 196, 252, 99, 148, 43, 104, 80, 80, 54, 56, 174, 65, 12,
+			224, 69, 182, 147, 110, 249, 94, 232, 61, 247, 255, 14, 0, 177,
+			222, 120, 151, 92, 185, 0, 0},
 	)
 }
 
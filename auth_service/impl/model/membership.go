@@ -0,0 +1,417 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/auth_service/api/rpcpb"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+)
+
+// MemberExpiration defines when a member of an AuthGroup expires.
+type MemberExpiration struct {
+	// Member is the identity of the member, e.g. "user:someone@example.com".
+	Member string `gae:"member,noindex"`
+
+	// ExpireTS is the time when the member is removed from the group.
+	ExpireTS time.Time `gae:"expire_ts"`
+}
+
+// MembershipChangeState is the state of an AuthMembershipChange.
+type MembershipChangeState int64
+
+const (
+	// MembershipChangePending means the change awaits a review.
+	MembershipChangePending MembershipChangeState = 1
+	// MembershipChangeApproved means the change was approved and applied.
+	MembershipChangeApproved MembershipChangeState = 2
+	// MembershipChangeRejected means the change was rejected.
+	MembershipChangeRejected MembershipChangeState = 3
+)
+
+// AuthMembershipChange is a proposed change to the members of an AuthGroup.
+//
+// It is not part of the AuthDB and thus is not versioned. Once approved, the
+// change is applied to the group in the same transaction.
+type AuthMembershipChange struct {
+	Kind string `gae:"$kind,AuthMembershipChange"`
+	ID   int64  `gae:"$id"`
+
+	// Parent is RootKey().
+	Parent *datastore.Key `gae:"$parent"`
+
+	// Group is the name of the group to change.
+	Group string `gae:"group"`
+
+	// AddMembers is the list of identities to add to the group.
+	AddMembers []string `gae:"add_members,noindex"`
+
+	// RemoveMembers is the list of identities to remove from the group.
+	RemoveMembers []string `gae:"remove_members,noindex"`
+
+	// ExpireTS, if set, is when the added members expire.
+	ExpireTS time.Time `gae:"expire_ts,noindex"`
+
+	// Reason is the justification of the change given by the proposer.
+	Reason string `gae:"reason,noindex"`
+
+	// State is the current state of the change.
+	State MembershipChangeState `gae:"state"`
+
+	// ProposedBy is the identity of the proposer.
+	ProposedBy string `gae:"proposed_by"`
+
+	// ProposedTS is when the change was proposed.
+	ProposedTS time.Time `gae:"proposed_ts,noindex"`
+
+	// ReviewedBy is the identity of the reviewer, if reviewed.
+	ReviewedBy string `gae:"reviewed_by"`
+
+	// ReviewedTS is when the change was reviewed.
+	ReviewedTS time.Time `gae:"reviewed_ts,noindex"`
+
+	// ReviewComment is an optional comment left by the reviewer.
+	ReviewComment string `gae:"review_comment,noindex"`
+}
+
+// sameSet returns true if both lists contain the same strings, e.g.
+// identities.
+func sameSet(a, b []string) bool {
+	as := stringset.NewFromSlice(a...)
+	bs := stringset.NewFromSlice(b...)
+	return as.Len() == bs.Len() && as.Contains(bs)
+}
+
+// sameMemberExpirations returns true if both lists define the same
+// expirations, regardless of the order.
+func sameMemberExpirations(a, b []MemberExpiration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	expirations := make(map[string]time.Time, len(a))
+	for _, e := range a {
+		expirations[e.Member] = e.ExpireTS
+	}
+	for _, e := range b {
+		if ts, ok := expirations[e.Member]; !ok || !ts.Equal(e.ExpireTS) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateMemberExpirations checks that the expirations refer to distinct
+// members of the group and are in the future.
+func validateMemberExpirations(ctx context.Context, expirations []MemberExpiration, members []string) error {
+	now := clock.Now(ctx)
+	memberSet := stringset.NewFromSlice(members...)
+	seen := stringset.New(len(expirations))
+	for _, e := range expirations {
+		switch {
+		case !memberSet.Has(e.Member):
+			return errors.Annotate(ErrInvalidArgument, "expiration of %q which is not a member", e.Member).Err()
+		case !seen.Add(e.Member):
+			return errors.Annotate(ErrInvalidArgument, "duplicate expiration of %q", e.Member).Err()
+		case !e.ExpireTS.After(now):
+			return errors.Annotate(ErrInvalidArgument, "expiration of %q is not in the future", e.Member).Err()
+		}
+	}
+	return nil
+}
+
+// pruneMemberExpirations returns the expirations which refer to the given
+// members.
+func pruneMemberExpirations(expirations []MemberExpiration, members []string) []MemberExpiration {
+	memberSet := stringset.NewFromSlice(members...)
+	var ret []MemberExpiration
+	for _, e := range expirations {
+		if memberSet.Has(e.Member) {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
+
+// ProposeMembershipChange stores a pending change to the members of a group.
+//
+// Possible errors:
+//
+//	ErrInvalidArgument if the change is empty or the expiration is in the past.
+//	ErrInvalidIdentity if any identities specified in the change are invalid.
+//	ErrPermissionDenied if the group is an external group.
+//	datastore.ErrNoSuchEntity if the specified group does not exist.
+//	Annotated error for other errors.
+func ProposeMembershipChange(ctx context.Context, change *AuthMembershipChange) (*AuthMembershipChange, error) {
+	if isExternalAuthGroupName(change.Group) {
+		return nil, errors.Annotate(ErrPermissionDenied, "cannot change external group").Err()
+	}
+	if len(change.AddMembers) == 0 && len(change.RemoveMembers) == 0 {
+		return nil, errors.Annotate(ErrInvalidArgument, "no members to add or remove").Err()
+	}
+	if err := validateIdentities(change.AddMembers); err != nil {
+		return nil, errors.Annotate(ErrInvalidIdentity, "%s", err).Err()
+	}
+	if err := validateIdentities(change.RemoveMembers); err != nil {
+		return nil, errors.Annotate(ErrInvalidIdentity, "%s", err).Err()
+	}
+	now := clock.Now(ctx).UTC()
+	if !change.ExpireTS.IsZero() && !change.ExpireTS.After(now) {
+		return nil, errors.Annotate(ErrInvalidArgument, "expiration is not in the future").Err()
+	}
+
+	newChange := &AuthMembershipChange{
+		Kind:          "AuthMembershipChange",
+		Parent:        RootKey(ctx),
+		Group:         change.Group,
+		AddMembers:    change.AddMembers,
+		RemoveMembers: change.RemoveMembers,
+		ExpireTS:      change.ExpireTS,
+		Reason:        change.Reason,
+		State:         MembershipChangePending,
+		ProposedBy:    string(auth.CurrentIdentity(ctx)),
+		ProposedTS:    now,
+	}
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		if _, err := GetAuthGroup(ctx, change.Group); err != nil {
+			return err
+		}
+		return datastore.Put(ctx, newChange)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newChange, nil
+}
+
+// GetMembershipChanges returns the membership changes of the given group,
+// most recent first.
+//
+// Returns an annotated error.
+func GetMembershipChanges(ctx context.Context, groupName string) ([]*AuthMembershipChange, error) {
+	q := datastore.NewQuery("AuthMembershipChange").Ancestor(RootKey(ctx)).Eq("group", groupName)
+	var changes []*AuthMembershipChange
+	if err := datastore.GetAll(ctx, q, &changes); err != nil {
+		return nil, errors.Annotate(err, "error getting AuthMembershipChange entities").Err()
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if !changes[i].ProposedTS.Equal(changes[j].ProposedTS) {
+			return changes[i].ProposedTS.After(changes[j].ProposedTS)
+		}
+		return changes[i].ID > changes[j].ID
+	})
+	return changes, nil
+}
+
+// ReviewMembershipChange approves or rejects a pending membership change.
+//
+// Approving the change applies it to the group, which updates the AuthDB.
+//
+// Possible errors:
+//
+//	datastore.ErrNoSuchEntity if the change or its group does not exist.
+//	ErrPermissionDenied if the caller is not allowed to review the change.
+//	ErrInvalidArgument if the change is not pending or has expired.
+//	Annotated error for other errors.
+func ReviewMembershipChange(ctx context.Context, id int64, approve bool, comment string) (*AuthMembershipChange, error) {
+	change := &AuthMembershipChange{
+		Kind:   "AuthMembershipChange",
+		ID:     id,
+		Parent: RootKey(ctx),
+	}
+	err := runAuthDBChange(ctx, func(ctx context.Context, commitEntity commitAuthEntity) error {
+		if err := datastore.Get(ctx, change); err != nil {
+			return err
+		}
+		if change.State != MembershipChangePending {
+			return errors.Annotate(ErrInvalidArgument, "membership change %d was already reviewed", id).Err()
+		}
+		group, err := GetAuthGroup(ctx, change.Group)
+		if err != nil {
+			return err
+		}
+
+		// Only the owners of the group may review, and never their own changes.
+		reviewer := auth.CurrentIdentity(ctx)
+		switch ok, err := auth.IsMember(ctx, AdminGroup, group.Owners); {
+		case err != nil:
+			return errors.Annotate(err, "permission check failed").Err()
+		case !ok:
+			return ErrPermissionDenied
+		case string(reviewer) == change.ProposedBy:
+			return errors.Annotate(ErrPermissionDenied, "cannot review own membership change").Err()
+		}
+
+		now := clock.Now(ctx).UTC()
+		change.ReviewedBy = string(reviewer)
+		change.ReviewedTS = now
+		change.ReviewComment = comment
+		if !approve {
+			change.State = MembershipChangeRejected
+			return datastore.Put(ctx, change)
+		}
+		if !change.ExpireTS.IsZero() && !change.ExpireTS.After(now) {
+			return errors.Annotate(ErrInvalidArgument, "membership change %d has expired", id).Err()
+		}
+		change.State = MembershipChangeApproved
+		if err := datastore.Put(ctx, change); err != nil {
+			return err
+		}
+
+		applyMembershipChange(group, change)
+		return commitEntity(group, now, reviewer, false)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// applyMembershipChange updates the members of the group according to the
+// change. Added members get the expiration of the change, replacing the
+// existing one if any.
+func applyMembershipChange(group *AuthGroup, change *AuthMembershipChange) {
+	removed := stringset.NewFromSlice(change.RemoveMembers...)
+	added := stringset.NewFromSlice(change.AddMembers...)
+
+	members := make([]string, 0, len(group.Members)+added.Len())
+	for _, m := range group.Members {
+		if !removed.Has(m) && !added.Has(m) {
+			members = append(members, m)
+		}
+	}
+	expirations := pruneMemberExpirations(group.MemberExpirations, members)
+	for _, m := range added.ToSortedSlice() {
+		members = append(members, m)
+		if !change.ExpireTS.IsZero() {
+			expirations = append(expirations, MemberExpiration{Member: m, ExpireTS: change.ExpireTS})
+		}
+	}
+	group.Members = members
+	group.MemberExpirations = expirations
+}
+
+// expireMembershipsBatchSize is the max number of groups updated by
+// ExpireMemberships in a single AuthDB change.
+const expireMembershipsBatchSize = 50
+
+// ExpireMemberships removes the expired members from all groups.
+//
+// Only the groups with expired members are updated, in batches.
+func ExpireMemberships(ctx context.Context) error {
+	now := clock.Now(ctx).UTC()
+	q := datastore.NewQuery("AuthGroup").
+		Ancestor(RootKey(ctx)).
+		Lte("member_expirations.expire_ts", now).
+		KeysOnly(true)
+	var keys []*datastore.Key
+	if err := datastore.GetAll(ctx, q, &keys); err != nil {
+		return errors.Annotate(err, "error querying groups with expired members").Err()
+	}
+
+	// A group with several expired members may be returned several times.
+	names := stringset.New(len(keys))
+	for _, key := range keys {
+		names.Add(key.StringID())
+	}
+	sorted := names.ToSortedSlice()
+	for len(sorted) > 0 {
+		batch := sorted
+		if len(batch) > expireMembershipsBatchSize {
+			batch = batch[:expireMembershipsBatchSize]
+		}
+		sorted = sorted[len(batch):]
+		if err := expireMembershipsOf(ctx, batch, now); err != nil {
+			return errors.Annotate(err, "error expiring members of %s", batch).Err()
+		}
+	}
+	return nil
+}
+
+// expireMembershipsOf removes the members expired by `now` from the given
+// groups in a single AuthDB change.
+func expireMembershipsOf(ctx context.Context, groupNames []string, now time.Time) error {
+	return runAuthDBChange(ctx, func(ctx context.Context, commitEntity commitAuthEntity) error {
+		for _, name := range groupNames {
+			group, err := GetAuthGroup(ctx, name)
+			switch {
+			case err == datastore.ErrNoSuchEntity:
+				// The group has been deleted in the meantime.
+				continue
+			case err != nil:
+				return err
+			}
+
+			expired := stringset.New(0)
+			var remaining []MemberExpiration
+			for _, e := range group.MemberExpirations {
+				if e.ExpireTS.After(now) {
+					remaining = append(remaining, e)
+				} else {
+					expired.Add(e.Member)
+				}
+			}
+			if expired.Len() == 0 {
+				continue
+			}
+			members := make([]string, 0, len(group.Members))
+			for _, m := range group.Members {
+				if !expired.Has(m) {
+					members = append(members, m)
+				}
+			}
+			logging.Infof(ctx, "removing expired members of %q: %s", group.ID, expired.ToSortedSlice())
+			group.Members = members
+			group.MemberExpirations = remaining
+			if err := commitEntity(group, now, auth.CurrentIdentity(ctx), false); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ToProto converts the AuthMembershipChange entity to the protobuffer
+// equivalent MembershipChange.
+func (change *AuthMembershipChange) ToProto() *rpcpb.MembershipChange {
+	ret := &rpcpb.MembershipChange{
+		Id:            change.ID,
+		Group:         change.Group,
+		AddMembers:    change.AddMembers,
+		RemoveMembers: change.RemoveMembers,
+		Reason:        change.Reason,
+		State:         rpcpb.MembershipChange_State(change.State),
+		ProposedBy:    change.ProposedBy,
+		ProposedTs:    timestamppb.New(change.ProposedTS),
+		ReviewedBy:    change.ReviewedBy,
+		ReviewComment: change.ReviewComment,
+	}
+	if !change.ExpireTS.IsZero() {
+		ret.ExpireTime = timestamppb.New(change.ExpireTS)
+	}
+	if !change.ReviewedTS.IsZero() {
+		ret.ReviewedTs = timestamppb.New(change.ReviewedTS)
+	}
+	return ret
+}
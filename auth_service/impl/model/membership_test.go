@@ -0,0 +1,310 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"go.chromium.org/luci/auth_service/impl/info"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/tq"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestMemberExpirations(t *testing.T) {
+	t.Parallel()
+
+	Convey("Member expirations", t, func() {
+		ctx := auth.WithState(memory.Use(context.Background()), &authtest.FakeState{
+			Identity:       "user:someone@example.com",
+			IdentityGroups: []string{"owners-foo"},
+		})
+		ctx, tc := testclock.UseTime(ctx, testCreatedTS)
+		ctx = info.SetImageVersion(ctx, "test-version")
+		ctx, _ = tq.TestingContext(txndefer.FilterRDS(ctx), nil)
+		datastore.GetTestable(ctx).AutoIndex(true)
+		So(datastore.Put(ctx, testAuthReplicationState(ctx, 10)), ShouldBeNil)
+
+		group := emptyAuthGroup(ctx, "foo")
+		group.Owners = "owners-foo"
+		group.Members = []string{"user:a@example.com", "user:b@example.com"}
+		So(datastore.Put(ctx, group), ShouldBeNil)
+
+		expiry := testCreatedTS.Add(time.Hour)
+		expirationsMask := &fieldmaskpb.FieldMask{Paths: []string{"member_expirations"}}
+
+		Convey("must refer to members", func() {
+			group.MemberExpirations = []MemberExpiration{{Member: "user:c@example.com", ExpireTS: expiry}}
+			_, err := UpdateAuthGroup(ctx, group, expirationsMask, "")
+			So(err, ShouldErrLike, ErrInvalidArgument)
+			So(err, ShouldErrLike, "not a member")
+		})
+
+		Convey("must be in the future", func() {
+			group.MemberExpirations = []MemberExpiration{{Member: "user:a@example.com", ExpireTS: testCreatedTS}}
+			_, err := UpdateAuthGroup(ctx, group, expirationsMask, "")
+			So(err, ShouldErrLike, "not in the future")
+		})
+
+		Convey("are dropped with removed members", func() {
+			group.MemberExpirations = []MemberExpiration{{Member: "user:a@example.com", ExpireTS: expiry}}
+			_, err := UpdateAuthGroup(ctx, group, expirationsMask, "")
+			So(err, ShouldBeNil)
+
+			group.Members = []string{"user:b@example.com"}
+			updated, err := UpdateAuthGroup(ctx, group, &fieldmaskpb.FieldMask{Paths: []string{"members"}}, "")
+			So(err, ShouldBeNil)
+			So(updated.MemberExpirations, ShouldBeEmpty)
+		})
+
+		Convey("expired members are removed", func() {
+			group.MemberExpirations = []MemberExpiration{{Member: "user:a@example.com", ExpireTS: expiry}}
+			_, err := UpdateAuthGroup(ctx, group, expirationsMask, "")
+			So(err, ShouldBeNil)
+			fetched, err := GetAuthGroup(ctx, "foo")
+			So(err, ShouldBeNil)
+			So(fetched.MemberExpirations, ShouldHaveLength, 1)
+			So(fetched.MemberExpirations[0].ExpireTS.Equal(expiry), ShouldBeTrue)
+
+			// Nothing has expired yet.
+			So(ExpireMemberships(ctx), ShouldBeNil)
+			state, err := GetReplicationState(ctx)
+			So(err, ShouldBeNil)
+			So(state.AuthDBRev, ShouldEqual, 11)
+
+			tc.Add(2 * time.Hour)
+			So(ExpireMemberships(ctx), ShouldBeNil)
+			fetched, err = GetAuthGroup(ctx, "foo")
+			So(err, ShouldBeNil)
+			So(fetched.Members, ShouldResemble, []string{"user:b@example.com"})
+			So(fetched.MemberExpirations, ShouldBeEmpty)
+			So(fetched.AuthDBRev, ShouldEqual, 12)
+		})
+
+		Convey("only groups with expired members are updated", func() {
+			untouched := emptyAuthGroup(ctx, "untouched")
+			untouched.Members = []string{"user:a@example.com"}
+			untouched.MemberExpirations = []MemberExpiration{{Member: "user:a@example.com", ExpireTS: expiry.Add(24 * time.Hour)}}
+			So(datastore.Put(ctx, untouched), ShouldBeNil)
+			for i := 0; i < expireMembershipsBatchSize+1; i++ {
+				g := emptyAuthGroup(ctx, fmt.Sprintf("expiring-%d", i))
+				g.Members = []string{"user:a@example.com", "user:b@example.com"}
+				g.MemberExpirations = []MemberExpiration{
+					{Member: "user:a@example.com", ExpireTS: expiry},
+					{Member: "user:b@example.com", ExpireTS: expiry},
+				}
+				So(datastore.Put(ctx, g), ShouldBeNil)
+			}
+
+			tc.Add(2 * time.Hour)
+			So(ExpireMemberships(ctx), ShouldBeNil)
+			// The groups are updated in 2 batches.
+			state, err := GetReplicationState(ctx)
+			So(err, ShouldBeNil)
+			So(state.AuthDBRev, ShouldEqual, 12)
+			for i := 0; i < expireMembershipsBatchSize+1; i++ {
+				fetched, err := GetAuthGroup(ctx, fmt.Sprintf("expiring-%d", i))
+				So(err, ShouldBeNil)
+				So(fetched.Members, ShouldBeEmpty)
+			}
+			fetched, err := GetAuthGroup(ctx, "untouched")
+			So(err, ShouldBeNil)
+			So(fetched.Members, ShouldResemble, []string{"user:a@example.com"})
+			So(fetched.AuthDBRev, ShouldEqual, untouched.AuthDBRev)
+		})
+	})
+}
+
+func TestMembershipChanges(t *testing.T) {
+	t.Parallel()
+
+	Convey("Membership changes", t, func() {
+		ctx := auth.WithState(memory.Use(context.Background()), &authtest.FakeState{
+			Identity: "user:proposer@example.com",
+		})
+		ctx = clock.Set(ctx, testclock.New(testCreatedTS))
+		ctx = info.SetImageVersion(ctx, "test-version")
+		ctx, _ = tq.TestingContext(txndefer.FilterRDS(ctx), nil)
+		So(datastore.Put(ctx, testAuthReplicationState(ctx, 10)), ShouldBeNil)
+
+		group := emptyAuthGroup(ctx, "foo")
+		group.Owners = "owners-foo"
+		group.Members = []string{"user:a@example.com", "user:b@example.com"}
+		group.RequiresApproval = true
+		So(datastore.Put(ctx, group), ShouldBeNil)
+
+		ownerCtx := auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:owner@example.com",
+			IdentityGroups: []string{"owners-foo"},
+		})
+		expiry := testCreatedTS.Add(time.Hour)
+
+		Convey("direct member updates are rejected", func() {
+			group.Members = []string{"user:c@example.com"}
+			_, err := UpdateAuthGroup(ownerCtx, group, nil, "")
+			So(err, ShouldErrLike, ErrApprovalRequired)
+
+			// Other fields can still be updated.
+			group.Members = []string{"user:b@example.com", "user:a@example.com"}
+			group.Description = "updated"
+			_, err = UpdateAuthGroup(ownerCtx, group, nil, "")
+			So(err, ShouldBeNil)
+		})
+
+		Convey("direct glob updates are rejected", func() {
+			group.Globs = []string{"user:*@example.com"}
+			_, err := UpdateAuthGroup(ownerCtx, group, &fieldmaskpb.FieldMask{Paths: []string{"globs"}}, "")
+			So(err, ShouldErrLike, ErrApprovalRequired)
+			So(err, ShouldErrLike, "globs")
+		})
+
+		Convey("direct nested group updates are rejected", func() {
+			So(datastore.Put(ctx, emptyAuthGroup(ctx, "bar")), ShouldBeNil)
+			group.Nested = []string{"bar"}
+			_, err := UpdateAuthGroup(ownerCtx, group, &fieldmaskpb.FieldMask{Paths: []string{"nested"}}, "")
+			So(err, ShouldErrLike, ErrApprovalRequired)
+			So(err, ShouldErrLike, "nested groups")
+		})
+
+		Convey("direct member expiration updates are rejected", func() {
+			group.MemberExpirations = []MemberExpiration{{Member: "user:a@example.com", ExpireTS: expiry}}
+			_, err := UpdateAuthGroup(ownerCtx, group, &fieldmaskpb.FieldMask{Paths: []string{"member_expirations"}}, "")
+			So(err, ShouldErrLike, ErrApprovalRequired)
+			So(err, ShouldErrLike, "member expirations")
+		})
+
+		Convey("only admins can change requires_approval", func() {
+			group.RequiresApproval = false
+			mask := &fieldmaskpb.FieldMask{Paths: []string{"requires_approval"}}
+			_, err := UpdateAuthGroup(ownerCtx, group, mask, "")
+			So(err, ShouldErrLike, "only administrators")
+
+			adminCtx := auth.WithState(ctx, &authtest.FakeState{
+				Identity:       "user:admin@example.com",
+				IdentityGroups: []string{AdminGroup},
+			})
+			updated, err := UpdateAuthGroup(adminCtx, group, mask, "")
+			So(err, ShouldBeNil)
+			So(updated.RequiresApproval, ShouldBeFalse)
+		})
+
+		Convey("propose", func() {
+			Convey("fails for unknown group", func() {
+				_, err := ProposeMembershipChange(ctx, &AuthMembershipChange{
+					Group:      "unknown",
+					AddMembers: []string{"user:c@example.com"},
+				})
+				So(err, ShouldEqual, datastore.ErrNoSuchEntity)
+			})
+
+			Convey("fails for empty change", func() {
+				_, err := ProposeMembershipChange(ctx, &AuthMembershipChange{Group: "foo"})
+				So(err, ShouldErrLike, "no members to add or remove")
+			})
+
+			Convey("fails for invalid identity", func() {
+				_, err := ProposeMembershipChange(ctx, &AuthMembershipChange{
+					Group:      "foo",
+					AddMembers: []string{"no-kind"},
+				})
+				So(err, ShouldErrLike, ErrInvalidIdentity)
+			})
+		})
+
+		Convey("review", func() {
+			change, err := ProposeMembershipChange(ctx, &AuthMembershipChange{
+				Group:         "foo",
+				AddMembers:    []string{"user:c@example.com"},
+				RemoveMembers: []string{"user:a@example.com"},
+				ExpireTS:      expiry,
+				Reason:        "oncall",
+			})
+			So(err, ShouldBeNil)
+			So(change.ID, ShouldNotEqual, 0)
+			So(change.State, ShouldEqual, MembershipChangePending)
+			So(change.ProposedBy, ShouldEqual, "user:proposer@example.com")
+
+			changes, err := GetMembershipChanges(ctx, "foo")
+			So(err, ShouldBeNil)
+			So(changes, ShouldHaveLength, 1)
+			So(changes[0].ID, ShouldEqual, change.ID)
+
+			Convey("not by a non-owner", func() {
+				_, err := ReviewMembershipChange(ctx, change.ID, true, "")
+				So(err, ShouldEqual, ErrPermissionDenied)
+			})
+
+			Convey("not by the proposer", func() {
+				ctx := auth.WithState(ctx, &authtest.FakeState{
+					Identity:       "user:proposer@example.com",
+					IdentityGroups: []string{"owners-foo"},
+				})
+				_, err := ReviewMembershipChange(ctx, change.ID, true, "")
+				So(err, ShouldErrLike, "cannot review own membership change")
+			})
+
+			Convey("approve", func() {
+				reviewed, err := ReviewMembershipChange(ownerCtx, change.ID, true, "lgtm")
+				So(err, ShouldBeNil)
+				So(reviewed.State, ShouldEqual, MembershipChangeApproved)
+				So(reviewed.ReviewedBy, ShouldEqual, "user:owner@example.com")
+				So(reviewed.ReviewComment, ShouldEqual, "lgtm")
+
+				fetched, err := GetAuthGroup(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(fetched.Members, ShouldResemble, []string{"user:b@example.com", "user:c@example.com"})
+				So(fetched.MemberExpirations, ShouldHaveLength, 1)
+				So(fetched.MemberExpirations[0].Member, ShouldEqual, "user:c@example.com")
+				So(fetched.AuthDBRev, ShouldEqual, 11)
+				So(fetched.ModifiedBy, ShouldEqual, "user:owner@example.com")
+
+				_, err = ReviewMembershipChange(ownerCtx, change.ID, false, "")
+				So(err, ShouldErrLike, "already reviewed")
+			})
+
+			Convey("reject", func() {
+				reviewed, err := ReviewMembershipChange(ownerCtx, change.ID, false, "no")
+				So(err, ShouldBeNil)
+				So(reviewed.State, ShouldEqual, MembershipChangeRejected)
+
+				fetched, err := GetAuthGroup(ctx, "foo")
+				So(err, ShouldBeNil)
+				So(fetched.Members, ShouldResemble, group.Members)
+				state, err := GetReplicationState(ctx)
+				So(err, ShouldBeNil)
+				So(state.AuthDBRev, ShouldEqual, 10)
+			})
+
+			Convey("expired change can't be approved", func() {
+				clock.Get(ctx).(testclock.TestClock).Add(2 * time.Hour)
+				_, err := ReviewMembershipChange(ownerCtx, change.ID, true, "")
+				So(err, ShouldErrLike, "has expired")
+			})
+		})
+	})
+}
@@ -234,6 +234,16 @@ type AuthGroup struct {
 
 	// CreatedBy is the email of the user who created this group.
 	CreatedBy string `gae:"created_by"`
+
+	// MemberExpirations is the list of expiration times of some of the
+	// members. Each entry refers to an identity in Members.
+	//
+	// The expiration times are indexed to find the groups with expired members.
+	MemberExpirations []MemberExpiration `gae:"member_expirations"`
+
+	// RequiresApproval indicates that the members of this group can only be
+	// changed through approved membership changes.
+	RequiresApproval bool `gae:"requires_approval,noindex"`
 }
 
 type AuthIPAllowlist struct {
@@ -328,6 +338,9 @@ var (
 	ErrReferencedEntity = stderrors.New("cannot delete referenced entity")
 	// ErrCyclicDependency is returned when an update would create a cyclic dependency.
 	ErrCyclicDependency = stderrors.New("circular dependency")
+	// ErrApprovalRequired is returned when members of a group which requires
+	// approval are modified directly.
+	ErrApprovalRequired = stderrors.New("approval required")
 )
 
 // RootKey gets the root key of the entity group with all AuthDB entities.
@@ -669,7 +682,8 @@ func findGroupDependencyCycle(ctx context.Context, group *AuthGroup) ([]string,
 
 // CreateAuthGroup creates a new AuthGroup and writes it to the datastore.
 // Only the following fields will be read from the input:
-// ID, Description, Owners, Members, Globs, Nested.
+// ID, Description, Owners, Members, Globs, Nested, MemberExpirations,
+// RequiresApproval.
 func CreateAuthGroup(ctx context.Context, group *AuthGroup) (*AuthGroup, error) {
 	// Check the supplied group name is valid, and not an external group.
 	if !isValidAuthGroupName(group.ID) || isExternalAuthGroupName(group.ID) {
@@ -683,6 +697,9 @@ func CreateAuthGroup(ctx context.Context, group *AuthGroup) (*AuthGroup, error)
 	if err := validateGlobs(group.Globs); err != nil {
 		return nil, errors.Annotate(ErrInvalidIdentity, "%s", err).Err()
 	}
+	if err := validateMemberExpirations(ctx, group.MemberExpirations, group.Members); err != nil {
+		return nil, err
+	}
 
 	// Construct a new group so that we don't modify the input.
 	newGroup := makeAuthGroup(ctx, group.ID)
@@ -704,6 +721,8 @@ func CreateAuthGroup(ctx context.Context, group *AuthGroup) (*AuthGroup, error)
 		newGroup.Members = group.Members
 		newGroup.Globs = group.Globs
 		newGroup.Nested = group.Nested
+		newGroup.MemberExpirations = group.MemberExpirations
+		newGroup.RequiresApproval = group.RequiresApproval
 
 		// Check that all referenced groups (owning group, nested groups) exist.
 		// It is ok for a new group to have itself as owner.
@@ -789,6 +808,7 @@ func findReferencingGroups(ctx context.Context, groupName string) (stringset.Set
 //	datastore.ErrNoSuchEntity if the specified group does not exist.
 //	ErrPermissionDenied if the caller is not allowed to update the group.
 //	ErrConcurrentModification if the provided etag is not up-to-date.
+//	ErrApprovalRequired if the members of a group requiring approval change.
 //	Annotated error for other errors.
 func UpdateAuthGroup(ctx context.Context, groupUpdate *AuthGroup, updateMask *fieldmaskpb.FieldMask, etag string) (*AuthGroup, error) {
 	// A nil updateMask means we should update all fields.
@@ -802,6 +822,8 @@ func UpdateAuthGroup(ctx context.Context, groupUpdate *AuthGroup, updateMask *fi
 				"nested",
 				"description",
 				"owners",
+				"member_expirations",
+				"requires_approval",
 			},
 		}
 	}
@@ -849,13 +871,41 @@ func UpdateAuthGroup(ctx context.Context, groupUpdate *AuthGroup, updateMask *fi
 		}
 
 		// Update fields according to the mask.
+		membersChanged, expirationsChanged := false, false
+		// The membership of a group requiring approval can only be changed
+		// through approved membership changes.
+		checkApproval := func(field string) error {
+			if !authGroup.RequiresApproval {
+				return nil
+			}
+			return errors.Annotate(ErrApprovalRequired, "%s of group %q can only be changed through approved membership changes", field, authGroup.ID).Err()
+		}
 		for _, field := range updateMask.GetPaths() {
 			switch field {
 			case "members":
+				if sameSet(authGroup.Members, groupUpdate.Members) {
+					continue
+				}
+				if err := checkApproval("members"); err != nil {
+					return err
+				}
 				authGroup.Members = groupUpdate.Members
+				membersChanged = true
 			case "globs":
+				if sameSet(authGroup.Globs, groupUpdate.Globs) {
+					continue
+				}
+				if err := checkApproval("globs"); err != nil {
+					return err
+				}
 				authGroup.Globs = groupUpdate.Globs
 			case "nested":
+				if sameSet(authGroup.Nested, groupUpdate.Nested) {
+					continue
+				}
+				if err := checkApproval("nested groups"); err != nil {
+					return err
+				}
 				// Check that any new groups being added exist.
 				addingNestedGroups := stringset.NewFromSlice(groupUpdate.Nested...)
 				addingNestedGroups.DelAll(authGroup.Nested)
@@ -889,11 +939,41 @@ func UpdateAuthGroup(ctx context.Context, groupUpdate *AuthGroup, updateMask *fi
 					}
 				}
 				authGroup.Owners = newOwners
+			case "member_expirations":
+				if sameMemberExpirations(authGroup.MemberExpirations, groupUpdate.MemberExpirations) {
+					continue
+				}
+				if err := checkApproval("member expirations"); err != nil {
+					return err
+				}
+				authGroup.MemberExpirations = groupUpdate.MemberExpirations
+				expirationsChanged = true
+			case "requires_approval":
+				if groupUpdate.RequiresApproval == authGroup.RequiresApproval {
+					continue
+				}
+				switch ok, err := auth.IsMember(ctx, AdminGroup); {
+				case err != nil:
+					return errors.Annotate(err, "permission check failed").Err()
+				case !ok:
+					return errors.Annotate(ErrPermissionDenied, "only administrators can change requires_approval").Err()
+				}
+				authGroup.RequiresApproval = groupUpdate.RequiresApproval
 			default:
 				return errors.Annotate(ErrInvalidArgument, "unknown field: %s", field).Err()
 			}
 		}
 
+		// Expirations of removed members are dropped silently, but explicitly
+		// set expirations must refer to the members of the group.
+		if expirationsChanged {
+			if err := validateMemberExpirations(ctx, authGroup.MemberExpirations, authGroup.Members); err != nil {
+				return err
+			}
+		} else if membersChanged {
+			authGroup.MemberExpirations = pruneMemberExpirations(authGroup.MemberExpirations, authGroup.Members)
+		}
+
 		// Commit the update.
 		return commitEntity(authGroup, clock.Now(ctx).UTC(), auth.CurrentIdentity(ctx), false)
 	})
@@ -1219,12 +1299,27 @@ func (group *AuthGroup) ToProto(includeMemberships bool) *rpcpb.AuthGroup {
 		authGroup.Members = group.Members
 		authGroup.Globs = group.Globs
 		authGroup.Nested = group.Nested
+		authGroup.MemberExpirations = make([]*rpcpb.MemberExpiration, len(group.MemberExpirations))
+		for i, e := range group.MemberExpirations {
+			authGroup.MemberExpirations[i] = &rpcpb.MemberExpiration{
+				Principal:  e.Member,
+				ExpireTime: timestamppb.New(e.ExpireTS),
+			}
+		}
 	}
+	authGroup.RequiresApproval = group.RequiresApproval
 	return authGroup
 }
 
 // AuthGroupFromProto allocates a new AuthGroup using the values in the supplied protobuffer.
 func AuthGroupFromProto(ctx context.Context, grouppb *rpcpb.AuthGroup) *AuthGroup {
+	var expirations []MemberExpiration
+	for _, e := range grouppb.GetMemberExpirations() {
+		expirations = append(expirations, MemberExpiration{
+			Member:   e.GetPrincipal(),
+			ExpireTS: e.GetExpireTime().AsTime(),
+		})
+	}
 	return &AuthGroup{
 		Kind:        "AuthGroup",
 		ID:          grouppb.GetName(),
@@ -1236,6 +1331,9 @@ func AuthGroupFromProto(ctx context.Context, grouppb *rpcpb.AuthGroup) *AuthGrou
 		Owners:      grouppb.GetOwners(),
 		CreatedTS:   grouppb.GetCreatedTs().AsTime(),
 		CreatedBy:   grouppb.GetCreatedBy(),
+
+		MemberExpirations: expirations,
+		RequiresApproval:  grouppb.GetRequiresApproval(),
 	}
 }
 
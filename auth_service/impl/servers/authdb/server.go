@@ -16,24 +16,36 @@
 package authdb
 
 import (
+	"bytes"
+	"compress/zlib"
 	"context"
 	"encoding/json"
+	"io"
 	"strconv"
+	"sync"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
+	"go.chromium.org/luci/auth/identity"
 	"go.chromium.org/luci/auth_service/api/rpcpb"
 	"go.chromium.org/luci/auth_service/impl/model"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth/authdb"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/auth/service/protocol"
 	"go.chromium.org/luci/server/router"
 )
 
 // Server implements AuthDB server.
 type Server struct {
 	rpcpb.UnimplementedAuthDBServer
+
+	m         sync.RWMutex
+	explainer *authdb.Explainer // prepared latest AuthDB, see getExplainer
 }
 
 // GetSnapshot implements the corresponding RPC method.
@@ -60,6 +72,136 @@ func (*Server) GetSnapshot(ctx context.Context, request *rpcpb.GetSnapshotReques
 	}
 }
 
+// ExplainPermission implements the corresponding RPC method.
+func (srv *Server) ExplainPermission(ctx context.Context, request *rpcpb.ExplainPermissionRequest) (*rpcpb.PermissionExplanation, error) {
+	id, err := identity.MakeIdentity(request.Principal)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad principal: %s", err)
+	}
+	if err := realms.ValidatePermissionName(request.Permission); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad permission: %s", err)
+	}
+	if err := realms.ValidateRealmName(request.Realm, realms.GlobalScope); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "bad realm: %s", err)
+	}
+
+	explainer, err := srv.getExplainer(ctx)
+	if err != nil {
+		return nil, err
+	}
+	exp, err := explainer.ExplainPermission(ctx, id, request.Permission, request.Realm, request.Attributes)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to explain permission in AuthDB revision %d: %s", explainer.Rev, err)
+	}
+	return explanationToProto(exp, explainer.Rev), nil
+}
+
+// getExplainer returns an Explainer for the latest AuthDB revision.
+//
+// It caches it in memory, refetching the AuthDB snapshot only when a new
+// revision appears. Returns gRPC errors.
+func (srv *Server) getExplainer(ctx context.Context) (*authdb.Explainer, error) {
+	rev, err := getLatestRevision(ctx, &rpcpb.GetSnapshotRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the cached copy if it is up-to-date.
+	srv.m.RLock()
+	cached := srv.explainer
+	srv.m.RUnlock()
+	if cached != nil && cached.Rev == rev {
+		return cached, nil
+	}
+
+	// Make all other callers wait to avoid all of them fetching and inflating
+	// the snapshot at once.
+	srv.m.Lock()
+	defer srv.m.Unlock()
+
+	// Maybe someone else already fetched a fresher copy while we were waiting
+	// on the lock.
+	if srv.explainer != nil && srv.explainer.Rev >= rev {
+		return srv.explainer, nil
+	}
+
+	snapshot, err := model.GetAuthDBSnapshot(ctx, rev, false)
+	switch {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, status.Errorf(codes.NotFound, "AuthDB revision %v not found", rev)
+	case err != nil:
+		logging.Errorf(ctx, "failed to fetch AuthDB revision %d: %s", rev, err)
+		return nil, status.Errorf(codes.Internal, "failed to fetch AuthDB revision %d", rev)
+	}
+	authDB, err := inflateSnapshot(snapshot.AuthDBDeflated)
+	if err != nil {
+		logging.Errorf(ctx, "failed to inflate AuthDB revision %d: %s", rev, err)
+		return nil, status.Errorf(codes.Internal, "failed to inflate AuthDB revision %d", rev)
+	}
+	explainer, err := authdb.NewExplainer(authDB, rev)
+	if err != nil {
+		logging.Errorf(ctx, "failed to process AuthDB revision %d: %s", rev, err)
+		return nil, status.Errorf(codes.Internal, "failed to process AuthDB revision %d", rev)
+	}
+
+	srv.explainer = explainer
+	return explainer, nil
+}
+
+// inflateSnapshot extracts AuthDB from a deflated ReplicationPushRequest.
+func inflateSnapshot(deflated []byte) (*protocol.AuthDB, error) {
+	reader, err := zlib.NewReader(bytes.NewReader(deflated))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	inflated, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	msg := &protocol.ReplicationPushRequest{}
+	if err := proto.Unmarshal(inflated, msg); err != nil {
+		return nil, err
+	}
+	if msg.AuthDb == nil {
+		return nil, errors.Reason("'auth_db' field is missing").Err()
+	}
+	return msg.AuthDb, nil
+}
+
+// explanationToProto converts authdb.Explanation to its proto representation.
+func explanationToProto(exp *authdb.Explanation, rev int64) *rpcpb.PermissionExplanation {
+	ret := &rpcpb.PermissionExplanation{
+		Granted:   exp.Granted,
+		AuthDbRev: rev,
+		Realm:     exp.Realm,
+		Notes:     exp.Notes,
+		Bindings:  make([]*rpcpb.PermissionExplanation_Binding, len(exp.Bindings)),
+	}
+	for i, b := range exp.Bindings {
+		binding := &rpcpb.PermissionExplanation_Binding{
+			Principals:  b.Principals,
+			Permissions: b.Permissions,
+			Granted:     b.Granted,
+		}
+		for _, c := range b.Conditions {
+			binding.Conditions = append(binding.Conditions, &rpcpb.PermissionExplanation_Condition{
+				Description: c.Description,
+				Satisfied:   c.Satisfied,
+			})
+		}
+		for _, m := range b.Memberships {
+			binding.Memberships = append(binding.Memberships, &rpcpb.PermissionExplanation_Membership{
+				Principal: m.Principal,
+				Groups:    m.Groups,
+				Via:       m.Via,
+			})
+		}
+		ret.Bindings[i] = binding
+	}
+	return ret
+}
+
 // getLatestRevision is a helper function to set the latest revision number for the GetSnapshotRequest.
 func getLatestRevision(ctx context.Context, request *rpcpb.GetSnapshotRequest) (int64, error) {
 	switch latest, err := model.GetAuthDBSnapshotLatest(ctx); {
@@ -15,6 +15,8 @@
 package authdb
 
 import (
+	"bytes"
+	"compress/zlib"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -26,12 +28,14 @@ import (
 	"time"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/auth_service/api/rpcpb"
 	"go.chromium.org/luci/auth_service/impl/model"
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/auth/service/protocol"
 	"go.chromium.org/luci/server/router"
 
 	"github.com/julienschmidt/httprouter"
@@ -65,7 +69,7 @@ func TestAuthDBServing(t *testing.T) {
 		}
 	}
 
-	legacyCall := func(server Server, ctx context.Context, rid int64, skipBody bool) []byte {
+	legacyCall := func(server *Server, ctx context.Context, rid int64, skipBody bool) []byte {
 		rw := httptest.NewRecorder()
 		var revIDStr string
 		var sb string
@@ -249,7 +253,7 @@ func TestAuthDBServing(t *testing.T) {
 		Convey("Testing GetSnapshotLegacy skipBody=true", func() {
 			rid := int64(3)
 			skipBody := true
-			actualBlob := legacyCall(server, ctx, rid, skipBody)
+			actualBlob := legacyCall(&server, ctx, rid, skipBody)
 			expectedBlob, err := expectedJSON(rid, skipBody)
 			So(err, ShouldBeNil)
 			So(actualBlob, ShouldResemble, expectedBlob)
@@ -258,7 +262,7 @@ func TestAuthDBServing(t *testing.T) {
 		Convey("Testing GetSnapshotLegacy skipBody=false", func() {
 			rid := int64(3)
 			skipBody := false
-			actualBlob := legacyCall(server, ctx, rid, skipBody)
+			actualBlob := legacyCall(&server, ctx, rid, skipBody)
 			expectedBlob, err := expectedJSON(rid, skipBody)
 			So(err, ShouldBeNil)
 			So(actualBlob, ShouldResemble, expectedBlob)
@@ -267,7 +271,7 @@ func TestAuthDBServing(t *testing.T) {
 		Convey("Testing GetSnapshotLatestLegacy skipBody=true", func() {
 			rid := int64(4)
 			skipBody := true
-			actualBlob := legacyCall(server, ctx, rid, skipBody)
+			actualBlob := legacyCall(&server, ctx, rid, skipBody)
 			expectedBlob, err := expectedJSON(rid, skipBody)
 			So(err, ShouldBeNil)
 			So(actualBlob, ShouldResemble, expectedBlob)
@@ -277,10 +281,122 @@ func TestAuthDBServing(t *testing.T) {
 		Convey("Testing GetSnapshotLatestLegacy skipBody=false", func() {
 			rid := int64(4)
 			skipBody := false
-			actualBlob := legacyCall(server, ctx, rid, skipBody)
+			actualBlob := legacyCall(&server, ctx, rid, skipBody)
 			expectedBlob, err := expectedJSON(rid, skipBody)
 			So(err, ShouldBeNil)
 			So(actualBlob, ShouldResemble, expectedBlob)
 		})
 	})
 }
+
+func TestExplainPermission(t *testing.T) {
+	t.Parallel()
+
+	Convey("ExplainPermission RPC call", t, func() {
+		ctx := memory.Use(context.Background())
+		server := Server{}
+
+		call := func(principal, realm string) (*rpcpb.PermissionExplanation, error) {
+			return server.ExplainPermission(ctx, &rpcpb.ExplainPermissionRequest{
+				Principal:  principal,
+				Permission: "luci.dev.testing",
+				Realm:      realm,
+			})
+		}
+
+		Convey("Invalid arguments", func() {
+			_, err := call("not-an-identity", "proj:realm")
+			So(err, ShouldHaveRPCCode, codes.InvalidArgument)
+			_, err = call("user:someone@example.com", "not-a-realm")
+			So(err, ShouldHaveRPCCode, codes.InvalidArgument)
+		})
+
+		Convey("No snapshot", func() {
+			_, err := call("user:someone@example.com", "proj:realm")
+			So(err, ShouldHaveRPCCode, codes.NotFound)
+		})
+
+		Convey("Explains", func() {
+			deflated, err := deflateReplicationPushRequest(&protocol.AuthDB{
+				Groups: []*protocol.AuthGroup{
+					{Name: "outer", Nested: []string{"inner"}},
+					{Name: "inner", Members: []string{"user:someone@example.com"}},
+				},
+				Realms: &protocol.Realms{
+					ApiVersion:  1,
+					Permissions: []*protocol.Permission{{Name: "luci.dev.testing"}},
+					Realms: []*protocol.Realm{
+						{
+							Name: "proj:realm",
+							Bindings: []*protocol.Binding{
+								{Permissions: []uint32{0}, Principals: []string{"group:outer"}},
+							},
+						},
+					},
+				},
+			})
+			So(err, ShouldBeNil)
+			So(datastore.Put(ctx,
+				&model.AuthDBSnapshotLatest{Kind: "AuthDBSnapshotLatest", ID: "latest", AuthDBRev: 5},
+				&model.AuthDBSnapshot{Kind: "AuthDBSnapshot", ID: 5, AuthDBDeflated: deflated},
+			), ShouldBeNil)
+
+			exp, err := call("user:someone@example.com", "proj:realm")
+			So(err, ShouldBeNil)
+			So(exp, ShouldResembleProto, &rpcpb.PermissionExplanation{
+				Granted:   true,
+				AuthDbRev: 5,
+				Realm:     "proj:realm",
+				Bindings: []*rpcpb.PermissionExplanation_Binding{
+					{
+						Principals:  []string{"group:outer"},
+						Permissions: []string{"luci.dev.testing"},
+						Memberships: []*rpcpb.PermissionExplanation_Membership{
+							{
+								Principal: "group:outer",
+								Groups:    []string{"outer", "inner"},
+								Via:       "user:someone@example.com",
+							},
+						},
+						Granted: true,
+					},
+				},
+			})
+
+			exp, err = call("user:another@example.com", "proj:realm")
+			So(err, ShouldBeNil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Bindings[0].Memberships, ShouldBeEmpty)
+
+			Convey("Caches the prepared AuthDB", func() {
+				So(datastore.Delete(ctx, &model.AuthDBSnapshot{Kind: "AuthDBSnapshot", ID: 5}), ShouldBeNil)
+				exp, err := call("user:someone@example.com", "proj:realm")
+				So(err, ShouldBeNil)
+				So(exp.Granted, ShouldBeTrue)
+
+				// Picks up new revisions.
+				So(datastore.Put(ctx,
+					&model.AuthDBSnapshotLatest{Kind: "AuthDBSnapshotLatest", ID: "latest", AuthDBRev: 6},
+				), ShouldBeNil)
+				_, err = call("user:someone@example.com", "proj:realm")
+				So(err, ShouldHaveRPCCode, codes.NotFound)
+			})
+		})
+	})
+}
+
+func deflateReplicationPushRequest(authDB *protocol.AuthDB) ([]byte, error) {
+	blob, err := proto.Marshal(&protocol.ReplicationPushRequest{AuthDb: authDB})
+	if err != nil {
+		return nil, err
+	}
+	out := bytes.Buffer{}
+	writer := zlib.NewWriter(&out)
+	if _, err := writer.Write(blob); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
@@ -79,6 +79,8 @@ func (*Server) CreateGroup(ctx context.Context, request *rpcpb.CreateGroupReques
 		return nil, status.Errorf(codes.InvalidArgument, "invalid group reference: %s", err)
 	case errors.Is(err, model.ErrInvalidIdentity):
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	case errors.Is(err, model.ErrInvalidArgument):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	default:
 		return nil, status.Errorf(codes.Internal, "failed to create group %q: %s", request.GetGroup().GetName(), err)
 	}
@@ -104,6 +106,8 @@ func (*Server) UpdateGroup(ctx context.Context, request *rpcpb.UpdateGroupReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, model.ErrCyclicDependency):
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrApprovalRequired):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return nil, status.Errorf(codes.Internal, "failed to update group %q: %s", request.GetGroup().GetName(), err)
 	}
@@ -163,6 +167,63 @@ func (*Server) GetSubgraph(ctx context.Context, request *rpcpb.GetSubgraphReques
 	return subgraphProto, nil
 }
 
+// ProposeMembershipChange implements the corresponding RPC method.
+func (*Server) ProposeMembershipChange(ctx context.Context, request *rpcpb.ProposeMembershipChangeRequest) (*rpcpb.MembershipChange, error) {
+	change := &model.AuthMembershipChange{
+		Group:         request.GetGroup(),
+		AddMembers:    request.GetAddMembers(),
+		RemoveMembers: request.GetRemoveMembers(),
+		Reason:        request.GetReason(),
+	}
+	if request.GetExpireTime() != nil {
+		change.ExpireTS = request.GetExpireTime().AsTime()
+	}
+	switch proposed, err := model.ProposeMembershipChange(ctx, change); {
+	case err == nil:
+		return proposed.ToProto(), nil
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, status.Errorf(codes.NotFound, "no such group %q", request.GetGroup())
+	case errors.Is(err, model.ErrPermissionDenied):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, model.ErrInvalidArgument), errors.Is(err, model.ErrInvalidIdentity):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to propose membership change of group %q: %s", request.GetGroup(), err)
+	}
+}
+
+// ListMembershipChanges implements the corresponding RPC method.
+func (*Server) ListMembershipChanges(ctx context.Context, request *rpcpb.ListMembershipChangesRequest) (*rpcpb.ListMembershipChangesResponse, error) {
+	changes, err := model.GetMembershipChanges(ctx, request.GetGroup())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to fetch membership changes of group %q: %s", request.GetGroup(), err)
+	}
+	resp := &rpcpb.ListMembershipChangesResponse{
+		Changes: make([]*rpcpb.MembershipChange, len(changes)),
+	}
+	for i, change := range changes {
+		resp.Changes[i] = change.ToProto()
+	}
+	return resp, nil
+}
+
+// ReviewMembershipChange implements the corresponding RPC method.
+func (*Server) ReviewMembershipChange(ctx context.Context, request *rpcpb.ReviewMembershipChangeRequest) (*rpcpb.MembershipChange, error) {
+	id := request.GetId()
+	switch reviewed, err := model.ReviewMembershipChange(ctx, id, request.GetApprove(), request.GetComment()); {
+	case err == nil:
+		return reviewed.ToProto(), nil
+	case errors.Is(err, datastore.ErrNoSuchEntity):
+		return nil, status.Errorf(codes.NotFound, "no such membership change %d or its group", id)
+	case errors.Is(err, model.ErrPermissionDenied):
+		return nil, status.Errorf(codes.PermissionDenied, "%s does not have permission to review membership change %d: %s", auth.CurrentIdentity(ctx), id, err)
+	case errors.Is(err, model.ErrInvalidArgument):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to review membership change %d: %s", id, err)
+	}
+}
+
 // convertPrincipal handles the conversion of rpcpb.Principal -> graph.NodeKey
 func convertPrincipal(p *rpcpb.Principal) (graph.NodeKey, error) {
 	switch p.Kind {
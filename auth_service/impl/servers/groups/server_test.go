@@ -817,4 +817,90 @@ func TestGroupsServer(t *testing.T) {
 			So(err.Error(), ShouldContainSubstring, "no such group")
 		})
 	})
+
+	Convey("Membership change RPC calls", t, func() {
+		ctx := auth.WithState(memory.Use(context.Background()), &authtest.FakeState{
+			Identity: "user:proposer@example.com",
+		})
+		ctx = info.SetImageVersion(ctx, "test-version")
+		ctx, _ = tq.TestingContext(txndefer.FilterRDS(ctx), nil)
+
+		So(datastore.Put(ctx,
+			&model.AuthGroup{
+				ID:                       "test-group",
+				Parent:                   model.RootKey(ctx),
+				Members:                  []string{"user:test-user-1@example.com"},
+				Owners:                   "owners",
+				RequiresApproval:         true,
+				CreatedTS:                createdTime,
+				CreatedBy:                "user:test-user-1@example.com",
+				AuthVersionedEntityMixin: versionedEntity,
+			}), ShouldBeNil)
+		ownerCtx := auth.WithState(ctx, &authtest.FakeState{
+			Identity:       "user:owner@example.com",
+			IdentityGroups: []string{"owners"},
+		})
+
+		Convey("Direct update requires approval", func() {
+			_, err := srv.UpdateGroup(ownerCtx, &rpcpb.UpdateGroupRequest{
+				Group: &rpcpb.AuthGroup{
+					Name:    "test-group",
+					Members: []string{"user:test-user-2@example.com"},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"members"}},
+			})
+			So(err, ShouldHaveGRPCStatus, codes.FailedPrecondition)
+		})
+
+		Convey("Propose for unknown group", func() {
+			_, err := srv.ProposeMembershipChange(ctx, &rpcpb.ProposeMembershipChangeRequest{
+				Group:      "unknown",
+				AddMembers: []string{"user:test-user-2@example.com"},
+			})
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+		})
+
+		Convey("Propose with invalid member", func() {
+			_, err := srv.ProposeMembershipChange(ctx, &rpcpb.ProposeMembershipChangeRequest{
+				Group:      "test-group",
+				AddMembers: []string{"bad"},
+			})
+			So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+		})
+
+		Convey("Propose, list and approve", func() {
+			proposed, err := srv.ProposeMembershipChange(ctx, &rpcpb.ProposeMembershipChangeRequest{
+				Group:      "test-group",
+				AddMembers: []string{"user:test-user-2@example.com"},
+				Reason:     "oncall",
+			})
+			So(err, ShouldBeNil)
+			So(proposed.State, ShouldEqual, rpcpb.MembershipChange_PENDING)
+
+			listed, err := srv.ListMembershipChanges(ctx, &rpcpb.ListMembershipChangesRequest{Group: "test-group"})
+			So(err, ShouldBeNil)
+			So(listed.Changes, ShouldHaveLength, 1)
+			So(listed.Changes[0].Id, ShouldEqual, proposed.Id)
+			So(listed.Changes[0].Reason, ShouldEqual, "oncall")
+
+			_, err = srv.ReviewMembershipChange(ctx, &rpcpb.ReviewMembershipChangeRequest{Id: proposed.Id, Approve: true})
+			So(err, ShouldHaveGRPCStatus, codes.PermissionDenied)
+
+			reviewed, err := srv.ReviewMembershipChange(ownerCtx, &rpcpb.ReviewMembershipChangeRequest{Id: proposed.Id, Approve: true})
+			So(err, ShouldBeNil)
+			So(reviewed.State, ShouldEqual, rpcpb.MembershipChange_APPROVED)
+
+			group, err := srv.GetGroup(ctx, &rpcpb.GetGroupRequest{Name: "test-group"})
+			So(err, ShouldBeNil)
+			So(group.Members, ShouldResemble, []string{"user:test-user-1@example.com", "user:test-user-2@example.com"})
+
+			_, err = srv.ReviewMembershipChange(ownerCtx, &rpcpb.ReviewMembershipChangeRequest{Id: proposed.Id})
+			So(err, ShouldHaveGRPCStatus, codes.FailedPrecondition)
+		})
+
+		Convey("Review unknown change", func() {
+			_, err := srv.ReviewMembershipChange(ownerCtx, &rpcpb.ReviewMembershipChangeRequest{Id: 123})
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+		})
+	})
 }
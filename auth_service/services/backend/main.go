@@ -71,6 +71,7 @@ func main() {
 			}
 			return nil
 		})
+		cron.RegisterHandler("expire_memberships", model.ExpireMemberships)
 		return nil
 	})
 }
@@ -74,6 +74,7 @@ func expireBuilds(ctx context.Context, bs []*model.Build, mr parallel.MultiRunne
 				workC <- func() error {
 					return tasks.FinalizeResultDB(ctx, &taskdefs.FinalizeResultDB{BuildId: b.ID})
 				}
+				workC <- func() error { return tasks.PopPendingBuildTask(ctx, b) }
 			}
 			workC <- func() error { return datastore.Put(ctx, toUpdate) }
 		})
@@ -93,18 +94,18 @@ func expireBuilds(ctx context.Context, bs []*model.Build, mr parallel.MultiRunne
 // TimeoutExpiredBuilds marks incomplete builds that were created longer than
 // model.BuildMaxCompletionTime w/ INFRA_FAILURE.
 func TimeoutExpiredBuilds(ctx context.Context) error {
-	// expireBuilds() updates 4 entities for each of the given builds within
+	// expireBuilds() updates 5 entities for each of the given builds within
 	// a single transaction, and a ds transaction can update at most
 	// 25 entities.
 	//
-	// Hence, this batchSize must be 6 or lower.
-	const batchSize = 25 / 4
-	// Processing each batch requires at most 5 goroutines.
+	// Hence, this batchSize must be 5 or lower.
+	const batchSize = 25 / 5
+	// Processing each batch requires at most 6 goroutines.
 	// - 1 for ds.RunTransaction()
-	// - 4 for add tasks into TQ and ds.Put()
+	// - 5 for add tasks into TQ and ds.Put()
 	//
 	// Also, there is another goroutine for scanning expired builds.
-	// Hence, this can run at most 6 transactions in parallel.
+	// Hence, this can run at most 5 transactions in parallel.
 	const nWorkers = 32
 	q := datastore.NewQuery(model.BuildKind).
 		Gt("__key__", buildKeyByAge(ctx, model.BuildMaxCompletionTime)).
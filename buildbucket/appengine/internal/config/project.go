@@ -510,6 +510,11 @@ func validateBuilderCfg(ctx *validation.Context, b *pb.BuilderConfig, wellKnownE
 		ctx.Errorf("service_account %q doesn't match %q", b.ServiceAccount, serviceAccountRegex)
 	}
 
+	// reuse_policy
+	if w := b.ReusePolicy.GetWindow(); w != nil && (w.CheckValid() != nil || w.AsDuration() < 0) {
+		ctx.Errorf("reuse_policy.window: must be a non-negative duration")
+	}
+
 	// experiments
 	for expName, percent := range b.Experiments {
 		ctx.Enter("experiments %q", expName)
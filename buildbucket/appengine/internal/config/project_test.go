@@ -414,6 +414,38 @@ func TestValidateProject(t *testing.T) {
 			So(ve.Errors[10].Error(), ShouldContainSubstring, "(swarming / builders #3 - many): 'too many different (8) wait_for_warm_cache_secs values; max 7")
 		})
 
+		Convey("bad reuse_policy in builders cfg", func() {
+			content := `
+				builders {
+					name: "b1"
+					swarming_host: "example.com"
+					exe {
+						cipd_package: "infra/executable/bar"
+						cipd_version: "refs/heads/main"
+					}
+					reuse_policy {
+						window { seconds: -60 }
+					}
+				}
+				builders {
+					name: "b2"
+					swarming_host: "example.com"
+					exe {
+						cipd_package: "infra/executable/bar"
+						cipd_version: "refs/heads/main"
+					}
+					reuse_policy {
+						window { seconds: 3600 }
+					}
+				}
+			`
+			validateProjectSwarming(vctx, toBBSwarmingCfg(content), wellKnownExperiments)
+			ve, ok := vctx.Finalize().(*validation.Error)
+			So(ok, ShouldEqual, true)
+			So(len(ve.Errors), ShouldEqual, 1)
+			So(ve.Errors[0].Error(), ShouldContainSubstring, "(swarming / builders #0 - b1): reuse_policy.window: must be a non-negative duration")
+		})
+
 		Convey("bad experiments in builders cfg", func() {
 			content := `
 				builders {
@@ -138,6 +138,11 @@ type Build struct {
 	// PubSubCallback, if set, creates notifications for build status changes.
 	PubSubCallback PubSubCallback `gae:"pubsub_callback,noindex"`
 
+	// Queued is true if the build belongs to a builder with
+	// max_concurrent_builds set, meaning the build is tracked in the builder's
+	// BuilderQueue until it ends.
+	Queued bool `gae:"queued,noindex"`
+
 	// ParentID is the build's immediate parent build id.
 	// Stored separately from AncestorIds in order to index this special case.
 	ParentID int64 `gae:"parent_id"`
@@ -148,6 +153,13 @@ type Build struct {
 	// This does not include any "siblings" at higher levels of the tree, just
 	// the direct chain of ancestors from root to this build.
 	AncestorIds []int64 `gae:"ancestor_ids"`
+
+	// ReuseKey identifies the inputs of the build for builders with a reuse
+	// policy, see BuildReuseKey. Empty for builds which can't be reused.
+	//
+	// Stored only if set, so that the index doesn't grow with builds which
+	// can't be reused.
+	ReuseKey string `gae:"reuse_key"`
 }
 
 // Realm returns this build's auth realm, or an empty string if not opted into the
@@ -310,6 +322,10 @@ func (b *Build) Save(withMeta bool) (datastore.PropertyMap, error) {
 	// Writing a value for PubSubCallback confuses the Python implementation which
 	// expects PubSubCallback to be a LocalStructuredProperty. See also unused.go.
 	delete(p, "pubsub_callback")
+
+	if b.ReuseKey == "" {
+		delete(p, "reuse_key")
+	}
 	return p, nil
 }
 
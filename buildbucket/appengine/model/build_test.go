@@ -104,6 +104,40 @@ func TestBuild(t *testing.T) {
 			})
 		})
 
+		Convey("reuse key", func() {
+			b := &Build{
+				ID: 1,
+				Proto: &pb.Build{
+					Id: 1,
+					Builder: &pb.BuilderID{
+						Project: "project",
+						Bucket:  "bucket",
+						Builder: "builder",
+					},
+					Status: pb.Status_SUCCESS,
+				},
+			}
+
+			Convey("not stored if empty", func() {
+				p, err := b.Save(false)
+				So(err, ShouldBeNil)
+				So(p, ShouldNotContainKey, "reuse_key")
+			})
+
+			Convey("stored and indexed if set", func() {
+				b.ReuseKey = "key"
+				p, err := b.Save(false)
+				So(err, ShouldBeNil)
+				So(p["reuse_key"], ShouldResemble, datastore.MkProperty("key"))
+
+				So(datastore.Put(ctx, b), ShouldBeNil)
+				var found []*Build
+				So(datastore.GetAll(ctx, datastore.NewQuery(BuildKind).Eq("reuse_key", "key"), &found), ShouldBeNil)
+				So(found, ShouldHaveLength, 1)
+				So(found[0].ReuseKey, ShouldEqual, "key")
+			})
+		})
+
 		Convey("legacy", func() {
 			Convey("infra failure", func() {
 				So(datastore.Put(ctx, &Build{
@@ -0,0 +1,108 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/buildbucket/protoutil"
+)
+
+// BuilderQueueKind is a BuilderQueue entity's kind in the datastore.
+const BuilderQueueKind = "BuilderQueue"
+
+// PendingBuildKind is a PendingBuild entity's kind in the datastore.
+const PendingBuildKind = "PendingBuild"
+
+// BuilderQueue tracks triggered builds of a builder with max_concurrent_builds
+// set.
+//
+// The number of triggered builds is bounded by max_concurrent_builds, builds
+// waiting for capacity are stored as separate PendingBuild entities instead.
+// A build is added to TriggeredBuilds when the builder has capacity for it and
+// removed from the queue when it ends.
+type BuilderQueue struct {
+	_     datastore.PropertyMap `gae:"-,extra"`
+	_kind string                `gae:"$kind,BuilderQueue"`
+	// ID is the builder ID in the format of <project>/<bucket>/<builder>.
+	ID string `gae:"$id"`
+
+	// TriggeredBuilds are IDs of builds which have been triggered and haven't
+	// ended yet.
+	TriggeredBuilds []int64 `gae:"triggered_builds,noindex"`
+}
+
+// Has returns whether the given build is triggered by the queue.
+func (q *BuilderQueue) Has(buildID int64) bool {
+	for _, id := range q.TriggeredBuilds {
+		if id == buildID {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the given build from the queue, if present.
+func (q *BuilderQueue) Remove(buildID int64) {
+	ret := q.TriggeredBuilds[:0]
+	for _, id := range q.TriggeredBuilds {
+		if id != buildID {
+			ret = append(ret, id)
+		}
+	}
+	q.TriggeredBuilds = ret
+}
+
+// Empty returns whether the queue tracks no builds.
+func (q *BuilderQueue) Empty() bool {
+	return len(q.TriggeredBuilds) == 0
+}
+
+// PendingBuild is a build of a builder with max_concurrent_builds set, which
+// is waiting to be triggered.
+//
+// It's stored together with the build, so creating builds doesn't contend on
+// the BuilderQueue of the builder.
+type PendingBuild struct {
+	_kind string `gae:"$kind,PendingBuild"`
+	// ID is <builder ID>/<create time>/<build ID>.
+	//
+	// It orders the pending builds of a builder in FIFO order.
+	ID string `gae:"$id"`
+
+	// BuildID is the ID of the pending build.
+	BuildID int64 `gae:"build_id,noindex"`
+}
+
+// NewPendingBuild returns a PendingBuild for the given build.
+func NewPendingBuild(b *Build) *PendingBuild {
+	return &PendingBuild{
+		ID:      fmt.Sprintf("%s/%016x/%d", protoutil.FormatBuilderID(b.Proto.Builder), b.Proto.CreateTime.AsTime().UnixNano(), b.ID),
+		BuildID: b.ID,
+	}
+}
+
+// PendingBuildsQuery returns a query for the pending builds of the given
+// builder in FIFO order.
+func PendingBuildsQuery(ctx context.Context, bldrID string) *datastore.Query {
+	// '0' follows '/' in ASCII, so the range covers all IDs prefixed with
+	// "<builder ID>/".
+	return datastore.NewQuery(PendingBuildKind).
+		Gt("__key__", datastore.KeyForObj(ctx, &PendingBuild{ID: bldrID + "/"})).
+		Lt("__key__", datastore.KeyForObj(ctx, &PendingBuild{ID: bldrID + "0"}))
+}
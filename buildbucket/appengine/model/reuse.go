@@ -0,0 +1,75 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/buildbucket/protoutil"
+)
+
+// BuildReuseKey returns a key identifying the inputs of the given build which
+// matter for build reuse: builder, input properties, gitiles commit and gerrit
+// changes. The order of gerrit changes doesn't matter.
+//
+// The key is stored in Build.ReuseKey, so that the latest successful build
+// with the same inputs can be found with a query. Builds are cleaned up by
+// the usual retention policy, no separate entities are kept.
+//
+// build.Builder and build.Input must be set.
+func BuildReuseKey(build *pb.Build) (string, error) {
+	opts := proto.MarshalOptions{Deterministic: true}
+	marshal := func(m proto.Message) ([]byte, error) {
+		if m == nil {
+			return nil, nil
+		}
+		return opts.Marshal(m)
+	}
+
+	props, err := marshal(build.Input.GetProperties())
+	if err != nil {
+		return "", errors.Annotate(err, "failed to marshal input properties").Err()
+	}
+	commit, err := marshal(build.Input.GetGitilesCommit())
+	if err != nil {
+		return "", errors.Annotate(err, "failed to marshal gitiles commit").Err()
+	}
+	changes := make([][]byte, len(build.Input.GetGerritChanges()))
+	for i, ch := range build.Input.GetGerritChanges() {
+		if changes[i], err = marshal(ch); err != nil {
+			return "", errors.Annotate(err, "failed to marshal gerrit change").Err()
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i], changes[j]) < 0
+	})
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00", protoutil.FormatBuilderID(build.Builder))
+	fmt.Fprintf(h, "%d:%s", len(props), props)
+	fmt.Fprintf(h, "%d:%s", len(commit), commit)
+	for _, ch := range changes {
+		fmt.Fprintf(h, "%d:%s", len(ch), ch)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
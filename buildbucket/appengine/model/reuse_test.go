@@ -0,0 +1,108 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuildReuseKey(t *testing.T) {
+	t.Parallel()
+
+	Convey("BuildReuseKey", t, func() {
+		build := func() *pb.Build {
+			props, err := structpb.NewStruct(map[string]interface{}{
+				"a": "b",
+				"c": 1,
+			})
+			So(err, ShouldBeNil)
+			return &pb.Build{
+				Builder: &pb.BuilderID{
+					Project: "project",
+					Bucket:  "bucket",
+					Builder: "builder",
+				},
+				Input: &pb.Build_Input{
+					Properties: props,
+					GitilesCommit: &pb.GitilesCommit{
+						Host:    "host",
+						Project: "project",
+						Ref:     "refs/heads/main",
+						Id:      "deadbeef",
+					},
+					GerritChanges: []*pb.GerritChange{
+						{Host: "host", Project: "project", Change: 1, Patchset: 1},
+						{Host: "host", Project: "project", Change: 2, Patchset: 1},
+					},
+				},
+			}
+		}
+		key := func(b *pb.Build) string {
+			k, err := BuildReuseKey(b)
+			So(err, ShouldBeNil)
+			return k
+		}
+		base := key(build())
+
+		Convey("stable", func() {
+			So(key(build()), ShouldEqual, base)
+		})
+
+		Convey("ignores gerrit change order", func() {
+			b := build()
+			chs := b.Input.GerritChanges
+			chs[0], chs[1] = chs[1], chs[0]
+			So(key(b), ShouldEqual, base)
+		})
+
+		Convey("ignores fields unrelated to inputs", func() {
+			b := build()
+			b.Id = 1
+			b.Status = pb.Status_SUCCESS
+			b.Tags = []*pb.StringPair{{Key: "k", Value: "v"}}
+			So(key(b), ShouldEqual, base)
+		})
+
+		Convey("builder", func() {
+			b := build()
+			b.Builder.Builder = "other"
+			So(key(b), ShouldNotEqual, base)
+		})
+
+		Convey("properties", func() {
+			b := build()
+			b.Input.Properties.Fields["a"] = structpb.NewStringValue("c")
+			So(key(b), ShouldNotEqual, base)
+		})
+
+		Convey("commit", func() {
+			b := build()
+			b.Input.GitilesCommit.Id = "cafe"
+			So(key(b), ShouldNotEqual, base)
+		})
+
+		Convey("changes", func() {
+			b := build()
+			b.Input.GerritChanges = b.Input.GerritChanges[:1]
+			So(key(b), ShouldNotEqual, base)
+		})
+	})
+}
@@ -538,6 +538,7 @@ func (bc *buildCreator) createBuilds(ctx context.Context) ([]*model.Build, error
 			bldr := b.Proto.Builder
 			bucket := fmt.Sprintf("%s/%s", bldr.Project, bldr.Bucket)
 			cfg := bc.cfgs[bucket][bldr.Builder]
+			b.Queued = cfg.GetMaxConcurrentBuilds() > 0
 			work <- func() error {
 				toPut := []interface{}{
 					b,
@@ -551,6 +552,9 @@ func (bc *buildCreator) createBuilds(ctx context.Context) ([]*model.Build, error
 					},
 				}
 				r := model.NewRequestID(ctx, b.ID, now, reqID)
+				if b.Queued {
+					toPut = append(toPut, model.NewPendingBuild(b))
+				}
 
 				// Write the entities and trigger a task queue task to create the Swarming task.
 				err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
@@ -586,6 +590,16 @@ func (bc *buildCreator) createBuilds(ctx context.Context) ([]*model.Build, error
 						return nil
 					}
 
+					if b.Queued {
+						if err := tasks.PushPendingBuildTask(ctx, &taskdefs.PushPendingBuildTask{
+							BuildId:   b.ID,
+							BuilderId: b.Proto.Builder,
+						}); err != nil {
+							return errors.Annotate(err, "failed to enqueue PushPendingBuildTask: %d", b.ID).Err()
+						}
+						return nil
+					}
+
 					if stringset.NewFromSlice(b.Proto.Input.Experiments...).Has(bb.ExperimentBackendGo) {
 						if err := tasks.CreateSwarmingBuildTask(ctx, &taskdefs.CreateSwarmingBuildTask{
 							BuildId: b.ID,
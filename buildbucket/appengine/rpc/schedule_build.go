@@ -31,12 +31,14 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"go.chromium.org/luci/cipd/common"
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/data/rand/mathrand"
 	"go.chromium.org/luci/common/data/stringset"
 	"go.chromium.org/luci/common/data/strpair"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/sync/parallel"
+	"go.chromium.org/luci/gae/service/datastore"
 	"go.chromium.org/luci/grpc/appstatus"
 
 	bb "go.chromium.org/luci/buildbucket"
@@ -1253,6 +1255,17 @@ func scheduleBuilds(ctx context.Context, globalCfg *pb.SettingsCfg, reqs ...*pb.
 			merr[origI] = appstatus.BadRequest(errors.Reason("build %d contains more than 6 unique expirations", i).Err())
 			continue
 		}
+
+		// A reused build doesn't notify the new requester, so requests with
+		// notify always create a new build.
+		if cfg.GetReusePolicy().GetWindow().AsDuration() > 0 && len(blds[i].Proto.AncestorIds) == 0 && validReq[i].GetNotify() == nil {
+			key, err := model.BuildReuseKey(blds[i].Proto)
+			if err != nil {
+				merr[origI] = errors.Annotate(err, "failed to compute reuse key").Err()
+				continue
+			}
+			blds[i].ReuseKey = key
+		}
 	}
 	if dryRun {
 		if merr.First() == nil {
@@ -1261,19 +1274,125 @@ func scheduleBuilds(ctx context.Context, globalCfg *pb.SettingsCfg, reqs ...*pb.
 		return blds, merr
 	}
 
+	reused, err := findReusableBuilds(ctx, blds, cfgs, merr, idxMapBlds)
+	if err != nil {
+		return nil, err
+	}
+	var newBlds []*model.Build
+	var idxMapNewBlds []int
+	for i, b := range blds {
+		if reused[i] == nil {
+			newBlds = append(newBlds, b)
+			idxMapNewBlds = append(idxMapNewBlds, idxMapBlds[i])
+		}
+	}
+
 	reqIDs := make([]string, 0, len(reqs))
 	for _, req := range reqs {
 		reqIDs = append(reqIDs, req.RequestId)
 	}
 	bc := &buildCreator{
 		globalCfg:      globalCfg,
-		blds:           blds,
+		blds:           newBlds,
 		cfgs:           cfgs,
-		idxMapBldToReq: idxMapBlds,
+		idxMapBldToReq: idxMapNewBlds,
 		reqIDs:         reqIDs,
 		merr:           merr,
 	}
-	return bc.createBuilds(ctx)
+	created, err := bc.createBuilds(ctx)
+	if len(newBlds) == len(blds) {
+		return created, err
+	}
+
+	// Map created and reused builds back to requests. createBuilds returns
+	// builds indexed by request iff there were errors.
+	ret := make([]*model.Build, len(reqs))
+	switch me, ok := err.(errors.MultiError); {
+	case err == nil:
+		for i, b := range created {
+			ret[idxMapNewBlds[i]] = b
+		}
+	case ok:
+		copy(ret, created)
+		merr = me
+	default:
+		return nil, err
+	}
+	for i, b := range reused {
+		if b != nil {
+			ret[idxMapBlds[i]] = b
+		}
+	}
+	if merr.First() == nil {
+		return ret, nil
+	}
+	return ret, merr
+}
+
+// findReusableBuilds returns builds which can be returned instead of creating
+// the given builds, according to their builders' reuse policies.
+//
+// The reusable build is the latest successful build with the same reuse key,
+// which ended within the reuse window. Builds of requests which already failed
+// are skipped.
+//
+// The returned slice is aligned with blds and has nil for builds which must be
+// created.
+func findReusableBuilds(ctx context.Context, blds []*model.Build, cfgs map[string]map[string]*pb.BuilderConfig, merr errors.MultiError, idxMapBlds []int) ([]*model.Build, error) {
+	ret := make([]*model.Build, len(blds))
+	byKey := map[string][]int{}
+	var keys []string
+	for i, b := range blds {
+		if b.ReuseKey == "" || merr[idxMapBlds[i]] != nil {
+			continue
+		}
+		if _, ok := byKey[b.ReuseKey]; !ok {
+			keys = append(keys, b.ReuseKey)
+		}
+		byKey[b.ReuseKey] = append(byKey[b.ReuseKey], i)
+	}
+	if len(keys) == 0 {
+		return ret, nil
+	}
+
+	// Build IDs decrease over time, so the first build in the key order is the
+	// latest one.
+	latest := make([]*model.Build, len(keys))
+	err := parallel.WorkPool(min(64, len(keys)), func(work chan<- func() error) {
+		for i, key := range keys {
+			i := i
+			q := datastore.NewQuery(model.BuildKind).
+				Eq("reuse_key", key).
+				Eq("status_v2", pb.Status_SUCCESS).
+				Limit(1)
+			work <- func() error {
+				return datastore.Run(ctx, q, func(b *model.Build) error {
+					latest[i] = b
+					return datastore.Stop
+				})
+			}
+		}
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to fetch reusable builds").Err()
+	}
+
+	now := clock.Now(ctx)
+	for i, c := range latest {
+		if c == nil {
+			continue
+		}
+		bldr := c.Proto.Builder
+		window := cfgs[protoutil.FormatBucketID(bldr.Project, bldr.Bucket)][bldr.Builder].GetReusePolicy().GetWindow().AsDuration()
+		if now.Sub(c.Proto.EndTime.AsTime()) > window {
+			continue
+		}
+		logging.Infof(ctx, "reusing build %d for builder %q", c.ID, protoutil.FormatBuilderID(bldr))
+		for _, idx := range byKey[keys[i]] {
+			ret[idx] = c
+		}
+	}
+	return ret, nil
 }
 
 // normalizeSchedule converts deprecated fields to non-deprecated ones.
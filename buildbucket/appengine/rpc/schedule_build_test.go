@@ -1270,6 +1270,154 @@ func TestScheduleBuild(t *testing.T) {
 			So(datastore.Get(ctx, &model.Build{ID: 9021868963222163297}), ShouldErrLike, "no such entity")
 		})
 
+		Convey("max concurrent builds", func() {
+			So(datastore.Put(ctx, &model.Builder{
+				Parent: model.BucketKey(ctx, "project", "bucket"),
+				ID:     "builder",
+				Config: &pb.BuilderConfig{
+					Name:                "builder",
+					MaxConcurrentBuilds: 1,
+				},
+			}), ShouldBeNil)
+			testutil.PutBucket(ctx, "project", "bucket", nil)
+			req := &pb.ScheduleBuildRequest{
+				Builder: &pb.BuilderID{
+					Project: "project",
+					Bucket:  "bucket",
+					Builder: "builder",
+				},
+			}
+
+			blds, err := scheduleBuilds(ctx, globalCfg, req)
+			So(err, ShouldBeNil)
+			So(blds, ShouldHaveLength, 1)
+			So(blds[0].Queued, ShouldBeTrue)
+			So(sch.Tasks(), ShouldHaveLength, 1)
+			So(sch.Tasks().Payloads()[0], ShouldResembleProto, &taskdefs.PushPendingBuildTask{
+				BuildId:   blds[0].ID,
+				BuilderId: req.Builder,
+			})
+			So(datastore.Get(ctx, model.NewPendingBuild(blds[0])), ShouldBeNil)
+		})
+
+		Convey("reuse", func() {
+			So(datastore.Put(ctx, &model.Builder{
+				Parent: model.BucketKey(ctx, "project", "bucket"),
+				ID:     "builder",
+				Config: &pb.BuilderConfig{
+					Name: "builder",
+					ReusePolicy: &pb.BuilderConfig_BuildReusePolicy{
+						Window: durationpb.New(time.Hour),
+					},
+				},
+			}), ShouldBeNil)
+			testutil.PutBucket(ctx, "project", "bucket", nil)
+			req := func() *pb.ScheduleBuildRequest {
+				return &pb.ScheduleBuildRequest{
+					Builder: &pb.BuilderID{
+						Project: "project",
+						Bucket:  "bucket",
+						Builder: "builder",
+					},
+					GerritChanges: []*pb.GerritChange{
+						{Host: "host", Project: "project", Change: 1, Patchset: 1},
+					},
+				}
+			}
+
+			blds, err := scheduleBuilds(ctx, globalCfg, req())
+			So(err, ShouldBeNil)
+			So(blds, ShouldHaveLength, 1)
+			first := blds[0].ID
+			So(sch.Tasks(), ShouldHaveLength, 1)
+
+			key, err := model.BuildReuseKey(blds[0].Proto)
+			So(err, ShouldBeNil)
+			stored := &model.Build{ID: first}
+			So(datastore.Get(ctx, stored), ShouldBeNil)
+			So(stored.ReuseKey, ShouldEqual, key)
+
+			endBuild := func(id int64, status pb.Status, end time.Time) {
+				b := &model.Build{ID: id}
+				So(datastore.Get(ctx, b), ShouldBeNil)
+				b.Proto.Status = status
+				b.Proto.EndTime = timestamppb.New(end)
+				So(datastore.Put(ctx, b), ShouldBeNil)
+			}
+
+			Convey("not ended", func() {
+				blds, err := scheduleBuilds(ctx, globalCfg, req())
+				So(err, ShouldBeNil)
+				So(blds[0].ID, ShouldNotEqual, first)
+				So(sch.Tasks(), ShouldHaveLength, 2)
+			})
+
+			Convey("failed", func() {
+				endBuild(first, pb.Status_FAILURE, testclock.TestRecentTimeUTC)
+				blds, err := scheduleBuilds(ctx, globalCfg, req())
+				So(err, ShouldBeNil)
+				So(blds[0].ID, ShouldNotEqual, first)
+			})
+
+			Convey("succeeded outside window", func() {
+				endBuild(first, pb.Status_SUCCESS, testclock.TestRecentTimeUTC.Add(-2*time.Hour))
+				blds, err := scheduleBuilds(ctx, globalCfg, req())
+				So(err, ShouldBeNil)
+				So(blds[0].ID, ShouldNotEqual, first)
+			})
+
+			Convey("succeeded within window", func() {
+				endBuild(first, pb.Status_SUCCESS, testclock.TestRecentTimeUTC.Add(-time.Minute))
+
+				Convey("identical", func() {
+					blds, err := scheduleBuilds(ctx, globalCfg, req())
+					So(err, ShouldBeNil)
+					So(blds[0].ID, ShouldEqual, first)
+					So(sch.Tasks(), ShouldHaveLength, 1)
+				})
+
+				Convey("with notify", func() {
+					r := req()
+					r.Notify = &pb.NotificationConfig{
+						PubsubTopic: "projects/project/topics/topic",
+						UserData:    []byte("data"),
+					}
+					blds, err := scheduleBuilds(ctx, globalCfg, r)
+					So(err, ShouldBeNil)
+					So(blds[0].ID, ShouldNotEqual, first)
+					So(blds[0].ReuseKey, ShouldBeEmpty)
+					So(blds[0].PubSubCallback.Topic, ShouldEqual, "projects/project/topics/topic")
+					So(sch.Tasks(), ShouldHaveLength, 2)
+				})
+
+				Convey("later build failed", func() {
+					So(datastore.Put(ctx, &model.Build{
+						ID: first - 1,
+						Proto: &pb.Build{
+							Id:      first - 1,
+							Builder: req().Builder,
+							Status:  pb.Status_FAILURE,
+						},
+						ReuseKey: key,
+					}), ShouldBeNil)
+					blds, err := scheduleBuilds(ctx, globalCfg, req())
+					So(err, ShouldBeNil)
+					So(blds[0].ID, ShouldEqual, first)
+				})
+
+				Convey("different inputs", func() {
+					r := req()
+					r.GerritChanges[0].Patchset = 2
+					blds, err := scheduleBuilds(ctx, globalCfg, r, req())
+					So(err, ShouldBeNil)
+					So(blds, ShouldHaveLength, 2)
+					So(blds[0].ID, ShouldNotEqual, first)
+					So(blds[1].ID, ShouldEqual, first)
+					So(sch.Tasks(), ShouldHaveLength, 2)
+				})
+			})
+		})
+
 		Convey("one with parent", func() {
 			tkBody := &pb.TokenBody{
 				BuildId: 1,
@@ -457,6 +457,7 @@ func updateEntities(ctx context.Context, req *pb.UpdateBuildRequest, parentID in
 					return tasks.ExportBigQuery(ctx, b.ID, strings.Contains(b.ExperimentsString(), buildbucket.ExperimentBqExporterGo))
 				}
 				tks <- func() error { return tasks.FinalizeResultDB(ctx, invTask) }
+				tks <- func() error { return tasks.PopPendingBuildTask(ctx, b) }
 			})
 			if err != nil {
 				return err
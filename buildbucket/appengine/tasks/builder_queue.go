@@ -0,0 +1,211 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"fmt"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq"
+
+	bb "go.chromium.org/luci/buildbucket"
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/buildbucket/protoutil"
+)
+
+// PushPendingBuildTask enqueues a task to trigger the pending builds of the
+// builder of a newly created build. Must be called in a transaction which
+// creates the build and its model.PendingBuild.
+func PushPendingBuildTask(ctx context.Context, task *taskdefs.PushPendingBuildTask) error {
+	switch {
+	case task.GetBuildId() == 0:
+		return errors.Reason("build_id is required").Err()
+	case task.GetBuilderId() == nil:
+		return errors.Reason("builder_id is required").Err()
+	}
+	return tq.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("push-pending-build-%d", task.BuildId),
+		Payload: task,
+	})
+}
+
+// PopPendingBuildTask enqueues a task to remove the given ended build from the
+// queue of its builder. Does nothing if the build isn't queued.
+func PopPendingBuildTask(ctx context.Context, b *model.Build) error {
+	if !b.Queued {
+		return nil
+	}
+	return tq.AddTask(ctx, &tq.Task{
+		Title: fmt.Sprintf("pop-pending-build-%d", b.ID),
+		Payload: &taskdefs.PopPendingBuildTask{
+			BuildId:   b.ID,
+			BuilderId: b.Proto.Builder,
+		},
+	})
+}
+
+// maxConcurrentBuilds returns the max_concurrent_builds limit of the given
+// builder, or 0 if the builder has no limit.
+func maxConcurrentBuilds(ctx context.Context, bldrID *pb.BuilderID) (int, error) {
+	bldr := &model.Builder{
+		Parent: model.BucketKey(ctx, bldrID.Project, bldrID.Bucket),
+		ID:     bldrID.Builder,
+	}
+	switch err := datastore.Get(ctx, bldr); {
+	case err == datastore.ErrNoSuchEntity:
+		// The builder has been removed, release all its builds.
+		return 0, nil
+	case err != nil:
+		return 0, transient.Tag.Apply(errors.Annotate(err, "failed to fetch builder %q", protoutil.FormatBuilderID(bldrID)).Err())
+	}
+	return int(bldr.Config.GetMaxConcurrentBuilds()), nil
+}
+
+// triggerBuild enqueues a task to create the backend task of the given build.
+// Must be called in a transaction.
+func triggerBuild(ctx context.Context, b *model.Build) error {
+	if b.ExperimentStatus(bb.ExperimentBackendGo) == pb.Trinary_YES {
+		return CreateSwarmingBuildTask(ctx, &taskdefs.CreateSwarmingBuildTask{
+			BuildId: b.ID,
+		})
+	}
+	return CreateSwarmingTask(ctx, &taskdefs.CreateSwarmingTask{
+		BuildId: b.ID,
+	})
+}
+
+// triggerBatchSize is the max number of pending builds triggered in a single
+// transaction.
+//
+// Triggering a build deletes its PendingBuild, reads the build and adds a task
+// into TQ, and a ds transaction can update at most 25 entities, one of which
+// is the BuilderQueue.
+const triggerBatchSize = 24 / 3
+
+// triggerPendingBuilds triggers pending builds of the builder in FIFO order
+// while the builder has capacity. Pending builds which have already ended are
+// dropped.
+func triggerPendingBuilds(ctx context.Context, bldrID *pb.BuilderID) error {
+	max, err := maxConcurrentBuilds(ctx, bldrID)
+	if err != nil {
+		return err
+	}
+	qID := protoutil.FormatBuilderID(bldrID)
+	for {
+		// Queries within a transaction must include an Ancestor filter.
+		// Hence, this searches pending builds out of a transaction first,
+		// and then triggers them in a transaction.
+		var pending []*model.PendingBuild
+		q := model.PendingBuildsQuery(ctx, qID).Limit(triggerBatchSize)
+		if err := datastore.GetAll(ctx, q, &pending); err != nil {
+			return transient.Tag.Apply(errors.Annotate(err, "failed to fetch pending builds of %q", qID).Err())
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		full := false
+		err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+			full = false
+			q := &model.BuilderQueue{ID: qID}
+			if err := model.GetIgnoreMissing(ctx, q); err != nil {
+				return errors.Annotate(err, "failed to fetch builder queue").Err()
+			}
+			// Pending builds may have been triggered concurrently.
+			exists, err := datastore.Exists(ctx, pending)
+			if err != nil {
+				return errors.Annotate(err, "failed to check pending builds").Err()
+			}
+			for i, p := range pending {
+				if max > 0 && len(q.TriggeredBuilds) >= max {
+					full = true
+					break
+				}
+				if !exists.Get(0, i) {
+					continue
+				}
+				if err := datastore.Delete(ctx, p); err != nil {
+					return errors.Annotate(err, "failed to delete pending build %d", p.BuildID).Err()
+				}
+				b := &model.Build{ID: p.BuildID}
+				switch err := datastore.Get(ctx, b); {
+				case err == datastore.ErrNoSuchEntity:
+					continue
+				case err != nil:
+					return errors.Annotate(err, "failed to fetch build %d", p.BuildID).Err()
+				case protoutil.IsEnded(b.Proto.Status):
+					continue
+				}
+				if err := triggerBuild(ctx, b); err != nil {
+					return errors.Annotate(err, "failed to trigger build %d", b.ID).Err()
+				}
+				q.TriggeredBuilds = append(q.TriggeredBuilds, b.ID)
+			}
+			if q.Empty() {
+				return nil
+			}
+			return datastore.Put(ctx, q)
+		}, nil)
+		switch {
+		case err != nil:
+			return transient.Tag.Apply(errors.Annotate(err, "failed to trigger pending builds of %q", qID).Err())
+		case full || len(pending) < triggerBatchSize:
+			return nil
+		}
+	}
+}
+
+// popPendingBuild removes the ended build from the queue of its builder and
+// triggers the next pending builds.
+func popPendingBuild(ctx context.Context, buildID int64, bldrID *pb.BuilderID) error {
+	b := &model.Build{ID: buildID}
+	switch err := datastore.Get(ctx, b); {
+	case err == datastore.ErrNoSuchEntity:
+		logging.Warningf(ctx, "build %d not found", buildID)
+		return nil
+	case err != nil:
+		return transient.Tag.Apply(errors.Annotate(err, "failed to fetch build %d", buildID).Err())
+	}
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		// The build may have ended while pending.
+		if err := datastore.Delete(ctx, model.NewPendingBuild(b)); err != nil {
+			return errors.Annotate(err, "failed to delete pending build").Err()
+		}
+		q := &model.BuilderQueue{ID: protoutil.FormatBuilderID(bldrID)}
+		switch err := datastore.Get(ctx, q); {
+		case err == datastore.ErrNoSuchEntity:
+			return nil
+		case err != nil:
+			return errors.Annotate(err, "failed to fetch builder queue").Err()
+		case !q.Has(buildID):
+			return nil
+		}
+		q.Remove(buildID)
+		if q.Empty() {
+			return datastore.Delete(ctx, q)
+		}
+		return datastore.Put(ctx, q)
+	}, nil)
+	if err != nil {
+		return transient.Tag.Apply(errors.Annotate(err, "failed to pop build %d", buildID).Err())
+	}
+	return triggerPendingBuilds(ctx, bldrID)
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq"
+
+	"go.chromium.org/luci/buildbucket/appengine/model"
+	taskdefs "go.chromium.org/luci/buildbucket/appengine/tasks/defs"
+	pb "go.chromium.org/luci/buildbucket/proto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBuilderQueue(t *testing.T) {
+	t.Parallel()
+
+	Convey("BuilderQueue", t, func() {
+		ctx := txndefer.FilterRDS(memory.Use(context.Background()))
+		datastore.GetTestable(ctx).AutoIndex(true)
+		datastore.GetTestable(ctx).Consistent(true)
+		ctx, sch := tq.TestingContext(ctx, nil)
+
+		bldrID := &pb.BuilderID{
+			Project: "project",
+			Bucket:  "bucket",
+			Builder: "builder",
+		}
+		So(datastore.Put(ctx, &model.Builder{
+			Parent: model.BucketKey(ctx, "project", "bucket"),
+			ID:     "builder",
+			Config: &pb.BuilderConfig{
+				Name:                "builder",
+				MaxConcurrentBuilds: 2,
+			},
+		}), ShouldBeNil)
+
+		now := testclock.TestRecentTimeUTC
+		putBuild := func(id int64) {
+			b := &model.Build{
+				ID: id,
+				Proto: &pb.Build{
+					Id:         id,
+					Builder:    bldrID,
+					Status:     pb.Status_SCHEDULED,
+					CreateTime: timestamppb.New(now.Add(time.Duration(id) * time.Second)),
+				},
+				Queued: true,
+			}
+			So(datastore.Put(ctx, b, model.NewPendingBuild(b)), ShouldBeNil)
+		}
+		endBuild := func(id int64) {
+			b := &model.Build{ID: id}
+			So(datastore.Get(ctx, b), ShouldBeNil)
+			b.Proto.Status = pb.Status_SUCCESS
+			So(datastore.Put(ctx, b), ShouldBeNil)
+		}
+		queue := func() *model.BuilderQueue {
+			q := &model.BuilderQueue{ID: "project/bucket/builder"}
+			So(model.GetIgnoreMissing(ctx, q), ShouldBeNil)
+			return q
+		}
+		pending := func() []int64 {
+			var pbs []*model.PendingBuild
+			So(datastore.GetAll(ctx, model.PendingBuildsQuery(ctx, "project/bucket/builder"), &pbs), ShouldBeNil)
+			ids := []int64{}
+			for _, p := range pbs {
+				ids = append(ids, p.BuildID)
+			}
+			return ids
+		}
+		triggered := func() []int64 {
+			var ids []int64
+			for _, t := range sch.Tasks() {
+				if p, ok := t.Payload.(*taskdefs.CreateSwarmingTask); ok {
+					ids = append(ids, p.BuildId)
+				}
+			}
+			return ids
+		}
+
+		// Builds are queued by their creation time, not by the order they're
+		// stored in.
+		for i := int64(4); i >= 1; i-- {
+			putBuild(i)
+		}
+		So(triggerPendingBuilds(ctx, bldrID), ShouldBeNil)
+		So(queue().TriggeredBuilds, ShouldResemble, []int64{1, 2})
+
+		Convey("push", func() {
+			So(pending(), ShouldResemble, []int64{3, 4})
+			So(triggered(), ShouldHaveLength, 2)
+
+			Convey("idempotent", func() {
+				So(triggerPendingBuilds(ctx, bldrID), ShouldBeNil)
+				So(queue().TriggeredBuilds, ShouldResemble, []int64{1, 2})
+				So(pending(), ShouldResemble, []int64{3, 4})
+				So(triggered(), ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("pop triggered", func() {
+			endBuild(1)
+			So(popPendingBuild(ctx, 1, bldrID), ShouldBeNil)
+			So(queue().TriggeredBuilds, ShouldResemble, []int64{2, 3})
+			So(pending(), ShouldResemble, []int64{4})
+			So(triggered(), ShouldHaveLength, 3)
+		})
+
+		Convey("pop pending", func() {
+			endBuild(3)
+			So(popPendingBuild(ctx, 3, bldrID), ShouldBeNil)
+			So(queue().TriggeredBuilds, ShouldResemble, []int64{1, 2})
+			So(pending(), ShouldResemble, []int64{4})
+			So(triggered(), ShouldHaveLength, 2)
+		})
+
+		Convey("skips ended pending builds", func() {
+			endBuild(3)
+			endBuild(1)
+			So(popPendingBuild(ctx, 1, bldrID), ShouldBeNil)
+			So(queue().TriggeredBuilds, ShouldResemble, []int64{2, 4})
+			So(pending(), ShouldBeEmpty)
+		})
+
+		Convey("deletes empty queue", func() {
+			for i := int64(1); i <= 4; i++ {
+				endBuild(i)
+				So(popPendingBuild(ctx, i, bldrID), ShouldBeNil)
+			}
+			So(datastore.Get(ctx, &model.BuilderQueue{ID: "project/bucket/builder"}), ShouldEqual, datastore.ErrNoSuchEntity)
+		})
+
+		Convey("limit removed", func() {
+			So(datastore.Put(ctx, &model.Builder{
+				Parent: model.BucketKey(ctx, "project", "bucket"),
+				ID:     "builder",
+				Config: &pb.BuilderConfig{
+					Name: "builder",
+				},
+			}), ShouldBeNil)
+			endBuild(1)
+			So(popPendingBuild(ctx, 1, bldrID), ShouldBeNil)
+			So(queue().TriggeredBuilds, ShouldResemble, []int64{2, 3, 4})
+			So(pending(), ShouldBeEmpty)
+		})
+
+		Convey("triggers in batches", func() {
+			for i := int64(5); i <= 20; i++ {
+				putBuild(i)
+			}
+			So(datastore.Put(ctx, &model.Builder{
+				Parent: model.BucketKey(ctx, "project", "bucket"),
+				ID:     "builder",
+				Config: &pb.BuilderConfig{
+					Name:                "builder",
+					MaxConcurrentBuilds: 19,
+				},
+			}), ShouldBeNil)
+			So(triggerPendingBuilds(ctx, bldrID), ShouldBeNil)
+			So(queue().TriggeredBuilds, ShouldHaveLength, 19)
+			So(pending(), ShouldResemble, []int64{20})
+		})
+
+		Convey("PopPendingBuildTask", func() {
+			b := &model.Build{ID: 1}
+			So(datastore.Get(ctx, b), ShouldBeNil)
+			n := len(sch.Tasks())
+
+			Convey("queued", func() {
+				So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+					return PopPendingBuildTask(ctx, b)
+				}, nil), ShouldBeNil)
+				So(sch.Tasks(), ShouldHaveLength, n+1)
+			})
+
+			Convey("not queued", func() {
+				b.Queued = false
+				So(PopPendingBuildTask(ctx, b), ShouldBeNil)
+				So(sch.Tasks(), ShouldHaveLength, n)
+			})
+		})
+	})
+}
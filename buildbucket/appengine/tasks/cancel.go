@@ -188,6 +188,9 @@ func Cancel(ctx context.Context, bID int64) (*model.Build, error) {
 		if err := NotifyPubSub(ctx, bld); err != nil {
 			return errors.Annotate(err, "failed to enqueue pubsub notification task: %d", bld.ID).Err()
 		}
+		if err := PopPendingBuildTask(ctx, bld); err != nil {
+			return errors.Annotate(err, "failed to enqueue pop pending build task: %d", bld.ID).Err()
+		}
 
 		now := clock.Now(ctx).UTC()
 
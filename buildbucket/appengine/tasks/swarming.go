@@ -608,6 +608,9 @@ func sendOnBuildCompletion(ctx context.Context, bld *model.Build) error {
 	if err := NotifyPubSub(ctx, bld); err != nil {
 		return errors.Annotate(err, "failed to enqueue pubsub notification task: %d", bld.ID).Err()
 	}
+	if err := PopPendingBuildTask(ctx, bld); err != nil {
+		return errors.Annotate(err, "failed to enqueue pop pending build task: %d", bld.ID).Err()
+	}
 	return nil
 }
 
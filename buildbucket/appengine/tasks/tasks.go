@@ -198,6 +198,28 @@ func init() {
 		},
 	})
 
+	tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "push-pending-build",
+		Kind:      tq.Transactional,
+		Prototype: (*taskdefs.PushPendingBuildTask)(nil),
+		Queue:     "backend-go-default",
+		Handler: func(ctx context.Context, payload proto.Message) error {
+			t := payload.(*taskdefs.PushPendingBuildTask)
+			return triggerPendingBuilds(ctx, t.BuilderId)
+		},
+	})
+
+	tq.RegisterTaskClass(tq.TaskClass{
+		ID:        "pop-pending-build",
+		Kind:      tq.Transactional,
+		Prototype: (*taskdefs.PopPendingBuildTask)(nil),
+		Queue:     "backend-go-default",
+		Handler: func(ctx context.Context, payload proto.Message) error {
+			t := payload.(*taskdefs.PopPendingBuildTask)
+			return popPendingBuild(ctx, t.BuildId, t.BuilderId)
+		},
+	})
+
 	tq.RegisterTaskClass(tq.TaskClass{
 		ID:        "export-bigquery-go",
 		Kind:      tq.Transactional,
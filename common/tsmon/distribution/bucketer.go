@@ -81,6 +81,22 @@ func (b *Bucketer) UnderflowBucket() int { return 0 }
 // OverflowBucket returns the index of the overflow bucket.
 func (b *Bucketer) OverflowBucket() int { return b.numFiniteBuckets + 1 }
 
+// LowerBound returns the lower bound of the bucket with the given index.
+//
+// The lower bound of the underflow bucket is -Inf.
+func (b *Bucketer) LowerBound(bucket int) float64 { return b.lowerBounds[bucket] }
+
+// UpperBound returns the upper bound of the bucket with the given index.
+//
+// Buckets don't include their upper bounds. The upper bound of the overflow
+// bucket is +Inf.
+func (b *Bucketer) UpperBound(bucket int) float64 {
+	if bucket >= b.OverflowBucket() {
+		return math.Inf(1)
+	}
+	return b.lowerBounds[bucket+1]
+}
+
 // Bucket returns the index of the bucket for sample.
 // TODO(dsansome): consider reimplementing sort.Search inline to avoid overhead
 // of calling a function to compare two values.
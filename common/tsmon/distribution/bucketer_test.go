@@ -15,6 +15,7 @@
 package distribution
 
 import (
+	"math"
 	"testing"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -44,6 +45,14 @@ func TestFixedWidthBucketer(t *testing.T) {
 		So(b.Bucket(5), ShouldEqual, 1)
 		So(b.Bucket(10), ShouldEqual, 2)
 		So(b.Bucket(100), ShouldEqual, 2)
+
+		So(b.LowerBound(0), ShouldEqual, math.Inf(-1))
+		So(b.LowerBound(1), ShouldEqual, 0)
+		So(b.LowerBound(2), ShouldEqual, 10)
+
+		So(b.UpperBound(0), ShouldEqual, 0)
+		So(b.UpperBound(1), ShouldEqual, 10)
+		So(b.UpperBound(2), ShouldEqual, math.Inf(1))
 	})
 }
 
@@ -77,5 +86,9 @@ func TestGeometricBucketer(t *testing.T) {
 		So(b.Bucket(16), ShouldEqual, 3)
 		So(b.Bucket(63), ShouldEqual, 3)
 		So(b.Bucket(64), ShouldEqual, 4)
+
+		So(b.LowerBound(0), ShouldEqual, math.Inf(-1))
+		So(b.LowerBound(1), ShouldEqual, 1)
+		So(b.LowerBound(5), ShouldEqual, 256)
 	})
 }
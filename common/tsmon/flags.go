@@ -58,7 +58,9 @@ func (fl *Flags) Register(f *flag.FlagSet) {
 			"deployment of credentials.")
 	f.StringVar(&fl.Endpoint, "ts-mon-endpoint", fl.Endpoint,
 		"url (including file://, https://, pubsub://project/topic) to post "+
-			"monitoring metrics to. If set, overrides the value in "+
+			"monitoring metrics to. Prefix an http(s) url with \"otlp+\" to post "+
+			"metrics to an OpenTelemetry collector, e.g. "+
+			"otlp+http://localhost:4318/v1/metrics. If set, overrides the value in "+
 			"--ts-mon-config-file")
 	f.StringVar(&fl.Credentials, "ts-mon-credentials", fl.Credentials,
 		"path to a pkcs8 json credential file. If set, overrides the value in "+
@@ -17,6 +17,7 @@ package tsmon
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -187,6 +188,18 @@ func initMonitor(c context.Context, cfg config) (monitor.Monitor, error) {
 		}
 
 		return monitor.NewHTTPMonitor(c, client, endpointURL)
+	case "otlp+http", "otlp+https":
+		endpointURL.Scheme = strings.TrimPrefix(endpointURL.Scheme, "otlp+")
+		// OpenTelemetry collectors are usually unauthenticated. Authenticate
+		// only if credentials are given explicitly.
+		client := &http.Client{}
+		if cfg.Credentials != "" || cfg.ActAs != "" {
+			var err error
+			if client, err = newAuthenticator(c, cfg.Credentials, cfg.ActAs, []string{auth.OAuthScopeEmail}).Client(); err != nil {
+				return nil, err
+			}
+		}
+		return monitor.NewOTLPMonitor(c, client, endpointURL), nil
 	default:
 		return nil, fmt.Errorf("unknown tsmon endpoint url: %s", cfg.Endpoint)
 	}
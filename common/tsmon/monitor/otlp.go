@@ -0,0 +1,364 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/lhttp"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/distribution"
+	pb "go.chromium.org/luci/common/tsmon/ts_mon_proto"
+	"go.chromium.org/luci/common/tsmon/types"
+)
+
+// otlpScope is the instrumentation scope of all metrics exported via OTLP.
+const otlpScope = "go.chromium.org/luci/common/tsmon"
+
+type otlpMonitor struct {
+	client   *http.Client
+	endpoint *url.URL
+
+	m        sync.Mutex
+	lastSent time.Time // when the previous Send started
+}
+
+// NewOTLPMonitor creates a new Monitor object that sends metrics to an
+// OpenTelemetry collector using OTLP/HTTP protocol with protobuf encoding.
+//
+// The endpoint is the full URL of the metrics endpoint of the collector,
+// usually "http://<host>:4318/v1/metrics". The http client should be
+// authenticated as required.
+//
+// See SerializeOTLP for how tsmon metrics are represented in OpenTelemetry.
+func NewOTLPMonitor(ctx context.Context, client *http.Client, endpoint *url.URL) Monitor {
+	return &otlpMonitor{
+		client:   client,
+		endpoint: endpoint,
+		lastSent: clock.Now(ctx),
+	}
+}
+
+func (m *otlpMonitor) ChunkSize() int {
+	return 500
+}
+
+func (m *otlpMonitor) Send(ctx context.Context, cells []types.Cell) (err error) {
+	startTime := clock.Now(ctx)
+
+	m.m.Lock()
+	intervalStart := m.lastSent
+	m.lastSent = startTime
+	m.m.Unlock()
+
+	defer func() {
+		if err == nil {
+			logging.Debugf(ctx, "tsmon: sent %d cells in %s", len(cells), clock.Now(ctx).Sub(startTime))
+		} else {
+			logging.Warningf(ctx, "tsmon: failed to send %d cells - %s", len(cells), err)
+		}
+	}()
+
+	// Don't waste time on serialization if we are already too late.
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	encoded, err := proto.Marshal(&collectorpb.ExportMetricsServiceRequest{
+		ResourceMetrics: SerializeOTLP(cells, intervalStart, startTime),
+	})
+	if err != nil {
+		return err
+	}
+
+	status, err := lhttp.NewRequest(ctx, m.client, nil, func() (*http.Request, error) {
+		req, err := http.NewRequest("POST", m.endpoint.String(), bytes.NewReader(encoded))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		return req, nil
+	}, func(resp *http.Response) error {
+		return resp.Body.Close()
+	}, func(resp *http.Response, oErr error) error {
+		if resp != nil {
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				logging.WithError(err).Warningf(ctx, "Failed to read error response body")
+			} else {
+				logging.Warningf(ctx, "OTLP export failed.\nResponse body: %s", body)
+			}
+			resp.Body.Close()
+		}
+		return oErr
+	})()
+	if err != nil {
+		return err
+	}
+	if status != http.StatusOK {
+		return fmt.Errorf("bad response status %d from endpoint %s", status, m.endpoint)
+	}
+	return nil
+}
+
+func (m *otlpMonitor) Close() error {
+	return nil
+}
+
+// SerializeOTLP converts cells into OpenTelemetry metrics, one ResourceMetrics
+// per target.
+//
+// Target fields become resource attributes, metric fields become data point
+// attributes. Metric types are converted as follows:
+//   - Cumulative ints and floats become monotonic cumulative sums.
+//   - Non-cumulative ints and floats become gauges.
+//   - Bools become int gauges with values 0 or 1.
+//   - Strings become int gauges with value 1 and the string in the "value"
+//     attribute.
+//   - Cumulative distributions become cumulative explicit bucket histograms.
+//   - Non-cumulative distributions become delta histograms covering the
+//     reporting interval [intervalStart, now), i.e. the time since the previous
+//     export.
+func SerializeOTLP(cells []types.Cell, intervalStart, now time.Time) []*metricspb.ResourceMetrics {
+	type metricKey struct {
+		targetHash uint64
+		metricName string
+	}
+	resources := map[uint64]*metricspb.ResourceMetrics{}
+	metrics := map[metricKey]*metricspb.Metric{}
+	var ret []*metricspb.ResourceMetrics
+
+	for _, c := range cells {
+		// Find the resource, add it if it doesn't exist.
+		targetHash := c.Target.Hash()
+		res, ok := resources[targetHash]
+		if !ok {
+			res = &metricspb.ResourceMetrics{
+				Resource: &resourcepb.Resource{
+					Attributes: targetAttributes(c.Target),
+				},
+				ScopeMetrics: []*metricspb.ScopeMetrics{{
+					Scope: &commonpb.InstrumentationScope{Name: otlpScope},
+				}},
+			}
+			resources[targetHash] = res
+			ret = append(ret, res)
+		}
+
+		// Find the metric, add it if it doesn't exist.
+		key := metricKey{targetHash, c.Name}
+		metric, ok := metrics[key]
+		if !ok {
+			metric = otlpMetric(c)
+			metrics[key] = metric
+			res.ScopeMetrics[0].Metrics = append(res.ScopeMetrics[0].Metrics, metric)
+		}
+
+		// Add the data point to the metric.
+		addOTLPDataPoint(metric, c, intervalStart, now)
+	}
+	return ret
+}
+
+// otlpMetric creates a new metric without any data points.
+func otlpMetric(c types.Cell) *metricspb.Metric {
+	m := &metricspb.Metric{
+		Name:        c.Name,
+		Description: c.Description,
+		Unit:        string(c.Units),
+	}
+	switch c.ValueType {
+	case types.CumulativeIntType, types.CumulativeFloatType:
+		m.Data = &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+		}}
+	case types.CumulativeDistributionType:
+		m.Data = &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		}}
+	case types.NonCumulativeDistributionType:
+		m.Data = &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+		}}
+	default:
+		m.Data = &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{}}
+	}
+	return m
+}
+
+// addOTLPDataPoint adds the cell's value to the metric created by otlpMetric.
+func addOTLPDataPoint(m *metricspb.Metric, c types.Cell, intervalStart, now time.Time) {
+	var start time.Time
+	switch {
+	case c.ValueType.IsCumulative():
+		start = c.ResetTime
+	case c.ValueType == types.NonCumulativeDistributionType:
+		// Delta histograms must have a non-empty time interval.
+		start = intervalStart
+		if !start.Before(now) {
+			start = now.Add(-time.Millisecond)
+		}
+	default:
+		// Start time is not used by gauges.
+		start = now
+	}
+	attrs := fieldAttributes(c)
+
+	if d, ok := c.Value.(*distribution.Distribution); ok {
+		hist := m.Data.(*metricspb.Metric_Histogram).Histogram
+		dp := otlpHistogramDataPoint(d)
+		dp.Attributes = attrs
+		dp.StartTimeUnixNano = uint64(start.UnixNano())
+		dp.TimeUnixNano = uint64(now.UnixNano())
+		hist.DataPoints = append(hist.DataPoints, dp)
+		return
+	}
+
+	dp := &metricspb.NumberDataPoint{
+		Attributes:        attrs,
+		StartTimeUnixNano: uint64(start.UnixNano()),
+		TimeUnixNano:      uint64(now.UnixNano()),
+	}
+	switch v := c.Value.(type) {
+	case int64:
+		dp.Value = &metricspb.NumberDataPoint_AsInt{AsInt: v}
+	case float64:
+		dp.Value = &metricspb.NumberDataPoint_AsDouble{AsDouble: v}
+	case bool:
+		dp.Value = &metricspb.NumberDataPoint_AsInt{AsInt: boolToInt(v)}
+	case string:
+		dp.Attributes = append(dp.Attributes, otlpKeyValue("value", v))
+		dp.Value = &metricspb.NumberDataPoint_AsInt{AsInt: 1}
+	}
+	switch data := m.Data.(type) {
+	case *metricspb.Metric_Sum:
+		data.Sum.DataPoints = append(data.Sum.DataPoints, dp)
+	case *metricspb.Metric_Gauge:
+		data.Gauge.DataPoints = append(data.Gauge.DataPoints, dp)
+	}
+}
+
+// otlpHistogramDataPoint converts a distribution into a histogram data point
+// without attributes and timestamps.
+//
+// tsmon buckets are [lower, upper) ranges, with underflow and overflow buckets
+// at the ends. They map to OpenTelemetry explicit bounds one-to-one: the bounds
+// are upper bounds of all buckets except the overflow one. Note that
+// OpenTelemetry buckets include the upper bound instead of the lower one.
+func otlpHistogramDataPoint(d *distribution.Distribution) *metricspb.HistogramDataPoint {
+	b := d.Bucketer()
+	dp := &metricspb.HistogramDataPoint{
+		Count:          uint64(d.Count()),
+		Sum:            proto.Float64(d.Sum()),
+		BucketCounts:   make([]uint64, b.NumBuckets()),
+		ExplicitBounds: make([]float64, b.NumBuckets()-1),
+	}
+	for i := range dp.ExplicitBounds {
+		dp.ExplicitBounds[i] = b.UpperBound(i)
+	}
+	for i, count := range d.Buckets() {
+		dp.BucketCounts[i] = uint64(count)
+	}
+	return dp
+}
+
+// fieldAttributes converts metric fields of the cell into attributes.
+func fieldAttributes(c types.Cell) []*commonpb.KeyValue {
+	if len(c.Fields) == 0 {
+		return nil
+	}
+	attrs := make([]*commonpb.KeyValue, len(c.Fields))
+	for i, f := range c.Fields {
+		attrs[i] = otlpKeyValue(f.Name, c.FieldVals[i])
+	}
+	return attrs
+}
+
+// targetAttributes converts the target into resource attributes.
+func targetAttributes(t types.Target) []*commonpb.KeyValue {
+	labels := targetLabels(t)
+	attrs := make([]*commonpb.KeyValue, len(labels))
+	for i, l := range labels {
+		var v interface{}
+		switch val := l.Value.(type) {
+		case *pb.MetricsCollection_RootLabels_StringValue:
+			v = val.StringValue
+		case *pb.MetricsCollection_RootLabels_Int64Value:
+			v = val.Int64Value
+		case *pb.MetricsCollection_RootLabels_BoolValue:
+			v = val.BoolValue
+		}
+		attrs[i] = otlpKeyValue(l.GetKey(), v)
+	}
+	return attrs
+}
+
+// prodXLabels are target labels that only make sense to ProdX.
+var prodXLabels = map[string]bool{
+	"proxy_environment": true,
+	"acquisition_name":  true,
+	"proxy_zone":        true,
+}
+
+// targetLabels returns the target's root labels except ones specific to ProdX.
+func targetLabels(t types.Target) []*pb.MetricsCollection_RootLabels {
+	collection := &pb.MetricsCollection{}
+	t.PopulateProto(collection)
+	labels := make([]*pb.MetricsCollection_RootLabels, 0, len(collection.RootLabels))
+	for _, l := range collection.RootLabels {
+		if !prodXLabels[l.GetKey()] {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
+
+// otlpKeyValue creates an attribute from a string, int64 or bool value.
+func otlpKeyValue(key string, value interface{}) *commonpb.KeyValue {
+	kv := &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{}}
+	switch v := value.(type) {
+	case string:
+		kv.Value.Value = &commonpb.AnyValue_StringValue{StringValue: v}
+	case int64:
+		kv.Value.Value = &commonpb.AnyValue_IntValue{IntValue: v}
+	case bool:
+		kv.Value.Value = &commonpb.AnyValue_BoolValue{BoolValue: v}
+	default:
+		kv.Value.Value = &commonpb.AnyValue_StringValue{StringValue: fmt.Sprintf("%v", v)}
+	}
+	return kv
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,263 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/target"
+	"go.chromium.org/luci/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestSerializeOTLP(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2001, 1, 2, 3, 4, 5, 6, time.UTC)
+	intervalStart := now.Add(-time.Minute)
+	reset := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+	nowNano := uint64(now.UnixNano())
+	resetNano := uint64(reset.UnixNano())
+
+	task := &target.Task{ServiceName: "svc", JobName: "job", TaskNum: 1}
+	taskAttrs := []*commonpb.KeyValue{
+		otlpKeyValue("service_name", "svc"),
+		otlpKeyValue("job_name", "job"),
+		otlpKeyValue("data_center", ""),
+		otlpKeyValue("host_name", ""),
+		otlpKeyValue("task_num", int64(1)),
+	}
+
+	cell := func(name string, typ types.ValueType, value interface{}) types.Cell {
+		return types.Cell{
+			MetricInfo: types.MetricInfo{
+				Name:        name,
+				Description: "desc",
+				Fields:      []field.Field{field.String("f")},
+				ValueType:   typ,
+			},
+			MetricMetadata: types.MetricMetadata{Units: types.Seconds},
+			CellData: types.CellData{
+				FieldVals: []interface{}{"v"},
+				Target:    task,
+				ResetTime: reset,
+				Value:     value,
+			},
+		}
+	}
+
+	resourceMetrics := func(metrics ...*metricspb.Metric) []*metricspb.ResourceMetrics {
+		return []*metricspb.ResourceMetrics{{
+			Resource: &resourcepb.Resource{Attributes: taskAttrs},
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Scope:   &commonpb.InstrumentationScope{Name: otlpScope},
+				Metrics: metrics,
+			}},
+		}}
+	}
+
+	Convey("Cumulative int", t, func() {
+		ret := SerializeOTLP([]types.Cell{
+			cell("foo", types.CumulativeIntType, int64(42)),
+			cell("foo", types.CumulativeIntType, int64(43)),
+		}, intervalStart, now)
+		dp := func(v int64) *metricspb.NumberDataPoint {
+			return &metricspb.NumberDataPoint{
+				Attributes:        []*commonpb.KeyValue{otlpKeyValue("f", "v")},
+				StartTimeUnixNano: resetNano,
+				TimeUnixNano:      nowNano,
+				Value:             &metricspb.NumberDataPoint_AsInt{AsInt: v},
+			}
+		}
+		So(ret, ShouldResembleProto, resourceMetrics(&metricspb.Metric{
+			Name:        "foo",
+			Description: "desc",
+			Unit:        "s",
+			Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				IsMonotonic:            true,
+				DataPoints:             []*metricspb.NumberDataPoint{dp(42), dp(43)},
+			}},
+		}))
+	})
+
+	Convey("Gauges", t, func() {
+		ret := SerializeOTLP([]types.Cell{
+			cell("float", types.NonCumulativeFloatType, 1.5),
+			cell("bool", types.BoolType, true),
+			cell("str", types.StringType, "hi"),
+		}, intervalStart, now)
+		gauge := func(name string, dp *metricspb.NumberDataPoint) *metricspb.Metric {
+			dp.StartTimeUnixNano = nowNano
+			dp.TimeUnixNano = nowNano
+			if dp.Attributes == nil {
+				dp.Attributes = []*commonpb.KeyValue{otlpKeyValue("f", "v")}
+			}
+			return &metricspb.Metric{
+				Name:        name,
+				Description: "desc",
+				Unit:        "s",
+				Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+					DataPoints: []*metricspb.NumberDataPoint{dp},
+				}},
+			}
+		}
+		So(ret, ShouldResembleProto, resourceMetrics(
+			gauge("float", &metricspb.NumberDataPoint{
+				Value: &metricspb.NumberDataPoint_AsDouble{AsDouble: 1.5},
+			}),
+			gauge("bool", &metricspb.NumberDataPoint{
+				Value: &metricspb.NumberDataPoint_AsInt{AsInt: 1},
+			}),
+			gauge("str", &metricspb.NumberDataPoint{
+				Attributes: []*commonpb.KeyValue{otlpKeyValue("f", "v"), otlpKeyValue("value", "hi")},
+				Value:      &metricspb.NumberDataPoint_AsInt{AsInt: 1},
+			}),
+		))
+	})
+
+	Convey("Distribution", t, func() {
+		d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+		d.Add(-1)
+		d.Add(5)
+		d.Add(15)
+		d.Add(15)
+
+		ret := SerializeOTLP([]types.Cell{cell("dist", types.CumulativeDistributionType, d)}, intervalStart, now)
+		So(ret, ShouldResembleProto, resourceMetrics(&metricspb.Metric{
+			Name:        "dist",
+			Description: "desc",
+			Unit:        "s",
+			Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []*metricspb.HistogramDataPoint{{
+					Attributes:        []*commonpb.KeyValue{otlpKeyValue("f", "v")},
+					StartTimeUnixNano: resetNano,
+					TimeUnixNano:      nowNano,
+					Count:             4,
+					Sum:               proto.Float64(34),
+					BucketCounts:      []uint64{1, 1, 2, 0},
+					ExplicitBounds:    []float64{0, 10, 20},
+				}},
+			}},
+		}))
+	})
+
+	Convey("Non-cumulative distribution", t, func() {
+		d := distribution.New(distribution.FixedWidthBucketer(10, 2))
+		d.Add(5)
+
+		ret := SerializeOTLP([]types.Cell{cell("dist", types.NonCumulativeDistributionType, d)}, intervalStart, now)
+		hist := ret[0].ScopeMetrics[0].Metrics[0].Data.(*metricspb.Metric_Histogram).Histogram
+		So(hist.AggregationTemporality, ShouldEqual, metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA)
+		So(hist.DataPoints[0].StartTimeUnixNano, ShouldEqual, uint64(intervalStart.UnixNano()))
+		So(hist.DataPoints[0].TimeUnixNano, ShouldEqual, nowNano)
+
+		// The interval is never empty.
+		ret = SerializeOTLP([]types.Cell{cell("dist", types.NonCumulativeDistributionType, d)}, now, now)
+		hist = ret[0].ScopeMetrics[0].Metrics[0].Data.(*metricspb.Metric_Histogram).Histogram
+		So(hist.DataPoints[0].StartTimeUnixNano, ShouldBeLessThan, nowNano)
+	})
+
+	Convey("Target without ProdX labels", t, func() {
+		attrs := targetAttributes(&target.NetworkDevice{Hostname: "host"})
+		keys := make([]string, len(attrs))
+		for i, kv := range attrs {
+			keys[i] = kv.Key
+		}
+		So(keys, ShouldNotContain, "proxy_environment")
+		So(keys, ShouldContain, "hostname")
+	})
+
+	Convey("Empty buckets", t, func() {
+		d := distribution.New(distribution.GeometricBucketer(2, 2))
+		dp := otlpHistogramDataPoint(d)
+		So(dp.BucketCounts, ShouldResemble, []uint64{0, 0, 0, 0})
+		So(dp.ExplicitBounds, ShouldResemble, []float64{1, 2, 4})
+		So(math.IsInf(d.Bucketer().LowerBound(0), -1), ShouldBeTrue)
+	})
+}
+
+func TestOTLPMonitor(t *testing.T) {
+	t.Parallel()
+
+	Convey("Sends metrics", t, func() {
+		var got *collectorpb.ExportMetricsServiceRequest
+		var contentType string
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			contentType = r.Header.Get("Content-Type")
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			got = &collectorpb.ExportMetricsServiceRequest{}
+			if err := proto.Unmarshal(body, got); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		}))
+		defer ts.Close()
+
+		endpoint, err := url.Parse(ts.URL + "/v1/metrics")
+		So(err, ShouldBeNil)
+		mon := NewOTLPMonitor(context.Background(), ts.Client(), endpoint)
+		defer mon.Close()
+
+		cells := []types.Cell{{
+			MetricInfo: types.MetricInfo{
+				Name:      "foo",
+				ValueType: types.CumulativeIntType,
+			},
+			CellData: types.CellData{
+				Target: &target.Task{},
+				Value:  int64(1),
+			},
+		}}
+		So(mon.Send(context.Background(), cells), ShouldBeNil)
+		So(contentType, ShouldEqual, "application/x-protobuf")
+		So(got.ResourceMetrics, ShouldHaveLength, 1)
+		So(got.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Name, ShouldEqual, "foo")
+	})
+
+	Convey("Fails on bad status", t, func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "nope", http.StatusBadRequest)
+		}))
+		defer ts.Close()
+
+		endpoint, err := url.Parse(ts.URL)
+		So(err, ShouldBeNil)
+		mon := NewOTLPMonitor(context.Background(), ts.Client(), endpoint)
+		So(mon.Send(context.Background(), nil), ShouldNotBeNil)
+	})
+}
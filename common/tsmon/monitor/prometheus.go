@@ -0,0 +1,190 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/tsmon/distribution"
+	pb "go.chromium.org/luci/common/tsmon/ts_mon_proto"
+	"go.chromium.org/luci/common/tsmon/types"
+)
+
+// PrometheusContentType is the content type of the output of WritePrometheus.
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// WritePrometheus writes cells to w in the Prometheus text exposition format.
+//
+// Metric names are sanitized to match Prometheus requirements, e.g.
+// "luci/server/http/count" becomes "luci_server_http_count". Metric fields and
+// target fields become labels. Metric types are converted as follows:
+//   - Cumulative ints and floats become counters.
+//   - Non-cumulative ints and floats become gauges.
+//   - Bools become gauges with values 0 or 1.
+//   - Strings become gauges with value 1 and the string in the "value" label.
+//   - Distributions become histograms.
+//
+// Mutates order of `cells` as a side effect.
+func WritePrometheus(w io.Writer, cells []types.Cell) error {
+	sort.SliceStable(cells, func(i, j int) bool { return cells[i].Name < cells[j].Name })
+
+	bw := bufio.NewWriter(w)
+	targetLabels := map[uint64]string{}
+	for i, c := range cells {
+		name := prometheusName(c.Name)
+		if i == 0 || cells[i-1].Name != c.Name {
+			fmt.Fprintf(bw, "# HELP %s %s\n", name, prometheusHelpEscaper.Replace(c.Description))
+			fmt.Fprintf(bw, "# TYPE %s %s\n", name, prometheusType(c.ValueType))
+		}
+
+		// Labels are the metric fields followed by the target fields.
+		h := c.Target.Hash()
+		tl, ok := targetLabels[h]
+		if !ok {
+			tl = prometheusTargetLabels(c)
+			targetLabels[h] = tl
+		}
+		labels := prometheusFieldLabels(c)
+		if tl != "" {
+			labels = append(labels, tl)
+		}
+
+		switch v := c.Value.(type) {
+		case *distribution.Distribution:
+			writePrometheusHistogram(bw, name, labels, v)
+		case int64:
+			writePrometheusSample(bw, name, labels, strconv.FormatInt(v, 10))
+		case float64:
+			writePrometheusSample(bw, name, labels, prometheusFloat(v))
+		case bool:
+			writePrometheusSample(bw, name, labels, strconv.FormatInt(boolToInt(v), 10))
+		case string:
+			labels = append(labels, prometheusLabel("value", v))
+			writePrometheusSample(bw, name, labels, "1")
+		}
+	}
+	return bw.Flush()
+}
+
+func writePrometheusHistogram(w io.Writer, name string, labels []string, d *distribution.Distribution) {
+	b := d.Bucketer()
+	buckets := d.Buckets()
+	cumulative := int64(0)
+	for i := 0; i < b.NumBuckets(); i++ {
+		if i < len(buckets) {
+			cumulative += buckets[i]
+		}
+		// Prometheus buckets are labeled by their upper bound.
+		le := b.UpperBound(i)
+		bucketLabels := append(labels[:len(labels):len(labels)], prometheusLabel("le", prometheusFloat(le)))
+		writePrometheusSample(w, name+"_bucket", bucketLabels, strconv.FormatInt(cumulative, 10))
+	}
+	writePrometheusSample(w, name+"_sum", labels, prometheusFloat(d.Sum()))
+	writePrometheusSample(w, name+"_count", labels, strconv.FormatInt(d.Count(), 10))
+}
+
+func writePrometheusSample(w io.Writer, name string, labels []string, value string) {
+	if len(labels) == 0 {
+		fmt.Fprintf(w, "%s %s\n", name, value)
+	} else {
+		fmt.Fprintf(w, "%s{%s} %s\n", name, strings.Join(labels, ","), value)
+	}
+}
+
+func prometheusType(t types.ValueType) string {
+	switch t {
+	case types.CumulativeIntType, types.CumulativeFloatType:
+		return "counter"
+	case types.CumulativeDistributionType, types.NonCumulativeDistributionType:
+		return "histogram"
+	default:
+		return "gauge"
+	}
+}
+
+// prometheusFieldLabels returns formatted labels for the metric fields.
+func prometheusFieldLabels(c types.Cell) []string {
+	labels := make([]string, len(c.Fields), len(c.Fields)+2)
+	for i, f := range c.Fields {
+		labels[i] = prometheusLabel(f.Name, fmt.Sprintf("%v", c.FieldVals[i]))
+	}
+	return labels
+}
+
+// prometheusTargetLabels returns formatted labels for the target fields, joined
+// by commas.
+func prometheusTargetLabels(c types.Cell) string {
+	labels := targetLabels(c.Target)
+	formatted := make([]string, len(labels))
+	for i, l := range labels {
+		var v string
+		switch val := l.Value.(type) {
+		case *pb.MetricsCollection_RootLabels_StringValue:
+			v = val.StringValue
+		case *pb.MetricsCollection_RootLabels_Int64Value:
+			v = strconv.FormatInt(val.Int64Value, 10)
+		case *pb.MetricsCollection_RootLabels_BoolValue:
+			v = strconv.FormatBool(val.BoolValue)
+		}
+		formatted[i] = prometheusLabel(l.GetKey(), v)
+	}
+	return strings.Join(formatted, ",")
+}
+
+var (
+	// prometheusEscaper escapes label values.
+	prometheusEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+	// prometheusHelpEscaper escapes help strings.
+	prometheusHelpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func prometheusLabel(name, value string) string {
+	return fmt.Sprintf(`%s="%s"`, prometheusName(name), prometheusEscaper.Replace(value))
+}
+
+// prometheusName replaces characters not allowed in Prometheus metric and label
+// names with underscores.
+func prometheusName(name string) string {
+	name = strings.TrimPrefix(name, "/")
+	b := []byte(name)
+	for i, c := range b {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+func prometheusFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+}
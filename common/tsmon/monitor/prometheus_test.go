@@ -0,0 +1,96 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package monitor
+
+import (
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/common/tsmon/distribution"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/target"
+	"go.chromium.org/luci/common/tsmon/types"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	t.Parallel()
+
+	Convey("WritePrometheus", t, func() {
+		task := &target.Task{ServiceName: "svc", JobName: "job"}
+		cell := func(name string, typ types.ValueType, fields []field.Field, vals []interface{}, value interface{}) types.Cell {
+			return types.Cell{
+				MetricInfo: types.MetricInfo{
+					Name:        name,
+					Description: "desc of " + name,
+					Fields:      fields,
+					ValueType:   typ,
+				},
+				CellData: types.CellData{
+					FieldVals: vals,
+					Target:    task,
+					Value:     value,
+				},
+			}
+		}
+		targetLabels := `service_name="svc",job_name="job",data_center="",host_name="",task_num="0"`
+
+		d := distribution.New(distribution.FixedWidthBucketer(10, 1))
+		d.Add(5)
+		d.Add(50)
+
+		fields := []field.Field{field.String("code"), field.Int("n")}
+		cells := []types.Cell{
+			cell("/chrome/infra/requests", types.CumulativeIntType, fields, []interface{}{"o\"k", int64(1)}, int64(10)),
+			cell("a/latency", types.CumulativeDistributionType, nil, nil, d),
+			cell("/chrome/infra/requests", types.CumulativeIntType, fields, []interface{}{"err", int64(2)}, int64(3)),
+			cell("b/up", types.BoolType, nil, nil, true),
+			cell("c/version", types.StringType, nil, nil, "v1"),
+			cell("d/load", types.NonCumulativeFloatType, nil, nil, 0.5),
+		}
+
+		out := strings.Builder{}
+		So(WritePrometheus(&out, cells), ShouldBeNil)
+		So(out.String(), ShouldEqual, strings.Join([]string{
+			`# HELP chrome_infra_requests desc of /chrome/infra/requests`,
+			`# TYPE chrome_infra_requests counter`,
+			`chrome_infra_requests{code="o\"k",n="1",` + targetLabels + `} 10`,
+			`chrome_infra_requests{code="err",n="2",` + targetLabels + `} 3`,
+			`# HELP a_latency desc of a/latency`,
+			`# TYPE a_latency histogram`,
+			`a_latency_bucket{` + targetLabels + `,le="0"} 0`,
+			`a_latency_bucket{` + targetLabels + `,le="10"} 1`,
+			`a_latency_bucket{` + targetLabels + `,le="+Inf"} 2`,
+			`a_latency_sum{` + targetLabels + `} 55`,
+			`a_latency_count{` + targetLabels + `} 2`,
+			`# HELP b_up desc of b/up`,
+			`# TYPE b_up gauge`,
+			`b_up{` + targetLabels + `} 1`,
+			`# HELP c_version desc of c/version`,
+			`# TYPE c_version gauge`,
+			`c_version{` + targetLabels + `,value="v1"} 1`,
+			`# HELP d_load desc of d/load`,
+			`# TYPE d_load gauge`,
+			`d_load{` + targetLabels + `} 0.5`,
+			``,
+		}, "\n"))
+	})
+
+	Convey("prometheusName", t, func() {
+		So(prometheusName("/chrome/infra/a.b-c"), ShouldEqual, "chrome_infra_a_b_c")
+		So(prometheusName("1abc"), ShouldEqual, "_abc")
+	})
+}
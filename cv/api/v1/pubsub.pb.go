@@ -23,6 +23,7 @@ package cvpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -34,6 +35,195 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Type is the type of the event.
+type PubSubRunEvent_Type int32
+
+const (
+	// TYPE_UNSPECIFIED is never used.
+	PubSubRunEvent_TYPE_UNSPECIFIED PubSubRunEvent_Type = 0
+	// RUN_STARTED is sent when the Run starts verifying its CLs.
+	PubSubRunEvent_RUN_STARTED PubSubRunEvent_Type = 1
+	// TRYJOBS_UPDATED is sent when the state of the Run's Tryjobs changes.
+	PubSubRunEvent_TRYJOBS_UPDATED PubSubRunEvent_Type = 2
+	// RUN_ENDED is sent when the Run reaches a terminal status.
+	PubSubRunEvent_RUN_ENDED PubSubRunEvent_Type = 3
+)
+
+// Enum value maps for PubSubRunEvent_Type.
+var (
+	PubSubRunEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "RUN_STARTED",
+		2: "TRYJOBS_UPDATED",
+		3: "RUN_ENDED",
+	}
+	PubSubRunEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"RUN_STARTED":      1,
+		"TRYJOBS_UPDATED":  2,
+		"RUN_ENDED":        3,
+	}
+)
+
+func (x PubSubRunEvent_Type) Enum() *PubSubRunEvent_Type {
+	p := new(PubSubRunEvent_Type)
+	*p = x
+	return p
+}
+
+func (x PubSubRunEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PubSubRunEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes[0].Descriptor()
+}
+
+func (PubSubRunEvent_Type) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes[0]
+}
+
+func (x PubSubRunEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PubSubRunEvent_Type.Descriptor instead.
+func (PubSubRunEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Status is a high level status of a Tryjob.
+type PubSubTryjob_Status int32
+
+const (
+	// STATUS_UNSPECIFIED is never used.
+	PubSubTryjob_STATUS_UNSPECIFIED PubSubTryjob_Status = 0
+	// PENDING means Tryjob is being triggered by CV.
+	PubSubTryjob_PENDING PubSubTryjob_Status = 1
+	// TRIGGERED means Tryjob was triggered.
+	PubSubTryjob_TRIGGERED PubSubTryjob_Status = 2
+	// ENDED is a completed Tryjob. Final status.
+	PubSubTryjob_ENDED PubSubTryjob_Status = 3
+	// CANCELLED is Tryjob cancelled by CV. Final status.
+	PubSubTryjob_CANCELLED PubSubTryjob_Status = 4
+	// UNTRIGGERED means Tryjob was not triggered. Final status.
+	PubSubTryjob_UNTRIGGERED PubSubTryjob_Status = 5
+)
+
+// Enum value maps for PubSubTryjob_Status.
+var (
+	PubSubTryjob_Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "PENDING",
+		2: "TRIGGERED",
+		3: "ENDED",
+		4: "CANCELLED",
+		5: "UNTRIGGERED",
+	}
+	PubSubTryjob_Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"PENDING":            1,
+		"TRIGGERED":          2,
+		"ENDED":              3,
+		"CANCELLED":          4,
+		"UNTRIGGERED":        5,
+	}
+)
+
+func (x PubSubTryjob_Status) Enum() *PubSubTryjob_Status {
+	p := new(PubSubTryjob_Status)
+	*p = x
+	return p
+}
+
+func (x PubSubTryjob_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PubSubTryjob_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes[1].Descriptor()
+}
+
+func (PubSubTryjob_Status) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes[1]
+}
+
+func (x PubSubTryjob_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PubSubTryjob_Status.Descriptor instead.
+func (PubSubTryjob_Status) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// ResultStatus is the verdict of verification of Run's CLs by the Tryjob.
+type PubSubTryjob_ResultStatus int32
+
+const (
+	// RESULT_STATUS_UNSPECIFIED means the Tryjob has no result yet.
+	PubSubTryjob_RESULT_STATUS_UNSPECIFIED PubSubTryjob_ResultStatus = 0
+	// UNKNOWN means Tryjob didn't reach a conclusion.
+	PubSubTryjob_UNKNOWN PubSubTryjob_ResultStatus = 1
+	// SUCCEEDED means that Run's CLs are considered OK by this Tryjob.
+	PubSubTryjob_SUCCEEDED PubSubTryjob_ResultStatus = 2
+	// FAILED_PERMANENTLY means that Run's CLs are most likely not good.
+	PubSubTryjob_FAILED_PERMANENTLY PubSubTryjob_ResultStatus = 3
+	// FAILED_TRANSIENTLY means that Run's CLs are most likely not to blame
+	// for the failure.
+	PubSubTryjob_FAILED_TRANSIENTLY PubSubTryjob_ResultStatus = 4
+	// TIMEOUT means the Tryjob ran over some deadline and did not make a
+	// decision about this Run's CLs.
+	PubSubTryjob_TIMEOUT PubSubTryjob_ResultStatus = 5
+)
+
+// Enum value maps for PubSubTryjob_ResultStatus.
+var (
+	PubSubTryjob_ResultStatus_name = map[int32]string{
+		0: "RESULT_STATUS_UNSPECIFIED",
+		1: "UNKNOWN",
+		2: "SUCCEEDED",
+		3: "FAILED_PERMANENTLY",
+		4: "FAILED_TRANSIENTLY",
+		5: "TIMEOUT",
+	}
+	PubSubTryjob_ResultStatus_value = map[string]int32{
+		"RESULT_STATUS_UNSPECIFIED": 0,
+		"UNKNOWN":                   1,
+		"SUCCEEDED":                 2,
+		"FAILED_PERMANENTLY":        3,
+		"FAILED_TRANSIENTLY":        4,
+		"TIMEOUT":                   5,
+	}
+)
+
+func (x PubSubTryjob_ResultStatus) Enum() *PubSubTryjob_ResultStatus {
+	p := new(PubSubTryjob_ResultStatus)
+	*p = x
+	return p
+}
+
+func (x PubSubTryjob_ResultStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PubSubTryjob_ResultStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes[2].Descriptor()
+}
+
+func (PubSubTryjob_ResultStatus) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes[2]
+}
+
+func (x PubSubTryjob_ResultStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PubSubTryjob_ResultStatus.Descriptor instead.
+func (PubSubTryjob_ResultStatus) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescGZIP(), []int{2, 1}
+}
+
 // PubSubRun includes the high-level information about the CV Run sent via
 // PubSub.
 //
@@ -118,26 +308,258 @@ func (x *PubSubRun) GetHostname() string {
 	return ""
 }
 
+// PubSubRunEvent is a single event in the lifecycle of a CV Run sent via
+// PubSub.
+//
+// Events of all the types are published into the same topic, so that
+// consumers can observe the lifecycle of a Run with a single subscription.
+type PubSubRunEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type of the event.
+	Type PubSubRunEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=cv.v1.PubSubRunEvent_Type" json:"type,omitempty"`
+	// The Run as of the event.
+	Run *PubSubRun `protobuf:"bytes,2,opt,name=run,proto3" json:"run,omitempty"`
+	// The time when the event happened.
+	EventTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	// The latest state of the Tryjobs of the Run.
+	//
+	// Set only for TRYJOBS_UPDATED events.
+	Tryjobs []*PubSubTryjob `protobuf:"bytes,4,rep,name=tryjobs,proto3" json:"tryjobs,omitempty"`
+}
+
+func (x *PubSubRunEvent) Reset() {
+	*x = PubSubRunEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubRunEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubRunEvent) ProtoMessage() {}
+
+func (x *PubSubRunEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubRunEvent.ProtoReflect.Descriptor instead.
+func (*PubSubRunEvent) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PubSubRunEvent) GetType() PubSubRunEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PubSubRunEvent_TYPE_UNSPECIFIED
+}
+
+func (x *PubSubRunEvent) GetRun() *PubSubRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+func (x *PubSubRunEvent) GetEventTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventTime
+	}
+	return nil
+}
+
+func (x *PubSubRunEvent) GetTryjobs() []*PubSubTryjob {
+	if x != nil {
+		return x.Tryjobs
+	}
+	return nil
+}
+
+// PubSubTryjob includes the high-level information about a Tryjob required
+// by a CV Run.
+type PubSubTryjob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The builder of the Tryjob in the format of "project/bucket/builder".
+	Builder string `protobuf:"bytes,1,opt,name=builder,proto3" json:"builder,omitempty"`
+	// The ID of the latest attempt of the Tryjob in the backend, e.g.
+	// "buildbucket/cr-buildbucket.appspot.com/8812345678901234567".
+	//
+	// Empty if the Tryjob hasn't been triggered yet.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// The status of the latest attempt of the Tryjob.
+	Status PubSubTryjob_Status `protobuf:"varint,3,opt,name=status,proto3,enum=cv.v1.PubSubTryjob_Status" json:"status,omitempty"`
+	// The result status of the latest attempt of the Tryjob.
+	ResultStatus PubSubTryjob_ResultStatus `protobuf:"varint,4,opt,name=result_status,json=resultStatus,proto3,enum=cv.v1.PubSubTryjob_ResultStatus" json:"result_status,omitempty"`
+	// Whether the Tryjob is critical to the Run's final status.
+	Critical bool `protobuf:"varint,5,opt,name=critical,proto3" json:"critical,omitempty"`
+	// The number of attempts to run the Tryjob, including retries.
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *PubSubTryjob) Reset() {
+	*x = PubSubTryjob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PubSubTryjob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubSubTryjob) ProtoMessage() {}
+
+func (x *PubSubTryjob) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubSubTryjob.ProtoReflect.Descriptor instead.
+func (*PubSubTryjob) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PubSubTryjob) GetBuilder() string {
+	if x != nil {
+		return x.Builder
+	}
+	return ""
+}
+
+func (x *PubSubTryjob) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *PubSubTryjob) GetStatus() PubSubTryjob_Status {
+	if x != nil {
+		return x.Status
+	}
+	return PubSubTryjob_STATUS_UNSPECIFIED
+}
+
+func (x *PubSubTryjob) GetResultStatus() PubSubTryjob_ResultStatus {
+	if x != nil {
+		return x.ResultStatus
+	}
+	return PubSubTryjob_RESULT_STATUS_UNSPECIFIED
+}
+
+func (x *PubSubTryjob) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+func (x *PubSubTryjob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
 var File_go_chromium_org_luci_cv_api_v1_pubsub_proto protoreflect.FileDescriptor
 
 var file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDesc = []byte{
 	0x0a, 0x2b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
 	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
 	0x2f, 0x70, 0x75, 0x62, 0x73, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x63,
-	0x76, 0x2e, 0x76, 0x31, 0x1a, 0x28, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
-	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7e,
-	0x0a, 0x09, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x76,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x25,
-	0x5a, 0x23, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
-	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x3b, 0x63, 0x76, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69,
+	0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x7e, 0x0a, 0x09, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
+	0x76, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0xa1, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x52, 0x75, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x63, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x52,
+	0x75, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x22, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x52, 0x75,
+	0x6e, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75,
+	0x62, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x52, 0x07, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73,
+	0x22, 0x51, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x55, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x54, 0x52, 0x59, 0x4a, 0x4f, 0x42, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x4e, 0x44, 0x45,
+	0x44, 0x10, 0x03, 0x22, 0xee, 0x03, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54, 0x72,
+	0x79, 0x6a, 0x6f, 0x62, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x12,
+	0x32, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x63, 0x76, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54, 0x72,
+	0x79, 0x6a, 0x6f, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x76, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x53, 0x75, 0x62, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x22, 0x67, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x43,
+	0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
+	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x45, 0x44, 0x10, 0x05, 0x22, 0x86, 0x01, 0x0a, 0x0c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x4c, 0x59, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49,
+	0x45, 0x4e, 0x54, 0x4c, 0x59, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f,
+	0x55, 0x54, 0x10, 0x05, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x76, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -152,18 +574,31 @@ func file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescGZIP() []byte {
 	return file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_go_chromium_org_luci_cv_api_v1_pubsub_proto_goTypes = []interface{}{
-	(*PubSubRun)(nil), // 0: cv.v1.PubSubRun
-	(Run_Status)(0),   // 1: cv.v1.Run.Status
+	(PubSubRunEvent_Type)(0),       // 0: cv.v1.PubSubRunEvent.Type
+	(PubSubTryjob_Status)(0),       // 1: cv.v1.PubSubTryjob.Status
+	(PubSubTryjob_ResultStatus)(0), // 2: cv.v1.PubSubTryjob.ResultStatus
+	(*PubSubRun)(nil),              // 3: cv.v1.PubSubRun
+	(*PubSubRunEvent)(nil),         // 4: cv.v1.PubSubRunEvent
+	(*PubSubTryjob)(nil),           // 5: cv.v1.PubSubTryjob
+	(Run_Status)(0),                // 6: cv.v1.Run.Status
+	(*timestamppb.Timestamp)(nil),  // 7: google.protobuf.Timestamp
 }
 var file_go_chromium_org_luci_cv_api_v1_pubsub_proto_depIdxs = []int32{
-	1, // 0: cv.v1.PubSubRun.status:type_name -> cv.v1.Run.Status
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6, // 0: cv.v1.PubSubRun.status:type_name -> cv.v1.Run.Status
+	0, // 1: cv.v1.PubSubRunEvent.type:type_name -> cv.v1.PubSubRunEvent.Type
+	3, // 2: cv.v1.PubSubRunEvent.run:type_name -> cv.v1.PubSubRun
+	7, // 3: cv.v1.PubSubRunEvent.event_time:type_name -> google.protobuf.Timestamp
+	5, // 4: cv.v1.PubSubRunEvent.tryjobs:type_name -> cv.v1.PubSubTryjob
+	1, // 5: cv.v1.PubSubTryjob.status:type_name -> cv.v1.PubSubTryjob.Status
+	2, // 6: cv.v1.PubSubTryjob.result_status:type_name -> cv.v1.PubSubTryjob.ResultStatus
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_go_chromium_org_luci_cv_api_v1_pubsub_proto_init() }
@@ -185,19 +620,44 @@ func file_go_chromium_org_luci_cv_api_v1_pubsub_proto_init() {
 				return nil
 			}
 		}
+		file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubSubRunEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PubSubTryjob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_cv_api_v1_pubsub_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      3,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_go_chromium_org_luci_cv_api_v1_pubsub_proto_goTypes,
 		DependencyIndexes: file_go_chromium_org_luci_cv_api_v1_pubsub_proto_depIdxs,
+		EnumInfos:         file_go_chromium_org_luci_cv_api_v1_pubsub_proto_enumTypes,
 		MessageInfos:      file_go_chromium_org_luci_cv_api_v1_pubsub_proto_msgTypes,
 	}.Build()
 	File_go_chromium_org_luci_cv_api_v1_pubsub_proto = out.File
@@ -50,13 +50,13 @@ import (
 	bbfacade "go.chromium.org/luci/cv/internal/buildbucket/facade"
 	bblistener "go.chromium.org/luci/cv/internal/buildbucket/listener"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/bq"
 	"go.chromium.org/luci/cv/internal/common/tree"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/configs/srvcfg"
 	"go.chromium.org/luci/cv/internal/gerrit"
-	gerritupdater "go.chromium.org/luci/cv/internal/gerrit/updater"
 	"go.chromium.org/luci/cv/internal/migration"
 	"go.chromium.org/luci/cv/internal/prjmanager"
 	pmimpl "go.chromium.org/luci/cv/internal/prjmanager/manager"
@@ -104,7 +104,10 @@ func main() {
 		tryjobNotifier := tryjob.NewNotifier(&tq.Default)
 		clMutator := changelist.NewMutator(&tq.Default, pmNotifier, runNotifier, tryjobNotifier)
 		clUpdater := changelist.NewUpdater(&tq.Default, clMutator)
-		gerritupdater.RegisterUpdater(clUpdater, gFactory)
+
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(gFactory))
+		reviewers.RegisterUpdaterBackends(clUpdater)
 
 		bbFactory := buildbucket.NewClientFactory()
 		bbFacade := &bbfacade.Facade{
@@ -116,7 +119,7 @@ func main() {
 		tryjobCancellator := tjcancel.NewCancellator(tryjobNotifier)
 		tryjobCancellator.RegisterBackend(bbFacade)
 
-		_ = pmimpl.New(pmNotifier, runNotifier, clMutator, gFactory, clUpdater)
+		_ = pmimpl.New(pmNotifier, runNotifier, clMutator, gFactory, clUpdater, reviewers)
 		tc, err := tree.NewClient(srv.Context)
 		if err != nil {
 			return err
@@ -125,7 +128,7 @@ func main() {
 		if err != nil {
 			return err
 		}
-		_ = runimpl.New(runNotifier, pmNotifier, tryjobNotifier, clMutator, clUpdater, gFactory, bbFactory, tc, bqc, env)
+		_ = runimpl.New(runNotifier, pmNotifier, tryjobNotifier, clMutator, clUpdater, gFactory, reviewers, bbFactory, tc, bqc, env)
 
 		// Setup pRPC authentication.
 		srv.PRPC.Authenticator = &auth.Authenticator{
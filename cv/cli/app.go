@@ -42,6 +42,7 @@ func application(p Params) *cli.Application {
 		Title: "LUCI CV Command line utilities",
 		Commands: []*subcommands.Command{
 			cmdMatchConfig(p),
+			cmdEvaluateTryjobs(p),
 
 			{}, // a separator
 			authcli.SubcommandLogin(p.Auth, "auth-login", false),
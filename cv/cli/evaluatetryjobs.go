@@ -0,0 +1,154 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag"
+
+	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob/requirement"
+)
+
+func cmdEvaluateTryjobs(p Params) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "evaluate-tryjobs [flags] CFG_PATH",
+		ShortDesc: "Evaluate which tryjobs a hypothetical CL would trigger.",
+		LongDesc: text.Doc(`
+			With a given configuration file, validate it, and compute the tryjob
+			requirement of a hypothetical CL with the given location and changed files.
+			Each builder in the matched config group is printed along with the reason it
+			is included in or skipped from the requirement.
+
+			CFG_PATH must be the the path to a generated "commit-queue.cfg" file.
+
+			Group membership of the CL owner is not looked up. Instead, the owner is
+			considered to be a member of exactly the groups given with -owner-group.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &evaluateTryjobsRun{}
+			r.Flags.StringVar(&r.host, "host", "", "Gerrit host of the CL, e.g. chromium-review.googlesource.com.")
+			r.Flags.StringVar(&r.project, "project", "", "Gerrit project of the CL.")
+			r.Flags.StringVar(&r.ref, "ref", "refs/heads/main", "Target ref of the CL.")
+			r.Flags.Var(flag.StringSlice(&r.files), "file", "Path of a file changed by the CL. Can be specified multiple times.")
+			r.Flags.StringVar(&r.owner, "owner", "user@example.com", "Email of the CL owner.")
+			r.Flags.Var(flag.StringSlice(&r.ownerGroups), "owner-group", "Group the CL owner is a member of. Can be specified multiple times.")
+			r.Flags.StringVar(&r.mode, "mode", string(run.DryRun), "Mode of the hypothetical Run.")
+			r.Flags.StringVar(&r.configGroup, "config-group", "", "Name of the config group to use. If not set, it is matched by the CL location.")
+			r.Flags.Var(flag.StringSlice(&r.included), "include", `Value of the "Cq-Include-Trybots" footer. Can be specified multiple times.`)
+			return r
+		},
+	}
+}
+
+type evaluateTryjobsRun struct {
+	subcommands.CommandRunBase
+
+	host, project, ref string
+	files              []string
+	owner              string
+	ownerGroups        []string
+	mode               string
+	configGroup        string
+	included           []string
+}
+
+func (r *evaluateTryjobsRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+
+	if err := r.validateArgs(args); err != nil {
+		return r.done(badArgsTag.Apply(err))
+	}
+
+	config, err := loadAndValidateConfig(ctx, args[0])
+	if err != nil {
+		return r.done(err)
+	}
+
+	groups := stringset.NewFromSlice(r.ownerGroups...)
+	res, err := requirement.WhatIf(ctx, requirement.WhatIfInput{
+		Config:          config,
+		ConfigGroup:     r.configGroup,
+		GerritHost:      r.host,
+		GerritProject:   r.project,
+		Ref:             r.ref,
+		Files:           r.files,
+		OwnerEmail:      r.owner,
+		Mode:            run.Mode(r.mode),
+		IncludedTryjobs: r.included,
+		IsMember: func(ctx context.Context, id identity.Identity, gs []string) (bool, error) {
+			for _, g := range gs {
+				if groups.Has(g) {
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	})
+	if err != nil {
+		return r.done(err)
+	}
+
+	fmt.Printf("Config group: %s\n", res.ConfigGroup.GetName())
+	if !res.OK() {
+		return r.done(errors.Reason("failed to compute the requirement: %s", res.ComputationFailure.Reason()).Err())
+	}
+	for _, d := range res.Decisions {
+		verdict := "SKIPPED"
+		if d.Included {
+			verdict = "INCLUDED"
+		}
+		fmt.Printf("  %-8s %s: %s\n", verdict, d.Builder, d.Reason)
+	}
+	return 0
+}
+
+func (r *evaluateTryjobsRun) validateArgs(args []string) error {
+	switch {
+	case len(args) != 1:
+		return errors.Reason("exactly 1 argument is required").Err()
+	case r.host == "" || r.project == "":
+		return errors.Reason("-host and -project are required").Err()
+	case !run.Mode(r.mode).Valid():
+		return errors.Reason("invalid -mode %q", r.mode).Err()
+	case strings.TrimSpace(r.owner) == "":
+		return errors.Reason("-owner must not be empty").Err()
+	}
+	_, err := os.Stat(args[0])
+	return err
+}
+
+func (r *evaluateTryjobsRun) done(err error) int {
+	if err == nil {
+		return 0
+	}
+	fmt.Fprintln(os.Stderr, err)
+	_, badArgs := errors.TagValueIn(badArgsTag.Key, err)
+	if badArgs {
+		return 2
+	}
+	return 1
+}
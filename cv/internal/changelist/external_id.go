@@ -24,7 +24,7 @@ import (
 
 // ExternalID is a unique CL ID deterministically constructed based on CL data.
 //
-// Currently, only Gerrit is supported.
+// Currently, Gerrit and GitHub are supported.
 type ExternalID string
 
 // GobID makes an ExternalID for a Gerrit CL.
@@ -63,6 +63,44 @@ func (eid ExternalID) ParseGobID() (host string, change int64, err error) {
 	return
 }
 
+// GitHubID makes an ExternalID for a GitHub pull request.
+//
+// Host is typically "github.com". Owner and repo identify the repository,
+// e.g. "luci" and "luci-go" for https://github.com/luci/luci-go.
+func GitHubID(host, owner, repo string, number int64) (ExternalID, error) {
+	for _, part := range []string{host, owner, repo} {
+		if part == "" || strings.ContainsRune(part, '/') {
+			return "", errors.Reason("invalid GitHub host, owner or repo %q: must be non-empty and must not contain /", part).Err()
+		}
+	}
+	return ExternalID(fmt.Sprintf("github/%s/%s/%s/%d", host, owner, repo, number)), nil
+}
+
+// MustGitHubID is like GitHubID but panics on error.
+func MustGitHubID(host, owner, repo string, number int64) ExternalID {
+	ret, err := GitHubID(host, owner, repo, number)
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
+
+// ParseGitHubID returns GitHub host, repository owner and name and pull
+// request number if this is a GitHubID.
+func (eid ExternalID) ParseGitHubID() (host, owner, repo string, number int64, err error) {
+	parts := strings.Split(string(eid), "/")
+	if len(parts) != 5 || parts[0] != "github" {
+		err = errors.Reason("%q is not a valid GitHubID", eid).Err()
+		return
+	}
+	host, owner, repo = parts[1], parts[2], parts[3]
+	number, err = strconv.ParseInt(parts[4], 10, 63)
+	if err != nil {
+		err = errors.Annotate(err, "%q is not a valid GitHubID", eid).Err()
+	}
+	return
+}
+
 // URL returns URL of the CL.
 func (eid ExternalID) URL() (string, error) {
 	parts := strings.Split(string(eid), "/")
@@ -72,6 +110,11 @@ func (eid ExternalID) URL() (string, error) {
 	switch kind := parts[0]; kind {
 	case "gerrit":
 		return fmt.Sprintf("https://%s/c/%s", parts[1], parts[2]), nil
+	case "github":
+		if len(parts) != 5 {
+			return "", errors.Reason("invalid ExternalID: %q", eid).Err()
+		}
+		return fmt.Sprintf("https://%s/%s/%s/pull/%s", parts[1], parts[2], parts[3], parts[4]), nil
 	default:
 		return "", errors.Reason("unrecognized ExternalID: %q", eid).Err()
 	}
@@ -86,7 +129,8 @@ func (eid ExternalID) MustURL() string {
 	return ret
 }
 
-func (e ExternalID) kind() (string, error) {
+// Kind returns the kind of the code review system of the CL, e.g. "gerrit".
+func (e ExternalID) Kind() (string, error) {
 	s := string(e)
 	idx := strings.IndexRune(s, '/')
 	if idx <= 0 {
@@ -47,5 +47,34 @@ func TestExternalID(t *testing.T) {
 			So(err, ShouldErrLike, "is not a valid GobID")
 		})
 
+		Convey("GitHubID", func() {
+			eid, err := GitHubID("github.com", "luci", "luci-go", 12)
+			So(err, ShouldBeNil)
+			So(eid, ShouldResemble, ExternalID("github/github.com/luci/luci-go/12"))
+
+			host, owner, repo, number, err := eid.ParseGitHubID()
+			So(err, ShouldBeNil)
+			So(host, ShouldResemble, "github.com")
+			So(owner, ShouldResemble, "luci")
+			So(repo, ShouldResemble, "luci-go")
+			So(number, ShouldEqual, 12)
+
+			So(eid.MustURL(), ShouldResemble, "https://github.com/luci/luci-go/pull/12")
+			kind, err := eid.Kind()
+			So(err, ShouldBeNil)
+			So(kind, ShouldEqual, "github")
+		})
+
+		Convey("Invalid GitHubID", func() {
+			_, err := GitHubID("github.com", "luci/luci-go", "", 1)
+			So(err, ShouldErrLike, "must not contain /")
+
+			_, _, _, _, err = ExternalID("gerrit/x-review.example.com/12").ParseGitHubID()
+			So(err, ShouldErrLike, "is not a valid GitHubID")
+
+			_, _, _, _, err = ExternalID("github/github.com/luci/luci-go/x").ParseGitHubID()
+			So(err, ShouldErrLike, "is not a valid GitHubID")
+		})
+
 	})
 }
@@ -697,7 +697,7 @@ func (*Updater) preload(ctx context.Context, task *UpdateCLTask) (*CL, error) {
 }
 
 func (u *Updater) backendFor(cl *CL) (UpdaterBackend, error) {
-	kind, err := cl.ExternalID.kind()
+	kind, err := cl.ExternalID.Kind()
 	if err != nil {
 		return nil, err
 	}
@@ -795,7 +795,7 @@ func makeTQTitleForHumans(t *UpdateCLTask) string {
 		// Reduce verbosity in common case of Gerrit on googlesource.
 		// Although it's possible to delegate this to backend, the additional
 		// boilerplate isn't yet justified.
-		if kind, err := ExternalID(eid).kind(); err == nil && kind == "gerrit" {
+		if kind, err := ExternalID(eid).Kind(); err == nil && kind == "gerrit" {
 			eid = strings.Replace(eid, "-review.googlesource.com/", "/", 1)
 		}
 		sb.WriteString(eid)
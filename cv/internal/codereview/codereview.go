@@ -0,0 +1,157 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codereview abstracts code review systems, e.g. Gerrit or GitHub,
+// from the rest of CV.
+package codereview
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.chromium.org/luci/common/errors"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/run"
+)
+
+// Provider implements the code review system specific parts of CV.
+type Provider interface {
+	// Kind identifies the code review system.
+	//
+	// It's the first part of the CL's ExternalID, e.g. "gerrit", and must match
+	// the Kind of the corresponding changelist.UpdaterBackend.
+	Kind() string
+
+	// NewUpdaterBackend returns the backend fetching CLs from the code review
+	// system for the given CL Updater.
+	NewUpdaterBackend(u *changelist.Updater) changelist.UpdaterBackend
+
+	// FindTriggers returns the CV triggers currently active on the CL, based on
+	// its latest Snapshot.
+	//
+	// Returns nil if the CL isn't triggered.
+	FindTriggers(cl *changelist.CL, cg *cfgpb.ConfigGroup) *run.Triggers
+
+	// Submit submits the revision of the Run CL in the context of the given
+	// LUCI project.
+	//
+	// Returns the error of the code review system as is, so that the caller
+	// can tell transient and permanent failures apart.
+	Submit(ctx context.Context, luciProject string, rcl *run.RunCL) error
+
+	// ReportStatus reports the status of a CV Run on the Run CL to the users of
+	// the code review system.
+	ReportStatus(ctx context.Context, luciProject string, rcl *run.RunCL, st *Status) error
+}
+
+// State is the state of a CV Run reported via Provider.ReportStatus.
+type State string
+
+const (
+	// StatePending means the Run is in progress.
+	StatePending State = "pending"
+	// StateSuccess means the Run succeeded.
+	StateSuccess State = "success"
+	// StateFailure means the Run failed.
+	StateFailure State = "failure"
+)
+
+// Status is the status of a CV Run on a CL.
+type Status struct {
+	State State
+	// Message is a human-readable description of the status.
+	Message string
+	// URL links to the details of the Run, if any.
+	URL string
+}
+
+// Registry maps code review system kinds to their Providers.
+type Registry struct {
+	providers map[string]Provider
+}
+
+// Register registers a Provider.
+//
+// Panics if a Provider of the same Kind is already registered.
+func (r *Registry) Register(p Provider) {
+	kind := p.Kind()
+	if _, exists := r.providers[kind]; exists {
+		panic(fmt.Errorf("provider %q is already registered", kind))
+	}
+	if r.providers == nil {
+		r.providers = make(map[string]Provider, 1)
+	}
+	r.providers[kind] = p
+}
+
+// RegisterUpdaterBackends registers the backends of all Providers with the CL
+// Updater.
+func (r *Registry) RegisterUpdaterBackends(u *changelist.Updater) {
+	kinds := make([]string, 0, len(r.providers))
+	for kind := range r.providers {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		u.RegisterBackend(r.providers[kind].NewUpdaterBackend(u))
+	}
+}
+
+// Get returns the Provider for the code review system of the given CL.
+func (r *Registry) Get(eid changelist.ExternalID) (Provider, error) {
+	kind, err := eid.Kind()
+	if err != nil {
+		return nil, err
+	}
+	p, ok := r.providers[kind]
+	if !ok {
+		return nil, errors.Reason("no provider for %q code review system", kind).Err()
+	}
+	return p, nil
+}
+
+// FindTriggers returns the CV triggers currently active on the CL using the
+// Provider of its code review system.
+//
+// Panics if there is no such Provider, since the CL couldn't have been fetched
+// without one.
+func (r *Registry) FindTriggers(cl *changelist.CL, cg *cfgpb.ConfigGroup) *run.Triggers {
+	p, err := r.Get(cl.ExternalID)
+	if err != nil {
+		panic(errors.Annotate(err, "CL %d", cl.ID).Err())
+	}
+	return p.FindTriggers(cl, cg)
+}
+
+// Submit submits the Run CL using the Provider of its code review system.
+func (r *Registry) Submit(ctx context.Context, luciProject string, rcl *run.RunCL) error {
+	p, err := r.Get(rcl.ExternalID)
+	if err != nil {
+		return err
+	}
+	return p.Submit(ctx, luciProject, rcl)
+}
+
+// ReportStatus reports the status of a CV Run on the Run CL using the Provider
+// of its code review system.
+func (r *Registry) ReportStatus(ctx context.Context, luciProject string, rcl *run.RunCL, st *Status) error {
+	p, err := r.Get(rcl.ExternalID)
+	if err != nil {
+		return err
+	}
+	return p.ReportStatus(ctx, luciProject, rcl, st)
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codereview
+
+import (
+	"testing"
+
+	"go.chromium.org/luci/server/tq"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	gf "go.chromium.org/luci/cv/internal/gerrit/gerritfake"
+	gerritupdater "go.chromium.org/luci/cv/internal/gerrit/updater"
+	"go.chromium.org/luci/cv/internal/run"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	Convey("Registry", t, func() {
+		r := &Registry{}
+		gp := NewGerritProvider(nil)
+		r.Register(gp)
+		So(func() { r.Register(NewGerritProvider(nil)) }, ShouldPanic)
+
+		p, err := r.Get(changelist.MustGobID("x-review.example.com", 1))
+		So(err, ShouldBeNil)
+		So(p, ShouldEqual, gp)
+
+		_, err = r.Get(changelist.MustGitHubID("github.com", "o", "r", 1))
+		So(err, ShouldErrLike, `no provider for "github"`)
+
+		_, err = r.Get("bogus")
+		So(err, ShouldNotBeNil)
+
+		Convey("FindTriggers", func() {
+			cl := &changelist.CL{
+				ID:         1,
+				ExternalID: changelist.MustGobID("x-review.example.com", 1),
+				Snapshot: &changelist.Snapshot{Kind: &changelist.Snapshot_Gerrit{Gerrit: &changelist.Gerrit{
+					Host: "x-review.example.com",
+					Info: gf.CI(1, gf.CQ(2)),
+				}}},
+			}
+			So(r.FindTriggers(cl, &cfgpb.ConfigGroup{}).GetCqVoteTrigger().GetMode(), ShouldEqual, string(run.FullRun))
+
+			cl.ExternalID = changelist.MustGitHubID("github.com", "o", "r", 1)
+			So(func() { r.FindTriggers(cl, &cfgpb.ConfigGroup{}) }, ShouldPanicLike, `no provider for "github"`)
+		})
+
+		Convey("RegisterUpdaterBackends", func() {
+			u := changelist.NewUpdater(&tq.Dispatcher{}, nil)
+			r.RegisterUpdaterBackends(u)
+			So(func() { gerritupdater.RegisterUpdater(u, nil) }, ShouldPanicLike, `"gerrit" is already registered`)
+		})
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codereview
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"go.chromium.org/luci/common/errors"
+	gerritpb "go.chromium.org/luci/common/proto/gerrit"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/gerrit"
+	"go.chromium.org/luci/cv/internal/gerrit/trigger"
+	gerritupdater "go.chromium.org/luci/cv/internal/gerrit/updater"
+	"go.chromium.org/luci/cv/internal/run"
+)
+
+// NewGerritProvider returns a Provider for Gerrit.
+func NewGerritProvider(gFactory gerrit.Factory) Provider {
+	return &gerritProvider{gFactory: gFactory}
+}
+
+type gerritProvider struct {
+	gFactory gerrit.Factory
+}
+
+// Kind implements Provider.
+func (*gerritProvider) Kind() string {
+	return "gerrit"
+}
+
+// NewUpdaterBackend implements Provider.
+func (p *gerritProvider) NewUpdaterBackend(u *changelist.Updater) changelist.UpdaterBackend {
+	return gerritupdater.NewBackend(u, p.gFactory)
+}
+
+// FindTriggers implements Provider.
+func (*gerritProvider) FindTriggers(cl *changelist.CL, cg *cfgpb.ConfigGroup) *run.Triggers {
+	return trigger.Find(&trigger.FindInput{
+		ChangeInfo:                   cl.Snapshot.GetGerrit().GetInfo(),
+		ConfigGroup:                  cg,
+		TriggerNewPatchsetRunAfterPS: cl.TriggerNewPatchsetRunAfterPS,
+	})
+}
+
+// Submit implements Provider.
+//
+// Gerrit may return an error even though the CL was actually merged, so the
+// CL is re-fetched on failure to check that.
+func (p *gerritProvider) Submit(ctx context.Context, luciProject string, rcl *run.RunCL) error {
+	g := rcl.Detail.GetGerrit()
+	if g == nil {
+		return errors.Reason("CL %d is not a Gerrit CL", rcl.ID).Err()
+	}
+	gc, err := p.gFactory.MakeClient(ctx, g.GetHost(), luciProject)
+	if err != nil {
+		return err
+	}
+	ci := g.GetInfo()
+	_, submitErr := gc.SubmitRevision(ctx, &gerritpb.SubmitRevisionRequest{
+		Number:     ci.GetNumber(),
+		RevisionId: ci.GetCurrentRevision(),
+		Project:    ci.GetProject(),
+	})
+	if submitErr == nil {
+		return nil
+	}
+	// Load the change again to check whether it is actually merged.
+	merged := false
+	_ = p.gFactory.MakeMirrorIterator(ctx).RetryIfStale(func(opt grpc.CallOption) error {
+		latest, err := gc.GetChange(ctx, &gerritpb.GetChangeRequest{
+			Number:  ci.GetNumber(),
+			Project: ci.GetProject(),
+		}, opt)
+		switch {
+		case err != nil:
+			return err
+		case latest.GetStatus() == gerritpb.ChangeStatus_MERGED:
+			// It is possible that somebody else submitted the change, but this is
+			// unlikely enough that we presume CV did it. If necessary, it's possible
+			// to examine Change messages to see who actually did it.
+			merged = true
+			return nil
+		case latest.GetUpdated().AsTime().Before(ci.GetUpdated().AsTime()):
+			return gerrit.ErrStaleData
+		default:
+			merged = false
+			return nil
+		}
+	})
+	if merged {
+		return nil
+	}
+	return submitErr
+}
+
+// ReportStatus implements Provider.
+//
+// Gerrit has no notion of statuses. Instead, the Run Manager posts messages
+// on Gerrit CLs when a Run starts and ends, so this is a noop.
+func (*gerritProvider) ReportStatus(context.Context, string, *run.RunCL, *Status) error {
+	return nil
+}
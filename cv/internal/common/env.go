@@ -42,6 +42,9 @@ type Env struct {
 	// from CQDaemon which doesn't have equivalent -dev environment.
 	IsGAEDev bool
 
+	// LUCIAnalysisHost is the host of LUCI Analysis CV talks to.
+	LUCIAnalysisHost string
+
 	// GAEInfo is populated if LUCI CV runs on GAE.
 	GAEInfo struct {
 		// CloudProject is the name of the Google Cloud Project LUCI CV runs in.
@@ -69,6 +72,10 @@ func MakeEnv(opts server.Options) *Env {
 			InstanceID:  opts.Hostname,
 		},
 	}
+	env.LUCIAnalysisHost = "luci-analysis.appspot.com"
+	if env.IsGAEDev {
+		env.LUCIAnalysisHost = "luci-analysis-dev.appspot.com"
+	}
 	env.HTTPAddressBase = "https://" + env.LogicalHostname
 	if !opts.Prod {
 		// Local development.
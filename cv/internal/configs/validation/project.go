@@ -95,6 +95,18 @@ func validateProjectConfig(ctx *validation.Context, cfg *cfgpb.Config) {
 		if d := cfg.SubmitOptions.BurstDelay; d != nil && d.AsDuration() < 0 {
 			ctx.Errorf("burst_delay must be positive or 0")
 		}
+		if t := cfg.SubmitOptions.Train; t != nil {
+			if t.MaxSize < 2 {
+				ctx.Errorf("train.max_size must be >= 2")
+			}
+			if t.Builder == "" {
+				ctx.Errorf("train.builder is required")
+			} else {
+				ctx.Enter("train.builder")
+				validateBuilderName(ctx, t.Builder, stringset.New(1))
+				ctx.Exit()
+			}
+		}
 		ctx.Exit()
 	}
 	if len(cfg.ConfigGroups) == 0 {
@@ -138,8 +150,8 @@ func validateConfigGroup(ctx *validation.Context, group *cfgpb.ConfigGroup, know
 		knownNames.Add(group.Name)
 	}
 
-	if len(group.Gerrit) == 0 {
-		ctx.Errorf("at least 1 gerrit is required")
+	if len(group.Gerrit) == 0 && len(group.Github) == 0 {
+		ctx.Errorf("at least 1 gerrit or github is required")
 	}
 	gerritURLs := stringset.Set{}
 	for i, g := range group.Gerrit {
@@ -150,6 +162,15 @@ func validateConfigGroup(ctx *validation.Context, group *cfgpb.ConfigGroup, know
 		}
 		ctx.Exit()
 	}
+	githubHosts := stringset.Set{}
+	for i, g := range group.Github {
+		enter(ctx, "github", i, g.Host)
+		validateGitHub(ctx, g)
+		if g.Host != "" && !githubHosts.Add(g.Host) {
+			ctx.Errorf("duplicate github host in the same config_group: %q", g.Host)
+		}
+		ctx.Exit()
+	}
 
 	if group.CombineCls != nil {
 		ctx.Enter("combine_cls")
@@ -294,6 +315,54 @@ func validateGerritProject(ctx *validation.Context, gp *cfgpb.ConfigGroup_Gerrit
 	}
 }
 
+func validateGitHub(ctx *validation.Context, g *cfgpb.ConfigGroup_GitHub) {
+	switch {
+	case g.Host == "":
+		ctx.Errorf("host is required")
+	case strings.Contains(g.Host, "/"):
+		ctx.Errorf("host must not contain a scheme or a path, %q given", g.Host)
+	}
+	if len(g.Repos) == 0 {
+		ctx.Errorf("at least 1 repo is required")
+	}
+	names := stringset.New(len(g.Repos))
+	for i, r := range g.Repos {
+		enter(ctx, "repos", i, r.Name)
+		switch parts := strings.Split(r.Name, "/"); {
+		case r.Name == "":
+			ctx.Errorf("name is required")
+		case len(parts) != 2 || parts[0] == "" || parts[1] == "":
+			ctx.Errorf("name must be <owner>/<repo>, %q given", r.Name)
+		case !names.Add(r.Name):
+			ctx.Errorf("duplicate repo in the same github: %q", r.Name)
+		}
+		if len(r.BranchRegexp) == 0 {
+			ctx.Errorf("at least 1 branch_regexp is required")
+		}
+		validateRegexps(ctx, "branch_regexp", r.BranchRegexp, nil)
+		validateRegexps(ctx, "branch_regexp_exclude", r.BranchRegexpExclude, stringset.NewFromSlice(r.BranchRegexp...))
+		ctx.Exit()
+	}
+}
+
+// validateRegexps validates a list of regexps which must not duplicate each
+// other or any of the `seen` ones.
+func validateRegexps(ctx *validation.Context, field string, regexps []string, seen stringset.Set) {
+	if seen == nil {
+		seen = stringset.New(len(regexps))
+	}
+	for i, r := range regexps {
+		ctx.Enter("%s #%d", field, i+1)
+		if _, err := regexpCompileCached(r); err != nil {
+			ctx.Error(err)
+		}
+		if !seen.Add(r) {
+			ctx.Errorf("duplicate regexp: %q", r)
+		}
+		ctx.Exit()
+	}
+}
+
 func validateVerifiers(ctx *validation.Context, v *cfgpb.Verifiers, supportedModes stringset.Set) {
 	if v.Cqlinter != nil {
 		ctx.Errorf("cqlinter verifier is not allowed (internal use only)")
@@ -433,6 +502,15 @@ func validateTryjobVerifier(ctx *validation.Context, v *cfgpb.Verifiers, support
 			}
 		}
 
+		if b.RetryConfig != nil {
+			ctx.Enter("retry_config")
+			validateTryjobRetry(ctx, b.RetryConfig)
+			if b.RetryConfig.GlobalQuota != 0 {
+				ctx.Errorf("global_quota is not allowed in a builder retry_config")
+			}
+			ctx.Exit()
+		}
+
 		if len(b.OwnerWhitelistGroup) > 0 {
 			for i, g := range b.OwnerWhitelistGroup {
 				if g == "" {
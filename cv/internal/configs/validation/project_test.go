@@ -166,6 +166,21 @@ func TestValidateProjectDetailed(t *testing.T) {
 				validateProjectConfig(vctx, &cfg)
 				So(vctx.Finalize(), ShouldNotBeNil)
 			})
+			Convey("Bad train max_size", func() {
+				cfg.SubmitOptions.Train = &cfgpb.SubmitOptions_SubmitTrain{MaxSize: 1, Builder: "x/try/train"}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "train.max_size must be >= 2")
+			})
+			Convey("Bad train builder", func() {
+				cfg.SubmitOptions.Train = &cfgpb.SubmitOptions_SubmitTrain{MaxSize: 2}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "train.builder is required")
+			})
+			Convey("Bad train builder name", func() {
+				cfg.SubmitOptions.Train = &cfgpb.SubmitOptions_SubmitTrain{MaxSize: 2, Builder: "x/train"}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "doesn't match required format")
+			})
 			Convey("config_groups", func() {
 				orig := cfg.ConfigGroups[0]
 				add := func(refRegexps ...string) {
@@ -234,7 +249,20 @@ func TestValidateProjectDetailed(t *testing.T) {
 			Convey("with Gerrit", func() {
 				cfg.ConfigGroups[0].Gerrit = nil
 				validateProjectConfig(vctx, &cfg)
-				So(vctx.Finalize(), ShouldErrLike, "at least 1 gerrit is required")
+				So(vctx.Finalize(), ShouldErrLike, "at least 1 gerrit or github is required")
+			})
+			Convey("with GitHub only", func() {
+				cfg.ConfigGroups[0].Gerrit = nil
+				cfg.ConfigGroups[0].Github = []*cfgpb.ConfigGroup_GitHub{
+					{
+						Host: "github.com",
+						Repos: []*cfgpb.ConfigGroup_GitHub_Repo{
+							{Name: "luci/luci-go", BranchRegexp: []string{"main"}},
+						},
+					},
+				}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldBeNil)
 			})
 			Convey("with Verifiers", func() {
 				cfg.ConfigGroups[0].Verifiers = nil
@@ -372,6 +400,56 @@ func TestValidateProjectDetailed(t *testing.T) {
 			})
 		})
 
+		Convey("GitHub", func() {
+			g := &cfgpb.ConfigGroup_GitHub{
+				Host: "github.com",
+				Repos: []*cfgpb.ConfigGroup_GitHub_Repo{
+					{Name: "luci/luci-go", BranchRegexp: []string{"main"}},
+				},
+			}
+			cfg.ConfigGroups[0].Github = []*cfgpb.ConfigGroup_GitHub{g}
+			Convey("needs valid host", func() {
+				g.Host = ""
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "host is required")
+
+				g.Host = "https://github.com"
+				vctx = &validation.Context{Context: ctx}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "host must not contain a scheme or a path")
+			})
+			Convey("at least 1 repo required", func() {
+				g.Repos = nil
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "at least 1 repo is required")
+			})
+			Convey("repo name must be owner/repo", func() {
+				g.Repos[0].Name = "luci-go"
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "name must be <owner>/<repo>")
+			})
+			Convey("no dup repo blocks", func() {
+				g.Repos = append(g.Repos, g.Repos[0])
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "duplicate repo in the same github")
+			})
+			Convey("branch_regexp required", func() {
+				g.Repos[0].BranchRegexp = nil
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "at least 1 branch_regexp is required")
+			})
+			Convey("bad branch_regexp", func() {
+				g.Repos[0].BranchRegexp = []string{"main", "*is-bad-regexp"}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "branch_regexp #2): error parsing regexp:")
+			})
+			Convey("duplicate regexp include/exclude", func() {
+				g.Repos[0].BranchRegexpExclude = []string{"main"}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "branch_regexp_exclude #1): duplicate regexp:")
+			})
+		})
+
 		Convey("Gerrit Project", func() {
 			p := cfg.ConfigGroups[0].Gerrit[0].Projects[0]
 			Convey("project name required", func() {
@@ -484,6 +562,19 @@ func TestValidateProjectDetailed(t *testing.T) {
 				So(vctx.Finalize(), ShouldErrLike,
 					"negative single_quota not allowed (-1 given) (and 4 other errors)")
 			})
+
+			Convey("builder retry config", func() {
+				v.Builders[0].RetryConfig = &cfgpb.Verifiers_Tryjob_RetryConfig{
+					SingleQuota: 1,
+					FlakyOnly:   true,
+				}
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldBeNil)
+
+				v.Builders[0].RetryConfig.GlobalQuota = 2
+				validateProjectConfig(vctx, &cfg)
+				So(vctx.Finalize(), ShouldErrLike, "global_quota is not allowed in a builder retry_config")
+			})
 		})
 
 		Convey("UserLimits and UserLimitDefault", func() {
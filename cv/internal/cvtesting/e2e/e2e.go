@@ -46,12 +46,12 @@ import (
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	migrationpb "go.chromium.org/luci/cv/api/migration"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/eventbox"
 	"go.chromium.org/luci/cv/internal/cvtesting"
 	gf "go.chromium.org/luci/cv/internal/gerrit/gerritfake"
 	"go.chromium.org/luci/cv/internal/gerrit/trigger"
-	gerritupdater "go.chromium.org/luci/cv/internal/gerrit/updater"
 	"go.chromium.org/luci/cv/internal/migration"
 	"go.chromium.org/luci/cv/internal/migration/cqdfake"
 	"go.chromium.org/luci/cv/internal/prjmanager"
@@ -166,9 +166,11 @@ func (t *Test) SetUp() (ctx context.Context, deferme func()) {
 	clMutator := changelist.NewMutator(t.TQDispatcher, t.PMNotifier, t.RunNotifier, tjNotifier)
 	clUpdater := changelist.NewUpdater(t.TQDispatcher, clMutator)
 	bbFactory := t.BuildbucketFake.NewClientFactory()
-	gerritupdater.RegisterUpdater(clUpdater, gFactory)
-	_ = pmimpl.New(t.PMNotifier, t.RunNotifier, clMutator, gFactory, clUpdater)
-	_ = runimpl.New(t.RunNotifier, t.PMNotifier, tjNotifier, clMutator, clUpdater, gFactory, bbFactory, t.TreeFake.Client(), t.BQFake, t.Env)
+	reviewers := &codereview.Registry{}
+	reviewers.Register(codereview.NewGerritProvider(gFactory))
+	reviewers.RegisterUpdaterBackends(clUpdater)
+	_ = pmimpl.New(t.PMNotifier, t.RunNotifier, clMutator, gFactory, clUpdater, reviewers)
+	_ = runimpl.New(t.RunNotifier, t.PMNotifier, tjNotifier, clMutator, clUpdater, gFactory, reviewers, bbFactory, t.TreeFake.Client(), t.BQFake, t.Env)
 	_ = tjcancel.NewCancellator(tjNotifier)
 	t.MigrationServer = &migration.MigrationServer{
 		RunNotifier: t.RunNotifier,
@@ -25,10 +25,15 @@ import (
 
 // RegisterUpdater register a Gerrit backend with the CL Updater.
 func RegisterUpdater(u *changelist.Updater, gFactory gerrit.Factory) {
-	u.RegisterBackend(&updaterBackend{
+	u.RegisterBackend(NewBackend(u, gFactory))
+}
+
+// NewBackend returns the Gerrit backend for the CL Updater.
+func NewBackend(u *changelist.Updater, gFactory gerrit.Factory) changelist.UpdaterBackend {
+	return &updaterBackend{
 		gFactory:  gFactory,
 		clUpdater: u,
-	})
+	}
 }
 
 // updaterBackend implements changelist.UpdaterBackend for Gerrit.
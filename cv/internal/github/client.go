@@ -0,0 +1,129 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package github implements support for GitHub pull requests in CV.
+//
+// The provider isn't registered with CV yet, because CV doesn't discover pull
+// requests and Runs are only started for Gerrit CLs.
+package github
+
+import (
+	"context"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// ErrNotFound is returned by Client if the requested resource doesn't exist
+// or isn't visible to the LUCI project.
+var ErrNotFound = errors.New("not found")
+
+// PullRequest is a subset of a GitHub pull request used by CV.
+//
+// https://docs.github.com/en/rest/pulls/pulls#get-a-pull-request
+type PullRequest struct {
+	Number int64
+	Title  string
+	Body   string
+	// State is either "open" or "closed".
+	State string
+	// Merged is true if the pull request has been merged.
+	Merged bool
+	// Author is the login of the author.
+	Author string
+	// BaseRef is the branch the pull request is going to be merged into.
+	BaseRef string
+	// HeadSHA is the SHA of the latest commit of the pull request.
+	HeadSHA   string
+	UpdatedAt time.Time
+	// Labels are the names of the labels currently applied.
+	Labels []string
+}
+
+// IssueEvent is a subset of a GitHub issue event used by CV.
+//
+// https://docs.github.com/en/rest/issues/events
+type IssueEvent struct {
+	// Event is the kind of the event, e.g. "labeled" or "unlabeled".
+	Event string
+	// Actor is the login of the user who triggered the event.
+	Actor string
+	// Label is the name of the label for "labeled" and "unlabeled" events.
+	Label     string
+	CreatedAt time.Time
+}
+
+// Comment is a subset of a GitHub issue comment used by CV.
+//
+// https://docs.github.com/en/rest/issues/comments
+type Comment struct {
+	ID int64
+	// Author is the login of the author.
+	Author    string
+	Body      string
+	CreatedAt time.Time
+}
+
+// Status is a GitHub commit status.
+//
+// https://docs.github.com/en/rest/commits/statuses
+type Status struct {
+	// State is one of "error", "failure", "pending" or "success".
+	State       string
+	Description string
+	// Context distinguishes statuses of different systems, e.g. "luci-cv".
+	Context   string
+	TargetURL string
+}
+
+// Client defines a subset of GitHub API used by CV.
+type Client interface {
+	// GetPullRequest loads a pull request.
+	GetPullRequest(ctx context.Context, owner, repo string, number int64) (*PullRequest, error)
+
+	// ListFiles lists the names of the files touched by a pull request.
+	ListFiles(ctx context.Context, owner, repo string, number int64) ([]string, error)
+
+	// ListIssueEvents lists the events of a pull request in chronological
+	// order.
+	ListIssueEvents(ctx context.Context, owner, repo string, number int64) ([]*IssueEvent, error)
+
+	// ListComments lists the comments of a pull request in chronological order.
+	ListComments(ctx context.Context, owner, repo string, number int64) ([]*Comment, error)
+
+	// CreateComment posts a comment on a pull request.
+	CreateComment(ctx context.Context, owner, repo string, number int64, body string) error
+
+	// RemoveLabel removes a label from a pull request.
+	RemoveLabel(ctx context.Context, owner, repo string, number int64, label string) error
+
+	// CreateStatus sets a status on a commit.
+	CreateStatus(ctx context.Context, owner, repo, sha string, status *Status) error
+
+	// Merge merges a pull request if its head is still at the given SHA.
+	Merge(ctx context.Context, owner, repo string, number int64, sha string) error
+
+	// GetUserEmail returns the public email of a user.
+	//
+	// GitHub only allows verified emails to be public. Returns "" if the user
+	// has no public email.
+	GetUserEmail(ctx context.Context, login string) (string, error)
+}
+
+// Factory creates Client tied to GitHub host and LUCI project.
+//
+// GitHub host and LUCI project determine the authentication being used.
+type Factory interface {
+	MakeClient(ctx context.Context, host, luciProject string) (Client, error)
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package githubfake implements a fake GitHub for use in CV tests.
+package githubfake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cv/internal/github"
+)
+
+// PR is a pull request stored in the Fake.
+type PR struct {
+	github.PullRequest
+	Files    []string
+	Events   []*github.IssueEvent
+	Comments []*github.Comment
+	// Statuses are commit statuses of the head of the pull request, keyed by
+	// their context.
+	Statuses map[string]*github.Status
+	// Visible is the set of LUCI projects which can see the pull request.
+	//
+	// If nil, all projects can see it.
+	Visible map[string]bool
+}
+
+// Fake simulates GitHub for CV tests.
+//
+// Fake implements both github.Factory and github.Client.
+type Fake struct {
+	m      sync.Mutex
+	prs    map[string]*PR
+	emails map[string]string
+}
+
+// Add adds or replaces a pull request.
+func (f *Fake) Add(host, owner, repo string, pr *PR) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.prs == nil {
+		f.prs = map[string]*PR{}
+	}
+	f.prs[key(host, owner, repo, pr.Number)] = pr
+}
+
+// Get returns a copy of the pull request or nil if it doesn't exist.
+func (f *Fake) Get(host, owner, repo string, number int64) *PR {
+	f.m.Lock()
+	defer f.m.Unlock()
+	pr, ok := f.prs[key(host, owner, repo, number)]
+	if !ok {
+		return nil
+	}
+	cpy := *pr
+	return &cpy
+}
+
+// SetUserEmail sets the public email of a user.
+func (f *Fake) SetUserEmail(login, email string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.emails == nil {
+		f.emails = map[string]string{}
+	}
+	f.emails[login] = email
+}
+
+// MakeClient implements github.Factory.
+func (f *Fake) MakeClient(ctx context.Context, host, luciProject string) (github.Client, error) {
+	return &client{f: f, host: host, luciProject: luciProject}, nil
+}
+
+func key(host, owner, repo string, number int64) string {
+	return fmt.Sprintf("%s/%s/%s/%d", host, owner, repo, number)
+}
+
+type client struct {
+	f           *Fake
+	host        string
+	luciProject string
+}
+
+var _ github.Client = (*client)(nil)
+
+// withPR calls cb with the lock held and the requested pull request if it's
+// visible to the LUCI project.
+func (c *client) withPR(owner, repo string, number int64, cb func(pr *PR) error) error {
+	c.f.m.Lock()
+	defer c.f.m.Unlock()
+	pr, ok := c.f.prs[key(c.host, owner, repo, number)]
+	if !ok || (pr.Visible != nil && !pr.Visible[c.luciProject]) {
+		return github.ErrNotFound
+	}
+	return cb(pr)
+}
+
+func (c *client) GetPullRequest(ctx context.Context, owner, repo string, number int64) (ret *github.PullRequest, err error) {
+	err = c.withPR(owner, repo, number, func(pr *PR) error {
+		cpy := pr.PullRequest
+		cpy.Labels = append([]string(nil), pr.Labels...)
+		ret = &cpy
+		return nil
+	})
+	return
+}
+
+func (c *client) ListFiles(ctx context.Context, owner, repo string, number int64) (ret []string, err error) {
+	err = c.withPR(owner, repo, number, func(pr *PR) error {
+		ret = append(ret, pr.Files...)
+		return nil
+	})
+	return
+}
+
+func (c *client) ListIssueEvents(ctx context.Context, owner, repo string, number int64) (ret []*github.IssueEvent, err error) {
+	err = c.withPR(owner, repo, number, func(pr *PR) error {
+		ret = append(ret, pr.Events...)
+		return nil
+	})
+	return
+}
+
+func (c *client) ListComments(ctx context.Context, owner, repo string, number int64) (ret []*github.Comment, err error) {
+	err = c.withPR(owner, repo, number, func(pr *PR) error {
+		ret = append(ret, pr.Comments...)
+		return nil
+	})
+	return
+}
+
+func (c *client) CreateComment(ctx context.Context, owner, repo string, number int64, body string) error {
+	return c.withPR(owner, repo, number, func(pr *PR) error {
+		pr.Comments = append(pr.Comments, &github.Comment{
+			ID:   int64(len(pr.Comments) + 1),
+			Body: body,
+		})
+		return nil
+	})
+}
+
+func (c *client) RemoveLabel(ctx context.Context, owner, repo string, number int64, label string) error {
+	return c.withPR(owner, repo, number, func(pr *PR) error {
+		for i, l := range pr.Labels {
+			if l == label {
+				pr.Labels = append(pr.Labels[:i:i], pr.Labels[i+1:]...)
+				pr.Events = append(pr.Events, &github.IssueEvent{Event: "unlabeled", Label: label})
+				return nil
+			}
+		}
+		return github.ErrNotFound
+	})
+}
+
+func (c *client) CreateStatus(ctx context.Context, owner, repo, sha string, status *github.Status) error {
+	c.f.m.Lock()
+	defer c.f.m.Unlock()
+	prefix := fmt.Sprintf("%s/%s/%s/", c.host, owner, repo)
+	for k, pr := range c.f.prs {
+		if strings.HasPrefix(k, prefix) && pr.HeadSHA == sha {
+			if pr.Statuses == nil {
+				pr.Statuses = map[string]*github.Status{}
+			}
+			cpy := *status
+			pr.Statuses[status.Context] = &cpy
+			return nil
+		}
+	}
+	return github.ErrNotFound
+}
+
+func (c *client) GetUserEmail(ctx context.Context, login string) (string, error) {
+	c.f.m.Lock()
+	defer c.f.m.Unlock()
+	return c.f.emails[login], nil
+}
+
+func (c *client) Merge(ctx context.Context, owner, repo string, number int64, sha string) error {
+	return c.withPR(owner, repo, number, func(pr *PR) error {
+		switch {
+		case pr.State != "open" || pr.Merged:
+			return errors.Reason("pull request %d is not open", number).Err()
+		case pr.HeadSHA != sha:
+			return errors.Reason("head of pull request %d has changed to %s", number, pr.HeadSHA).Err()
+		}
+		pr.Merged = true
+		pr.State = "closed"
+		return nil
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"go.chromium.org/luci/common/errors"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/configs/prjcfg"
+)
+
+// LookupApplicableConfig implements ConfigLookupFn based on the latest configs
+// of the enabled LUCI projects.
+//
+// Unlike for Gerrit, there is no precomputed map of the watched repositories,
+// so all the enabled projects are inspected.
+func LookupApplicableConfig(ctx context.Context, host, owner, repo, baseRef string) (*changelist.ApplicableConfig, error) {
+	projects, err := prjcfg.GetAllProjectIDs(ctx, true)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to list LUCI projects").Err()
+	}
+	name := fmt.Sprintf("%s/%s", owner, repo)
+	ac := &changelist.ApplicableConfig{}
+	for _, project := range projects {
+		meta, err := prjcfg.GetLatestMeta(ctx, project)
+		switch {
+		case err != nil:
+			return nil, err
+		case !meta.Exists():
+			continue
+		}
+		cgs, err := meta.GetConfigGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, cg := range cgs {
+			matched, err := watches(cg.Content, host, name, baseRef)
+			if err != nil {
+				return nil, errors.Annotate(err, "invalid config group %q", cg.ID).Err()
+			}
+			if matched {
+				ids = append(ids, string(cg.ID))
+			}
+		}
+		if len(ids) > 0 {
+			ac.Projects = append(ac.Projects, &changelist.ApplicableConfig_Project{
+				Name:           project,
+				ConfigGroupIds: ids,
+			})
+		}
+	}
+	return ac, nil
+}
+
+// watches returns whether the ConfigGroup watches the given branch of the
+// repository.
+func watches(cg *cfgpb.ConfigGroup, host, name, branch string) (bool, error) {
+	for _, g := range cg.GetGithub() {
+		if g.GetHost() != host {
+			continue
+		}
+		for _, r := range g.GetRepos() {
+			if r.GetName() != name {
+				continue
+			}
+			included, err := matchAny(r.GetBranchRegexp(), branch)
+			if err != nil || !included {
+				return false, err
+			}
+			excluded, err := matchAny(r.GetBranchRegexpExclude(), branch)
+			return !excluded, err
+		}
+	}
+	return false, nil
+}
+
+func matchAny(regexps []string, s string) (bool, error) {
+	for _, re := range regexps {
+		// The regexps must match the whole branch name.
+		compiled, err := regexp.Compile("^(?:" + re + ")$")
+		if err != nil {
+			return false, err
+		}
+		if compiled.MatchString(s) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github_test
+
+import (
+	"testing"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/configs/prjcfg"
+	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+	"go.chromium.org/luci/cv/internal/github"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLookupApplicableConfig(t *testing.T) {
+	t.Parallel()
+
+	Convey("LookupApplicableConfig", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp()
+		defer cancel()
+
+		repoCfg := func(name string, branches, excluded []string) *cfgpb.ConfigGroup_GitHub {
+			return &cfgpb.ConfigGroup_GitHub{
+				Host: "github.com",
+				Repos: []*cfgpb.ConfigGroup_GitHub_Repo{{
+					Name:                name,
+					BranchRegexp:        branches,
+					BranchRegexpExclude: excluded,
+				}},
+			}
+		}
+		prjcfgtest.Create(ctx, "first", &cfgpb.Config{
+			ConfigGroups: []*cfgpb.ConfigGroup{
+				{Name: "main", Github: []*cfgpb.ConfigGroup_GitHub{repoCfg("luci/luci-go", []string{"main"}, nil)}},
+				{Name: "release", Github: []*cfgpb.ConfigGroup_GitHub{repoCfg("luci/luci-go", []string{"release-.*"}, []string{"release-old"})}},
+			},
+		})
+		prjcfgtest.Create(ctx, "second", &cfgpb.Config{
+			ConfigGroups: []*cfgpb.ConfigGroup{
+				{Name: "all", Github: []*cfgpb.ConfigGroup_GitHub{repoCfg("luci/luci-go", []string{".*"}, nil)}},
+			},
+		})
+		prjcfgtest.Create(ctx, "disabled", &cfgpb.Config{
+			ConfigGroups: []*cfgpb.ConfigGroup{
+				{Name: "all", Github: []*cfgpb.ConfigGroup_GitHub{repoCfg("luci/luci-go", []string{".*"}, nil)}},
+			},
+		})
+		prjcfgtest.Disable(ctx, "disabled")
+
+		lookup := func(host, owner, repo, branch string) map[string][]string {
+			ac, err := github.LookupApplicableConfig(ctx, host, owner, repo, branch)
+			So(err, ShouldBeNil)
+			ret := map[string][]string{}
+			for _, p := range ac.GetProjects() {
+				for _, id := range p.GetConfigGroupIds() {
+					ret[p.GetName()] = append(ret[p.GetName()], prjcfg.ConfigGroupID(id).Name())
+				}
+			}
+			return ret
+		}
+
+		So(lookup("github.com", "luci", "luci-go", "main"), ShouldResemble, map[string][]string{
+			"first":  {"main"},
+			"second": {"all"},
+		})
+		So(lookup("github.com", "luci", "luci-go", "release-1"), ShouldResemble, map[string][]string{
+			"first":  {"release"},
+			"second": {"all"},
+		})
+		So(lookup("github.com", "luci", "luci-go", "release-old"), ShouldResemble, map[string][]string{
+			"second": {"all"},
+		})
+		// The regexps must match the whole branch name.
+		So(lookup("github.com", "luci", "luci-go", "main-2"), ShouldResemble, map[string][]string{
+			"second": {"all"},
+		})
+		So(lookup("github.com", "luci", "other", "main"), ShouldBeEmpty)
+		So(lookup("github.example.com", "luci", "luci-go", "main"), ShouldBeEmpty)
+	})
+}
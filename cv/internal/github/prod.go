@@ -0,0 +1,334 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/context/ctxhttp"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/secrets"
+)
+
+// TransportFn returns the authenticating transport to use for talking to the
+// given GitHub host on behalf of the given LUCI project.
+type TransportFn func(ctx context.Context, host, luciProject string) (http.RoundTripper, error)
+
+// SecretTransport returns a TransportFn which authenticates all requests with
+// the GitHub token stored in the given secret.
+//
+// The same token is used for all the hosts and LUCI projects.
+func SecretTransport(secretName string) TransportFn {
+	return func(ctx context.Context, host, luciProject string) (http.RoundTripper, error) {
+		s, err := secrets.StoredSecret(ctx, secretName)
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to get the GitHub token").Err()
+		}
+		return &tokenTransport{token: string(s.Current), base: http.DefaultTransport}, nil
+	}
+}
+
+type tokenTransport struct {
+	token string
+	base  http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tokenTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+t.token)
+	return t.base.RoundTrip(r)
+}
+
+// NewFactory returns Factory for use in production.
+func NewFactory(transport TransportFn) Factory {
+	return prodFactory{transport: transport}
+}
+
+type prodFactory struct {
+	transport TransportFn
+}
+
+// MakeClient implements Factory.
+func (f prodFactory) MakeClient(ctx context.Context, host, luciProject string) (Client, error) {
+	t, err := f.transport(ctx, host, luciProject)
+	if err != nil {
+		return nil, err
+	}
+	return &prodClient{
+		c:       &http.Client{Transport: t},
+		baseURL: apiURL(host),
+	}, nil
+}
+
+// apiURL returns the base URL of the REST API of the given GitHub host.
+func apiURL(host string) string {
+	if host == "github.com" {
+		return "https://api.github.com"
+	}
+	// GitHub Enterprise Server.
+	return fmt.Sprintf("https://%s/api/v3", host)
+}
+
+// pageSize is the number of items requested per page of list calls.
+const pageSize = 100
+
+type prodClient struct {
+	c       *http.Client
+	baseURL string
+}
+
+type user struct {
+	Login string `json:"login"`
+}
+
+type label struct {
+	Name string `json:"name"`
+}
+
+// GetPullRequest implements Client.
+func (p *prodClient) GetPullRequest(ctx context.Context, owner, repo string, number int64) (*PullRequest, error) {
+	var pr struct {
+		Number    int64     `json:"number"`
+		Title     string    `json:"title"`
+		Body      string    `json:"body"`
+		State     string    `json:"state"`
+		Merged    bool      `json:"merged"`
+		User      user      `json:"user"`
+		UpdatedAt time.Time `json:"updated_at"`
+		Labels    []label   `json:"labels"`
+		Base      struct {
+			Ref string `json:"ref"`
+		} `json:"base"`
+		Head struct {
+			SHA string `json:"sha"`
+		} `json:"head"`
+	}
+	if err := p.do(ctx, "GET", p.pullPath(owner, repo, number), nil, &pr); err != nil {
+		return nil, err
+	}
+	ret := &PullRequest{
+		Number:    pr.Number,
+		Title:     pr.Title,
+		Body:      pr.Body,
+		State:     pr.State,
+		Merged:    pr.Merged,
+		Author:    pr.User.Login,
+		BaseRef:   pr.Base.Ref,
+		HeadSHA:   pr.Head.SHA,
+		UpdatedAt: pr.UpdatedAt,
+	}
+	for _, l := range pr.Labels {
+		ret.Labels = append(ret.Labels, l.Name)
+	}
+	return ret, nil
+}
+
+// ListFiles implements Client.
+func (p *prodClient) ListFiles(ctx context.Context, owner, repo string, number int64) ([]string, error) {
+	var ret []string
+	err := p.list(ctx, p.pullPath(owner, repo, number)+"/files", func(dec *json.Decoder) (int, error) {
+		var page []struct {
+			Filename string `json:"filename"`
+		}
+		if err := dec.Decode(&page); err != nil {
+			return 0, err
+		}
+		for _, f := range page {
+			ret = append(ret, f.Filename)
+		}
+		return len(page), nil
+	})
+	return ret, err
+}
+
+// ListIssueEvents implements Client.
+func (p *prodClient) ListIssueEvents(ctx context.Context, owner, repo string, number int64) ([]*IssueEvent, error) {
+	var ret []*IssueEvent
+	err := p.list(ctx, p.issuePath(owner, repo, number)+"/events", func(dec *json.Decoder) (int, error) {
+		var page []struct {
+			Event     string    `json:"event"`
+			Actor     user      `json:"actor"`
+			Label     label     `json:"label"`
+			CreatedAt time.Time `json:"created_at"`
+		}
+		if err := dec.Decode(&page); err != nil {
+			return 0, err
+		}
+		for _, e := range page {
+			ret = append(ret, &IssueEvent{
+				Event:     e.Event,
+				Actor:     e.Actor.Login,
+				Label:     e.Label.Name,
+				CreatedAt: e.CreatedAt,
+			})
+		}
+		return len(page), nil
+	})
+	return ret, err
+}
+
+// ListComments implements Client.
+func (p *prodClient) ListComments(ctx context.Context, owner, repo string, number int64) ([]*Comment, error) {
+	var ret []*Comment
+	err := p.list(ctx, p.issuePath(owner, repo, number)+"/comments", func(dec *json.Decoder) (int, error) {
+		var page []struct {
+			ID        int64     `json:"id"`
+			User      user      `json:"user"`
+			Body      string    `json:"body"`
+			CreatedAt time.Time `json:"created_at"`
+		}
+		if err := dec.Decode(&page); err != nil {
+			return 0, err
+		}
+		for _, c := range page {
+			ret = append(ret, &Comment{
+				ID:        c.ID,
+				Author:    c.User.Login,
+				Body:      c.Body,
+				CreatedAt: c.CreatedAt,
+			})
+		}
+		return len(page), nil
+	})
+	return ret, err
+}
+
+// CreateComment implements Client.
+func (p *prodClient) CreateComment(ctx context.Context, owner, repo string, number int64, body string) error {
+	req := map[string]string{"body": body}
+	return p.do(ctx, "POST", p.issuePath(owner, repo, number)+"/comments", req, nil)
+}
+
+// RemoveLabel implements Client.
+func (p *prodClient) RemoveLabel(ctx context.Context, owner, repo string, number int64, label string) error {
+	path := fmt.Sprintf("%s/labels/%s", p.issuePath(owner, repo, number), url.PathEscape(label))
+	return p.do(ctx, "DELETE", path, nil, nil)
+}
+
+// CreateStatus implements Client.
+func (p *prodClient) CreateStatus(ctx context.Context, owner, repo, sha string, status *Status) error {
+	req := map[string]string{
+		"state":       status.State,
+		"description": status.Description,
+		"context":     status.Context,
+		"target_url":  status.TargetURL,
+	}
+	path := fmt.Sprintf("/repos/%s/%s/statuses/%s", url.PathEscape(owner), url.PathEscape(repo), url.PathEscape(sha))
+	return p.do(ctx, "POST", path, req, nil)
+}
+
+// Merge implements Client.
+func (p *prodClient) Merge(ctx context.Context, owner, repo string, number int64, sha string) error {
+	req := map[string]string{"sha": sha}
+	return p.do(ctx, "PUT", p.pullPath(owner, repo, number)+"/merge", req, nil)
+}
+
+// GetUserEmail implements Client.
+func (p *prodClient) GetUserEmail(ctx context.Context, login string) (string, error) {
+	var u struct {
+		Email string `json:"email"`
+	}
+	if err := p.do(ctx, "GET", "/users/"+url.PathEscape(login), nil, &u); err != nil {
+		return "", err
+	}
+	return u.Email, nil
+}
+
+func (p *prodClient) pullPath(owner, repo string, number int64) string {
+	return fmt.Sprintf("/repos/%s/%s/pulls/%d", url.PathEscape(owner), url.PathEscape(repo), number)
+}
+
+func (p *prodClient) issuePath(owner, repo string, number int64) string {
+	return fmt.Sprintf("/repos/%s/%s/issues/%d", url.PathEscape(owner), url.PathEscape(repo), number)
+}
+
+// list fetches all pages of a list call, passing each page to `cb` which
+// must decode it and return the number of items in it.
+func (p *prodClient) list(ctx context.Context, path string, cb func(*json.Decoder) (int, error)) error {
+	for page := 1; ; page++ {
+		var n int
+		err := p.doRaw(ctx, "GET", fmt.Sprintf("%s?per_page=%d&page=%d", path, pageSize, page), nil, func(r io.Reader) (err error) {
+			n, err = cb(json.NewDecoder(r))
+			return err
+		})
+		switch {
+		case err != nil:
+			return err
+		case n < pageSize:
+			return nil
+		}
+	}
+}
+
+// do sends a request with an optional JSON body and decodes the JSON
+// response into `out` if it's not nil.
+func (p *prodClient) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		blob, err := json.Marshal(in)
+		if err != nil {
+			return errors.Annotate(err, "failed to marshal the request").Err()
+		}
+		body = bytes.NewReader(blob)
+	}
+	return p.doRaw(ctx, method, path, body, func(r io.Reader) error {
+		if out == nil {
+			return nil
+		}
+		return json.NewDecoder(r).Decode(out)
+	})
+}
+
+func (p *prodClient) doRaw(ctx context.Context, method, path string, body io.Reader, cb func(io.Reader) error) error {
+	req, err := http.NewRequest(method, p.baseURL+path, body)
+	if err != nil {
+		return errors.Annotate(err, "failed to create the request").Err()
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := ctxhttp.Do(ctx, p.c, req)
+	if err != nil {
+		return errors.Annotate(err, "%s %s", method, path).Tag(transient.Tag).Err()
+	}
+	defer resp.Body.Close()
+
+	switch code := resp.StatusCode; {
+	case code == http.StatusNotFound:
+		return ErrNotFound
+	case code == http.StatusTooManyRequests || code >= 500:
+		return errors.Reason("%s %s: HTTP %d", method, path, code).Tag(transient.Tag).Err()
+	case code >= 300:
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Reason("%s %s: HTTP %d: %s", method, path, code, bytes.TrimSpace(msg)).Err()
+	}
+	if err := cb(resp.Body); err != nil {
+		return errors.Annotate(err, "failed to decode the response of %s %s", method, path).Err()
+	}
+	return nil
+}
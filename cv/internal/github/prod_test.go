@@ -0,0 +1,174 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/server/secrets"
+	"go.chromium.org/luci/server/secrets/testsecrets"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestProdClient(t *testing.T) {
+	t.Parallel()
+
+	Convey("prodClient", t, func() {
+		ctx := context.Background()
+		var requests []string
+		var bodies []map[string]string
+		mux := http.NewServeMux()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.RequestURI())
+			if r.Body != nil && r.ContentLength > 0 {
+				body := map[string]string{}
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+				bodies = append(bodies, body)
+			}
+			mux.ServeHTTP(w, r)
+		}))
+		defer srv.Close()
+		c := &prodClient{c: srv.Client(), baseURL: srv.URL}
+
+		Convey("GetPullRequest", func() {
+			mux.HandleFunc("/repos/o/r/pulls/7", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{
+					"number": 7, "title": "T", "body": "B", "state": "open",
+					"user": {"login": "alice"}, "updated_at": "2022-02-02T10:00:00Z",
+					"labels": [{"name": "cq:dry-run"}],
+					"base": {"ref": "main"}, "head": {"sha": "abc"}
+				}`)
+			})
+			pr, err := c.GetPullRequest(ctx, "o", "r", 7)
+			So(err, ShouldBeNil)
+			So(pr, ShouldResemble, &PullRequest{
+				Number:    7,
+				Title:     "T",
+				Body:      "B",
+				State:     "open",
+				Author:    "alice",
+				BaseRef:   "main",
+				HeadSHA:   "abc",
+				UpdatedAt: time.Date(2022, 2, 2, 10, 0, 0, 0, time.UTC),
+				Labels:    []string{"cq:dry-run"},
+			})
+
+			_, err = c.GetPullRequest(ctx, "o", "r", 8)
+			So(err, ShouldEqual, ErrNotFound)
+		})
+
+		Convey("ListFiles paginates", func() {
+			mux.HandleFunc("/repos/o/r/pulls/7/files", func(w http.ResponseWriter, r *http.Request) {
+				var page []map[string]string
+				n := pageSize
+				if r.URL.Query().Get("page") == "2" {
+					n = 1
+				}
+				for i := 0; i < n; i++ {
+					page = append(page, map[string]string{"filename": "f"})
+				}
+				json.NewEncoder(w).Encode(page)
+			})
+			files, err := c.ListFiles(ctx, "o", "r", 7)
+			So(err, ShouldBeNil)
+			So(files, ShouldHaveLength, pageSize+1)
+			So(requests, ShouldResemble, []string{
+				"GET /repos/o/r/pulls/7/files?per_page=100&page=1",
+				"GET /repos/o/r/pulls/7/files?per_page=100&page=2",
+			})
+		})
+
+		Convey("CreateStatus", func() {
+			mux.HandleFunc("/repos/o/r/statuses/abc", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusCreated)
+			})
+			So(c.CreateStatus(ctx, "o", "r", "abc", &Status{State: "success", Context: "luci-cv"}), ShouldBeNil)
+			So(bodies, ShouldResemble, []map[string]string{
+				{"state": "success", "context": "luci-cv", "description": "", "target_url": ""},
+			})
+		})
+
+		Convey("GetUserEmail", func() {
+			mux.HandleFunc("/users/alice", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"login": "alice", "email": "alice@example.com"}`)
+			})
+			mux.HandleFunc("/users/bob", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, `{"login": "bob", "email": null}`)
+			})
+			email, err := c.GetUserEmail(ctx, "alice")
+			So(err, ShouldBeNil)
+			So(email, ShouldEqual, "alice@example.com")
+			email, err = c.GetUserEmail(ctx, "bob")
+			So(err, ShouldBeNil)
+			So(email, ShouldEqual, "")
+		})
+
+		Convey("Errors", func() {
+			mux.HandleFunc("/repos/o/r/pulls/7/merge", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				fmt.Fprint(w, `{"message": "Pull Request is not mergeable"}`)
+			})
+			mux.HandleFunc("/repos/o/r/pulls/8/merge", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadGateway)
+			})
+			err := c.Merge(ctx, "o", "r", 7, "abc")
+			So(err, ShouldErrLike, "HTTP 405", "not mergeable")
+			So(transient.Tag.In(err), ShouldBeFalse)
+
+			err = c.Merge(ctx, "o", "r", 8, "abc")
+			So(err, ShouldErrLike, "HTTP 502")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+	})
+
+	Convey("SecretTransport", t, func() {
+		ctx := secrets.Use(context.Background(), &testsecrets.Store{
+			Secrets: map[string]secrets.Secret{
+				"github-token": {Current: []byte("tok")},
+			},
+		})
+		var authz string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authz = r.Header.Get("Authorization")
+		}))
+		defer srv.Close()
+
+		t, err := SecretTransport("github-token")(ctx, "github.com", "lProject")
+		So(err, ShouldBeNil)
+		_, err = (&http.Client{Transport: t}).Get(srv.URL)
+		So(err, ShouldBeNil)
+		So(authz, ShouldEqual, "Bearer tok")
+
+		_, err = SecretTransport("missing")(ctx, "github.com", "lProject")
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("apiURL", t, func() {
+		So(apiURL("github.com"), ShouldEqual, "https://api.github.com")
+		So(apiURL("github.example.com"), ShouldEqual, "https://github.example.com/api/v3")
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"go.chromium.org/luci/common/errors"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
+	"go.chromium.org/luci/cv/internal/run"
+)
+
+// StatusContext is the context of the commit statuses reported by CV.
+const StatusContext = "luci-cv"
+
+// maxStatusDescription is the max length in characters of a commit status
+// description accepted by GitHub.
+const maxStatusDescription = 140
+
+// NewProvider returns a codereview.Provider for GitHub.
+//
+// The lookup function is used by the CL Updater to find the LUCI projects
+// watching the pull requests.
+func NewProvider(factory Factory, lookup ConfigLookupFn) codereview.Provider {
+	return &provider{factory: factory, lookup: lookup}
+}
+
+type provider struct {
+	factory Factory
+	lookup  ConfigLookupFn
+}
+
+// Kind implements codereview.Provider.
+func (*provider) Kind() string {
+	return "github"
+}
+
+// NewUpdaterBackend implements codereview.Provider.
+func (p *provider) NewUpdaterBackend(*changelist.Updater) changelist.UpdaterBackend {
+	return newUpdaterBackend(p.factory, p.lookup)
+}
+
+// FindTriggers implements codereview.Provider.
+//
+// Additional modes configured in the ConfigGroup aren't supported on GitHub.
+func (*provider) FindTriggers(cl *changelist.CL, _ *cfgpb.ConfigGroup) *run.Triggers {
+	return FindTriggers(cl.Snapshot.GetGithub())
+}
+
+// Submit implements codereview.Provider.
+//
+// The pull request is merged only if its head hasn't changed since the Run
+// started.
+func (p *provider) Submit(ctx context.Context, luciProject string, rcl *run.RunCL) error {
+	gh := rcl.Detail.GetGithub()
+	if gh == nil {
+		return errors.Reason("CL %d is not a GitHub CL", rcl.ID).Err()
+	}
+	client, err := p.factory.MakeClient(ctx, gh.GetHost(), luciProject)
+	if err != nil {
+		return err
+	}
+	return client.Merge(ctx, gh.GetOwner(), gh.GetRepo(), gh.GetNumber(), gh.GetHeadSha())
+}
+
+// ReportStatus implements codereview.Provider.
+//
+// The status is reported as a commit status on the head of the pull request.
+func (p *provider) ReportStatus(ctx context.Context, luciProject string, rcl *run.RunCL, st *codereview.Status) error {
+	gh := rcl.Detail.GetGithub()
+	if gh == nil {
+		return errors.Reason("CL %d is not a GitHub CL", rcl.ID).Err()
+	}
+	client, err := p.factory.MakeClient(ctx, gh.GetHost(), luciProject)
+	if err != nil {
+		return err
+	}
+	err = client.CreateStatus(ctx, gh.GetOwner(), gh.GetRepo(), gh.GetHeadSha(), &Status{
+		State:       string(st.State),
+		Description: truncateDescription(st.Message),
+		Context:     StatusContext,
+		TargetURL:   st.URL,
+	})
+	return errors.Annotate(err, "failed to report status on CL %d", rcl.ID).Err()
+}
+
+// truncateDescription truncates the commit status description to
+// maxStatusDescription characters, cutting it on a rune boundary.
+func truncateDescription(desc string) string {
+	if utf8.RuneCountInString(desc) <= maxStatusDescription {
+		return desc
+	}
+	n := 0
+	for i := range desc {
+		if n == maxStatusDescription-3 {
+			return desc[:i] + "..."
+		}
+		n++
+	}
+	return desc
+}
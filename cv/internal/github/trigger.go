@@ -0,0 +1,144 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/run"
+)
+
+const (
+	// DryRunLabel is the pull request label which triggers a CV dry run.
+	DryRunLabel = "cq:dry-run"
+	// FullRunLabel is the pull request label which triggers a CV full run.
+	FullRunLabel = "cq:full-run"
+
+	// commandPrefix is the prefix of comments which are CV commands.
+	commandPrefix = "/cq"
+)
+
+// Command is a CV command posted as a pull request comment.
+type Command string
+
+const (
+	// CommandDryRun triggers a CV dry run.
+	CommandDryRun Command = "dry-run"
+	// CommandFullRun triggers a CV full run.
+	CommandFullRun Command = "full-run"
+	// CommandCancel cancels the CV runs triggered by earlier commands.
+	CommandCancel Command = "cancel"
+)
+
+// ParseCommand returns the CV command in the first line of the comment body,
+// e.g. "/cq dry-run".
+//
+// Returns false if the comment isn't a CV command.
+func ParseCommand(body string) (Command, bool) {
+	line := strings.TrimSpace(strings.SplitN(body, "\n", 2)[0])
+	fields := strings.Fields(line)
+	if len(fields) != 2 || fields[0] != commandPrefix {
+		return "", false
+	}
+	switch cmd := Command(fields[1]); cmd {
+	case CommandDryRun, CommandFullRun, CommandCancel:
+		return cmd, true
+	default:
+		return "", false
+	}
+}
+
+// FindTriggers returns the CV triggers of a pull request.
+//
+// A pull request is triggered by applying the DryRunLabel or FullRunLabel
+// label or by posting a "/cq dry-run" or "/cq full-run" comment. A
+// "/cq cancel" comment cancels the runs requested by earlier comments, but not
+// by labels, which must be removed instead.
+//
+// Full run takes precedence over dry run. Among triggers of the same mode, the
+// earliest one wins.
+//
+// Triggers by users without a public email are ignored, since CV can't map
+// them to LUCI identities. So are the triggers which predate the current head
+// of the pull request, since they have been applied to an earlier revision.
+//
+// Returns nil if the pull request isn't open or isn't triggered.
+func FindTriggers(gh *changelist.GitHub) *run.Triggers {
+	if gh.GetState() != changelist.GitHub_OPEN {
+		return nil
+	}
+
+	var dry, full *run.Trigger
+	consider := func(mode run.Mode, email string, at *timestamppb.Timestamp) {
+		switch {
+		case email == "":
+			return
+		case gh.GetHeadUpdated() != nil && at.AsTime().Before(gh.GetHeadUpdated().AsTime()):
+			return
+		}
+		t := &run.Trigger{
+			Mode:  string(mode),
+			Email: email,
+			Time:  at,
+		}
+		cur := &dry
+		if mode == run.FullRun {
+			cur = &full
+		}
+		if *cur == nil || t.Time.AsTime().Before((*cur).Time.AsTime()) {
+			*cur = t
+		}
+	}
+
+	for _, l := range gh.GetLabels() {
+		switch l.GetName() {
+		case DryRunLabel:
+			consider(run.DryRun, l.GetAppliedByEmail(), l.GetAppliedTime())
+		case FullRunLabel:
+			consider(run.FullRun, l.GetAppliedByEmail(), l.GetAppliedTime())
+		}
+	}
+
+	var dryCmd, fullCmd *changelist.GitHubComment
+	for _, c := range gh.GetCommands() {
+		cmd, _ := ParseCommand(c.GetBody())
+		switch {
+		case cmd == CommandCancel:
+			dryCmd, fullCmd = nil, nil
+		case cmd == CommandDryRun && dryCmd == nil:
+			dryCmd = c
+		case cmd == CommandFullRun && fullCmd == nil:
+			fullCmd = c
+		}
+	}
+	if dryCmd != nil {
+		consider(run.DryRun, dryCmd.GetAuthorEmail(), dryCmd.GetCreated())
+	}
+	if fullCmd != nil {
+		consider(run.FullRun, fullCmd.GetAuthorEmail(), fullCmd.GetCreated())
+	}
+
+	switch {
+	case full != nil:
+		return &run.Triggers{CqVoteTrigger: full}
+	case dry != nil:
+		return &run.Triggers{CqVoteTrigger: dry}
+	default:
+		return nil
+	}
+}
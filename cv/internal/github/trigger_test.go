@@ -0,0 +1,151 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/run"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestParseCommand(t *testing.T) {
+	t.Parallel()
+
+	Convey("ParseCommand", t, func() {
+		parse := func(body string) Command {
+			cmd, ok := ParseCommand(body)
+			So(ok, ShouldEqual, cmd != "")
+			return cmd
+		}
+		So(parse("/cq dry-run"), ShouldEqual, CommandDryRun)
+		So(parse("  /cq full-run  \nplease"), ShouldEqual, CommandFullRun)
+		So(parse("/cq cancel"), ShouldEqual, CommandCancel)
+		So(parse("/cq"), ShouldEqual, "")
+		So(parse("/cq submit"), ShouldEqual, "")
+		So(parse("lgtm\n/cq dry-run"), ShouldEqual, "")
+	})
+}
+
+func TestFindTriggers(t *testing.T) {
+	t.Parallel()
+
+	Convey("FindTriggers", t, func() {
+		epoch := time.Date(2022, 2, 2, 10, 0, 0, 0, time.UTC)
+		ts := func(min int) *timestamppb.Timestamp {
+			return timestamppb.New(epoch.Add(time.Duration(min) * time.Minute))
+		}
+		gh := &changelist.GitHub{State: changelist.GitHub_OPEN}
+		// Users named "anon-*" have no public email.
+		email := func(login string) string {
+			if strings.HasPrefix(login, "anon-") {
+				return ""
+			}
+			return login + "@example.com"
+		}
+		label := func(name, by string, min int) {
+			gh.Labels = append(gh.Labels, &changelist.GitHubLabel{
+				Name:           name,
+				AppliedBy:      by,
+				AppliedByEmail: email(by),
+				AppliedTime:    ts(min),
+			})
+		}
+		command := func(body, by string, min int) {
+			gh.Commands = append(gh.Commands, &changelist.GitHubComment{
+				Body:        body,
+				Author:      by,
+				AuthorEmail: email(by),
+				Created:     ts(min),
+			})
+		}
+		trigger := func(mode run.Mode, by string, min int) *run.Triggers {
+			return &run.Triggers{CqVoteTrigger: &run.Trigger{
+				Mode:  string(mode),
+				Email: email(by),
+				Time:  ts(min),
+			}}
+		}
+
+		Convey("Not triggered", func() {
+			label("bug", "alice", 1)
+			command("/cq cancel", "bob", 2)
+			So(FindTriggers(gh), ShouldBeNil)
+		})
+
+		Convey("Label", func() {
+			label(DryRunLabel, "alice", 1)
+			So(FindTriggers(gh), ShouldResembleProto, trigger(run.DryRun, "alice", 1))
+
+			Convey("Full run takes precedence", func() {
+				command("/cq full-run", "bob", 5)
+				So(FindTriggers(gh), ShouldResembleProto, trigger(run.FullRun, "bob", 5))
+			})
+
+			Convey("Earliest wins", func() {
+				command("/cq dry-run", "bob", 0)
+				So(FindTriggers(gh), ShouldResembleProto, trigger(run.DryRun, "bob", 0))
+			})
+
+			Convey("Isn't cancelled by a command", func() {
+				command("/cq cancel", "bob", 5)
+				So(FindTriggers(gh), ShouldResembleProto, trigger(run.DryRun, "alice", 1))
+			})
+
+			Convey("Closed", func() {
+				gh.State = changelist.GitHub_CLOSED
+				So(FindTriggers(gh), ShouldBeNil)
+			})
+
+			Convey("Stale after the head has changed", func() {
+				gh.HeadUpdated = ts(3)
+				So(FindTriggers(gh), ShouldBeNil)
+
+				command("/cq dry-run", "bob", 4)
+				So(FindTriggers(gh), ShouldResembleProto, trigger(run.DryRun, "bob", 4))
+			})
+		})
+
+		Convey("Users without public email are ignored", func() {
+			label(FullRunLabel, "anon-alice", 1)
+			command("/cq full-run", "anon-bob", 2)
+			So(FindTriggers(gh), ShouldBeNil)
+
+			command("/cq dry-run", "carol", 3)
+			So(FindTriggers(gh), ShouldResembleProto, trigger(run.DryRun, "carol", 3))
+		})
+
+		Convey("Commands", func() {
+			command("/cq dry-run", "alice", 1)
+			command("/cq dry-run", "bob", 2)
+			So(FindTriggers(gh), ShouldResembleProto, trigger(run.DryRun, "alice", 1))
+
+			Convey("Cancel", func() {
+				command("/cq cancel", "alice", 3)
+				So(FindTriggers(gh), ShouldBeNil)
+
+				command("/cq full-run", "bob", 4)
+				So(FindTriggers(gh), ShouldResembleProto, trigger(run.FullRun, "bob", 4))
+			})
+		})
+	})
+}
@@ -0,0 +1,277 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/common"
+)
+
+// ConfigLookupFn returns the ApplicableConfig for pull requests against the
+// given branch of a GitHub repository.
+type ConfigLookupFn func(ctx context.Context, host, owner, repo, baseRef string) (*changelist.ApplicableConfig, error)
+
+// RegisterUpdater registers a GitHub backend with the CL Updater.
+func RegisterUpdater(u *changelist.Updater, factory Factory, lookup ConfigLookupFn) {
+	u.RegisterBackend(newUpdaterBackend(factory, lookup))
+}
+
+func newUpdaterBackend(factory Factory, lookup ConfigLookupFn) *updaterBackend {
+	return &updaterBackend{
+		factory: factory,
+		lookup:  lookup,
+	}
+}
+
+// updaterBackend implements changelist.UpdaterBackend for GitHub.
+type updaterBackend struct {
+	factory Factory
+	lookup  ConfigLookupFn
+}
+
+// Kind implements the changelist.UpdaterBackend.
+func (u *updaterBackend) Kind() string {
+	return "github"
+}
+
+// LookupApplicableConfig implements the changelist.UpdaterBackend.
+func (u *updaterBackend) LookupApplicableConfig(ctx context.Context, saved *changelist.CL) (*changelist.ApplicableConfig, error) {
+	gh := saved.Snapshot.GetGithub()
+	if gh == nil {
+		// Not enough info to decide.
+		return nil, nil
+	}
+	return u.lookup(ctx, gh.GetHost(), gh.GetOwner(), gh.GetRepo(), gh.GetBaseRef())
+}
+
+// Fetch implements the changelist.UpdaterBackend.
+func (u *updaterBackend) Fetch(ctx context.Context, in *changelist.FetchInput) (changelist.UpdateFields, error) {
+	host, owner, repo, number, err := in.CL.ExternalID.ParseGitHubID()
+	if err != nil {
+		return changelist.UpdateFields{}, err
+	}
+	client, err := u.factory.MakeClient(ctx, host, in.Project)
+	if err != nil {
+		return changelist.UpdateFields{}, err
+	}
+
+	pr, err := client.GetPullRequest(ctx, owner, repo, number)
+	switch {
+	case err == ErrNotFound:
+		return noAccess(ctx, in), nil
+	case err != nil:
+		return changelist.UpdateFields{}, errors.Annotate(err, "failed to fetch pull request %s", in.CL.ExternalID).Err()
+	}
+
+	gh := &changelist.GitHub{
+		Host:        host,
+		Owner:       owner,
+		Repo:        repo,
+		Number:      number,
+		State:       prState(pr),
+		Title:       pr.Title,
+		Description: pr.Body,
+		Author:      pr.Author,
+		BaseRef:     pr.BaseRef,
+		HeadSha:     pr.HeadSHA,
+		Updated:     timestamppb.New(pr.UpdatedAt),
+	}
+	if gh.Files, err = client.ListFiles(ctx, owner, repo, number); err != nil {
+		return changelist.UpdateFields{}, errors.Annotate(err, "failed to list files of %s", in.CL.ExternalID).Err()
+	}
+	sort.Strings(gh.Files)
+
+	events, err := client.ListIssueEvents(ctx, owner, repo, number)
+	if err != nil {
+		return changelist.UpdateFields{}, errors.Annotate(err, "failed to list events of %s", in.CL.ExternalID).Err()
+	}
+	gh.Labels = labels(pr.Labels, events)
+
+	comments, err := client.ListComments(ctx, owner, repo, number)
+	if err != nil {
+		return changelist.UpdateFields{}, errors.Annotate(err, "failed to list comments of %s", in.CL.ExternalID).Err()
+	}
+	for _, c := range comments {
+		if _, ok := ParseCommand(c.Body); ok {
+			gh.Commands = append(gh.Commands, &changelist.GitHubComment{
+				Id:      c.ID,
+				Author:  c.Author,
+				Body:    c.Body,
+				Created: timestamppb.New(c.CreatedAt),
+			})
+		}
+	}
+	if err := resolveEmails(ctx, client, gh); err != nil {
+		return changelist.UpdateFields{}, errors.Annotate(err, "failed to resolve users of %s", in.CL.ExternalID).Err()
+	}
+
+	// GitHub has no notion of patchsets, so a new one is assumed every time
+	// the head of the pull request changes.
+	patchset := int32(1)
+	if prior := in.CL.Snapshot; prior.GetGithub() != nil {
+		patchset = prior.GetPatchset()
+		gh.HeadUpdated = prior.GetGithub().GetHeadUpdated()
+		if prior.GetGithub().GetHeadSha() != gh.HeadSha {
+			patchset++
+			// GitHub doesn't tell when the head was pushed, only that it
+			// happened no later than the last update of the pull request.
+			// Err on the side of treating older triggers as stale.
+			gh.HeadUpdated = gh.Updated
+		}
+	}
+
+	acfg, err := u.lookup(ctx, host, owner, repo, pr.BaseRef)
+	if err != nil {
+		return changelist.UpdateFields{}, err
+	}
+	return changelist.UpdateFields{
+		Snapshot: &changelist.Snapshot{
+			ExternalUpdateTime:    gh.Updated,
+			LuciProject:           in.Project,
+			Patchset:              patchset,
+			MinEquivalentPatchset: patchset,
+			Kind:                  &changelist.Snapshot_Github{Github: gh},
+		},
+		ApplicableConfig: acfg,
+	}, nil
+}
+
+// HasChanged implements the changelist.UpdaterBackend.
+func (u *updaterBackend) HasChanged(cvCurrent, backendCurrent *changelist.Snapshot) bool {
+	cvGH, backendGH := cvCurrent.GetGithub(), backendCurrent.GetGithub()
+	switch {
+	case backendGH.GetUpdated().AsTime().After(cvGH.GetUpdated().AsTime()):
+		return true
+	case cvGH.GetUpdated().AsTime().After(backendGH.GetUpdated().AsTime()):
+		// LUCI CV has more recent data. Most likely GitHub is returning stale
+		// data.
+		return false
+	default:
+		// Applying labels and posting comments doesn't always bump the update
+		// time of the pull request.
+		return !proto.Equal(cvGH, backendGH)
+	}
+}
+
+// TQErrorSpec implements the changelist.UpdaterBackend.
+func (u *updaterBackend) TQErrorSpec() common.TQIfy {
+	return common.TQIfy{}
+}
+
+func prState(pr *PullRequest) changelist.GitHub_State {
+	switch {
+	case pr.Merged:
+		return changelist.GitHub_MERGED
+	case pr.State == "open":
+		return changelist.GitHub_OPEN
+	case pr.State == "closed":
+		return changelist.GitHub_CLOSED
+	default:
+		return changelist.GitHub_STATE_UNSPECIFIED
+	}
+}
+
+// labels returns the currently applied labels along with who applied them and
+// when, based on the chronological issue events.
+func labels(names []string, events []*IssueEvent) []*changelist.GitHubLabel {
+	applied := make(map[string]*IssueEvent, len(names))
+	for _, e := range events {
+		switch e.Event {
+		case "labeled":
+			applied[e.Label] = e
+		case "unlabeled":
+			delete(applied, e.Label)
+		}
+	}
+	ret := make([]*changelist.GitHubLabel, 0, len(names))
+	for _, name := range names {
+		l := &changelist.GitHubLabel{Name: name}
+		if e := applied[name]; e != nil {
+			l.AppliedBy = e.Actor
+			l.AppliedTime = timestamppb.New(e.CreatedAt)
+		}
+		ret = append(ret, l)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].GetName() < ret[j].GetName() })
+	return ret
+}
+
+// resolveEmails populates the public emails of the users who have applied the
+// CV labels or posted the CV commands.
+func resolveEmails(ctx context.Context, client Client, gh *changelist.GitHub) error {
+	emails := map[string]string{}
+	resolve := func(login string) (string, error) {
+		if login == "" {
+			return "", nil
+		}
+		if email, ok := emails[login]; ok {
+			return email, nil
+		}
+		email, err := client.GetUserEmail(ctx, login)
+		if err != nil {
+			return "", errors.Annotate(err, "failed to get the email of %q", login).Err()
+		}
+		emails[login] = email
+		return email, nil
+	}
+
+	var err error
+	for _, l := range gh.Labels {
+		if name := l.GetName(); name == DryRunLabel || name == FullRunLabel {
+			if l.AppliedByEmail, err = resolve(l.GetAppliedBy()); err != nil {
+				return err
+			}
+		}
+	}
+	for _, c := range gh.Commands {
+		if c.AuthorEmail, err = resolve(c.GetAuthor()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// noAccess records that the LUCI project can't see the pull request.
+//
+// Unlike Gerrit, GitHub is consistent, so there is no grace period.
+func noAccess(ctx context.Context, in *changelist.FetchInput) changelist.UpdateFields {
+	now := clock.Now(ctx)
+	noAccessAt := now
+	if prior := in.CL.Access.GetByProject()[in.Project]; prior.GetNoAccess() {
+		if t := prior.GetNoAccessTime().AsTime(); t.Before(now) {
+			noAccessAt = t
+		}
+	}
+	return changelist.UpdateFields{
+		AddDependentMeta: &changelist.Access{
+			ByProject: map[string]*changelist.Access_Project{
+				in.Project: {
+					UpdateTime:   timestamppb.New(now),
+					NoAccessTime: timestamppb.New(noAccessAt),
+					NoAccess:     true,
+				},
+			},
+		},
+	}
+}
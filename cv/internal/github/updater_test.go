@@ -0,0 +1,213 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package github_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.chromium.org/luci/common/clock"
+
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+	"go.chromium.org/luci/cv/internal/github"
+	"go.chromium.org/luci/cv/internal/github/githubfake"
+	"go.chromium.org/luci/cv/internal/run"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestUpdaterBackend(t *testing.T) {
+	t.Parallel()
+
+	Convey("updaterBackend", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp()
+		defer cancel()
+
+		const (
+			lProject = "lProject"
+			host     = "github.com"
+			owner    = "luci"
+			repo     = "luci-go"
+		)
+		fake := &githubfake.Fake{}
+		fake.SetUserEmail("bob", "bob@example.com")
+		fake.SetUserEmail("carol", "carol@example.com")
+		acfg := &changelist.ApplicableConfig{
+			Projects: []*changelist.ApplicableConfig_Project{
+				{Name: lProject, ConfigGroupIds: []string{"hash/main"}},
+			},
+		}
+		lookup := func(ctx context.Context, h, o, r, ref string) (*changelist.ApplicableConfig, error) {
+			So(h+"/"+o+"/"+r+"/"+ref, ShouldEqual, "github.com/luci/luci-go/main")
+			return acfg, nil
+		}
+		u := changelist.NewUpdater(ct.TQDispatcher, changelist.NewMutator(ct.TQDispatcher, &pmMock{}, &rmMock{}, &tjMock{}))
+		github.RegisterUpdater(u, fake, lookup)
+
+		created := clock.Now(ctx).UTC().Truncate(time.Second)
+		fake.Add(host, owner, repo, &githubfake.PR{
+			PullRequest: github.PullRequest{
+				Number:    7,
+				Title:     "Fix bug",
+				State:     "open",
+				Author:    "alice",
+				BaseRef:   "main",
+				HeadSHA:   "sha1",
+				UpdatedAt: created,
+				Labels:    []string{github.DryRunLabel},
+			},
+			Files: []string{"b.go", "a.go"},
+			Events: []*github.IssueEvent{
+				{Event: "labeled", Label: github.DryRunLabel, Actor: "bob", CreatedAt: created.Add(-time.Hour)},
+				{Event: "unlabeled", Label: github.DryRunLabel, Actor: "bob", CreatedAt: created.Add(-time.Minute)},
+				{Event: "labeled", Label: github.DryRunLabel, Actor: "carol", CreatedAt: created},
+			},
+			Comments: []*github.Comment{
+				{ID: 1, Author: "bob", Body: "looks good", CreatedAt: created},
+				{ID: 2, Author: "bob", Body: "/cq full-run", CreatedAt: created},
+			},
+		})
+
+		eid := changelist.MustGitHubID(host, owner, repo, 7)
+		fetch := func() *changelist.CL {
+			So(u.TestingForceUpdate(ctx, &changelist.UpdateCLTask{
+				LuciProject: lProject,
+				ExternalId:  string(eid),
+			}), ShouldBeNil)
+			cl, err := eid.Load(ctx)
+			So(err, ShouldBeNil)
+			So(cl, ShouldNotBeNil)
+			return cl
+		}
+
+		Convey("Fetches a new pull request", func() {
+			cl := fetch()
+			So(cl.ApplicableConfig, ShouldResembleProto, acfg)
+			So(cl.Snapshot.GetPatchset(), ShouldEqual, 1)
+			So(cl.Snapshot.GetLuciProject(), ShouldEqual, lProject)
+			So(cl.Snapshot.GetGithub(), ShouldResembleProto, &changelist.GitHub{
+				Host:    host,
+				Owner:   owner,
+				Repo:    repo,
+				Number:  7,
+				State:   changelist.GitHub_OPEN,
+				Title:   "Fix bug",
+				Author:  "alice",
+				BaseRef: "main",
+				HeadSha: "sha1",
+				Files:   []string{"a.go", "b.go"},
+				Labels: []*changelist.GitHubLabel{
+					{Name: github.DryRunLabel, AppliedBy: "carol", AppliedByEmail: "carol@example.com", AppliedTime: timestamppb.New(created)},
+				},
+				Commands: []*changelist.GitHubComment{
+					{Id: 2, Author: "bob", AuthorEmail: "bob@example.com", Body: "/cq full-run", Created: timestamppb.New(created)},
+				},
+				Updated: timestamppb.New(created),
+			})
+
+			p := github.NewProvider(fake, lookup)
+			So(p.FindTriggers(cl, nil).GetCqVoteTrigger().GetMode(), ShouldEqual, string(run.FullRun))
+
+			Convey("New head bumps the patchset", func() {
+				pr := fake.Get(host, owner, repo, 7)
+				pr.HeadSHA = "sha2"
+				pr.UpdatedAt = created.Add(time.Minute)
+				fake.Add(host, owner, repo, pr)
+				cl := fetch()
+				So(cl.Snapshot.GetPatchset(), ShouldEqual, 2)
+				So(cl.Snapshot.GetGithub().GetHeadUpdated().AsTime(), ShouldEqual, created.Add(time.Minute))
+				// The triggers were applied before the new head, hence stale.
+				So(p.FindTriggers(cl, nil), ShouldBeNil)
+
+				Convey("Other updates keep the head time", func() {
+					pr.Title = "Fix bug for real"
+					pr.UpdatedAt = created.Add(time.Hour)
+					fake.Add(host, owner, repo, pr)
+					cl := fetch()
+					So(cl.Snapshot.GetPatchset(), ShouldEqual, 2)
+					So(cl.Snapshot.GetGithub().GetHeadUpdated().AsTime(), ShouldEqual, created.Add(time.Minute))
+				})
+			})
+
+			Convey("Submit and report status", func() {
+				rcl := &run.RunCL{ID: cl.ID, ExternalID: cl.ExternalID, Detail: cl.Snapshot}
+				So(p.ReportStatus(ctx, lProject, rcl, &codereview.Status{
+					State:   codereview.StateSuccess,
+					Message: "Dry run passed",
+					URL:     "https://example.com/run",
+				}), ShouldBeNil)
+				So(fake.Get(host, owner, repo, 7).Statuses, ShouldResemble, map[string]*github.Status{
+					github.StatusContext: {
+						State:       "success",
+						Description: "Dry run passed",
+						Context:     github.StatusContext,
+						TargetURL:   "https://example.com/run",
+					},
+				})
+
+				Convey("Long description is truncated on a rune boundary", func() {
+					So(p.ReportStatus(ctx, lProject, rcl, &codereview.Status{
+						State:   codereview.StateFailure,
+						Message: strings.Repeat("é", 200),
+					}), ShouldBeNil)
+					desc := fake.Get(host, owner, repo, 7).Statuses[github.StatusContext].Description
+					So(desc, ShouldEqual, strings.Repeat("é", 137)+"...")
+					So(utf8.ValidString(desc), ShouldBeTrue)
+				})
+
+				So(p.Submit(ctx, lProject, rcl), ShouldBeNil)
+				So(fake.Get(host, owner, repo, 7).Merged, ShouldBeTrue)
+				So(p.Submit(ctx, lProject, rcl), ShouldErrLike, "not open")
+			})
+		})
+
+		Convey("No access", func() {
+			pr := fake.Get(host, owner, repo, 7)
+			pr.Visible = map[string]bool{"other": true}
+			fake.Add(host, owner, repo, pr)
+			cl := fetch()
+			So(cl.Snapshot, ShouldBeNil)
+			So(cl.Access.GetByProject()[lProject].GetNoAccess(), ShouldBeTrue)
+		})
+	})
+}
+
+type pmMock struct{}
+
+func (*pmMock) NotifyCLsUpdated(ctx context.Context, project string, cls *changelist.CLUpdatedEvents) error {
+	return nil
+}
+
+type rmMock struct{}
+
+func (*rmMock) NotifyCLsUpdated(ctx context.Context, rid common.RunID, cls *changelist.CLUpdatedEvents) error {
+	return nil
+}
+
+type tjMock struct{}
+
+func (*tjMock) ScheduleCancelStale(ctx context.Context, clid common.CLID, prevMinEquivalentPatchset, currentMinEquivalentPatchset int32, eta time.Time) error {
+	return nil
+}
@@ -31,11 +31,11 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/gerrit"
 	"go.chromium.org/luci/cv/internal/gerrit/cancel"
-	"go.chromium.org/luci/cv/internal/gerrit/trigger"
 	"go.chromium.org/luci/cv/internal/prjmanager"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
 	"go.chromium.org/luci/cv/internal/run"
@@ -48,6 +48,7 @@ type Purger struct {
 	gFactory   gerrit.Factory
 	clUpdater  clUpdater
 	clMutator  *changelist.Mutator
+	reviewers  *codereview.Registry
 }
 
 // clUpdater is a subset of the *changelist.Updater which Purger needs.
@@ -57,8 +58,8 @@ type clUpdater interface {
 
 // New creates a Purger and registers it for handling tasks created by the given
 // PM Notifier.
-func New(n *prjmanager.Notifier, g gerrit.Factory, u clUpdater, clm *changelist.Mutator) *Purger {
-	p := &Purger{n, g, u, clm}
+func New(n *prjmanager.Notifier, g gerrit.Factory, u clUpdater, clm *changelist.Mutator, reviewers *codereview.Registry) *Purger {
+	p := &Purger{n, g, u, clm, reviewers}
 	n.TasksBinding.PurgeProjectCL.AttachHandler(
 		func(ctx context.Context, payload proto.Message) error {
 			task := payload.(*prjpb.PurgeCLTask)
@@ -117,7 +118,7 @@ func (p *Purger) purgeWithDeadline(ctx context.Context, task *prjpb.PurgeCLTask)
 	if err != nil {
 		return nil
 	}
-	purgeTriggers, msg, err := triggersToPurge(ctx, configGroups[0].Content, cl, task)
+	purgeTriggers, msg, err := p.triggersToPurge(ctx, configGroups[0].Content, cl, task)
 	switch {
 	case err != nil:
 		return errors.Annotate(err, "CL %d of project %q", cl.ID, task.GetLuciProject()).Err()
@@ -175,20 +176,19 @@ func (p *Purger) purgeWithDeadline(ctx context.Context, task *prjpb.PurgeCLTask)
 	})
 }
 
-func triggersToPurge(ctx context.Context, cg *cfgpb.ConfigGroup, cl *changelist.CL, task *prjpb.PurgeCLTask) (*run.Triggers, string, error) {
+func (p *Purger) triggersToPurge(ctx context.Context, cg *cfgpb.ConfigGroup, cl *changelist.CL, task *prjpb.PurgeCLTask) (*run.Triggers, string, error) {
 	if cl.Snapshot == nil {
 		logging.Warningf(ctx, "CL without Snapshot can't be purged\n%s", task)
 		return nil, "", nil
 	}
-	if p := cl.Snapshot.GetLuciProject(); p != task.GetLuciProject() {
-		logging.Warningf(ctx, "CL now belongs to different project %q", p)
+	if lp := cl.Snapshot.GetLuciProject(); lp != task.GetLuciProject() {
+		logging.Warningf(ctx, "CL now belongs to different project %q", lp)
 		return nil, "", nil
 	}
 	if cl.Snapshot.GetGerrit() == nil {
 		panic(fmt.Errorf("CL %d has non-Gerrit snapshot", cl.ID))
 	}
-	ci := cl.Snapshot.GetGerrit().GetInfo()
-	currentTriggers := trigger.Find(&trigger.FindInput{ChangeInfo: ci, ConfigGroup: cg, TriggerNewPatchsetRunAfterPS: cl.TriggerNewPatchsetRunAfterPS})
+	currentTriggers := p.reviewers.FindTriggers(cl, cg)
 	if currentTriggers == nil {
 		return nil, "", nil
 	}
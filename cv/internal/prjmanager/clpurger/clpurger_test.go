@@ -26,6 +26,7 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
 	"go.chromium.org/luci/cv/internal/cvtesting"
 	gf "go.chromium.org/luci/cv/internal/gerrit/gerritfake"
@@ -57,7 +58,9 @@ func TestPurgeCL(t *testing.T) {
 		_ = tjcancel.NewCancellator(tjNotifier)
 		clMutator := changelist.NewMutator(ct.TQDispatcher, pmNotifier, nil, tjNotifier)
 		fakeCLUpdater := clUpdaterMock{}
-		purger := New(pmNotifier, ct.GFactory(), &fakeCLUpdater, clMutator)
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+		purger := New(pmNotifier, ct.GFactory(), &fakeCLUpdater, clMutator, reviewers)
 
 		const lProject = "lprj"
 		const gHost = "x-review"
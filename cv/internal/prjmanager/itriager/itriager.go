@@ -21,8 +21,11 @@ import (
 	"context"
 
 	"go.chromium.org/luci/common/errors"
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
+	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/runcreator"
 )
 
@@ -95,6 +98,11 @@ type PMState interface {
 	// ConfigGroup returns a ConfigGroup for a given index of the current
 	// (from the view point of PM) LUCI project config version.
 	ConfigGroup(index int32) *prjcfg.ConfigGroup
+
+	// FindTriggers returns the triggers currently active on the CL.
+	//
+	// Unlike the triggers of the PCLs, these include the triggerer's details.
+	FindTriggers(cl *changelist.CL, cg *cfgpb.ConfigGroup) *run.Triggers
 }
 
 // ErrOutdatedPMState signals that PMState is out dated.
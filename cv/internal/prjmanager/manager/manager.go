@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/gae/service/datastore"
 
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/eventbox"
 	"go.chromium.org/luci/cv/internal/gerrit"
@@ -69,16 +70,17 @@ type ProjectManager struct {
 
 // New creates a new ProjectManager and registers it for handling tasks created
 // by the given TQ Notifier.
-func New(n *prjmanager.Notifier, rn state.RunNotifier, c *changelist.Mutator, g gerrit.Factory, u *changelist.Updater) *ProjectManager {
+func New(n *prjmanager.Notifier, rn state.RunNotifier, c *changelist.Mutator, g gerrit.Factory, u *changelist.Updater, reviewers *codereview.Registry) *ProjectManager {
 	pm := &ProjectManager{
 		tasksBinding: n.TasksBinding,
 		handler: state.Handler{
 			CLMutator:       c,
 			PMNotifier:      n,
 			RunNotifier:     rn,
-			CLPurger:        clpurger.New(n, g, u, c),
+			CLPurger:        clpurger.New(n, g, u, c, reviewers),
 			CLPoller:        poller.New(n.TasksBinding.TQDispatcher, g, u, n),
 			ComponentTriage: triager.Triage,
+			Reviewers:       reviewers,
 		},
 	}
 	n.TasksBinding.ManageProject.AttachHandler(
@@ -31,6 +31,7 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/eventbox"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
@@ -38,7 +39,6 @@ import (
 	gf "go.chromium.org/luci/cv/internal/gerrit/gerritfake"
 	"go.chromium.org/luci/cv/internal/gerrit/gobmap/gobmaptest"
 	"go.chromium.org/luci/cv/internal/gerrit/poller"
-	gerritupdater "go.chromium.org/luci/cv/internal/gerrit/updater"
 	"go.chromium.org/luci/cv/internal/prjmanager"
 	"go.chromium.org/luci/cv/internal/prjmanager/pmtest"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
@@ -61,8 +61,10 @@ func TestProjectTQLateTasks(t *testing.T) {
 		runNotifier := runNotifierMock{}
 		clMutator := changelist.NewMutator(ct.TQDispatcher, pmNotifier, &runNotifier, &tjMock{})
 		clUpdater := changelist.NewUpdater(ct.TQDispatcher, clMutator)
-		gerritupdater.RegisterUpdater(clUpdater, ct.GFactory())
-		_ = New(pmNotifier, &runNotifier, clMutator, ct.GFactory(), clUpdater)
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+		reviewers.RegisterUpdaterBackends(clUpdater)
+		_ = New(pmNotifier, &runNotifier, clMutator, ct.GFactory(), clUpdater, reviewers)
 
 		const lProject = "infra"
 		recipient := prjmanager.EventboxRecipient(ctx, lProject)
@@ -106,8 +108,10 @@ func TestProjectLifeCycle(t *testing.T) {
 		runNotifier := runNotifierMock{}
 		clMutator := changelist.NewMutator(ct.TQDispatcher, pmNotifier, &runNotifier, &tjMock{})
 		clUpdater := changelist.NewUpdater(ct.TQDispatcher, clMutator)
-		gerritupdater.RegisterUpdater(clUpdater, ct.GFactory())
-		_ = New(pmNotifier, &runNotifier, clMutator, ct.GFactory(), clUpdater)
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+		reviewers.RegisterUpdaterBackends(clUpdater)
+		_ = New(pmNotifier, &runNotifier, clMutator, ct.GFactory(), clUpdater, reviewers)
 
 		const lProject = "infra"
 		recipient := prjmanager.EventboxRecipient(ctx, lProject)
@@ -237,8 +241,10 @@ func TestProjectHandlesManyEvents(t *testing.T) {
 		runNotifier := runNotifierMock{}
 		clMutator := changelist.NewMutator(ct.TQDispatcher, pmNotifier, &runNotifier, &tjMock{})
 		clUpdater := changelist.NewUpdater(ct.TQDispatcher, clMutator)
-		gerritupdater.RegisterUpdater(clUpdater, ct.GFactory())
-		pm := New(pmNotifier, &runNotifier, clMutator, ct.GFactory(), clUpdater)
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+		reviewers.RegisterUpdaterBackends(clUpdater)
+		pm := New(pmNotifier, &runNotifier, clMutator, ct.GFactory(), clUpdater, reviewers)
 
 		cfg := singleRepoConfig(gHost, gRepo)
 		cfg.ConfigGroups[0].CombineCls = &cfgpb.CombineCLs{
@@ -158,6 +158,7 @@ func TestCategorizeAndLoadActiveIntoPCLs(t *testing.T) {
 			ConfigGroupNames:    []string{"g0", "g1"},
 			RepartitionRequired: true,
 		}}
+		state.reviewers = ct.reviewers
 
 		Convey("just categorization", func() {
 			state.PB.Pcls = sortPCLs([]*prjpb.PCL{
@@ -31,10 +31,14 @@ import (
 	"go.chromium.org/luci/common/runtime/paniccatcher"
 	"go.chromium.org/luci/common/sync/parallel"
 
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/prjmanager/itriager"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
+	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/runcreator"
 )
 
@@ -381,6 +385,7 @@ func (s *State) makeTriageSupporter(ctx context.Context) (*triageSupporter, erro
 		pclIndex:     s.pclIndex,
 		purging:      purging,
 		configGroups: s.configGroups,
+		reviewers:    s.reviewers,
 	}, nil
 }
 
@@ -392,6 +397,7 @@ type triageSupporter struct {
 	pclIndex     map[common.CLID]int
 	purging      map[int64]*prjpb.PurgingCL
 	configGroups []*prjcfg.ConfigGroup
+	reviewers    *codereview.Registry
 }
 
 var _ itriager.PMState = (*triageSupporter)(nil)
@@ -412,6 +418,10 @@ func (a *triageSupporter) ConfigGroup(index int32) *prjcfg.ConfigGroup {
 	return a.configGroups[index]
 }
 
+func (a *triageSupporter) FindTriggers(cl *changelist.CL, cg *cfgpb.ConfigGroup) *run.Triggers {
+	return a.reviewers.FindTriggers(cl, cg)
+}
+
 func markForTriage(in []*prjpb.Component) []*prjpb.Component {
 	out := make([]*prjpb.Component, len(in))
 	for i, c := range in {
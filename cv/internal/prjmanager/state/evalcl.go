@@ -31,7 +31,6 @@ import (
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/gerrit/cfgmatcher"
-	"go.chromium.org/luci/cv/internal/gerrit/trigger"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
 	"go.chromium.org/luci/cv/internal/run"
 )
@@ -298,7 +297,7 @@ func (s *State) makePCL(ctx context.Context, cl *changelist.CL) *prjpb.PCL {
 		pcl.Errors = append(pcl.Errors, err)
 	}
 
-	s.setTriggers(ci, pcl, cl.TriggerNewPatchsetRunAfterPS)
+	s.setTriggers(cl, pcl)
 
 	// Check for "Commit: false" footer after setting Trigger, because this should
 	// only have an effect in the case of an attempted full run.
@@ -363,11 +362,11 @@ func (s *State) tryUsingApplicableConfigGroups(ap *changelist.ApplicableConfig_P
 }
 
 // setTriggers populates a PCL's .Triggers field with the triggers present in
-// the given ChangeInfo.
+// the given CL.
 //
 // It also validates that the trigger mode is allowed, and strips the
 // information from the triggerer.
-func (s *State) setTriggers(ci *gerritpb.ChangeInfo, pcl *prjpb.PCL, latestPSRun int32) {
+func (s *State) setTriggers(cl *changelist.CL, pcl *prjpb.PCL) {
 	// Triggers are a function of a CL and applicable ConfigGroup, which may
 	// define additional modes.
 	// In case of misconfiguration, there may be 0 or 2+ applicable
@@ -382,7 +381,7 @@ func (s *State) setTriggers(ci *gerritpb.ChangeInfo, pcl *prjpb.PCL, latestPSRun
 	} else {
 		cg = &cfgpb.ConfigGroup{}
 	}
-	ts := trigger.Find(&trigger.FindInput{ChangeInfo: ci, ConfigGroup: cg, TriggerNewPatchsetRunAfterPS: latestPSRun})
+	ts := s.reviewers.FindTriggers(cl, cg)
 	if ts == nil {
 		return
 	}
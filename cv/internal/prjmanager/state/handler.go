@@ -28,6 +28,7 @@ import (
 	"go.chromium.org/luci/common/trace"
 
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/gerrit/cfgmatcher"
@@ -53,11 +54,18 @@ type Handler struct {
 	CLPurger        *clpurger.Purger
 	CLPoller        *poller.Poller
 	ComponentTriage itriager.Triage
+	Reviewers       *codereview.Registry
+}
+
+// prepare must be called at the start of each state transition.
+func (h *Handler) prepare(s *State) {
+	s.ensureNotYetCloned()
+	s.reviewers = h.Reviewers
 }
 
 // UpdateConfig updates PM to the latest config version.
 func (h *Handler) UpdateConfig(ctx context.Context, s *State) (*State, SideEffect, error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	meta, err := prjcfg.GetLatestMeta(ctx, s.PB.GetLuciProject())
 	if err != nil {
@@ -146,7 +154,7 @@ func (h *Handler) UpdateConfig(ctx context.Context, s *State) (*State, SideEffec
 
 // Poke propagates "the poke" downstream to Poller & Runs.
 func (h *Handler) Poke(ctx context.Context, s *State) (*State, SideEffect, error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	// First, check if UpdateConfig if necessary.
 	switch newState, sideEffect, err := h.UpdateConfig(ctx, s); {
@@ -173,7 +181,7 @@ func (h *Handler) Poke(ctx context.Context, s *State) (*State, SideEffect, error
 
 // OnRunsCreated updates state after new Runs were created.
 func (h *Handler) OnRunsCreated(ctx context.Context, s *State, created common.RunIDs) (_ *State, __ SideEffect, err error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	ctx, span := trace.StartSpan(ctx, "go.chromium.org/luci/cv/internal/prjmanager/impl/state/OnRunsCreated")
 	defer func() { span.End(err) }()
@@ -215,7 +223,7 @@ func (h *Handler) OnRunsCreated(ctx context.Context, s *State, created common.Ru
 
 // OnRunsFinished updates state after Runs were finished.
 func (h *Handler) OnRunsFinished(ctx context.Context, s *State, finished common.RunIDs) (_ *State, __ SideEffect, err error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	_, span := trace.StartSpan(ctx, "go.chromium.org/luci/cv/internal/prjmanager/impl/state/OnRunsFinished")
 	defer func() { span.End(err) }()
@@ -236,7 +244,7 @@ func (h *Handler) OnRunsFinished(ctx context.Context, s *State, finished common.
 // clEVersions must map CL's ID to CL's EVersion.
 // clEVersions is mutated.
 func (h *Handler) OnCLsUpdated(ctx context.Context, s *State, clEVersions map[int64]int64) (_ *State, __ SideEffect, err error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	ctx, span := trace.StartSpan(ctx, "go.chromium.org/luci/cv/internal/prjmanager/impl/state/OnCLsUpdated")
 	defer func() { span.End(err) }()
@@ -265,7 +273,7 @@ func (h *Handler) OnCLsUpdated(ctx context.Context, s *State, clEVersions map[in
 
 // OnPurgesCompleted updates state as a result of completed purge operations.
 func (h *Handler) OnPurgesCompleted(ctx context.Context, s *State, events []*prjpb.PurgeCompleted) (_ *State, __ SideEffect, err error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	ctx, span := trace.StartSpan(ctx, "go.chromium.org/luci/cv/internal/prjmanager/impl/state/OnPurgesCompleted")
 	defer func() { span.End(err) }()
@@ -323,7 +331,7 @@ func (h *Handler) OnPurgesCompleted(ctx context.Context, s *State, events []*prj
 
 // ExecDeferred performs previously postponed actions, notably creating Runs.
 func (h *Handler) ExecDeferred(ctx context.Context, s *State) (_ *State, __ SideEffect, err error) {
-	s.ensureNotYetCloned()
+	h.prepare(s)
 
 	ctx, span := trace.StartSpan(ctx, "go.chromium.org/luci/cv/internal/prjmanager/impl/state/ExecDeferred")
 	defer func() { span.End(err) }()
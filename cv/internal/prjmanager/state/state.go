@@ -15,6 +15,7 @@
 package state
 
 import (
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/gerrit/cfgmatcher"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
@@ -58,6 +59,8 @@ type State struct {
 	configGroups []*prjcfg.ConfigGroup
 	// cfgMatcher is lazily created, cached, and passed on to State clones.
 	cfgMatcher *cfgmatcher.Matcher
+	// reviewers is set by the Handler and passed on to State clones.
+	reviewers *codereview.Registry
 	// pclIndex provides O(1) check if PCL exists for a CL.
 	//
 	// lazily created, see ensurePCLIndex().
@@ -32,6 +32,7 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
@@ -41,7 +42,6 @@ import (
 	"go.chromium.org/luci/cv/internal/gerrit/gobmap/gobmaptest"
 	"go.chromium.org/luci/cv/internal/gerrit/poller"
 	"go.chromium.org/luci/cv/internal/gerrit/trigger"
-	gerritupdater "go.chromium.org/luci/cv/internal/gerrit/updater"
 	"go.chromium.org/luci/cv/internal/prjmanager"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
 	"go.chromium.org/luci/cv/internal/run"
@@ -58,13 +58,16 @@ type ctest struct {
 	gHost     string
 	pm        *prjmanager.Notifier
 	clUpdater *changelist.Updater
+	reviewers *codereview.Registry
 }
 
 func (ct *ctest) SetUp() (context.Context, func()) {
 	ctx, cancel := ct.Test.SetUp()
 	ct.pm = prjmanager.NewNotifier(ct.TQDispatcher)
 	ct.clUpdater = changelist.NewUpdater(ct.TQDispatcher, changelist.NewMutator(ct.TQDispatcher, ct.pm, nil, tryjob.NewNotifier(ct.TQDispatcher)))
-	gerritupdater.RegisterUpdater(ct.clUpdater, ct.GFactory())
+	ct.reviewers = &codereview.Registry{}
+	ct.reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+	ct.reviewers.RegisterUpdaterBackends(ct.clUpdater)
 	return ctx, cancel
 }
 
@@ -160,7 +163,7 @@ func TestUpdateConfig(t *testing.T) {
 		gobmaptest.Update(ctx, ct.lProject)
 
 		clPoller := poller.New(ct.TQDispatcher, nil, nil, nil)
-		h := Handler{CLPoller: clPoller}
+		h := Handler{CLPoller: clPoller, Reviewers: ct.reviewers}
 
 		Convey("initializes newly started project", func() {
 			// Newly started project doesn't have any CLs, yet, regardless of what CL
@@ -403,6 +406,7 @@ func TestUpdateConfig(t *testing.T) {
 			s1.configGroups, err = meta.GetConfigGroups(ctx)
 			So(err, ShouldBeNil)
 			s1.cfgMatcher = cfgmatcher.LoadMatcherFromConfigGroups(ctx, s1.configGroups, &meta)
+			s1.reviewers = ct.reviewers
 
 			Convey("Status == OK", func() {
 				expected := &prjpb.PCL{
@@ -581,7 +585,7 @@ func TestOnCLsUpdated(t *testing.T) {
 		cl202 := ct.runCLUpdater(ctx, 202)
 		cl203 := ct.runCLUpdater(ctx, 203)
 
-		h := Handler{}
+		h := Handler{Reviewers: ct.reviewers}
 		s0 := &State{PB: &prjpb.PState{
 			LuciProject:      ct.lProject,
 			Status:           prjpb.Status_STARTED,
@@ -830,7 +834,7 @@ func TestRunsCreatedAndFinished(t *testing.T) {
 		So(datastore.Put(ctx, run1finished, run1, run789), ShouldBeNil)
 		So(run.IsEnded(run1finished.Status), ShouldBeTrue)
 
-		h := Handler{}
+		h := Handler{Reviewers: ct.reviewers}
 		s1 := &State{PB: &prjpb.PState{
 			LuciProject:      ct.lProject,
 			Status:           prjpb.Status_STARTED,
@@ -31,7 +31,6 @@ import (
 	"go.chromium.org/luci/cv/internal/changelist"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
-	"go.chromium.org/luci/cv/internal/gerrit/trigger"
 	"go.chromium.org/luci/cv/internal/prjmanager/itriager"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
 	"go.chromium.org/luci/cv/internal/run"
@@ -375,11 +374,7 @@ func (rs *runStage) makeCreator(ctx context.Context, combo *combo, cg *prjcfg.Co
 		opts = run.MergeOptions(opts, run.ExtractOptions(cl.Snapshot))
 
 		// Restore email, which Project Manager doesn't track inside PCLs.
-		tr := chooseTrigger(trigger.Find(&trigger.FindInput{
-			ChangeInfo:                   cl.Snapshot.GetGerrit().GetInfo(),
-			ConfigGroup:                  cg.Content,
-			TriggerNewPatchsetRunAfterPS: cl.TriggerNewPatchsetRunAfterPS,
-		}))
+		tr := chooseTrigger(rs.pm.FindTriggers(cl, cg.Content))
 		pclT := chooseTrigger(pcl.GetTriggers())
 		if tr.GetMode() != pclT.GetMode() {
 			panic(fmt.Errorf("inconsistent Trigger in PM (%s) vs freshly extracted (%s)", pclT, tr))
@@ -142,8 +142,9 @@ func TestTriage(t *testing.T) {
 				So(trs.GetCqVoteTrigger().GetMode(), ShouldResemble, string(mode))
 			}
 			cl := &changelist.CL{
-				ID:       common.CLID(clid),
-				EVersion: 1,
+				ID:         common.CLID(clid),
+				ExternalID: changelist.MustGobID(gHost, ci.GetNumber()),
+				EVersion:   1,
 				Snapshot: &changelist.Snapshot{Kind: &changelist.Snapshot_Gerrit{Gerrit: &changelist.Gerrit{
 					Host: gHost,
 					Info: ci,
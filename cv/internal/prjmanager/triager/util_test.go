@@ -18,8 +18,12 @@ import (
 	"testing"
 	"time"
 
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/prjmanager/prjpb"
+	"go.chromium.org/luci/cv/internal/run"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -53,6 +57,12 @@ func (s *simplePMState) ConfigGroup(index int32) *prjcfg.ConfigGroup {
 	return s.cgs[index]
 }
 
+func (s *simplePMState) FindTriggers(cl *changelist.CL, cg *cfgpb.ConfigGroup) *run.Triggers {
+	r := &codereview.Registry{}
+	r.Register(codereview.NewGerritProvider(nil))
+	return r.FindTriggers(cl, cg)
+}
+
 func TestEarliest(t *testing.T) {
 	t.Parallel()
 
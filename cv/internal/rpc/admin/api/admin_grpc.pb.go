@@ -35,6 +35,12 @@ type AdminClient interface {
 	GetPoller(ctx context.Context, in *GetPollerRequest, opts ...grpc.CallOption) (*GetPollerResponse, error)
 	// SearchRuns returns Runs ordered by .CreateTime DESC (most recent first).
 	SearchRuns(ctx context.Context, in *SearchRunsRequest, opts ...grpc.CallOption) (*RunsResponse, error)
+	// EvaluateTryjobRequirement computes which Tryjobs a hypothetical CL would
+	// trigger under the given project config.
+	//
+	// Doesn't read or modify any CV state, so it can be used to evaluate
+	// proposed config changes before they land.
+	EvaluateTryjobRequirement(ctx context.Context, in *EvaluateTryjobRequirementRequest, opts ...grpc.CallOption) (*EvaluateTryjobRequirementResponse, error)
 	// DSMLaunchJob launches a new job, pre-registered in dsmapper.go.
 	//
 	// If unsure about job name, use "404" and observe the error,
@@ -148,6 +154,15 @@ func (c *adminClient) SearchRuns(ctx context.Context, in *SearchRunsRequest, opt
 	return out, nil
 }
 
+func (c *adminClient) EvaluateTryjobRequirement(ctx context.Context, in *EvaluateTryjobRequirementRequest, opts ...grpc.CallOption) (*EvaluateTryjobRequirementResponse, error) {
+	out := new(EvaluateTryjobRequirementResponse)
+	err := c.cc.Invoke(ctx, "/cv.internal.rpc.admin.api.Admin/EvaluateTryjobRequirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) DSMLaunchJob(ctx context.Context, in *DSMLaunchJobRequest, opts ...grpc.CallOption) (*DSMJobID, error) {
 	out := new(DSMJobID)
 	err := c.cc.Invoke(ctx, "/cv.internal.rpc.admin.api.Admin/DSMLaunchJob", in, out, opts...)
@@ -236,6 +251,12 @@ type AdminServer interface {
 	GetPoller(context.Context, *GetPollerRequest) (*GetPollerResponse, error)
 	// SearchRuns returns Runs ordered by .CreateTime DESC (most recent first).
 	SearchRuns(context.Context, *SearchRunsRequest) (*RunsResponse, error)
+	// EvaluateTryjobRequirement computes which Tryjobs a hypothetical CL would
+	// trigger under the given project config.
+	//
+	// Doesn't read or modify any CV state, so it can be used to evaluate
+	// proposed config changes before they land.
+	EvaluateTryjobRequirement(context.Context, *EvaluateTryjobRequirementRequest) (*EvaluateTryjobRequirementResponse, error)
 	// DSMLaunchJob launches a new job, pre-registered in dsmapper.go.
 	//
 	// If unsure about job name, use "404" and observe the error,
@@ -310,6 +331,9 @@ func (UnimplementedAdminServer) GetPoller(context.Context, *GetPollerRequest) (*
 func (UnimplementedAdminServer) SearchRuns(context.Context, *SearchRunsRequest) (*RunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchRuns not implemented")
 }
+func (UnimplementedAdminServer) EvaluateTryjobRequirement(context.Context, *EvaluateTryjobRequirementRequest) (*EvaluateTryjobRequirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateTryjobRequirement not implemented")
+}
 func (UnimplementedAdminServer) DSMLaunchJob(context.Context, *DSMLaunchJobRequest) (*DSMJobID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DSMLaunchJob not implemented")
 }
@@ -455,6 +479,24 @@ func _Admin_SearchRuns_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_EvaluateTryjobRequirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateTryjobRequirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EvaluateTryjobRequirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cv.internal.rpc.admin.api.Admin/EvaluateTryjobRequirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EvaluateTryjobRequirement(ctx, req.(*EvaluateTryjobRequirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_DSMLaunchJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DSMLaunchJobRequest)
 	if err := dec(in); err != nil {
@@ -630,6 +672,10 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchRuns",
 			Handler:    _Admin_SearchRuns_Handler,
 		},
+		{
+			MethodName: "EvaluateTryjobRequirement",
+			Handler:    _Admin_EvaluateTryjobRequirement_Handler,
+		},
 		{
 			MethodName: "DSMLaunchJob",
 			Handler:    _Admin_DSMLaunchJob_Handler,
@@ -0,0 +1,94 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/grpc/appstatus"
+	"go.chromium.org/luci/server/auth"
+
+	"go.chromium.org/luci/cv/internal/configs/validation"
+	adminpb "go.chromium.org/luci/cv/internal/rpc/admin/api"
+	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob/requirement"
+)
+
+func (a *AdminServer) EvaluateTryjobRequirement(ctx context.Context, req *adminpb.EvaluateTryjobRequirementRequest) (resp *adminpb.EvaluateTryjobRequirementResponse, err error) {
+	defer func() { err = appstatus.GRPCifyAndLog(ctx, err) }()
+	if err = checkAllowed(ctx, "EvaluateTryjobRequirement"); err != nil {
+		return
+	}
+
+	switch {
+	case req.GetConfig() == nil:
+		return nil, appstatus.Error(codes.InvalidArgument, "config is required")
+	case req.GetGerritHost() == "" || req.GetGerritProject() == "":
+		return nil, appstatus.Error(codes.InvalidArgument, "gerrit_host and gerrit_project are required")
+	}
+	if err := validation.ValidateProject(req.GetConfig()); err != nil {
+		return nil, appstatus.Errorf(codes.InvalidArgument, "invalid config: %s", err)
+	}
+	mode := run.DryRun
+	if req.GetMode() != "" {
+		mode = run.Mode(req.GetMode())
+	}
+	if !mode.Valid() {
+		return nil, appstatus.Errorf(codes.InvalidArgument, "invalid mode %q", req.GetMode())
+	}
+	owner := req.GetOwnerEmail()
+	if owner == "" {
+		owner = auth.CurrentIdentity(ctx).Email()
+	}
+
+	res, err := requirement.WhatIf(ctx, requirement.WhatIfInput{
+		Config:          req.GetConfig(),
+		ConfigGroup:     req.GetConfigGroup(),
+		GerritHost:      req.GetGerritHost(),
+		GerritProject:   req.GetGerritProject(),
+		Ref:             req.GetRef(),
+		Files:           req.GetFiles(),
+		OwnerEmail:      owner,
+		Mode:            mode,
+		IncludedTryjobs: req.GetIncludedTryjobs(),
+	})
+	switch {
+	case requirement.BadWhatIfInputTag.In(err):
+		return nil, appstatus.Errorf(codes.InvalidArgument, "%s", err)
+	case err != nil:
+		// E.g. AuthDB failures. GRPCifyAndLog turns them into Internal.
+		return nil, err
+	}
+
+	resp = &adminpb.EvaluateTryjobRequirementResponse{
+		ConfigGroup: res.ConfigGroup.GetName(),
+	}
+	if !res.OK() {
+		resp.ComputationFailure = res.ComputationFailure.Reason()
+		return resp, nil
+	}
+	resp.Requirement = res.Requirement
+	resp.Decisions = make([]*adminpb.EvaluateTryjobRequirementResponse_BuilderDecision, len(res.Decisions))
+	for i, d := range res.Decisions {
+		resp.Decisions[i] = &adminpb.EvaluateTryjobRequirementResponse_BuilderDecision{
+			Builder:  d.Builder,
+			Included: d.Included,
+			Reason:   d.Reason,
+		}
+	}
+	return resp, nil
+}
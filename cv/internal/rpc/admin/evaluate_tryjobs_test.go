@@ -0,0 +1,155 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+	adminpb "go.chromium.org/luci/cv/internal/rpc/admin/api"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+// failingDB fails membership checks of the given group.
+type failingDB struct {
+	*authtest.FakeDB
+	group string
+}
+
+func (db *failingDB) IsMember(ctx context.Context, id identity.Identity, groups []string) (bool, error) {
+	for _, g := range groups {
+		if g == db.group {
+			return false, errors.New("auth db is down")
+		}
+	}
+	return db.FakeDB.IsMember(ctx, id, groups)
+}
+
+func TestEvaluateTryjobRequirement(t *testing.T) {
+	t.Parallel()
+
+	Convey("EvaluateTryjobRequirement works", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp()
+		defer cancel()
+
+		a := AdminServer{}
+		req := &adminpb.EvaluateTryjobRequirementRequest{
+			Config: &cfgpb.Config{
+				ConfigGroups: []*cfgpb.ConfigGroup{{
+					Name: "main",
+					Gerrit: []*cfgpb.ConfigGroup_Gerrit{{
+						Url: "https://example-review.googlesource.com",
+						Projects: []*cfgpb.ConfigGroup_Gerrit_Project{{
+							Name:      "repo",
+							RefRegexp: []string{"refs/heads/main"},
+						}},
+					}},
+					Verifiers: &cfgpb.Verifiers{
+						GerritCqAbility: &cfgpb.Verifiers_GerritCQAbility{
+							CommitterList: []string{"committers"},
+						},
+						Tryjob: &cfgpb.Verifiers_Tryjob{
+							Builders: []*cfgpb.Verifiers_Tryjob_Builder{
+								{Name: "proj/try/all"},
+								{
+									Name:           "proj/try/docs",
+									LocationRegexp: []string{`.+/[+]/docs/.+`},
+								},
+							},
+						},
+					},
+				}},
+			},
+			GerritHost:    "example-review.googlesource.com",
+			GerritProject: "repo",
+			Files:         []string{"src/main.go"},
+		}
+
+		Convey("without access", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity: "anonymous:anonymous",
+			})
+			_, err := a.EvaluateTryjobRequirement(ctx, req)
+			So(err, ShouldBeRPCPermissionDenied)
+		})
+
+		Convey("with access", func() {
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity:       "user:admin@example.com",
+				IdentityGroups: []string{allowGroup},
+			})
+
+			Convey("explains decisions", func() {
+				resp, err := a.EvaluateTryjobRequirement(ctx, req)
+				So(err, ShouldBeNil)
+				So(resp.GetConfigGroup(), ShouldEqual, "main")
+				So(resp.GetRequirement().GetDefinitions(), ShouldHaveLength, 1)
+				So(resp.GetDecisions(), ShouldResembleProto, []*adminpb.EvaluateTryjobRequirementResponse_BuilderDecision{
+					{Builder: "proj/try/all", Included: true, Reason: "included"},
+					{Builder: "proj/try/docs", Reason: "no changed file matches location_regexp"},
+				})
+			})
+
+			Convey("reports computation failure", func() {
+				req.IncludedTryjobs = []string{"proj/try:undefined"}
+				resp, err := a.EvaluateTryjobRequirement(ctx, req)
+				So(err, ShouldBeNil)
+				So(resp.GetRequirement(), ShouldBeNil)
+				So(resp.GetComputationFailure(), ShouldContainSubstring, "proj/try/undefined")
+			})
+
+			Convey("rejects invalid config", func() {
+				req.Config.ConfigGroups[0].Verifiers.Tryjob.Builders[1].LocationRegexp = []string{"("}
+				_, err := a.EvaluateTryjobRequirement(ctx, req)
+				So(err, ShouldBeRPCInvalidArgument)
+			})
+
+			Convey("rejects invalid mode", func() {
+				req.Mode = "BOGUS"
+				_, err := a.EvaluateTryjobRequirement(ctx, req)
+				So(err, ShouldBeRPCInvalidArgument)
+			})
+
+			Convey("rejects unwatched location", func() {
+				req.Ref = "refs/heads/other"
+				_, err := a.EvaluateTryjobRequirement(ctx, req)
+				So(err, ShouldBeRPCInvalidArgument)
+			})
+
+			Convey("fails on AuthDB errors", func() {
+				req.Config.ConfigGroups[0].Verifiers.Tryjob.Builders[0].OwnerWhitelistGroup = []string{"insiders"}
+				ctx = auth.WithState(ctx, &authtest.FakeState{
+					Identity: "user:admin@example.com",
+					FakeDB: &failingDB{
+						FakeDB: authtest.NewFakeDB(authtest.MockMembership("user:admin@example.com", allowGroup)),
+						group:  "insiders",
+					},
+				})
+				_, err := a.EvaluateTryjobRequirement(ctx, req)
+				So(err, ShouldBeRPCInternal)
+			})
+		})
+	})
+}
@@ -40,3 +40,14 @@ func RunStatusV0(s run.Status) apiv0pb.Run_Status {
 func RunStatusV1(s run.Status) apiv1pb.Run_Status {
 	return apiv1pb.Run_Status(s)
 }
+
+// TryjobStatusV1 converts internal Tryjob status to an APIv1 equivalent.
+func TryjobStatusV1(s tryjob.Status) apiv1pb.PubSubTryjob_Status {
+	return apiv1pb.PubSubTryjob_Status(s)
+}
+
+// TryjobResultStatusV1 converts internal Tryjob Result status to an APIv1
+// equivalent.
+func TryjobResultStatusV1(s tryjob.Result_Status) apiv1pb.PubSubTryjob_ResultStatus {
+	return apiv1pb.PubSubTryjob_ResultStatus(s)
+}
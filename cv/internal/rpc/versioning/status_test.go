@@ -43,6 +43,38 @@ func TestStatusV1(t *testing.T) {
 			})
 		}
 	})
+
+	Convey("TryjobStatusV1 returns a valid enum", t, func() {
+		for name, val := range tryjob.Status_value {
+			name, val := name, val
+			Convey("for internal."+name, func() {
+				eq := TryjobStatusV1(tryjob.Status(val))
+
+				// check if it's typed with the API enum.
+				So(eq.Descriptor().FullName(), ShouldEqual,
+					apiv1pb.PubSubTryjob_STATUS_UNSPECIFIED.Descriptor().FullName())
+				// check if it's one of the defined enum ints.
+				_, ok := apiv1pb.PubSubTryjob_Status_name[int32(eq)]
+				So(ok, ShouldBeTrue)
+			})
+		}
+	})
+
+	Convey("TryjobResultStatusV1 returns a valid enum", t, func() {
+		for name, val := range tryjob.Result_Status_value {
+			name, val := name, val
+			Convey("for internal."+name, func() {
+				eq := TryjobResultStatusV1(tryjob.Result_Status(val))
+
+				// check if it's typed with the API enum.
+				So(eq.Descriptor().FullName(), ShouldEqual,
+					apiv1pb.PubSubTryjob_RESULT_STATUS_UNSPECIFIED.Descriptor().FullName())
+				// check if it's one of the defined enum ints.
+				_, ok := apiv1pb.PubSubTryjob_ResultStatus_name[int32(eq)]
+				So(ok, ShouldBeTrue)
+			})
+		}
+	})
 }
 
 func TestStatusV0(t *testing.T) {
@@ -26,7 +26,6 @@ import (
 	"go.chromium.org/luci/cv/internal/changelist"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
-	"go.chromium.org/luci/cv/internal/gerrit/trigger"
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
 )
@@ -65,7 +64,7 @@ func (impl *Impl) OnCLsUpdated(ctx context.Context, rs *state.RunState, clids co
 			// runs.CheckRunCreate() should be checked or not.
 			hasNilSnapshot = true
 		}
-		switch reconsiderAt, cancellationReason := shouldCancel(ctx, cls[i], runCLs[i], cg); {
+		switch reconsiderAt, cancellationReason := impl.shouldCancel(ctx, cls[i], runCLs[i], cg); {
 		case !reconsiderAt.IsZero():
 			if earliestReconsiderAt.IsZero() || earliestReconsiderAt.After(reconsiderAt) {
 				earliestReconsiderAt = reconsiderAt
@@ -108,7 +107,7 @@ func (impl *Impl) OnCLsUpdated(ctx context.Context, rs *state.RunState, clids co
 	return &Result{State: rs}, nil
 }
 
-func shouldCancel(ctx context.Context, cl *changelist.CL, rcl *run.RunCL, cg *prjcfg.ConfigGroup) (time.Time, string) {
+func (impl *Impl) shouldCancel(ctx context.Context, cl *changelist.CL, rcl *run.RunCL, cg *prjcfg.ConfigGroup) (time.Time, string) {
 	project := cg.ProjectString()
 	clString := fmt.Sprintf("CL %d %s", cl.ID, cl.ExternalID)
 	switch kind, reason := cl.AccessKindWithReason(ctx, project); kind {
@@ -138,11 +137,7 @@ func shouldCancel(ctx context.Context, cl *changelist.CL, rcl *run.RunCL, cg *pr
 		logging.Warningf(ctx, "%s has new ref %q => %q", clString, o, c)
 		return time.Time{}, fmt.Sprintf("the ref of %s has moved from %s to %s", cl.ExternalID.MustURL(), o, c)
 	}
-	o, c := rcl.Trigger, trigger.Find(&trigger.FindInput{
-		ChangeInfo:                   cl.Snapshot.GetGerrit().GetInfo(),
-		ConfigGroup:                  cg.Content,
-		TriggerNewPatchsetRunAfterPS: cl.TriggerNewPatchsetRunAfterPS,
-	})
+	o, c := rcl.Trigger, impl.Reviewers.FindTriggers(cl, cg.Content)
 	if whatChanged := run.HasTriggerChanged(o, c, cl.ExternalID.MustURL()); whatChanged != "" {
 		logging.Infof(ctx, "%s has new trigger\nOLD: %s\nNEW: %s", clString, o, c)
 		return time.Time{}, whatChanged
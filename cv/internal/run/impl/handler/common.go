@@ -94,7 +94,7 @@ func (impl *Impl) endRun(ctx context.Context, rs *state.RunState, st run.Status)
 			// Datastore), the EVersion will be increased by 1 based on how
 			// eventbox works. If this eventbox behavior is changed in the future,
 			// this logic should be revisited.
-			return impl.Publisher.RunEnded(ctx, rs.ID, rs.Status, rs.EVersion+1)
+			return impl.Publisher.RunEnded(ctx, rs.ID, rs.Status, rs.EVersion+1, rs.EndTime)
 		},
 		func(ctx context.Context) error {
 			txndefer.Defer(ctx, func(ctx context.Context) {
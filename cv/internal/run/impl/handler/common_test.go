@@ -30,7 +30,9 @@ import (
 	"go.chromium.org/luci/common/errors"
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	apiv0pb "go.chromium.org/luci/cv/api/v0"
+	cvpb "go.chromium.org/luci/cv/api/v1"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
@@ -115,10 +117,13 @@ func TestEndRun(t *testing.T) {
 
 		Convey("Publish RunEnded event", func() {
 			var task *pubsub.PublishRunEndedTask
+			var event *pubsub.PublishRunEventTask
 			for _, t := range ct.TQ.Tasks() {
-				if p, ok := t.Payload.(*pubsub.PublishRunEndedTask); ok {
+				switch p := t.Payload.(type) {
+				case *pubsub.PublishRunEndedTask:
 					task = p
-					break
+				case *pubsub.PublishRunEventTask:
+					event = p
 				}
 			}
 			So(task, ShouldResembleProto, &pubsub.PublishRunEndedTask{
@@ -127,6 +132,8 @@ func TestEndRun(t *testing.T) {
 				Status:      rs.Status,
 				Eversion:    int64(rs.EVersion + 1),
 			})
+			So(event.GetEvent().GetType(), ShouldEqual, cvpb.PubSubRunEvent_RUN_ENDED)
+			So(event.GetEvent().GetEventTime().AsTime(), ShouldEqual, rs.EndTime)
 		})
 	})
 }
@@ -313,10 +320,11 @@ func TestCheckRunCreate(t *testing.T) {
 }
 
 type dependencies struct {
-	pm         *prjmanager.Notifier
-	rm         *run.Notifier
-	tjNotifier *tryjobNotifierMock
-	clUpdater  *clUpdaterMock
+	pm            *prjmanager.Notifier
+	rm            *run.Notifier
+	tjNotifier    *tryjobNotifierMock
+	clUpdater     *clUpdaterMock
+	trainVerifier *trainVerifierMock
 }
 
 type testHandler struct {
@@ -474,22 +482,27 @@ func makeTestHandler(ct *cvtesting.Test) (Handler, dependencies) {
 // please use makeTestHandler instead.
 func makeImpl(ct *cvtesting.Test) (*Impl, dependencies) {
 	deps := dependencies{
-		pm:         prjmanager.NewNotifier(ct.TQDispatcher),
-		rm:         run.NewNotifier(ct.TQDispatcher),
-		tjNotifier: &tryjobNotifierMock{},
-		clUpdater:  &clUpdaterMock{},
+		pm:            prjmanager.NewNotifier(ct.TQDispatcher),
+		rm:            run.NewNotifier(ct.TQDispatcher),
+		tjNotifier:    &tryjobNotifierMock{},
+		clUpdater:     &clUpdaterMock{},
+		trainVerifier: &trainVerifierMock{},
 	}
+	reviewers := &codereview.Registry{}
+	reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
 	impl := &Impl{
-		PM:         deps.pm,
-		RM:         deps.rm,
-		TN:         deps.tjNotifier,
-		CLMutator:  changelist.NewMutator(ct.TQDispatcher, deps.pm, deps.rm, nil),
-		CLUpdater:  deps.clUpdater,
-		TreeClient: ct.TreeFake.Client(),
-		GFactory:   ct.GFactory(),
-		BQExporter: bq.NewExporter(ct.TQDispatcher, ct.BQFake, ct.Env),
-		Publisher:  pubsub.NewPublisher(ct.TQDispatcher, ct.Env),
-		Env:        ct.Env,
+		PM:            deps.pm,
+		RM:            deps.rm,
+		TN:            deps.tjNotifier,
+		CLMutator:     changelist.NewMutator(ct.TQDispatcher, deps.pm, deps.rm, nil),
+		CLUpdater:     deps.clUpdater,
+		TreeClient:    ct.TreeFake.Client(),
+		GFactory:      ct.GFactory(),
+		Reviewers:     reviewers,
+		BQExporter:    bq.NewExporter(ct.TQDispatcher, ct.BQFake, ct.Env),
+		Publisher:     pubsub.NewPublisher(ct.TQDispatcher, ct.Env),
+		TrainVerifier: deps.trainVerifier,
+		Env:           ct.Env,
 	}
 	return impl, deps
 }
@@ -519,3 +532,23 @@ func (t *tryjobNotifierMock) ScheduleUpdate(ctx context.Context, id common.Tryjo
 	t.m.Unlock()
 	return nil
 }
+
+type trainVerifierMock struct {
+	m         sync.Mutex
+	verified  []common.RunIDs
+	cancelled common.TryjobIDs
+}
+
+func (t *trainVerifierMock) Verify(ctx context.Context, runs common.RunIDs, opts *cfgpb.SubmitOptions) (common.TryjobID, error) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.verified = append(t.verified, runs)
+	return common.TryjobID(100 + len(t.verified)), nil
+}
+
+func (t *trainVerifierMock) CancelTrain(ctx context.Context, tryjobID common.TryjobID) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.cancelled = append(t.cancelled, tryjobID)
+	return nil
+}
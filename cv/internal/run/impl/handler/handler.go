@@ -18,7 +18,9 @@ import (
 	"context"
 	"time"
 
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/eventbox"
 	"go.chromium.org/luci/cv/internal/common/tree"
@@ -124,18 +126,27 @@ type TryjobNotifier interface {
 	ScheduleUpdate(ctx context.Context, id common.TryjobID, eid tryjob.ExternalID) error
 }
 
+// TrainVerifier encapsulates verification of submit trains by the Run events
+// handler.
+type TrainVerifier interface {
+	Verify(ctx context.Context, runs common.RunIDs, opts *cfgpb.SubmitOptions) (common.TryjobID, error)
+	CancelTrain(ctx context.Context, tryjobID common.TryjobID) error
+}
+
 // Impl is a prod implementation of Handler interface.
 type Impl struct {
-	PM         PM
-	RM         RM
-	TN         TryjobNotifier
-	GFactory   gerrit.Factory
-	CLUpdater  CLUpdater
-	CLMutator  *changelist.Mutator
-	BQExporter *bq.Exporter
-	TreeClient tree.Client
-	Publisher  *pubsub.Publisher
-	Env        *common.Env
+	PM            PM
+	RM            RM
+	TN            TryjobNotifier
+	GFactory      gerrit.Factory
+	Reviewers     *codereview.Registry
+	CLUpdater     CLUpdater
+	CLMutator     *changelist.Mutator
+	BQExporter    *bq.Exporter
+	TreeClient    tree.Client
+	Publisher     *pubsub.Publisher
+	TrainVerifier TrainVerifier
+	Env           *common.Env
 }
 
 var _ Handler = (*Impl)(nil)
@@ -172,7 +172,8 @@ func (impl *Impl) Start(ctx context.Context, rs *state.RunState) (*Result, error
 			txndefer.Defer(ctx, func(ctx context.Context) {
 				reportStartMetrics(ctx, rs, cg)
 			})
-			return nil
+			// See the comment in endRun about the EVersion.
+			return impl.Publisher.RunStarted(ctx, rs.ID, rs.Status, rs.EVersion+1, rs.StartTime)
 		},
 	}, nil
 }
@@ -44,6 +44,7 @@ import (
 	"go.chromium.org/luci/cv/internal/run/impl/state"
 	"go.chromium.org/luci/cv/internal/run/impl/submit"
 	"go.chromium.org/luci/cv/internal/run/impl/util"
+	"go.chromium.org/luci/cv/internal/tryjob"
 )
 
 // OnReadyForSubmission implements Handler interface.
@@ -59,7 +60,7 @@ func (impl *Impl) OnReadyForSubmission(ctx context.Context, rs *state.RunState)
 		// Under certain race conditions, this Run may still occupy the submit
 		// queue. So, check first without a transaction and then initiate a
 		// transaction to release if this Run currently occupies the submit queue.
-		if err := releaseSubmitQueueIfTaken(ctx, rs, impl.RM); err != nil {
+		if err := releaseSubmitQueueIfTaken(ctx, rs, impl.RM, impl.TrainVerifier); err != nil {
 			return nil, err
 		}
 		return &Result{State: rs}, nil
@@ -83,7 +84,7 @@ func (impl *Impl) OnReadyForSubmission(ctx context.Context, rs *state.RunState)
 			panic(fmt.Errorf("impossible; Run %q is in Status_WAITING_FOR_SUBMISSION status but has submitted CLs ", rs.ID))
 		}
 		rs = rs.ShallowCopy()
-		switch waitlisted, err := acquireSubmitQueue(ctx, rs, impl.RM); {
+		switch waitlisted, err := acquireSubmitQueue(ctx, rs, impl.RM, impl.TrainVerifier); {
 		case err != nil:
 			return nil, err
 		case waitlisted:
@@ -113,7 +114,7 @@ func (impl *Impl) OnReadyForSubmission(ctx context.Context, rs *state.RunState)
 				}
 			}
 			scheduleTriggersCancellation(ctx, rs, rims, run.Status_FAILED)
-			if err := releaseSubmitQueue(ctx, rs, impl.RM); err != nil {
+			if err := releaseSubmitQueue(ctx, rs, impl.RM, impl.TrainVerifier); err != nil {
 				return nil, err
 			}
 			return &Result{
@@ -130,7 +131,7 @@ func (impl *Impl) OnReadyForSubmission(ctx context.Context, rs *state.RunState)
 				}
 				work <- func() error {
 					// Give up the Submit Queue while waiting for tree to open.
-					return releaseSubmitQueue(ctx, rs, impl.RM)
+					return releaseSubmitQueue(ctx, rs, impl.RM, impl.TrainVerifier)
 				}
 			})
 			if err != nil {
@@ -141,7 +142,7 @@ func (impl *Impl) OnReadyForSubmission(ctx context.Context, rs *state.RunState)
 			if err := markSubmitting(ctx, rs); err != nil {
 				return nil, err
 			}
-			s := submit.NewSubmitter(ctx, rs.ID, rs.Submission, impl.RM, impl.GFactory)
+			s := submit.NewSubmitter(ctx, rs.ID, rs.Submission, impl.RM, impl.Reviewers)
 			rs.SubmissionScheduled = true
 			return &Result{
 				State:         rs,
@@ -203,7 +204,7 @@ func (impl *Impl) OnSubmissionCompleted(ctx context.Context, rs *state.RunState,
 	case run.IsEnded(status):
 		logging.Warningf(ctx, "received SubmissionCompleted event when Run is %s", status)
 		rs = rs.ShallowCopy()
-		if err := releaseSubmitQueueIfTaken(ctx, rs, impl.RM); err != nil {
+		if err := releaseSubmitQueueIfTaken(ctx, rs, impl.RM, impl.TrainVerifier); err != nil {
 			return nil, err
 		}
 		return &Result{State: rs}, nil
@@ -339,7 +340,7 @@ func (impl *Impl) tryResumeSubmission(ctx context.Context, rs *state.RunState, s
 				return nil, err
 			}
 		}
-		if err := releaseSubmitQueueIfTaken(ctx, rs, impl.RM); err != nil {
+		if err := releaseSubmitQueueIfTaken(ctx, rs, impl.RM, impl.TrainVerifier); err != nil {
 			return nil, err
 		}
 		se := impl.endRun(ctx, rs, status)
@@ -351,7 +352,7 @@ func (impl *Impl) tryResumeSubmission(ctx context.Context, rs *state.RunState, s
 		// Matching taskID indicates current task is the retry of a previous
 		// submitting task that has failed transiently. Continue the submission.
 		rs = rs.ShallowCopy()
-		s := submit.NewSubmitter(ctx, rs.ID, rs.Submission, impl.RM, impl.GFactory)
+		s := submit.NewSubmitter(ctx, rs.ID, rs.Submission, impl.RM, impl.Reviewers)
 		rs.SubmissionScheduled = true
 		return &Result{
 			State:         rs,
@@ -373,7 +374,7 @@ func (impl *Impl) tryResumeSubmission(ctx context.Context, rs *state.RunState, s
 	}
 }
 
-func acquireSubmitQueue(ctx context.Context, rs *state.RunState, rm RM) (waitlisted bool, err error) {
+func acquireSubmitQueue(ctx context.Context, rs *state.RunState, rm RM, tv TrainVerifier) (waitlisted bool, err error) {
 	cg, err := prjcfg.GetConfigGroup(ctx, rs.ID.LUCIProject(), rs.ConfigGroupID)
 	if err != nil {
 		return false, err
@@ -381,7 +382,11 @@ func acquireSubmitQueue(ctx context.Context, rs *state.RunState, rm RM) (waitlis
 	now := clock.Now(ctx).UTC()
 	var innerErr error
 	err = datastore.RunInTransaction(ctx, func(ctx context.Context) error {
-		waitlisted, innerErr = submit.TryAcquire(ctx, rm.NotifyReadyForSubmission, rs.ID, cg.SubmitOptions)
+		if cg.SubmitOptions.GetTrain() != nil {
+			waitlisted, innerErr = submit.TryAcquireTrain(ctx, rm.NotifyReadyForSubmission, tv.Verify, rs.ID, cg.SubmitOptions)
+		} else {
+			waitlisted, innerErr = submit.TryAcquire(ctx, rm.NotifyReadyForSubmission, rs.ID, cg.SubmitOptions)
+		}
 		switch {
 		case innerErr != nil:
 			return innerErr
@@ -421,28 +426,99 @@ func acquireSubmitQueue(ctx context.Context, rs *state.RunState, rm RM) (waitlis
 	}
 }
 
+// onTrainTryjobsUpdated records the outcome of the Tryjob verifying the submit
+// train which this Run is part of, if any.
+//
+// Fails the Run if it turns out to be the culprit of the failed verification.
+func (impl *Impl) onTrainTryjobsUpdated(ctx context.Context, rs *state.RunState, tryjobs common.TryjobIDs) (*Result, error) {
+	train, tryjobID, _, err := submit.LoadTrain(ctx, rs.ID.LUCIProject())
+	switch {
+	case err != nil:
+		return nil, err
+	case tryjobID == 0 || !tryjobs.Contains(tryjobID) || train.Index(rs.ID) == -1:
+		logging.Debugf(ctx, "Ignoring Tryjobs event because Run is waiting for submission")
+		return &Result{State: rs}, nil
+	}
+	tj := &tryjob.Tryjob{ID: tryjobID}
+	if err := datastore.Get(ctx, tj); err != nil {
+		return nil, errors.Annotate(err, "failed to load Tryjob %d", tryjobID).Tag(transient.Tag).Err()
+	}
+	tjName := fmt.Sprintf("%d", tj.ID)
+	if url, err := tj.ExternalID.URL(); err == nil {
+		tjName = url
+	}
+	var passed bool
+	var reason string
+	switch tj.Status {
+	case tryjob.Status_ENDED:
+		passed = tj.Result.GetStatus() == tryjob.Result_SUCCEEDED
+		reason = fmt.Sprintf("Tryjob %s has failed", tjName)
+	case tryjob.Status_CANCELLED:
+		reason = fmt.Sprintf("Tryjob %s has been cancelled", tjName)
+	case tryjob.Status_UNTRIGGERED:
+		reason = tj.UntriggeredReason
+	default:
+		logging.Debugf(ctx, "submit train Tryjob %d is still %s", tryjobID, tj.Status)
+		return &Result{State: rs}, nil
+	}
+
+	var culprit common.RunID
+	var innerErr error
+	err = datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		culprit, innerErr = submit.OnTrainVerified(ctx, impl.RM.NotifyReadyForSubmission, impl.TrainVerifier.Verify, rs.ID.LUCIProject(), tryjobID, passed)
+		return innerErr
+	}, nil)
+	switch {
+	case innerErr != nil:
+		return nil, innerErr
+	case err != nil:
+		return nil, errors.Annotate(err, "failed to record the verification of the submit train").Tag(transient.Tag).Err()
+	case culprit == "":
+		return &Result{State: rs}, nil
+	case culprit != rs.ID:
+		panic(fmt.Errorf("impossible; the culprit %q of submit train Tryjob %d isn't watching it", culprit, tryjobID))
+	}
+
+	rs = rs.ShallowCopy()
+	whoms := rs.Mode.GerritNotifyTargets()
+	meta := reviewInputMeta{
+		notify:         whoms,
+		addToAttention: whoms,
+		reason:         "Submit train failed",
+		message:        "This CL has failed the verification together with the other CLs waiting for submission. Reason:\n\n" + reason,
+	}
+	metas := make(map[common.CLID]reviewInputMeta, len(rs.CLs))
+	for _, cl := range rs.CLs {
+		metas[cl] = meta
+	}
+	scheduleTriggersCancellation(ctx, rs, metas, run.Status_FAILED)
+	return &Result{State: rs}, nil
+}
+
 // releaseSubmitQueueIfTaken checks if submit queue is occupied by the given
 // Run before trying to release.
-func releaseSubmitQueueIfTaken(ctx context.Context, rs *state.RunState, rm RM) error {
+func releaseSubmitQueueIfTaken(ctx context.Context, rs *state.RunState, rm RM, tv TrainVerifier) error {
 	switch current, waitlist, err := submit.LoadCurrentAndWaitlist(ctx, rs.ID); {
 	case err != nil:
 		return err
-	case current == rs.ID:
-		return releaseSubmitQueue(ctx, rs, rm)
-	default:
-		for _, w := range waitlist {
-			if w == rs.ID {
-				return releaseSubmitQueue(ctx, rs, rm)
-			}
-		}
+	case current == rs.ID || waitlist.Index(rs.ID) != -1:
+		return releaseSubmitQueue(ctx, rs, rm, tv)
+	}
+	// Runs which have boarded a submit train occupy the submission slot
+	// without being current.
+	switch _, _, boarded, err := submit.LoadTrain(ctx, rs.ID.LUCIProject()); {
+	case err != nil:
+		return err
+	case boarded.Index(rs.ID) != -1:
+		return releaseSubmitQueue(ctx, rs, rm, tv)
 	}
 	return nil
 }
 
-func releaseSubmitQueue(ctx context.Context, rs *state.RunState, rm RM) error {
+func releaseSubmitQueue(ctx context.Context, rs *state.RunState, rm RM, tv TrainVerifier) error {
 	var innerErr error
 	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
-		innerErr = submit.Release(ctx, rm.NotifyReadyForSubmission, rs.ID)
+		innerErr = submit.Release(ctx, rm.NotifyReadyForSubmission, tv.CancelTrain, rs.ID)
 		return innerErr
 	}, nil)
 	switch {
@@ -271,6 +271,72 @@ func TestOnReadyForSubmission(t *testing.T) {
 				})
 			})
 		}
+
+		Convey("Submit train", func() {
+			cg.SubmitOptions = &cfgpb.SubmitOptions{
+				Train: &cfgpb.SubmitOptions_SubmitTrain{
+					MaxSize: 2,
+					Builder: "l_project/try/submit-train",
+				},
+			}
+			prjcfgtest.Update(ctx, lProject, cg)
+			meta, err := prjcfg.GetLatestMeta(ctx, lProject)
+			So(err, ShouldBeNil)
+			rs.ConfigGroupID = meta.ConfigGroupIDs[0]
+			rs.Status = run.Status_WAITING_FOR_SUBMISSION
+
+			Convey("Verify the train before submitting", func() {
+				res, err := h.OnReadyForSubmission(ctx, rs)
+				So(err, ShouldBeNil)
+				So(res.State.Status, ShouldEqual, run.Status_WAITING_FOR_SUBMISSION)
+				So(res.PostProcessFn, ShouldBeNil)
+				So(res.State.LogEntries, ShouldHaveLength, 1)
+				So(res.State.LogEntries[0].Kind, ShouldHaveSameTypeAs, &run.LogEntry_Waitlisted_{})
+				So(deps.trainVerifier.verified, ShouldResemble, []common.RunIDs{{rid}})
+				train, tryjobID, _, err := submit.LoadTrain(ctx, lProject)
+				So(err, ShouldBeNil)
+				So(train, ShouldResemble, common.RunIDs{rid})
+				So(tryjobID, ShouldEqual, 101)
+
+				Convey("Cancel the train Tryjob once the Run has ended", func() {
+					rs.Status = run.Status_CANCELLED
+					res, err := h.OnReadyForSubmission(ctx, rs)
+					So(err, ShouldBeNil)
+					So(res.State.LogEntries, ShouldHaveLength, 1)
+					So(res.State.LogEntries[0].Kind, ShouldHaveSameTypeAs, &run.LogEntry_ReleasedSubmitQueue_{})
+					So(deps.trainVerifier.cancelled, ShouldResemble, common.TryjobIDs{101})
+					train, tryjobID, _, err := submit.LoadTrain(ctx, lProject)
+					So(err, ShouldBeNil)
+					So(train, ShouldBeEmpty)
+					So(tryjobID, ShouldEqual, 0)
+				})
+			})
+
+			Convey("Submit once the train has passed", func() {
+				_, err := h.OnReadyForSubmission(ctx, rs)
+				So(err, ShouldBeNil)
+				So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+					_, err := submit.OnTrainVerified(ctx, deps.rm.NotifyReadyForSubmission, deps.trainVerifier.Verify, lProject, 101, true)
+					return err
+				}, nil), ShouldBeNil)
+
+				res, err := h.OnReadyForSubmission(ctx, rs)
+				So(err, ShouldBeNil)
+				So(res.State.Status, ShouldEqual, run.Status_SUBMITTING)
+				So(res.PostProcessFn, ShouldNotBeNil)
+
+				Convey("Release the boarded Run once it has ended", func() {
+					rs.Status = run.Status_FAILED
+					res, err := h.OnReadyForSubmission(ctx, rs)
+					So(err, ShouldBeNil)
+					So(res.State.LogEntries, ShouldHaveLength, 1)
+					So(res.State.LogEntries[0].Kind, ShouldHaveSameTypeAs, &run.LogEntry_ReleasedSubmitQueue_{})
+					_, _, boarded, err := submit.LoadTrain(ctx, lProject)
+					So(err, ShouldBeNil)
+					So(boarded, ShouldBeEmpty)
+				})
+			})
+		})
 	})
 }
 
@@ -31,6 +31,7 @@ import (
 	cvbqpb "go.chromium.org/luci/cv/api/bigquery/v1"
 	migrationpb "go.chromium.org/luci/cv/api/migration"
 	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/common/eventbox"
 	"go.chromium.org/luci/cv/internal/migration"
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/eventpb"
@@ -49,9 +50,11 @@ func (impl *Impl) OnTryjobsUpdated(ctx context.Context, rs *state.RunState, tryj
 	switch status := rs.Status; {
 	case run.IsEnded(status):
 		fallthrough
-	case status == run.Status_WAITING_FOR_SUBMISSION || status == run.Status_SUBMITTING:
+	case status == run.Status_SUBMITTING:
 		logging.Debugf(ctx, "Ignoring Tryjobs event because Run is in status %s", status)
 		return &Result{State: rs}, nil
+	case status == run.Status_WAITING_FOR_SUBMISSION:
+		return impl.onTrainTryjobsUpdated(ctx, rs, tryjobs)
 	case status != run.Status_RUNNING:
 		return nil, errors.Reason("expected RUNNING status, got %s", status).Err()
 	case !rs.UseCVTryjobExecutor:
@@ -81,6 +84,7 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 		runStatus       run.Status
 		msg             string
 		attentionReason string
+		publishFn       eventbox.SideEffectFn
 	)
 	switch opCompleted.GetStatus() {
 	case eventpb.LongOpCompleted_EXPIRED:
@@ -99,6 +103,9 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 		case es == nil:
 			panic(fmt.Errorf("impossible; Execute Tryjobs task succeeded but ExecutionState was missing"))
 		default:
+			if !proto.Equal(rs.Tryjobs.GetState(), es) {
+				publishFn = impl.publishTryjobsUpdated(ctx, rs, es)
+			}
 			if rs.Tryjobs == nil {
 				rs.Tryjobs = &run.Tryjobs{}
 			} else {
@@ -108,7 +115,12 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 			switch executionStatus := es.GetStatus(); {
 			case executionStatus == tryjob.ExecutionState_SUCCEEDED && rs.Mode == run.FullRun:
 				rs.Status = run.Status_WAITING_FOR_SUBMISSION
-				return impl.OnReadyForSubmission(ctx, rs)
+				res, err := impl.OnReadyForSubmission(ctx, rs)
+				if err != nil {
+					return nil, err
+				}
+				res.SideEffectFn = eventbox.Chain(publishFn, res.SideEffectFn)
+				return res, nil
 			case executionStatus == tryjob.ExecutionState_SUCCEEDED:
 				runStatus = run.Status_SUCCEEDED
 				switch rs.Mode {
@@ -154,10 +166,23 @@ func (impl *Impl) onCompletedExecuteTryjobs(ctx context.Context, rs *state.RunSt
 	}
 
 	return &Result{
-		State: rs,
+		State:        rs,
+		SideEffectFn: publishFn,
 	}, nil
 }
 
+// publishTryjobsUpdated returns the side effect to publish the updated state
+// of the Tryjobs of the Run.
+func (impl *Impl) publishTryjobsUpdated(ctx context.Context, rs *state.RunState, es *tryjob.ExecutionState) eventbox.SideEffectFn {
+	now := clock.Now(ctx).UTC()
+	// Capture the values now, since the caller keeps modifying rs.
+	// See the comment in endRun about the EVersion.
+	rid, status, eVersion := rs.ID, rs.Status, rs.EVersion+1
+	return func(ctx context.Context) error {
+		return impl.Publisher.TryjobsUpdated(ctx, rid, status, eVersion, now, es)
+	}
+}
+
 // OnCQDTryjobsUpdated implements Handler interface.
 func (impl *Impl) OnCQDTryjobsUpdated(ctx context.Context, rs *state.RunState) (*Result, error) {
 	switch status := rs.Status; {
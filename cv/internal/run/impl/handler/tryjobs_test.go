@@ -34,6 +34,7 @@ import (
 	cvbqpb "go.chromium.org/luci/cv/api/bigquery/v1"
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	migrationpb "go.chromium.org/luci/cv/api/migration"
+	cvpb "go.chromium.org/luci/cv/api/v1"
 	"go.chromium.org/luci/cv/internal/changelist"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
@@ -44,6 +45,8 @@ import (
 	"go.chromium.org/luci/cv/internal/run/eventpb"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
 	"go.chromium.org/luci/cv/internal/run/impl/submit"
+	"go.chromium.org/luci/cv/internal/run/pubsub"
+	"go.chromium.org/luci/cv/internal/run/runtest"
 	"go.chromium.org/luci/cv/internal/tryjob"
 
 	. "github.com/smartystreets/goconvey/convey"
@@ -72,7 +75,7 @@ func TestOnTryjobsUpdated(t *testing.T) {
 				UseCVTryjobExecutor: true,
 			},
 		}
-		h, _ := makeTestHandler(&ct)
+		h, deps := makeTestHandler(&ct)
 
 		Convey("Enqueue longop", func() {
 			res, err := h.OnTryjobsUpdated(ctx, rs, common.MakeTryjobIDs(456, 789, 456))
@@ -126,6 +129,93 @@ func TestOnTryjobsUpdated(t *testing.T) {
 			So(res.SideEffectFn, ShouldBeNil)
 			So(res.PreserveEvents, ShouldBeFalse)
 		})
+
+		Convey("Submit train", func() {
+			rs.Status = run.Status_WAITING_FOR_SUBMISSION
+			rs.Mode = run.FullRun
+			opts := &cfgpb.SubmitOptions{
+				Train: &cfgpb.SubmitOptions_SubmitTrain{
+					MaxSize: 2,
+					Builder: "infra/try/submit-train",
+				},
+			}
+			So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+				waitlisted, err := submit.TryAcquireTrain(ctx, deps.rm.NotifyReadyForSubmission, deps.trainVerifier.Verify, rid, opts)
+				So(waitlisted, ShouldBeTrue)
+				return err
+			}, nil), ShouldBeNil)
+			So(deps.trainVerifier.verified, ShouldResemble, []common.RunIDs{{rid}})
+			tj := &tryjob.Tryjob{
+				ID:         101,
+				ExternalID: tryjob.MustBuildbucketID("bb.example.com", 1),
+				Status:     tryjob.Status_TRIGGERED,
+			}
+			So(datastore.Put(ctx, tj), ShouldBeNil)
+
+			Convey("Noop if the Tryjob doesn't verify the train", func() {
+				res, err := h.OnTryjobsUpdated(ctx, rs, common.TryjobIDs{123})
+				So(err, ShouldBeNil)
+				So(res.State, ShouldEqual, rs)
+				_, tryjobID, _, err := submit.LoadTrain(ctx, lProject)
+				So(err, ShouldBeNil)
+				So(tryjobID, ShouldEqual, 101)
+			})
+
+			Convey("Noop if the Tryjob hasn't ended", func() {
+				res, err := h.OnTryjobsUpdated(ctx, rs, common.TryjobIDs{101})
+				So(err, ShouldBeNil)
+				So(res.State, ShouldEqual, rs)
+				_, tryjobID, _, err := submit.LoadTrain(ctx, lProject)
+				So(err, ShouldBeNil)
+				So(tryjobID, ShouldEqual, 101)
+			})
+
+			Convey("Passed", func() {
+				tj.Status = tryjob.Status_ENDED
+				tj.Result = &tryjob.Result{Status: tryjob.Result_SUCCEEDED}
+				So(datastore.Put(ctx, tj), ShouldBeNil)
+				res, err := h.OnTryjobsUpdated(ctx, rs, common.TryjobIDs{101})
+				So(err, ShouldBeNil)
+				So(res.State, ShouldEqual, rs)
+				train, _, boarded, err := submit.LoadTrain(ctx, lProject)
+				So(err, ShouldBeNil)
+				So(train, ShouldBeEmpty)
+				So(boarded, ShouldResemble, common.RunIDs{rid})
+				runtest.AssertReceivedReadyForSubmission(ctx, rid, now)
+			})
+
+			Convey("Failed", func() {
+				tj.Status = tryjob.Status_ENDED
+				tj.Result = &tryjob.Result{Status: tryjob.Result_FAILED_PERMANENTLY}
+				So(datastore.Put(ctx, tj), ShouldBeNil)
+				res, err := h.OnTryjobsUpdated(ctx, rs, common.TryjobIDs{101})
+				So(err, ShouldBeNil)
+				So(res.State.Status, ShouldEqual, run.Status_WAITING_FOR_SUBMISSION)
+				So(res.State.OngoingLongOps.GetOps(), ShouldHaveLength, 1)
+				whoms := []run.OngoingLongOps_Op_TriggersCancellation_Whom{
+					run.OngoingLongOps_Op_TriggersCancellation_OWNER,
+					run.OngoingLongOps_Op_TriggersCancellation_CQ_VOTERS,
+				}
+				for _, op := range res.State.OngoingLongOps.GetOps() {
+					So(op.GetCancelTriggers().GetRequests(), ShouldResembleProto, []*run.OngoingLongOps_Op_TriggersCancellation_Request{
+						{
+							Clid:                 1,
+							Message:              "This CL has failed the verification together with the other CLs waiting for submission. Reason:\n\nTryjob https://bb.example.com/build/1 has failed",
+							Notify:               whoms,
+							AddToAttention:       whoms,
+							AddToAttentionReason: "Submit train failed",
+						},
+					})
+					So(op.GetCancelTriggers().GetRunStatusIfSucceeded(), ShouldEqual, run.Status_FAILED)
+				}
+				train, _, _, err := submit.LoadTrain(ctx, lProject)
+				So(err, ShouldBeNil)
+				So(train, ShouldBeEmpty)
+				_, waitlist, err := submit.LoadCurrentAndWaitlist(ctx, rid)
+				So(err, ShouldBeNil)
+				So(waitlist, ShouldBeEmpty)
+			})
+		})
 	})
 }
 
@@ -231,8 +321,26 @@ func TestOnCompletedExecuteTryjobs(t *testing.T) {
 					},
 				})
 				So(res.State.OngoingLongOps, ShouldBeNil)
-				So(res.SideEffectFn, ShouldBeNil)
+				So(res.SideEffectFn, ShouldNotBeNil)
 				So(res.PreserveEvents, ShouldBeFalse)
+				So(datastore.RunInTransaction(ctx, res.SideEffectFn, nil), ShouldBeNil)
+				So(ct.TQ.Tasks(), ShouldHaveLength, 1)
+				So(ct.TQ.Tasks()[0].Class, ShouldEqual, "v1-publish-run-event")
+			})
+
+			Convey("Tryjob execution state unchanged", func() {
+				es := &tryjob.ExecutionState{
+					Status: tryjob.ExecutionState_RUNNING,
+				}
+				err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+					return tryjob.SaveExecutionState(ctx, rs.ID, es, 0, nil)
+				}, nil)
+				So(err, ShouldBeNil)
+				rs.Tryjobs = &run.Tryjobs{State: es}
+				res, err := h.OnLongOpCompleted(ctx, rs, result)
+				So(err, ShouldBeNil)
+				So(res.State.Status, ShouldEqual, run.Status_RUNNING)
+				So(res.SideEffectFn, ShouldBeNil)
 			})
 
 			Convey("Tryjob execution succeeds", func() {
@@ -256,8 +364,19 @@ func TestOnCompletedExecuteTryjobs(t *testing.T) {
 					So(res.State.OngoingLongOps, ShouldBeNil)
 					So(res.State.Submission, ShouldNotBeNil)
 					So(submit.MustCurrentRun(ctx, lProject), ShouldEqual, rs.ID)
-					So(res.SideEffectFn, ShouldBeNil)
+					So(res.SideEffectFn, ShouldNotBeNil)
 					So(res.PreserveEvents, ShouldBeFalse)
+
+					// The event is published with the status before the update.
+					So(datastore.RunInTransaction(ctx, res.SideEffectFn, nil), ShouldBeNil)
+					var event *pubsub.PublishRunEventTask
+					for _, t := range ct.TQ.Tasks() {
+						if p, ok := t.Payload.(*pubsub.PublishRunEventTask); ok {
+							event = p
+						}
+					}
+					So(event.GetEvent().GetType(), ShouldEqual, cvpb.PubSubRunEvent_TRYJOBS_UPDATED)
+					So(event.GetEvent().GetRun().GetStatus(), ShouldEqual, cvpb.Run_RUNNING)
 				})
 				Convey("Dry Run", func() {
 					rs.Mode = run.DryRun
@@ -289,7 +408,7 @@ func TestOnCompletedExecuteTryjobs(t *testing.T) {
 						})
 						So(op.GetCancelTriggers().GetRunStatusIfSucceeded(), ShouldEqual, run.Status_SUCCEEDED)
 					}
-					So(res.SideEffectFn, ShouldBeNil)
+					So(res.SideEffectFn, ShouldNotBeNil)
 					So(res.PreserveEvents, ShouldBeFalse)
 				})
 				Convey("New Patchset Run, no message is posted", func() {
@@ -310,7 +429,7 @@ func TestOnCompletedExecuteTryjobs(t *testing.T) {
 						})
 						So(op.GetCancelTriggers().GetRunStatusIfSucceeded(), ShouldEqual, run.Status_SUCCEEDED)
 					}
-					So(res.SideEffectFn, ShouldBeNil)
+					So(res.SideEffectFn, ShouldNotBeNil)
 					So(res.PreserveEvents, ShouldBeFalse)
 				})
 			})
@@ -361,7 +480,7 @@ func TestOnCompletedExecuteTryjobs(t *testing.T) {
 					})
 					So(op.GetCancelTriggers().GetRunStatusIfSucceeded(), ShouldEqual, run.Status_FAILED)
 				}
-				So(res.SideEffectFn, ShouldBeNil)
+				So(res.SideEffectFn, ShouldNotBeNil)
 				So(res.PreserveEvents, ShouldBeFalse)
 			})
 		})
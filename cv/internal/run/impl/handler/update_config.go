@@ -27,10 +27,10 @@ import (
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
 
+	"go.chromium.org/luci/cv/internal/changelist"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/gerrit/cfgmatcher"
-	"go.chromium.org/luci/cv/internal/gerrit/trigger"
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
 	"go.chromium.org/luci/cv/internal/tryjob/requirement"
@@ -104,11 +104,12 @@ func (impl *Impl) UpdateConfig(ctx context.Context, rs *state.RunState, hash str
 			unwatched = append(unwatched, cl)
 		case 1:
 			cgid := cgids[0]
-			tr := trigger.Find(&trigger.FindInput{
-				ChangeInfo:                   cl.Detail.GetGerrit().GetInfo(),
-				ConfigGroup:                  cgsMap[cgid.Name()].Content,
+			tr := impl.Reviewers.FindTriggers(&changelist.CL{
+				ID:                           cl.ID,
+				ExternalID:                   cl.ExternalID,
+				Snapshot:                     cl.Detail,
 				TriggerNewPatchsetRunAfterPS: cl.Detail.Patchset - 1,
-			})
+			}, cgsMap[cgid.Name()].Content)
 			if whatChanged := run.HasTriggerChanged(cl.Trigger, tr, cl.ExternalID.MustURL()); whatChanged != "" {
 				diffTrigger = append(diffTrigger, cl)
 			} else {
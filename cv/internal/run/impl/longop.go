@@ -32,6 +32,7 @@ import (
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/eventpb"
 	"go.chromium.org/luci/cv/internal/run/impl/longops"
+	"go.chromium.org/luci/cv/internal/tryjob/flakiness"
 )
 
 // enqueueLongOp enqueues long operations task and updates the given Run's long
@@ -132,14 +133,16 @@ func (rm *RunManager) doLongOperationWithDeadline(ctx context.Context, opBase *l
 	switch w := opBase.Op.GetWork().(type) {
 	case *run.OngoingLongOps_Op_PostStartMessage:
 		op = &longops.PostStartMessageOp{
-			Base:     opBase,
-			Env:      rm.env,
-			GFactory: rm.gFactory,
+			Base:      opBase,
+			Env:       rm.env,
+			GFactory:  rm.gFactory,
+			Reviewers: rm.reviewers,
 		}
 	case *run.OngoingLongOps_Op_CancelTriggers:
 		op = &longops.CancelTriggersOp{
 			Base:              opBase,
 			GFactory:          rm.gFactory,
+			Reviewers:         rm.reviewers,
 			CLMutator:         rm.clMutator,
 			CancelConcurrency: 8,
 		}
@@ -151,6 +154,7 @@ func (rm *RunManager) doLongOperationWithDeadline(ctx context.Context, opBase *l
 			Backend: &bbfacade.Facade{
 				ClientFactory: rm.bbFactory,
 			},
+			Flakiness: flakiness.NewChecker(rm.bbFactory, rm.env.LUCIAnalysisHost),
 		}
 	default:
 		logging.Errorf(ctx, "unknown LongOp work %T", w)
@@ -34,6 +34,7 @@ import (
 	"go.chromium.org/luci/cv/internal/run/impl/longops"
 	"go.chromium.org/luci/cv/internal/run/impl/state"
 	"go.chromium.org/luci/cv/internal/run/runtest"
+	"go.chromium.org/luci/cv/internal/tryjob"
 
 	. "github.com/smartystreets/goconvey/convey"
 	"go.chromium.org/luci/common/retry/transient"
@@ -103,7 +104,7 @@ func TestLongOps(t *testing.T) {
 		})
 
 		Convey("manager handles Long Operation TQ task", func() {
-			manager := New(notifier, nil, nil, nil, nil, nil, nil, nil, nil, ct.Env)
+			manager := New(notifier, nil, tryjob.NewNotifier(ct.TQDispatcher), nil, nil, nil, nil, nil, nil, nil, ct.Env)
 
 			Convey("OK", func() {
 				called := false
@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/common/sync/dispatcher/buffer"
 
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/lease"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
@@ -42,6 +43,9 @@ import (
 
 // CancelTriggersOp cancels the triggers for the provided CLs.
 //
+// It also reports the final status of the Run via the code review system of
+// each CL whose triggers were cancelled.
+//
 // CancelTriggersOp keeps retrying on lease error and transient failure for each
 // CL till the long op deadline is exceeded or cancellation either succeeds
 // or fails non-transiently.
@@ -54,6 +58,7 @@ import (
 type CancelTriggersOp struct {
 	*Base
 	GFactory  gerrit.Factory
+	Reviewers *codereview.Registry
 	CLMutator *changelist.Mutator
 	// CancelConcurrency is the number of CLs that will be cancelled concurrently.
 	//
@@ -64,6 +69,7 @@ type CancelTriggersOp struct {
 
 	inputs  []cancel.Input
 	results []cancelResult
+	runCLs  map[common.CLID]*run.RunCL
 
 	// testAfterTryCancelFn is always called after each try to cancel the trigger
 	// of a CL.
@@ -83,6 +89,7 @@ func (op *CancelTriggersOp) Do(ctx context.Context) (*eventpb.LongOpCompleted, e
 	}
 
 	op.executeInParallel(ctx)
+	op.reportStatuses(ctx)
 
 	longOpStatus := eventpb.LongOpCompleted_SUCCEEDED // be optimistic
 	ct := &eventpb.LongOpCompleted_CancelTriggers{
@@ -168,9 +175,11 @@ func (op *CancelTriggersOp) loadInputs(ctx context.Context) error {
 			return err
 		}
 		triggers = make(map[common.CLID]*run.Triggers, len(runCLs))
+		op.runCLs = make(map[common.CLID]*run.RunCL, len(runCLs))
 		allRunCLExternalIDs = make([]changelist.ExternalID, len(runCLs))
 		for i, runCL := range runCLs {
 			triggers[runCL.ID] = triggers[runCL.ID].WithTrigger(runCL.Trigger)
+			op.runCLs[runCL.ID] = runCL
 			allRunCLExternalIDs[i] = runCL.ExternalID
 		}
 		return nil
@@ -210,6 +219,28 @@ func (op *CancelTriggersOp) loadInputs(ctx context.Context) error {
 	return nil
 }
 
+// reportStatuses reports the final status of the Run on the CLs whose triggers
+// were cancelled successfully.
+//
+// The status is informational only, so failures are logged but not returned.
+func (op *CancelTriggersOp) reportStatuses(ctx context.Context) {
+	state := codereview.StateFailure
+	if op.Op.GetCancelTriggers().GetRunStatusIfSucceeded() == run.Status_SUCCEEDED {
+		state = codereview.StateSuccess
+	}
+	luciProject := op.Run.ID.LUCIProject()
+	for i, req := range op.Op.GetCancelTriggers().GetRequests() {
+		if op.results[i].err != nil {
+			continue
+		}
+		rcl := op.runCLs[common.CLID(req.GetClid())]
+		st := &codereview.Status{State: state, Message: req.GetMessage()}
+		if err := op.Reviewers.ReportStatus(ctx, luciProject, rcl, st); err != nil {
+			logging.Warningf(ctx, "failed to report the final status on CL %d %q: %s", rcl.ID, rcl.ExternalID, err)
+		}
+	}
+}
+
 func convertToGerritWhoms(whoms []run.OngoingLongOps_Op_TriggersCancellation_Whom) gerrit.Whoms {
 	ret := make(gerrit.Whoms, len(whoms))
 	for i, whom := range whoms {
@@ -27,6 +27,7 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/lease"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
@@ -105,6 +106,10 @@ func TestCancelTriggers(t *testing.T) {
 			return r, clids
 		}
 
+		statuses := &statusRecorder{Provider: codereview.NewGerritProvider(ct.GFactory())}
+		reviewers := &codereview.Registry{}
+		reviewers.Register(statuses)
+
 		makeOp := func(r *run.Run) *CancelTriggersOp {
 			reqs := make([]*run.OngoingLongOps_Op_TriggersCancellation_Request, len(r.CLs))
 			for i, clid := range r.CLs {
@@ -138,6 +143,7 @@ func TestCancelTriggers(t *testing.T) {
 					Run:               r,
 				},
 				GFactory:  ct.GFactory(),
+				Reviewers: reviewers,
 				CLMutator: mutator,
 			}
 		}
@@ -241,6 +247,11 @@ func TestCancelTriggers(t *testing.T) {
 				}
 				So(result.ExternalId, ShouldNotBeEmpty)
 			}
+			So(statuses.get(clids[0]), ShouldResemble, []codereview.Status{{
+				State:   codereview.StateFailure,
+				Message: fmt.Sprintf("cancel message for CL %d", clids[0]),
+			}})
+			So(statuses.get(clids[1]), ShouldBeEmpty)
 		})
 
 		Convey("Doesn't obey long op cancellation", func() {
@@ -32,6 +32,7 @@ type ExecuteTryjobsOp struct {
 	Env         *common.Env
 	RunNotifier *run.Notifier
 	Backend     execute.TryjobBackend
+	Flakiness   execute.FlakinessChecker
 }
 
 // Do implements Operation interface.
@@ -41,6 +42,7 @@ func (op *ExecuteTryjobsOp) Do(ctx context.Context) (*eventpb.LongOpCompleted, e
 		Backend:    op.Backend,
 		RM:         op.RunNotifier,
 		ShouldStop: op.IsCancelRequested,
+		Flakiness:  op.Flakiness,
 	}
 	switch err := executor.Do(ctx, op.Run, op.Op.GetExecuteTryjobs()); {
 	case err == nil:
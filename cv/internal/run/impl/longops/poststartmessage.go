@@ -30,6 +30,7 @@ import (
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/common/sync/parallel"
 
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg"
 	"go.chromium.org/luci/cv/internal/gerrit"
@@ -47,13 +48,17 @@ var notPosted time.Time
 
 // PostStartMessageOp posts a start message on each of the Run CLs.
 //
+// It also reports the pending status of the Run via the code review system of
+// each CL.
+//
 // PostStartMessageOp is a single-use object.
 type PostStartMessageOp struct {
 	// All public fields must be set.
 
 	*Base
-	GFactory gerrit.Factory
-	Env      *common.Env
+	GFactory  gerrit.Factory
+	Reviewers *codereview.Registry
+	Env       *common.Env
 
 	// These private fields are set internally as implementation details.
 
@@ -166,14 +171,23 @@ func (op *PostStartMessageOp) prepare(ctx context.Context) error {
 }
 
 func (op *PostStartMessageOp) doCL(ctx context.Context, rcl *run.RunCL) (time.Time, error) {
-	if rcl.Detail.GetGerrit() == nil {
-		panic(fmt.Errorf("CL %d is not a Gerrit CL", rcl.ID))
-	}
-
 	if op.IsCancelRequested() {
 		return notPosted, errCancelHonored
 	}
 
+	st := &codereview.Status{
+		State:   codereview.StatePending,
+		Message: usertext.OnRunStarted(op.Run.Mode),
+		URL:     fmt.Sprintf("%s/ui/run/%s", op.Env.HTTPAddressBase, op.Run.ID),
+	}
+	if err := op.Reviewers.ReportStatus(ctx, op.Run.ID.LUCIProject(), rcl, st); err != nil {
+		return notPosted, errors.Annotate(err, "failed to report the pending status").Err()
+	}
+	if rcl.Detail.GetGerrit() == nil {
+		// The start message is specific to Gerrit.
+		return clock.Now(ctx).Truncate(time.Second), nil
+	}
+
 	queryOpts := []gerritpb.QueryOption{gerritpb.QueryOption_MESSAGES}
 	switch postedAt, err := util.IsActionTakenOnGerritCL(ctx, op.GFactory, rcl, queryOpts, op.hasStartMessagePosted); {
 	case err != nil:
@@ -15,7 +15,9 @@
 package longops
 
 import (
+	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
 	"go.chromium.org/luci/cv/internal/configs/validation"
@@ -139,6 +142,10 @@ func TestPostStartMessage(t *testing.T) {
 			return r
 		}
 
+		statuses := &statusRecorder{Provider: codereview.NewGerritProvider(ct.GFactory())}
+		reviewers := &codereview.Registry{}
+		reviewers.Register(statuses)
+
 		makeOp := func(r *run.Run) *PostStartMessageOp {
 			return &PostStartMessageOp{
 				Base: &Base{
@@ -152,8 +159,9 @@ func TestPostStartMessage(t *testing.T) {
 					IsCancelRequested: func() bool { return false },
 					Run:               r,
 				},
-				Env:      ct.Env,
-				GFactory: ct.GFactory(),
+				Env:       ct.Env,
+				GFactory:  ct.GFactory(),
+				Reviewers: reviewers,
 			}
 		}
 
@@ -182,6 +190,11 @@ func TestPostStartMessage(t *testing.T) {
 			So(ci, gf.ShouldLastMessageContain, "Bot data:")
 			// Should post exactly one message.
 			So(ci.GetMessages(), ShouldHaveLength, 1)
+			So(statuses.get(common.CLID(clidOf(gChange1))), ShouldResemble, []codereview.Status{{
+				State:   codereview.StatePending,
+				Message: "CV is trying the patch.",
+				URL:     "https://luci-change-verifier.appspot.com/ui/run/chromeos/777-1-deadbeef",
+			}})
 
 			// Recorded timestamp must be approximately correct.
 			So(res.GetPostStartMessage().GetTime().AsTime(), ShouldHappenWithin, time.Second, ci.GetMessages()[0].GetDate().AsTime())
@@ -315,3 +328,27 @@ func TestPostStartMessage(t *testing.T) {
 		})
 	})
 }
+
+// statusRecorder records the statuses reported via the wrapped Provider.
+type statusRecorder struct {
+	codereview.Provider
+
+	m        sync.Mutex
+	statuses map[common.CLID][]codereview.Status
+}
+
+func (r *statusRecorder) ReportStatus(ctx context.Context, luciProject string, rcl *run.RunCL, st *codereview.Status) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.statuses == nil {
+		r.statuses = make(map[common.CLID][]codereview.Status, 1)
+	}
+	r.statuses[rcl.ID] = append(r.statuses[rcl.ID], *st)
+	return r.Provider.ReportStatus(ctx, luciProject, rcl, st)
+}
+
+func (r *statusRecorder) get(clid common.CLID) []codereview.Status {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.statuses[clid]
+}
@@ -31,7 +31,9 @@ import (
 	"go.chromium.org/luci/server/tq"
 
 	"go.chromium.org/luci/cv/internal/buildbucket"
+	bbfacade "go.chromium.org/luci/cv/internal/buildbucket/facade"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/bq"
 	"go.chromium.org/luci/cv/internal/common/eventbox"
@@ -68,6 +70,7 @@ type RunManager struct {
 	tqDispatcher *tq.Dispatcher
 	env          *common.Env
 	gFactory     gerrit.Factory
+	reviewers    *codereview.Registry
 	bbFactory    buildbucket.ClientFactory
 	handler      handler.Handler
 
@@ -82,6 +85,7 @@ func New(
 	clm *changelist.Mutator,
 	clu *changelist.Updater,
 	g gerrit.Factory,
+	reviewers *codereview.Registry,
 	bb buildbucket.ClientFactory,
 	tc tree.Client,
 	bqc bq.Client,
@@ -94,18 +98,21 @@ func New(
 		tqDispatcher: n.TasksBinding.TQDispatcher,
 		env:          env,
 		gFactory:     g,
+		reviewers:    reviewers,
 		bbFactory:    bb,
 		handler: &handler.Impl{
-			PM:         pm,
-			RM:         n,
-			TN:         tn,
-			CLUpdater:  clu,
-			CLMutator:  clm,
-			BQExporter: runbq.NewExporter(n.TasksBinding.TQDispatcher, bqc, env),
-			GFactory:   g,
-			TreeClient: tc,
-			Publisher:  pubsub.NewPublisher(n.TasksBinding.TQDispatcher, env),
-			Env:        env,
+			PM:            pm,
+			RM:            n,
+			TN:            tn,
+			CLUpdater:     clu,
+			CLMutator:     clm,
+			BQExporter:    runbq.NewExporter(n.TasksBinding.TQDispatcher, bqc, env),
+			GFactory:      g,
+			Reviewers:     reviewers,
+			TreeClient:    tc,
+			Publisher:     pubsub.NewPublisher(n.TasksBinding.TQDispatcher, env),
+			TrainVerifier: submit.NewTrainVerifier(tn, n, &bbfacade.Facade{ClientFactory: bb}),
+			Env:           env,
 		},
 	}
 	n.TasksBinding.ManageRun.AttachHandler(
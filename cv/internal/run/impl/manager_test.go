@@ -29,6 +29,7 @@ import (
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/common/eventbox"
 	"go.chromium.org/luci/cv/internal/configs/prjcfg/prjcfgtest"
@@ -71,7 +72,9 @@ func TestRunManager(t *testing.T) {
 		tjNotifier := tryjob.NewNotifier(ct.TQDispatcher)
 		clMutator := changelist.NewMutator(ct.TQDispatcher, pm, notifier, tjNotifier)
 		clUpdater := changelist.NewUpdater(ct.TQDispatcher, clMutator)
-		_ = New(notifier, pm, tjNotifier, clMutator, clUpdater, ct.GFactory(), ct.BuildbucketFake.NewClientFactory(), ct.TreeFake.Client(), ct.BQFake, ct.Env)
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+		_ = New(notifier, pm, tjNotifier, clMutator, clUpdater, ct.GFactory(), reviewers, ct.BuildbucketFake.NewClientFactory(), ct.TreeFake.Client(), ct.BQFake, ct.Env)
 
 		// sorted by the order of execution.
 		eventTestcases := []struct {
@@ -423,7 +426,9 @@ func TestRunManager(t *testing.T) {
 		tjNotifier := tryjob.NewNotifier(ct.TQDispatcher)
 		clMutator := changelist.NewMutator(ct.TQDispatcher, pm, notifier, tjNotifier)
 		clUpdater := changelist.NewUpdater(ct.TQDispatcher, clMutator)
-		_ = New(notifier, pm, tjNotifier, clMutator, clUpdater, ct.GFactory(), ct.BuildbucketFake.NewClientFactory(), ct.TreeFake.Client(), ct.BQFake, ct.Env)
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+		_ = New(notifier, pm, tjNotifier, clMutator, clUpdater, ct.GFactory(), reviewers, ct.BuildbucketFake.NewClientFactory(), ct.TreeFake.Client(), ct.BQFake, ct.Env)
 
 		Convey("Recursive", func() {
 			So(notifier.PokeNow(ctx, runID), ShouldBeNil)
@@ -50,6 +50,26 @@ type queue struct {
 	//
 	// Sorted in ascending order.
 	History []time.Time `gae:",noindex"`
+	// Train are the Runs from the head of the waitlist which are currently
+	// being verified together on a speculative combined tryjob.
+	//
+	// Only used if `Opts.Train` is set. See TryAcquireTrain.
+	Train common.RunIDs `gae:",noindex"`
+	// TrainTryjob is the Tryjob verifying the Runs in Train.
+	//
+	// Only used if `Opts.Train` is set. See TryAcquireTrain.
+	TrainTryjob common.TryjobID `gae:",noindex"`
+	// Boarded are the Runs whose combination has passed the verification and
+	// which are submitting concurrently in place of the current Run.
+	//
+	// Only used if `Opts.Train` is set. See TryAcquireTrain.
+	Boarded common.RunIDs `gae:",noindex"`
+}
+
+// busy returns true if some Run occupies the submission slot or a submit train
+// is in progress.
+func (q *queue) busy() bool {
+	return q.Current != "" || len(q.Train) > 0 || len(q.Boarded) > 0
 }
 
 // nextSubmissionETA computes the eta of when next submission can happen based
@@ -137,9 +157,13 @@ func tryAcquire(ctx context.Context, notifyFn NotifyFn, runID common.RunID, opts
 	}
 
 	switch waitlistIdx := q.Waitlist.Index(runID); {
-	case q.Current == runID:
+	case q.Current == runID || q.Boarded.Index(runID) != -1:
 		waitlisted = false
-	case waitlistIdx > 0 || (q.Current != "" && waitlistIdx == 0):
+	case waitlistIdx > 0 || (q.busy() && waitlistIdx == 0):
+		waitlisted = true
+	case q.busy() && waitlistIdx == -1:
+		q.Waitlist = append(q.Waitlist, runID)
+		shouldSave = true
 		waitlisted = true
 	case q.Current == "" && len(q.Waitlist) == 0:
 		// Queue is completely empty and waitlistIdx == -1.
@@ -179,15 +203,17 @@ func tryAcquire(ctx context.Context, notifyFn NotifyFn, runID common.RunID, opts
 //
 // If the provided Run occupies the current slot, give it up and notify the
 // first Run in the waitlist is ready for submission.
-// If the provided Run is in waitlist, remove it from the waitlist.
+// If the provided Run is in waitlist, remove it from the waitlist. If it was
+// also in the submit train being verified, the train is reset and its Tryjob
+// is cancelled via `cancelFn`.
 // If the provided Run is not present in the submit queue, no-op.
 //
 // MUST be called in a datastore transaction.
-func Release(ctx context.Context, notifyFn NotifyFn, runID common.RunID) error {
+func Release(ctx context.Context, notifyFn NotifyFn, cancelFn CancelTrainFn, runID common.RunID) error {
 	if datastore.CurrentTransaction(ctx) == nil {
 		panic("Release must be called in a datastore transaction")
 	}
-	return release(ctx, notifyFn, runID, time.Time{})
+	return release(ctx, notifyFn, cancelFn, runID, time.Time{})
 }
 
 // ReleaseOnSuccess, in addition to releasing the slot like `Release`, also
@@ -205,10 +231,12 @@ func ReleaseOnSuccess(ctx context.Context, notifyFn NotifyFn, runID common.RunID
 	case submittedAt.IsZero():
 		panic("zero submittedAt timestamp")
 	}
-	return release(ctx, notifyFn, runID, submittedAt)
+	// The submitting Run has either taken the current slot or boarded a
+	// verified train, so it can't be in the train being verified.
+	return release(ctx, notifyFn, nil, runID, submittedAt)
 }
 
-func release(ctx context.Context, notifyFn NotifyFn, runID common.RunID, submittedAt time.Time) error {
+func release(ctx context.Context, notifyFn NotifyFn, cancelFn CancelTrainFn, runID common.RunID, submittedAt time.Time) error {
 	q, err := loadQueue(ctx, runID.LUCIProject())
 	if err != nil {
 		return err
@@ -217,21 +245,34 @@ func release(ctx context.Context, notifyFn NotifyFn, runID common.RunID, submitt
 	switch waitlistIdx := q.Waitlist.Index(runID); {
 	case waitlistIdx != -1:
 		q.Waitlist = append(q.Waitlist[:waitlistIdx], q.Waitlist[waitlistIdx+1:]...)
+		if q.Train.Index(runID) != -1 {
+			// The result of the ongoing verification no longer applies to the
+			// remaining Runs. The next train will be formed from the waitlist.
+			logging.Debugf(ctx, "%q left the submit train %s", runID, q.Train)
+			switch {
+			case q.TrainTryjob == 0:
+			case cancelFn == nil:
+				logging.Errorf(ctx, "can't cancel Tryjob %d verifying the submit train %s", q.TrainTryjob, q.Train)
+			default:
+				if err := cancelFn(ctx, q.TrainTryjob); err != nil {
+					return err
+				}
+			}
+			q.Train, q.TrainTryjob = nil, 0
+		}
 		if !submittedAt.IsZero() {
 			logging.Warningf(ctx, "%q has submitted at %s, but it's no longer current (%q)", runID, submittedAt, q.Current)
 		}
 	case q.Current == runID:
 		q.Current = ""
-		if !submittedAt.IsZero() && q.Opts.GetBurstDelay().AsDuration() > 0 {
-			q.History = append(q.History, submittedAt.UTC())
-			// Make sure the newly added ts is at the right position s.t. history is
-			// sorted.
-			sort.Slice(q.History, func(i, j int) bool { return q.History[i].Before(q.History[j]) })
-			// Cleanup early timestamps that are no longer relevant.
-			cutoff := clock.Now(ctx).Add(-1 * q.Opts.GetBurstDelay().AsDuration())
-			for len(q.History) > 0 && q.History[0].Before(cutoff) {
-				q.History = q.History[1:]
-			}
+		if !submittedAt.IsZero() {
+			q.recordSubmission(ctx, submittedAt)
+		}
+	case q.Boarded.Index(runID) != -1:
+		idx := q.Boarded.Index(runID)
+		q.Boarded = append(q.Boarded[:idx], q.Boarded[idx+1:]...)
+		if !submittedAt.IsZero() {
+			q.recordSubmission(ctx, submittedAt)
 		}
 	default:
 		if !submittedAt.IsZero() {
@@ -244,7 +285,7 @@ func release(ctx context.Context, notifyFn NotifyFn, runID common.RunID, submitt
 		return errors.Annotate(err, "failed to put SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
 	}
 
-	if q.Current == "" && len(q.Waitlist) > 0 {
+	if !q.busy() && len(q.Waitlist) > 0 {
 		if err := notifyFn(ctx, q.Waitlist[0], q.nextSubmissionETA(clock.Now(ctx))); err != nil {
 			return err
 		}
@@ -252,6 +293,23 @@ func release(ctx context.Context, notifyFn NotifyFn, runID common.RunID, submitt
 	return nil
 }
 
+// recordSubmission records the timestamp of a successful submission in the
+// history if rate limiting is enabled.
+func (q *queue) recordSubmission(ctx context.Context, submittedAt time.Time) {
+	if q.Opts.GetBurstDelay().AsDuration() <= 0 {
+		return
+	}
+	q.History = append(q.History, submittedAt.UTC())
+	// Make sure the newly added ts is at the right position s.t. history is
+	// sorted.
+	sort.Slice(q.History, func(i, j int) bool { return q.History[i].Before(q.History[j]) })
+	// Cleanup early timestamps that are no longer relevant.
+	cutoff := clock.Now(ctx).Add(-1 * q.Opts.GetBurstDelay().AsDuration())
+	for len(q.History) > 0 && q.History[0].Before(cutoff) {
+		q.History = q.History[1:]
+	}
+}
+
 // CurrentRun returns the RunID that is currently submitting in the submit queue
 // of the provided LUCI Project.
 func CurrentRun(ctx context.Context, luciProject string) (common.RunID, error) {
@@ -134,7 +134,7 @@ func TestQueue(t *testing.T) {
 			mustRelease := func(ctx context.Context, runID common.RunID) {
 				var innerErr error
 				err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
-					innerErr = Release(ctx, notifier.notify, runID)
+					innerErr = Release(ctx, notifier.notify, nil, runID)
 					return innerErr
 				}, nil)
 				So(innerErr, ShouldBeNil)
@@ -19,7 +19,6 @@ import (
 	"fmt"
 	"time"
 
-	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -27,13 +26,12 @@ import (
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
 	"go.chromium.org/luci/common/logging"
-	gerritpb "go.chromium.org/luci/common/proto/gerrit"
 	"go.chromium.org/luci/common/retry"
 	"go.chromium.org/luci/common/retry/transient"
 	"go.chromium.org/luci/gae/service/datastore"
 
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
-	"go.chromium.org/luci/cv/internal/gerrit"
 	"go.chromium.org/luci/cv/internal/run"
 	"go.chromium.org/luci/cv/internal/run/eventpb"
 )
@@ -58,12 +56,12 @@ type RunCLsSubmitter struct {
 	clids common.CLIDs
 	// rm is used to interact with Run Manager.
 	rm RM
-	// gFactory is used to interact with Gerrit.
-	gFactory gerrit.Factory
+	// reviewers is used to interact with the code review systems of the CLs.
+	reviewers *codereview.Registry
 }
 
 // NewSubmitter creates a new RunCLsSubmitter.
-func NewSubmitter(ctx context.Context, runID common.RunID, submission *run.Submission, rm RM, g gerrit.Factory) *RunCLsSubmitter {
+func NewSubmitter(ctx context.Context, runID common.RunID, submission *run.Submission, rm RM, reviewers *codereview.Registry) *RunCLsSubmitter {
 	notSubmittedCLs := make(common.CLIDs, 0, len(submission.GetCls())-len(submission.GetSubmittedCls()))
 	submitted := common.MakeCLIDs(submission.GetSubmittedCls()...).Set()
 	for _, cl := range submission.GetCls() {
@@ -73,11 +71,11 @@ func NewSubmitter(ctx context.Context, runID common.RunID, submission *run.Submi
 		}
 	}
 	return &RunCLsSubmitter{
-		runID:    runID,
-		deadline: submission.GetDeadline().AsTime(),
-		clids:    notSubmittedCLs,
-		rm:       rm,
-		gFactory: g,
+		runID:     runID,
+		deadline:  submission.GetDeadline().AsTime(),
+		clids:     notSubmittedCLs,
+		rm:        rm,
+		reviewers: reviewers,
 	}
 }
 
@@ -137,7 +135,8 @@ func (s RunCLsSubmitter) endSubmission(ctx context.Context, sc *eventpb.Submissi
 				return innerErr
 			}
 		} else {
-			if innerErr = Release(ctx, s.rm.NotifyReadyForSubmission, s.runID); innerErr != nil {
+			// The submitting Run can't be in the submit train being verified.
+			if innerErr = Release(ctx, s.rm.NotifyReadyForSubmission, nil, s.runID); innerErr != nil {
 				return innerErr
 			}
 		}
@@ -184,7 +183,7 @@ func (s RunCLsSubmitter) submitCLs(ctx context.Context, cls []*run.RunCL) *event
 		var msg string
 		err := retry.Retry(ctx, perCLRetryFactory, func() error {
 			if !submitted {
-				switch err := s.submitCL(ctx, cl); {
+				switch err := s.reviewers.Submit(ctx, s.runID.LUCIProject(), cl); {
 				case err == nil:
 					submitted = true
 				default:
@@ -219,51 +218,6 @@ func (s RunCLsSubmitter) submitCLs(ctx context.Context, cls []*run.RunCL) *event
 	}
 }
 
-// submitCL makes a request to Gerrit to submit (merge) the CL.
-func (s RunCLsSubmitter) submitCL(ctx context.Context, cl *run.RunCL) error {
-	gc, err := s.gFactory.MakeClient(ctx, cl.Detail.GetGerrit().GetHost(), s.runID.LUCIProject())
-	if err != nil {
-		return err
-	}
-	ci := cl.Detail.GetGerrit().GetInfo()
-	_, submitErr := gc.SubmitRevision(ctx, &gerritpb.SubmitRevisionRequest{
-		Number:     ci.GetNumber(),
-		RevisionId: ci.GetCurrentRevision(),
-		Project:    ci.GetProject(),
-	})
-	if submitErr == nil {
-		return nil
-	}
-	// Sometimes, Gerrit may return error but change is actually merged.
-	// Load the change again to check whether it is actually merged.
-	merged := false
-	_ = s.gFactory.MakeMirrorIterator(ctx).RetryIfStale(func(opt grpc.CallOption) error {
-		latest, err := gc.GetChange(ctx, &gerritpb.GetChangeRequest{
-			Number:  ci.GetNumber(),
-			Project: ci.GetProject(),
-		}, opt)
-		switch {
-		case err != nil:
-			return err
-		case latest.GetStatus() == gerritpb.ChangeStatus_MERGED:
-			// It is possible that somebody else submitted the change, but this is
-			// unlikely enough that we presume CV did it. If necessary, it's possible
-			// to examine Change messages to see who actually did it.
-			merged = true
-			return nil
-		case latest.GetUpdated().AsTime().Before(ci.GetUpdated().AsTime()):
-			return gerrit.ErrStaleData
-		default:
-			merged = false
-			return nil
-		}
-	})
-	if merged {
-		return nil
-	}
-	return submitErr
-}
-
 // Determines the type of submission completion result based on the given error.
 func classifyErr(ctx context.Context, err error) *eventpb.SubmissionCompleted {
 	switch {
@@ -31,6 +31,7 @@ import (
 	"go.chromium.org/luci/gae/service/datastore"
 
 	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/codereview"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/cvtesting"
 	gf "go.chromium.org/luci/cv/internal/gerrit/gerritfake"
@@ -58,13 +59,16 @@ func TestSubmitter(t *testing.T) {
 		ct.GFake.AddFrom(gf.WithCIs(gHost1, gf.ACLRestricted(lProject), ci1))
 		ct.GFake.AddFrom(gf.WithCIs(gHost2, gf.ACLRestricted(lProject), ci2))
 
+		reviewers := &codereview.Registry{}
+		reviewers.Register(codereview.NewGerritProvider(ct.GFactory()))
+
 		now := ct.Clock.Now().UTC()
 		s := RunCLsSubmitter{
-			runID:    common.MakeRunID(lProject, now, 1, []byte("deadbeef")),
-			deadline: now.Add(1 * time.Minute),
-			clids:    common.CLIDs{1, 2},
-			rm:       run.NewNotifier(ct.TQDispatcher),
-			gFactory: ct.GFactory(),
+			runID:     common.MakeRunID(lProject, now, 1, []byte("deadbeef")),
+			deadline:  now.Add(1 * time.Minute),
+			clids:     common.CLIDs{1, 2},
+			rm:        run.NewNotifier(ct.TQDispatcher),
+			reviewers: reviewers,
 		}
 		So(datastore.Put(ctx,
 			&run.Run{
@@ -75,8 +79,9 @@ func TestSubmitter(t *testing.T) {
 				CLs:        s.clids,
 			},
 			&run.RunCL{
-				ID:  1,
-				Run: datastore.MakeKey(ctx, common.RunKind, string(s.runID)),
+				ID:         1,
+				Run:        datastore.MakeKey(ctx, common.RunKind, string(s.runID)),
+				ExternalID: changelist.MustGobID(gHost1, 1),
 				Detail: &changelist.Snapshot{
 					Kind: &changelist.Snapshot_Gerrit{
 						Gerrit: &changelist.Gerrit{
@@ -87,8 +92,9 @@ func TestSubmitter(t *testing.T) {
 				},
 			},
 			&run.RunCL{
-				ID:  2,
-				Run: datastore.MakeKey(ctx, common.RunKind, string(s.runID)),
+				ID:         2,
+				Run:        datastore.MakeKey(ctx, common.RunKind, string(s.runID)),
+				ExternalID: changelist.MustGobID(gHost2, 2),
 				Detail: &changelist.Snapshot{
 					Kind: &changelist.Snapshot_Gerrit{
 						Gerrit: &changelist.Gerrit{
@@ -139,7 +145,7 @@ func TestSubmitter(t *testing.T) {
 				ctx = memlogger.Use(ctx)
 				log := logging.Get(ctx).(*memlogger.MemLogger)
 				So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
-					return Release(ctx, s.rm.NotifyReadyForSubmission, s.runID)
+					return Release(ctx, s.rm.NotifyReadyForSubmission, nil, s.runID)
 				}, nil), ShouldBeNil)
 				So(s.Submit(ctx), ShouldBeNil)
 				runtest.AssertReceivedSubmissionCompleted(ctx, s.runID,
@@ -0,0 +1,186 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submit
+
+import (
+	"context"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/common"
+)
+
+// VerifyTrainFn is used to launch a speculative combined tryjob which tests
+// the CLs of all the provided Runs together.
+//
+// Returns the ID of the Tryjob. The outcome of the verification MUST be
+// reported back via OnTrainVerified with the same Tryjob ID.
+//
+// Called in a datastore transaction.
+type VerifyTrainFn func(ctx context.Context, runs common.RunIDs, opts *cfgpb.SubmitOptions) (common.TryjobID, error)
+
+// CancelTrainFn is used to cancel the speculative combined tryjob launched via
+// VerifyTrainFn when the train it verifies is reset.
+//
+// Called in a datastore transaction.
+type CancelTrainFn func(ctx context.Context, tryjobID common.TryjobID) error
+
+// TryAcquireTrain is like TryAcquire, but for LUCI Projects which have
+// submit trains enabled in `opts`.
+//
+// Instead of handing out the submission slot to one Run at a time, Runs from
+// the head of the waitlist (up to `opts.Train.MaxSize` of them) form a submit
+// train which is verified on a speculative combined tryjob launched via
+// `verifyFn`. Once the verification passes (see OnTrainVerified), all Runs of
+// the train acquire the submission slot together and are notified via
+// `notifyFn`.
+//
+// Returns waitlisted == false iff the requested Run is allowed to submit now.
+//
+// MUST be called in a datastore transaction.
+func TryAcquireTrain(ctx context.Context, notifyFn NotifyFn, verifyFn VerifyTrainFn, runID common.RunID, opts *cfgpb.SubmitOptions) (waitlisted bool, err error) {
+	switch {
+	case datastore.CurrentTransaction(ctx) == nil:
+		panic("TryAcquireTrain must be called in a datastore transaction")
+	case opts.GetTrain().GetMaxSize() < 2:
+		return tryAcquire(ctx, notifyFn, runID, opts)
+	}
+
+	q := &queue{ID: runID.LUCIProject()}
+	switch err := datastore.Get(ctx, q); {
+	case err == datastore.ErrNoSuchEntity:
+	case err != nil:
+		return false, errors.Annotate(err, "failed to load SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	q.Opts = opts
+
+	switch {
+	case q.Current == runID || q.Boarded.Index(runID) != -1:
+		return false, nil
+	case q.Waitlist.Index(runID) == -1:
+		q.Waitlist = append(q.Waitlist, runID)
+	}
+	if !q.busy() {
+		if err := q.departTrain(ctx, verifyFn); err != nil {
+			return false, err
+		}
+	}
+	if err := datastore.Put(ctx, q); err != nil {
+		return false, errors.Annotate(err, "failed to put SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	return true, nil
+}
+
+// OnTrainVerified records the outcome of the speculative combined tryjob
+// launched via VerifyTrainFn.
+//
+// If the verification passed, all the Runs acquire the submission slot and
+// are notified via `notifyFn`.
+//
+// If it failed, the train is bisected: the first half of the Runs is verified
+// again via `verifyFn`. If the train consists of a single Run, that Run is the
+// culprit. It is removed from the submit queue and returned to the caller,
+// which is responsible for failing it, and a new train departs with the
+// following Runs.
+//
+// Outdated outcomes (i.e. for Tryjobs which no longer verify the current
+// train) are ignored.
+//
+// MUST be called in a datastore transaction.
+func OnTrainVerified(ctx context.Context, notifyFn NotifyFn, verifyFn VerifyTrainFn, luciProject string, tryjobID common.TryjobID, passed bool) (culprit common.RunID, err error) {
+	if datastore.CurrentTransaction(ctx) == nil {
+		panic("OnTrainVerified must be called in a datastore transaction")
+	}
+	q, err := loadQueue(ctx, luciProject)
+	if err != nil {
+		return "", err
+	}
+	if tryjobID == 0 || q.TrainTryjob != tryjobID {
+		logging.Warningf(ctx, "ignoring outdated verification by Tryjob %d; current train %s is verified by Tryjob %d", tryjobID, q.Train, q.TrainTryjob)
+		return "", nil
+	}
+	q.TrainTryjob = 0
+
+	switch {
+	case passed:
+		q.Boarded, q.Train = q.Train, nil
+		q.Waitlist = q.Waitlist[len(q.Boarded):]
+		now := clock.Now(ctx)
+		for _, id := range q.Boarded {
+			if err := notifyFn(ctx, id, now); err != nil {
+				return "", err
+			}
+		}
+	case len(q.Train) == 1:
+		culprit, q.Train = q.Train[0], nil
+		q.Waitlist = q.Waitlist[1:]
+		logging.Debugf(ctx, "bisected submit train down to the culprit %q", culprit)
+		if err := q.departTrain(ctx, verifyFn); err != nil {
+			return "", err
+		}
+	default:
+		failed := q.Train
+		q.Train = q.Train[:len(q.Train)/2]
+		logging.Debugf(ctx, "bisecting failed submit train %s, verifying %s", failed, q.Train)
+		if q.TrainTryjob, err = verifyFn(ctx, q.Train, q.Opts); err != nil {
+			return "", err
+		}
+	}
+
+	if err := datastore.Put(ctx, q); err != nil {
+		return "", errors.Annotate(err, "failed to put SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	return culprit, nil
+}
+
+// LoadTrain loads the Runs of the submit train currently being verified, the
+// Tryjob verifying them and the Runs which have boarded the previous train and
+// are submitting.
+//
+// Returns no Runs if the SubmitQueue doesn't exist yet.
+func LoadTrain(ctx context.Context, luciProject string) (train common.RunIDs, tryjobID common.TryjobID, boarded common.RunIDs, err error) {
+	q := &queue{ID: luciProject}
+	switch err := datastore.Get(ctx, q); {
+	case err == datastore.ErrNoSuchEntity:
+		return nil, 0, nil, nil
+	case err != nil:
+		return nil, 0, nil, errors.Annotate(err, "failed to load SubmitQueue %q", q.ID).Tag(transient.Tag).Err()
+	}
+	return q.Train, q.TrainTryjob, q.Boarded, nil
+}
+
+// departTrain forms a new submit train from the head of the waitlist and
+// launches its verification.
+//
+// No-op if the waitlist is empty.
+func (q *queue) departTrain(ctx context.Context, verifyFn VerifyTrainFn) error {
+	n := len(q.Waitlist)
+	if max := int(q.Opts.GetTrain().GetMaxSize()); n > max {
+		n = max
+	}
+	if n == 0 {
+		return nil
+	}
+	q.Train = append(common.RunIDs(nil), q.Waitlist[:n]...)
+	logging.Debugf(ctx, "submit train %s departs", q.Train)
+	var err error
+	q.TrainTryjob, err = verifyFn(ctx, q.Train, q.Opts)
+	return err
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTrain(t *testing.T) {
+	t.Parallel()
+
+	Convey("Train", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp()
+		defer cancel()
+
+		notifier := &fakeNotifier{}
+		var verified []common.RunIDs
+		verify := func(ctx context.Context, runs common.RunIDs, opts *cfgpb.SubmitOptions) (common.TryjobID, error) {
+			So(opts.GetTrain().GetMaxSize(), ShouldEqual, 4)
+			verified = append(verified, runs)
+			return common.TryjobID(len(verified)), nil
+		}
+		var cancelled common.TryjobIDs
+		cancelTrain := func(ctx context.Context, tryjobID common.TryjobID) error {
+			cancelled = append(cancelled, tryjobID)
+			return nil
+		}
+
+		const lProject = "lProject"
+		runs := make(common.RunIDs, 5)
+		for i := range runs {
+			runs[i] = common.MakeRunID(lProject, clock.Now(ctx), 1, []byte(fmt.Sprintf("deadbee%d", i)))
+		}
+		opts := &cfgpb.SubmitOptions{
+			Train: &cfgpb.SubmitOptions_SubmitTrain{MaxSize: 4},
+		}
+
+		mustTryAcquire := func(runID common.RunID) bool {
+			var waitlisted bool
+			So(datastore.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+				waitlisted, err = TryAcquireTrain(ctx, notifier.notify, verify, runID, opts)
+				return err
+			}, nil), ShouldBeNil)
+			return waitlisted
+		}
+		mustVerified := func(runs common.RunIDs, passed bool) common.RunID {
+			// Find the latest Tryjob verifying these Runs.
+			tryjobID := common.TryjobID(1000)
+			for i, v := range verified {
+				if v.Equal(runs) {
+					tryjobID = common.TryjobID(i + 1)
+				}
+			}
+			var culprit common.RunID
+			So(datastore.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+				culprit, err = OnTrainVerified(ctx, notifier.notify, verify, lProject, tryjobID, passed)
+				return err
+			}, nil), ShouldBeNil)
+			return culprit
+		}
+		mustRelease := func(runID common.RunID) {
+			So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+				return ReleaseOnSuccess(ctx, notifier.notify, runID, clock.Now(ctx))
+			}, nil), ShouldBeNil)
+		}
+		mustLoadTrain := func() (common.RunIDs, common.RunIDs) {
+			train, tryjobID, boarded, err := LoadTrain(ctx, lProject)
+			So(err, ShouldBeNil)
+			if len(train) > 0 {
+				So(tryjobID, ShouldEqual, len(verified))
+			} else {
+				So(tryjobID, ShouldEqual, 0)
+			}
+			return train, boarded
+		}
+
+		// The first Run departs on its own since nothing else is waiting.
+		So(mustTryAcquire(runs[0]), ShouldBeTrue)
+		So(verified, ShouldResemble, []common.RunIDs{{runs[0]}})
+		for _, r := range runs[1:] {
+			So(mustTryAcquire(r), ShouldBeTrue)
+		}
+		So(verified, ShouldHaveLength, 1)
+
+		Convey("Passed", func() {
+			So(mustVerified(common.RunIDs{runs[0]}, true), ShouldBeEmpty)
+			So(notifier.notifyETAs(ctx, runs[0]), ShouldHaveLength, 1)
+			So(mustTryAcquire(runs[0]), ShouldBeFalse)
+			So(mustTryAcquire(runs[1]), ShouldBeTrue)
+
+			mustRelease(runs[0])
+			// The next Run in the waitlist is notified and the next train
+			// departs with as many Runs as allowed.
+			So(notifier.notifyETAs(ctx, runs[1]), ShouldHaveLength, 1)
+			So(mustTryAcquire(runs[1]), ShouldBeTrue)
+			So(verified[len(verified)-1], ShouldResemble, runs[1:5])
+
+			So(mustVerified(runs[1:5], true), ShouldBeEmpty)
+			for _, r := range runs[1:5] {
+				So(mustTryAcquire(r), ShouldBeFalse)
+			}
+			train, boarded := mustLoadTrain()
+			So(train, ShouldBeEmpty)
+			So(boarded, ShouldResemble, runs[1:5])
+		})
+
+		Convey("Bisection", func() {
+			So(mustVerified(common.RunIDs{runs[0]}, true), ShouldBeEmpty)
+			mustRelease(runs[0])
+			So(mustTryAcquire(runs[1]), ShouldBeTrue)
+			So(verified[len(verified)-1], ShouldResemble, runs[1:5])
+
+			// runs[2] is the culprit.
+			So(mustVerified(runs[1:5], false), ShouldBeEmpty)
+			So(verified[len(verified)-1], ShouldResemble, runs[1:3])
+			So(mustVerified(runs[1:3], false), ShouldBeEmpty)
+			So(verified[len(verified)-1], ShouldResemble, runs[1:2])
+			So(mustVerified(runs[1:2], true), ShouldBeEmpty)
+			So(mustTryAcquire(runs[1]), ShouldBeFalse)
+
+			mustRelease(runs[1])
+			So(mustTryAcquire(runs[2]), ShouldBeTrue)
+			So(verified[len(verified)-1], ShouldResemble, runs[2:5])
+			So(mustVerified(runs[2:5], false), ShouldBeEmpty)
+			So(mustVerified(runs[2:3], false), ShouldEqual, runs[2])
+
+			// The remaining Runs depart right away.
+			So(verified[len(verified)-1], ShouldResemble, runs[3:5])
+			_, waitlist, err := LoadCurrentAndWaitlist(ctx, runs[3])
+			So(err, ShouldBeNil)
+			So(waitlist, ShouldResemble, runs[3:5])
+		})
+
+		Convey("Outdated verification is ignored", func() {
+			So(mustVerified(common.RunIDs{runs[1]}, true), ShouldBeEmpty)
+			train, boarded := mustLoadTrain()
+			So(train, ShouldResemble, common.RunIDs{runs[0]})
+			So(boarded, ShouldBeEmpty)
+		})
+
+		Convey("Run in train is released", func() {
+			So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+				return Release(ctx, notifier.notify, cancelTrain, runs[0])
+			}, nil), ShouldBeNil)
+			train, _ := mustLoadTrain()
+			So(train, ShouldBeEmpty)
+			So(cancelled, ShouldResemble, common.TryjobIDs{1})
+			So(notifier.notifyETAs(ctx, runs[1]), ShouldHaveLength, 1)
+			So(mustTryAcquire(runs[1]), ShouldBeTrue)
+			So(verified[len(verified)-1], ShouldResemble, runs[1:5])
+		})
+	})
+}
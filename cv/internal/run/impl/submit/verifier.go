@@ -0,0 +1,276 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/hardcoded/chromeinfra"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/buildbucket"
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob"
+)
+
+// TrainBackend launches and cancels Tryjobs in the backend, e.g. Buildbucket.
+type TrainBackend interface {
+	Launch(ctx context.Context, tryjobs []*tryjob.Tryjob, r *run.Run, cls []*run.RunCL) error
+	CancelTryjob(ctx context.Context, tj *tryjob.Tryjob, reason string) error
+}
+
+// TrainRM encapsulates the interactions with Run Manager.
+type TrainRM interface {
+	NotifyTryjobsUpdated(ctx context.Context, runID common.RunID, tryjobs *tryjob.TryjobUpdatedEvents) error
+}
+
+// TrainVerifier launches speculative combined Tryjobs which verify submit
+// trains.
+//
+// All Runs of the train watch the Tryjob, i.e. they are notified when it is
+// updated. The first Run is recorded as the one which launched it.
+type TrainVerifier struct {
+	tn      *tryjob.Notifier
+	rm      TrainRM
+	backend TrainBackend
+}
+
+// NewTrainVerifier creates a new TrainVerifier and registers it to handle
+// tasks launching and cancelling the Tryjobs.
+func NewTrainVerifier(tn *tryjob.Notifier, rm TrainRM, backend TrainBackend) *TrainVerifier {
+	v := &TrainVerifier{tn: tn, rm: rm, backend: backend}
+	tn.Bindings.LaunchTrain.AttachHandler(func(ctx context.Context, payload proto.Message) error {
+		task := payload.(*tryjob.LaunchTrainTryjobTask)
+		ctx = logging.SetField(ctx, "tryjob", task.GetId())
+		return common.TQifyError(ctx, v.launch(ctx, common.TryjobID(task.GetId())))
+	})
+	tn.Bindings.CancelTrain.AttachHandler(func(ctx context.Context, payload proto.Message) error {
+		task := payload.(*tryjob.CancelTrainTryjobTask)
+		ctx = logging.SetField(ctx, "tryjob", task.GetId())
+		return common.TQifyError(ctx, v.cancel(ctx, common.TryjobID(task.GetId())))
+	})
+	return v
+}
+
+// Verify implements VerifyTrainFn.
+//
+// It saves a pending Tryjob which tests the CLs of all the Runs together on
+// the builder of the submit train and schedules a task to launch it.
+func (v *TrainVerifier) Verify(ctx context.Context, runs common.RunIDs, opts *cfgpb.SubmitOptions) (common.TryjobID, error) {
+	builderID, err := buildbucket.ParseBuilderID(opts.GetTrain().GetBuilder())
+	if err != nil {
+		return 0, errors.Annotate(err, "invalid submit train builder").Err()
+	}
+	// Runs and their CLs are immutable for the purpose of this function, so
+	// load them outside of the transaction to avoid contention.
+	_, cls, err := loadTrain(datastore.WithoutTransaction(ctx), runs)
+	if err != nil {
+		return 0, err
+	}
+	clPatchsets := make(tryjob.CLPatchsets, len(cls))
+	for i, cl := range cls {
+		clPatchsets[i] = tryjob.MakeCLPatchset(cl.ID, cl.Detail.GetPatchset())
+	}
+	sort.Sort(clPatchsets)
+
+	now := datastore.RoundTime(clock.Now(ctx).UTC())
+	tj := &tryjob.Tryjob{
+		EVersion:         1,
+		EntityCreateTime: now,
+		EntityUpdateTime: now,
+		// ReuseKey is deliberately not set. The result of the Tryjob is
+		// meaningful only for this particular combination of Runs.
+		Definition: &tryjob.Definition{
+			Backend: &tryjob.Definition_Buildbucket_{
+				Buildbucket: &tryjob.Definition_Buildbucket{
+					Host:    chromeinfra.BuildbucketHost,
+					Builder: builderID,
+				},
+			},
+		},
+		Status:      tryjob.Status_PENDING,
+		LaunchedBy:  runs[0],
+		ReusedBy:    append(common.RunIDs(nil), runs[1:]...),
+		CLPatchsets: clPatchsets,
+	}
+	if err := tryjob.SaveTryjobs(ctx, []*tryjob.Tryjob{tj}, nil); err != nil {
+		return 0, err
+	}
+	if err := v.tn.ScheduleLaunchTrain(ctx, tj.ID); err != nil {
+		return 0, err
+	}
+	logging.Debugf(ctx, "verifying submit train %s with Tryjob %d", runs, tj.ID)
+	return tj.ID, nil
+}
+
+// CancelTrain implements CancelTrainFn.
+//
+// It schedules a task to cancel the Tryjob.
+func (v *TrainVerifier) CancelTrain(ctx context.Context, id common.TryjobID) error {
+	return v.tn.ScheduleCancelTrain(ctx, id)
+}
+
+// launch launches the pending Tryjob saved by Verify.
+//
+// If the Tryjob can't be launched, marks it as untriggered, which fails the
+// verification.
+func (v *TrainVerifier) launch(ctx context.Context, id common.TryjobID) error {
+	tj := &tryjob.Tryjob{ID: id}
+	switch err := datastore.Get(ctx, tj); {
+	case err == datastore.ErrNoSuchEntity:
+		return errors.Reason("Tryjob %d doesn't exist", id).Err()
+	case err != nil:
+		return errors.Annotate(err, "failed to load Tryjob %d", id).Tag(transient.Tag).Err()
+	case tj.Status != tryjob.Status_PENDING:
+		logging.Debugf(ctx, "Tryjob %d is %s already", id, tj.Status)
+		return nil
+	}
+	eversion := tj.EVersion
+
+	head, cls, err := loadTrain(ctx, tj.AllWatchingRuns())
+	if err != nil {
+		return err
+	}
+	err = v.backend.Launch(ctx, []*tryjob.Tryjob{tj}, head, cls)
+	if merr, ok := err.(errors.MultiError); ok {
+		err = merr.First()
+	}
+	switch {
+	case err == nil:
+	case transient.Tag.In(err) || canRetryBackendError(err):
+		return errors.Annotate(err, "failed to launch Tryjob %d", id).Tag(transient.Tag).Err()
+	default:
+		logging.Warningf(ctx, "failed to launch Tryjob %d: %s", id, err)
+		tj.Status = tryjob.Status_UNTRIGGERED
+		tj.UntriggeredReason = fmt.Sprintf("failed to launch the Tryjob: %s", err)
+	}
+
+	var innerErr error
+	err = datastore.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+		defer func() { innerErr = err }()
+		latest := &tryjob.Tryjob{ID: id}
+		switch err := datastore.Get(ctx, latest); {
+		case err != nil:
+			return errors.Annotate(err, "failed to load Tryjob %d", id).Tag(transient.Tag).Err()
+		case latest.EVersion != eversion:
+			return errors.Reason("Tryjob %d was modified concurrently", id).Tag(transient.Tag).Err()
+		}
+		tj.EVersion++
+		tj.EntityUpdateTime = datastore.RoundTime(clock.Now(ctx).UTC())
+		return tryjob.SaveTryjobs(ctx, []*tryjob.Tryjob{tj}, v.rm.NotifyTryjobsUpdated)
+	}, nil)
+	switch {
+	case innerErr != nil:
+		return innerErr
+	case err != nil:
+		return errors.Annotate(err, "failed to commit transaction").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// cancel cancels the Tryjob of a submit train which was reset.
+//
+// If the Tryjob hasn't been launched yet, retries later, as it can't be
+// cancelled in the backend before it's launched.
+func (v *TrainVerifier) cancel(ctx context.Context, id common.TryjobID) error {
+	tj := &tryjob.Tryjob{ID: id}
+	switch err := datastore.Get(ctx, tj); {
+	case err == datastore.ErrNoSuchEntity:
+		return errors.Reason("Tryjob %d doesn't exist", id).Err()
+	case err != nil:
+		return errors.Annotate(err, "failed to load Tryjob %d", id).Tag(transient.Tag).Err()
+	case tj.IsEnded():
+		logging.Debugf(ctx, "Tryjob %d is %s already", id, tj.Status)
+		return nil
+	case tj.Status == tryjob.Status_PENDING:
+		return errors.Reason("Tryjob %d is not launched yet", id).Tag(transient.Tag).Err()
+	}
+	eversion := tj.EVersion
+
+	if err := v.backend.CancelTryjob(ctx, tj, "the submit train was reset"); err != nil {
+		return errors.Annotate(err, "failed to cancel Tryjob %d", id).Tag(transient.Tag).Err()
+	}
+
+	var innerErr error
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+		defer func() { innerErr = err }()
+		latest := &tryjob.Tryjob{ID: id}
+		switch err := datastore.Get(ctx, latest); {
+		case err != nil:
+			return errors.Annotate(err, "failed to load Tryjob %d", id).Tag(transient.Tag).Err()
+		case latest.EVersion != eversion:
+			return errors.Reason("Tryjob %d was modified concurrently", id).Tag(transient.Tag).Err()
+		}
+		tj.Status = tryjob.Status_CANCELLED
+		tj.EVersion++
+		tj.EntityUpdateTime = datastore.RoundTime(clock.Now(ctx).UTC())
+		return tryjob.SaveTryjobs(ctx, []*tryjob.Tryjob{tj}, v.rm.NotifyTryjobsUpdated)
+	}, nil)
+	switch {
+	case innerErr != nil:
+		return innerErr
+	case err != nil:
+		return errors.Annotate(err, "failed to commit transaction").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+func canRetryBackendError(err error) bool {
+	grpcStatus, ok := status.FromError(errors.Unwrap(err))
+	return ok && retriableBackendErrorCodes[grpcStatus.Code()]
+}
+
+// retriableBackendErrorCodes are the backend error codes which indicate that
+// launching may succeed if retried. Keep in sync with the Tryjob executor.
+var retriableBackendErrorCodes = map[codes.Code]bool{
+	codes.Internal:          true,
+	codes.Unknown:           true,
+	codes.Unavailable:       true,
+	codes.NotFound:          true,
+	codes.ResourceExhausted: true,
+	codes.DeadlineExceeded:  true,
+}
+
+// loadTrain loads the first of the given Runs and the CLs of all of them.
+func loadTrain(ctx context.Context, runIDs common.RunIDs) (*run.Run, []*run.RunCL, error) {
+	runs := make([]*run.Run, len(runIDs))
+	for i, id := range runIDs {
+		runs[i] = &run.Run{ID: id}
+	}
+	if err := datastore.Get(ctx, runs); err != nil {
+		return nil, nil, errors.Annotate(common.MostSevereError(err), "failed to load Runs %s", runIDs).Tag(transient.Tag).Err()
+	}
+	var cls []*run.RunCL
+	for _, r := range runs {
+		runCLs, err := run.LoadRunCLs(ctx, r.ID, r.CLs)
+		if err != nil {
+			return nil, nil, err
+		}
+		cls = append(cls, runCLs...)
+	}
+	return runs[0], cls, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package submit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/common"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestTrainVerifier(t *testing.T) {
+	t.Parallel()
+
+	Convey("TrainVerifier", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp()
+		defer cancel()
+
+		const lProject = "lProject"
+		backend := &fakeBackend{}
+		rm := &fakeTrainRM{}
+		v := NewTrainVerifier(tryjob.NewNotifier(ct.TQDispatcher), rm, backend)
+
+		runs := make(common.RunIDs, 2)
+		for i := range runs {
+			runs[i] = common.MakeRunID(lProject, clock.Now(ctx), 1, []byte(fmt.Sprintf("deadbee%d", i)))
+			clid := common.CLID(i + 1)
+			So(datastore.Put(ctx,
+				&run.Run{ID: runs[i], CLs: common.CLIDs{clid}},
+				&run.RunCL{
+					ID:     clid,
+					Run:    datastore.MakeKey(ctx, common.RunKind, string(runs[i])),
+					Detail: &changelist.Snapshot{Patchset: int32(i + 10)},
+				},
+			), ShouldBeNil)
+		}
+		opts := &cfgpb.SubmitOptions{
+			Train: &cfgpb.SubmitOptions_SubmitTrain{
+				MaxSize: 2,
+				Builder: "lProject/try/submit-train",
+			},
+		}
+
+		var id common.TryjobID
+		So(datastore.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+			id, err = v.Verify(ctx, runs, opts)
+			return err
+		}, nil), ShouldBeNil)
+		So(id, ShouldNotEqual, 0)
+		So(ct.TQ.Tasks().Payloads(), ShouldResembleProto, []proto.Message{
+			&tryjob.LaunchTrainTryjobTask{Id: int64(id)},
+		})
+
+		tj := &tryjob.Tryjob{ID: id}
+		So(datastore.Get(ctx, tj), ShouldBeNil)
+		So(tj.Status, ShouldEqual, tryjob.Status_PENDING)
+		So(tj.LaunchedBy, ShouldEqual, runs[0])
+		So(tj.ReusedBy, ShouldResemble, runs[1:])
+		So(tj.CLPatchsets, ShouldResemble, tryjob.CLPatchsets{
+			tryjob.MakeCLPatchset(1, 10),
+			tryjob.MakeCLPatchset(2, 11),
+		})
+		So(tj.Definition.GetBuildbucket().GetBuilder(), ShouldResembleProto, &bbpb.BuilderID{
+			Project: lProject,
+			Bucket:  "try",
+			Builder: "submit-train",
+		})
+
+		Convey("Launches the Tryjob", func() {
+			ct.TQ.Run(ctx, tqtesting.StopAfterTask(tryjob.LaunchTrainTaskClass))
+			So(backend.launched, ShouldResemble, []common.TryjobID{id})
+			So(datastore.Get(ctx, tj), ShouldBeNil)
+			So(tj.Status, ShouldEqual, tryjob.Status_TRIGGERED)
+			So(tj.ExternalID, ShouldEqual, tryjob.MustBuildbucketID("bb.example.com", 1))
+			So(tj.EVersion, ShouldEqual, 2)
+			So(rm.notified, ShouldResemble, runs)
+
+			Convey("Only once", func() {
+				So(v.launch(ctx, id), ShouldBeNil)
+				So(backend.launched, ShouldHaveLength, 1)
+			})
+
+			Convey("Cancels the Tryjob", func() {
+				So(datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+					return v.CancelTrain(ctx, id)
+				}, nil), ShouldBeNil)
+				ct.TQ.Run(ctx, tqtesting.StopAfterTask(tryjob.CancelTrainTaskClass))
+				So(backend.cancelled, ShouldResemble, []common.TryjobID{id})
+				So(datastore.Get(ctx, tj), ShouldBeNil)
+				So(tj.Status, ShouldEqual, tryjob.Status_CANCELLED)
+				So(tj.EVersion, ShouldEqual, 3)
+				So(rm.notified, ShouldResemble, common.RunIDs{runs[0], runs[0], runs[1], runs[1]})
+
+				Convey("Only once", func() {
+					So(v.cancel(ctx, id), ShouldBeNil)
+					So(backend.cancelled, ShouldHaveLength, 1)
+				})
+			})
+		})
+
+		Convey("Doesn't cancel the Tryjob before it's launched", func() {
+			err := v.cancel(ctx, id)
+			So(err, ShouldErrLike, "not launched yet")
+			So(transient.Tag.In(err), ShouldBeTrue)
+			So(backend.cancelled, ShouldBeEmpty)
+		})
+
+		Convey("Retries transient failures", func() {
+			backend.err = status.Error(codes.Unavailable, "try again")
+			err := v.launch(ctx, id)
+			So(err, ShouldErrLike, "try again")
+			So(transient.Tag.In(err), ShouldBeTrue)
+			So(datastore.Get(ctx, tj), ShouldBeNil)
+			So(tj.Status, ShouldEqual, tryjob.Status_PENDING)
+			So(rm.notified, ShouldBeEmpty)
+		})
+
+		Convey("Marks the Tryjob untriggered on permanent failures", func() {
+			backend.err = status.Error(codes.PermissionDenied, "no access")
+			So(v.launch(ctx, id), ShouldBeNil)
+			So(datastore.Get(ctx, tj), ShouldBeNil)
+			So(tj.Status, ShouldEqual, tryjob.Status_UNTRIGGERED)
+			So(tj.UntriggeredReason, ShouldContainSubstring, "no access")
+			So(rm.notified, ShouldResemble, runs)
+		})
+	})
+}
+
+type fakeBackend struct {
+	err       error
+	launched  []common.TryjobID
+	cancelled []common.TryjobID
+}
+
+func (b *fakeBackend) Launch(ctx context.Context, tryjobs []*tryjob.Tryjob, r *run.Run, cls []*run.RunCL) error {
+	if b.err != nil {
+		return b.err
+	}
+	for _, tj := range tryjobs {
+		b.launched = append(b.launched, tj.ID)
+		tj.ExternalID = tryjob.MustBuildbucketID("bb.example.com", int64(len(b.launched)))
+		tj.Status = tryjob.Status_TRIGGERED
+	}
+	return nil
+}
+
+func (b *fakeBackend) CancelTryjob(ctx context.Context, tj *tryjob.Tryjob, reason string) error {
+	b.cancelled = append(b.cancelled, tj.ID)
+	return nil
+}
+
+// fakeTrainRM records the notified Runs sorted, since Runs watching a Tryjob
+// are notified in no particular order.
+type fakeTrainRM struct {
+	notified common.RunIDs
+}
+
+func (rm *fakeTrainRM) NotifyTryjobsUpdated(ctx context.Context, runID common.RunID, tryjobs *tryjob.TryjobUpdatedEvents) error {
+	rm.notified = append(rm.notified, runID)
+	sort.Sort(rm.notified)
+	return nil
+}
@@ -16,21 +16,28 @@ package pubsub
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.chromium.org/luci/buildbucket/protoutil"
 	"go.chromium.org/luci/server/tq"
 
 	cvpb "go.chromium.org/luci/cv/api/v1"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/rpc/versioning"
 	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob"
 )
 
 const (
 	v1RunEndedTopic     = "v1.run_ended"
 	v1RunEndedTaskClass = "v1-publish-run-ended"
+
+	v1RunEventsTopic     = "v1.run_events"
+	v1RunEventsTaskClass = "v1-publish-run-event"
 )
 
 // Publisher publishes a message for various run events into Cloud PubSub
@@ -68,17 +75,113 @@ func NewPublisher(tqd *tq.Dispatcher, env *common.Env) *Publisher {
 			}, nil
 		},
 	})
+	tqd.RegisterTaskClass(tq.TaskClass{
+		ID:        v1RunEventsTaskClass,
+		Topic:     v1RunEventsTopic,
+		Prototype: &PublishRunEventTask{},
+		Kind:      tq.FollowsContext,
+		Custom: func(ctx context.Context, m proto.Message) (*tq.CustomPayload, error) {
+			t := m.(*PublishRunEventTask)
+			ev := proto.Clone(t.GetEvent()).(*cvpb.PubSubRunEvent)
+			ev.Run.Hostname = env.LogicalHostname
+			blob, err := (protojson.MarshalOptions{Indent: "\t"}).Marshal(ev)
+			if err != nil {
+				return nil, err
+			}
+			return &tq.CustomPayload{
+				Meta: map[string]string{
+					"type":         ev.GetType().String(),
+					"status":       ev.GetRun().GetStatus().String(),
+					"luci_project": t.GetLuciProject(),
+				},
+				Body: blob,
+			}, nil
+		},
+	})
 	return p
 }
 
-// RunEnded schedules a task to publish a RunEnded message.
-func (s *Publisher) RunEnded(ctx context.Context, rid common.RunID, status run.Status, eVersion int64) error {
-	return s.tqd.AddTask(ctx, &tq.Task{
+// RunStarted schedules a task to publish a RUN_STARTED event.
+func (s *Publisher) RunStarted(ctx context.Context, rid common.RunID, status run.Status, eVersion int64, startTime time.Time) error {
+	return s.publishEvent(ctx, rid, &cvpb.PubSubRunEvent{
+		Type:      cvpb.PubSubRunEvent_RUN_STARTED,
+		Run:       makePubSubRun(rid, status, eVersion),
+		EventTime: timestamppb.New(startTime),
+	})
+}
+
+// TryjobsUpdated schedules a task to publish a TRYJOBS_UPDATED event with
+// the latest state of the Tryjobs in the provided ExecutionState.
+func (s *Publisher) TryjobsUpdated(ctx context.Context, rid common.RunID, status run.Status, eVersion int64, updateTime time.Time, es *tryjob.ExecutionState) error {
+	return s.publishEvent(ctx, rid, &cvpb.PubSubRunEvent{
+		Type:      cvpb.PubSubRunEvent_TRYJOBS_UPDATED,
+		Run:       makePubSubRun(rid, status, eVersion),
+		EventTime: timestamppb.New(updateTime),
+		Tryjobs:   makePubSubTryjobs(es),
+	})
+}
+
+// RunEnded schedules a task to publish a RunEnded message and a RUN_ENDED
+// event.
+func (s *Publisher) RunEnded(ctx context.Context, rid common.RunID, status run.Status, eVersion int64, endTime time.Time) error {
+	err := s.tqd.AddTask(ctx, &tq.Task{
 		Payload: &PublishRunEndedTask{
 			PublicId:    rid.PublicID(),
 			LuciProject: rid.LUCIProject(),
 			Status:      status,
 			Eversion:    eVersion,
 		},
+		Title: string(rid),
+	})
+	if err != nil {
+		return err
+	}
+	return s.publishEvent(ctx, rid, &cvpb.PubSubRunEvent{
+		Type:      cvpb.PubSubRunEvent_RUN_ENDED,
+		Run:       makePubSubRun(rid, status, eVersion),
+		EventTime: timestamppb.New(endTime),
+	})
+}
+
+func (s *Publisher) publishEvent(ctx context.Context, rid common.RunID, ev *cvpb.PubSubRunEvent) error {
+	return s.tqd.AddTask(ctx, &tq.Task{
+		Payload: &PublishRunEventTask{
+			LuciProject: rid.LUCIProject(),
+			Event:       ev,
+		},
 	})
 }
+
+func makePubSubRun(rid common.RunID, status run.Status, eVersion int64) *cvpb.PubSubRun {
+	return &cvpb.PubSubRun{
+		Id:       rid.PublicID(),
+		Status:   versioning.RunStatusV1(status),
+		Eversion: eVersion,
+	}
+}
+
+// makePubSubTryjobs returns the state of the latest attempt of each Tryjob
+// in the ExecutionState.
+func makePubSubTryjobs(es *tryjob.ExecutionState) []*cvpb.PubSubTryjob {
+	defs := es.GetRequirement().GetDefinitions()
+	ret := make([]*cvpb.PubSubTryjob, 0, len(es.GetExecutions()))
+	for i, exec := range es.GetExecutions() {
+		tj := &cvpb.PubSubTryjob{
+			Attempts: int32(len(exec.GetAttempts())),
+		}
+		if i < len(defs) {
+			if b := defs[i].GetBuildbucket().GetBuilder(); b != nil {
+				tj.Builder = protoutil.FormatBuilderID(b)
+			}
+			tj.Critical = defs[i].GetCritical()
+		}
+		if n := len(exec.GetAttempts()); n > 0 {
+			latest := exec.GetAttempts()[n-1]
+			tj.ExternalId = latest.GetExternalId()
+			tj.Status = versioning.TryjobStatusV1(latest.GetStatus())
+			tj.ResultStatus = versioning.TryjobResultStatusV1(latest.GetResult().GetStatus())
+		}
+		ret = append(ret, tj)
+	}
+	return ret
+}
@@ -16,17 +16,22 @@ package pubsub
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	bbpb "go.chromium.org/luci/buildbucket/proto"
 	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/server/tq/tqtesting"
 
 	cvpb "go.chromium.org/luci/cv/api/v1"
 	"go.chromium.org/luci/cv/internal/common"
 	"go.chromium.org/luci/cv/internal/cvtesting"
 	"go.chromium.org/luci/cv/internal/run"
+	"go.chromium.org/luci/cv/internal/tryjob"
 
 	. "github.com/smartystreets/goconvey/convey"
 	. "go.chromium.org/luci/common/testing/assertions"
@@ -59,11 +64,12 @@ func TestExportRunToBQ(t *testing.T) {
 			// A RunEnded task must be scheduled in a transaction.
 			runEnded := func() error {
 				return datastore.RunInTransaction(ctx, func(tCtx context.Context) error {
-					return publisher.RunEnded(tCtx, r.ID, r.Status, r.EVersion)
+					return publisher.RunEnded(tCtx, r.ID, r.Status, r.EVersion, r.EndTime)
 				}, nil)
 			}
 			So(runEnded(), ShouldBeNil)
-			t := ct.TQ.Tasks()[0]
+			So(ct.TQ.Tasks(), ShouldHaveLength, 2)
+			t := findTask(ct.TQ.Tasks(), v1RunEndedTaskClass)
 
 			Convey("with attributes", func() {
 				attrs := t.Message.GetAttributes()
@@ -83,6 +89,122 @@ func TestExportRunToBQ(t *testing.T) {
 					Hostname: ct.Env.LogicalHostname,
 				})
 			})
+
+			Convey("with a RUN_ENDED event", func() {
+				t := findTask(ct.TQ.Tasks(), v1RunEventsTaskClass)
+				attrs := t.Message.GetAttributes()
+				So(attrs["type"], ShouldEqual, "RUN_ENDED")
+				So(attrs["status"], ShouldEqual, "SUCCEEDED")
+				So(attrs["luci_project"], ShouldEqual, r.ID.LUCIProject())
+				var msg cvpb.PubSubRunEvent
+				So(protojson.Unmarshal(t.Message.GetData(), &msg), ShouldBeNil)
+				So(&msg, ShouldResembleProto, &cvpb.PubSubRunEvent{
+					Type: cvpb.PubSubRunEvent_RUN_ENDED,
+					Run: &cvpb.PubSubRun{
+						Id:       r.ID.PublicID(),
+						Status:   cvpb.Run_SUCCEEDED,
+						Eversion: int64(r.EVersion),
+						Hostname: ct.Env.LogicalHostname,
+					},
+					EventTime: timestamppb.New(r.EndTime),
+				})
+			})
+		})
+
+		Convey("RunStarted enqueues a task", func() {
+			So(datastore.RunInTransaction(ctx, func(tCtx context.Context) error {
+				return publisher.RunStarted(tCtx, r.ID, run.Status_RUNNING, r.EVersion, r.StartTime)
+			}, nil), ShouldBeNil)
+			So(ct.TQ.Tasks(), ShouldHaveLength, 1)
+			t := findTask(ct.TQ.Tasks(), v1RunEventsTaskClass)
+			So(t.Message.GetAttributes(), ShouldContainKey, "type")
+			So(t.Message.GetAttributes()["type"], ShouldEqual, "RUN_STARTED")
+
+			var msg cvpb.PubSubRunEvent
+			So(protojson.Unmarshal(t.Message.GetData(), &msg), ShouldBeNil)
+			So(msg.GetRun().GetStatus(), ShouldEqual, cvpb.Run_RUNNING)
+			So(msg.GetEventTime().AsTime(), ShouldEqual, r.StartTime)
+		})
+
+		Convey("TryjobsUpdated enqueues a task", func() {
+			es := &tryjob.ExecutionState{
+				Requirement: &tryjob.Requirement{
+					Definitions: []*tryjob.Definition{
+						{
+							Backend: &tryjob.Definition_Buildbucket_{
+								Buildbucket: &tryjob.Definition_Buildbucket{
+									Host: "buildbucket.example.com",
+									Builder: &bbpb.BuilderID{
+										Project: "lproject",
+										Bucket:  "try",
+										Builder: "linux",
+									},
+								},
+							},
+							Critical: true,
+						},
+						{
+							Backend: &tryjob.Definition_Buildbucket_{
+								Buildbucket: &tryjob.Definition_Buildbucket{
+									Host: "buildbucket.example.com",
+									Builder: &bbpb.BuilderID{
+										Project: "lproject",
+										Bucket:  "try",
+										Builder: "mac",
+									},
+								},
+							},
+						},
+					},
+				},
+				Executions: []*tryjob.ExecutionState_Execution{
+					{
+						Attempts: []*tryjob.ExecutionState_Execution_Attempt{
+							{
+								ExternalId: "buildbucket/buildbucket.example.com/1",
+								Status:     tryjob.Status_ENDED,
+								Result:     &tryjob.Result{Status: tryjob.Result_FAILED_TRANSIENTLY},
+							},
+							{
+								ExternalId: "buildbucket/buildbucket.example.com/2",
+								Status:     tryjob.Status_TRIGGERED,
+								Result:     &tryjob.Result{Status: tryjob.Result_UNKNOWN},
+							},
+						},
+					},
+					{},
+				},
+			}
+			So(datastore.RunInTransaction(ctx, func(tCtx context.Context) error {
+				return publisher.TryjobsUpdated(tCtx, r.ID, run.Status_RUNNING, r.EVersion, r.StartTime, es)
+			}, nil), ShouldBeNil)
+			t := findTask(ct.TQ.Tasks(), v1RunEventsTaskClass)
+
+			var msg cvpb.PubSubRunEvent
+			So(protojson.Unmarshal(t.Message.GetData(), &msg), ShouldBeNil)
+			So(msg.GetType(), ShouldEqual, cvpb.PubSubRunEvent_TRYJOBS_UPDATED)
+			So(msg.GetTryjobs(), ShouldResembleProto, []*cvpb.PubSubTryjob{
+				{
+					Builder:      "lproject/try/linux",
+					ExternalId:   "buildbucket/buildbucket.example.com/2",
+					Status:       cvpb.PubSubTryjob_TRIGGERED,
+					ResultStatus: cvpb.PubSubTryjob_UNKNOWN,
+					Critical:     true,
+					Attempts:     2,
+				},
+				{
+					Builder: "lproject/try/mac",
+				},
+			})
 		})
 	})
 }
+
+func findTask(tasks tqtesting.TaskList, class string) *tqtesting.Task {
+	for _, t := range tasks {
+		if t.Class == class {
+			return t
+		}
+	}
+	panic(fmt.Errorf("no task of class %q", class))
+}
@@ -47,6 +47,11 @@ type Executor struct {
 	RM rm
 	// ShouldStop returns whether Executor should stop the execution.
 	ShouldStop func() bool
+	// Flakiness is used to decide whether failed Tryjobs which may only be
+	// retried on flaky failures can be retried.
+	//
+	// If nil, such Tryjobs are never retried.
+	Flakiness FlakinessChecker
 	// logEntries records what has happened during the execution.
 	logEntries []*tryjob.ExecutionLogEntry
 	// stagedMetricReportFns temporally stores metrics reporting functions.
@@ -176,7 +181,7 @@ func (e *Executor) prepExecutionPlan(ctx context.Context, execState *tryjob.Exec
 		panic(fmt.Errorf("the Executor can't handle updates to Requirement and Tryjobs at the same time"))
 	case hasTryjobsUpdated:
 		logging.Debugf(ctx, "received update for tryjobs %v", tryjobsUpdated)
-		return e.handleUpdatedTryjobs(ctx, tryjobsUpdated, execState)
+		return e.handleUpdatedTryjobs(ctx, tryjobsUpdated, execState, r.ID.LUCIProject())
 	case reqmtChanged && r.Tryjobs.GetRequirementVersion() <= execState.GetRequirementVersion():
 		logging.Errorf(ctx, "Tryjob Executor is executing a Requirement that is either later than or equal to the requested Requirement version. current: %d, got: %d ", r.Tryjobs.GetRequirementVersion(), execState.GetRequirementVersion())
 		return execState, nil, nil
@@ -201,7 +206,7 @@ func (e *Executor) prepExecutionPlan(ctx context.Context, execState *tryjob.Exec
 // Returns a ExecutionState with succeeded status and empty plan if all
 // critical Tryjobs have ended successfully.
 // Returns a non-empty plan if any Tryjob should be retried.
-func (e *Executor) handleUpdatedTryjobs(ctx context.Context, tryjobs []int64, execState *tryjob.ExecutionState) (*tryjob.ExecutionState, *plan, error) {
+func (e *Executor) handleUpdatedTryjobs(ctx context.Context, tryjobs []int64, execState *tryjob.ExecutionState, luciProject string) (*tryjob.ExecutionState, *plan, error) {
 	tryjobByID, err := tryjob.LoadTryjobsMapByIDs(ctx, common.MakeTryjobIDs(tryjobs...))
 	if err != nil {
 		return nil, nil, err
@@ -276,7 +281,7 @@ func (e *Executor) handleUpdatedTryjobs(ctx context.Context, tryjobs []int64, ex
 		execState.Status = tryjob.ExecutionState_SUCCEEDED
 		return execState, nil, nil
 	case hasFailed:
-		if ok := e.canRetryAll(ctx, execState, failedIndices); !ok {
+		if !e.canRetryAll(ctx, luciProject, execState, failedIndices) {
 			execState.Status = tryjob.ExecutionState_FAILED
 			execState.FailureReason = composeReason(failedTryjobs)
 			return execState, nil, nil
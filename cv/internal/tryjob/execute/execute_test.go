@@ -57,8 +57,11 @@ func TestPrepExecutionPlan(t *testing.T) {
 
 		Convey("Tryjobs Updated", func() {
 			const builderFoo = "foo"
+			r := &run.Run{
+				ID: common.MakeRunID("test-proj", ct.Clock.Now().Add(-time.Hour), 1, []byte("abcd")),
+			}
 			prepPlan := func(execState *tryjob.ExecutionState, updatedTryjobs ...int64) *plan {
-				_, p, err := executor.prepExecutionPlan(ctx, execState, nil, updatedTryjobs, false)
+				_, p, err := executor.prepExecutionPlan(ctx, execState, r, updatedTryjobs, false)
 				So(err, ShouldBeNil)
 				return p
 			}
@@ -67,6 +67,19 @@ func (e *Executor) logRetryDenied(ctx context.Context, execState *tryjob.Executi
 	})
 }
 
+func (e *Executor) logFlakinessChecked(ctx context.Context, def *tryjob.Definition, attempt *tryjob.ExecutionState_Execution_Attempt, flaky bool, reason string) {
+	e.log(&tryjob.ExecutionLogEntry{
+		Time: timestamppb.New(clock.Now(ctx).UTC()),
+		Kind: &tryjob.ExecutionLogEntry_FlakinessChecked_{
+			FlakinessChecked: &tryjob.ExecutionLogEntry_FlakinessChecked{
+				Snapshot: makeLogTryjobSnapshotFromAttempt(def, attempt),
+				Flaky:    flaky,
+				Reason:   reason,
+			},
+		},
+	})
+}
+
 // log adds a new execution log entry.
 func (e *Executor) log(entry *tryjob.ExecutionLogEntry) {
 	if entry.GetTime() == nil {
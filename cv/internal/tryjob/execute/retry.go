@@ -39,39 +39,61 @@ const (
 	retryAllowedIgnoreQuota
 )
 
+// FlakinessChecker decides whether the failure of a Tryjob looks flaky.
+type FlakinessChecker interface {
+	// CheckFlaky returns whether the failure of the given ended Tryjob attempt
+	// looks flaky, i.e. likely not caused by the CLs under test, and a
+	// human-readable explanation of the decision.
+	//
+	// luciProject is the LUCI project of the Run.
+	CheckFlaky(ctx context.Context, luciProject string, definition *tryjob.Definition, result *tryjob.Result) (flaky bool, reason string, err error)
+}
+
 // canRetryAll checks whether all failed critical tryjobs can be retried.
 //
+// Each tryjob is subject to its own retry config if it has one, or to the
+// retry config of the Requirement otherwise. Retries of all tryjobs count
+// towards the global quota of the Requirement retry config, if it's enabled.
+//
 // Panics when any provided index points to a tryjob that is not critical or
 // its latest attempt doesn't fail.
 //
 // Always updates the consumed quota for failed executions.
 func (e *Executor) canRetryAll(
 	ctx context.Context,
+	luciProject string,
 	execState *tryjob.ExecutionState,
 	failedIndices []int,
 ) bool {
-	if !isRetryEnabled(execState.GetRequirement().GetRetryConfig()) {
+	if !hasRetryEnabled(execState, failedIndices) {
 		e.logRetryDenied(ctx, execState, failedIndices, "retry is not enabled in the config")
 		return false
 	}
+	globalRetryConfig := execState.GetRequirement().GetRetryConfig()
+	globalRetryEnabled := isRetryEnabled(globalRetryConfig)
 
 	var totalUsedQuota int32
 	canRetryAll := true
 	for _, execution := range execState.GetExecutions() {
 		totalUsedQuota += execution.GetUsedQuota()
 	}
-	retryConfig := execState.GetRequirement().GetRetryConfig()
 	for _, idx := range failedIndices {
 		definition := execState.GetRequirement().GetDefinitions()[idx]
 		exec := execState.GetExecutions()[idx]
 		attempt := tryjob.LatestAttempt(exec)
 		ensureTryjobCriticalAndFailed(definition, attempt)
+		retryConfig := effectiveRetryConfig(execState, idx)
 		switch canRetry(definition, attempt) {
 		case retryDenied:
 			canRetryAll = false
 			e.logRetryDenied(ctx, execState, []int{idx}, "tryjob explicitly denies retry in its output")
 		case retryAllowedIgnoreQuota:
 		case retryAllowed:
+			if !isRetryEnabled(retryConfig) {
+				canRetryAll = false
+				e.logRetryDenied(ctx, execState, []int{idx}, "retry is not enabled in the config")
+				continue
+			}
 			var quotaToRetry int32
 			switch attempt.Result.Status {
 			case tryjob.Result_TIMEOUT:
@@ -89,19 +111,68 @@ func (e *Executor) canRetryAll(
 			if exec.UsedQuota > retryConfig.GetSingleQuota() {
 				canRetryAll = false
 				e.logRetryDenied(ctx, execState, []int{idx}, "insufficient quota")
+				continue
+			}
+			if retryConfig.GetFlakyOnly() && attempt.Result.Status == tryjob.Result_FAILED_PERMANENTLY {
+				if !e.checkFlaky(ctx, luciProject, definition, attempt) {
+					canRetryAll = false
+					e.logRetryDenied(ctx, execState, []int{idx}, "the failure doesn't look flaky")
+				}
 			}
 		default:
 			panic(fmt.Errorf("unknown retriability"))
 		}
 	}
 
-	if canRetryAll && totalUsedQuota > retryConfig.GetGlobalQuota() {
+	if canRetryAll && globalRetryEnabled && totalUsedQuota > globalRetryConfig.GetGlobalQuota() {
 		canRetryAll = false
 		e.logRetryDenied(ctx, execState, failedIndices, "insufficient global quota")
 	}
 	return canRetryAll
 }
 
+// checkFlaky checks whether the failure of the attempt looks flaky and records
+// the decision in the execution log.
+//
+// If the flakiness can't be checked, the failure is considered not flaky, so
+// that an outage of LUCI Analysis doesn't block the Run.
+func (e *Executor) checkFlaky(ctx context.Context, luciProject string, definition *tryjob.Definition, attempt *tryjob.ExecutionState_Execution_Attempt) bool {
+	var flaky bool
+	var reason string
+	if e.Flakiness == nil {
+		reason = "flakiness analysis is not available"
+	} else {
+		var err error
+		flaky, reason, err = e.Flakiness.CheckFlaky(ctx, luciProject, definition, attempt.GetResult())
+		if err != nil {
+			logging.Errorf(ctx, "failed to check flakiness of tryjob %d: %s", attempt.GetTryjobId(), err)
+			flaky, reason = false, "failed to check flakiness"
+		}
+	}
+	e.logFlakinessChecked(ctx, definition, attempt, flaky, reason)
+	return flaky
+}
+
+// hasRetryEnabled returns true if retry is enabled for any of the given
+// tryjobs.
+func hasRetryEnabled(execState *tryjob.ExecutionState, indices []int) bool {
+	for _, idx := range indices {
+		if isRetryEnabled(effectiveRetryConfig(execState, idx)) {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveRetryConfig returns the retry config of the tryjob if it has one,
+// or the retry config of the Requirement otherwise.
+func effectiveRetryConfig(execState *tryjob.ExecutionState, idx int) *cfgpb.Verifiers_Tryjob_RetryConfig {
+	if rc := execState.GetRequirement().GetDefinitions()[idx].GetRetryConfig(); rc != nil {
+		return rc
+	}
+	return execState.GetRequirement().GetRetryConfig()
+}
+
 func ensureTryjobCriticalAndFailed(def *tryjob.Definition, attempt *tryjob.ExecutionState_Execution_Attempt) {
 	switch {
 	case !def.Critical:
@@ -23,6 +23,8 @@ import (
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
 
 	cfgpb "go.chromium.org/luci/cv/api/config/v2"
 	"go.chromium.org/luci/cv/api/recipe/v1"
@@ -35,6 +37,7 @@ import (
 func TestCanRetryAll(t *testing.T) {
 	Convey("CanRetryAll", t, func() {
 		ctx, _ := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		const lProject = "infra"
 		const builderZero = "builder-zero"
 		const builderOne = "builder-one"
 		execState := newExecStateBuilder().
@@ -63,7 +66,7 @@ func TestCanRetryAll(t *testing.T) {
 					appendAttempt(builderZero, makeAttempt(1, tryjob.Status_ENDED, tryjob.Result_FAILED_TRANSIENTLY)).
 					build()
 			})
-			ok := executor.canRetryAll(ctx, execState, []int{0})
+			ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
 			So(ok, ShouldBeFalse)
 			So(executor.logEntries, ShouldResembleProto, []*tryjob.ExecutionLogEntry{
 				{
@@ -93,7 +96,7 @@ func TestCanRetryAll(t *testing.T) {
 					execState = newExecStateBuilder(execState).
 						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_TRANSIENTLY)).
 						build()
-					ok := executor.canRetryAll(ctx, execState, []int{0})
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
 					So(ok, ShouldBeTrue)
 					So(executor.logEntries, ShouldBeEmpty)
 				})
@@ -101,7 +104,7 @@ func TestCanRetryAll(t *testing.T) {
 					execState = newExecStateBuilder(execState).
 						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
 						build()
-					ok := executor.canRetryAll(ctx, execState, []int{0})
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
 					So(ok, ShouldBeFalse)
 					So(execState.GetExecutions()[0].GetUsedQuota(), ShouldEqual, 3)
 					So(executor.logEntries, ShouldResembleProto, []*tryjob.ExecutionLogEntry{
@@ -131,7 +134,7 @@ func TestCanRetryAll(t *testing.T) {
 						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_TIMEOUT)).
 						appendAttempt(builderOne, makeAttempt(567, tryjob.Status_ENDED, tryjob.Result_TIMEOUT)).
 						build()
-					ok := executor.canRetryAll(ctx, execState, []int{0, 1})
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0, 1})
 					So(ok, ShouldBeFalse)
 					So(execState.GetExecutions()[0].GetUsedQuota(), ShouldEqual, 2)
 					So(execState.GetExecutions()[1].GetUsedQuota(), ShouldEqual, 2)
@@ -171,7 +174,7 @@ func TestCanRetryAll(t *testing.T) {
 						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
 						build()
 					execState.GetExecutions()[0].Attempts[0].Reused = true
-					ok := executor.canRetryAll(ctx, execState, []int{0})
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
 					So(ok, ShouldBeTrue)
 					So(execState.GetExecutions()[0].GetUsedQuota(), ShouldEqual, 0)
 					So(executor.logEntries, ShouldBeEmpty)
@@ -181,7 +184,7 @@ func TestCanRetryAll(t *testing.T) {
 						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_TRANSIENTLY)).
 						build()
 					execState.GetExecutions()[0].Attempts[0].Result.Output = &recipe.Output{Retry: recipe.Output_OUTPUT_RETRY_DENIED}
-					ok := executor.canRetryAll(ctx, execState, []int{0})
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
 					So(ok, ShouldBeFalse)
 					So(executor.logEntries, ShouldResembleProto, []*tryjob.ExecutionLogEntry{
 						{
@@ -210,5 +213,148 @@ func TestCanRetryAll(t *testing.T) {
 				})
 			})
 		})
+		Convey("With builder retry config", func() {
+			def := execState.GetRequirement().GetDefinitions()[0]
+			def.RetryConfig = &cfgpb.Verifiers_Tryjob_RetryConfig{
+				SingleQuota:   1,
+				FailureWeight: 1,
+			}
+			snapshot := func(tjID int64, status tryjob.Result_Status) *tryjob.ExecutionLogEntry_TryjobSnapshot {
+				return &tryjob.ExecutionLogEntry_TryjobSnapshot{
+					Definition: def,
+					Id:         tjID,
+					ExternalId: string(tryjob.MustBuildbucketID("buildbucket.example.com", math.MaxInt64-tjID)),
+					Status:     tryjob.Status_ENDED,
+					Result:     &tryjob.Result{Status: status},
+				}
+			}
+
+			Convey("overrides the requirement retry config", func() {
+				execState = newExecStateBuilder(execState).
+					withRetryConfig(nil).
+					appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+					build()
+				ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+				So(ok, ShouldBeTrue)
+				So(execState.GetExecutions()[0].GetUsedQuota(), ShouldEqual, 1)
+
+				execState = newExecStateBuilder(execState).
+					appendAttempt(builderZero, makeAttempt(346, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+					build()
+				ok = executor.canRetryAll(ctx, lProject, execState, []int{0})
+				So(ok, ShouldBeFalse)
+				So(executor.logEntries[0].GetRetryDenied().GetReason(), ShouldEqual, "insufficient quota")
+			})
+
+			Convey("can disable retries of the builder", func() {
+				def.RetryConfig = &cfgpb.Verifiers_Tryjob_RetryConfig{}
+				execState = newExecStateBuilder(execState).
+					appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_TRANSIENTLY)).
+					build()
+				ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+				So(ok, ShouldBeFalse)
+				So(executor.logEntries[0].GetRetryDenied().GetReason(), ShouldEqual, "retry is not enabled in the config")
+			})
+
+			Convey("doesn't enable retries of other builders", func() {
+				execState = newExecStateBuilder(execState).
+					withRetryConfig(nil).
+					appendAttempt(builderOne, makeAttempt(567, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+					build()
+				ok := executor.canRetryAll(ctx, lProject, execState, []int{1})
+				So(ok, ShouldBeFalse)
+				So(executor.logEntries[0].GetRetryDenied().GetReason(), ShouldEqual, "retry is not enabled in the config")
+			})
+
+			Convey("flaky only", func() {
+				def.RetryConfig.FlakyOnly = true
+				checker := &fakeFlakinessChecker{flaky: true, reason: "all failed tests are flaky"}
+				executor.Flakiness = checker
+
+				Convey("retries flaky failures", func() {
+					execState = newExecStateBuilder(execState).
+						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+						build()
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+					So(ok, ShouldBeTrue)
+					So(checker.luciProject, ShouldEqual, lProject)
+					So(executor.logEntries, ShouldResembleProto, []*tryjob.ExecutionLogEntry{
+						{
+							Time: timestamppb.New(clock.Now(ctx).UTC()),
+							Kind: &tryjob.ExecutionLogEntry_FlakinessChecked_{
+								FlakinessChecked: &tryjob.ExecutionLogEntry_FlakinessChecked{
+									Snapshot: snapshot(345, tryjob.Result_FAILED_PERMANENTLY),
+									Flaky:    true,
+									Reason:   "all failed tests are flaky",
+								},
+							},
+						},
+					})
+				})
+
+				Convey("doesn't retry other failures", func() {
+					checker.flaky, checker.reason = false, "test foo isn't flaky"
+					execState = newExecStateBuilder(execState).
+						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+						build()
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+					So(ok, ShouldBeFalse)
+					So(executor.logEntries, ShouldHaveLength, 2)
+					So(executor.logEntries[0].GetFlakinessChecked().GetReason(), ShouldEqual, "test foo isn't flaky")
+					So(executor.logEntries[1].GetRetryDenied().GetReason(), ShouldEqual, "the failure doesn't look flaky")
+				})
+
+				Convey("doesn't check transient failures", func() {
+					execState = newExecStateBuilder(execState).
+						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_TRANSIENTLY)).
+						build()
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+					So(ok, ShouldBeTrue)
+					So(checker.luciProject, ShouldBeEmpty)
+					So(executor.logEntries, ShouldBeEmpty)
+				})
+
+				Convey("without flakiness checker", func() {
+					executor.Flakiness = nil
+					execState = newExecStateBuilder(execState).
+						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+						build()
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+					So(ok, ShouldBeFalse)
+					So(executor.logEntries[0].GetFlakinessChecked(), ShouldResembleProto, &tryjob.ExecutionLogEntry_FlakinessChecked{
+						Snapshot: snapshot(345, tryjob.Result_FAILED_PERMANENTLY),
+						Reason:   "flakiness analysis is not available",
+					})
+				})
+
+				Convey("treats errors as not flaky", func() {
+					checker.err = errors.New("boom", transient.Tag)
+					execState = newExecStateBuilder(execState).
+						appendAttempt(builderZero, makeAttempt(345, tryjob.Status_ENDED, tryjob.Result_FAILED_PERMANENTLY)).
+						build()
+					ok := executor.canRetryAll(ctx, lProject, execState, []int{0})
+					So(ok, ShouldBeFalse)
+					So(executor.logEntries, ShouldHaveLength, 2)
+					So(executor.logEntries[0].GetFlakinessChecked(), ShouldResembleProto, &tryjob.ExecutionLogEntry_FlakinessChecked{
+						Snapshot: snapshot(345, tryjob.Result_FAILED_PERMANENTLY),
+						Reason:   "failed to check flakiness",
+					})
+					So(executor.logEntries[1].GetRetryDenied().GetReason(), ShouldEqual, "the failure doesn't look flaky")
+				})
+			})
+		})
 	})
 }
+
+type fakeFlakinessChecker struct {
+	flaky  bool
+	reason string
+	err    error
+
+	luciProject string
+}
+
+func (f *fakeFlakinessChecker) CheckFlaky(ctx context.Context, luciProject string, definition *tryjob.Definition, result *tryjob.Result) (bool, string, error) {
+	f.luciProject = luciProject
+	return f.flaky, f.reason, f.err
+}
@@ -0,0 +1,224 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flakiness decides whether Tryjob failures look flaky based on the
+// test history in LUCI Analysis.
+package flakiness
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	analysispb "go.chromium.org/luci/analysis/proto/v1"
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+	"go.chromium.org/luci/grpc/prpc"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+	"go.chromium.org/luci/server/auth"
+
+	"go.chromium.org/luci/cv/internal/buildbucket"
+	"go.chromium.org/luci/cv/internal/tryjob"
+)
+
+const (
+	// maxFailedTests is the max number of failed tests of a single Tryjob
+	// which are looked up in LUCI Analysis.
+	//
+	// Matches the max number of test variants per QueryFailureRate request.
+	// If more tests failed, the failure is unlikely to be flaky anyway.
+	maxFailedTests = 100
+
+	// DefaultMinFlakyVerdicts is the default number of recent flaky verdicts
+	// a test must have for its failure to look flaky.
+	DefaultMinFlakyVerdicts = 2
+)
+
+// RDBClient is a subset of the ResultDB API used by Checker.
+type RDBClient interface {
+	QueryTestVariants(ctx context.Context, in *rdbpb.QueryTestVariantsRequest, opts ...grpc.CallOption) (*rdbpb.QueryTestVariantsResponse, error)
+}
+
+// AnalysisClient is a subset of the LUCI Analysis API used by Checker.
+type AnalysisClient interface {
+	QueryFailureRate(ctx context.Context, in *analysispb.QueryTestVariantFailureRateRequest, opts ...grpc.CallOption) (*analysispb.QueryTestVariantFailureRateResponse, error)
+}
+
+// Checker decides whether the failure of a Buildbucket Tryjob looks flaky.
+//
+// A failure looks flaky if every test which unexpectedly failed in the build
+// has had at least MinFlakyVerdicts verdicts with both expected and unexpected
+// runs in LUCI Analysis over the last 5 weekdays. Failures without failed tests
+// (e.g. compile failures) and failures of tests without history in LUCI
+// Analysis never look flaky.
+//
+// Checker implements execute.FlakinessChecker.
+type Checker struct {
+	// BBFactory creates clients to find the ResultDB invocation of a build.
+	BBFactory buildbucket.ClientFactory
+	// MakeRDBClient creates a ResultDB client for the given host.
+	MakeRDBClient func(ctx context.Context, host, luciProject string) (RDBClient, error)
+	// MakeAnalysisClient creates a LUCI Analysis client.
+	MakeAnalysisClient func(ctx context.Context, luciProject string) (AnalysisClient, error)
+	// MinFlakyVerdicts is the number of recent flaky verdicts a test must have
+	// for its failure to look flaky.
+	MinFlakyVerdicts int
+}
+
+// NewChecker returns a Checker for use in production, which queries LUCI
+// Analysis at the given host.
+func NewChecker(bbFactory buildbucket.ClientFactory, analysisHost string) *Checker {
+	return &Checker{
+		BBFactory: bbFactory,
+		MakeRDBClient: func(ctx context.Context, host, luciProject string) (RDBClient, error) {
+			c, err := makePRPCClient(ctx, host, luciProject)
+			if err != nil {
+				return nil, err
+			}
+			return rdbpb.NewResultDBPRPCClient(c), nil
+		},
+		MakeAnalysisClient: func(ctx context.Context, luciProject string) (AnalysisClient, error) {
+			c, err := makePRPCClient(ctx, analysisHost, luciProject)
+			if err != nil {
+				return nil, err
+			}
+			return analysispb.NewTestVariantsPRPCClient(c), nil
+		},
+		MinFlakyVerdicts: DefaultMinFlakyVerdicts,
+	}
+}
+
+func makePRPCClient(ctx context.Context, host, luciProject string) (*prpc.Client, error) {
+	rt, err := auth.GetRPCTransport(ctx, auth.AsProject, auth.WithProject(luciProject))
+	if err != nil {
+		return nil, err
+	}
+	return &prpc.Client{
+		C:    &http.Client{Transport: rt},
+		Host: host,
+	}, nil
+}
+
+var buildMask = &bbpb.BuildMask{
+	Fields: &fieldmaskpb.FieldMask{Paths: []string{"infra.resultdb"}},
+}
+
+// CheckFlaky implements execute.FlakinessChecker.
+func (c *Checker) CheckFlaky(ctx context.Context, luciProject string, definition *tryjob.Definition, result *tryjob.Result) (flaky bool, reason string, err error) {
+	bbHost := definition.GetBuildbucket().GetHost()
+	buildID := result.GetBuildbucket().GetId()
+	if bbHost == "" || buildID == 0 {
+		return false, "only Buildbucket tryjobs are supported", nil
+	}
+
+	bbClient, err := c.BBFactory.MakeClient(ctx, bbHost, luciProject)
+	if err != nil {
+		return false, "", err
+	}
+	build, err := bbClient.GetBuild(ctx, &bbpb.GetBuildRequest{Id: buildID, Mask: buildMask})
+	if err != nil {
+		return false, "", rpcErr(err, "failed to get build %d", buildID)
+	}
+	rdb := build.GetInfra().GetResultdb()
+	if rdb.GetInvocation() == "" {
+		return false, fmt.Sprintf("build %d has no ResultDB invocation", buildID), nil
+	}
+
+	rdbClient, err := c.MakeRDBClient(ctx, rdb.GetHostname(), luciProject)
+	if err != nil {
+		return false, "", err
+	}
+	res, err := rdbClient.QueryTestVariants(ctx, &rdbpb.QueryTestVariantsRequest{
+		Invocations: []string{rdb.GetInvocation()},
+		Predicate:   &rdbpb.TestVariantPredicate{Status: rdbpb.TestVariantStatus_UNEXPECTED},
+		PageSize:    maxFailedTests,
+		ResultLimit: 1,
+	})
+	switch {
+	case err != nil:
+		return false, "", rpcErr(err, "failed to query failed tests of build %d", buildID)
+	case len(res.GetTestVariants()) == 0:
+		return false, "no tests failed", nil
+	case res.GetNextPageToken() != "":
+		return false, fmt.Sprintf("more than %d tests failed", maxFailedTests), nil
+	}
+
+	req := &analysispb.QueryTestVariantFailureRateRequest{
+		// Test results are ingested into the LUCI project of the builder.
+		Project:      definition.GetBuildbucket().GetBuilder().GetProject(),
+		TestVariants: make([]*analysispb.TestVariantIdentifier, len(res.GetTestVariants())),
+	}
+	for i, tv := range res.GetTestVariants() {
+		req.TestVariants[i] = &analysispb.TestVariantIdentifier{
+			TestId:  tv.GetTestId(),
+			Variant: &analysispb.Variant{Def: tv.GetVariant().GetDef()},
+		}
+	}
+	analysisClient, err := c.MakeAnalysisClient(ctx, luciProject)
+	if err != nil {
+		return false, "", err
+	}
+	rates, err := analysisClient.QueryFailureRate(ctx, req)
+	if err != nil {
+		return false, "", rpcErr(err, "failed to query failure rates in LUCI Analysis")
+	}
+	analyzed := make(map[string]bool, len(rates.GetTestVariants()))
+	for _, tv := range rates.GetTestVariants() {
+		if n := flakyVerdicts(tv); n < c.MinFlakyVerdicts {
+			return false, fmt.Sprintf("test %q had %d flaky verdicts recently, fewer than the required %d", tv.GetTestId(), n, c.MinFlakyVerdicts), nil
+		}
+		analyzed[variantKey(tv.GetTestId(), tv.GetVariant().GetDef())] = true
+	}
+	for _, tv := range req.TestVariants {
+		if !analyzed[variantKey(tv.GetTestId(), tv.GetVariant().GetDef())] {
+			return false, fmt.Sprintf("test %q has no recent history in LUCI Analysis", tv.GetTestId()), nil
+		}
+	}
+	return true, fmt.Sprintf("all %d failed tests have been flaky recently", len(req.TestVariants)), nil
+}
+
+// flakyVerdicts returns the number of recent verdicts of the test variant which
+// had both expected and unexpected runs.
+func flakyVerdicts(tv *analysispb.TestVariantFailureRateAnalysis) int {
+	n := 0
+	for _, stats := range tv.GetIntervalStats() {
+		n += int(stats.GetTotalRunFlakyVerdicts())
+	}
+	return n
+}
+
+// variantKey returns a string identifying the test variant.
+func variantKey(testID string, def map[string]string) string {
+	pairs := make([]string, 0, len(def))
+	for k, v := range def {
+		pairs = append(pairs, fmt.Sprintf("%q:%q", k, v))
+	}
+	sort.Strings(pairs)
+	return fmt.Sprintf("%q{%s}", testID, strings.Join(pairs, ","))
+}
+
+func rpcErr(err error, format string, args ...interface{}) error {
+	err = errors.Annotate(err, format, args...).Err()
+	if grpcutil.IsTransientCode(grpcutil.Code(err)) {
+		err = transient.Tag.Apply(err)
+	}
+	return err
+}
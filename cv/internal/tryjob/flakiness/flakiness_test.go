@@ -0,0 +1,202 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flakiness
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	analysispb "go.chromium.org/luci/analysis/proto/v1"
+	bbpb "go.chromium.org/luci/buildbucket/proto"
+	"go.chromium.org/luci/common/retry/transient"
+	rdbpb "go.chromium.org/luci/resultdb/proto/v1"
+
+	"go.chromium.org/luci/cv/internal/buildbucket"
+	"go.chromium.org/luci/cv/internal/tryjob"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type fakeBB struct {
+	buildbucket.Client
+	build *bbpb.Build
+}
+
+func (f *fakeBB) MakeClient(ctx context.Context, host, luciProject string) (buildbucket.Client, error) {
+	return f, nil
+}
+
+func (f *fakeBB) GetBuild(ctx context.Context, in *bbpb.GetBuildRequest, opts ...grpc.CallOption) (*bbpb.Build, error) {
+	if in.GetId() != f.build.GetId() {
+		return nil, status.Errorf(codes.NotFound, "not found")
+	}
+	return f.build, nil
+}
+
+type fakeRDB struct {
+	res *rdbpb.QueryTestVariantsResponse
+	req *rdbpb.QueryTestVariantsRequest
+}
+
+func (f *fakeRDB) QueryTestVariants(ctx context.Context, in *rdbpb.QueryTestVariantsRequest, opts ...grpc.CallOption) (*rdbpb.QueryTestVariantsResponse, error) {
+	f.req = in
+	return f.res, nil
+}
+
+type fakeAnalysis struct {
+	flaky   map[string]int32
+	missing string
+	err     error
+	req     *analysispb.QueryTestVariantFailureRateRequest
+}
+
+func (f *fakeAnalysis) QueryFailureRate(ctx context.Context, in *analysispb.QueryTestVariantFailureRateRequest, opts ...grpc.CallOption) (*analysispb.QueryTestVariantFailureRateResponse, error) {
+	f.req = in
+	if f.err != nil {
+		return nil, f.err
+	}
+	res := &analysispb.QueryTestVariantFailureRateResponse{}
+	for _, tv := range in.GetTestVariants() {
+		if f.missing == tv.GetTestId() {
+			continue
+		}
+		res.TestVariants = append(res.TestVariants, &analysispb.TestVariantFailureRateAnalysis{
+			TestId:  tv.GetTestId(),
+			Variant: tv.GetVariant(),
+			IntervalStats: []*analysispb.TestVariantFailureRateAnalysis_IntervalStats{
+				{IntervalAge: 1, TotalRunFlakyVerdicts: f.flaky[tv.GetTestId()]},
+				{IntervalAge: 2, TotalRunFlakyVerdicts: f.flaky[tv.GetTestId()]},
+			},
+		})
+	}
+	return res, nil
+}
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	Convey("Checker", t, func() {
+		ctx := context.Background()
+		const buildID = 123
+		bb := &fakeBB{build: &bbpb.Build{
+			Id: buildID,
+			Infra: &bbpb.BuildInfra{
+				Resultdb: &bbpb.BuildInfra_ResultDB{
+					Hostname:   "rdb.example.com",
+					Invocation: "invocations/build-123",
+				},
+			},
+		}}
+		rdb := &fakeRDB{res: &rdbpb.QueryTestVariantsResponse{
+			TestVariants: []*rdbpb.TestVariant{
+				{TestId: "test1", Variant: &rdbpb.Variant{Def: map[string]string{"os": "linux"}}},
+				{TestId: "test2"},
+			},
+		}}
+		analysis := &fakeAnalysis{flaky: map[string]int32{"test1": 1, "test2": 3}}
+		c := &Checker{
+			BBFactory: bb,
+			MakeRDBClient: func(ctx context.Context, host, luciProject string) (RDBClient, error) {
+				So(host, ShouldEqual, "rdb.example.com")
+				return rdb, nil
+			},
+			MakeAnalysisClient: func(ctx context.Context, luciProject string) (AnalysisClient, error) {
+				return analysis, nil
+			},
+			MinFlakyVerdicts: 2,
+		}
+		def := &tryjob.Definition{
+			Backend: &tryjob.Definition_Buildbucket_{
+				Buildbucket: &tryjob.Definition_Buildbucket{
+					Host:    "bb.example.com",
+					Builder: &bbpb.BuilderID{Project: "chromium", Bucket: "try", Builder: "linux"},
+				},
+			},
+		}
+		result := &tryjob.Result{
+			Status: tryjob.Result_FAILED_PERMANENTLY,
+			Backend: &tryjob.Result_Buildbucket_{
+				Buildbucket: &tryjob.Result_Buildbucket{Id: buildID},
+			},
+		}
+
+		Convey("Flaky", func() {
+			flaky, reason, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldBeNil)
+			So(flaky, ShouldBeTrue)
+			So(reason, ShouldEqual, "all 2 failed tests have been flaky recently")
+			So(rdb.req.GetInvocations(), ShouldResemble, []string{"invocations/build-123"})
+			So(analysis.req, ShouldResembleProto, &analysispb.QueryTestVariantFailureRateRequest{
+				Project: "chromium",
+				TestVariants: []*analysispb.TestVariantIdentifier{
+					{TestId: "test1", Variant: &analysispb.Variant{Def: map[string]string{"os": "linux"}}},
+					{TestId: "test2", Variant: &analysispb.Variant{}},
+				},
+			})
+		})
+
+		Convey("Not flaky", func() {
+			analysis.flaky["test2"] = 0
+			flaky, reason, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldBeNil)
+			So(flaky, ShouldBeFalse)
+			So(reason, ShouldEqual, `test "test2" had 0 flaky verdicts recently, fewer than the required 2`)
+		})
+
+		Convey("Not analyzed", func() {
+			analysis.missing = "test1"
+			flaky, reason, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldBeNil)
+			So(flaky, ShouldBeFalse)
+			So(reason, ShouldEqual, `test "test1" has no recent history in LUCI Analysis`)
+		})
+
+		Convey("No failed tests", func() {
+			rdb.res.TestVariants = nil
+			flaky, reason, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldBeNil)
+			So(flaky, ShouldBeFalse)
+			So(reason, ShouldEqual, "no tests failed")
+		})
+
+		Convey("Too many failed tests", func() {
+			rdb.res.NextPageToken = "next"
+			flaky, reason, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldBeNil)
+			So(flaky, ShouldBeFalse)
+			So(reason, ShouldEqual, "more than 100 tests failed")
+		})
+
+		Convey("No ResultDB invocation", func() {
+			bb.build.Infra = nil
+			flaky, reason, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldBeNil)
+			So(flaky, ShouldBeFalse)
+			So(reason, ShouldEqual, "build 123 has no ResultDB invocation")
+		})
+
+		Convey("Transient errors", func() {
+			analysis.err = status.Errorf(codes.Unavailable, "try later")
+			_, _, err := c.CheckFlaky(ctx, "infra", def, result)
+			So(err, ShouldErrLike, "failed to query failure rates")
+			So(transient.Tag.In(err), ShouldBeTrue)
+		})
+	})
+}
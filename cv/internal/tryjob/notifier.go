@@ -28,11 +28,15 @@ import (
 
 const CancelStaleTaskClass = "cancel-stale-tryjobs"
 const UpdateTaskClass = "update-tryjob"
+const LaunchTrainTaskClass = "launch-train-tryjob"
+const CancelTrainTaskClass = "cancel-train-tryjob"
 
 // TaskBindings allow us to assign handlers separately from task registration.
 type TaskBindings struct {
 	CancelStale tq.TaskClassRef
 	Update      tq.TaskClassRef
+	LaunchTrain tq.TaskClassRef
+	CancelTrain tq.TaskClassRef
 	tqd         *tq.Dispatcher
 }
 
@@ -64,6 +68,26 @@ func NewNotifier(tqd *tq.Dispatcher) *Notifier {
 				QuietOnError: true,
 			},
 		),
+		LaunchTrain: tqd.RegisterTaskClass(
+			tq.TaskClass{
+				ID:           LaunchTrainTaskClass,
+				Prototype:    &LaunchTrainTryjobTask{},
+				Queue:        "launch-train-tryjob",
+				Kind:         tq.Transactional,
+				Quiet:        true,
+				QuietOnError: true,
+			},
+		),
+		CancelTrain: tqd.RegisterTaskClass(
+			tq.TaskClass{
+				ID:           CancelTrainTaskClass,
+				Prototype:    &CancelTrainTryjobTask{},
+				Queue:        "cancel-train-tryjob",
+				Kind:         tq.Transactional,
+				Quiet:        true,
+				QuietOnError: true,
+			},
+		),
 		tqd: tqd,
 	},
 	}
@@ -112,3 +136,25 @@ func (n *Notifier) ScheduleUpdate(ctx context.Context, id common.TryjobID, eid E
 		Payload: &UpdateTryjobTask{ExternalId: string(eid), Id: int64(id)},
 	})
 }
+
+// ScheduleLaunchTrain schedules a task to launch the Tryjob verifying a submit
+// train.
+//
+// MUST be called in a datastore transaction.
+func (n *Notifier) ScheduleLaunchTrain(ctx context.Context, id common.TryjobID) error {
+	return n.Bindings.tqd.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("id-%d", id),
+		Payload: &LaunchTrainTryjobTask{Id: int64(id)},
+	})
+}
+
+// ScheduleCancelTrain schedules a task to cancel the Tryjob verifying a submit
+// train.
+//
+// MUST be called in a datastore transaction.
+func (n *Notifier) ScheduleCancelTrain(ctx context.Context, id common.TryjobID) error {
+	return n.Bindings.tqd.AddTask(ctx, &tq.Task{
+		Title:   fmt.Sprintf("id-%d", id),
+		Payload: &CancelTrainTryjobTask{Id: int64(id)},
+	})
+}
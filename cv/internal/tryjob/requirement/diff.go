@@ -65,7 +65,11 @@ type DiffResult struct {
 	// UnchangedDefsReverse is a reverse mapping of `UnchangedDefs`
 	UnchangedDefsReverse DefinitionMapping
 
-	// RetryConfigChanged indicates the retry configuration has changed.
+	// RetryConfigChanged indicates the retry configuration has changed, either
+	// of the Requirement or of any of the definitions.
+	//
+	// Definitions that differ only in their retry configuration are not
+	// considered changed.
 	RetryConfigChanged bool
 }
 
@@ -86,7 +90,10 @@ func Diff(base, target *tryjob.Requirement) DiffResult {
 		baseDef, targetDef := sortedBaseDefs[0].def, sortedTargetDefs[0].def
 		switch bytes.Compare(sortedBaseDefs[0].sortKey, sortedTargetDefs[0].sortKey) {
 		case 0:
-			if !proto.Equal(baseDef, targetDef) {
+			if !proto.Equal(baseDef.GetRetryConfig(), targetDef.GetRetryConfig()) {
+				res.RetryConfigChanged = true
+			}
+			if !equalIgnoringRetryConfig(baseDef, targetDef) {
 				res.ChangedDefs[baseDef] = targetDef
 				res.ChangedDefsReverse[targetDef] = baseDef
 			} else {
@@ -120,6 +127,17 @@ func Diff(base, target *tryjob.Requirement) DiffResult {
 	return res
 }
 
+// equalIgnoringRetryConfig compares two definitions ignoring their retry
+// configs, which don't affect the tryjobs themselves.
+func equalIgnoringRetryConfig(a, b *tryjob.Definition) bool {
+	if proto.Equal(a.GetRetryConfig(), b.GetRetryConfig()) {
+		return proto.Equal(a, b)
+	}
+	a, b = proto.Clone(a).(*tryjob.Definition), proto.Clone(b).(*tryjob.Definition)
+	a.RetryConfig, b.RetryConfig = nil, nil
+	return proto.Equal(a, b)
+}
+
 type comparableTryjobDef struct {
 	def *tryjob.Definition
 	// sortKey is comparable binary encoding of critical part of definition.
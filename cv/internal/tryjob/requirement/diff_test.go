@@ -202,6 +202,20 @@ func TestDiff(t *testing.T) {
 					&tryjob.Requirement{})
 				So(res.RetryConfigChanged, ShouldBeTrue)
 			})
+			Convey("Definition retry config changed", func() {
+				baseDef := makeBBTryjobDefinition("a.example.com", "infra", "try", "someBuilder")
+				baseDef.RetryConfig = &cfgpb.Verifiers_Tryjob_RetryConfig{SingleQuota: 1}
+				targetDef := proto.Clone(baseDef).(*tryjob.Definition)
+				targetDef.RetryConfig.SingleQuota = 2
+				res := Diff(
+					&tryjob.Requirement{Definitions: []*tryjob.Definition{baseDef}},
+					&tryjob.Requirement{Definitions: []*tryjob.Definition{targetDef}})
+				So(res.RetryConfigChanged, ShouldBeTrue)
+				// The definition itself is unchanged.
+				So(res.ChangedDefs, ShouldBeEmpty)
+				So(res.UnchangedDefs, ShouldHaveLength, 1)
+				So(res.UnchangedDefsReverse[targetDef], ShouldEqual, baseDef)
+			})
 			Convey("Retry config changed", func() {
 				res := Diff(
 					&tryjob.Requirement{
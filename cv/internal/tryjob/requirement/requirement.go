@@ -39,6 +39,9 @@ import (
 	"go.chromium.org/luci/cv/internal/tryjob"
 )
 
+// IsMemberFn returns true if the given identity belongs to any of the groups.
+type IsMemberFn func(ctx context.Context, id identity.Identity, groups []string) (bool, error)
+
 // Input contains all info needed to compute the Tryjob Requirement.
 type Input struct {
 	ConfigGroup *cfgpb.ConfigGroup
@@ -46,6 +49,23 @@ type Input struct {
 	CLs         []*run.RunCL
 	RunOptions  *run.Options
 	RunMode     run.Mode
+
+	// IsMember checks the membership of the CL owners in the owner allowlist
+	// groups of the builders.
+	//
+	// Defaults to the AuthDB of the current auth state.
+	IsMember IsMemberFn
+	// ExplainDecisions populates ComputationResult.Decisions if true.
+	ExplainDecisions bool
+}
+
+// because returns the reason of a builder decision, if decisions are
+// requested.
+func (in *Input) because(format string, args ...interface{}) string {
+	if !in.ExplainDecisions {
+		return ""
+	}
+	return fmt.Sprintf(format, args...)
 }
 
 // ComputationFailure is what fails the Tryjob Requirement computation.
@@ -76,6 +96,23 @@ type ComputationResult struct {
 	//
 	// Mutually exclusive with `Requirement`.
 	ComputationFailure ComputationFailure
+	// Decisions explains whether each builder of the Config Group was included
+	// in the Requirement and why, in the order of the builders in the config.
+	//
+	// Set iff `Requirement` is set and Input.ExplainDecisions is true.
+	Decisions []BuilderDecision
+}
+
+// BuilderDecision explains whether a builder was included in the Tryjob
+// Requirement.
+type BuilderDecision struct {
+	// Builder is the name of the builder in the config,
+	// e.g. "chromium/try/linux-rel".
+	Builder string
+	// Included is true if the builder is included in the Requirement.
+	Included bool
+	// Reason is a human-readable explanation of the decision.
+	Reason string
 }
 
 // OK returns true if the Tryjob Requirement is successfully computed.
@@ -103,12 +140,14 @@ func (r ComputationResult) OK() bool {
 // regards to checking whether the owners are allowed to use a certain builder,
 // if no allowList groups are defined, then the expectation is that the action
 // is allowed by default.
-func getDisallowedOwners(ctx context.Context, allOwnerEmails []string, allowLists ...string) ([]string, error) {
+func getDisallowedOwners(ctx context.Context, isMember IsMemberFn, allOwnerEmails []string, allowLists ...string) ([]string, error) {
 	switch {
 	case len(allOwnerEmails) == 0:
 		panic(fmt.Errorf("cannot check membership of nil user"))
 	case len(allowLists) == 0:
 		return nil, nil
+	case isMember == nil:
+		isMember = auth.GetState(ctx).DB().IsMember
 	}
 	var disallowed []string
 	for _, userEmail := range allOwnerEmails {
@@ -116,7 +155,7 @@ func getDisallowedOwners(ctx context.Context, allOwnerEmails []string, allowList
 		if err != nil {
 			return nil, err
 		}
-		switch allowed, err := auth.GetState(ctx).DB().IsMember(ctx, id, allowLists); {
+		switch allowed, err := isMember(ctx, id, allowLists); {
 		case err != nil:
 			return nil, err
 		case !allowed:
@@ -126,9 +165,9 @@ func getDisallowedOwners(ctx context.Context, allOwnerEmails []string, allowList
 	return disallowed, nil
 }
 
-func isBuilderAllowed(ctx context.Context, allOwners []string, b *cfgpb.Verifiers_Tryjob_Builder) (bool, error) {
+func isBuilderAllowed(ctx context.Context, isMember IsMemberFn, allOwners []string, b *cfgpb.Verifiers_Tryjob_Builder) (bool, error) {
 	if len(b.GetOwnerWhitelistGroup()) > 0 {
-		switch disallowedOwners, err := getDisallowedOwners(ctx, allOwners, b.GetOwnerWhitelistGroup()...); {
+		switch disallowedOwners, err := getDisallowedOwners(ctx, isMember, allOwners, b.GetOwnerWhitelistGroup()...); {
 		case err != nil:
 			return false, err
 		case len(disallowedOwners) > 0:
@@ -139,9 +178,9 @@ func isBuilderAllowed(ctx context.Context, allOwners []string, b *cfgpb.Verifier
 
 }
 
-func isEquiBuilderAllowed(ctx context.Context, allOwners []string, b *cfgpb.Verifiers_Tryjob_EquivalentBuilder) (bool, error) {
+func isEquiBuilderAllowed(ctx context.Context, isMember IsMemberFn, allOwners []string, b *cfgpb.Verifiers_Tryjob_EquivalentBuilder) (bool, error) {
 	if b.GetOwnerWhitelistGroup() != "" {
-		switch disallowedOwners, err := getDisallowedOwners(ctx, allOwners, b.GetOwnerWhitelistGroup()); {
+		switch disallowedOwners, err := getDisallowedOwners(ctx, isMember, allOwners, b.GetOwnerWhitelistGroup()); {
 		case err != nil:
 			return false, err
 		case len(disallowedOwners) > 0:
@@ -336,6 +375,7 @@ func (dm *definitionMaker) make() *tryjob.Definition {
 	definition.Experimental = dm.builder.GetExperimentPercentage() > 0
 	definition.ResultVisibility = dm.builder.GetResultVisibility()
 	definition.SkipStaleCheck = dm.skipStaleCheck
+	definition.RetryConfig = dm.builder.GetRetryConfig()
 	return definition
 }
 
@@ -406,45 +446,47 @@ const (
 
 // shouldInclude decides based on the configuration whether a given builder
 // should be skipped in generating the Requirement.
-func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimentSelected, useEquivalent bool, b *cfgpb.Verifiers_Tryjob_Builder, incl stringset.Set, owners []string) (inclusionResult, ComputationFailure, error) {
+//
+// Also returns a human-readable reason of the decision.
+func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimentSelected, useEquivalent bool, b *cfgpb.Verifiers_Tryjob_Builder, incl stringset.Set, owners []string) (inclusionResult, string, ComputationFailure, error) {
 	switch ps := isPresubmit(b); {
 	case in.RunOptions.GetSkipTryjobs() && !ps:
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("the Run skips tryjobs"), nil, nil
 	// TODO(crbug.com/950074): Remove this clause.
 	case in.RunOptions.GetSkipPresubmit() && ps:
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("the Run skips presubmit builders"), nil, nil
 	}
 
 	// If the builder is triggered by another builder, it does not need to be
 	// considered as a required Tryjob.
 	if b.TriggeredBy != "" {
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("triggered by builder %q", b.TriggeredBy), nil, nil
 	}
 
 	if incl.Has(b.Name) {
-		switch disallowedOwners, err := getDisallowedOwners(ctx, owners, b.GetOwnerWhitelistGroup()...); {
+		switch disallowedOwners, err := getDisallowedOwners(ctx, in.IsMember, owners, b.GetOwnerWhitelistGroup()...); {
 		case err != nil:
-			return skipBuilder, nil, err
+			return skipBuilder, "", nil, err
 		case len(disallowedOwners) != 0:
 			// The requested builder is defined, but the owner is not allowed to include it.
-			return skipBuilder, &unauthorizedIncludedTryjob{
+			return skipBuilder, "", &unauthorizedIncludedTryjob{
 				Users:   disallowedOwners,
 				Builder: b.Name,
 			}, nil
 		}
 		dm.equivalence = mainOnly
 		dm.criticality = true // Explicitly included builder is always critical.
-		return includeBuilder, nil, nil
+		return includeBuilder, in.because("explicitly included"), nil, nil
 	}
 
 	if b.GetEquivalentTo() != nil && incl.Has(b.GetEquivalentTo().GetName()) {
 		if ownerAllowGroup := b.GetEquivalentTo().GetOwnerWhitelistGroup(); ownerAllowGroup != "" {
-			switch disallowedOwners, err := getDisallowedOwners(ctx, owners, ownerAllowGroup); {
+			switch disallowedOwners, err := getDisallowedOwners(ctx, in.IsMember, owners, ownerAllowGroup); {
 			case err != nil:
-				return skipBuilder, nil, err
+				return skipBuilder, "", nil, err
 			case len(disallowedOwners) != 0:
 				// The requested builder is defined, but the owner is not allowed to include it.
-				return skipBuilder, &unauthorizedIncludedTryjob{
+				return skipBuilder, "", &unauthorizedIncludedTryjob{
 					Users:   disallowedOwners,
 					Builder: b.EquivalentTo.Name,
 				}, nil
@@ -452,19 +494,19 @@ func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimen
 		}
 		dm.equivalence = equivalentOnly
 		dm.criticality = true // explicitly included builder is always critical
-		return includeBuilder, nil, nil
+		return includeBuilder, in.because("equivalent builder %q is explicitly included", b.GetEquivalentTo().GetName()), nil, nil
 	}
 
 	if b.GetExperimentPercentage() != 0 && !experimentSelected {
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("not selected for the experiment (%g%%)", b.GetExperimentPercentage()), nil, nil
 	}
 
 	if b.IncludableOnly {
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("includable only"), nil, nil
 	}
 
 	if !isModeAllowed(in.RunMode, b.ModeAllowlist) {
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("mode %s is not allowed", in.RunMode), nil, nil
 	}
 
 	// Check for LocationRegexp match to decide whether to skip the builder.
@@ -473,7 +515,7 @@ func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimen
 	locationRegexpSpecified := (len(b.LocationRegexp) + len(b.LocationRegexpExclude)) > 0
 	locationRegexpMatched, err := locationMatch(ctx, b.LocationRegexp, b.LocationRegexpExclude, in.CLs)
 	if err != nil {
-		return skipBuilder, nil, err
+		return skipBuilder, "", nil, err
 	}
 
 	// Only evaluate LocationFilters iff the total file count is fewer than
@@ -488,9 +530,10 @@ func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimen
 		locationFilterSpecified = len(b.LocationFilters) > 0
 		locationFilterMatched, err = locationFilterMatch(ctx, b.LocationFilters, in.CLs)
 		if err != nil {
-			return skipBuilder, nil, err
+			return skipBuilder, "", nil, err
 		}
 	}
+	reason := "included"
 	switch {
 	case locationRegexpSpecified && locationFilterSpecified:
 		if locationRegexpMatched != locationFilterMatched {
@@ -534,30 +577,36 @@ func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimen
 
 			logging.Errorf(ctx, "%s", sb.String())
 		}
-		fallthrough
+		// LocationRegexp takes precedence over LocationFilters.
+		if !locationRegexpMatched {
+			return skipBuilder, in.because("no changed file matches location_regexp, which takes precedence over location_filters"), nil, nil
+		}
+		reason = "changed files match location_regexp, which takes precedence over location_filters"
 	case locationRegexpSpecified:
 		// If LocationRegexp was specified, use it as the source of truth.
 		if !locationRegexpMatched {
-			return skipBuilder, nil, nil
+			return skipBuilder, in.because("no changed file matches location_regexp"), nil, nil
 		}
+		reason = "changed files match location_regexp"
 	case locationFilterSpecified:
 		// If only LocationFilters was specified, use it as the source of truth.
 		if !locationFilterMatched {
-			return skipBuilder, nil, nil
+			return skipBuilder, in.because("no changed file matches location_filters"), nil, nil
 		}
+		reason = "changed files match location_filters"
 	}
 
-	switch allowed, err := isBuilderAllowed(ctx, owners, b); {
+	switch allowed, err := isBuilderAllowed(ctx, in.IsMember, owners, b); {
 	case err != nil:
-		return skipBuilder, nil, err
+		return skipBuilder, "", nil, err
 	case allowed:
 		// decide whether CV should use main builder or equivalent builder
 		dm.equivalence = mainOnly
 		if b.GetEquivalentTo() != nil && !in.RunOptions.GetSkipEquivalentBuilders() {
 			dm.equivalence = bothMainAndEquivalent
-			switch allowed, err := isEquiBuilderAllowed(ctx, owners, b.GetEquivalentTo()); {
+			switch allowed, err := isEquiBuilderAllowed(ctx, in.IsMember, owners, b.GetEquivalentTo()); {
 			case err != nil:
-				return skipBuilder, nil, err
+				return skipBuilder, "", nil, err
 			case allowed:
 				if useEquivalent {
 					// Invert equivalence: trigger equivalent, but accept reusing original too.
@@ -568,20 +617,20 @@ func shouldInclude(ctx context.Context, in Input, dm *definitionMaker, experimen
 				dm.equivalence = mainOnly
 			}
 		}
-		return includeBuilder, nil, nil
+		return includeBuilder, reason, nil, nil
 	case b.GetEquivalentTo() != nil && !in.RunOptions.GetSkipEquivalentBuilders():
 		// See if the owners can trigger the equivalent builder instead.
-		switch equiAllowed, err := isEquiBuilderAllowed(ctx, owners, b.GetEquivalentTo()); {
+		switch equiAllowed, err := isEquiBuilderAllowed(ctx, in.IsMember, owners, b.GetEquivalentTo()); {
 		case err != nil:
-			return skipBuilder, nil, err
+			return skipBuilder, "", nil, err
 		case equiAllowed:
 			dm.equivalence = equivalentOnly
-			return includeBuilder, nil, err
+			return includeBuilder, in.because("%s; the owners may only use equivalent builder %q", reason, b.GetEquivalentTo().GetName()), nil, err
 		default:
-			return skipBuilder, nil, err
+			return skipBuilder, in.because("the owners are not allowed to use the builder or its equivalent"), nil, err
 		}
 	default:
-		return skipBuilder, nil, nil
+		return skipBuilder, in.because("the owners are not allowed to use the builder"), nil, nil
 	}
 }
 
@@ -648,6 +697,10 @@ func Compute(ctx context.Context, in Input) (*ComputationResult, error) {
 	experimentRand, equivalentBuilderRand := rands[0], rands[1]
 	builders := in.ConfigGroup.GetVerifiers().GetTryjob().GetBuilders()
 	definitions := make([]*tryjob.Definition, len(builders))
+	var decisions []BuilderDecision
+	if in.ExplainDecisions {
+		decisions = make([]BuilderDecision, len(builders))
+	}
 	var computationFailureHolder atomic.Value
 	// Utilize multiple cores.
 	err := parallel.WorkPool(min(len(builders), runtime.NumCPU()), func(work chan<- func() error) {
@@ -671,13 +724,22 @@ func Compute(ctx context.Context, in Input) (*ComputationResult, error) {
 				} else {
 					dm.skipStaleCheck = builder.GetCancelStale() == cfgpb.Toggle_NO
 				}
-				switch r, compFail, err := shouldInclude(ctx, in, dm, experimentSelected, useEquivalent, builder, explicitlyIncluded, allOwnerEmails); {
+				switch r, reason, compFail, err := shouldInclude(ctx, in, dm, experimentSelected, useEquivalent, builder, explicitlyIncluded, allOwnerEmails); {
 				case err != nil:
 					return err
 				case compFail != nil:
 					computationFailureHolder.Store(compFail)
-				case r != skipBuilder:
-					definitions[i] = dm.make()
+				default:
+					if in.ExplainDecisions {
+						decisions[i] = BuilderDecision{
+							Builder:  builder.GetName(),
+							Included: bool(r),
+							Reason:   reason,
+						}
+					}
+					if r != skipBuilder {
+						definitions[i] = dm.make()
+					}
 				}
 				return nil
 			}
@@ -697,6 +759,7 @@ func Compute(ctx context.Context, in Input) (*ComputationResult, error) {
 				ret.Requirement.Definitions = append(ret.Requirement.Definitions, def)
 			}
 		}
+		ret.Decisions = decisions
 	}
 	return ret, nil
 }
@@ -244,17 +244,17 @@ func TestGetDisallowedOwners(t *testing.T) {
 	Convey("getDisallowedOwners", t, func() {
 		Convey("works", func() {
 			Convey("with no allowlists", func() {
-				disallowed, err := getDisallowedOwners(ctx, []string{userA.Email()})
+				disallowed, err := getDisallowedOwners(ctx, nil, []string{userA.Email()})
 				So(err, ShouldBeNil)
 				So(disallowed, ShouldHaveLength, 0)
 			})
 		})
 		Convey("panics", func() {
 			Convey("with nil users", func() {
-				So(func() { _, _ = getDisallowedOwners(ctx, nil, group1) }, ShouldPanicLike, "nil user")
+				So(func() { _, _ = getDisallowedOwners(ctx, nil, nil, group1) }, ShouldPanicLike, "nil user")
 			})
 			Convey("with zero users", func() {
-				So(func() { _, _ = getDisallowedOwners(ctx, []string{}, group1) }, ShouldPanicLike, "nil user")
+				So(func() { _, _ = getDisallowedOwners(ctx, nil, []string{}, group1) }, ShouldPanicLike, "nil user")
 			})
 		})
 	})
@@ -907,6 +907,21 @@ func TestCompute(t *testing.T) {
 						},
 					})
 					So(log, memlogger.ShouldHaveLog, logging.Error, "disagreed location outputs")
+					So(res.Decisions, ShouldBeNil)
+				})
+				Convey("explains that location_regexp decided", func() {
+					in.CLs[0].Detail.GetGerrit().Files = []string{
+						"some/excluded/file",
+					}
+					in.ExplainDecisions = true
+					res, err := Compute(ctx, *in)
+
+					So(err, ShouldBeNil)
+					So(res.ComputationFailure, ShouldBeNil)
+					So(res.Decisions, ShouldResemble, []BuilderDecision{{
+						Builder: "test-proj/test/builder1",
+						Reason:  "no changed file matches location_regexp, which takes precedence over location_filters",
+					}})
 				})
 			})
 		})
@@ -0,0 +1,172 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package requirement
+
+import (
+	"context"
+	"fmt"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	gerritpb "go.chromium.org/luci/common/proto/gerrit"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/changelist"
+	"go.chromium.org/luci/cv/internal/configs/prjcfg"
+	"go.chromium.org/luci/cv/internal/gerrit/cfgmatcher"
+	"go.chromium.org/luci/cv/internal/run"
+)
+
+// BadWhatIfInputTag tags WhatIf errors caused by the input itself, as opposed
+// to e.g. AuthDB failures.
+var BadWhatIfInputTag = errors.BoolTag{Key: errors.NewTagKey("bad WhatIf input")}
+
+// WhatIfInput describes a hypothetical CL to compute the Tryjob Requirement
+// for.
+type WhatIfInput struct {
+	// Config is the project config to evaluate the CL against.
+	Config *cfgpb.Config
+	// ConfigGroup is the name of the Config Group to use.
+	//
+	// If empty, the Config Group is matched by the location of the CL.
+	ConfigGroup string
+
+	// GerritHost, GerritProject and Ref is the location of the CL.
+	GerritHost    string
+	GerritProject string
+	Ref           string
+	// Files are the paths of the files changed by the CL.
+	Files []string
+	// OwnerEmail is the email of the owner of the CL.
+	OwnerEmail string
+
+	// Mode is the mode of the hypothetical Run.
+	Mode run.Mode
+	// IncludedTryjobs are the values of the "Cq-Include-Trybots" footer.
+	IncludedTryjobs []string
+
+	// IsMember is passed to Input as is.
+	IsMember IsMemberFn
+}
+
+// WhatIfResult is the result of WhatIf.
+type WhatIfResult struct {
+	*ComputationResult
+	// ConfigGroup is the Config Group the CL was evaluated against.
+	ConfigGroup *cfgpb.ConfigGroup
+}
+
+// WhatIf computes the Tryjob Requirement for a hypothetical CL without
+// creating a Run.
+//
+// This is useful to check which builders a CL would trigger under a proposed
+// project config.
+func WhatIf(ctx context.Context, in WhatIfInput) (*WhatIfResult, error) {
+	switch {
+	case !in.Mode.Valid():
+		return nil, errors.Reason("invalid mode %q", in.Mode).Tag(BadWhatIfInputTag).Err()
+	case in.GerritHost == "" || in.GerritProject == "":
+		return nil, errors.Reason("gerrit host and project are required").Tag(BadWhatIfInputTag).Err()
+	case in.OwnerEmail == "":
+		return nil, errors.Reason("owner email is required").Tag(BadWhatIfInputTag).Err()
+	}
+	owner, err := identity.MakeIdentity("user:" + in.OwnerEmail)
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid owner email").Tag(BadWhatIfInputTag).Err()
+	}
+	ref := in.Ref
+	if ref == "" {
+		ref = "refs/heads/main"
+	}
+	cg, err := findConfigGroup(ctx, in.Config, in.ConfigGroup, in.GerritHost, in.GerritProject, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	const revision = "whatif"
+	cl := &run.RunCL{
+		ID:         1,
+		ExternalID: changelist.MustGobID(in.GerritHost, 1),
+		Detail: &changelist.Snapshot{
+			Kind: &changelist.Snapshot_Gerrit{
+				Gerrit: &changelist.Gerrit{
+					Host:  in.GerritHost,
+					Files: in.Files,
+					Info: &gerritpb.ChangeInfo{
+						Number:          1,
+						Project:         in.GerritProject,
+						Ref:             ref,
+						Owner:           &gerritpb.AccountInfo{Email: in.OwnerEmail},
+						CurrentRevision: revision,
+						Revisions: map[string]*gerritpb.RevisionInfo{
+							revision: {
+								Commit: &gerritpb.CommitInfo{
+									Parents: []*gerritpb.CommitInfo_Parent{{}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	res, err := Compute(ctx, Input{
+		ConfigGroup: cg,
+		RunOwner:    owner,
+		CLs:         []*run.RunCL{cl},
+		RunOptions:  &run.Options{IncludedTryjobs: in.IncludedTryjobs},
+		RunMode:     in.Mode,
+		IsMember:    in.IsMember,
+
+		ExplainDecisions: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WhatIfResult{ComputationResult: res, ConfigGroup: cg}, nil
+}
+
+// findConfigGroup returns the Config Group with the given name or, if the
+// name is empty, the only Config Group watching the given location.
+func findConfigGroup(ctx context.Context, cfg *cfgpb.Config, name, host, project, ref string) (*cfgpb.ConfigGroup, error) {
+	if name != "" {
+		for _, cg := range cfg.GetConfigGroups() {
+			if cg.GetName() == name {
+				return cg, nil
+			}
+		}
+		return nil, errors.Reason("config group %q not found", name).Tag(BadWhatIfInputTag).Err()
+	}
+
+	groups := make([]*prjcfg.ConfigGroup, len(cfg.GetConfigGroups()))
+	byID := make(map[prjcfg.ConfigGroupID]*cfgpb.ConfigGroup, len(groups))
+	for i, cg := range cfg.GetConfigGroups() {
+		id := prjcfg.MakeConfigGroupID("", cg.GetName())
+		groups[i] = &prjcfg.ConfigGroup{Content: cg, ID: id}
+		byID[id] = cg
+	}
+	switch ids := cfgmatcher.LoadMatcherFromConfigGroups(ctx, groups, nil).Match(host, project, ref); len(ids) {
+	case 0:
+		return nil, errors.Reason("no config group watches %s", location(host, project, ref)).Tag(BadWhatIfInputTag).Err()
+	case 1:
+		return byID[ids[0]], nil
+	default:
+		return nil, errors.Reason("%s is watched by %d config groups", location(host, project, ref), len(ids)).Tag(BadWhatIfInputTag).Err()
+	}
+}
+
+func location(host, project, ref string) string {
+	return fmt.Sprintf("https://%s/%s/+/%s", host, project, ref)
+}
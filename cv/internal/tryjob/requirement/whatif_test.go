@@ -0,0 +1,152 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package requirement
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+
+	cfgpb "go.chromium.org/luci/cv/api/config/v2"
+	"go.chromium.org/luci/cv/internal/cvtesting"
+	"go.chromium.org/luci/cv/internal/run"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestWhatIf(t *testing.T) {
+	t.Parallel()
+
+	Convey("WhatIf", t, func() {
+		ct := cvtesting.Test{}
+		ctx, cancel := ct.SetUp()
+		defer cancel()
+
+		cfg := &cfgpb.Config{
+			ConfigGroups: []*cfgpb.ConfigGroup{
+				{
+					Name: "main",
+					Gerrit: []*cfgpb.ConfigGroup_Gerrit{{
+						Url: "https://example-review.googlesource.com",
+						Projects: []*cfgpb.ConfigGroup_Gerrit_Project{{
+							Name:      "repo",
+							RefRegexp: []string{"refs/heads/main"},
+						}},
+					}},
+					Verifiers: &cfgpb.Verifiers{
+						Tryjob: &cfgpb.Verifiers_Tryjob{
+							Builders: []*cfgpb.Verifiers_Tryjob_Builder{
+								{Name: "proj/try/all"},
+								{
+									Name:           "proj/try/docs",
+									LocationRegexp: []string{`.+/[+]/docs/.+`},
+								},
+								{Name: "proj/try/manual", IncludableOnly: true},
+								{
+									Name:                "proj/try/restricted",
+									OwnerWhitelistGroup: []string{"insiders"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		var groups []string
+		in := WhatIfInput{
+			Config:        cfg,
+			GerritHost:    "example-review.googlesource.com",
+			GerritProject: "repo",
+			Files:         []string{"src/main.go"},
+			OwnerEmail:    "user@example.com",
+			Mode:          run.DryRun,
+			IsMember: func(ctx context.Context, id identity.Identity, gs []string) (bool, error) {
+				for _, g := range gs {
+					for _, member := range groups {
+						if g == member {
+							return true, nil
+						}
+					}
+				}
+				return false, nil
+			},
+		}
+
+		decisions := func() []BuilderDecision {
+			res, err := WhatIf(ctx, in)
+			So(err, ShouldBeNil)
+			So(res.OK(), ShouldBeTrue)
+			So(res.ConfigGroup.GetName(), ShouldEqual, "main")
+			So(res.Requirement.GetDefinitions(), ShouldHaveLength, countIncluded(res.Decisions))
+			return res.Decisions
+		}
+
+		Convey("Explains each builder", func() {
+			So(decisions(), ShouldResemble, []BuilderDecision{
+				{Builder: "proj/try/all", Included: true, Reason: "included"},
+				{Builder: "proj/try/docs", Reason: "no changed file matches location_regexp"},
+				{Builder: "proj/try/manual", Reason: "includable only"},
+				{Builder: "proj/try/restricted", Reason: "the owners are not allowed to use the builder"},
+			})
+		})
+
+		Convey("Takes files, footers and groups into account", func() {
+			in.Files = append(in.Files, "docs/index.md")
+			in.IncludedTryjobs = []string{"proj/try:manual"}
+			groups = []string{"insiders"}
+			So(decisions(), ShouldResemble, []BuilderDecision{
+				{Builder: "proj/try/all", Included: true, Reason: "included"},
+				{Builder: "proj/try/docs", Included: true, Reason: "changed files match location_regexp"},
+				{Builder: "proj/try/manual", Included: true, Reason: "explicitly included"},
+				{Builder: "proj/try/restricted", Included: true, Reason: "included"},
+			})
+		})
+
+		Convey("Fails on unwatched location", func() {
+			in.Ref = "refs/heads/other"
+			_, err := WhatIf(ctx, in)
+			So(err, ShouldErrLike, "no config group watches")
+			So(BadWhatIfInputTag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Fails on unknown config group", func() {
+			in.ConfigGroup = "other"
+			_, err := WhatIf(ctx, in)
+			So(err, ShouldErrLike, `config group "other" not found`)
+			So(BadWhatIfInputTag.In(err), ShouldBeTrue)
+		})
+
+		Convey("Fails on membership check errors", func() {
+			in.IsMember = func(ctx context.Context, id identity.Identity, gs []string) (bool, error) {
+				return false, errors.New("auth db is down")
+			}
+			_, err := WhatIf(ctx, in)
+			So(err, ShouldErrLike, "auth db is down")
+			So(BadWhatIfInputTag.In(err), ShouldBeFalse)
+		})
+	})
+}
+
+func countIncluded(decisions []BuilderDecision) (n int) {
+	for _, d := range decisions {
+		if d.Included {
+			n++
+		}
+	}
+	return
+}
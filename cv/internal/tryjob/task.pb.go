@@ -172,6 +172,110 @@ func (x *CancelStaleTryjobsTask) GetCurrentMinEquivPatchset() int32 {
 	return 0
 }
 
+// LaunchTrainTryjobTask launches the speculative combined Tryjob which
+// verifies the CLs of all Runs in a submit train together.
+//
+// Queue: "launch-train-tryjob".
+type LaunchTrainTryjobTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the Tryjob entity datastore ID. Internal to CV.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *LaunchTrainTryjobTask) Reset() {
+	*x = LaunchTrainTryjobTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LaunchTrainTryjobTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchTrainTryjobTask) ProtoMessage() {}
+
+func (x *LaunchTrainTryjobTask) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LaunchTrainTryjobTask.ProtoReflect.Descriptor instead.
+func (*LaunchTrainTryjobTask) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LaunchTrainTryjobTask) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// CancelTrainTryjobTask cancels the speculative combined Tryjob of a submit
+// train which was reset before the Tryjob ended.
+//
+// Queue: "cancel-train-tryjob".
+type CancelTrainTryjobTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the Tryjob entity datastore ID. Internal to CV.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelTrainTryjobTask) Reset() {
+	*x = CancelTrainTryjobTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTrainTryjobTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTrainTryjobTask) ProtoMessage() {}
+
+func (x *CancelTrainTryjobTask) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTrainTryjobTask.ProtoReflect.Descriptor instead.
+func (*CancelTrainTryjobTask) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelTrainTryjobTask) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 // ExecuteTryjobsPayload is the payload of the long-op task that invokes
 // the Tryjob Executor.
 //
@@ -195,7 +299,7 @@ type ExecuteTryjobsPayload struct {
 func (x *ExecuteTryjobsPayload) Reset() {
 	*x = ExecuteTryjobsPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[2]
+		mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -208,7 +312,7 @@ func (x *ExecuteTryjobsPayload) String() string {
 func (*ExecuteTryjobsPayload) ProtoMessage() {}
 
 func (x *ExecuteTryjobsPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[2]
+	mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +325,7 @@ func (x *ExecuteTryjobsPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteTryjobsPayload.ProtoReflect.Descriptor instead.
 func (*ExecuteTryjobsPayload) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP(), []int{2}
+	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExecuteTryjobsPayload) GetRequirementChanged() bool {
@@ -248,7 +352,7 @@ type ExecuteTryjobsResult struct {
 func (x *ExecuteTryjobsResult) Reset() {
 	*x = ExecuteTryjobsResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[3]
+		mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -261,7 +365,7 @@ func (x *ExecuteTryjobsResult) String() string {
 func (*ExecuteTryjobsResult) ProtoMessage() {}
 
 func (x *ExecuteTryjobsResult) ProtoReflect() protoreflect.Message {
-	mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[3]
+	mi := &file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +378,7 @@ func (x *ExecuteTryjobsResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteTryjobsResult.ProtoReflect.Descriptor instead.
 func (*ExecuteTryjobsResult) Descriptor() ([]byte, []int) {
-	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP(), []int{3}
+	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP(), []int{5}
 }
 
 var File_go_chromium_org_luci_cv_internal_tryjob_task_proto protoreflect.FileDescriptor
@@ -299,19 +403,24 @@ var file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDesc = []byte{
 	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x76,
 	0x5f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x17, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x45, 0x71, 0x75, 0x69, 0x76,
-	0x50, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x22, 0x71, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x72, 0x79,
-	0x6a, 0x6f, 0x62, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69,
-	0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x3b, 0x74,
-	0x72, 0x79, 0x6a, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x22, 0x27, 0x0a, 0x15, 0x4c, 0x61, 0x75, 0x6e,
+	0x63, 0x68, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x54, 0x61, 0x73,
+	0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x27, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x71, 0x0a, 0x15, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x74,
+	0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x76,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62,
+	0x3b, 0x74, 0x72, 0x79, 0x6a, 0x6f, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -326,12 +435,14 @@ func file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescGZIP() []byt
 	return file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDescData
 }
 
-var file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_go_chromium_org_luci_cv_internal_tryjob_task_proto_goTypes = []interface{}{
 	(*UpdateTryjobTask)(nil),       // 0: cv.internal.tryjob.UpdateTryjobTask
 	(*CancelStaleTryjobsTask)(nil), // 1: cv.internal.tryjob.CancelStaleTryjobsTask
-	(*ExecuteTryjobsPayload)(nil),  // 2: cv.internal.tryjob.ExecuteTryjobsPayload
-	(*ExecuteTryjobsResult)(nil),   // 3: cv.internal.tryjob.ExecuteTryjobsResult
+	(*LaunchTrainTryjobTask)(nil),  // 2: cv.internal.tryjob.LaunchTrainTryjobTask
+	(*CancelTrainTryjobTask)(nil),  // 3: cv.internal.tryjob.CancelTrainTryjobTask
+	(*ExecuteTryjobsPayload)(nil),  // 4: cv.internal.tryjob.ExecuteTryjobsPayload
+	(*ExecuteTryjobsResult)(nil),   // 5: cv.internal.tryjob.ExecuteTryjobsResult
 }
 var file_go_chromium_org_luci_cv_internal_tryjob_task_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -372,7 +483,7 @@ func file_go_chromium_org_luci_cv_internal_tryjob_task_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteTryjobsPayload); i {
+			switch v := v.(*LaunchTrainTryjobTask); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -384,6 +495,30 @@ func file_go_chromium_org_luci_cv_internal_tryjob_task_proto_init() {
 			}
 		}
 		file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTrainTryjobTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteTryjobsPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_cv_internal_tryjob_task_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ExecuteTryjobsResult); i {
 			case 0:
 				return &v.state
@@ -402,7 +537,7 @@ func file_go_chromium_org_luci_cv_internal_tryjob_task_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_go_chromium_org_luci_cv_internal_tryjob_task_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -46,6 +46,7 @@ func (ul *uiLogEntry) HasTryjobChips() bool {
 		case *tryjob.ExecutionLogEntry_TryjobsEnded_:
 		case *tryjob.ExecutionLogEntry_TryjobDiscarded_:
 		case *tryjob.ExecutionLogEntry_RetryDenied_:
+		case *tryjob.ExecutionLogEntry_FlakinessChecked_:
 		default:
 			return false
 		}
@@ -124,6 +125,8 @@ func tryjobLogEventName(tle *tryjob.ExecutionLogEntry) string {
 		return "Tryjob Discarded"
 	case *tryjob.ExecutionLogEntry_RetryDenied_:
 		return "Retry Denied"
+	case *tryjob.ExecutionLogEntry_FlakinessChecked_:
+		return "Tryjob Flakiness Checked"
 	default:
 		panic(fmt.Errorf("unknown Tryjob execution log kind %T", v))
 	}
@@ -196,6 +199,11 @@ func tryjobLogMessage(tle *tryjob.ExecutionLogEntry) string {
 		return fmt.Sprintf("Reason: %s", v.TryjobDiscarded.GetReason())
 	case *tryjob.ExecutionLogEntry_RetryDenied_:
 		return fmt.Sprintf("Can't retry following tryjob(s) because %s", v.RetryDenied.GetReason())
+	case *tryjob.ExecutionLogEntry_FlakinessChecked_:
+		if v.FlakinessChecked.GetFlaky() {
+			return fmt.Sprintf("The failure looks flaky: %s", v.FlakinessChecked.GetReason())
+		}
+		return fmt.Sprintf("The failure doesn't look flaky: %s", v.FlakinessChecked.GetReason())
 	default:
 		return ""
 	}
@@ -235,6 +243,8 @@ func (ul *uiLogEntry) Tryjobs() []*uiTryjob {
 		return makeUITryjobsFromSnapshots([]*tryjob.ExecutionLogEntry_TryjobSnapshot{v.TryjobDiscarded.GetSnapshot()})
 	case *tryjob.ExecutionLogEntry_RetryDenied_:
 		return makeUITryjobsFromSnapshots(v.RetryDenied.GetTryjobs())
+	case *tryjob.ExecutionLogEntry_FlakinessChecked_:
+		return makeUITryjobsFromSnapshots([]*tryjob.ExecutionLogEntry_TryjobSnapshot{v.FlakinessChecked.GetSnapshot()})
 	default:
 		panic(fmt.Errorf("not supported tryjob log kind %T", v))
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"cloud.google.com/go/datastore"
+	"cloud.google.com/go/firestore"
 
 	"go.chromium.org/luci/gae/impl/dummy"
 	ds "go.chromium.org/luci/gae/service/datastore"
@@ -84,6 +85,13 @@ type ConfigLite struct {
 	//
 	// If populated, the datastore service will be installed.
 	DS *datastore.Client
+
+	// FS is the Firestore client.
+	//
+	// If populated and DS is not, the datastore service backed by Firestore in
+	// Native mode will be installed. It allows to use the datastore API with
+	// projects that don't have a database in Datastore mode.
+	FS *firestore.Client
 }
 
 // Use configures the context with implementation of Cloud Services.
@@ -108,10 +116,14 @@ func (cfg *ConfigLite) Use(c context.Context) context.Context {
 		ServiceAccountName: cfg.ServiceAccountName,
 	})
 
-	if cfg.DS != nil {
+	switch {
+	case cfg.DS != nil:
 		cds := cloudDatastore{client: cfg.DS}
 		c = cds.use(c)
-	} else {
+	case cfg.FS != nil:
+		fds := firestoreDatastore{client: cfg.FS}
+		c = fds.use(c)
+	default:
 		c = ds.SetRaw(c, dummy.Datastore())
 	}
 
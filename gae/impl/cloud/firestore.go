@@ -0,0 +1,1373 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/iterator"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/data/cmpbin"
+	"go.chromium.org/luci/common/data/rand/mathrand"
+	"go.chromium.org/luci/common/errors"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// Firestore documents layout.
+//
+// Each entity is stored as a document in a collection named after the entity
+// kind. Entities in non-default namespaces are stored in collections under
+// "@ns/<namespace>" document. The document ID encodes the full key path, so
+// entities with different parents never collide and keys of the same kind are
+// ordered the same way Datastore orders them.
+//
+// Entity properties are stored as document fields of the corresponding native
+// Firestore types, multi-valued properties are stored as arrays. Additionally
+// each document has a few reserved fields:
+//   - "@gae" with the information needed to restore the exact PropertyMap;
+//   - "@idx" with a map per indexed property holding an array of all its
+//     indexed values ("all") and the smallest ("min") and the largest ("max")
+//     of them, used to implement Datastore filters and orders, which consider
+//     every value of a multi-valued property, with Firestore single-field
+//     indexes;
+//   - "@anc" with encoded keys of all ancestors keyed by their depth, used for
+//     ancestor queries.
+//
+// Auto-allocated IDs are reserved in "@ids" collections, see allocateID.
+const (
+	fsNamespacesCollection   = "@ns"
+	fsAllocatedIDsCollection = "@ids"
+	fsMetaField              = "@gae"
+	fsIndexField             = "@idx"
+	fsAncestorsField         = "@anc"
+
+	fsMetaNoIndex = "noindex"
+	fsMetaSlices  = "slices"
+
+	fsIndexAll = "all"
+	fsIndexMin = "min"
+	fsIndexMax = "max"
+
+	// Datastore scatters auto-allocated IDs in the range of a float64 mantissa.
+	fsMaxAllocatedID = 1 << 52
+	// fsAllocateAttempts is how many random IDs to try before giving up.
+	fsAllocateAttempts = 10
+)
+
+type firestoreDatastore struct {
+	client *firestore.Client
+}
+
+func (fds *firestoreDatastore) use(c context.Context) context.Context {
+	return ds.SetRawFactory(c, func(ic context.Context) ds.RawInterface {
+		return &boundFirestore{
+			Context:            ic,
+			firestoreDatastore: fds,
+			transaction:        firestoreTransaction(ic),
+			kc:                 ds.GetKeyContext(ic),
+		}
+	})
+}
+
+// boundFirestore is a bound instance of the firestoreDatastore installed in the
+// Context.
+type boundFirestore struct {
+	context.Context
+
+	*firestoreDatastore
+
+	transaction *firestoreTransactionWrapper
+	kc          ds.KeyContext
+}
+
+func (bfs *boundFirestore) AllocateIDs(keys []*ds.Key, cb ds.NewKeyCB) error {
+	for i, key := range keys {
+		key, err := bfs.allocateID(key)
+		cb(i, key, err)
+	}
+	return nil
+}
+
+// allocateID returns a complete key with a random integer ID.
+//
+// Firestore has no ID allocator. A random ID is reserved by creating a
+// document in the "@ids" collection, which fails if the ID was already handed
+// out, and is rejected if an entity with this key already exists (e.g. it was
+// put with an explicit ID). Reservations are made outside of the current
+// transaction, so that IDs are never handed out twice even if the transaction
+// is retried.
+func (bfs *boundFirestore) allocateID(key *ds.Key) (*ds.Key, error) {
+	ctx := withoutFirestoreTransaction(bfs)
+	for attempt := 0; attempt < fsAllocateAttempts; attempt++ {
+		candidate := key.KeyContext().NewKey(key.Kind(), "", mathrand.Int63n(bfs, fsMaxAllocatedID)+1, key.Parent())
+		reservation := bfs.collection(key.Namespace(), fsAllocatedIDsCollection).Doc(docID(candidate))
+		switch _, err := reservation.Create(ctx, map[string]interface{}{}); {
+		case status.Code(err) == codes.AlreadyExists:
+			continue
+		case err != nil:
+			return nil, normalizeFirestoreError(err)
+		}
+		switch _, err := bfs.keyToDocRef(candidate).Get(ctx); {
+		case status.Code(err) == codes.NotFound:
+			return candidate, nil
+		case err != nil:
+			return nil, normalizeFirestoreError(err)
+		}
+	}
+	return nil, errors.Reason("failed to allocate an ID for %q in %d attempts", key.Kind(), fsAllocateAttempts).Err()
+}
+
+func (bfs *boundFirestore) RunInTransaction(fn func(context.Context) error, opts *ds.TransactionOptions) error {
+	if bfs.transaction != nil {
+		return errors.New("nested transactions are not supported")
+	}
+
+	var txOpts []firestore.TransactionOption
+	if opts != nil {
+		if opts.ReadOnly {
+			txOpts = append(txOpts, firestore.ReadOnly)
+		}
+		if opts.Attempts > 0 {
+			txOpts = append(txOpts, firestore.MaxAttempts(opts.Attempts))
+		}
+	}
+
+	err := bfs.client.RunTransaction(bfs, func(ctx context.Context, tx *firestore.Transaction) error {
+		tw := &firestoreTransactionWrapper{tx: tx}
+		if err := fn(withFirestoreTransaction(ctx, tw)); err != nil {
+			return err
+		}
+		return tw.flush()
+	}, txOpts...)
+	return normalizeFirestoreError(err)
+}
+
+func (bfs *boundFirestore) DecodeCursor(s string) (ds.Cursor, error) {
+	return decodeFirestoreCursor(s)
+}
+
+func (bfs *boundFirestore) Run(fq *ds.FinalizedQuery, cb ds.RawRunCB) error {
+	fsq, err := bfs.planQuery(fq)
+	if err != nil {
+		return err
+	}
+	if fsq.hasLimit && fsq.limit == 0 {
+		return nil
+	}
+
+	var it *firestore.DocumentIterator
+	if bfs.transaction != nil {
+		it = bfs.transaction.tx.Documents(fsq.native)
+	} else {
+		it = fsq.native.Documents(bfs)
+	}
+	defer it.Stop()
+
+	offset, limit := fsq.offset, fsq.limit
+	for {
+		doc, err := it.Next()
+		if err != nil {
+			if err == iterator.Done {
+				return nil
+			}
+			return normalizeFirestoreError(err)
+		}
+
+		data := doc.Data()
+		if !fsq.matches(data) {
+			continue
+		}
+		key, err := bfs.docRefToKey(doc.Ref)
+		if err != nil {
+			return err
+		}
+		rows, err := bfs.rows(fsq, data)
+		if err != nil {
+			return err
+		}
+
+		// Cursors may point in the middle of the rows of a single entity.
+		first, last := 0, len(rows)
+		if fsq.start != nil && fsq.start.key().Equal(key) {
+			first = fsq.start.rows
+		}
+		if fsq.end != nil && fsq.end.key().Equal(key) && fsq.end.rows < last {
+			last = fsq.end.rows
+		}
+
+		for i := first; i < last; i++ {
+			if offset > 0 {
+				offset--
+				continue
+			}
+			if fsq.hasLimit {
+				if limit == 0 {
+					return nil
+				}
+				limit--
+			}
+			consumed := i + 1
+			cursorFn := func() (ds.Cursor, error) {
+				return bfs.cursorAfter(fsq.orders, key, data, consumed)
+			}
+			if err := cb(key, rows[i], cursorFn); err != nil {
+				if err == ds.Stop {
+					return nil
+				}
+				return normalizeFirestoreError(err)
+			}
+		}
+	}
+}
+
+func (bfs *boundFirestore) Count(fq *ds.FinalizedQuery) (int64, error) {
+	// Firestore client doesn't support aggregation queries yet. Count keys.
+	count := int64(0)
+	kq, err := fq.Original().KeysOnly(true).Finalize()
+	if err != nil {
+		return -1, err
+	}
+	err = bfs.Run(kq, func(*ds.Key, ds.PropertyMap, ds.CursorCB) error {
+		count++
+		return nil
+	})
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
+}
+
+func (bfs *boundFirestore) GetMulti(keys []*ds.Key, _meta ds.MultiMetaGetter, cb ds.GetMultiCB) error {
+	refs := make([]*firestore.DocumentRef, len(keys))
+	for i, key := range keys {
+		refs[i] = bfs.keyToDocRef(key)
+	}
+
+	var docs []*firestore.DocumentSnapshot
+	var err error
+	if bfs.transaction != nil {
+		docs, err = bfs.transaction.tx.GetAll(refs)
+	} else {
+		docs, err = bfs.client.GetAll(bfs, refs)
+	}
+	if err != nil {
+		return normalizeFirestoreError(err)
+	}
+
+	for i, doc := range docs {
+		if !doc.Exists() {
+			cb(i, nil, ds.ErrNoSuchEntity)
+			continue
+		}
+		pmap, err := bfs.dataToEntity(doc.Data())
+		cb(i, pmap, err)
+	}
+	return nil
+}
+
+func (bfs *boundFirestore) PutMulti(keys []*ds.Key, vals []ds.PropertyMap, cb ds.NewKeyCB) error {
+	complete := make([]*ds.Key, len(keys))
+	writes := make([]firestoreWrite, len(keys))
+	for i, key := range keys {
+		if key.IsIncomplete() {
+			var err error
+			if key, err = bfs.allocateID(key); err != nil {
+				return err
+			}
+		}
+		data, err := bfs.entityToData(key, vals[i])
+		if err != nil {
+			return err
+		}
+		complete[i] = key
+		writes[i] = firestoreWrite{ref: bfs.keyToDocRef(key), data: data}
+	}
+
+	if err := bfs.write(writes); err != nil {
+		return err
+	}
+	for i, key := range complete {
+		cb(i, key, nil)
+	}
+	return nil
+}
+
+func (bfs *boundFirestore) DeleteMulti(keys []*ds.Key, cb ds.DeleteMultiCB) error {
+	writes := make([]firestoreWrite, len(keys))
+	for i, key := range keys {
+		writes[i] = firestoreWrite{ref: bfs.keyToDocRef(key)}
+	}
+
+	if err := bfs.write(writes); err != nil {
+		return err
+	}
+	for i := range keys {
+		cb(i, nil)
+	}
+	return nil
+}
+
+// write either buffers writes in the current transaction or commits them
+// atomically as a single batch.
+func (bfs *boundFirestore) write(writes []firestoreWrite) error {
+	if bfs.transaction != nil {
+		bfs.transaction.add(writes)
+		return nil
+	}
+	batch := bfs.client.Batch()
+	for _, w := range writes {
+		w.apply(batch)
+	}
+	_, err := batch.Commit(bfs)
+	return normalizeFirestoreError(err)
+}
+
+func (bfs *boundFirestore) WithoutTransaction() context.Context {
+	return withoutFirestoreTransaction(bfs)
+}
+
+func (bfs *boundFirestore) CurrentTransaction() ds.Transaction {
+	if bfs.transaction == nil {
+		return nil
+	}
+	return bfs.transaction
+}
+
+func (bfs *boundFirestore) Constraints() ds.Constraints {
+	// Firestore commits are limited to 500 writes.
+	return ds.Constraints{
+		MaxGetSize:    1000,
+		MaxPutSize:    500,
+		MaxDeleteSize: 500,
+	}
+}
+
+func (bfs *boundFirestore) GetTestable() ds.Testable { return nil }
+
+// firestoreQuery is a Datastore query translated into a Firestore query.
+//
+// Firestore can serve only some of Datastore filters natively: a single
+// "array-contains" filter per query and range filters on a single field. The
+// rest of filters are applied to the fetched documents in memory, in which case
+// the offset and the limit are applied in memory as well.
+type firestoreQuery struct {
+	native firestore.Query
+
+	// orders are the fields the native query is ordered by, the last one is
+	// always the document ID.
+	orders []firestore.FieldPath
+	// filters are the filters applied in memory.
+	filters []fsFilter
+	// propFilters are all filters on properties, including native ones, used to
+	// pick values of projected properties.
+	propFilters map[string][]fsFilter
+
+	project  []string
+	keysOnly bool
+
+	// inMemory is true if the native query can return documents that produce
+	// zero or more than one result.
+	inMemory bool
+
+	offset   int32
+	limit    int32
+	hasLimit bool
+
+	start *firestoreCursor
+	end   *firestoreCursor
+}
+
+// fsFilter is a Datastore filter on a property. It matches an entity if any
+// indexed value of the property satisfies it.
+type fsFilter struct {
+	prop string
+
+	hasEq bool
+	eq    interface{}
+
+	lowOp  string // ">" or ">=", or "" if unbounded
+	low    interface{}
+	highOp string // "<" or "<=", or "" if unbounded
+	high   interface{}
+}
+
+// matchValue returns true if the given indexed value satisfies the filter.
+//
+// Like in Datastore, range filters match only values of the same type as the
+// bound.
+func (f *fsFilter) matchValue(v interface{}) bool {
+	if f.hasEq {
+		return compareNative(v, f.eq) == 0
+	}
+	if f.lowOp != "" {
+		if fsTypeRank(v) != fsTypeRank(f.low) {
+			return false
+		}
+		if c := compareNative(v, f.low); c < 0 || (c == 0 && f.lowOp == ">") {
+			return false
+		}
+	}
+	if f.highOp != "" {
+		if fsTypeRank(v) != fsTypeRank(f.high) {
+			return false
+		}
+		if c := compareNative(v, f.high); c > 0 || (c == 0 && f.highOp == "<") {
+			return false
+		}
+	}
+	return true
+}
+
+// matches returns true if the document passes all in-memory filters.
+func (fsq *firestoreQuery) matches(data map[string]interface{}) bool {
+	for i := range fsq.filters {
+		f := &fsq.filters[i]
+		matched := false
+		for _, v := range indexedValues(data, f.prop) {
+			if f.matchValue(v) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesValue returns true if the value of the property passes all filters on
+// this property.
+func (fsq *firestoreQuery) matchesValue(prop string, v interface{}) bool {
+	for i := range fsq.propFilters[prop] {
+		if !fsq.propFilters[prop][i].matchValue(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (bfs *boundFirestore) planQuery(fq *ds.FinalizedQuery) (*firestoreQuery, error) {
+	kind := fq.Kind()
+	switch {
+	case kind == "":
+		return nil, errors.New("kindless queries are not supported by Firestore")
+	case strings.HasPrefix(kind, "__"):
+		return nil, errors.Reason("metadata queries (kind %q) are not supported by Firestore", kind).Err()
+	case fq.Distinct():
+		return nil, errors.New("distinct queries are not supported by Firestore")
+	}
+
+	fsq := &firestoreQuery{
+		project:     fq.Project(),
+		keysOnly:    fq.KeysOnly(),
+		propFilters: map[string][]fsFilter{},
+	}
+	q := bfs.collection(bfs.kc.Namespace, kind).Query
+
+	// Equality filters. Only the first one can be served natively, since
+	// Firestore allows a single "array-contains" filter per query.
+	eqs := fq.EqFilters()
+	fields := make([]string, 0, len(eqs))
+	for field := range eqs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	nativeEq := false
+	for _, field := range fields {
+		for _, prop := range eqs[field] {
+			switch field {
+			case "__ancestor__":
+				continue
+			case "__key__":
+				q = q.WherePath(fsDocIDPath, "==", bfs.keyToDocRef(prop.Value().(*ds.Key)))
+				continue
+			}
+			val, err := bfs.propertyToNative(prop)
+			if err != nil {
+				return nil, err
+			}
+			f := fsFilter{prop: field, hasEq: true, eq: val}
+			fsq.propFilters[field] = append(fsq.propFilters[field], f)
+			if nativeEq {
+				fsq.filters = append(fsq.filters, f)
+			} else {
+				q = q.WherePath(fsIndexPath(field, fsIndexAll), "array-contains", val)
+				nativeEq = true
+			}
+		}
+	}
+	if ancestor := fq.Ancestor(); ancestor != nil {
+		q = q.WherePath(fsAncestorPath(ancestor), "==", docID(ancestor))
+	}
+
+	// Inequality filters. A lower bound is served natively on the largest value
+	// and an upper bound on the smallest one, which is exact for one-sided
+	// ranges. For two-sided ranges only the lower bound is served natively, and
+	// the rest is checked in memory.
+	lowField, lowOp, lowProp := fq.IneqFilterLow()
+	highField, highOp, highProp := fq.IneqFilterHigh()
+	ineqField, ineqAgg := lowField, ""
+	if ineqField == "" {
+		ineqField = highField
+	}
+	if ineqField == "__key__" {
+		if lowOp != "" {
+			q = q.WherePath(fsDocIDPath, lowOp, bfs.keyToDocRef(lowProp.Value().(*ds.Key)))
+		}
+		if highOp != "" {
+			q = q.WherePath(fsDocIDPath, highOp, bfs.keyToDocRef(highProp.Value().(*ds.Key)))
+		}
+	} else if ineqField != "" {
+		f := fsFilter{prop: ineqField, lowOp: lowOp, highOp: highOp}
+		var err error
+		if lowOp != "" {
+			if f.low, err = bfs.propertyToNative(lowProp); err != nil {
+				return nil, err
+			}
+		}
+		if highOp != "" {
+			if f.high, err = bfs.propertyToNative(highProp); err != nil {
+				return nil, err
+			}
+		}
+		fsq.propFilters[ineqField] = append(fsq.propFilters[ineqField], f)
+		switch {
+		case lowOp == "":
+			ineqAgg = fsIndexMin
+			q = q.WherePath(fsIndexPath(ineqField, fsIndexMin), highOp, f.high)
+		case highOp == "":
+			ineqAgg = fsIndexMax
+			q = q.WherePath(fsIndexPath(ineqField, fsIndexMax), lowOp, f.low)
+		default:
+			ineqAgg = fsIndexMax
+			q = q.WherePath(fsIndexPath(ineqField, fsIndexMax), lowOp, f.low)
+			fsq.filters = append(fsq.filters, f)
+		}
+	}
+
+	// Orders, the last one is always __key__. Multi-valued properties are
+	// ordered by their smallest value in ascending orders and by the largest
+	// one in descending orders, like in Datastore. Firestore requires the first
+	// order to be on the field with the inequality filter, so the property with
+	// the inequality filter is always ordered by the filtered value, which
+	// differs from Datastore for multi-valued properties.
+	for _, ic := range fq.Orders() {
+		dir := firestore.Asc
+		if ic.Descending {
+			dir = firestore.Desc
+		}
+		path := fsDocIDPath
+		switch {
+		case ic.Property == "__key__":
+		case ic.Property == ineqField && ineqAgg != "":
+			path = fsIndexPath(ic.Property, ineqAgg)
+		case ic.Descending:
+			path = fsIndexPath(ic.Property, fsIndexMax)
+		default:
+			path = fsIndexPath(ic.Property, fsIndexMin)
+		}
+		q = q.OrderByPath(path, dir)
+		fsq.orders = append(fsq.orders, path)
+	}
+
+	// Projection queries produce a result per combination of projected values.
+	fsq.inMemory = len(fsq.filters) != 0 || len(fsq.project) != 0
+
+	// Keys-only and projection queries still need the values of order and
+	// filtered fields.
+	if fsq.keysOnly || len(fsq.project) != 0 {
+		var paths []firestore.FieldPath
+		for _, path := range fsq.orders {
+			if !fsPathsEqual(path, fsDocIDPath) {
+				paths = append(paths, path)
+			}
+		}
+		for _, f := range fsq.filters {
+			paths = append(paths, fsIndexPath(f.prop, fsIndexAll))
+		}
+		for _, prop := range fsq.project {
+			paths = append(paths, fsIndexPath(prop, fsIndexAll))
+		}
+		q = q.SelectPaths(dedupPaths(paths)...)
+	}
+
+	// Cursors of queries evaluated in memory may point in the middle of the
+	// rows of a single entity, so such queries start at the entity and skip the
+	// rows already consumed.
+	start, end := fq.Bounds()
+	if start != nil {
+		cur, vals, err := bfs.cursorValues(start, fsq.orders)
+		if err != nil {
+			return nil, err
+		}
+		if fsq.inMemory {
+			fsq.start = cur
+			q = q.StartAt(vals...)
+		} else {
+			q = q.StartAfter(vals...)
+		}
+	}
+	if end != nil {
+		cur, vals, err := bfs.cursorValues(end, fsq.orders)
+		if err != nil {
+			return nil, err
+		}
+		fsq.end = cur
+		q = q.EndAt(vals...)
+	}
+
+	offset, hasOffset := fq.Offset()
+	fsq.limit, fsq.hasLimit = fq.Limit()
+	if fsq.inMemory {
+		fsq.offset = offset
+	} else {
+		if hasOffset {
+			q = q.Offset(int(offset))
+		}
+		if fsq.hasLimit {
+			q = q.Limit(int(fsq.limit))
+		}
+	}
+
+	fsq.native = q
+	return fsq, nil
+}
+
+var fsDocIDPath = firestore.FieldPath{firestore.DocumentID}
+
+// fsIndexPath returns the path to an aggregated value of the indexed property.
+func fsIndexPath(prop, agg string) firestore.FieldPath {
+	return firestore.FieldPath{fsIndexField, prop, agg}
+}
+
+// fsAncestorPath returns the path to the ancestor at the depth of the given
+// key.
+func fsAncestorPath(ancestor *ds.Key) firestore.FieldPath {
+	_, _, toks := ancestor.Split()
+	return firestore.FieldPath{fsAncestorsField, strconv.Itoa(len(toks) - 1)}
+}
+
+func dedupPaths(paths []firestore.FieldPath) []firestore.FieldPath {
+	out := make([]firestore.FieldPath, 0, len(paths))
+	for _, p := range paths {
+		dup := false
+		for _, seen := range out {
+			if fsPathsEqual(seen, p) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			out = append(out, p)
+		}
+	}
+	if len(out) == 0 {
+		// Select only document names.
+		out = append(out, fsDocIDPath)
+	}
+	return out
+}
+
+func fsPathsEqual(a, b firestore.FieldPath) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// fsValueAt returns the value at the given path in the document data.
+func fsValueAt(data map[string]interface{}, path firestore.FieldPath) interface{} {
+	var cur interface{} = data
+	for _, name := range path {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		cur = m[name]
+	}
+	return cur
+}
+
+// indexedValues returns all indexed values of the property.
+func indexedValues(data map[string]interface{}, prop string) []interface{} {
+	vals, _ := fsValueAt(data, fsIndexPath(prop, fsIndexAll)).([]interface{})
+	return vals
+}
+
+// rows returns the results produced by the document.
+//
+// Projection queries produce a result per combination of indexed values of
+// the projected properties, like Datastore does for multi-valued properties.
+// Only values that pass filters on the projected properties are used.
+func (bfs *boundFirestore) rows(fsq *firestoreQuery, data map[string]interface{}) ([]ds.PropertyMap, error) {
+	switch {
+	case fsq.keysOnly:
+		return []ds.PropertyMap{nil}, nil
+	case len(fsq.project) == 0:
+		pmap, err := bfs.dataToEntity(data)
+		if err != nil {
+			return nil, err
+		}
+		return []ds.PropertyMap{pmap}, nil
+	}
+
+	rows := []ds.PropertyMap{{}}
+	for _, name := range fsq.project {
+		var props []ds.Property
+		for _, v := range indexedValues(data, name) {
+			if !fsq.matchesValue(name, v) {
+				continue
+			}
+			prop, err := bfs.nativeToProperty(v, ds.ShouldIndex)
+			if err != nil {
+				return nil, errors.Annotate(err, "property %q", name).Err()
+			}
+			props = append(props, prop)
+		}
+		expanded := make([]ds.PropertyMap, 0, len(rows)*len(props))
+		for _, row := range rows {
+			for _, prop := range props {
+				pmap := make(ds.PropertyMap, len(row)+1)
+				for k, v := range row {
+					pmap[k] = v
+				}
+				pmap[name] = prop
+				expanded = append(expanded, pmap)
+			}
+		}
+		rows = expanded
+	}
+	return rows, nil
+}
+
+// fsTypeRank returns the position of the value type in the Firestore ordering
+// of values of different types.
+func fsTypeRank(v interface{}) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case int64, float64:
+		return 2
+	case time.Time:
+		return 3
+	case string:
+		return 4
+	case []byte:
+		return 5
+	case *firestore.DocumentRef:
+		return 6
+	case *latlng.LatLng:
+		return 7
+	case []interface{}:
+		return 8
+	default:
+		return 9
+	}
+}
+
+// compareNative compares two Firestore values the way Firestore orders them.
+func compareNative(a, b interface{}) int {
+	if ra, rb := fsTypeRank(a), fsTypeRank(b); ra != rb {
+		return compareInts(int64(ra), int64(rb))
+	}
+	switch a := a.(type) {
+	case bool:
+		switch b := b.(bool); {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			return compareInts(a, b)
+		}
+		return compareFloats(float64(a), b.(float64))
+	case float64:
+		if b, ok := b.(int64); ok {
+			return compareFloats(a, float64(b))
+		}
+		return compareFloats(a, b.(float64))
+	case time.Time:
+		b := b.(time.Time)
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	case string:
+		return strings.Compare(a, b.(string))
+	case []byte:
+		return bytes.Compare(a, b.([]byte))
+	case *firestore.DocumentRef:
+		return strings.Compare(a.Path, b.(*firestore.DocumentRef).Path)
+	case *latlng.LatLng:
+		b := b.(*latlng.LatLng)
+		if c := compareFloats(a.Latitude, b.Latitude); c != 0 {
+			return c
+		}
+		return compareFloats(a.Longitude, b.Longitude)
+	}
+	return 0
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// collection returns a reference to the collection with entities of the given
+// kind in the given namespace.
+func (bfs *boundFirestore) collection(namespace, kind string) *firestore.CollectionRef {
+	if namespace == "" {
+		return bfs.client.Collection(kind)
+	}
+	return bfs.client.Collection(fsNamespacesCollection).Doc(escapeDocID(namespace)).Collection(kind)
+}
+
+func (bfs *boundFirestore) keyToDocRef(key *ds.Key) *firestore.DocumentRef {
+	return bfs.collection(key.Namespace(), key.Kind()).Doc(docID(key))
+}
+
+func (bfs *boundFirestore) docRefToKey(ref *firestore.DocumentRef) (*ds.Key, error) {
+	toks, err := parseDocID(ref.ID)
+	if err != nil {
+		return nil, err
+	}
+	kc := bfs.kc
+	kc.Namespace = ""
+	if ns := ref.Parent.Parent; ns != nil {
+		if kc.Namespace, err = url.PathUnescape(ns.ID); err != nil {
+			return nil, errors.Annotate(err, "bad namespace document %q", ns.Path).Err()
+		}
+	}
+	return kc.NewKeyToks(toks), nil
+}
+
+// docID encodes the full key path as a Firestore document ID.
+//
+// Integer IDs are zero-padded and sort before string IDs, so documents of
+// the same kind without parents are ordered like Datastore keys.
+func docID(key *ds.Key) string {
+	_, _, toks := key.Split()
+	return encodeKeyToks(toks)
+}
+
+func encodeKeyToks(toks []ds.KeyTok) string {
+	parts := make([]string, len(toks))
+	for i, tok := range toks {
+		id := "s" + escapeDocID(tok.StringID)
+		if tok.StringID == "" {
+			id = fmt.Sprintf("i%020d", tok.IntID)
+		}
+		parts[i] = escapeDocID(tok.Kind) + "," + id
+	}
+	return strings.Join(parts, "|")
+}
+
+func parseDocID(id string) ([]ds.KeyTok, error) {
+	parts := strings.Split(id, "|")
+	toks := make([]ds.KeyTok, len(parts))
+	for i, part := range parts {
+		chunks := strings.Split(part, ",")
+		if len(chunks) != 2 || len(chunks[1]) == 0 {
+			return nil, errors.Reason("bad document ID %q", id).Err()
+		}
+		kind, err := url.PathUnescape(chunks[0])
+		if err != nil {
+			return nil, errors.Annotate(err, "bad document ID %q", id).Err()
+		}
+		toks[i].Kind = kind
+		switch val := chunks[1][1:]; chunks[1][0] {
+		case 'i':
+			if toks[i].IntID, err = strconv.ParseInt(val, 10, 64); err != nil {
+				return nil, errors.Annotate(err, "bad document ID %q", id).Err()
+			}
+		case 's':
+			if toks[i].StringID, err = url.PathUnescape(val); err != nil {
+				return nil, errors.Annotate(err, "bad document ID %q", id).Err()
+			}
+		default:
+			return nil, errors.Reason("bad document ID %q", id).Err()
+		}
+	}
+	return toks, nil
+}
+
+var docIDEscaper = strings.NewReplacer(
+	"%", "%25",
+	"/", "%2F",
+	",", "%2C",
+	"|", "%7C",
+)
+
+func escapeDocID(s string) string {
+	return docIDEscaper.Replace(s)
+}
+
+func (bfs *boundFirestore) entityToData(key *ds.Key, pm ds.PropertyMap) (map[string]interface{}, error) {
+	data, err := bfs.propertyMapToNative(pm, true)
+	if err != nil {
+		return nil, err
+	}
+	_, _, toks := key.Split()
+	ancestors := make(map[string]interface{}, len(toks))
+	for i := range toks {
+		ancestors[strconv.Itoa(i)] = encodeKeyToks(toks[:i+1])
+	}
+	data[fsAncestorsField] = ancestors
+	return data, nil
+}
+
+// propertyMapToNative converts a PropertyMap into a Firestore map value.
+//
+// If withIndex is true, adds values of indexed properties used by queries.
+func (bfs *boundFirestore) propertyMapToNative(pm ds.PropertyMap, withIndex bool) (map[string]interface{}, error) {
+	pm, err := pm.Save(false)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]interface{}, len(pm)+3)
+	var noIndex, slices []interface{}
+	idx := map[string]interface{}{}
+
+	names := make([]string, 0, len(pm))
+	for name := range pm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if strings.HasPrefix(name, "@") {
+			return nil, errors.Reason("property name %q is reserved", name).Err()
+		}
+
+		var props ds.PropertySlice
+		switch t := pm[name].(type) {
+		case ds.Property:
+			val, err := bfs.propertyToNative(t)
+			if err != nil {
+				return nil, errors.Annotate(err, "property %q", name).Err()
+			}
+			data[name] = val
+			props = ds.PropertySlice{t}
+		case ds.PropertySlice:
+			vals := make([]interface{}, len(t))
+			for i, prop := range t {
+				if vals[i], err = bfs.propertyToNative(prop); err != nil {
+					return nil, errors.Annotate(err, "property %q", name).Err()
+				}
+			}
+			data[name] = vals
+			slices = append(slices, name)
+			props = t
+		default:
+			return nil, errors.Reason("unsupported PropertyData type for %q: %T", name, t).Err()
+		}
+
+		// Like in Cloud Datastore, a property is indexed if any of its values is.
+		indexed := false
+		for _, prop := range props {
+			if prop.IndexSetting() == ds.ShouldIndex {
+				indexed = true
+				break
+			}
+		}
+		if !indexed {
+			noIndex = append(noIndex, name)
+			continue
+		}
+		if withIndex {
+			if err := bfs.addIndexedValues(idx, name, props); err != nil {
+				return nil, errors.Annotate(err, "property %q", name).Err()
+			}
+		}
+	}
+
+	data[fsMetaField] = map[string]interface{}{
+		fsMetaNoIndex: noIndex,
+		fsMetaSlices:  slices,
+	}
+	if withIndex {
+		data[fsIndexField] = idx
+	}
+	return data, nil
+}
+
+// addIndexedValues adds indexed values of the property and the smallest and the
+// largest of them to idx. Embedded entities can't be queried and are skipped.
+func (bfs *boundFirestore) addIndexedValues(idx map[string]interface{}, name string, props ds.PropertySlice) error {
+	var vals []interface{}
+	for _, prop := range props {
+		if prop.IndexSetting() != ds.ShouldIndex || prop.Type() == ds.PTPropertyMap {
+			continue
+		}
+		val, err := bfs.propertyToNative(prop)
+		if err != nil {
+			return err
+		}
+		vals = append(vals, val)
+	}
+	if len(vals) == 0 {
+		return nil
+	}
+	min, max := vals[0], vals[0]
+	for _, val := range vals[1:] {
+		if compareNative(val, min) < 0 {
+			min = val
+		}
+		if compareNative(val, max) > 0 {
+			max = val
+		}
+	}
+	idx[name] = map[string]interface{}{
+		fsIndexAll: vals,
+		fsIndexMin: min,
+		fsIndexMax: max,
+	}
+	return nil
+}
+
+func (bfs *boundFirestore) propertyToNative(prop ds.Property) (interface{}, error) {
+	switch pt := prop.Type(); pt {
+	case ds.PTNull, ds.PTInt, ds.PTBool, ds.PTBytes, ds.PTString, ds.PTFloat:
+		return prop.Value(), nil
+
+	case ds.PTTime:
+		return prop.Value().(time.Time).UTC(), nil
+
+	case ds.PTGeoPoint:
+		gp := prop.Value().(ds.GeoPoint)
+		return &latlng.LatLng{Latitude: gp.Lat, Longitude: gp.Lng}, nil
+
+	case ds.PTKey:
+		return bfs.keyToDocRef(prop.Value().(*ds.Key)), nil
+
+	case ds.PTPropertyMap:
+		return bfs.propertyMapToNative(prop.Value().(ds.PropertyMap), false)
+
+	default:
+		return nil, errors.Reason("unsupported property type: %v", pt).Err()
+	}
+}
+
+func (bfs *boundFirestore) dataToEntity(data map[string]interface{}) (ds.PropertyMap, error) {
+	meta, _ := data[fsMetaField].(map[string]interface{})
+	noIndex := metaNames(meta, fsMetaNoIndex)
+	slices := metaNames(meta, fsMetaSlices)
+
+	pm := make(ds.PropertyMap, len(data))
+	for name, val := range data {
+		if strings.HasPrefix(name, "@") {
+			continue
+		}
+		indexSetting := ds.ShouldIndex
+		if noIndex[name] {
+			indexSetting = ds.NoIndex
+		}
+
+		vals, isArray := val.([]interface{})
+		if !slices[name] || !isArray {
+			prop, err := bfs.nativeToProperty(val, indexSetting)
+			if err != nil {
+				return nil, errors.Annotate(err, "property %q", name).Err()
+			}
+			pm[name] = prop
+			continue
+		}
+
+		pslice := make(ds.PropertySlice, len(vals))
+		for i, v := range vals {
+			var err error
+			if pslice[i], err = bfs.nativeToProperty(v, indexSetting); err != nil {
+				return nil, errors.Annotate(err, "property %q", name).Err()
+			}
+		}
+		pm[name] = pslice
+	}
+	return pm, nil
+}
+
+func metaNames(meta map[string]interface{}, field string) map[string]bool {
+	names, _ := meta[field].([]interface{})
+	out := make(map[string]bool, len(names))
+	for _, n := range names {
+		if s, ok := n.(string); ok {
+			out[s] = true
+		}
+	}
+	return out
+}
+
+func (bfs *boundFirestore) nativeToProperty(val interface{}, indexSetting ds.IndexSetting) (prop ds.Property, err error) {
+	switch v := val.(type) {
+	case nil, int64, bool, string, float64:
+	case []byte:
+		if len(v) == 0 {
+			val = []byte(nil)
+		}
+	case time.Time:
+		val = v.UTC()
+	case *latlng.LatLng:
+		val = ds.GeoPoint{Lat: v.Latitude, Lng: v.Longitude}
+	case *firestore.DocumentRef:
+		if val, err = bfs.docRefToKey(v); err != nil {
+			return
+		}
+	case map[string]interface{}:
+		if val, err = bfs.dataToEntity(v); err != nil {
+			return
+		}
+	default:
+		err = errors.Reason("unsupported Firestore value type %T", v).Err()
+		return
+	}
+	err = prop.SetValue(val, indexSetting)
+	return
+}
+
+// firestoreCursor is a position in query results.
+//
+// It holds the values of all native query orders of the last returned entity,
+// the last one being its key, and the number of rows of this entity already
+// returned by projection queries.
+type firestoreCursor struct {
+	vals ds.PropertySlice
+	rows int
+}
+
+func (c *firestoreCursor) key() *ds.Key {
+	key, _ := c.vals[len(c.vals)-1].Value().(*ds.Key)
+	return key
+}
+
+func (c *firestoreCursor) String() string {
+	buf := bytes.Buffer{}
+	if _, err := cmpbin.WriteUint(&buf, uint64(len(c.vals))); err != nil {
+		panic(err)
+	}
+	for _, prop := range c.vals {
+		if err := ds.SerializeKC.Property(&buf, prop); err != nil {
+			panic(err)
+		}
+	}
+	if _, err := cmpbin.WriteUint(&buf, uint64(c.rows)); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes())
+}
+
+func decodeFirestoreCursor(s string) (ds.Cursor, error) {
+	blob, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad cursor").Err()
+	}
+	buf := bytes.NewBuffer(blob)
+	count, _, err := cmpbin.ReadUint(buf)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad cursor").Err()
+	}
+	if count == 0 || count > uint64(len(blob)) {
+		return nil, errors.New("bad cursor: wrong number of values")
+	}
+	cur := &firestoreCursor{vals: make(ds.PropertySlice, count)}
+	for i := range cur.vals {
+		if cur.vals[i], err = ds.Deserialize.Property(buf); err != nil {
+			return nil, errors.Annotate(err, "bad cursor").Err()
+		}
+	}
+	if cur.key() == nil {
+		return nil, errors.New("bad cursor: no key")
+	}
+	rows, _, err := cmpbin.ReadUint(buf)
+	if err != nil {
+		return nil, errors.Annotate(err, "bad cursor").Err()
+	}
+	if rows > uint64(len(blob))*8 {
+		return nil, errors.New("bad cursor: too many rows")
+	}
+	cur.rows = int(rows)
+	if buf.Len() != 0 {
+		return nil, errors.New("bad cursor: trailing data")
+	}
+	return cur, nil
+}
+
+// cursorAfter returns a cursor pointing right after the given number of rows
+// of the given entity.
+func (bfs *boundFirestore) cursorAfter(orders []firestore.FieldPath, key *ds.Key, data map[string]interface{}, rows int) (ds.Cursor, error) {
+	cur := &firestoreCursor{vals: make(ds.PropertySlice, len(orders)), rows: rows}
+	for i, path := range orders {
+		if fsPathsEqual(path, fsDocIDPath) {
+			cur.vals[i] = ds.MkProperty(key)
+			continue
+		}
+		prop, err := bfs.nativeToProperty(fsValueAt(data, path), ds.ShouldIndex)
+		if err != nil {
+			return nil, err
+		}
+		cur.vals[i] = prop
+	}
+	return cur, nil
+}
+
+// cursorValues converts the cursor into values for Firestore StartAt/EndAt.
+func (bfs *boundFirestore) cursorValues(c ds.Cursor, orders []firestore.FieldPath) (*firestoreCursor, []interface{}, error) {
+	cur, ok := c.(*firestoreCursor)
+	if !ok {
+		return nil, nil, errors.Reason("unexpected cursor type %T", c).Err()
+	}
+	if len(cur.vals) != len(orders) {
+		return nil, nil, errors.Reason("the cursor doesn't match the query orders").Err()
+	}
+	vals := make([]interface{}, len(orders))
+	for i, path := range orders {
+		prop := cur.vals[i]
+		if fsPathsEqual(path, fsDocIDPath) {
+			key, ok := prop.Value().(*ds.Key)
+			if !ok {
+				return nil, nil, errors.Reason("the cursor doesn't match the query orders").Err()
+			}
+			vals[i] = docID(key)
+			continue
+		}
+		var err error
+		if vals[i], err = bfs.propertyToNative(prop); err != nil {
+			return nil, nil, err
+		}
+	}
+	return cur, vals, nil
+}
+
+// firestoreWrite is a pending Set or Delete of a document.
+type firestoreWrite struct {
+	ref  *firestore.DocumentRef
+	data map[string]interface{} // nil for deletions
+}
+
+func (w *firestoreWrite) apply(batch *firestore.WriteBatch) {
+	if w.data == nil {
+		batch.Delete(w.ref)
+	} else {
+		batch.Set(w.ref, w.data)
+	}
+}
+
+// firestoreTransactionWrapper buffers transactional writes.
+//
+// Firestore requires all reads in a transaction to happen before any writes,
+// while Datastore allows to interleave them. Since transactional reads in
+// Datastore don't observe writes made in the same transaction anyway, writes
+// are buffered and applied when the transaction function returns.
+type firestoreTransactionWrapper struct {
+	tx *firestore.Transaction
+
+	mu     sync.Mutex
+	writes []firestoreWrite
+}
+
+func (tw *firestoreTransactionWrapper) add(writes []firestoreWrite) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	tw.writes = append(tw.writes, writes...)
+}
+
+func (tw *firestoreTransactionWrapper) flush() error {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	for _, w := range tw.writes {
+		var err error
+		if w.data == nil {
+			err = tw.tx.Delete(w.ref)
+		} else {
+			err = tw.tx.Set(w.ref, w.data)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	tw.writes = nil
+	return nil
+}
+
+var firestoreTransactionKey = "*firestoreTransactionWrapper"
+
+func withFirestoreTransaction(c context.Context, tw *firestoreTransactionWrapper) context.Context {
+	return context.WithValue(c, &firestoreTransactionKey, tw)
+}
+
+func withoutFirestoreTransaction(c context.Context) context.Context {
+	return context.WithValue(c, &firestoreTransactionKey, nil)
+}
+
+func firestoreTransaction(c context.Context) *firestoreTransactionWrapper {
+	if tw, ok := c.Value(&firestoreTransactionKey).(*firestoreTransactionWrapper); ok {
+		return tw
+	}
+	return nil
+}
+
+func normalizeFirestoreError(err error) error {
+	switch status.Code(errors.Unwrap(err)) {
+	case codes.OK:
+		return err
+	case codes.Aborted:
+		return ds.ErrConcurrentTransaction
+	default:
+		return err
+	}
+}
@@ -0,0 +1,534 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"go.chromium.org/luci/common/system/port"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBoundFirestore(t *testing.T) {
+	t.Parallel()
+
+	Convey("boundFirestore", t, func() {
+		ctx := context.Background()
+		client, err := firestore.NewClient(ctx, "luci-gae-test",
+			option.WithoutAuthentication(),
+			option.WithEndpoint("localhost:1"),
+			option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+		So(err, ShouldBeNil)
+		defer client.Close()
+
+		kc := ds.KeyContext{AppID: "luci-gae-test"}
+		bfs := &boundFirestore{
+			Context:            ctx,
+			firestoreDatastore: &firestoreDatastore{client: client},
+			kc:                 kc,
+		}
+
+		Convey("Keys", func() {
+			keys := []*ds.Key{
+				kc.MakeKey("Kind", 123),
+				kc.MakeKey("Kind", "a/b,c|d%e"),
+				kc.MakeKey("Parent", "p", "Kind", 1),
+				ds.KeyContext{AppID: "luci-gae-test", Namespace: "ns/1"}.MakeKey("Kind", "x"),
+			}
+			for _, key := range keys {
+				ref := bfs.keyToDocRef(key)
+				So(ref.Parent.ID, ShouldEqual, "Kind")
+				back, err := bfs.docRefToKey(ref)
+				So(err, ShouldBeNil)
+				So(back.Equal(key), ShouldBeTrue)
+			}
+
+			So(docID(keys[0]), ShouldEqual, "Kind,i00000000000000000123")
+			So(docID(keys[1]), ShouldEqual, "Kind,sa%2Fb%2Cc%7Cd%25e")
+			So(docID(keys[2]), ShouldEqual, "Parent,sp|Kind,i00000000000000000001")
+			So(bfs.keyToDocRef(keys[3]).Parent.Parent.ID, ShouldEqual, "ns%2F1")
+
+			// Integer IDs are ordered numerically and before string IDs.
+			So(docID(kc.MakeKey("Kind", 9)) < docID(kc.MakeKey("Kind", 10)), ShouldBeTrue)
+			So(docID(kc.MakeKey("Kind", 10)) < docID(kc.MakeKey("Kind", "1")), ShouldBeTrue)
+
+			_, err := parseDocID("Kind,x1")
+			So(err, ShouldErrLike, "bad document ID")
+		})
+
+		Convey("Entities", func() {
+			testTime := ds.RoundTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+			pmap := ds.PropertyMap{
+				"$kind":   mkp("Test"),
+				"int":     mkp(1),
+				"str":     mkpNI("s"),
+				"bytes":   mkp([]byte("b")),
+				"time":    mkp(testTime),
+				"float":   mkp(1.5),
+				"bool":    mkp(true),
+				"null":    mkp(nil),
+				"geo":     mkp(ds.GeoPoint{Lat: 1, Lng: 2}),
+				"key":     mkp(kc.MakeKey("Other", "k")),
+				"multi":   mkp("a", "b"),
+				"single":  mkProperties(true, true, "a"),
+				"empty":   ds.PropertySlice{},
+				"nested":  mkp(ds.PropertyMap{"inner": mkpNI(1, 2)}),
+				"noindex": mkpNI(1, 2),
+			}
+			key := kc.MakeKey("Parent", 1, "Test", "id")
+
+			data, err := bfs.entityToData(key, pmap)
+			So(err, ShouldBeNil)
+			So(data[fsAncestorsField], ShouldResemble, map[string]interface{}{
+				"0": "Parent,i00000000000000000001",
+				"1": "Parent,i00000000000000000001|Test,sid",
+			})
+			idx := data[fsIndexField].(map[string]interface{})
+			So(idx["multi"], ShouldResemble, map[string]interface{}{
+				fsIndexAll: []interface{}{"a", "b"},
+				fsIndexMin: "a",
+				fsIndexMax: "b",
+			})
+			So(idx["int"], ShouldResemble, map[string]interface{}{
+				fsIndexAll: []interface{}{int64(1)},
+				fsIndexMin: int64(1),
+				fsIndexMax: int64(1),
+			})
+			So(idx, ShouldNotContainKey, "str")
+			So(idx, ShouldNotContainKey, "noindex")
+			So(idx, ShouldNotContainKey, "nested")
+			So(idx, ShouldNotContainKey, "empty")
+
+			back, err := bfs.dataToEntity(data)
+			So(err, ShouldBeNil)
+			delete(pmap, "$kind")
+			So(back, ShouldResemble, pmap)
+
+			_, err = bfs.entityToData(key, ds.PropertyMap{"@idx": mkp(1)})
+			So(err, ShouldErrLike, "is reserved")
+		})
+
+		Convey("Value ordering", func() {
+			ordered := []interface{}{
+				nil,
+				false,
+				true,
+				int64(-1),
+				0.5,
+				int64(1),
+				time.Unix(1, 0).UTC(),
+				time.Unix(2, 0).UTC(),
+				"a",
+				"b",
+				[]byte("a"),
+				bfs.keyToDocRef(kc.MakeKey("Kind", 1)),
+				bfs.keyToDocRef(kc.MakeKey("Kind", 2)),
+			}
+			for i := range ordered {
+				So(compareNative(ordered[i], ordered[i]), ShouldEqual, 0)
+				for j := i + 1; j < len(ordered); j++ {
+					So(compareNative(ordered[i], ordered[j]), ShouldEqual, -1)
+					So(compareNative(ordered[j], ordered[i]), ShouldEqual, 1)
+				}
+			}
+			So(compareNative(int64(1), 1.0), ShouldEqual, 0)
+		})
+
+		Convey("Query planning", func() {
+			plan := func(q *ds.Query) *firestoreQuery {
+				fq, err := q.Finalize()
+				So(err, ShouldBeNil)
+				fsq, err := bfs.planQuery(fq)
+				So(err, ShouldBeNil)
+				return fsq
+			}
+
+			Convey("Native", func() {
+				fsq := plan(ds.NewQuery("Kind").Eq("a", 1).Gt("b", 2).Limit(10))
+				So(fsq.inMemory, ShouldBeFalse)
+				So(fsq.filters, ShouldBeEmpty)
+				So(fsq.orders, ShouldResemble, []firestore.FieldPath{
+					fsIndexPath("b", fsIndexMax),
+					fsDocIDPath,
+				})
+
+				fsq = plan(ds.NewQuery("Kind").Lt("b", 2).Order("-b").Order("c"))
+				So(fsq.inMemory, ShouldBeFalse)
+				So(fsq.orders, ShouldResemble, []firestore.FieldPath{
+					fsIndexPath("b", fsIndexMin),
+					fsIndexPath("c", fsIndexMin),
+					fsDocIDPath,
+				})
+
+				fsq = plan(ds.NewQuery("Kind").Order("-c"))
+				So(fsq.orders, ShouldResemble, []firestore.FieldPath{
+					fsIndexPath("c", fsIndexMax),
+					fsDocIDPath,
+				})
+			})
+
+			Convey("In memory", func() {
+				fsq := plan(ds.NewQuery("Kind").Eq("a", 1).Eq("b", 2, 3))
+				So(fsq.inMemory, ShouldBeTrue)
+				So(fsq.filters, ShouldResemble, []fsFilter{
+					{prop: "b", hasEq: true, eq: int64(2)},
+					{prop: "b", hasEq: true, eq: int64(3)},
+				})
+
+				fsq = plan(ds.NewQuery("Kind").Gt("b", 2).Lt("b", 5))
+				So(fsq.inMemory, ShouldBeTrue)
+				So(fsq.filters, ShouldResemble, []fsFilter{
+					{prop: "b", lowOp: ">", low: int64(2), highOp: "<", high: int64(5)},
+				})
+
+				So(fsq.matches(map[string]interface{}{
+					fsIndexField: map[string]interface{}{
+						"b": map[string]interface{}{fsIndexAll: []interface{}{int64(1), int64(3)}},
+					},
+				}), ShouldBeTrue)
+				// Neither value is within the range.
+				So(fsq.matches(map[string]interface{}{
+					fsIndexField: map[string]interface{}{
+						"b": map[string]interface{}{fsIndexAll: []interface{}{int64(1), int64(7)}},
+					},
+				}), ShouldBeFalse)
+				// Range filters match values of the same type only.
+				So(fsq.matches(map[string]interface{}{
+					fsIndexField: map[string]interface{}{
+						"b": map[string]interface{}{fsIndexAll: []interface{}{"3"}},
+					},
+				}), ShouldBeFalse)
+
+				fsq = plan(ds.NewQuery("Kind").Project("a"))
+				So(fsq.inMemory, ShouldBeTrue)
+			})
+
+			Convey("Projections expand multi-valued properties", func() {
+				fsq := plan(ds.NewQuery("Kind").Project("a", "b").Gt("b", 1))
+				rows, err := bfs.rows(fsq, map[string]interface{}{
+					fsIndexField: map[string]interface{}{
+						"a": map[string]interface{}{fsIndexAll: []interface{}{"x", "y"}},
+						"b": map[string]interface{}{fsIndexAll: []interface{}{int64(1), int64(2), int64(3)}},
+					},
+				})
+				So(err, ShouldBeNil)
+				So(rows, ShouldResemble, []ds.PropertyMap{
+					{"a": mkp("x"), "b": mkp(2)},
+					{"a": mkp("x"), "b": mkp(3)},
+					{"a": mkp("y"), "b": mkp(2)},
+					{"a": mkp("y"), "b": mkp(3)},
+				})
+			})
+
+			Convey("Unsupported", func() {
+				fq, err := ds.NewQuery("").Finalize()
+				So(err, ShouldBeNil)
+				_, err = bfs.planQuery(fq)
+				So(err, ShouldErrLike, "kindless queries")
+
+				fq, err = ds.NewQuery("Kind").Project("a").Distinct(true).Finalize()
+				So(err, ShouldBeNil)
+				_, err = bfs.planQuery(fq)
+				So(err, ShouldErrLike, "distinct queries")
+			})
+		})
+
+		Convey("Cursors", func() {
+			orders := []firestore.FieldPath{fsIndexPath("a", fsIndexMin), fsIndexPath("b", fsIndexMax), fsDocIDPath}
+			key := kc.MakeKey("Kind", 1)
+			cur, err := bfs.cursorAfter(orders, key, map[string]interface{}{
+				fsIndexField: map[string]interface{}{
+					"a": map[string]interface{}{fsIndexMin: "x"},
+					"b": map[string]interface{}{fsIndexMax: int64(2)},
+				},
+			}, 3)
+			So(err, ShouldBeNil)
+
+			decoded, err := bfs.DecodeCursor(cur.String())
+			So(err, ShouldBeNil)
+			fc, vals, err := bfs.cursorValues(decoded, orders)
+			So(err, ShouldBeNil)
+			So(vals, ShouldResemble, []interface{}{"x", int64(2), docID(key)})
+			So(fc.rows, ShouldEqual, 3)
+			So(fc.key().Equal(key), ShouldBeTrue)
+
+			_, _, err = bfs.cursorValues(decoded, orders[1:])
+			So(err, ShouldErrLike, "doesn't match")
+			_, err = bfs.DecodeCursor("garbage!")
+			So(err, ShouldErrLike, "bad cursor")
+		})
+	})
+}
+
+// firestoreEmulator returns the address of the Firestore emulator to test
+// against.
+//
+// Uses FIRESTORE_EMULATOR_HOST if it is set. Otherwise, if INTEGRATION_TESTS is
+// "1", starts a new emulator with gcloud, which must be installed:
+//
+// $ gcloud components install cloud-firestore-emulator
+//
+// Otherwise returns "".
+func firestoreEmulator(t *testing.T) string {
+	if host := os.Getenv("FIRESTORE_EMULATOR_HOST"); host != "" {
+		return host
+	}
+	if os.Getenv("INTEGRATION_TESTS") != "1" {
+		return ""
+	}
+
+	p, err := port.PickUnusedPort()
+	if err != nil {
+		t.Fatalf("failed to pick a port for the Firestore emulator: %s", err)
+	}
+	host := fmt.Sprintf("localhost:%d", p)
+	cmd := exec.Command("gcloud", "emulators", "firestore", "start", "--host-port="+host)
+	cmd.Stdout = os.Stderr
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("failed to start the Firestore emulator: %s", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	deadline := time.Now().Add(time.Minute)
+	for {
+		conn, err := net.DialTimeout("tcp", host, time.Second)
+		if err == nil {
+			conn.Close()
+			return host
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("the Firestore emulator didn't start: %s", err)
+		}
+		time.Sleep(200 * time.Millisecond)
+	}
+}
+
+// TestFirestore tests the Firestore-backed datastore against the Firestore
+// emulator, see firestoreEmulator.
+//
+// If the emulator is not available, this test will be skipped.
+func TestFirestore(t *testing.T) {
+	t.Parallel()
+
+	emulatorHost := firestoreEmulator(t)
+	if emulatorHost == "" {
+		t.Skip("No emulator available (FIRESTORE_EMULATOR_HOST or INTEGRATION_TESTS=1). Skipping test suite.")
+	}
+
+	Convey(fmt.Sprintf(`A cloud installation using firestore emulator %q`, emulatorHost), t, func() {
+		c := context.Background()
+		client, err := firestore.NewClient(c, "luci-gae-test",
+			option.WithoutAuthentication(),
+			option.WithEndpoint(emulatorHost),
+			option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())))
+		So(err, ShouldBeNil)
+		defer client.Close()
+
+		cfg := ConfigLite{ProjectID: "luci-gae-test", FS: client}
+		c = cfg.Use(c)
+
+		randNamespace := make([]byte, 16)
+		if _, err := rand.Read(randNamespace); err != nil {
+			panic(err)
+		}
+		c = info.MustNamespace(c, fmt.Sprintf("testing-%s", hex.EncodeToString(randNamespace)))
+
+		type Entity struct {
+			Kind   string   `gae:"$kind,Entity"`
+			ID     int64    `gae:"$id"`
+			Parent *ds.Key  `gae:"$parent"`
+			Tags   []string `gae:"tags"`
+			Value  int64    `gae:"value"`
+			Nums   []int64  `gae:"nums"`
+		}
+
+		Convey(`Put, Get and Delete`, func() {
+			ent := &Entity{Tags: []string{"a", "b"}, Value: 1}
+			So(ds.Put(c, ent), ShouldBeNil)
+			So(ent.ID, ShouldNotEqual, 0)
+
+			fetched := &Entity{ID: ent.ID}
+			So(ds.Get(c, fetched), ShouldBeNil)
+			So(fetched, ShouldResemble, ent)
+
+			So(ds.Delete(c, fetched), ShouldBeNil)
+			So(ds.Get(c, fetched), ShouldEqual, ds.ErrNoSuchEntity)
+		})
+
+		Convey(`Queries`, func() {
+			parent := ds.MakeKey(c, "Parent", 1)
+			So(ds.Put(c, []*Entity{
+				{ID: 1, Tags: []string{"a", "b"}, Value: 1},
+				{ID: 2, Tags: []string{"b"}, Value: 2},
+				{ID: 3, Parent: parent, Tags: []string{"a"}, Value: 3},
+			}), ShouldBeNil)
+
+			run := func(q *ds.Query) []int64 {
+				var out []*Entity
+				So(ds.GetAll(c, q, &out), ShouldBeNil)
+				ids := make([]int64, len(out))
+				for i, e := range out {
+					ids[i] = e.Value
+				}
+				return ids
+			}
+
+			So(run(ds.NewQuery("Entity").Eq("tags", "a")), ShouldResemble, []int64{1, 3})
+			So(run(ds.NewQuery("Entity").Eq("tags", "a", "b")), ShouldResemble, []int64{1})
+			So(run(ds.NewQuery("Entity").Ancestor(parent)), ShouldResemble, []int64{3})
+			So(run(ds.NewQuery("Entity").Gt("value", 1).Order("-value")), ShouldResemble, []int64{3, 2})
+
+			count, err := ds.Count(c, ds.NewQuery("Entity").Eq("tags", "b"))
+			So(err, ShouldBeNil)
+			So(count, ShouldEqual, 2)
+
+			// Cursors.
+			var cursor ds.Cursor
+			var page []int64
+			err = ds.Run(c, ds.NewQuery("Entity").Order("value").Limit(2), func(e *Entity, cb ds.CursorCB) error {
+				page = append(page, e.Value)
+				var err error
+				cursor, err = cb()
+				return err
+			})
+			So(err, ShouldBeNil)
+			So(page, ShouldResemble, []int64{1, 2})
+			So(run(ds.NewQuery("Entity").Order("value").Start(cursor)), ShouldResemble, []int64{3})
+		})
+
+		Convey(`Multi-valued properties`, func() {
+			So(ds.Put(c, []*Entity{
+				{ID: 1, Tags: []string{"a", "b"}, Nums: []int64{1, 10}, Value: 1},
+				{ID: 2, Tags: []string{"b", "c"}, Nums: []int64{5}, Value: 2},
+				{ID: 3, Tags: []string{"c"}, Nums: []int64{3, 7}, Value: 3},
+			}), ShouldBeNil)
+
+			run := func(q *ds.Query) []int64 {
+				var out []*Entity
+				So(ds.GetAll(c, q, &out), ShouldBeNil)
+				vals := make([]int64, len(out))
+				for i, e := range out {
+					vals[i] = e.Value
+				}
+				return vals
+			}
+
+			// Any value satisfies the filter.
+			So(run(ds.NewQuery("Entity").Gt("nums", 6)), ShouldResemble, []int64{3, 1})
+			So(run(ds.NewQuery("Entity").Lt("nums", 4)), ShouldResemble, []int64{1, 3})
+			So(run(ds.NewQuery("Entity").Gt("nums", 4).Lt("nums", 6)), ShouldResemble, []int64{2})
+			So(run(ds.NewQuery("Entity").Gte("nums", 2).Lte("nums", 4)), ShouldResemble, []int64{3})
+
+			// Ascending orders use the smallest value, descending the largest.
+			So(run(ds.NewQuery("Entity").Order("nums")), ShouldResemble, []int64{1, 3, 2})
+			So(run(ds.NewQuery("Entity").Order("-nums")), ShouldResemble, []int64{1, 3, 2})
+
+			// Several equality filters.
+			So(run(ds.NewQuery("Entity").Eq("tags", "b").Eq("nums", 5)), ShouldResemble, []int64{2})
+			So(run(ds.NewQuery("Entity").Eq("tags", "b", "c")), ShouldResemble, []int64{2})
+			So(run(ds.NewQuery("Entity").Eq("tags", "c").Eq("nums", 3).Limit(1)), ShouldResemble, []int64{3})
+
+			Convey(`Projections`, func() {
+				project := func(q *ds.Query) []string {
+					var out []ds.PropertyMap
+					So(ds.GetAll(c, q, &out), ShouldBeNil)
+					rows := make([]string, len(out))
+					for i, pm := range out {
+						rows[i] = fmt.Sprintf("%d:%s", ds.GetMetaDefault(pm, "id", 0), pm.Slice("tags")[0].Value())
+					}
+					return rows
+				}
+
+				q := ds.NewQuery("Entity").Project("tags")
+				So(project(q), ShouldResemble, []string{"1:a", "1:b", "2:b", "2:c", "3:c"})
+				So(project(q.Gt("tags", "b")), ShouldResemble, []string{"2:c", "3:c"})
+				So(project(q.Offset(1).Limit(3)), ShouldResemble, []string{"1:b", "2:b", "2:c"})
+
+				// Cursors point in the middle of the entity rows.
+				var cursor ds.Cursor
+				err := ds.Run(c, q.Limit(3), func(pm ds.PropertyMap, cb ds.CursorCB) error {
+					var err error
+					cursor, err = cb()
+					return err
+				})
+				So(err, ShouldBeNil)
+				So(project(q.Start(cursor)), ShouldResemble, []string{"2:c", "3:c"})
+				So(project(q.End(cursor)), ShouldResemble, []string{"1:a", "1:b", "2:b"})
+			})
+		})
+
+		Convey(`ID allocation`, func() {
+			keys := make([]*ds.Key, 10)
+			for i := range keys {
+				keys[i] = ds.NewIncompleteKeys(c, 1, "Entity", nil)[0]
+			}
+			So(ds.AllocateIDs(c, keys), ShouldBeNil)
+			seen := map[int64]bool{}
+			for _, key := range keys {
+				So(key.IsIncomplete(), ShouldBeFalse)
+				So(seen[key.IntID()], ShouldBeFalse)
+				seen[key.IntID()] = true
+			}
+
+			// Allocated IDs are reserved even if entities were never put.
+			ents := []*Entity{{Value: 1}, {Value: 2}}
+			So(ds.Put(c, ents), ShouldBeNil)
+			So(ents[0].ID, ShouldNotEqual, ents[1].ID)
+			So(seen[ents[0].ID] || seen[ents[1].ID], ShouldBeFalse)
+		})
+
+		Convey(`Transactions`, func() {
+			So(ds.Put(c, &Entity{ID: 1, Value: 1}), ShouldBeNil)
+			err := ds.RunInTransaction(c, func(c context.Context) error {
+				ent := &Entity{ID: 1}
+				if err := ds.Get(c, ent); err != nil {
+					return err
+				}
+				ent.Value++
+				if err := ds.Put(c, ent); err != nil {
+					return err
+				}
+				// Reads after writes are allowed.
+				return ds.Get(c, &Entity{ID: 1})
+			}, nil)
+			So(err, ShouldBeNil)
+
+			ent := &Entity{ID: 1}
+			So(ds.Get(c, ent), ShouldBeNil)
+			So(ent.Value, ShouldEqual, 2)
+		})
+	})
+}
@@ -101,22 +101,26 @@ func (d *dsImpl) DecodeCursor(s string) (ds.Cursor, error) {
 
 func (d *dsImpl) Run(fq *ds.FinalizedQuery, cb ds.RawRunCB) error {
 	cb = d.data.stripSpecialPropsRunCB(cb)
+	plan := d.data.newQueryPlan(d.kc, fq, false)
 	idx, head := d.data.getQuerySnaps(!fq.EventuallyConsistent())
-	err := executeQuery(fq, d.kc, false, idx, head, cb)
-	if d.data.maybeAutoIndex(err) {
+	err := executeQuery(fq, d.kc, false, idx, head, plan, cb)
+	if plan.noteMissing(err) && d.data.maybeAutoIndex(err) {
 		idx, head = d.data.getQuerySnaps(!fq.EventuallyConsistent())
-		err = executeQuery(fq, d.kc, false, idx, head, cb)
+		err = executeQuery(fq, d.kc, false, idx, head, plan, cb)
 	}
+	d.data.recordQuery(plan)
 	return err
 }
 
 func (d *dsImpl) Count(fq *ds.FinalizedQuery) (ret int64, err error) {
+	plan := d.data.newQueryPlan(d.kc, fq, false)
 	idx, head := d.data.getQuerySnaps(!fq.EventuallyConsistent())
-	ret, err = countQuery(fq, d.kc, false, idx, head)
-	if d.data.maybeAutoIndex(err) {
+	ret, err = countQuery(fq, d.kc, false, idx, head, plan)
+	if plan.noteMissing(err) && d.data.maybeAutoIndex(err) {
 		idx, head := d.data.getQuerySnaps(!fq.EventuallyConsistent())
-		ret, err = countQuery(fq, d.kc, false, idx, head)
+		ret, err = countQuery(fq, d.kc, false, idx, head, plan)
 	}
+	d.data.recordQuery(plan)
 	return
 }
 
@@ -232,11 +236,19 @@ func (d *txnDsImpl) Run(q *ds.FinalizedQuery, cb ds.RawRunCB) error {
 	// that this would make sense... but at that point you should probably just
 	// add the index up front.
 	cb = d.data.parent.stripSpecialPropsRunCB(cb)
-	return executeQuery(q, d.kc, true, d.data.snap, d.data.snap, cb)
+	plan := d.data.parent.newQueryPlan(d.kc, q, true)
+	err := executeQuery(q, d.kc, true, d.data.snap, d.data.snap, plan, cb)
+	plan.noteMissing(err)
+	d.data.parent.recordQuery(plan)
+	return err
 }
 
 func (d *txnDsImpl) Count(fq *ds.FinalizedQuery) (ret int64, err error) {
-	return countQuery(fq, d.kc, true, d.data.snap, d.data.snap)
+	plan := d.data.parent.newQueryPlan(d.kc, fq, true)
+	ret, err = countQuery(fq, d.kc, true, d.data.snap, d.data.snap, plan)
+	plan.noteMissing(err)
+	d.data.parent.recordQuery(plan)
+	return
 }
 
 func (*txnDsImpl) RunInTransaction(func(c context.Context) error, *ds.TransactionOptions) error {
@@ -72,6 +72,12 @@ type dataStoreData struct {
 	// constraints is the fake datastore constraints. By default, this will match
 	// the Constraints of the "impl/prod" datastore.
 	constraints ds.Constraints
+
+	// journal, if set, persists all mutations. See UseDatastoreWithJournal.
+	journal *Journal
+
+	// queryLog, if set, records all executed queries. See RecordQueries.
+	queryLog *QueryLog
 }
 
 var (
@@ -251,6 +257,14 @@ func rootIDsKey(kind string) []byte {
 	return keyBytes(ds.MkKeyContext("", "").NewKey("__entity_root_ids__", kind, 0, nil))
 }
 
+// idsKey returns the key of the ID counter used to allocate IDs for the key.
+func idsKey(key *ds.Key) []byte {
+	if key.Parent() == nil {
+		return rootIDsKey(key.Kind())
+	}
+	return groupIDsKey(key)
+}
+
 func curVersion(ents memCollection, key []byte) int64 {
 	if ents != nil {
 		if v := ents.Get(key); v != nil {
@@ -309,6 +323,10 @@ func (d *dataStoreData) allocateIDs(keys []*ds.Key, cb ds.NewKeyCB) error {
 			for i, idx := range idxs {
 				keys[idx] = baseKey.WithID("", start+int64(i))
 			}
+			last := baseKey.WithID("", start+int64(len(idxs)-1))
+			if err := d.journalLocked(journalOp{kind: journalAlloc, key: last}); err != nil {
+				return err
+			}
 		}
 		return nil
 	}()
@@ -328,13 +346,7 @@ func (d *dataStoreData) allocateIDsLocked(ents memCollection, incomplete *ds.Key
 		return 0, errors.New("disableSpecialEntities is true so allocateIDs is disabled")
 	}
 
-	idKey := []byte(nil)
-	if incomplete.Parent() == nil {
-		idKey = rootIDsKey(incomplete.Kind())
-	} else {
-		idKey = groupIDsKey(incomplete)
-	}
-	return incrementLocked(ents, idKey, n), nil
+	return incrementLocked(ents, idsKey(incomplete), n), nil
 }
 
 func (d *dataStoreData) fixKeyLocked(ents memCollection, key *ds.Key) (*ds.Key, error) {
@@ -376,6 +388,13 @@ func (d *dataStoreData) putMulti(keys []*ds.Key, vals []ds.PropertyMap, cb ds.Ne
 			if err != nil {
 				return
 			}
+			// Transactions are journaled as a whole when committing, see
+			// journalTxnLocked.
+			if !lockedAlready {
+				if err = d.journalLocked(journalOp{kind: journalPut, key: key, data: newPM}); err != nil {
+					return
+				}
+			}
 			if !d.disableSpecialEntities {
 				incrementLocked(ents, groupMetaKey(key), 1)
 			}
@@ -464,6 +483,11 @@ func (d *dataStoreData) delMulti(keys []*ds.Key, cb ds.DeleteMultiCB, lockedAlre
 					if err != nil {
 						return err
 					}
+					if !lockedAlready {
+						if err := d.journalLocked(journalOp{kind: journalDelete, key: k}); err != nil {
+							return err
+						}
+					}
 					ents.Delete(kb)
 					updateIndexes(d.head, k, oldPM, nil)
 				}
@@ -521,6 +545,7 @@ func (d *dataStoreData) beginCommit(c context.Context, obj memContextObj) txnCom
 	return &txnCommitCallback{
 		unlock: unlock,
 		apply: func() {
+			d.journalTxnLocked(c, txn)
 			for _, muts := range txn.muts {
 				if len(muts) == 0 { // read-only
 					continue
@@ -30,6 +30,9 @@ import (
 type ErrMissingIndex struct {
 	ns      string
 	Missing *ds.IndexDefinition
+
+	// query is the query that needs the index, if known.
+	query *ds.FinalizedQuery
 }
 
 func (e *ErrMissingIndex) Error() string {
@@ -37,6 +40,10 @@ func (e *ErrMissingIndex) Error() string {
 	if err != nil {
 		panic(err)
 	}
+	if e.query != nil {
+		return fmt.Sprintf(
+			"Insufficient indexes for query %s. Consider adding:\n%s", e.query, yaml)
+	}
 	return fmt.Sprintf(
 		"Insufficient indexes. Consider adding:\n%s", yaml)
 }
@@ -80,6 +87,7 @@ type indexDefinitionSortable struct {
 	// (tag=1, tag=2) is a perfectly valid query).
 	eqFilts []ds.IndexColumn
 	coll    memCollection
+	def     *ds.IndexDefinition
 }
 
 func (i *indexDefinitionSortable) hasAncestor() bool {
@@ -226,7 +234,7 @@ func (idxs *indexDefinitionSortableSlice) maybeAddDefinition(q *reducedQuery, s
 			}
 		}
 	}
-	toAdd := indexDefinitionSortable{coll: coll, eqFilts: eqFilts}
+	toAdd := indexDefinitionSortable{coll: coll, eqFilts: eqFilts, def: id}
 	if perfect {
 		*idxs = indexDefinitionSortableSlice{toAdd}
 	} else {
@@ -327,7 +335,7 @@ func getRelevantIndexes(q *reducedQuery, s memStore) (indexDefinitionSortableSli
 			impossible(
 				fmt.Errorf("recommended missing index would be a builtin: %s", remains))
 		}
-		return nil, &ErrMissingIndex{q.kc.Namespace, remains}
+		return nil, &ErrMissingIndex{ns: q.kc.Namespace, Missing: remains}
 	}
 
 	return idxs, nil
@@ -476,7 +484,9 @@ func calculateConstraints(q *reducedQuery) *constraints {
 
 // getIndexes returns a set of iterator definitions. Iterating over these
 // will result in matching suffixes.
-func getIndexes(q *reducedQuery, s memStore) ([]*iterDefinition, error) {
+//
+// The indexes used are recorded in plan, if it is not nil.
+func getIndexes(q *reducedQuery, s memStore, plan *queryPlan) ([]*iterDefinition, error) {
 	relevantIdxs := indexDefinitionSortableSlice(nil)
 	if q.kind == "" {
 		if coll := s.GetCollection("ents:" + q.kc.Namespace); coll != nil {
@@ -552,6 +562,7 @@ func getIndexes(q *reducedQuery, s memStore) ([]*iterDefinition, error) {
 			// should always be able to make progress in this loop.
 			impossible(fmt.Errorf("deadlock: cannot fulfil query?"))
 		}
+		plan.addIndex(bestIdx.def)
 		ret = append(ret, generate(q, bestIdx, constraints))
 	}
 
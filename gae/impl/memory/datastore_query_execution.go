@@ -174,14 +174,14 @@ func parseSuffix(aid, ns string, suffixFormat []ds.IndexColumn, suffix []byte, c
 	return
 }
 
-func countQuery(fq *ds.FinalizedQuery, kc ds.KeyContext, isTxn bool, idx, head memStore) (ret int64, err error) {
+func countQuery(fq *ds.FinalizedQuery, kc ds.KeyContext, isTxn bool, idx, head memStore, plan *queryPlan) (ret int64, err error) {
 	if len(fq.Project()) == 0 && !fq.KeysOnly() {
 		fq, err = fq.Original().KeysOnly(true).Finalize()
 		if err != nil {
 			return
 		}
 	}
-	err = executeQuery(fq, kc, isTxn, idx, head, func(_ *ds.Key, _ ds.PropertyMap, _ ds.CursorCB) error {
+	err = executeQuery(fq, kc, isTxn, idx, head, plan, func(_ *ds.Key, _ ds.PropertyMap, _ ds.CursorCB) error {
 		ret++
 		return nil
 	})
@@ -234,7 +234,10 @@ func executeNamespaceQuery(fq *ds.FinalizedQuery, kc ds.KeyContext, head memStor
 	return nil
 }
 
-func executeQuery(fq *ds.FinalizedQuery, kc ds.KeyContext, isTxn bool, idx, head memStore, cb ds.RawRunCB) error {
+// executeQuery runs the query and calls cb for each result.
+//
+// If plan is not nil, it is populated with the indexes used to run the query.
+func executeQuery(fq *ds.FinalizedQuery, kc ds.KeyContext, isTxn bool, idx, head memStore, plan *queryPlan, cb ds.RawRunCB) error {
 	rq, err := reduce(fq, kc, isTxn)
 	if err == ds.ErrNullQuery {
 		return nil
@@ -247,11 +250,14 @@ func executeQuery(fq *ds.FinalizedQuery, kc ds.KeyContext, isTxn bool, idx, head
 		return executeNamespaceQuery(fq, kc, head, cb)
 	}
 
-	idxs, err := getIndexes(rq, idx)
+	idxs, err := getIndexes(rq, idx, plan)
 	if err == ds.ErrNullQuery {
 		return nil
 	}
 	if err != nil {
+		if mi, ok := err.(*ErrMissingIndex); ok {
+			mi.query = fq
+		}
 		return err
 	}
 
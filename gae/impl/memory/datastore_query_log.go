@@ -0,0 +1,259 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+)
+
+// QueryRecord describes a query executed by the in-memory datastore.
+type QueryRecord struct {
+	// Query is the executed query.
+	Query *ds.FinalizedQuery
+	// Namespace is the namespace the query was executed in.
+	Namespace string
+	// Transactional is true if the query was executed inside a transaction.
+	Transactional bool
+
+	// Indexes are the indexes that served the query, builtin or composite.
+	//
+	// Empty if the query wasn't executed (e.g. because of a missing index) or
+	// didn't need any indexes (e.g. kindless queries).
+	Indexes []*ds.IndexDefinition
+
+	// Missing is the composite index that was missing when the query was
+	// executed, if any.
+	//
+	// If AutoIndex is enabled, this index was added and the query was then
+	// served by it.
+	Missing *ds.IndexDefinition
+}
+
+// Explain returns a human readable description of how the query was executed.
+func (r *QueryRecord) Explain() string {
+	sb := strings.Builder{}
+	sb.WriteString(r.Query.String())
+	if r.Namespace != "" {
+		fmt.Fprintf(&sb, " [namespace %q]", r.Namespace)
+	}
+	if r.Transactional {
+		sb.WriteString(" [transactional]")
+	}
+	sb.WriteString("\n")
+	if r.Missing != nil {
+		fmt.Fprintf(&sb, "  missing index: %s\n", r.Missing)
+	}
+	for _, idx := range r.Indexes {
+		fmt.Fprintf(&sb, "  served by: %s\n", trimKeyColumn(idx))
+	}
+	if r.Missing == nil && len(r.Indexes) == 0 {
+		sb.WriteString("  no indexes used\n")
+	}
+	return sb.String()
+}
+
+// QueryLog records queries executed by the in-memory datastore.
+//
+// It can be used in tests to find out which composite indexes the code under
+// test needs, and to verify they are all declared in index.yaml before they
+// fail in production:
+//
+//	log := memory.RecordQueries(ctx)
+//	... run the code under test ...
+//	existing, err := datastore.FindAndParseIndexYAML(".")
+//	if diff := log.IndexYAMLDiff(existing); diff != "" {
+//	  t.Errorf("Some indexes are missing from index.yaml:\n%s", diff)
+//	}
+//
+// This works best with AutoIndex enabled, so that queries don't fail on
+// missing indexes and all required indexes are recorded in a single run.
+type QueryLog struct {
+	m       sync.Mutex
+	records []QueryRecord
+}
+
+// RecordQueries starts recording all queries executed by the in-memory
+// datastore in the context, including queries in transactions.
+//
+// Replaces the previous QueryLog, if any. Panics if the context doesn't have
+// the in-memory datastore installed.
+func RecordQueries(c context.Context) *QueryLog {
+	memCtx, isTxn := cur(c)
+	var data *dataStoreData
+	if isTxn {
+		data = memCtx.Get(memContextDSIdx).(*txnDataStoreData).parent
+	} else {
+		data = memCtx.Get(memContextDSIdx).(*dataStoreData)
+	}
+	log := &QueryLog{}
+	data.rwlock.Lock()
+	data.queryLog = log
+	data.rwlock.Unlock()
+	return log
+}
+
+// Records returns all recorded queries in the order they were executed.
+func (l *QueryLog) Records() []QueryRecord {
+	l.m.Lock()
+	defer l.m.Unlock()
+	return append([]QueryRecord(nil), l.records...)
+}
+
+// Explain returns a human readable description of how each recorded query was
+// executed.
+func (l *QueryLog) Explain() string {
+	sb := strings.Builder{}
+	for _, r := range l.Records() {
+		sb.WriteString(r.Explain())
+	}
+	return sb.String()
+}
+
+// RequiredIndexes returns all composite indexes required by the recorded
+// queries, sorted and deduplicated.
+//
+// These are the composite indexes that served the queries and the ones that
+// were missing.
+func (l *QueryLog) RequiredIndexes() []*ds.IndexDefinition {
+	var out []*ds.IndexDefinition
+	add := func(idx *ds.IndexDefinition) {
+		if idx == nil || idx.Builtin() {
+			return
+		}
+		idx = trimKeyColumn(idx)
+		for _, seen := range out {
+			if seen.Equal(idx) {
+				return
+			}
+		}
+		out = append(out, idx)
+	}
+	for _, r := range l.Records() {
+		add(r.Missing)
+		for _, idx := range r.Indexes {
+			add(idx)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Less(out[j]) })
+	return out
+}
+
+// IndexYAMLDiff returns index.yaml entries for the required composite indexes
+// that are not among the existing ones.
+//
+// The result can be appended to index.yaml. Returns an empty string if all
+// required indexes exist.
+func (l *QueryLog) IndexYAMLDiff(existing []*ds.IndexDefinition) string {
+	sb := strings.Builder{}
+	for _, idx := range l.RequiredIndexes() {
+		found := false
+		for _, e := range existing {
+			if e.Normalize().Equal(idx.Normalize()) {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		yaml, err := idx.YAMLString()
+		if err != nil {
+			panic(err) // RequiredIndexes returns only composite indexes
+		}
+		sb.WriteString(yaml)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// queryPlan describes how a query was executed, for the QueryLog.
+//
+// All methods are noops on nil queryPlan, which is used when queries are not
+// recorded.
+type queryPlan struct {
+	rec QueryRecord
+}
+
+// newQueryPlan returns a queryPlan if queries are being recorded, nil
+// otherwise.
+func (d *dataStoreData) newQueryPlan(kc ds.KeyContext, fq *ds.FinalizedQuery, isTxn bool) *queryPlan {
+	d.rwlock.RLock()
+	recording := d.queryLog != nil
+	d.rwlock.RUnlock()
+	if !recording {
+		return nil
+	}
+	return &queryPlan{rec: QueryRecord{
+		Query:         fq,
+		Namespace:     kc.Namespace,
+		Transactional: isTxn,
+	}}
+}
+
+// recordQuery adds the query plan to the QueryLog, if any.
+func (d *dataStoreData) recordQuery(p *queryPlan) {
+	if p == nil {
+		return
+	}
+	d.rwlock.RLock()
+	log := d.queryLog
+	d.rwlock.RUnlock()
+	if log != nil {
+		log.m.Lock()
+		log.records = append(log.records, p.rec)
+		log.m.Unlock()
+	}
+}
+
+// addIndex records an index used to serve the query.
+func (p *queryPlan) addIndex(idx *ds.IndexDefinition) {
+	if p == nil || idx == nil {
+		return
+	}
+	for _, seen := range p.rec.Indexes {
+		if seen.Equal(idx) {
+			return
+		}
+	}
+	p.rec.Indexes = append(p.rec.Indexes, idx)
+}
+
+// noteMissing records the missing index if err is ErrMissingIndex.
+//
+// Returns true if it is.
+func (p *queryPlan) noteMissing(err error) bool {
+	mi, ok := err.(*ErrMissingIndex)
+	if ok && p != nil {
+		p.rec.Missing = mi.Missing
+	}
+	return ok
+}
+
+// trimKeyColumn removes the implicit trailing ascending __key__ column from
+// the index definition.
+func trimKeyColumn(idx *ds.IndexDefinition) *ds.IndexDefinition {
+	if l := len(idx.SortBy); l == 0 || idx.SortBy[l-1] != (ds.IndexColumn{Property: "__key__"}) {
+		return idx
+	}
+	ret := *idx
+	ret.SortBy = idx.SortBy[:len(idx.SortBy)-1]
+	return &ret
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestQueryLog(t *testing.T) {
+	t.Parallel()
+
+	type Entity struct {
+		ID  int64 `gae:"$id"`
+		A   string
+		B   int64
+		Tag []string
+	}
+
+	Convey("QueryLog", t, func() {
+		ctx := Use(context.Background())
+		ds.GetTestable(ctx).Consistent(true)
+		So(ds.Put(ctx, &Entity{ID: 1, A: "a", B: 1, Tag: []string{"x", "y"}}), ShouldBeNil)
+
+		log := RecordQueries(ctx)
+
+		Convey("Builtin indexes", func() {
+			So(ds.Run(ctx, ds.NewQuery("Entity").Eq("A", "a"), func(*Entity) {}), ShouldBeNil)
+			So(ds.Run(ctx, ds.NewQuery("Entity").Eq("Tag", "x", "y"), func(*Entity) {}), ShouldBeNil)
+
+			recs := log.Records()
+			So(recs, ShouldHaveLength, 2)
+			So(recs[0].Indexes, ShouldResemble, []*ds.IndexDefinition{
+				{Kind: "Entity", SortBy: []ds.IndexColumn{{Property: "A"}}},
+			})
+			So(recs[0].Missing, ShouldBeNil)
+			So(recs[1].Indexes, ShouldHaveLength, 1)
+			So(log.RequiredIndexes(), ShouldBeEmpty)
+			So(log.IndexYAMLDiff(nil), ShouldEqual, "")
+			So(log.Explain(), ShouldContainSubstring, "served by: B:Entity/A")
+		})
+
+		Convey("Missing composite index", func() {
+			q := ds.NewQuery("Entity").Eq("A", "a").Order("-B")
+			err := ds.Run(ctx, q, func(*Entity) {})
+			So(err, ShouldErrLike, "Insufficient indexes for query SELECT * FROM `Entity`")
+
+			recs := log.Records()
+			So(recs, ShouldHaveLength, 1)
+			So(recs[0].Indexes, ShouldBeEmpty)
+			So(recs[0].Missing.String(), ShouldEqual, "C:Entity/A/-B")
+			So(log.Explain(), ShouldContainSubstring, "missing index: C:Entity/A/-B")
+
+			So(log.IndexYAMLDiff(nil), ShouldEqual, `- kind: Entity
+  properties:
+  - name: A
+  - name: B
+    direction: desc
+`)
+			So(log.IndexYAMLDiff([]*ds.IndexDefinition{recs[0].Missing}), ShouldEqual, "")
+		})
+
+		Convey("AutoIndex", func() {
+			ds.GetTestable(ctx).AutoIndex(true)
+			So(ds.Run(ctx, ds.NewQuery("Entity").Eq("A", "a").Order("B"), func(*Entity) {}), ShouldBeNil)
+			cnt, err := ds.Count(ctx, ds.NewQuery("Entity").Eq("A", "a").Order("B"))
+			So(err, ShouldBeNil)
+			So(cnt, ShouldEqual, 1)
+
+			recs := log.Records()
+			So(recs, ShouldHaveLength, 2)
+			So(recs[0].Missing.String(), ShouldEqual, "C:Entity/A/B")
+			So(recs[0].Indexes, ShouldHaveLength, 1)
+			So(recs[1].Missing, ShouldBeNil)
+			So(recs[1].Indexes, ShouldHaveLength, 1)
+
+			required := log.RequiredIndexes()
+			So(required, ShouldHaveLength, 1)
+			So(required[0].String(), ShouldEqual, "C:Entity/A/B")
+		})
+
+		Convey("Transactions", func() {
+			So(ds.RunInTransaction(ctx, func(ctx context.Context) error {
+				return ds.Run(ctx, ds.NewQuery("Entity").Ancestor(ds.MakeKey(ctx, "Entity", 1)), func(*Entity) {})
+			}, nil), ShouldBeNil)
+			recs := log.Records()
+			So(recs, ShouldHaveLength, 1)
+			So(recs[0].Transactional, ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,384 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"go.chromium.org/luci/common/data/cmpbin"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+)
+
+// Journal persists the state of a datastore installed by
+// UseDatastoreWithJournal in a local file.
+//
+// Every committed mutation is appended to the file as soon as it is applied,
+// so the state survives process restarts (but not necessarily OS crashes,
+// since the file is not fsync'ed). When opened, the journal is replayed and
+// then compacted to contain only the entities that are still alive.
+//
+// This is intended for local development servers: the whole dataset is kept in
+// memory.
+type Journal struct {
+	m    sync.Mutex
+	path string
+	f    *os.File
+	w    *bufio.Writer
+	err  error // sticky write error
+}
+
+// UseDatastoreWithJournal installs an in-memory datastore implementation that
+// persists its state in a journal file at the given path.
+//
+// Unlike Use, it installs only the datastore service and expects the info
+// service to already be in the context (it is used to get the app ID).
+//
+// The datastore is configured to be always consistent and to create indexes
+// automatically, like the dev_appserver does.
+//
+// The file is created if it doesn't exist. The caller is responsible for
+// closing the returned Journal when the datastore is no longer used.
+func UseDatastoreWithJournal(c context.Context, path string) (context.Context, *Journal, error) {
+	if c.Value(&memContextKey) != nil {
+		return nil, nil, errors.New("memory.UseDatastoreWithJournal: called twice on the same Context")
+	}
+
+	memctx := newMemContext(info.FullyQualifiedAppID(c))
+	dsd := memctx.Get(memContextDSIdx).(*dataStoreData)
+	dsd.setAutoIndex(true)
+	dsd.setConsistent(true)
+
+	if err := replayJournal(c, dsd, path); err != nil {
+		return nil, nil, errors.Annotate(err, "failed to replay journal %q", path).Err()
+	}
+	j, err := compactJournal(dsd, path)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "failed to compact journal %q", path).Err()
+	}
+	dsd.rwlock.Lock()
+	dsd.journal = j
+	dsd.rwlock.Unlock()
+
+	c = context.WithValue(c, &memContextKey, memctx)
+	return useRDS(c), j, nil
+}
+
+// Close flushes and closes the journal file.
+//
+// Returns the first error encountered when writing the journal, if any. The
+// datastore must not be used after the journal is closed.
+func (j *Journal) Close() error {
+	j.m.Lock()
+	defer j.m.Unlock()
+	if j.f == nil {
+		return j.err
+	}
+	if err := j.w.Flush(); err != nil && j.err == nil {
+		j.err = err
+	}
+	if err := j.f.Close(); err != nil && j.err == nil {
+		j.err = err
+	}
+	j.f = nil
+	if j.err == nil {
+		j.err = errors.Reason("the journal %q is closed", j.path).Err()
+		return nil
+	}
+	return j.err
+}
+
+// journalOpKind identifies the kind of a journal operation.
+type journalOpKind byte
+
+const (
+	// journalPut stores an entity.
+	journalPut journalOpKind = 'p'
+	// journalDelete deletes an entity.
+	journalDelete journalOpKind = 'd'
+	// journalAlloc marks the key's integer ID and all IDs below it as allocated.
+	journalAlloc journalOpKind = 'a'
+)
+
+// journalOp is a single mutation recorded in the journal.
+type journalOp struct {
+	kind journalOpKind
+	key  *ds.Key
+	data ds.PropertyMap // for journalPut
+}
+
+// append writes a record with the given operations and flushes it.
+//
+// All operations in a record are replayed together.
+func (j *Journal) append(ops ...journalOp) error {
+	j.m.Lock()
+	defer j.m.Unlock()
+	if j.err != nil {
+		return j.err
+	}
+	if j.err = writeJournalRecord(j.w, ops); j.err == nil {
+		j.err = j.w.Flush()
+	}
+	if j.err != nil {
+		j.err = errors.Annotate(j.err, "failed to write journal %q", j.path).Err()
+	}
+	return j.err
+}
+
+func writeJournalRecord(w *bufio.Writer, ops []journalOp) error {
+	buf := bytes.Buffer{}
+	if _, err := cmpbin.WriteUint(&buf, uint64(len(ops))); err != nil {
+		return err
+	}
+	for _, op := range ops {
+		buf.WriteByte(byte(op.kind))
+		if err := ds.SerializeKC.Key(&buf, op.key); err != nil {
+			return err
+		}
+		if op.kind == journalPut {
+			if err := ds.SerializeKC.PropertyMap(&buf, op.data); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := cmpbin.WriteBytes(w, buf.Bytes())
+	return err
+}
+
+func readJournalRecord(r *bufio.Reader) ([]journalOp, error) {
+	blob, _, err := cmpbin.ReadBytes(r)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(blob)
+	count, _, err := cmpbin.ReadUint(buf)
+	if err != nil {
+		return nil, err
+	}
+	ops := make([]journalOp, 0, count)
+	for i := uint64(0); i < count; i++ {
+		kind, err := buf.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		op := journalOp{kind: journalOpKind(kind)}
+		if op.key, err = ds.Deserialize.Key(buf); err != nil {
+			return nil, err
+		}
+		switch op.kind {
+		case journalPut:
+			if op.data, err = ds.Deserialize.PropertyMap(buf); err != nil {
+				return nil, err
+			}
+		case journalDelete, journalAlloc:
+		default:
+			return nil, errors.Reason("unknown journal operation %q", kind).Err()
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// replayJournal applies all records in the journal file to the datastore.
+//
+// A truncated or corrupted record at the end of the file (e.g. if the process
+// died while writing it) is skipped along with everything after it.
+func replayJournal(c context.Context, d *dataStoreData, path string) error {
+	f, err := os.Open(path)
+	switch {
+	case os.IsNotExist(err):
+		return nil
+	case err != nil:
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	records := 0
+	for {
+		if _, err := r.Peek(1); err == io.EOF {
+			break
+		}
+		ops, err := readJournalRecord(r)
+		if err != nil {
+			logging.Warningf(c, "Skipping the rest of the journal %q after %d records: %s", path, records, err)
+			break
+		}
+		for _, op := range ops {
+			if err := d.replayOp(op); err != nil {
+				return errors.Annotate(err, "record #%d", records).Err()
+			}
+		}
+		records++
+	}
+	logging.Infof(c, "Replayed %d records from the journal %q", records, path)
+	return nil
+}
+
+// replayOp applies a journal operation to the datastore, without journaling it.
+func (d *dataStoreData) replayOp(op journalOp) (err error) {
+	switch op.kind {
+	case journalPut:
+		impossible(d.putMulti([]*ds.Key{op.key}, []ds.PropertyMap{op.data},
+			func(_ int, _ *ds.Key, e error) { err = e }, false))
+		if err == nil {
+			d.ensureAllocated(op.key)
+		}
+	case journalDelete:
+		impossible(d.delMulti([]*ds.Key{op.key}, func(_ int, e error) { err = e }, false))
+	case journalAlloc:
+		d.ensureAllocated(op.key)
+	}
+	return
+}
+
+// ensureAllocated makes sure the key's integer ID is never allocated again.
+func (d *dataStoreData) ensureAllocated(key *ds.Key) {
+	id := key.IntID()
+	if id == 0 {
+		return
+	}
+	d.rwlock.Lock()
+	defer d.rwlock.Unlock()
+	ents := d.head.GetOrCreateCollection("ents:" + key.Namespace())
+	idKey := idsKey(key)
+	if cur := curVersion(ents, idKey); cur < id {
+		incrementLocked(ents, idKey, int(id-cur))
+	}
+}
+
+// compactJournal rewrites the journal file to contain only the current state of
+// the datastore and opens it for appending.
+func compactJournal(d *dataStoreData, path string) (j *Journal, err error) {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			f.Close()
+			os.Remove(tmp)
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	d.rwlock.RLock()
+	err = dumpJournal(d.head, func(op journalOp) error {
+		return writeJournalRecord(w, []journalOp{op})
+	})
+	d.rwlock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if err = w.Flush(); err != nil {
+		return nil, err
+	}
+	if err = f.Sync(); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return nil, err
+	}
+	return &Journal{path: path, f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// dumpJournal calls the callback with operations that reproduce the store.
+func dumpJournal(store memStore, cb func(journalOp) error) error {
+	for _, ns := range namespaces(store) {
+		ents := store.GetCollection("ents:" + ns)
+		if ents == nil {
+			continue
+		}
+		kc := ds.KeyContext{Namespace: ns}
+		var err error
+		ents.ForEachItem(func(k, v []byte) bool {
+			var prop ds.Property
+			if prop, err = (ds.Deserializer{KeyContext: kc}).Property(bytes.NewBuffer(k)); err != nil {
+				return false
+			}
+			key, ok := prop.Value().(*ds.Key)
+			if !ok {
+				err = errors.Reason("unexpected entity key type %s", prop.Type()).Err()
+				return false
+			}
+			var op journalOp
+			switch key.Kind() {
+			case "__entity_group__":
+				return true // only matters for transactions in flight
+			case "__entity_root_ids__":
+				op = journalOp{kind: journalAlloc, key: kc.NewKey(key.StringID(), "", curVersion(ents, k), nil)}
+			case "__entity_group_ids__":
+				op = journalOp{kind: journalAlloc, key: kc.NewKey(key.Kind(), "", curVersion(ents, k), key.Parent())}
+			default:
+				op = journalOp{kind: journalPut, key: key}
+				if op.data, err = readPropMap(v); err != nil {
+					return false
+				}
+				stripSpecialProps(op.data)
+			}
+			err = cb(op)
+			return err == nil
+		})
+		if err != nil {
+			return errors.Annotate(err, "namespace %q", ns).Err()
+		}
+	}
+	return nil
+}
+
+// journalLocked records operations in the journal, if any.
+//
+// Must be called under the writer lock.
+func (d *dataStoreData) journalLocked(ops ...journalOp) error {
+	if d.journal == nil {
+		return nil
+	}
+	return d.journal.append(ops...)
+}
+
+// journalTxnLocked records all mutations of a transaction as a single record.
+//
+// Must be called under the writer lock.
+func (d *dataStoreData) journalTxnLocked(c context.Context, txn *txnDataStoreData) {
+	if d.journal == nil {
+		return
+	}
+	var ops []journalOp
+	for _, muts := range txn.muts {
+		for _, m := range muts {
+			if m.data == nil {
+				ops = append(ops, journalOp{kind: journalDelete, key: m.key})
+			} else {
+				ops = append(ops, journalOp{kind: journalPut, key: m.key, data: m.data})
+			}
+		}
+	}
+	if len(ops) == 0 {
+		return
+	}
+	// The commit can't be aborted at this point. The error is sticky and will be
+	// reported by all following mutations and by Journal.Close.
+	if err := d.journal.append(ops...); err != nil {
+		logging.Errorf(c, "Transaction is not persisted: %s", err)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestJournal(t *testing.T) {
+	t.Parallel()
+
+	type Entity struct {
+		ID     int64   `gae:"$id"`
+		Parent *ds.Key `gae:"$parent"`
+		Value  string
+	}
+
+	Convey("Datastore with journal", t, func() {
+		base := useGI(useGID(context.Background(), func(mod *globalInfoData) {
+			mod.appID = "app"
+			mod.fqAppID = "dev~app"
+		}))
+		path := filepath.Join(t.TempDir(), "journal")
+
+		open := func() (context.Context, *Journal) {
+			c, j, err := UseDatastoreWithJournal(base, path)
+			So(err, ShouldBeNil)
+			return c, j
+		}
+
+		c, j := open()
+
+		So(ds.Put(c, &Entity{ID: 1, Value: "a"}, &Entity{ID: 2, Value: "b"}), ShouldBeNil)
+		So(ds.Delete(c, &Entity{ID: 2}), ShouldBeNil)
+
+		nsCtx := info.MustNamespace(c, "ns")
+		So(ds.Put(nsCtx, &Entity{ID: 1, Value: "ns"}), ShouldBeNil)
+
+		parent := ds.MakeKey(c, "Parent", "p")
+		So(ds.RunInTransaction(c, func(c context.Context) error {
+			return ds.Put(c, &Entity{Parent: parent, Value: "child"})
+		}, nil), ShouldBeNil)
+
+		auto := &Entity{Value: "auto"}
+		So(ds.Put(c, auto), ShouldBeNil)
+
+		So(j.Close(), ShouldBeNil)
+
+		Convey("State survives reopening", func() {
+			c, j := open()
+			defer j.Close()
+
+			So(ds.Get(c, &Entity{ID: 1}), ShouldBeNil)
+			So(ds.Get(c, &Entity{ID: 2}), ShouldEqual, ds.ErrNoSuchEntity)
+
+			ent := &Entity{ID: 1}
+			So(ds.Get(info.MustNamespace(c, "ns"), ent), ShouldBeNil)
+			So(ent.Value, ShouldEqual, "ns")
+
+			var children []*Entity
+			So(ds.GetAll(c, ds.NewQuery("Entity").Ancestor(parent), &children), ShouldBeNil)
+			So(children, ShouldHaveLength, 1)
+			So(children[0].Value, ShouldEqual, "child")
+
+			// Previously allocated IDs are not reused.
+			another := &Entity{Value: "another"}
+			So(ds.Put(c, another), ShouldBeNil)
+			So(another.ID, ShouldBeGreaterThan, auto.ID)
+
+			var all []*Entity
+			So(ds.GetAll(c, ds.NewQuery("Entity").Eq("Value", "auto"), &all), ShouldBeNil)
+			So(all, ShouldResemble, []*Entity{auto})
+		})
+
+		Convey("Truncated tail is skipped", func() {
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+			So(err, ShouldBeNil)
+			_, err = f.Write([]byte{0x80, 0x01})
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+
+			c, j := open()
+			defer j.Close()
+			So(ds.Get(c, &Entity{ID: 1}), ShouldBeNil)
+			So(ds.Put(c, &Entity{ID: 3}), ShouldBeNil)
+		})
+
+		Convey("Closed journal rejects writes", func() {
+			c, j := open()
+			So(j.Close(), ShouldBeNil)
+			So(ds.Put(c, &Entity{ID: 3}), ShouldErrLike, "is closed")
+		})
+	})
+}
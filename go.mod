@@ -9,6 +9,7 @@ require (
 	cloud.google.com/go/compute v1.10.0
 	cloud.google.com/go/datastore v1.8.0
 	cloud.google.com/go/errorreporting v0.2.0
+	cloud.google.com/go/firestore v1.7.0
 	cloud.google.com/go/iam v0.5.0
 	cloud.google.com/go/kms v1.4.0
 	cloud.google.com/go/logging v1.5.0
@@ -53,6 +54,7 @@ require (
 	github.com/smartystreets/goconvey v1.7.2
 	github.com/yosuke-furukawa/json5 v0.1.1
 	go.opencensus.io v0.23.0
+	go.opentelemetry.io/proto/otlp v0.19.0
 	go.starlark.net v0.0.0-20221010140840-6bf6f0955179
 	golang.org/x/crypto v0.0.0-20220518034528-6f7dac969898
 	golang.org/x/net v0.0.0-20221012135044-0b7e1fb9d458
@@ -91,6 +93,7 @@ require (
 	github.com/google/pprof v0.0.0-20220520215854-d04f2422c8a1 // indirect
 	github.com/googleapis/enterprise-certificate-proxy v0.2.0 // indirect
 	github.com/gopherjs/gopherjs v1.17.2 // indirect
+	github.com/grpc-ecosystem/grpc-gateway/v2 v2.7.0 // indirect
 	github.com/iancoleman/strcase v0.2.0 // indirect
 	github.com/jmespath/go-jmespath v0.4.0 // indirect
 	github.com/jtolds/gls v4.20.0+incompatible // indirect
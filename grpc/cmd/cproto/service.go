@@ -74,7 +74,7 @@ func getServices(file *ast.File) ([]*service, error) {
 					}
 
 					iface, ok := st.Type.(*ast.InterfaceType)
-					if !ok {
+					if !ok || isStreamIface(iface) {
 						continue
 					}
 
@@ -169,6 +169,26 @@ func (s *service) complete() error {
 	return nil
 }
 
+// isStreamIface returns true if the interface embeds grpc.ClientStream.
+//
+// Such interfaces (e.g. "<Service>_<Method>Client") are generated for streaming
+// methods and are not service clients.
+func isStreamIface(iface *ast.InterfaceType) bool {
+	if iface.Methods == nil {
+		return false
+	}
+	for _, m := range iface.Methods.List {
+		sel, ok := m.Type.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "ClientStream" {
+			continue
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "grpc" {
+			return true
+		}
+	}
+	return false
+}
+
 // trimPhrase removes the specified prefix and suffix strings from the supplied
 // v. If either prefix is missing, suffix is missing, or v consists entirely of
 // prefix and suffix, the empty string is returned.
@@ -150,6 +150,11 @@ func New{{.Service}}PRPCClient(client *{{.PRPCSymbolPrefix}}Client) {{.Service}}
 }
 
 {{range .Methods}}
+{{if .Streaming}}
+func (c *{{$.StructName}}) {{.Name}}({{.Params}}) ({{.Results}}) {
+	return New{{$.Service}}Client(c.client).{{.Name}}({{.Args}})
+}
+{{else}}
 func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}}, opts ...grpc.CallOption) (*{{.OutputMessage}}, error) {
 	out := new({{.OutputMessage}})
 	err := c.client.Call(ctx, "{{$.ProtoPkg}}.{{$.Service}}", "{{.Name}}", in, out, opts...)
@@ -159,6 +164,7 @@ func (c *{{$.StructName}}) {{.Name}}(ctx context.Context, in *{{.InputMessage}},
 	return out, nil
 }
 {{end}}
+{{end}}
 `))
 
 // generateClient generates pRPC implementation of a client interface.
@@ -171,6 +177,13 @@ func (t *transformer) generateClient(protoPackage, serviceName string, iface *as
 		Name          string
 		InputMessage  string
 		OutputMessage string
+
+		// Streaming methods are implemented through the gRPC client, since
+		// prpc.Client implements grpc.ClientConnInterface.
+		Streaming bool
+		Params    string // e.g. "ctx context.Context, in *Req, opts ...grpc.CallOption"
+		Results   string // e.g. "Service_MethodClient, error"
+		Args      string // e.g. "ctx, in, opts..."
 	}
 	methods := make([]Method, 0, len(iface.Methods.List))
 
@@ -190,6 +203,37 @@ func (t *transformer) generateClient(protoPackage, serviceName string, iface *as
 			return nil, fmt.Errorf("unexpected embedded interface in %sClient", serviceName)
 		}
 
+		if _, unary := signature.Results.List[0].Type.(*ast.StarExpr); !unary {
+			method := Method{Name: m.Names[0].Name, Streaming: true}
+			var params, results, args []string
+			for _, p := range signature.Params.List {
+				typ, err := toGoCode(p.Type)
+				if err != nil {
+					return nil, err
+				}
+				for _, n := range p.Names {
+					params = append(params, n.Name+" "+typ)
+					if _, variadic := p.Type.(*ast.Ellipsis); variadic {
+						args = append(args, n.Name+"...")
+					} else {
+						args = append(args, n.Name)
+					}
+				}
+			}
+			for _, r := range signature.Results.List {
+				typ, err := toGoCode(r.Type)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, typ)
+			}
+			method.Params = strings.Join(params, ", ")
+			method.Results = strings.Join(results, ", ")
+			method.Args = strings.Join(args, ", ")
+			methods = append(methods, method)
+			continue
+		}
+
 		inStructPtr := signature.Params.List[1].Type.(*ast.StarExpr)
 		inStruct, err := toGoCode(inStructPtr.X)
 		if err != nil {
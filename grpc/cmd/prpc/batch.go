@@ -0,0 +1,138 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/sync/parallel"
+	"go.chromium.org/luci/grpc/prpc"
+)
+
+// batchResult is a result of one call in batch mode, printed as a line of
+// output.
+type batchResult struct {
+	Index    int             `json:"index"`
+	Status   batchStatus     `json:"status"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// batchStatus is a status of a call, similar to JSONPB of google.rpc.Status.
+type batchStatus struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message,omitempty"`
+}
+
+// callBatch reads newline-delimited JSONPB requests from req.message, calls the
+// method with each of them and writes newline-delimited results to out, in the
+// order of requests.
+//
+// Empty lines are skipped. Returns an error if any of the calls failed.
+func callBatch(c context.Context, client *prpc.Client, req *request, concurrency int, out io.Writer) error {
+	results := make(chan *batchResult)
+	written := make(chan error, 1)
+	failed := 0
+	total := 0
+	go func() {
+		// Results arrive in the order of completion, buffer them until all
+		// preceding results are written.
+		pending := map[int]*batchResult{}
+		next := 0
+		var err error
+		for res := range results {
+			pending[res.Index] = res
+			for res := pending[next]; res != nil; res = pending[next] {
+				delete(pending, next)
+				next++
+				if res.Status.Code != codes.OK {
+					failed++
+				}
+				if err == nil {
+					err = writeBatchResult(out, res)
+				}
+			}
+		}
+		written <- err
+	}()
+
+	var readErr error
+	err := parallel.WorkPool(concurrency, func(work chan<- func() error) {
+		in := bufio.NewReader(req.message)
+		for {
+			line, err := in.ReadBytes('\n')
+			if err != nil && err != io.EOF {
+				readErr = fmt.Errorf("failed to read requests: %s", err)
+				return
+			}
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				index := total
+				total++
+				work <- func() error {
+					results <- callOne(c, client, req, index, line)
+					return nil
+				}
+			}
+			if err == io.EOF {
+				return
+			}
+		}
+	})
+	close(results)
+	werr := <-written
+
+	switch {
+	case err != nil:
+		return err
+	case readErr != nil:
+		return readErr
+	case werr != nil:
+		return fmt.Errorf("failed to write response: %s", werr)
+	case failed > 0:
+		return fmt.Errorf("%d of %d calls failed", failed, total)
+	}
+	return nil
+}
+
+// callOne makes one call of a batch.
+func callOne(c context.Context, client *prpc.Client, req *request, index int, message []byte) *batchResult {
+	res := &batchResult{Index: index}
+	out, err := client.CallWithFormats(c, req.service, req.method, message, prpc.FormatJSONPB, prpc.FormatJSONPB)
+	if err != nil {
+		st := status.Convert(err)
+		res.Status = batchStatus{Code: st.Code(), Message: st.Message()}
+		return res
+	}
+	res.Response = out
+	return res
+}
+
+// writeBatchResult writes a result as one line of JSON.
+func writeBatchResult(out io.Writer, res *batchResult) error {
+	// json.Marshal compacts the response.
+	blob, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "%s\n", blob)
+	return err
+}
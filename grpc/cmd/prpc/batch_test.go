@@ -0,0 +1,101 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+
+	"go.chromium.org/luci/common/testing/prpctest"
+	"go.chromium.org/luci/grpc/discovery"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	Convey("Batch", t, func() {
+		ctx := context.Background()
+		ts := prpctest.Server{}
+		discovery.Enable(&ts.Server)
+		ts.Start(ctx)
+		defer ts.Close()
+
+		client, err := ts.NewClient()
+		So(err, ShouldBeNil)
+
+		run := func(input string, concurrency int) ([]*batchResult, error) {
+			out := &bytes.Buffer{}
+			req := &request{
+				service: "discovery.Discovery",
+				method:  "Describe",
+				message: strings.NewReader(input),
+				format:  formatFlagJSONPB,
+			}
+			err := callBatch(ctx, client, req, concurrency, out)
+
+			var results []*batchResult
+			for _, line := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+				if line == "" {
+					continue
+				}
+				res := &batchResult{}
+				So(json.Unmarshal([]byte(line), res), ShouldBeNil)
+				results = append(results, res)
+			}
+			return results, err
+		}
+
+		Convey("Works", func() {
+			results, err := run("{}\n\n{}", 1)
+			So(err, ShouldBeNil)
+			So(results, ShouldHaveLength, 2)
+			for i, res := range results {
+				So(res.Index, ShouldEqual, i)
+				So(res.Status.Code, ShouldEqual, codes.OK)
+
+				rsp := &discovery.DescribeResponse{}
+				So(json.Unmarshal(res.Response, rsp), ShouldBeNil)
+				So(rsp.Services, ShouldContain, "discovery.Discovery")
+			}
+		})
+
+		Convey("Preserves order", func() {
+			results, err := run(strings.Repeat("{}\n", 20), 5)
+			So(err, ShouldBeNil)
+			So(results, ShouldHaveLength, 20)
+			for i, res := range results {
+				So(res.Index, ShouldEqual, i)
+			}
+		})
+
+		Convey("Errors", func() {
+			results, err := run("{}\n{bad\n", 2)
+			So(err, ShouldErrLike, "1 of 2 calls failed")
+			So(results, ShouldHaveLength, 2)
+			So(results[0].Status.Code, ShouldEqual, codes.OK)
+			So(results[1].Status.Code, ShouldEqual, codes.InvalidArgument)
+			So(results[1].Status.Message, ShouldNotBeEmpty)
+			So(results[1].Response, ShouldBeNil)
+		})
+	})
+}
@@ -17,6 +17,7 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"os"
@@ -51,7 +52,15 @@ func cmdCall(defaultAuthOpts auth.Options) *subcommands.Command {
 		UsageLine: cmdCallUsage,
 		ShortDesc: cmdCallDesc,
 		LongDesc: `Calls a service method.
-The input message is read from stdin (defaulting to JSONPB)`,
+The input message is read from stdin (defaulting to JSONPB).
+Server-streaming methods must be called with -stream flag.
+
+With -batch flag, stdin is read as newline-delimited JSONPB request messages
+and the method is called once per line. Results are printed in the order of
+requests as newline-delimited JSON objects:
+  {"index": 0, "status": {"code": 0}, "response": {...}}
+  {"index": 1, "status": {"code": 5, "message": "not found"}}
+A skeleton request message can be generated with "template" subcommand.`,
 		CommandRun: func() subcommands.CommandRun {
 			c := &callRun{
 				format:   formatFlagJSONPB,
@@ -63,6 +72,10 @@ The input message is read from stdin (defaulting to JSONPB)`,
 				formatFlagMap.Choices()))
 
 			c.Flags.Var(flag.GRPCMetadata(c.metadata), "metadata", "a key:value pair of request header metadata; may be specified multiple times")
+			c.Flags.BoolVar(&c.stream, "stream", false, `Call a server-streaming method. Responses are printed as they arrive, `+
+				`JSON and text messages are separated by new lines, binary messages are prefixed with their varint length.`)
+			c.Flags.BoolVar(&c.batch, "batch", false, "Call the method once per each line of stdin. Requires json format.")
+			c.Flags.IntVar(&c.concurrency, "concurrency", 1, "Maximum number of concurrent calls in -batch mode.")
 			return c
 		},
 	}
@@ -71,8 +84,11 @@ The input message is read from stdin (defaulting to JSONPB)`,
 // callRun implements "call" subcommand.
 type callRun struct {
 	cmdRun
-	format   formatFlag
-	metadata metadata.MD
+	format      formatFlag
+	metadata    metadata.MD
+	stream      bool
+	batch       bool
+	concurrency int
 }
 
 func (r *callRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -82,10 +98,22 @@ func (r *callRun) Run(a subcommands.Application, args []string, env subcommands.
 	host, target := args[0], args[1]
 	args = args[2:]
 
+	if r.batch {
+		switch {
+		case r.stream:
+			return r.argErr(cmdCallDesc, cmdCallUsage, "-batch and -stream are mutually exclusive")
+		case r.format != formatFlagJSONPB:
+			return r.argErr(cmdCallDesc, cmdCallUsage, "-batch requires json format")
+		case r.concurrency < 1:
+			return r.argErr(cmdCallDesc, cmdCallUsage, "-concurrency must be positive")
+		}
+	}
+
 	req := request{
 		format:       r.format,
 		message:      os.Stdin,
 		messageFlags: args,
+		stream:       r.stream,
 	}
 
 	var err error
@@ -103,6 +131,10 @@ func (r *callRun) Run(a subcommands.Application, args []string, env subcommands.
 	// Insert outoging metadata.
 	ctx = metadata.NewOutgoingContext(ctx, r.metadata)
 
+	if r.batch {
+		return r.done(callBatch(ctx, client, &req, r.concurrency, os.Stdout))
+	}
+
 	hmd, err := call(ctx, client, &req, os.Stdout)
 	if err != nil {
 		return r.done(err)
@@ -132,6 +164,7 @@ type request struct {
 	message      io.Reader
 	messageFlags []string
 	format       formatFlag
+	stream       bool
 }
 
 // call makes an RPC and writes response to out.
@@ -150,6 +183,10 @@ func call(c context.Context, client *prpc.Client, req *request, out io.Writer) (
 		outf = inf
 	}
 
+	if req.stream {
+		return callStream(c, client, req, message, inf, outf, out)
+	}
+
 	// Send the request.
 	res, err := client.CallWithFormats(c, req.service, req.method, message, inf, outf, grpc.Header(&hmd))
 	if err != nil {
@@ -164,6 +201,38 @@ func call(c context.Context, client *prpc.Client, req *request, out io.Writer) (
 	return hmd, nil
 }
 
+// callStream makes a server-streaming RPC and writes responses to out as they
+// arrive.
+func callStream(c context.Context, client *prpc.Client, req *request, message []byte, inf, outf prpc.Format, out io.Writer) (hmd metadata.MD, err error) {
+	stream, err := client.StreamWithFormats(c, req.service, req.method, message, inf, outf)
+	if err != nil {
+		return nil, &exitCode{err, int(status.Code(err))}
+	}
+	for {
+		res, err := stream.Recv()
+		switch {
+		case err == io.EOF:
+			return stream.Header(), nil
+		case err != nil:
+			return nil, &exitCode{err, int(status.Code(err))}
+		}
+
+		if outf == prpc.FormatBinary {
+			var prefix [binary.MaxVarintLen64]byte
+			_, err = out.Write(prefix[:binary.PutUvarint(prefix[:], uint64(len(res)))])
+		}
+		if err == nil {
+			_, err = out.Write(res)
+		}
+		if err == nil && outf != prpc.FormatBinary {
+			_, err = io.WriteString(out, "\n")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to write response: %s", err)
+		}
+	}
+}
+
 func printMetadata(w io.Writer, prefix string, md metadata.MD) {
 	keys := make([]string, 0, len(md))
 	for k := range md {
@@ -18,6 +18,9 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	"go.chromium.org/luci/grpc/discovery"
 	"go.chromium.org/luci/grpc/prpc"
 )
@@ -35,3 +38,21 @@ func loadDescription(c context.Context, client *prpc.Client) (*serverDescription
 
 	return &serverDescription{res}, nil
 }
+
+// method returns a descriptor of a method.
+func (d *serverDescription) method(service, method string) (protoreflect.MethodDescriptor, error) {
+	files, err := protodesc.NewFiles(d.Description)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse server description: %s", err)
+	}
+	name := protoreflect.FullName(service + "." + method)
+	desc, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("method %q is not found", name)
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a method", name)
+	}
+	return md, nil
+}
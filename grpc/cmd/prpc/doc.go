@@ -25,6 +25,31 @@
 //          "message": "Hello Lucy"
 //  }
 //
+// Server-streaming methods are called with -stream flag. Responses are printed
+// as they arrive, one per line.
+//
+//  $ echo '{"name": "Lucy"}' | prpc call -stream :8080 helloworld.Greeter.SayHelloStream
+//  {"message":"Hello Lucy"}
+//  {"message":"Hello again Lucy"}
+//
+// With -batch flag, each line of stdin is a separate request. The method is
+// called once per line (-concurrency calls at a time) and the results are
+// printed as newline-delimited JSON, in the order of requests.
+//
+//  $ printf '{"name": "Lucy"}\n{"name": ""}\n' | prpc call -batch :8080 helloworld.Greeter.SayHello
+//  {"index":0,"status":{"code":0},"response":{"message":"Hello Lucy"}}
+//  {"index":1,"status":{"code":3,"message":"name is required"}}
+//
+// Subcommand template
+//
+// template subcommand prints a skeleton request message of a method in JSONPB
+// format, generated from the server description.
+//
+//  $ prpc template :8080 helloworld.Greeter.SayHello
+//  {
+//    "name": ""
+//  }
+//
 // Subcommand show
 //
 // show subcommand resolves a name and describes the referenced entity
@@ -131,6 +131,7 @@ func getApplication(defaultAuthOpts auth.Options) *cli.Application {
 		Commands: []*subcommands.Command{
 			cmdCall(defaultAuthOpts),
 			cmdShow(defaultAuthOpts),
+			cmdTemplate(defaultAuthOpts),
 
 			{ /* spacer */ },
 
@@ -0,0 +1,260 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/maruel/subcommands"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/grpc/prpc"
+)
+
+const (
+	cmdTemplateUsage = `template [flags] <server> <service>.<method>
+
+  server: host ("example.com") or port for localhost (":8080").
+  service: full name of a service, e.g. "pkg.service"
+  method: name of the method.
+`
+
+	cmdTemplateDesc = "prints a skeleton request message of a method."
+)
+
+func cmdTemplate(defaultAuthOpts auth.Options) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: cmdTemplateUsage,
+		ShortDesc: cmdTemplateDesc,
+		LongDesc: `Prints a skeleton request message of a method in JSONPB format.
+
+The skeleton has all fields set to placeholder values: repeated fields and maps
+have one element, only the first field of each oneof is set and recursive
+messages are cut off at the first repetition. It is loaded from the server
+description, the server must have the discovery service enabled.
+
+The output can be edited and piped to "call", e.g.
+  prpc template -compact :8080 helloworld.Greeter.SayHello | prpc call :8080 helloworld.Greeter.SayHello`,
+		CommandRun: func() subcommands.CommandRun {
+			c := &templateRun{}
+			c.registerBaseFlags(defaultAuthOpts)
+			c.Flags.BoolVar(&c.compact, "compact", false, "Print the message in one line, e.g. to use it in -batch mode of call.")
+			return c
+		},
+	}
+}
+
+// templateRun implements "template" subcommand.
+type templateRun struct {
+	cmdRun
+	compact bool
+}
+
+func (r *templateRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if len(args) != 2 {
+		return r.argErr(cmdTemplateDesc, cmdTemplateUsage, "")
+	}
+	host, target := args[0], args[1]
+
+	service, method, err := splitServiceAndMethod(target)
+	if err != nil {
+		return r.argErr(cmdTemplateDesc, cmdTemplateUsage, "%s", err)
+	}
+
+	ctx := cli.GetContext(a, r, env)
+	client, err := r.authenticatedClient(ctx, host)
+	if err != nil {
+		return ecAuthenticatedClientError
+	}
+	return r.done(printTemplate(ctx, client, service, method, r.compact, os.Stdout))
+}
+
+// printTemplate prints a skeleton request message of a method to out.
+func printTemplate(c context.Context, client *prpc.Client, service, method string, compact bool, out io.Writer) error {
+	desc, err := loadDescription(c, client)
+	if err != nil {
+		return err
+	}
+	md, err := desc.method(service, method)
+	if err != nil {
+		return err
+	}
+
+	var blob []byte
+	if compact {
+		blob, err = json.Marshal(messageTemplate(md.Input()))
+	} else {
+		blob, err = json.MarshalIndent(messageTemplate(md.Input()), "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "%s\n", blob)
+	return err
+}
+
+// messageTemplate returns a JSONPB skeleton of a message as a value that can be
+// marshaled with encoding/json.
+func messageTemplate(md protoreflect.MessageDescriptor) interface{} {
+	g := templateGen{visiting: map[protoreflect.FullName]bool{}}
+	return g.message(md)
+}
+
+// jsonField is a field of jsonObject.
+type jsonField struct {
+	Name  string
+	Value interface{}
+}
+
+// jsonObject is a JSON object that preserves the order of its fields.
+type jsonObject []jsonField
+
+// MarshalJSON implements json.Marshaler.
+func (o jsonObject) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, f := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// templateGen generates message skeletons.
+type templateGen struct {
+	// visiting is a set of messages being generated, used to cut off recursion.
+	visiting map[protoreflect.FullName]bool
+}
+
+func (g *templateGen) message(md protoreflect.MessageDescriptor) interface{} {
+	if v, ok := g.wellKnown(md); ok {
+		return v
+	}
+	if g.visiting[md.FullName()] {
+		return jsonObject{}
+	}
+	g.visiting[md.FullName()] = true
+	defer delete(g.visiting, md.FullName())
+
+	obj := jsonObject{}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && oneof.Fields().Get(0) != fd {
+			continue
+		}
+
+		var v interface{}
+		switch {
+		case fd.IsMap():
+			v = jsonObject{{Name: mapKeyTemplate(fd.MapKey()), Value: g.singular(fd.MapValue())}}
+		case fd.IsList():
+			v = []interface{}{g.singular(fd)}
+		default:
+			v = g.singular(fd)
+		}
+		obj = append(obj, jsonField{Name: fd.JSONName(), Value: v})
+	}
+	return obj
+}
+
+// singular returns a placeholder for a single value of the field.
+func (g *templateGen) singular(fd protoreflect.FieldDescriptor) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return false
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.FloatKind, protoreflect.DoubleKind:
+		return 0
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// JSONPB represents 64-bit integers as strings.
+		return "0"
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return ""
+	case protoreflect.EnumKind:
+		if fd.Enum().FullName() == "google.protobuf.NullValue" {
+			return nil
+		}
+		return string(fd.Enum().Values().Get(0).Name())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return g.message(fd.Message())
+	default:
+		panic(fmt.Errorf("impossible: unknown field kind %s", fd.Kind()))
+	}
+}
+
+// wellKnown returns a placeholder for well-known types that have a special
+// JSONPB representation.
+func (g *templateGen) wellKnown(md protoreflect.MessageDescriptor) (interface{}, bool) {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		return "1970-01-01T00:00:00Z", true
+	case "google.protobuf.Duration":
+		return "0s", true
+	case "google.protobuf.FieldMask":
+		return "", true
+	case "google.protobuf.Struct", "google.protobuf.Empty":
+		return jsonObject{}, true
+	case "google.protobuf.ListValue":
+		return []interface{}{}, true
+	case "google.protobuf.Value":
+		return nil, true
+	case "google.protobuf.Any":
+		return jsonObject{{Name: "@type", Value: ""}}, true
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue",
+		"google.protobuf.Int64Value", "google.protobuf.UInt64Value",
+		"google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue",
+		"google.protobuf.BytesValue":
+		return g.singular(md.Fields().ByName("value")), true
+	default:
+		return nil, false
+	}
+}
+
+// mapKeyTemplate returns a placeholder for a map key. Map keys are always
+// strings in JSONPB.
+func mapKeyTemplate(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "false"
+	case protoreflect.StringKind:
+		return ""
+	default:
+		return "0"
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const testFile = `
+name: "test.proto"
+package: "test"
+dependency: "google/protobuf/duration.proto"
+dependency: "google/protobuf/wrappers.proto"
+message_type {
+  name: "Req"
+  field { name: "name" number: 1 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "name" }
+  field { name: "count" number: 2 label: LABEL_OPTIONAL type: TYPE_INT64 json_name: "count" }
+  field { name: "tags" number: 3 label: LABEL_REPEATED type: TYPE_STRING json_name: "tags" }
+  field { name: "kind" number: 4 label: LABEL_OPTIONAL type: TYPE_ENUM type_name: ".test.Kind" json_name: "kind" }
+  field { name: "child" number: 5 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".test.Req" json_name: "child" }
+  field { name: "labels" number: 6 label: LABEL_REPEATED type: TYPE_MESSAGE type_name: ".test.Req.LabelsEntry" json_name: "labels" }
+  field { name: "timeout" number: 7 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".google.protobuf.Duration" json_name: "timeout" }
+  field { name: "limit" number: 8 label: LABEL_OPTIONAL type: TYPE_MESSAGE type_name: ".google.protobuf.Int32Value" json_name: "limit" }
+  field { name: "a" number: 9 label: LABEL_OPTIONAL type: TYPE_BOOL json_name: "a" oneof_index: 0 }
+  field { name: "b" number: 10 label: LABEL_OPTIONAL type: TYPE_BOOL json_name: "b" oneof_index: 0 }
+  nested_type {
+    name: "LabelsEntry"
+    field { name: "key" number: 1 label: LABEL_OPTIONAL type: TYPE_INT32 json_name: "key" }
+    field { name: "value" number: 2 label: LABEL_OPTIONAL type: TYPE_STRING json_name: "value" }
+    options { map_entry: true }
+  }
+  oneof_decl { name: "choice" }
+}
+enum_type {
+  name: "Kind"
+  value { name: "KIND_UNSPECIFIED" number: 0 }
+  value { name: "KIND_A" number: 1 }
+}
+syntax: "proto3"
+`
+
+func TestMessageTemplate(t *testing.T) {
+	t.Parallel()
+
+	Convey("messageTemplate", t, func() {
+		fdp := &descriptorpb.FileDescriptorProto{}
+		So(prototext.Unmarshal([]byte(testFile), fdp), ShouldBeNil)
+		fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+		So(err, ShouldBeNil)
+
+		blob, err := json.Marshal(messageTemplate(fd.Messages().ByName("Req")))
+		So(err, ShouldBeNil)
+		So(string(blob), ShouldEqual, `{`+
+			`"name":"",`+
+			`"count":"0",`+
+			`"tags":[""],`+
+			`"kind":"KIND_UNSPECIFIED",`+
+			`"child":{},`+
+			`"labels":{"0":""},`+
+			`"timeout":"0s",`+
+			`"limit":0,`+
+			`"a":false`+
+			`}`)
+	})
+}
@@ -46,13 +46,44 @@ type {{$StructName}} struct {
 	Prelude func(ctx context.Context, methodName string, req proto.Message) (context.Context, error)
 	// Postlude is called for each method after Service has processed the call, or
 	// after the Prelude has returned an error. This takes the the Service's
-	// response proto (which may be nil) and/or any error. The decorated
+	// response proto (which may be nil, and is always nil for server-streaming
+	// methods) and/or any error. The decorated
 	// service will return the response (possibly mutated) and error that Postlude
 	// returns.
 	Postlude func(ctx context.Context, methodName string, rsp proto.Message, err error) error
 }
 
 {{range .Methods}}
+{{if .ServerStreaming}}
+// decorated{{.StreamType}} overrides the context of the stream with the one
+// returned by Prelude.
+type decorated{{.StreamType}} struct {
+	{{.StreamType}}
+	ctx context.Context
+}
+
+func (s *decorated{{.StreamType}}) Context() context.Context {
+	return s.ctx
+}
+
+func (s *{{$StructName}}) {{.Name}}(req {{.InputType}}, stream {{.StreamType}}) (err error) {
+	ctx := stream.Context()
+	if s.Prelude != nil {
+		var newCtx context.Context
+		newCtx, err = s.Prelude(ctx, "{{.Name}}", req)
+		if err == nil {
+			ctx = newCtx
+		}
+	}
+	if err == nil {
+		err = s.Service.{{.Name}}(req, &decorated{{.StreamType}}{stream, ctx})
+	}
+	if s.Postlude != nil {
+		err = s.Postlude(ctx, "{{.Name}}", nil, err)
+	}
+	return
+}
+{{else}}
 func (s *{{$StructName}}) {{.Name}}(ctx context.Context, req {{.InputType}}) (rsp {{.OutputType}}, err error) {
 	if s.Prelude != nil {
 		var newCtx context.Context
@@ -71,6 +102,7 @@ func (s *{{$StructName}}) {{.Name}}(ctx context.Context, req {{.InputType}}) (rs
 }
 {{end}}
 {{end}}
+{{end}}
 `))
 )
 
@@ -75,6 +75,31 @@ func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.Una
 	return
 }
 
+// StreamServerInterceptor is a grpc.StreamServerInterceptor that gathers RPC
+// handler metrics and sends them to tsmon.
+//
+// The duration is measured until the stream handler returns. It assumes the
+// RPC context has tsmon initialized already.
+func StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	ctx := ss.Context()
+	started := clock.Now(ctx)
+	panicking := true
+	defer func() {
+		// See UnaryServerInterceptor for why this is done this way.
+		code := codes.OK
+		switch {
+		case err != nil:
+			code = status.Code(err)
+		case panicking:
+			code = codes.Internal
+		}
+		reportServerRPCMetrics(ctx, info.FullMethod, code, clock.Now(ctx).Sub(started))
+	}()
+	err = handler(srv, ss)
+	panicking = false // normal exit, no panic happened, disarms defer
+	return
+}
+
 // reportServerRPCMetrics sends metrics after RPC handler has finished.
 func reportServerRPCMetrics(ctx context.Context, method string, code codes.Code, dur time.Duration) {
 	canon, ok := gcode.Code_name[int32(code)]
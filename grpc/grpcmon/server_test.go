@@ -57,6 +57,36 @@ func TestUnaryServerInterceptor(t *testing.T) {
 	})
 }
 
+func TestStreamServerInterceptor(t *testing.T) {
+	Convey("Captures count and duration", t, func() {
+		c, memStore := testContext()
+
+		// Handler that runs for 500 ms.
+		handler := func(srv interface{}, ss grpc.ServerStream) error {
+			clock.Get(ss.Context()).(testclock.TestClock).Add(500 * time.Millisecond)
+			return status.Error(codes.Unavailable, "stream broke")
+		}
+
+		// Run the handler with the interceptor.
+		StreamServerInterceptor(nil, &testServerStream{ctx: c}, &grpc.StreamServerInfo{
+			FullMethod: "/service/stream",
+		}, handler)
+
+		count := memStore.Get(c, grpcServerCount, time.Time{}, []interface{}{"/service/stream", 14, "UNAVAILABLE"})
+		So(count, ShouldEqual, 1)
+
+		duration := memStore.Get(c, grpcServerDuration, time.Time{}, []interface{}{"/service/stream", 14, "UNAVAILABLE"})
+		So(duration.(*distribution.Distribution).Sum(), ShouldEqual, 500)
+	})
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
 func testContext() (context.Context, store.Store) {
 	c := context.Background()
 	c, _ = testclock.UseTime(c, testclock.TestTimeUTC)
@@ -52,3 +52,37 @@ func combinator(first, second grpc.UnaryServerInterceptor) grpc.UnaryServerInter
 		})
 	}
 }
+
+// ChainStreamServerInterceptors chains multiple stream interceptors together.
+//
+// The first one becomes the outermost, and the last one becomes the
+// innermost, i.e. `ChainStreamServerInterceptors(a, b, c)(h) === a(b(c(h)))`.
+//
+// nil-valued interceptors are silently skipped.
+func ChainStreamServerInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	switch {
+	case len(interceptors) == 0:
+		// Noop interceptor.
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, ss)
+		}
+	case interceptors[0] == nil:
+		// Skip nils.
+		return ChainStreamServerInterceptors(interceptors[1:]...)
+	case len(interceptors) == 1:
+		// No need to actually chain anything.
+		return interceptors[0]
+	default:
+		return streamCombinator(interceptors[0], ChainStreamServerInterceptors(interceptors[1:]...))
+	}
+}
+
+// streamCombinator is an interceptor that chains just two stream interceptors
+// together.
+func streamCombinator(first, second grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return first(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return second(srv, ss, info, handler)
+		})
+	}
+}
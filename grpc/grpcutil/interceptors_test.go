@@ -192,3 +192,45 @@ func TestChainUnaryServerInterceptors(t *testing.T) {
 		})
 	})
 }
+
+func TestChainStreamServerInterceptors(t *testing.T) {
+	t.Parallel()
+
+	Convey("With interceptors", t, func() {
+		testInfo := &grpc.StreamServerInfo{} // constant address for assertions
+		testError := errors.New("boom")      // constant address for assertions
+
+		calls := []string{}
+		intr := func(name string) grpc.StreamServerInterceptor {
+			return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				So(info, ShouldEqual, testInfo)
+				calls = append(calls, "-> "+name)
+				defer func() { calls = append(calls, "<- "+name) }()
+				return handler(srv, ss)
+			}
+		}
+		handler := func(srv interface{}, ss grpc.ServerStream) error {
+			calls = append(calls, "handler")
+			return testError
+		}
+
+		Convey("Noop chain", func() {
+			err := ChainStreamServerInterceptors()(nil, nil, testInfo, handler)
+			So(err, ShouldEqual, testError)
+			So(calls, ShouldResemble, []string{"handler"})
+		})
+
+		Convey("Chains in order, skipping nils", func() {
+			chain := ChainStreamServerInterceptors(nil, intr("a"), nil, intr("b"), nil)
+			err := chain(nil, nil, testInfo, handler)
+			So(err, ShouldEqual, testError)
+			So(calls, ShouldResemble, []string{
+				"-> a",
+				"-> b",
+				"handler",
+				"<- b",
+				"<- a",
+			})
+		})
+	})
+}
@@ -37,3 +37,16 @@ func UnaryServerPanicCatcherInterceptor(ctx context.Context, req interface{}, in
 	})
 	return handler(ctx, req)
 }
+
+// StreamServerPanicCatcherInterceptor is a grpc.StreamServerInterceptor that
+// catches panics in RPC handlers, recovers them and returns codes.Internal gRPC
+// errors instead.
+func StreamServerPanicCatcherInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer paniccatcher.Catch(func(p *paniccatcher.Panic) {
+		logging.Fields{
+			"panic.error": p.Reason,
+		}.Errorf(ss.Context(), "Caught panic during handling of %q: %s\n%s", info.FullMethod, p.Reason, p.Stack)
+		err = status.Error(codes.Internal, "panic in the request handler")
+	})
+	return handler(srv, ss)
+}
@@ -160,6 +160,12 @@ func (p *parser) resolveService(c context.Context, typeName string) (*Service, e
 			continue
 		}
 		params := signature.Params.List
+		if method, ok, err := p.serverStreamingMethod(file, m, signature); err != nil {
+			return nil, err
+		} else if ok {
+			svc.Methods = append(svc.Methods, method)
+			continue
+		}
 		if len(params) != 2 {
 			logging.Warningf(
 				c,
@@ -206,6 +212,42 @@ func (p *parser) resolveService(c context.Context, typeName string) (*Service, e
 	return svc, nil
 }
 
+// serverStreamingMethod parses a server-streaming method, which has signature
+// "Name(*Request, Service_NameServer) error".
+//
+// Returns false if the method is not server-streaming.
+func (p *parser) serverStreamingMethod(file *ast.File, m *ast.Field, signature *ast.FuncType) (*Method, bool, error) {
+	params := signature.Params.List
+	if len(params) != 2 || signature.Results == nil || len(signature.Results.List) != 1 {
+		return nil, false, nil
+	}
+	if _, ok := params[0].Type.(*ast.StarExpr); !ok {
+		return nil, false, nil
+	}
+	stream, ok := params[1].Type.(*ast.Ident)
+	if !ok || !strings.HasSuffix(stream.Name, "Server") {
+		return nil, false, nil
+	}
+	if res, ok := signature.Results.List[0].Type.(*ast.Ident); !ok || res.Name != "error" {
+		return nil, false, nil
+	}
+
+	method := &Method{
+		Node:            m,
+		Name:            m.Names[0].Name,
+		ServerStreaming: true,
+		StreamType:      stream.Name,
+	}
+	if err := p.recordImport(file, params[0].Type); err != nil {
+		return nil, false, err
+	}
+	var err error
+	if method.InputType, err = p.exprString(params[0].Type); err != nil {
+		return nil, false, err
+	}
+	return method, true, nil
+}
+
 func (p *parser) findType(name string) (*ast.File, *ast.TypeSpec) {
 	if p.typeCache == nil {
 		p.typeCache = map[string]fileAndType{}
@@ -4,8 +4,8 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.26.0
-// 	protoc        v3.17.0
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
 // source: go.chromium.org/luci/grpc/internal/svctool/testdata/test.proto
 
 package test
@@ -183,20 +183,22 @@ var file_go_chromium_org_luci_grpc_internal_svctool_testdata_test_proto_rawDesc
 	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x22, 0x1e, 0x0a, 0x02, 0x4d, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0x1f, 0x0a, 0x02, 0x53, 0x31, 0x12, 0x19,
+	0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0x40, 0x0a, 0x02, 0x53, 0x31, 0x12, 0x19,
 	0x0a, 0x01, 0x4d, 0x12, 0x08, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x31, 0x1a, 0x08, 0x2e,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x32, 0x22, 0x00, 0x32, 0x6d, 0x0a, 0x02, 0x53, 0x32, 0x12,
-	0x1d, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x1a, 0x08, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x31, 0x22, 0x00, 0x12, 0x1d,
-	0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x08, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x31, 0x1a,
-	0x0a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x29, 0x0a,
-	0x03, 0x49, 0x6d, 0x70, 0x12, 0x08, 0x2e, 0x73, 0x75, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e, 0x63,
-	0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x73,
-	0x76, 0x63, 0x74, 0x6f, 0x6f, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x3b,
-	0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x32, 0x22, 0x00, 0x12, 0x1f, 0x0a, 0x05, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x08, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x31, 0x1a, 0x08, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x32, 0x22, 0x00, 0x30, 0x01, 0x32, 0x6d, 0x0a, 0x02, 0x53, 0x32,
+	0x12, 0x1d, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x0a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x08, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x31, 0x22, 0x00, 0x12,
+	0x1d, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x08, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x31,
+	0x1a, 0x0a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x22, 0x00, 0x12, 0x29,
+	0x0a, 0x03, 0x49, 0x6d, 0x70, 0x12, 0x08, 0x2e, 0x73, 0x75, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e,
+	0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63,
+	0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x73, 0x76, 0x63, 0x74, 0x6f, 0x6f, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61,
+	0x3b, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -221,15 +223,17 @@ var file_go_chromium_org_luci_grpc_internal_svctool_testdata_test_proto_goTypes
 }
 var file_go_chromium_org_luci_grpc_internal_svctool_testdata_test_proto_depIdxs = []int32{
 	0, // 0: test.S1.M:input_type -> test.M1
-	2, // 1: test.S2.Get:input_type -> test.Void
-	0, // 2: test.S2.Set:input_type -> test.M1
-	3, // 3: test.S2.Imp:input_type -> sub.Sub
-	1, // 4: test.S1.M:output_type -> test.M2
-	0, // 5: test.S2.Get:output_type -> test.M1
-	2, // 6: test.S2.Set:output_type -> test.Void
-	4, // 7: test.S2.Imp:output_type -> google.protobuf.Empty
-	4, // [4:8] is the sub-list for method output_type
-	0, // [0:4] is the sub-list for method input_type
+	0, // 1: test.S1.Watch:input_type -> test.M1
+	2, // 2: test.S2.Get:input_type -> test.Void
+	0, // 3: test.S2.Set:input_type -> test.M1
+	3, // 4: test.S2.Imp:input_type -> sub.Sub
+	1, // 5: test.S1.M:output_type -> test.M2
+	1, // 6: test.S1.Watch:output_type -> test.M2
+	0, // 7: test.S2.Get:output_type -> test.M1
+	2, // 8: test.S2.Set:output_type -> test.Void
+	4, // 9: test.S2.Imp:output_type -> google.protobuf.Empty
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -311,6 +315,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type S1Client interface {
 	M(ctx context.Context, in *M1, opts ...grpc.CallOption) (*M2, error)
+	Watch(ctx context.Context, in *M1, opts ...grpc.CallOption) (S1_WatchClient, error)
 }
 type s1PRPCClient struct {
 	client *prpc.Client
@@ -329,6 +334,10 @@ func (c *s1PRPCClient) M(ctx context.Context, in *M1, opts ...grpc.CallOption) (
 	return out, nil
 }
 
+func (c *s1PRPCClient) Watch(ctx context.Context, in *M1, opts ...grpc.CallOption) (S1_WatchClient, error) {
+	return NewS1Client(c.client).Watch(ctx, in, opts...)
+}
+
 type s1Client struct {
 	cc grpc.ClientConnInterface
 }
@@ -346,9 +355,42 @@ func (c *s1Client) M(ctx context.Context, in *M1, opts ...grpc.CallOption) (*M2,
 	return out, nil
 }
 
+func (c *s1Client) Watch(ctx context.Context, in *M1, opts ...grpc.CallOption) (S1_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_S1_serviceDesc.Streams[0], "/test.S1/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &s1WatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type S1_WatchClient interface {
+	Recv() (*M2, error)
+	grpc.ClientStream
+}
+
+type s1WatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *s1WatchClient) Recv() (*M2, error) {
+	m := new(M2)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // S1Server is the server API for S1 service.
 type S1Server interface {
 	M(context.Context, *M1) (*M2, error)
+	Watch(*M1, S1_WatchServer) error
 }
 
 // UnimplementedS1Server can be embedded to have forward compatible implementations.
@@ -358,6 +400,9 @@ type UnimplementedS1Server struct {
 func (*UnimplementedS1Server) M(context.Context, *M1) (*M2, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method M not implemented")
 }
+func (*UnimplementedS1Server) Watch(*M1, S1_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 
 func RegisterS1Server(s prpc.Registrar, srv S1Server) {
 	s.RegisterService(&_S1_serviceDesc, srv)
@@ -381,6 +426,27 @@ func _S1_M_Handler(srv interface{}, ctx context.Context, dec func(interface{}) e
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S1_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(M1)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(S1Server).Watch(m, &s1WatchServer{stream})
+}
+
+type S1_WatchServer interface {
+	Send(*M2) error
+	grpc.ServerStream
+}
+
+type s1WatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *s1WatchServer) Send(m *M2) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _S1_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "test.S1",
 	HandlerType: (*S1Server)(nil),
@@ -390,7 +456,13 @@ var _S1_serviceDesc = grpc.ServiceDesc{
 			Handler:    _S1_M_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _S1_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "go.chromium.org/luci/grpc/internal/svctool/testdata/test.proto",
 }
 
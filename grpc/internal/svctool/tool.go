@@ -45,6 +45,13 @@ type Method struct {
 	Node       *ast.Field
 	InputType  string
 	OutputType string
+
+	// ServerStreaming is true for server-streaming methods.
+	//
+	// Their signature is "Name(InputType, StreamType) error" and OutputType is
+	// empty.
+	ServerStreaming bool
+	StreamType      string
 }
 
 type Import struct {
@@ -73,14 +73,14 @@ const (
 
 var (
 	// DefaultUserAgent is default User-Agent HTTP header for pRPC requests.
-	DefaultUserAgent = "pRPC Client 1.4"
+	DefaultUserAgent = "pRPC Client 1.5"
 
 	// ErrResponseTooBig is returned by Call when the Response's body size exceeds
 	// the Client's MaxContentLength limit.
 	ErrResponseTooBig = status.Error(codes.Unavailable, "prpc: response too big")
 
 	// ErrNoStreamingSupport is returned if a pRPC client is used to start a
-	// streaming RPC. They are not supported.
+	// client-streaming or a bidirectional streaming RPC. They are not supported.
 	ErrNoStreamingSupport = status.Error(codes.Unimplemented, "prpc: no streaming support")
 )
 
@@ -150,12 +150,10 @@ var _ grpc.ClientConnInterface = (*Client)(nil)
 //
 // It is a part of grpc.ClientConnInterface.
 func (c *Client) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
-	// 'method' looks like "/service.Name/MethodName".
-	parts := strings.Split(method, "/")
-	if len(parts) != 3 || parts[0] != "" {
-		return status.Errorf(codes.Internal, "prpc: not a valid method name %q", method)
+	serviceName, methodName, err := splitMethodName(method)
+	if err != nil {
+		return err
 	}
-	serviceName, methodName := parts[1], parts[2]
 
 	// Inputs and outputs must be proto messages.
 	in, ok := args.(proto.Message)
@@ -172,9 +170,48 @@ func (c *Client) Invoke(ctx context.Context, method string, args interface{}, re
 
 // NewStream begins a streaming RPC.
 //
+// Only server-streaming RPCs are supported. Returns ErrNoStreamingSupport for
+// other kinds of streams. The RPC is started when CloseSend is called.
+//
 // It is a part of grpc.ClientConnInterface.
 func (c *Client) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
-	return nil, ErrNoStreamingSupport
+	if desc.ClientStreams {
+		return nil, ErrNoStreamingSupport
+	}
+	serviceName, methodName, err := splitMethodName(method)
+	if err != nil {
+		return nil, err
+	}
+	options := c.prepareOptions(opts, serviceName, methodName)
+	options.inFormat = FormatBinary
+	if options.outFormat, err = acceptedFormat(options.AcceptContentSubtype); err != nil {
+		return nil, err
+	}
+	return &clientStream{ctx: ctx, client: c, options: options}, nil
+}
+
+// splitMethodName splits "/service.Name/MethodName" into its components.
+func splitMethodName(method string) (serviceName, methodName string, err error) {
+	parts := strings.Split(method, "/")
+	if len(parts) != 3 || parts[0] != "" {
+		return "", "", status.Errorf(codes.Internal, "prpc: not a valid method name %q", method)
+	}
+	return parts[1], parts[2], nil
+}
+
+// acceptedFormat returns the response format for the given
+// AcceptContentSubtype option value.
+func acceptedFormat(contentSubtype string) (Format, error) {
+	switch contentSubtype {
+	case "", mtPRPCEncodingBinary:
+		return FormatBinary, nil
+	case mtPRPCEncodingJSONPB:
+		return FormatJSONPB, nil
+	case mtPRPCEncodingText:
+		return FormatText, nil
+	default:
+		return 0, status.Errorf(codes.Internal, "prpc: unrecognized contentSubtype %q of CallAcceptContentSubtype", contentSubtype)
+	}
 }
 
 // prepareOptions copies client options and applies opts.
@@ -228,15 +265,11 @@ func (c *Client) Call(ctx context.Context, serviceName, methodName string, in, o
 		return status.Errorf(codes.Internal, "prpc: failed to marshal the request: %s", err)
 	}
 
-	switch options.AcceptContentSubtype {
-	case "", mtPRPCEncodingBinary:
-		options.outFormat = FormatBinary
-	case mtPRPCEncodingJSONPB:
-		options.outFormat = FormatJSONPB
-	case mtPRPCEncodingText:
+	if options.AcceptContentSubtype == mtPRPCEncodingText {
 		return status.Errorf(codes.Internal, "prpc: text encoding for pRPC calls is not implemented")
-	default:
-		return status.Errorf(codes.Internal, "prpc: unrecognized contentSubtype %q of CallAcceptContentSubtype", options.AcceptContentSubtype)
+	}
+	if options.outFormat, err = acceptedFormat(options.AcceptContentSubtype); err != nil {
+		return err
 	}
 
 	resp, err := c.call(ctx, options, reqBody)
@@ -314,68 +347,83 @@ func (c *Client) call(ctx context.Context, options *Options, in []byte) ([]byte,
 
 	// Parse the response content type, verify it is what we expect.
 	if err == nil {
-		switch f, formatErr := FormatFromContentType(contentType); {
-		case formatErr != nil:
-			err = status.Errorf(codes.Internal, "prpc: bad response content type %q: %s", contentType, formatErr)
-		case f != options.outFormat:
-			err = status.Errorf(codes.Internal, "prpc: output format (%q) doesn't match expected format (%q)",
-				f.MediaType(), options.outFormat.MediaType())
-		}
+		err = checkContentType(contentType, options.outFormat)
 	}
 
 	if err != nil {
-		// The context error is more interesting if it is present.
-		switch cerr := ctx.Err(); {
-		case cerr == context.DeadlineExceeded:
-			err = status.Error(codes.DeadlineExceeded, "prpc: overall deadline exceeded")
-		case cerr == context.Canceled:
-			err = status.Error(codes.Canceled, "prpc: call canceled")
-		case cerr != nil:
-			err = status.Error(codes.Unknown, cerr.Error())
-		}
+		return nil, c.finalError(ctx, options, in, err)
+	}
 
-		// Unwrap the error since we wrap it in retry.Retry exclusively to attach
-		// a retry signal. call(...) **must** return standard unwrapped gRPC errors.
-		err = errors.Unwrap(err)
+	out := buf.Bytes()
+	if options.outFormat == FormatJSONPB {
+		out = bytes.TrimPrefix(out, bytesJSONPBPrefix)
+	}
+	return out, nil
+}
 
-		// Convert the error into status.Error (with Unknown code) if it wasn't
-		// a status before.
-		if status, ok := status.FromError(err); !ok {
-			err = status.Err()
-		}
+// checkContentType verifies the response content type matches the expected
+// format.
+func checkContentType(contentType string, expected Format) error {
+	switch f, err := FormatFromContentType(contentType); {
+	case err != nil:
+		return status.Errorf(codes.Internal, "prpc: bad response content type %q: %s", contentType, err)
+	case f != expected:
+		return status.Errorf(codes.Internal, "prpc: output format (%q) doesn't match expected format (%q)",
+			f.MediaType(), expected.MediaType())
+	}
+	return nil
+}
 
-		// Log only on unexpected codes.
-		if code := status.Code(err); code != codes.Canceled {
-			ignore := false
-			for _, expected := range options.expectedCodes {
-				if code == expected {
-					ignore = true
-					break
-				}
+// finalError converts an error of the retry loop into a gRPC error to return
+// to the caller and logs it if necessary.
+//
+// `in` is the request body, used for debug logging.
+func (c *Client) finalError(ctx context.Context, options *Options, in []byte, err error) error {
+	// The context error is more interesting if it is present.
+	switch cerr := ctx.Err(); {
+	case cerr == context.DeadlineExceeded:
+		err = status.Error(codes.DeadlineExceeded, "prpc: overall deadline exceeded")
+	case cerr == context.Canceled:
+		err = status.Error(codes.Canceled, "prpc: call canceled")
+	case cerr != nil:
+		err = status.Error(codes.Unknown, cerr.Error())
+	}
+
+	// Unwrap the error since we wrap it in retry.Retry exclusively to attach
+	// a retry signal. call(...) **must** return standard unwrapped gRPC errors.
+	err = errors.Unwrap(err)
+
+	// Convert the error into status.Error (with Unknown code) if it wasn't
+	// a status before.
+	if status, ok := status.FromError(err); !ok {
+		err = status.Err()
+	}
+
+	// Log only on unexpected codes.
+	if code := status.Code(err); code != codes.Canceled {
+		ignore := false
+		for _, expected := range options.expectedCodes {
+			if code == expected {
+				ignore = true
+				break
 			}
-			if !ignore {
-				logging.Warningf(ctx, "RPC failed permanently: %s", err)
-				if options.Debug {
-					if code == codes.InvalidArgument && strings.Contains(err.Error(), "could not decode body") {
-						logging.Warningf(ctx, "Original request size: %d", len(in))
-						logging.Warningf(ctx, "Content-type: %s", options.inFormat.MediaType())
-						b64 := base64.StdEncoding.EncodeToString(in)
-						logging.Warningf(ctx, "Original request in base64 encoding: %s", b64)
-					}
+		}
+		if !ignore {
+			logging.Warningf(ctx, "RPC failed permanently: %s", err)
+			if options.Debug {
+				if code == codes.InvalidArgument && strings.Contains(err.Error(), "could not decode body") {
+					logging.Warningf(ctx, "Original request size: %d", len(in))
+					logging.Warningf(ctx, "Content-type: %s", options.inFormat.MediaType())
+					b64 := base64.StdEncoding.EncodeToString(in)
+					logging.Warningf(ctx, "Original request in base64 encoding: %s", b64)
 				}
 			}
 		}
-
-		// Do not return metadata from failed attempts.
-		options.resetResponseMetadata()
-		return nil, err
 	}
 
-	out := buf.Bytes()
-	if options.outFormat == FormatJSONPB {
-		out = bytes.TrimPrefix(out, bytesJSONPBPrefix)
-	}
-	return out, nil
+	// Do not return metadata from failed attempts.
+	options.resetResponseMetadata()
+	return err
 }
 
 // concurrencySem returns a semaphore to use to limit concurrency or nil if
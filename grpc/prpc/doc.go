@@ -19,7 +19,7 @@
 //  - service implementation does not depend on pRPC.
 // Unlike gRPC:
 //  - supports HTTP 1.x and AppEngine 1.x.
-//  - does not support streams.
+//  - supports only server-streaming streams (see v1.5 below).
 //
 // Compile service definitions
 //
@@ -33,6 +33,34 @@
 //
 // Protocol
 //
+// ## v1.5
+//
+// v1.5 adds support for server-streaming methods, i.e. methods that accept one
+// request message and return a stream of response messages.
+//
+// The request is the same as for unary methods.
+//
+// If the server fails the call before sending any response messages, it MUST
+// respond the same way as to a failed unary call.
+//
+// Otherwise the server MUST respond with HTTP 200, "X-Prpc-Grpc-Code: 0" and
+// the "Content-Type" header, and MUST NOT compress the response. The response
+// body is a sequence of frames sent as soon as they are available (i.e. via
+// chunked transfer encoding in HTTP 1.1). Each frame is:
+//  - 1 byte with the frame type:
+//    - 0x00: the payload is a response message.
+//    - 0x80: the payload is a google.rpc.Status with the final status of the
+//      call. This is the last frame.
+//  - 4 bytes with the length of the payload as a big-endian unsigned integer.
+//  - The payload encoded in the response format. For the JSON encoding the
+//    XSSI prefix is not included in frames. Instead the whole response body
+//    starts with it.
+//
+// The status frame MUST be present, the client MUST treat a stream without it
+// as failed. Metadata set by the service as trailers is sent as HTTP trailers.
+//
+// Client-streaming and bidirectional streaming methods are not supported.
+//
 // ## v1.4
 //
 // v1.4 hides some leaking HTTP v1 transport implementation details from gRPC
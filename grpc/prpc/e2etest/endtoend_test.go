@@ -17,6 +17,7 @@ package e2etest
 import (
 	"context"
 	"encoding/hex"
+	"io"
 	"math/rand"
 	"strings"
 	"sync"
@@ -46,6 +47,9 @@ type service struct {
 
 	sleep func() time.Duration
 
+	streamLen  int           // number of times GreetStream sends R
+	streamGate chan struct{} // if set, GreetStream waits on it after each R
+
 	m          sync.Mutex
 	incomingMD metadata.MD
 }
@@ -71,6 +75,30 @@ func (s *service) Greet(ctx context.Context, req *HelloRequest) (*HelloReply, er
 	return s.R, s.err
 }
 
+func (s *service) GreetStream(req *HelloRequest, stream Hello_GreetStreamServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.m.Lock()
+	s.incomingMD = md.Copy()
+	s.m.Unlock()
+
+	if s.outgoingMD != nil {
+		if err := stream.SetHeader(s.outgoingMD); err != nil {
+			return err
+		}
+		stream.SetTrailer(s.outgoingMD)
+	}
+
+	for i := 0; i < s.streamLen; i++ {
+		if err := stream.Send(s.R); err != nil {
+			return err
+		}
+		if s.streamGate != nil {
+			<-s.streamGate
+		}
+	}
+	return s.err
+}
+
 func (s *service) getIncomingMD() metadata.MD {
 	s.m.Lock()
 	defer s.m.Unlock()
@@ -177,6 +205,119 @@ func TestEndToEnd(t *testing.T) {
 	})
 }
 
+func TestStreaming(t *testing.T) {
+	t.Parallel()
+
+	Convey(`A client/server for the GreetStream method`, t, func() {
+		ctx := gologger.StdConfig.Use(context.Background())
+		svc := service{R: &HelloReply{Message: "sup"}, streamLen: 3}
+		ts, client := newTestClient(ctx, &svc, nil)
+		defer ts.Close()
+
+		recvAll := func(stream Hello_GreetStreamClient) (msgs []*HelloReply, err error) {
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					return msgs, err
+				}
+				msgs = append(msgs, msg)
+			}
+		}
+
+		for _, subtype := range []string{"binary", "json", "text"} {
+			subtype := subtype
+			Convey(`Can receive messages in `+subtype, func() {
+				stream, err := client.GreetStream(ctx, &HelloRequest{Name: "stream"}, prpc.CallAcceptContentSubtype(subtype))
+				So(err, ShouldBeRPCOK)
+				msgs, err := recvAll(stream)
+				So(err, ShouldEqual, io.EOF)
+				So(msgs, ShouldHaveLength, 3)
+				So(msgs[2], ShouldResembleProto, svc.R)
+			})
+		}
+
+		Convey(`Receives messages as they are sent`, func() {
+			svc.streamGate = make(chan struct{})
+			stream, err := client.GreetStream(ctx, &HelloRequest{Name: "stream"})
+			So(err, ShouldBeRPCOK)
+			for i := 0; i < svc.streamLen; i++ {
+				msg, err := stream.Recv()
+				So(err, ShouldBeRPCOK)
+				So(msg, ShouldResembleProto, svc.R)
+				svc.streamGate <- struct{}{}
+			}
+			_, err = stream.Recv()
+			So(err, ShouldEqual, io.EOF)
+		})
+
+		Convey(`Returns errors returned before streaming`, func() {
+			svc.streamLen = 0
+			svc.err = status.Errorf(codes.NotFound, "not found")
+			_, err := client.GreetStream(ctx, &HelloRequest{Name: "stream"})
+			So(err, ShouldHaveRPCCode, codes.NotFound, "not found")
+		})
+
+		Convey(`Returns errors returned after streaming with details`, func() {
+			detail := &errdetails.DebugInfo{Detail: "x"}
+			s, err := status.New(codes.AlreadyExists, "already exists").WithDetails(detail)
+			So(err, ShouldBeNil)
+			svc.err = s.Err()
+
+			stream, err := client.GreetStream(ctx, &HelloRequest{Name: "stream"})
+			So(err, ShouldBeRPCOK)
+			msgs, err := recvAll(stream)
+			So(msgs, ShouldHaveLength, 3)
+			So(err, ShouldHaveRPCCode, codes.AlreadyExists, "already exists")
+			So(status.Convert(err).Details(), ShouldResembleProto, []proto.Message{detail})
+		})
+
+		Convey(`Can handle metadata`, func() {
+			md := metadata.Pairs("key", "val", "binary-bin", string([]byte{0, 1, 2, 3}))
+			svc.outgoingMD = md
+
+			var header, trailer metadata.MD
+			stream, err := client.GreetStream(
+				metadata.NewOutgoingContext(ctx, metadata.Pairs("in", "val")),
+				&HelloRequest{Name: "stream"},
+				grpc.Header(&header), grpc.Trailer(&trailer))
+			So(err, ShouldBeRPCOK)
+			So(svc.getIncomingMD()["in"], ShouldResemble, []string{"val"})
+
+			streamHeader, err := stream.Header()
+			So(err, ShouldBeNil)
+			So(streamHeader, ShouldResemble, md)
+			So(header, ShouldResemble, md)
+
+			_, err = recvAll(stream)
+			So(err, ShouldEqual, io.EOF)
+			So(stream.Trailer(), ShouldResemble, md)
+			So(trailer, ShouldResemble, md)
+		})
+
+		Convey(`StreamWithFormats`, func() {
+			prpcClient, err := ts.NewClient()
+			So(err, ShouldBeNil)
+			stream, err := prpcClient.StreamWithFormats(ctx, "e2etest.Hello", "GreetStream",
+				[]byte(`{"name": "stream"}`), prpc.FormatJSONPB, prpc.FormatJSONPB)
+			So(err, ShouldBeRPCOK)
+			var msgs []string
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					So(err, ShouldEqual, io.EOF)
+					break
+				}
+				msgs = append(msgs, string(msg))
+			}
+			So(msgs, ShouldResemble, []string{
+				`{"message":"sup"}`,
+				`{"message":"sup"}`,
+				`{"message":"sup"}`,
+			})
+		})
+	})
+}
+
 func TestTimeouts(t *testing.T) {
 	t.Parallel()
 
@@ -138,14 +138,17 @@ var file_go_chromium_org_luci_grpc_prpc_e2etest_helloworld_test_proto_rawDesc =
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x0a, 0x48,
 	0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x32, 0x3c, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x33, 0x0a, 0x05,
+	0x61, 0x67, 0x65, 0x32, 0x79, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x33, 0x0a, 0x05,
 	0x47, 0x72, 0x65, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x65, 0x32, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e,
 	0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x65,
 	0x32, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d,
-	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70,
-	0x72, 0x70, 0x63, 0x2f, 0x65, 0x32, 0x65, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x47, 0x72, 0x65, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x15, 0x2e, 0x65, 0x32, 0x65, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x65, 0x32, 0x65, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x30, 0x01, 0x42, 0x28,
+	0x5a, 0x26, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x70, 0x63,
+	0x2f, 0x65, 0x32, 0x65, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -167,9 +170,11 @@ var file_go_chromium_org_luci_grpc_prpc_e2etest_helloworld_test_proto_goTypes =
 }
 var file_go_chromium_org_luci_grpc_prpc_e2etest_helloworld_test_proto_depIdxs = []int32{
 	0, // 0: e2etest.Hello.Greet:input_type -> e2etest.HelloRequest
-	1, // 1: e2etest.Hello.Greet:output_type -> e2etest.HelloReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	0, // 1: e2etest.Hello.GreetStream:input_type -> e2etest.HelloRequest
+	1, // 2: e2etest.Hello.Greet:output_type -> e2etest.HelloReply
+	1, // 3: e2etest.Hello.GreetStream:output_type -> e2etest.HelloReply
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -239,6 +244,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type HelloClient interface {
 	Greet(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error)
+	GreetStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Hello_GreetStreamClient, error)
 }
 type helloPRPCClient struct {
 	client *prpc.Client
@@ -257,6 +263,10 @@ func (c *helloPRPCClient) Greet(ctx context.Context, in *HelloRequest, opts ...g
 	return out, nil
 }
 
+func (c *helloPRPCClient) GreetStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Hello_GreetStreamClient, error) {
+	return NewHelloClient(c.client).GreetStream(ctx, in, opts...)
+}
+
 type helloClient struct {
 	cc grpc.ClientConnInterface
 }
@@ -274,9 +284,42 @@ func (c *helloClient) Greet(ctx context.Context, in *HelloRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *helloClient) GreetStream(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (Hello_GreetStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Hello_serviceDesc.Streams[0], "/e2etest.Hello/GreetStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &helloGreetStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Hello_GreetStreamClient interface {
+	Recv() (*HelloReply, error)
+	grpc.ClientStream
+}
+
+type helloGreetStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *helloGreetStreamClient) Recv() (*HelloReply, error) {
+	m := new(HelloReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // HelloServer is the server API for Hello service.
 type HelloServer interface {
 	Greet(context.Context, *HelloRequest) (*HelloReply, error)
+	GreetStream(*HelloRequest, Hello_GreetStreamServer) error
 }
 
 // UnimplementedHelloServer can be embedded to have forward compatible implementations.
@@ -286,6 +329,9 @@ type UnimplementedHelloServer struct {
 func (*UnimplementedHelloServer) Greet(context.Context, *HelloRequest) (*HelloReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Greet not implemented")
 }
+func (*UnimplementedHelloServer) GreetStream(*HelloRequest, Hello_GreetStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GreetStream not implemented")
+}
 
 func RegisterHelloServer(s prpc.Registrar, srv HelloServer) {
 	s.RegisterService(&_Hello_serviceDesc, srv)
@@ -309,6 +355,27 @@ func _Hello_Greet_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Hello_GreetStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HelloRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(HelloServer).GreetStream(m, &helloGreetStreamServer{stream})
+}
+
+type Hello_GreetStreamServer interface {
+	Send(*HelloReply) error
+	grpc.ServerStream
+}
+
+type helloGreetStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *helloGreetStreamServer) Send(m *HelloReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Hello_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "e2etest.Hello",
 	HandlerType: (*HelloServer)(nil),
@@ -318,6 +385,12 @@ var _Hello_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Hello_Greet_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GreetStream",
+			Handler:       _Hello_GreetStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "go.chromium.org/luci/grpc/prpc/e2etest/helloworld_test.proto",
 }
@@ -221,6 +221,26 @@ func writeError(ctx context.Context, w http.ResponseWriter, err error, format Fo
 		w.Header()[HeaderStatusDetail] = detailHeader
 	}
 
+	body := publicErrorMessage(ctx, st, httpStatus, err)
+
+	w.Header().Set(HeaderGRPCCode, strconv.Itoa(int(st.Code())))
+	w.Header().Set(headerContentType, "text/plain")
+	w.WriteHeader(httpStatus)
+	if _, err := io.WriteString(w, body); err != nil {
+		// This error most commonly happens if the client disconnects. The header is
+		// already written. There is nothing more we can do other than log it.
+		logging.Warningf(ctx, "prpc: failed to write response body: %s", err)
+		return
+	}
+	io.WriteString(w, "\n")
+}
+
+// publicErrorMessage logs the error status and returns the error message to
+// send to the client.
+//
+// Messages of errors that result in HTTP status >= 500 are replaced with
+// generic ones to avoid leaking implementation details.
+func publicErrorMessage(ctx context.Context, st *status.Status, httpStatus int, err error) string {
 	body := st.Message()
 	if httpStatus < 500 {
 		logging.Warningf(ctx, "prpc: responding with %s error (HTTP %d): %s", st.Code(), httpStatus, st.Message())
@@ -249,15 +269,5 @@ func writeError(ctx context.Context, w http.ResponseWriter, err error, format Fo
 		logging.Errorf(ctx, "prpc: responding with %s error (HTTP %d): %s", st.Code(), httpStatus, st.Message())
 		errors.Log(ctx, err)
 	}
-
-	w.Header().Set(HeaderGRPCCode, strconv.Itoa(int(st.Code())))
-	w.Header().Set(headerContentType, "text/plain")
-	w.WriteHeader(httpStatus)
-	if _, err := io.WriteString(w, body); err != nil {
-		// This error most commonly happens if the client disconnects. The header is
-		// already written. There is nothing more we can do other than log it.
-		logging.Warningf(ctx, "prpc: failed to write response body: %s", err)
-		return
-	}
-	io.WriteString(w, "\n")
+	return body
 }
@@ -0,0 +1,107 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/grpc/grpcutil"
+)
+
+// WaitOperationOptions configure WaitOperation.
+type WaitOperationOptions struct {
+	// Interval is a delay between the first two polls. Default is 1s.
+	//
+	// The delay doubles after each poll up to MaxInterval.
+	Interval time.Duration
+
+	// MaxInterval is the maximum delay between polls. Default is 30s.
+	MaxInterval time.Duration
+
+	// OnProgress, if set, is called each time the operation is fetched.
+	//
+	// Can be used to report the progress based on the operation metadata.
+	OnProgress func(op *longrunning.Operation)
+}
+
+// WaitOperation polls a google.longrunning.Operation until it is done.
+//
+// Takes the operation as returned by the RPC that started it. Polls it via
+// GetOperation with an exponential backoff, retrying transient errors, until
+// the operation is done or the context expires.
+//
+// Returns the finished operation. Use OperationResult to extract its result.
+// Non-transient RPC errors are returned as is.
+func WaitOperation(ctx context.Context, client longrunning.OperationsClient, op *longrunning.Operation, opts *WaitOperationOptions) (*longrunning.Operation, error) {
+	if opts == nil {
+		opts = &WaitOperationOptions{}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+
+	for !op.Done {
+		if r := <-clock.After(ctx, interval); r.Err != nil {
+			return nil, errors.Annotate(r.Err, "waiting for operation %q", op.Name).Err()
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+
+		switch fresh, err := client.GetOperation(ctx, &longrunning.GetOperationRequest{Name: op.Name}); {
+		case err == nil:
+			op = fresh
+			if opts.OnProgress != nil {
+				opts.OnProgress(op)
+			}
+		case grpcutil.IsTransientCode(status.Code(err)):
+			logging.Warningf(ctx, "Transient error when polling operation %q: %s", op.Name, err)
+		default:
+			return nil, err
+		}
+	}
+	return op, nil
+}
+
+// OperationResult extracts the result of a finished operation.
+//
+// If the operation failed, returns its error as a gRPC status error. Otherwise
+// deserializes the operation response into resp.
+func OperationResult(op *longrunning.Operation, resp proto.Message) error {
+	switch res := op.Result.(type) {
+	case *longrunning.Operation_Error:
+		return status.ErrorProto(res.Error)
+	case *longrunning.Operation_Response:
+		if err := res.Response.UnmarshalTo(resp); err != nil {
+			return errors.Annotate(err, "bad response of operation %q", op.Name).Err()
+		}
+		return nil
+	default:
+		return errors.Reason("operation %q is not done", op.Name).Err()
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type fakeOperationsClient struct {
+	longrunning.OperationsClient
+
+	replies []func() (*longrunning.Operation, error)
+	calls   int
+}
+
+func (c *fakeOperationsClient) GetOperation(ctx context.Context, in *longrunning.GetOperationRequest, opts ...grpc.CallOption) (*longrunning.Operation, error) {
+	c.calls++
+	return c.replies[0]()
+}
+
+func TestWaitOperation(t *testing.T) {
+	t.Parallel()
+
+	Convey("WaitOperation", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		var delays []time.Duration
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			delays = append(delays, d)
+			tc.Add(d)
+		})
+
+		resp, _ := anypb.New(wrapperspb.String("result"))
+		pending := &longrunning.Operation{Name: "operations/1"}
+		done := &longrunning.Operation{
+			Name:   "operations/1",
+			Done:   true,
+			Result: &longrunning.Operation_Response{Response: resp},
+		}
+
+		client := &fakeOperationsClient{}
+		reply := func(op *longrunning.Operation, err error) func() (*longrunning.Operation, error) {
+			return func() (*longrunning.Operation, error) {
+				client.replies = client.replies[1:]
+				return op, err
+			}
+		}
+
+		Convey("Polls until done", func() {
+			client.replies = append(client.replies,
+				reply(pending, nil),
+				reply(nil, status.Errorf(codes.Unavailable, "flake")),
+				reply(pending, nil),
+				reply(done, nil),
+			)
+			op, err := WaitOperation(ctx, client, pending, &WaitOperationOptions{MaxInterval: 3 * time.Second})
+			So(err, ShouldBeNil)
+			So(op, ShouldResembleProto, done)
+			So(client.calls, ShouldEqual, 4)
+			So(delays, ShouldResemble, []time.Duration{
+				time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second,
+			})
+
+			out := &wrapperspb.StringValue{}
+			So(OperationResult(op, out), ShouldBeNil)
+			So(out.Value, ShouldEqual, "result")
+		})
+
+		Convey("Already done", func() {
+			op, err := WaitOperation(ctx, client, done, nil)
+			So(err, ShouldBeNil)
+			So(op, ShouldEqual, done)
+			So(client.calls, ShouldEqual, 0)
+		})
+
+		Convey("Fatal error", func() {
+			client.replies = append(client.replies, reply(nil, status.Errorf(codes.NotFound, "gone")))
+			_, err := WaitOperation(ctx, client, pending, nil)
+			So(err, ShouldHaveGRPCStatus, codes.NotFound)
+		})
+
+		Convey("OperationResult with error", func() {
+			failed := &longrunning.Operation{
+				Name:   "operations/1",
+				Done:   true,
+				Result: &longrunning.Operation_Error{Error: status.New(codes.Aborted, "boom").Proto()},
+			}
+			So(OperationResult(failed, &wrapperspb.StringValue{}), ShouldHaveGRPCStatus, codes.Aborted)
+			So(OperationResult(pending, &wrapperspb.StringValue{}), ShouldErrLike, "is not done")
+		})
+	})
+}
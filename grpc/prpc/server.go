@@ -172,6 +172,11 @@ type Server struct {
 	// invoke handler to complete the RPC.
 	UnaryServerInterceptor grpc.UnaryServerInterceptor
 
+	// StreamServerInterceptor provides a hook to intercept the execution of
+	// a server-streaming RPC on the server. It is the responsibility of the
+	// interceptor to invoke handler to complete the RPC.
+	StreamServerInterceptor grpc.StreamServerInterceptor
+
 	// EnableResponseCompression allows the server to compress responses if they
 	// are larger than a certain threshold.
 	//
@@ -182,6 +187,8 @@ type Server struct {
 	//
 	// The request compression is configured independently on the client. The
 	// server always accepts compressed requests.
+	//
+	// Responses of server-streaming RPCs are never compressed.
 	EnableResponseCompression bool
 
 	mu        sync.RWMutex
@@ -191,6 +198,7 @@ type Server struct {
 
 type service struct {
 	methods map[string]grpc.MethodDesc
+	streams map[string]grpc.StreamDesc
 	impl    interface{}
 }
 
@@ -205,10 +213,14 @@ func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
 	serv := &service{
 		impl:    impl,
 		methods: make(map[string]grpc.MethodDesc, len(desc.Methods)),
+		streams: make(map[string]grpc.StreamDesc, len(desc.Streams)),
 	}
 	for _, m := range desc.Methods {
 		serv.methods[m.MethodName] = m
 	}
+	for _, s := range desc.Streams {
+		serv.streams[s.StreamName] = s
+	}
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -307,7 +319,7 @@ func (s *Server) handlePOST(c *router.Context) {
 	serviceName := c.Params.ByName("service")
 	methodName := c.Params.ByName("method")
 
-	override, service, method, methodFound := s.lookup(serviceName, methodName)
+	override, service, method, stream := s.lookup(serviceName, methodName)
 	// Override takes precedence over notImplementedErr.
 	if override != nil && override(c) {
 		return
@@ -324,21 +336,30 @@ func (s *Server) handlePOST(c *router.Context) {
 			codes.Unimplemented,
 			"service %q is not implemented",
 			serviceName)
-	case !methodFound:
+	case stream != nil && stream.ClientStreams:
+		res.err = status.Errorf(
+			codes.Unimplemented,
+			"method %q in service %q is client-streaming, pRPC supports only unary and server-streaming methods",
+			methodName, serviceName)
+	case stream != nil:
+		s.callStream(c, service, *stream, &res)
+	case method == nil:
 		res.err = status.Errorf(
 			codes.Unimplemented,
 			"method %q in service %q is not implemented",
 			methodName, serviceName)
 	default:
-		s.call(c, service, method, &res)
+		s.call(c, service, *method, &res)
 	}
 
-	if res.err != nil {
+	switch {
+	case res.err != nil:
 		writeError(c.Context, c.Writer, res.err, res.fmt)
-		return
+	case res.out != nil:
+		writeMessage(c.Context, c.Writer, res.out, res.fmt, s.EnableResponseCompression && res.acceptsGZip)
+	default:
+		// The response was streamed by callStream.
 	}
-
-	writeMessage(c.Context, c.Writer, res.out, res.fmt, s.EnableResponseCompression && res.acceptsGZip)
 }
 
 func (s *Server) handleOPTIONS(c *router.Context) {
@@ -375,7 +396,9 @@ type response struct {
 	err         error
 }
 
-func (s *Server) lookup(serviceName, methodName string) (override Override, service *service, method grpc.MethodDesc, methodFound bool) {
+// lookup returns either a unary method or a streaming method, or neither if
+// the method is not implemented.
+func (s *Server) lookup(serviceName, methodName string) (override Override, service *service, method *grpc.MethodDesc, stream *grpc.StreamDesc) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 	if methods, ok := s.overrides[serviceName]; ok {
@@ -385,7 +408,11 @@ func (s *Server) lookup(serviceName, methodName string) (override Override, serv
 	if service == nil {
 		return
 	}
-	method, methodFound = service.methods[methodName]
+	if m, ok := service.methods[methodName]; ok {
+		method = &m
+	} else if s, ok := service.streams[methodName]; ok {
+		stream = &s
+	}
 	return
 }
 
@@ -20,9 +20,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -60,6 +63,30 @@ func (s *greeterService) SayHello(c context.Context, req *HelloRequest) (*HelloR
 	}, nil
 }
 
+func (s *greeterService) SayHelloStream(req *HelloRequest, stream Greeter_SayHelloStreamServer) error {
+	if req.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "Name unspecified")
+	}
+
+	if s.headerMD != nil {
+		if err := stream.SetHeader(s.headerMD); err != nil {
+			return err
+		}
+	}
+
+	names := strings.Split(req.Name, ",")
+	for _, name := range names {
+		if name == "!" {
+			return status.Errorf(codes.FailedPrecondition, "bad name")
+		}
+		if err := stream.Send(&HelloReply{Message: "Hello " + name}); err != nil {
+			return err
+		}
+	}
+	stream.SetTrailer(metadata.Pairs("count", strconv.Itoa(len(names))))
+	return nil
+}
+
 type calcService struct{}
 
 func (s *calcService) Multiply(c context.Context, req *MultiplyRequest) (*MultiplyResponse, error) {
@@ -172,6 +199,82 @@ func TestServer(t *testing.T) {
 				So(res.Header().Get(HeaderGRPCCode), ShouldEqual, unimplemented)
 			})
 
+			Convey("Streaming", func() {
+				req.URL.Path = "/prpc/prpc.Greeter/SayHelloStream"
+				hiMsg.Reset()
+				hiMsg.WriteString(`name: "A,B"`)
+
+				// readFrames reads all frames from the response body.
+				readFrames := func() (msgs []string, st *spb.Status) {
+					body := res.Body
+					if res.Header().Get("Content-Type") == mtPRPCJSONPB {
+						So(body.Next(len(JSONPBPrefix)), ShouldResemble, bytesJSONPBPrefix)
+					}
+					for {
+						typ, payload, err := readFrame(body, 1024)
+						So(err, ShouldBeNil)
+						if typ == frameStatus {
+							st = &spb.Status{}
+							So(unmarshalMessage(payload, st, FormatJSONPB), ShouldBeNil)
+							So(body.Len(), ShouldEqual, 0)
+							return
+						}
+						So(typ, ShouldEqual, frameMessage)
+						msgs = append(msgs, string(payload))
+					}
+				}
+
+				Convey("Works", func() {
+					greeterSvc.headerMD = metadata.Pairs("a", "1")
+					req.Header.Set("Accept", mtPRPCJSONPB)
+					r.ServeHTTP(res, req)
+					So(res.Code, ShouldEqual, http.StatusOK)
+					So(res.Header().Get(HeaderGRPCCode), ShouldEqual, "0")
+					So(res.Header().Get("Content-Type"), ShouldEqual, mtPRPCJSONPB)
+					So(res.Header()["A"], ShouldResemble, []string{"1"})
+
+					msgs, st := readFrames()
+					So(msgs, ShouldResemble, []string{
+						`{"message":"Hello A"}`,
+						`{"message":"Hello B"}`,
+					})
+					So(st.Code, ShouldEqual, int32(codes.OK))
+					So(res.Result().Trailer, ShouldResemble, http.Header{"Count": {"2"}})
+				})
+
+				Convey("Error before the first message", func() {
+					hiMsg.Reset()
+					r.ServeHTTP(res, req)
+					So(res.Code, ShouldEqual, http.StatusBadRequest)
+					So(res.Header().Get(HeaderGRPCCode), ShouldEqual, invalidArgument)
+					So(res.Body.String(), ShouldEqual, "Name unspecified\n")
+				})
+
+				Convey("Error after some messages", func() {
+					hiMsg.Reset()
+					hiMsg.WriteString(`name: "A,!"`)
+					req.Header.Set("Accept", mtPRPCJSONPB)
+					r.ServeHTTP(res, req)
+					So(res.Code, ShouldEqual, http.StatusOK)
+
+					msgs, st := readFrames()
+					So(msgs, ShouldHaveLength, 1)
+					So(st.Code, ShouldEqual, int32(codes.FailedPrecondition))
+					So(st.Message, ShouldEqual, "bad name")
+				})
+
+				Convey("Interceptor", func() {
+					var fullMethod string
+					server.StreamServerInterceptor = func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+						fullMethod = info.FullMethod
+						return handler(srv, ss)
+					}
+					r.ServeHTTP(res, req)
+					So(res.Code, ShouldEqual, http.StatusOK)
+					So(fullMethod, ShouldEqual, "/prpc.Greeter/SayHelloStream")
+				})
+			})
+
 			Convey(`When access control is enabled without credentials`, func() {
 				server.AccessControl = func(c context.Context, origin string) AccessControlDecision {
 					return AccessControlDecision{
@@ -0,0 +1,96 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+// This file implements framing of server-streaming responses.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// frameMessage is a type of a frame with a response message.
+	frameMessage byte = 0x00
+	// frameStatus is a type of the last frame of a stream with the final
+	// google.rpc.Status of the RPC.
+	frameStatus byte = 0x80
+
+	// frameHeaderLen is the length of the frame type and the payload length.
+	frameHeaderLen = 5
+)
+
+// errFrameTooBig is returned by readFrame if the frame exceeds the limit.
+var errFrameTooBig = fmt.Errorf("frame is too big")
+
+// writeFrame writes a frame with the given type and payload to w.
+//
+// A frame is a byte with the frame type, followed by the payload length as
+// a big-endian uint32, followed by the payload.
+func writeFrame(w io.Writer, typ byte, payload []byte) error {
+	var hdr [frameHeaderLen]byte
+	hdr[0] = typ
+	binary.BigEndian.PutUint32(hdr[1:], uint32(len(payload)))
+	if _, err := w.Write(hdr[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+// readFrame reads a frame written by writeFrame.
+//
+// Returns io.EOF if there are no more frames, io.ErrUnexpectedEOF if the frame
+// is truncated and errFrameTooBig if its payload is larger than the limit.
+func readFrame(r io.Reader, limit int) (typ byte, payload []byte, err error) {
+	var hdr [frameHeaderLen]byte
+	if _, err := io.ReadFull(r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+	l := binary.BigEndian.Uint32(hdr[1:])
+	if uint64(l) > uint64(limit) {
+		return 0, nil, errFrameTooBig
+	}
+	payload = make([]byte, l)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, err
+	}
+	return hdr[0], payload, nil
+}
+
+// unmarshalMessage unmarshals a message marshaled by marshalMessage without
+// wrapping.
+//
+// Unknown JSONPB fields are ignored, see the comment in Client.Call.
+func unmarshalMessage(buf []byte, msg proto.Message, format Format) error {
+	switch format {
+	case FormatBinary:
+		return proto.Unmarshal(buf, msg)
+	case FormatJSONPB:
+		return (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewReader(buf), msg)
+	case FormatText:
+		return proto.UnmarshalText(string(buf), msg)
+	default:
+		panic(fmt.Errorf("impossible: invalid format %d", format))
+	}
+}
@@ -0,0 +1,392 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+)
+
+// RawStream is a server-streaming RPC started by StreamWithFormats.
+type RawStream struct {
+	s *clientStream
+}
+
+// StreamWithFormats is like CallWithFormats, but starts a server-streaming RPC.
+//
+// Returns after the server has accepted the RPC. Response messages can then be
+// read with RawStream.Recv. Cancel the context to abort the RPC before reading
+// all responses.
+func (c *Client) StreamWithFormats(ctx context.Context, serviceName, methodName string, in []byte, inf, outf Format, opts ...grpc.CallOption) (*RawStream, error) {
+	options := c.prepareOptions(opts, serviceName, methodName)
+	if options.AcceptContentSubtype != "" {
+		return nil, status.Errorf(codes.Internal,
+			"prpc: CallAcceptContentSubtype option is not allowed with StreamWithFormats "+
+				"because input/output formats are already specified")
+	}
+	options.inFormat = inf
+	options.outFormat = outf
+	s := &clientStream{ctx: ctx, client: c, options: options, req: in}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return &RawStream{s}, nil
+}
+
+// Recv returns the next response message in the format requested in
+// StreamWithFormats.
+//
+// Returns io.EOF when the RPC successfully finishes or a gRPC error if it
+// fails.
+func (r *RawStream) Recv() ([]byte, error) {
+	return r.s.recv()
+}
+
+// Header returns the response header metadata.
+func (r *RawStream) Header() metadata.MD {
+	return r.s.header
+}
+
+// Trailer returns the response trailer metadata.
+//
+// Available only after Recv has returned an error.
+func (r *RawStream) Trailer() metadata.MD {
+	return r.s.trailer
+}
+
+// clientStream implements grpc.ClientStream for server-streaming RPCs.
+//
+// The request is sent when the stream is opened by CloseSend. Response messages
+// are read from the response body as frames, see readFrame.
+type clientStream struct {
+	ctx     context.Context
+	client  *Client
+	options *Options
+
+	req     []byte // the serialized request, set by SendMsg
+	res     *http.Response
+	body    *bufio.Reader
+	release func()      // releases the concurrency semaphore slot
+	header  metadata.MD // the response header metadata
+	trailer metadata.MD // the response trailer metadata
+	err     error       // the final error of the stream, io.EOF on success
+}
+
+var _ grpc.ClientStream = (*clientStream)(nil)
+
+// Header returns the header metadata received from the server.
+func (s *clientStream) Header() (metadata.MD, error) {
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s.header, nil
+}
+
+// Trailer returns the trailer metadata from the server.
+//
+// Available only after RecvMsg has returned an error.
+func (s *clientStream) Trailer() metadata.MD {
+	return s.trailer
+}
+
+// CloseSend sends the request to the server.
+func (s *clientStream) CloseSend() error {
+	return s.open()
+}
+
+// Context returns the context of the stream.
+func (s *clientStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sets the request message.
+//
+// Server-streaming RPCs accept exactly one request message.
+func (s *clientStream) SendMsg(m interface{}) error {
+	if s.req != nil {
+		return status.Errorf(codes.Internal, "prpc: the request message was already sent")
+	}
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad argument type %T, not a proto", m)
+	}
+	var err error
+	if s.req, err = proto.Marshal(msg); err != nil {
+		return status.Errorf(codes.Internal, "prpc: failed to marshal the request: %s", err)
+	}
+	return nil
+}
+
+// RecvMsg reads the next response message into m.
+func (s *clientStream) RecvMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad reply type %T, not a proto", m)
+	}
+	blob, err := s.recv()
+	if err != nil {
+		return err
+	}
+	if err := unmarshalMessage(blob, msg, s.options.outFormat); err != nil {
+		return s.fail(status.Errorf(codes.Internal, "prpc: failed to unmarshal the response: %s", err))
+	}
+	return nil
+}
+
+// open sends the request if it wasn't sent yet.
+//
+// Retries on transient errors until the server accepts the RPC.
+func (s *clientStream) open() error {
+	switch {
+	case s.err != nil:
+		return s.err
+	case s.res != nil:
+		return nil
+	case s.req == nil:
+		return status.Errorf(codes.Internal, "prpc: the request message was not sent")
+	}
+
+	md, _ := metadata.FromOutgoingContext(s.ctx)
+	req, err := s.client.prepareRequest(s.options, md, s.req)
+	if err != nil {
+		s.err = err
+		return err
+	}
+	ctx := logging.SetFields(s.ctx, logging.Fields{
+		"host":    s.options.host,
+		"service": s.options.serviceName,
+		"method":  s.options.methodName,
+	})
+
+	err = retry.Retry(ctx, transient.Only(s.options.Retry), func() (err error) {
+		s.res, s.release, err = s.client.attemptStream(ctx, s.options, req)
+		return grpcutil.WrapIfTransient(err)
+	}, func(err error, sleepTime time.Duration) {
+		logging.Fields{
+			"sleepTime": sleepTime,
+		}.Warningf(ctx, "RPC failed transiently (retry in %s): %s", sleepTime, err)
+	})
+
+	if err == nil {
+		err = checkContentType(s.res.Header.Get("Content-Type"), s.options.outFormat)
+	}
+	if err == nil {
+		s.header, err = headersIntoMetadata(s.res.Header)
+		if err != nil {
+			err = status.Errorf(codes.Internal, "prpc: decoding headers: %s", err)
+		}
+	}
+	if err == nil {
+		s.body = bufio.NewReader(s.res.Body)
+		if s.options.outFormat == FormatJSONPB {
+			err = s.skipJSONPBPrefix()
+		}
+	}
+	if err != nil {
+		s.close()
+		s.err = s.client.finalError(ctx, s.options, s.req, err)
+		return s.err
+	}
+
+	if s.options.resHeaderMetadata != nil {
+		*s.options.resHeaderMetadata = s.header
+	}
+	return nil
+}
+
+// skipJSONPBPrefix reads JSONPBPrefix from the beginning of the body.
+func (s *clientStream) skipJSONPBPrefix() error {
+	prefix := make([]byte, len(bytesJSONPBPrefix))
+	if _, err := io.ReadFull(s.body, prefix); err != nil {
+		return s.readErr(err)
+	}
+	if !bytes.Equal(prefix, bytesJSONPBPrefix) {
+		return status.Errorf(codes.Internal, "prpc: the response doesn't start with %q", JSONPBPrefix)
+	}
+	return nil
+}
+
+// recv reads the next response message.
+func (s *clientStream) recv() ([]byte, error) {
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	limit := s.client.MaxContentLength
+	if limit <= 0 {
+		limit = DefaultMaxContentLength
+	}
+	typ, payload, err := readFrame(s.body, limit)
+	switch {
+	case err == errFrameTooBig:
+		logging.Errorf(s.ctx, "Response message exceeds the size limit %d.", limit)
+		return nil, s.fail(ErrResponseTooBig)
+	case err == io.EOF:
+		return nil, s.fail(status.Errorf(codes.Internal, "prpc: the stream ended without a status"))
+	case err != nil:
+		return nil, s.fail(s.readErr(err))
+	case typ == frameMessage:
+		return payload, nil
+	case typ == frameStatus:
+		return nil, s.fail(s.finalStatus(payload))
+	default:
+		return nil, s.fail(status.Errorf(codes.Internal, "prpc: unexpected frame type %#x", typ))
+	}
+}
+
+// finalStatus parses the status frame and reads the trailers.
+//
+// Returns io.EOF if the status is OK.
+func (s *clientStream) finalStatus(payload []byte) error {
+	sp := &spb.Status{}
+	if err := unmarshalMessage(payload, sp, s.options.outFormat); err != nil {
+		return status.Errorf(codes.Internal, "prpc: failed to unmarshal the stream status: %s", err)
+	}
+
+	// Trailers are available only after the body is read till the end.
+	if _, err := io.Copy(io.Discard, s.body); err != nil {
+		return s.readErr(err)
+	}
+	trailer, err := headersIntoMetadata(s.res.Trailer)
+	if err != nil {
+		return status.Errorf(codes.Internal, "prpc: decoding trailers: %s", err)
+	}
+	s.trailer = trailer
+	if s.options.resTrailerMetadata != nil {
+		*s.options.resTrailerMetadata = trailer
+	}
+
+	if codes.Code(sp.Code) == codes.OK {
+		return io.EOF
+	}
+	return status.FromProto(sp).Err()
+}
+
+// readErr converts an error from reading the body into a gRPC error.
+func (s *clientStream) readErr(err error) error {
+	switch cerr := s.ctx.Err(); {
+	case cerr == context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, "prpc: overall deadline exceeded")
+	case cerr == context.Canceled:
+		return status.Error(codes.Canceled, "prpc: call canceled")
+	}
+	return status.Errorf(codeForErr(err), "prpc: reading response: %s", err)
+}
+
+// fail finishes the stream with the given error and returns it.
+func (s *clientStream) fail(err error) error {
+	s.close()
+	s.err = err
+	return err
+}
+
+// close releases resources held by the stream.
+func (s *clientStream) close() {
+	if s.res != nil {
+		s.res.Body.Close()
+	}
+	if s.release != nil {
+		s.release()
+		s.release = nil
+	}
+}
+
+// attemptStream makes one attempt at starting a server-streaming RPC.
+//
+// On success returns the response with the stream in its body and a callback
+// that must be called when the stream is closed.
+//
+// Returns gRPC errors.
+func (c *Client) attemptStream(ctx context.Context, options *Options, req *http.Request) (res *http.Response, release func(), err error) {
+	// Wait until there's an execution slot available. It is held until the
+	// stream is closed.
+	releaseSlot := func() {}
+	if sem := c.concurrencySem(); sem != nil {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			return nil, nil, status.FromContextError(err).Err()
+		}
+		releaseSlot = func() { sem.Release(1) }
+	}
+	defer func() {
+		if err != nil {
+			releaseSlot()
+		}
+	}()
+
+	// PerRPCTimeout is not applied to streams, since they may be long-lived.
+	// Propagate the overall deadline, if any.
+	if deadline, ok := ctx.Deadline(); ok {
+		delta := deadline.Sub(clock.Now(ctx))
+		if delta <= 0 {
+			return nil, nil, status.Error(codes.DeadlineExceeded, "prpc: attempt deadline exceeded")
+		}
+		logging.Debugf(ctx, "RPC %s/%s.%s [stream, deadline %s]", options.host, options.serviceName, options.methodName, delta)
+		req.Header.Set(HeaderTimeout, EncodeTimeout(delta))
+	} else {
+		logging.Debugf(ctx, "RPC %s/%s.%s [stream]", options.host, options.serviceName, options.methodName)
+		req.Header.Del(HeaderTimeout)
+	}
+
+	client := c.C
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	// Send the request. The response body is closed by the caller on success.
+	req.Body, _ = req.GetBody()
+	resp, err := client.Do(req.WithContext(ctx))
+	if c.testPostHTTP != nil {
+		err = c.testPostHTTP(ctx, err)
+	}
+	if err != nil {
+		return nil, nil, status.Errorf(codeForErr(err), "prpc: sending request: %s", err)
+	}
+
+	// If the server didn't accept the RPC, the response is a regular pRPC error
+	// response.
+	if resp.Header.Get(HeaderGRPCCode) != "0" {
+		defer func() {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+		buf := &bytes.Buffer{}
+		if err := c.readResponseBody(ctx, buf, resp); err != nil {
+			return nil, nil, err
+		}
+		if err := c.readStatus(resp, buf); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, status.Errorf(codes.Internal, "prpc: the server responded to a streaming RPC with a unary response")
+	}
+	return resp, releaseSlot, nil
+}
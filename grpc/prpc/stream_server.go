@@ -0,0 +1,234 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/server/router"
+)
+
+// callStream calls a server-streaming method.
+//
+// If the method fails before sending anything, puts the error into r.
+// Otherwise the response (including the final status) is already written when
+// callStream returns.
+func (s *Server) callStream(c *router.Context, service *service, desc grpc.StreamDesc, r *response) {
+	var perr *protocolError
+	r.fmt, perr = responseFormat(c.Request.Header.Get(headerAccept))
+	if perr != nil {
+		r.err = perr
+		return
+	}
+
+	methodCtx, cancelFunc, err := parseHeader(c.Context, c.Request.Header, c.Request.Host)
+	if err != nil {
+		r.err = protocolErr(codes.InvalidArgument, http.StatusBadRequest, "bad request headers: %s", err)
+		return
+	}
+	defer cancelFunc()
+
+	methodCtx = context.WithValue(methodCtx, &requestContextKey, &requestContext{header: c.Writer.Header()})
+
+	stream := &serverStream{
+		ctx:                  methodCtx,
+		w:                    c.Writer,
+		r:                    c.Request,
+		format:               r.fmt,
+		fixFieldMasksForJSON: s.HackFixFieldMasksForJSON,
+	}
+	if s.StreamServerInterceptor != nil {
+		info := &grpc.StreamServerInfo{
+			FullMethod:     "/" + c.Params.ByName("service") + "/" + desc.StreamName,
+			IsServerStream: true,
+		}
+		err = s.StreamServerInterceptor(service.impl, stream, info, desc.Handler)
+	} else {
+		err = desc.Handler(service.impl, stream)
+	}
+
+	if !stream.started && err != nil {
+		r.err = err
+		return
+	}
+	stream.finish(err)
+}
+
+// serverStream implements grpc.ServerStream on top of an HTTP response.
+//
+// Response messages are written as frames (see writeFrame) as soon as they
+// are sent. The stream ends with a frame with the final status.
+type serverStream struct {
+	ctx                  context.Context
+	w                    http.ResponseWriter
+	r                    *http.Request
+	format               Format
+	fixFieldMasksForJSON bool
+
+	received bool        // true if RecvMsg was called
+	started  bool        // true if the response header was written
+	trailer  metadata.MD // metadata to send in HTTP trailers
+}
+
+var _ grpc.ServerStream = (*serverStream)(nil)
+
+// SetHeader sets the header metadata.
+//
+// Fails if the header was already sent.
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	if s.started {
+		return status.Errorf(codes.Internal, "prpc: the response header was already sent")
+	}
+	if err := metaIntoHeaders(md, s.w.Header()); err != nil {
+		return status.Errorf(codes.Internal, "prpc: %s", err)
+	}
+	return nil
+}
+
+// SendHeader sends the header metadata.
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	s.start()
+	s.flush()
+	return nil
+}
+
+// SetTrailer sets the trailer metadata sent when the stream ends.
+func (s *serverStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+// Context returns the context of the stream.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// SendMsg sends a response message.
+func (s *serverStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad response type %T, not a proto", m)
+	}
+	blob, err := marshalMessage(msg, s.format, false)
+	if err != nil {
+		return status.Errorf(codes.Internal, "prpc: failed to marshal the response: %s", err)
+	}
+	s.start()
+	if err := writeFrame(s.w, frameMessage, blob); err != nil {
+		if cerr := s.ctx.Err(); cerr != nil {
+			return status.FromContextError(cerr).Err()
+		}
+		return status.Errorf(codes.Unavailable, "prpc: failed to write the response: %s", err)
+	}
+	s.flush()
+	return nil
+}
+
+// RecvMsg reads the request message.
+//
+// pRPC streams have exactly one request message. RecvMsg returns io.EOF when
+// called again.
+func (s *serverStream) RecvMsg(m interface{}) error {
+	if s.received {
+		return io.EOF
+	}
+	s.received = true
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return status.Errorf(codes.Internal, "prpc: bad request type %T, not a proto", m)
+	}
+	// Do not collapse it to one line. There is implicit err type conversion.
+	if perr := readMessage(s.r, msg, s.fixFieldMasksForJSON); perr != nil {
+		return perr
+	}
+	return nil
+}
+
+// start writes the response header if it wasn't written yet.
+func (s *serverStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	h := s.w.Header()
+	h.Set(HeaderGRPCCode, strconv.Itoa(int(codes.OK)))
+	h.Set(headerContentType, s.format.MediaType())
+	s.w.WriteHeader(http.StatusOK)
+	if s.format == FormatJSONPB {
+		io.WriteString(s.w, JSONPBPrefix)
+	}
+}
+
+// flush sends buffered data to the client.
+func (s *serverStream) flush() {
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish writes the trailers and the final status of the stream.
+//
+// err is the error returned by the handler.
+func (s *serverStream) finish(err error) {
+	s.start()
+
+	trailer := http.Header{}
+	if terr := metaIntoHeaders(s.trailer, trailer); terr != nil {
+		logging.Errorf(s.ctx, "prpc: failed to set trailers: %s", terr)
+	} else {
+		for k, v := range trailer {
+			s.w.Header()[http.TrailerPrefix+k] = v
+		}
+	}
+
+	st := status.New(codes.OK, "")
+	if err != nil {
+		var httpStatus int
+		st, httpStatus = errorStatus(err)
+		st = status.FromProto(&spb.Status{
+			Code:    int32(st.Code()),
+			Message: publicErrorMessage(s.ctx, st, httpStatus, err),
+			Details: st.Proto().Details,
+		})
+	}
+	blob, merr := marshalMessage(st.Proto(), s.format, false)
+	if merr != nil {
+		st = status.New(codes.Internal, "prpc: failed to write status details")
+		blob, merr = marshalMessage(st.Proto(), s.format, false)
+		if merr != nil {
+			panic(merr) // a status without details is always marshalable
+		}
+	}
+
+	if werr := writeFrame(s.w, frameStatus, blob); werr != nil {
+		// This error most commonly happens if the client disconnects. There is
+		// nothing more we can do other than log it.
+		logging.Warningf(s.ctx, "prpc: failed to write the stream status: %s", werr)
+	}
+}
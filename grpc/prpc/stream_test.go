@@ -0,0 +1,67 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prpc
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFrames(t *testing.T) {
+	t.Parallel()
+
+	Convey("Frames", t, func() {
+		buf := &bytes.Buffer{}
+		So(writeFrame(buf, frameMessage, []byte("hello")), ShouldBeNil)
+		So(writeFrame(buf, frameStatus, nil), ShouldBeNil)
+		So(buf.Bytes(), ShouldResemble, []byte{
+			0x00, 0, 0, 0, 5, 'h', 'e', 'l', 'l', 'o',
+			0x80, 0, 0, 0, 0,
+		})
+
+		Convey("Round trip", func() {
+			typ, payload, err := readFrame(buf, 10)
+			So(err, ShouldBeNil)
+			So(typ, ShouldEqual, frameMessage)
+			So(string(payload), ShouldEqual, "hello")
+
+			typ, payload, err = readFrame(buf, 10)
+			So(err, ShouldBeNil)
+			So(typ, ShouldEqual, frameStatus)
+			So(payload, ShouldHaveLength, 0)
+
+			_, _, err = readFrame(buf, 10)
+			So(err, ShouldEqual, io.EOF)
+		})
+
+		Convey("Too big", func() {
+			_, _, err := readFrame(buf, 4)
+			So(err, ShouldEqual, errFrameTooBig)
+		})
+
+		Convey("Truncated payload", func() {
+			_, _, err := readFrame(bytes.NewReader(buf.Bytes()[:7]), 10)
+			So(err, ShouldEqual, io.ErrUnexpectedEOF)
+		})
+
+		Convey("Truncated header", func() {
+			_, _, err := readFrame(bytes.NewReader(buf.Bytes()[:3]), 10)
+			So(err, ShouldEqual, io.ErrUnexpectedEOF)
+		})
+	})
+}
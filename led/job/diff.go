@@ -0,0 +1,209 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+	swarmingpb "go.chromium.org/luci/swarming/proto/api"
+)
+
+// DifferenceKind tells whether an entry was added, removed or changed.
+type DifferenceKind string
+
+// All the possible values of DifferenceKind.
+const (
+	Added   DifferenceKind = "added"
+	Removed DifferenceKind = "removed"
+	Changed DifferenceKind = "changed"
+)
+
+// Difference is a single semantic difference between two job Definitions.
+type Difference struct {
+	// Kind tells whether the entry was added, removed or changed.
+	Kind DifferenceKind `json:"kind"`
+
+	// Field is the aspect of the job which differs, e.g. "property" or
+	// "dimension".
+	Field string `json:"field"`
+
+	// Key identifies the differing entry within Field (e.g. the property name).
+	// Empty for single-valued fields.
+	Key string `json:"key,omitempty"`
+
+	// Old is the value in the first Definition, empty if Added.
+	Old string `json:"old,omitempty"`
+
+	// New is the value in the second Definition, empty if Removed.
+	New string `json:"new,omitempty"`
+}
+
+func (d Difference) String() string {
+	name := d.Field
+	if d.Key != "" {
+		name = fmt.Sprintf("%s[%q]", d.Field, d.Key)
+	}
+	switch d.Kind {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", name, d.New)
+	case Removed:
+		return fmt.Sprintf("- %s: %s", name, d.Old)
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", name, d.Old, d.New)
+	}
+}
+
+// Diff semantically compares two job Definitions.
+//
+// It compares the swarming host, task name, dimensions, CIPD packages,
+// environment and CAS inputs of both jobs and, if both are Buildbucket jobs,
+// their input properties and experiments as well.
+//
+// The returned differences are sorted by field, then by key.
+func Diff(a, b *Definition) ([]Difference, error) {
+	aInfo, bInfo := a.Info(), b.Info()
+	if aInfo == nil || bInfo == nil {
+		return nil, errors.New("both job Definitions must be either Buildbucket or Swarming jobs")
+	}
+
+	var ret []Difference
+	add := func(field, old, new string) {
+		if old != new {
+			ret = append(ret, Difference{Kind: Changed, Field: field, Old: old, New: new})
+		}
+	}
+	addMap := func(field string, old, new map[string]string) {
+		ret = append(ret, diffMaps(field, old, new)...)
+	}
+
+	add("type", jobType(a), jobType(b))
+	add("swarming_host", aInfo.SwarmingHostname(), bInfo.SwarmingHostname())
+	add("task_name", aInfo.TaskName(), bInfo.TaskName())
+
+	type getter struct {
+		field string
+		get   func(Info) (map[string]string, error)
+	}
+	getters := []getter{
+		{"dimension", func(i Info) (map[string]string, error) {
+			dims, err := i.Dimensions()
+			return flattenDimensions(dims), err
+		}},
+		{"cipd_package", func(i Info) (map[string]string, error) {
+			pkgs, err := i.CIPDPkgs()
+			return pkgs, err
+		}},
+		{"env", Info.Env},
+		{"cas_input", func(i Info) (map[string]string, error) {
+			cas, err := i.CurrentIsolated()
+			if err != nil || cas.GetDigest().GetHash() == "" {
+				return nil, err
+			}
+			return map[string]string{"": formatCASReference(cas)}, nil
+		}},
+	}
+	for _, g := range getters {
+		old, err := g.get(aInfo)
+		if err != nil {
+			return nil, errors.Annotate(err, "reading %s of the first job", g.field).Err()
+		}
+		new, err := g.get(bInfo)
+		if err != nil {
+			return nil, errors.Annotate(err, "reading %s of the second job", g.field).Err()
+		}
+		addMap(g.field, old, new)
+	}
+
+	if aHL, bHL := a.HighLevelInfo(), b.HighLevelInfo(); aHL != nil && bHL != nil {
+		old, err := aHL.Properties()
+		if err != nil {
+			return nil, errors.Annotate(err, "reading properties of the first job").Err()
+		}
+		new, err := bHL.Properties()
+		if err != nil {
+			return nil, errors.Annotate(err, "reading properties of the second job").Err()
+		}
+		addMap("property", old, new)
+		addMap("experiment", stringSet(aHL.Experiments()), stringSet(bHL.Experiments()))
+		add("experimental", fmt.Sprint(aHL.Experimental()), fmt.Sprint(bHL.Experimental()))
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Field != ret[j].Field {
+			return ret[i].Field < ret[j].Field
+		}
+		return ret[i].Key < ret[j].Key
+	})
+	return ret, nil
+}
+
+func diffMaps(field string, old, new map[string]string) (ret []Difference) {
+	for key, oldVal := range old {
+		switch newVal, ok := new[key]; {
+		case !ok:
+			ret = append(ret, Difference{Kind: Removed, Field: field, Key: key, Old: oldVal})
+		case newVal != oldVal:
+			ret = append(ret, Difference{Kind: Changed, Field: field, Key: key, Old: oldVal, New: newVal})
+		}
+	}
+	for key, newVal := range new {
+		if _, ok := old[key]; !ok {
+			ret = append(ret, Difference{Kind: Added, Field: field, Key: key, New: newVal})
+		}
+	}
+	return
+}
+
+func jobType(jd *Definition) string {
+	if jd.GetBuildbucket() != nil {
+		return "buildbucket"
+	}
+	return "swarming"
+}
+
+// flattenDimensions renders each dimension as a single comma-separated
+// string of `value[@expiration_secs]`, in the order returned by
+// Info.Dimensions.
+func flattenDimensions(dims ExpiringDimensions) map[string]string {
+	ret := make(map[string]string, len(dims))
+	for key, values := range dims {
+		bits := make([]string, len(values))
+		for i, value := range values {
+			if value.Expiration == 0 {
+				bits[i] = value.Value
+			} else {
+				bits[i] = fmt.Sprintf("%s@%d", value.Value, value.Expiration/time.Second)
+			}
+		}
+		ret[key] = strings.Join(bits, ",")
+	}
+	return ret
+}
+
+func formatCASReference(ref *swarmingpb.CASReference) string {
+	return fmt.Sprintf("%s/%s/%d", ref.CasInstance, ref.Digest.Hash, ref.Digest.SizeBytes)
+}
+
+func stringSet(items []string) map[string]string {
+	ret := make(map[string]string, len(items))
+	for _, item := range items {
+		ret[item] = "enabled"
+	}
+	return ret
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+
+	api "go.chromium.org/luci/swarming/proto/api"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Diff`, t, func() {
+		Convey(`bb`, func() {
+			oldJob := testBBJob(false)
+			SoHLEdit(oldJob, func(je HighLevelEditor) {
+				je.Properties(map[string]string{
+					"same":    `"value"`,
+					"changed": `1`,
+					"removed": `true`,
+				}, false)
+				je.Experiments(map[string]bool{"luci.old": true})
+			})
+			SoEdit(oldJob, func(je Editor) {
+				je.CIPDPkgs(CIPDPkgs{"some/pkg": "latest"})
+			})
+
+			newJob := proto.Clone(oldJob).(*Definition)
+			So(must(Diff(oldJob, newJob)), ShouldBeEmpty)
+
+			SoHLEdit(newJob, func(je HighLevelEditor) {
+				je.Properties(map[string]string{
+					"changed": `2`,
+					"removed": ``,
+					"added":   `{"a": "b"}`,
+				}, false)
+				je.Experiments(map[string]bool{"luci.old": false, "luci.new": true})
+			})
+			SoEdit(newJob, func(je Editor) {
+				je.CIPDPkgs(CIPDPkgs{"some/pkg": "stable"})
+				je.Env(map[string]string{"KEY": "value"})
+			})
+
+			So(must(Diff(oldJob, newJob)), ShouldResemble, []Difference{
+				{Kind: Changed, Field: "cipd_package", Key: "some/pkg", Old: "latest", New: "stable"},
+				{Kind: Added, Field: "env", Key: "KEY", New: "value"},
+				{Kind: Added, Field: "experiment", Key: "luci.new", New: "enabled"},
+				{Kind: Removed, Field: "experiment", Key: "luci.old", Old: "enabled"},
+				{Kind: Added, Field: "property", Key: "added", New: `{"a":"b"}`},
+				{Kind: Changed, Field: "property", Key: "changed", Old: "1", New: "2"},
+				{Kind: Removed, Field: "property", Key: "removed", Old: "true"},
+			})
+		})
+
+		Convey(`sw`, func() {
+			oldJob := testSWJob(swSlice1Exp)
+			SoEdit(oldJob, func(je Editor) {
+				je.SetDimensions(ExpiringDimensions{
+					"os": []ExpiringValue{{Value: "Linux"}},
+				})
+			})
+			newJob := proto.Clone(oldJob).(*Definition)
+			SoEdit(newJob, func(je Editor) {
+				je.SetDimensions(ExpiringDimensions{
+					"os":   []ExpiringValue{{Value: "Mac"}},
+					"pool": []ExpiringValue{{Value: "luci.pool"}},
+				})
+			})
+			newJob.GetSwarming().CasUserPayload = &api.CASReference{
+				CasInstance: "projects/p/instances/default_instance",
+				Digest:      &api.Digest{Hash: "hash", SizeBytes: 10},
+			}
+
+			So(must(Diff(oldJob, newJob)), ShouldResemble, []Difference{
+				{Kind: Added, Field: "cas_input", New: "projects/p/instances/default_instance/hash/10"},
+				{Kind: Changed, Field: "dimension", Key: "os", Old: "Linux@60", New: "Mac@60"},
+				{Kind: Added, Field: "dimension", Key: "pool", New: "luci.pool@60"},
+			})
+		})
+
+		Convey(`different types`, func() {
+			bbJob := testBBJob(false)
+			SoEdit(bbJob, func(je Editor) {
+				je.Env(map[string]string{"KEY": "value"})
+			})
+			diffs := must(Diff(bbJob, testSWJob())).([]Difference)
+			So(diffs[len(diffs)-1], ShouldResemble, Difference{
+				Kind: Changed, Field: "type", Old: "buildbucket", New: "swarming",
+			})
+		})
+
+		Convey(`empty values`, func() {
+			So(diffMaps("env", map[string]string{"K": "v"}, map[string]string{"K": ""}), ShouldResemble, []Difference{
+				{Kind: Changed, Field: "env", Key: "K", Old: "v"},
+			})
+			So(diffMaps("env", nil, map[string]string{"K": ""}), ShouldResemble, []Difference{
+				{Kind: Added, Field: "env", Key: "K"},
+			})
+			So(diffMaps("env", map[string]string{"K": ""}, nil), ShouldResemble, []Difference{
+				{Kind: Removed, Field: "env", Key: "K"},
+			})
+		})
+
+		Convey(`String`, func() {
+			So(Difference{Kind: Added, Field: "env", Key: "K", New: "v"}.String(), ShouldEqual, `+ env["K"]: v`)
+			So(Difference{Kind: Removed, Field: "task_name", Old: "a"}.String(), ShouldEqual, `- task_name: a`)
+			So(Difference{Kind: Changed, Field: "task_name", Old: "a", New: "b"}.String(), ShouldEqual, `~ task_name: a -> b`)
+			So(Difference{Kind: Changed, Field: "env", Key: "K", Old: "a"}.String(), ShouldEqual, `~ env["K"]: a -> `)
+		})
+	})
+}
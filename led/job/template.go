@@ -0,0 +1,156 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// placeholderRe matches template placeholders of the form `${led:name}`, as
+// well as escaped ones of the form `$${led:name}`.
+//
+// The `led:` prefix keeps placeholders from colliding with `${...}` and
+// `{{...}}` syntaxes commonly found in recipe properties.
+var placeholderRe = regexp.MustCompile(`(\$?)\$\{led:([A-Za-z_][A-Za-z0-9_.-]*)\}`)
+
+// Placeholders returns the sorted, deduplicated names of all `${led:name}`
+// placeholders found in the string values of the Definition.
+//
+// Escaped placeholders (`$${led:name}`) are not included.
+func (jd *Definition) Placeholders() []string {
+	names := map[string]struct{}{}
+	walkStrings(jd.ProtoReflect(), func(s string) string {
+		for _, m := range placeholderRe.FindAllStringSubmatch(s, -1) {
+			if m[1] == "" {
+				names[m[2]] = struct{}{}
+			}
+		}
+		return s
+	})
+	ret := make([]string, 0, len(names))
+	for name := range names {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Instantiate replaces every `${led:name}` placeholder in the string values
+// of the Definition (including string values in input properties) with
+// params[name], and unescapes every `$${led:name}` into a literal
+// `${led:name}`.
+//
+// This allows saving a Definition as a template and instantiating it
+// repeatedly with different parameters.
+//
+// Returns an error (and leaves the Definition untouched) if some placeholder
+// has no value in params, or if some param doesn't match any placeholder.
+func (jd *Definition) Instantiate(params map[string]string) error {
+	placeholders := jd.Placeholders()
+
+	var missing, unused []string
+	used := make(map[string]bool, len(placeholders))
+	for _, name := range placeholders {
+		used[name] = true
+		if _, ok := params[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	for name := range params {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+
+	var merr errors.MultiError
+	if len(missing) > 0 {
+		merr = append(merr, errors.Reason("no value for placeholders: %s", strings.Join(missing, ", ")).Err())
+	}
+	if len(unused) > 0 {
+		merr = append(merr, errors.Reason("unknown parameters: %s", strings.Join(unused, ", ")).Err())
+	}
+	if len(merr) > 0 {
+		return merr
+	}
+
+	walkStrings(jd.ProtoReflect(), func(s string) string {
+		return placeholderRe.ReplaceAllStringFunc(s, func(match string) string {
+			if m := placeholderRe.FindStringSubmatch(match); m[1] == "" {
+				return params[m[2]]
+			}
+			return match[1:]
+		})
+	})
+	return nil
+}
+
+// walkStrings calls `cb` on every string value (including list elements and
+// map values) in `msg` and its submessages, replacing the value with the
+// result of `cb` if it differs.
+func walkStrings(msg protoreflect.Message, cb func(string) string) {
+	type field struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+	// Collect the fields first, since msg must not be mutated inside Range.
+	var fields []field
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, field{fd, v})
+		return true
+	})
+
+	visit := func(fd protoreflect.FieldDescriptor, v protoreflect.Value, set func(protoreflect.Value)) {
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			s := v.String()
+			if out := cb(s); out != s {
+				set(protoreflect.ValueOfString(out))
+			}
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			walkStrings(v.Message(), cb)
+		}
+	}
+
+	for _, f := range fields {
+		switch {
+		case f.fd.IsList():
+			l := f.v.List()
+			for i := 0; i < l.Len(); i++ {
+				i := i
+				visit(f.fd, l.Get(i), func(v protoreflect.Value) { l.Set(i, v) })
+			}
+		case f.fd.IsMap():
+			mp := f.v.Map()
+			var keys []protoreflect.MapKey
+			mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			for _, k := range keys {
+				k := k
+				visit(f.fd.MapValue(), mp.Get(k), func(v protoreflect.Value) { mp.Set(k, v) })
+			}
+		default:
+			visit(f.fd, f.v, func(v protoreflect.Value) { msg.Set(f.fd, v) })
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestInstantiate(t *testing.T) {
+	t.Parallel()
+
+	Convey(`Instantiate`, t, func() {
+		jd := testBBJob(false)
+		SoHLEdit(jd, func(je HighLevelEditor) {
+			je.Properties(map[string]string{
+				"bug":    `"crbug.com/${led:bug}"`,
+				"nested": `{"list": ["${led:revision}", "fixed"]}`,
+				"other":  `"{{bug}} ${bug} $${led:escaped}"`,
+			}, false)
+		})
+		SoEdit(jd, func(je Editor) {
+			je.Env(map[string]string{"REVISION": "${led:revision}"})
+		})
+
+		So(jd.Placeholders(), ShouldResemble, []string{"bug", "revision"})
+
+		Convey(`ok`, func() {
+			So(jd.Instantiate(map[string]string{
+				"bug":      "123",
+				"revision": "deadbeef",
+			}), ShouldBeNil)
+			// The unescaped placeholder is left for a later instantiation.
+			So(jd.Placeholders(), ShouldResemble, []string{"escaped"})
+			So(must(jd.HighLevelInfo().Properties()), ShouldResemble, map[string]string{
+				"bug":    `"crbug.com/123"`,
+				"nested": `{"list":["deadbeef","fixed"]}`,
+				"other":  `"{{bug}} ${bug} ${led:escaped}"`,
+			})
+			So(must(jd.Info().Env()), ShouldResemble, map[string]string{
+				"REVISION": "deadbeef",
+			})
+		})
+
+		Convey(`missing`, func() {
+			So(jd.Instantiate(map[string]string{"bug": "123"}),
+				ShouldErrLike, "no value for placeholders: revision")
+			So(jd.Placeholders(), ShouldHaveLength, 2)
+		})
+
+		Convey(`unknown`, func() {
+			So(jd.Instantiate(map[string]string{
+				"bug":      "123",
+				"revision": "deadbeef",
+				"typo":     "",
+			}), ShouldErrLike, "unknown parameters: typo")
+		})
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledcli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/led/job"
+)
+
+func diffCmd(opts cmdBaseOptions) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "diff <old.json> <new.json>",
+		ShortDesc: "semantically compares two JobDefinitions",
+		LongDesc: `Compares two JobDefinitions and prints their differences.
+
+Rather than comparing the JSON documents textually, this compares the parts of
+the jobs which matter when launching them: dimensions, CIPD packages,
+environment, CAS inputs and, for buildbucket jobs, input properties and
+experiments.
+
+Each difference is printed on its own line, prefixed with '+' (only in
+new.json), '-' (only in old.json) or '~' (changed).
+
+Example:
+
+led get-builder ... > old.json
+led edit -p something=[100] < old.json > new.json
+led diff old.json new.json
+`,
+
+		CommandRun: func() subcommands.CommandRun {
+			ret := &cmdDiff{}
+			ret.initFlags(opts)
+			return ret
+		},
+	}
+}
+
+type cmdDiff struct {
+	cmdBase
+
+	asJSON bool
+
+	oldPath string
+	newPath string
+}
+
+func (c *cmdDiff) initFlags(opts cmdBaseOptions) {
+	c.Flags.BoolVar(&c.asJSON, "json", false,
+		"Write the differences to stdout as a JSON list instead of text.")
+	c.cmdBase.initFlags(opts)
+}
+
+func (c *cmdDiff) jobInput() bool                  { return false }
+func (c *cmdDiff) positionalRange() (min, max int) { return 2, 2 }
+
+func (c *cmdDiff) validateFlags(ctx context.Context, positionals []string, _ subcommands.Env) error {
+	c.oldPath, c.newPath = positionals[0], positionals[1]
+	return nil
+}
+
+func (c *cmdDiff) execute(ctx context.Context, _ *http.Client, _ auth.Options, _ *job.Definition) (out interface{}, err error) {
+	oldJob, err := readJobDefinitionFile(c.oldPath)
+	if err != nil {
+		return nil, err
+	}
+	newJob, err := readJobDefinitionFile(c.newPath)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := job.Diff(oldJob, newJob)
+	if err != nil {
+		return nil, err
+	}
+	if c.asJSON {
+		if diffs == nil {
+			diffs = []job.Difference{}
+		}
+		return diffs, nil
+	}
+	for _, d := range diffs {
+		fmt.Println(d)
+	}
+	return nil, nil
+}
+
+func (c *cmdDiff) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	return c.doContextExecute(a, c, args, env)
+}
+
+func readJobDefinitionFile(path string) (*job.Definition, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "opening %q", path).Err()
+	}
+	defer f.Close()
+
+	jd := &job.Definition{}
+	if err := jsonpb.Unmarshal(f, jd); err != nil {
+		return nil, errors.Annotate(err, "decoding job Definition from %q", path).Err()
+	}
+	return jd, nil
+}
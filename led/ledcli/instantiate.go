@@ -0,0 +1,82 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledcli
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/flag/stringmapflag"
+	"go.chromium.org/luci/led/job"
+)
+
+func instantiateCmd(opts cmdBaseOptions) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "instantiate [-p key=value]*",
+		ShortDesc: "fills in the placeholders of a JobDefinition template",
+		LongDesc: `Instantiates a JobDefinition template.
+
+A template is a JobDefinition (e.g. one saved from 'led get-builder' and edited
+by hand) where some string values contain placeholders of the form
+${led:name}. Placeholders may appear in any string value of the JobDefinition,
+including string values within input properties. To keep a literal
+${led:name} in the instantiated JobDefinition, write it as $${led:name}.
+
+Every placeholder must be given a value with -p, and every -p must match some
+placeholder.
+
+Example:
+
+led instantiate -p bug=1234 -p revision=deadbeef < template.json |
+  led launch
+`,
+
+		CommandRun: func() subcommands.CommandRun {
+			ret := &cmdInstantiate{}
+			ret.initFlags(opts)
+			return ret
+		},
+	}
+}
+
+type cmdInstantiate struct {
+	cmdBase
+
+	params stringmapflag.Value
+}
+
+func (c *cmdInstantiate) initFlags(opts cmdBaseOptions) {
+	c.Flags.Var(&c.params, "p",
+		"(repeatable) set the value of a template placeholder. This takes a parameter of `name=value`.")
+	c.cmdBase.initFlags(opts)
+}
+
+func (c *cmdInstantiate) jobInput() bool                  { return true }
+func (c *cmdInstantiate) positionalRange() (min, max int) { return 0, 0 }
+
+func (c *cmdInstantiate) validateFlags(ctx context.Context, _ []string, _ subcommands.Env) error {
+	return nil
+}
+
+func (c *cmdInstantiate) execute(ctx context.Context, _ *http.Client, _ auth.Options, inJob *job.Definition) (out interface{}, err error) {
+	return inJob, inJob.Instantiate(c.params)
+}
+
+func (c *cmdInstantiate) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	return c.doContextExecute(a, c, args, env)
+}
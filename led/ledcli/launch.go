@@ -46,6 +46,11 @@ led get-builder ... |
 
 If stdout is not a tty (e.g. a file), this command writes a JSON object
 containing information about the launched task to stdout.
+
+With -local, the job is not sent to swarming. Instead its CAS inputs and CIPD
+packages are materialized into a work directory on this machine and the task
+command is run there with the task environment. Swarming dimensions and named
+caches are ignored.
 `,
 
 		CommandRun: func() subcommands.CommandRun {
@@ -64,6 +69,8 @@ type cmdLaunch struct {
 	noLEDTag  bool
 	resultdb  job.RDBEnablement
 	realBuild bool
+	local     bool
+	workDir   string
 }
 
 func (c *cmdLaunch) initFlags(opts cmdBaseOptions) {
@@ -78,6 +85,12 @@ func (c *cmdLaunch) initFlags(opts cmdBaseOptions) {
 		 If unspecified, resultdb will be enabled if the original build had resultdb enabled.`))
 	c.Flags.BoolVar(&c.realBuild, "real-build", false,
 		"Launch a real buildbucket build instead of a raw swarming task.")
+	c.Flags.BoolVar(&c.local, "local", false,
+		"Run the job on this machine instead of launching it on swarming.")
+	c.Flags.StringVar(&c.workDir, "work-dir", "", text.Doc(`
+		With -local, the directory to materialize and run the job in. It must be
+		empty or not exist, and is kept afterwards. If unspecified, a temporary
+		directory is used and removed when the job completes.`))
 	c.cmdBase.initFlags(opts)
 }
 
@@ -85,10 +98,18 @@ func (c *cmdLaunch) jobInput() bool                  { return true }
 func (c *cmdLaunch) positionalRange() (min, max int) { return 0, 0 }
 
 func (c *cmdLaunch) validateFlags(ctx context.Context, _ []string, _ subcommands.Env) (err error) {
+	switch {
+	case c.local && c.dump:
+		return errors.New("-local and -dump are mutually exclusive")
+	case c.local && c.realBuild:
+		return errors.New("-local and -real-build are mutually exclusive")
+	case c.workDir != "" && !c.local:
+		return errors.New("-work-dir requires -local")
+	}
 	return
 }
 
-func (c *cmdLaunch) execute(ctx context.Context, authClient *http.Client, _ auth.Options, inJob *job.Definition) (out interface{}, err error) {
+func (c *cmdLaunch) execute(ctx context.Context, authClient *http.Client, authOpts auth.Options, inJob *job.Definition) (out interface{}, err error) {
 	uid, err := ledcmd.GetUID(ctx, c.authenticator)
 	if err != nil {
 		return nil, err
@@ -105,6 +126,14 @@ func (c *cmdLaunch) execute(ctx context.Context, authClient *http.Client, _ auth
 		}
 	}
 
+	if c.local {
+		return nil, ledcmd.LaunchLocal(ctx, authOpts, inJob, ledcmd.LaunchLocalOpts{
+			UserID:         uid,
+			WorkDir:        c.workDir,
+			KitchenSupport: c.kitchenSupport,
+		})
+	}
+
 	task, meta, err := ledcmd.LaunchSwarming(ctx, authClient, inJob, ledcmd.LaunchSwarmingOpts{
 		DryRun:          c.dump,
 		UserID:          uid,
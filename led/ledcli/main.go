@@ -151,9 +151,12 @@ The spec (as it is) for JobDefinition is at:
 			// commands to edit the raw isolated files.
 			editIsolated(defaults),
 
+			// commands to inspect and reuse JobDescriptions.
+			diffCmd(defaults),
+			instantiateCmd(defaults),
+
 			// commands to launch swarming tasks.
 			launchCmd(defaults),
-			// TODO(iannucci): launch-local to launch locally
 			// TODO(iannucci): launch-buildbucket to launch on buildbucket
 
 			{}, // spacer
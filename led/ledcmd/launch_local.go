@@ -0,0 +1,244 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledcmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/cipd/client/cipd"
+	"go.chromium.org/luci/cipd/client/cipd/ensure"
+	"go.chromium.org/luci/cipd/client/cipd/template"
+	"go.chromium.org/luci/client/casclient"
+	clientswarming "go.chromium.org/luci/client/swarming"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/system/environ"
+	apipb "go.chromium.org/luci/swarming/proto/api"
+
+	"go.chromium.org/luci/led/job"
+)
+
+// LaunchLocalOpts are the options for LaunchLocal.
+type LaunchLocalOpts struct {
+	// Must be a unique user identity string and must not be empty.
+	//
+	// See LaunchSwarmingOpts.UserID.
+	UserID string
+
+	// The directory to materialize the task into. It is created if it doesn't
+	// exist and must be empty otherwise.
+	//
+	// If empty, a temporary directory is used and removed after the task
+	// completes.
+	WorkDir string
+
+	KitchenSupport job.KitchenSupport
+}
+
+// LaunchLocal runs the given job Definition directly on this machine instead
+// of on swarming.
+//
+// The job is flattened to a swarming task exactly as LaunchSwarming would do.
+// Then the CAS inputs and CIPD packages of the first task slice are
+// materialized into a work directory and the task command is executed there
+// with the task environment. Dimensions, named caches and other bot-specific
+// parts of the task are ignored.
+func LaunchLocal(ctx context.Context, authOpts auth.Options, jd *job.Definition, opts LaunchLocalOpts) error {
+	if opts.KitchenSupport == nil {
+		opts.KitchenSupport = job.NoKitchenSupport()
+	}
+	if opts.UserID == "" {
+		return errors.New("opts.UserID is empty")
+	}
+
+	logging.Infof(ctx, "building swarming task")
+	if err := jd.FlattenToSwarming(ctx, opts.UserID, "", opts.KitchenSupport, job.RDBOff); err != nil {
+		return errors.Annotate(err, "failed to flatten job definition to swarming").Err()
+	}
+	sw := jd.GetSwarming()
+	slices := sw.GetTask().GetTaskSlices()
+	if len(slices) == 0 {
+		return errors.New("swarming task has no slices")
+	}
+	props := slices[0].GetProperties()
+	if len(props.GetCommand()) == 0 {
+		return errors.New("swarming task has no command")
+	}
+	logging.Infof(ctx, "building swarming task: done")
+
+	workDir := opts.WorkDir
+	if workDir == "" {
+		tdir, err := ioutil.TempDir("", "led-launch-local")
+		if err != nil {
+			return errors.Annotate(err, "failed to create tempdir").Err()
+		}
+		defer func() {
+			if err := os.RemoveAll(tdir); err != nil {
+				logging.Errorf(ctx, "failed to cleanup temp dir %q: %s", tdir, err)
+			}
+		}()
+		workDir = tdir
+	}
+	workDir, err := prepareWorkDir(workDir)
+	if err != nil {
+		return err
+	}
+	rootDir := filepath.Join(workDir, "w")
+	outDir := filepath.Join(workDir, "out")
+	for _, dir := range []string{rootDir, outDir} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return errors.Annotate(err, "failed to create directory %q", dir).Err()
+		}
+	}
+	logging.Infof(ctx, "materializing task in %q", workDir)
+
+	casRef := props.GetCasInputRoot()
+	if casRef.GetDigest().GetHash() == "" {
+		casRef = sw.GetCasUserPayload()
+	}
+	if casRef.GetDigest().GetHash() != "" {
+		casClient, err := casclient.NewLegacy(ctx, casclient.AddrProd, casRef.GetCasInstance(), authOpts, true)
+		if err != nil {
+			return errors.Annotate(err, "failed to create CAS client").Err()
+		}
+		err = downloadFromCas(ctx, casRef, casClient, rootDir)
+		casClient.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	if pkgs := props.GetCipdInputs(); len(pkgs) > 0 {
+		logging.Infof(ctx, "installing CIPD packages...")
+		if err := ensureCIPDPackages(ctx, rootDir, pkgs); err != nil {
+			return err
+		}
+	}
+
+	cmd, err := localTaskCommand(ctx, props, rootDir, outDir)
+	if err != nil {
+		return err
+	}
+
+	logging.Infof(ctx, "running %q in %q", cmd.Args, cmd.Dir)
+	if err := cmd.Run(); err != nil {
+		return errors.Annotate(err, "running local task").Err()
+	}
+	logging.Infof(ctx, "local task: done")
+	return nil
+}
+
+// prepareWorkDir creates the work directory if it doesn't exist and returns its
+// absolute path.
+//
+// Refuses to use a non-empty directory, since it may contain unrelated files
+// of the user.
+func prepareWorkDir(workDir string) (string, error) {
+	workDir, err := filepath.Abs(workDir)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to get absolute path of %q", workDir).Err()
+	}
+	entries, err := ioutil.ReadDir(workDir)
+	switch {
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(workDir, 0700); err != nil {
+			return "", errors.Annotate(err, "failed to create work dir %q", workDir).Err()
+		}
+	case err != nil:
+		return "", errors.Annotate(err, "failed to read work dir %q", workDir).Err()
+	case len(entries) != 0:
+		return "", errors.Reason("work dir %q is not empty", workDir).Err()
+	}
+	return workDir, nil
+}
+
+// ensureCIPDPackages installs the given CIPD packages into rootDir.
+func ensureCIPDPackages(ctx context.Context, rootDir string, pkgs []*apipb.CIPDPackage) error {
+	bySubdir := map[string]ensure.PackageSlice{}
+	for _, pkg := range pkgs {
+		// CIPD deals with the root as "".
+		subdir := pkg.GetDestPath()
+		if subdir == "." {
+			subdir = ""
+		}
+		bySubdir[subdir] = append(bySubdir[subdir], ensure.PackageDef{
+			PackageTemplate:   pkg.GetPackageName(),
+			UnresolvedVersion: pkg.GetVersion(),
+		})
+	}
+
+	client, err := cipd.NewClientFromEnv(ctx, cipd.ClientOptions{Root: rootDir})
+	if err != nil {
+		return errors.Annotate(err, "failed to create CIPD client").Err()
+	}
+	defer client.Close(ctx)
+
+	resolver := cipd.Resolver{Client: client}
+	resolved, err := resolver.Resolve(ctx, &ensure.File{
+		ServiceURL:       client.Options().ServiceURL,
+		PackagesBySubdir: bySubdir,
+	}, template.DefaultExpander())
+	if err != nil {
+		return errors.Annotate(err, "failed to resolve CIPD package versions").Err()
+	}
+	if _, err := client.EnsurePackages(ctx, resolved.PackagesBySubdir, &cipd.EnsureOptions{
+		Paranoia: resolved.ParanoidMode,
+	}); err != nil {
+		return errors.Annotate(err, "failed to install CIPD packages").Err()
+	}
+	return nil
+}
+
+// localTaskCommand returns the command to run the task with the given
+// properties, materialized in rootDir.
+func localTaskCommand(ctx context.Context, props *apipb.TaskProperties, rootDir, outDir string) (*exec.Cmd, error) {
+	env := environ.FromCtx(ctx)
+	for _, pair := range props.GetEnv() {
+		if pair.Value == "" {
+			env.Remove(pair.Key)
+		} else {
+			env.Set(pair.Key, pair.Value)
+		}
+	}
+	for _, prefix := range props.GetEnvPaths() {
+		paths := make([]string, 0, len(prefix.Values)+1)
+		for _, value := range prefix.Values {
+			paths = append(paths, filepath.Join(rootDir, value))
+		}
+		if cur, ok := env.Lookup(prefix.Key); ok {
+			paths = append(paths, cur)
+		}
+		env.Set(prefix.Key, strings.Join(paths, string(os.PathListSeparator)))
+	}
+
+	args, err := clientswarming.ProcessCommand(ctx, props.GetCommand(), outDir, "")
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to process task command").Err()
+	}
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = env.Sorted()
+	cmd.Dir = filepath.Join(rootDir, props.GetRelativeCwd())
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd, nil
+}
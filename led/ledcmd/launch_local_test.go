@@ -0,0 +1,115 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ledcmd
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.chromium.org/luci/auth"
+	"go.chromium.org/luci/common/system/environ"
+	apipb "go.chromium.org/luci/swarming/proto/api"
+
+	"go.chromium.org/luci/led/job"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestLaunchLocal(t *testing.T) {
+	t.Parallel()
+
+	Convey(`LaunchLocal`, t, func() {
+		ctx := context.Background()
+
+		Convey(`requires UserID`, func() {
+			err := LaunchLocal(ctx, auth.Options{}, &job.Definition{}, LaunchLocalOpts{})
+			So(err, ShouldErrLike, "opts.UserID is empty")
+		})
+
+		Convey(`prepareWorkDir`, func() {
+			tmp, err := ioutil.TempDir("", "led-launch-local-test")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(tmp)
+
+			Convey(`creates missing directory`, func() {
+				dir, err := prepareWorkDir(filepath.Join(tmp, "a", "b"))
+				So(err, ShouldBeNil)
+				So(filepath.IsAbs(dir), ShouldBeTrue)
+				info, err := os.Stat(dir)
+				So(err, ShouldBeNil)
+				So(info.IsDir(), ShouldBeTrue)
+			})
+
+			Convey(`accepts empty directory`, func() {
+				dir, err := prepareWorkDir(tmp)
+				So(err, ShouldBeNil)
+				So(dir, ShouldEqual, tmp)
+			})
+
+			Convey(`refuses non-empty directory`, func() {
+				precious := filepath.Join(tmp, "precious")
+				So(ioutil.WriteFile(precious, []byte("data"), 0600), ShouldBeNil)
+				_, err := prepareWorkDir(tmp)
+				So(err, ShouldErrLike, "is not empty")
+				_, err = os.Stat(precious)
+				So(err, ShouldBeNil)
+			})
+
+			Convey(`refuses files`, func() {
+				file := filepath.Join(tmp, "file")
+				So(ioutil.WriteFile(file, nil, 0600), ShouldBeNil)
+				_, err := prepareWorkDir(file)
+				So(err, ShouldErrLike, "failed to read work dir")
+			})
+		})
+
+		Convey(`localTaskCommand`, func() {
+			env := environ.New([]string{"KEEP=1", "DROP=1", "PATH=/usr/bin"})
+			ctx = env.SetInCtx(ctx)
+
+			rootDir := "root"
+			outDir := "out"
+			cmd, err := localTaskCommand(ctx, &apipb.TaskProperties{
+				Command:     []string{"luciexe", "-output", "${ISOLATED_OUTDIR}/build.json"},
+				RelativeCwd: "sub",
+				Env: []*apipb.StringPair{
+					{Key: "NEW", Value: "v"},
+					{Key: "DROP"},
+				},
+				EnvPaths: []*apipb.StringListPair{
+					{Key: "PATH", Values: []string{"cipd/bin", "bin"}},
+				},
+			}, rootDir, outDir)
+			So(err, ShouldBeNil)
+
+			So(cmd.Args, ShouldResemble, []string{"luciexe", "-output", filepath.Join("out", "build.json")})
+			So(cmd.Dir, ShouldEqual, filepath.Join("root", "sub"))
+			So(cmd.Env, ShouldResemble, []string{
+				"KEEP=1",
+				"NEW=v",
+				"PATH=" + strings.Join([]string{
+					filepath.Join("root", "cipd/bin"),
+					filepath.Join("root", "bin"),
+					"/usr/bin",
+				}, string(os.PathListSeparator)),
+			})
+		})
+	})
+}
@@ -0,0 +1,162 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+
+	"go.chromium.org/luci/server/auditlog/auditlogpb"
+	"go.chromium.org/luci/server/auditlog/internal/testpb"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/module"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type memorySink struct {
+	m       sync.Mutex
+	entries []*auditlogpb.Entry
+}
+
+func (s *memorySink) Log(ctx context.Context, entry *auditlogpb.Entry) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.entries = append(s.entries, entry)
+}
+
+func TestInterceptor(t *testing.T) {
+	t.Parallel()
+
+	Convey("With interceptor", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:someone@example.com"})
+
+		sink := &memorySink{}
+		intr := newInterceptor(sink)
+
+		call := func(method string, req *testpb.UpdateThingRequest, err error) {
+			info := &grpc.UnaryServerInfo{FullMethod: method}
+			_, _ = intr(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				tc.Add(time.Second)
+				return &testpb.Thing{}, err
+			})
+		}
+
+		req := &testpb.UpdateThingRequest{
+			Name: "things/1",
+			Thing: &testpb.Thing{
+				Name:   "things/1",
+				Kind:   testpb.Kind_BIG,
+				Secret: &testpb.Secret{Id: "id", Password: "password"},
+			},
+			Tags:    []string{"a", "b"},
+			Limits:  map[string]int64{"y": 2, "x": 1},
+			Kind:    testpb.Kind_BIG,
+			Token:   "token",
+			Secrets: []*testpb.Secret{{Id: "id0"}, {Id: "id1", Password: "password"}},
+			Ignored: "ignored",
+		}
+
+		Convey("Audited method", func() {
+			call("/luci.server.auditlog.test.Things/UpdateThing", req, nil)
+			So(sink.entries, ShouldHaveLength, 1)
+			So(sink.entries[0], ShouldResembleProto, &auditlogpb.Entry{
+				Timestamp:    testclock.TestRecentTimeUTC.UnixNano() / 1000,
+				Caller:       "user:someone@example.com",
+				PeerIdentity: "user:someone@example.com",
+				PeerIp:       "127.0.0.1",
+				Service:      "luci.server.auditlog.test.Things",
+				Method:       "UpdateThing",
+				Resource:     "things/1",
+				Fields: []*auditlogpb.Entry_Field{
+					{Path: "kind", Value: "BIG"},
+					{Path: "limits", Value: "{x: 1, y: 2}"},
+					{Path: "secrets[0].id", Value: "id0"},
+					{Path: "secrets[1].id", Value: "id1"},
+					{Path: "secrets[1].password", Value: "<redacted>", Redacted: true},
+					{Path: "tags", Value: "[a, b]"},
+					{Path: "thing", Value: `{"name":"things/1","kind":"BIG","secret":{"id":"id"}}`},
+					{Path: "thing.secret.id", Value: "id"},
+					{Path: "thing.secret.password", Value: "<redacted>", Redacted: true},
+					{Path: "token", Value: "<redacted>", Redacted: true},
+				},
+				ResponseCode:     "OK",
+				ResponseTimeUsec: 1000000,
+			})
+
+			// Didn't modify the request.
+			So(req.Token, ShouldEqual, "token")
+			So(req.Thing.Secret.Password, ShouldEqual, "password")
+		})
+
+		Convey("Failed call", func() {
+			call("/luci.server.auditlog.test.Things/UpdateThing", &testpb.UpdateThingRequest{}, status.Errorf(codes.PermissionDenied, "boo"))
+			So(sink.entries, ShouldHaveLength, 1)
+			So(sink.entries[0].ResponseCode, ShouldEqual, "PERMISSION_DENIED")
+			So(sink.entries[0].ResponseErr, ShouldContainSubstring, "boo")
+			So(sink.entries[0].Fields, ShouldBeEmpty)
+		})
+
+		Convey("Not audited method", func() {
+			call("/luci.server.auditlog.test.Things/GetThing", req, nil)
+			call("/luci.server.auditlog.test.Things/Unknown", req, nil)
+			call("/unknown.Service/Method", req, nil)
+			So(sink.entries, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestWriterSink(t *testing.T) {
+	t.Parallel()
+
+	Convey("Writes JSON lines", t, func() {
+		ctx := context.Background()
+		buf := bytes.Buffer{}
+		sink := &writerSink{w: &buf}
+		sink.Log(ctx, &auditlogpb.Entry{Method: "A", Timestamp: clock.Now(ctx).UnixNano() / 1000})
+		sink.Log(ctx, &auditlogpb.Entry{Method: "B"})
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		So(lines, ShouldHaveLength, 2)
+		entry := &auditlogpb.Entry{}
+		So(protojson.Unmarshal([]byte(lines[1]), entry), ShouldBeNil)
+		So(entry, ShouldResembleProto, &auditlogpb.Entry{Method: "B"})
+	})
+}
+
+func TestModule(t *testing.T) {
+	t.Parallel()
+
+	Convey("BigQuery sink requires bqlog module", t, func() {
+		m := NewModule(&ModuleOptions{SinkSpec: "bigquery"})
+		_, err := m.Initialize(context.Background(), nil, module.HostOptions{})
+		So(err, ShouldErrLike, `requires module "go.chromium.org/luci/server/bqlog"`)
+	})
+}
@@ -0,0 +1,367 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: go.chromium.org/luci/server/auditlog/auditlogpb/entry.proto
+
+package auditlogpb
+
+import (
+	_ "go.chromium.org/luci/common/bq/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Entry defines a schema for `audit` BigQuery table with audit logs.
+//
+// One entry is recorded per a call to an audited method.
+//
+// Field types must be compatible with BigQuery Storage Write API, see
+// https://cloud.google.com/bigquery/docs/write-api#data_type_conversions
+type Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp        int64          `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                          // microseconds since epoch
+	RequestId        string         `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`                          // the trace ID of the request
+	Caller           string         `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`                                                 // the identity of the caller, e.g. "user:someone@example.com"
+	PeerIdentity     string         `protobuf:"bytes,4,opt,name=peer_identity,json=peerIdentity,proto3" json:"peer_identity,omitempty"`                 // the identity of the peer making the call
+	PeerIp           string         `protobuf:"bytes,5,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`                                   // IP address of the peer
+	Service          string         `protobuf:"bytes,6,opt,name=service,proto3" json:"service,omitempty"`                                               // the full service name, e.g. "pkg.Things"
+	Method           string         `protobuf:"bytes,7,opt,name=method,proto3" json:"method,omitempty"`                                                 // the method name, e.g. "DeleteThing"
+	Resource         string         `protobuf:"bytes,8,opt,name=resource,proto3" json:"resource,omitempty"`                                             // the name of the accessed resource (if known)
+	Fields           []*Entry_Field `protobuf:"bytes,9,rep,name=fields,proto3" json:"fields,omitempty"`                                                 // request fields selected for recording
+	ResponseCode     string         `protobuf:"bytes,10,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`                // the gRPC code, e.g. "OK" or "PERMISSION_DENIED"
+	ResponseErr      string         `protobuf:"bytes,11,opt,name=response_err,json=responseErr,proto3" json:"response_err,omitempty"`                   // the error message if the call failed
+	ResponseTimeUsec int64          `protobuf:"varint,12,opt,name=response_time_usec,json=responseTimeUsec,proto3" json:"response_time_usec,omitempty"` // how long the call took
+}
+
+func (x *Entry) Reset() {
+	*x = Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry) ProtoMessage() {}
+
+func (x *Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry.ProtoReflect.Descriptor instead.
+func (*Entry) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Entry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Entry) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *Entry) GetCaller() string {
+	if x != nil {
+		return x.Caller
+	}
+	return ""
+}
+
+func (x *Entry) GetPeerIdentity() string {
+	if x != nil {
+		return x.PeerIdentity
+	}
+	return ""
+}
+
+func (x *Entry) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *Entry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *Entry) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Entry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *Entry) GetFields() []*Entry_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Entry) GetResponseCode() string {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return ""
+}
+
+func (x *Entry) GetResponseErr() string {
+	if x != nil {
+		return x.ResponseErr
+	}
+	return ""
+}
+
+func (x *Entry) GetResponseTimeUsec() int64 {
+	if x != nil {
+		return x.ResponseTimeUsec
+	}
+	return 0
+}
+
+// A recorded request field.
+type Entry_Field struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`          // path to the field in the request, e.g. "thing.name"
+	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`        // the field value or a placeholder if redacted
+	Redacted bool   `protobuf:"varint,3,opt,name=redacted,proto3" json:"redacted,omitempty"` // true if the value was redacted
+}
+
+func (x *Entry_Field) Reset() {
+	*x = Entry_Field{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entry_Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entry_Field) ProtoMessage() {}
+
+func (x *Entry_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entry_Field.ProtoReflect.Descriptor instead.
+func (*Entry_Field) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Entry_Field) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Entry_Field) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Entry_Field) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+var File_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDesc = []byte{
+	0x0a, 0x3b, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70,
+	0x62, 0x2f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x6c,
+	0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x6c, 0x6f, 0x67, 0x1a, 0x2f, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d,
+	0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x62, 0x71, 0x2f, 0x70, 0x62, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf9, 0x03, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x0f, 0xe2, 0xbc, 0x24, 0x0b, 0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41,
+	0x4d, 0x50, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x65,
+	0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
+	0x49, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x65, 0x72, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x45, 0x72, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x65,
+	0x63, 0x1a, 0x4d, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e,
+	0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f,
+	0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescData = file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDesc
+)
+
+func file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescData)
+	})
+	return file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_goTypes = []interface{}{
+	(*Entry)(nil),       // 0: luci.server.auditlog.Entry
+	(*Entry_Field)(nil), // 1: luci.server.auditlog.Entry.Field
+}
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_depIdxs = []int32{
+	1, // 0: luci.server.auditlog.Entry.fields:type_name -> luci.server.auditlog.Entry.Field
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_init() }
+func file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_init() {
+	if File_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entry_Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_goTypes,
+		DependencyIndexes: file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_depIdxs,
+		MessageInfos:      file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_msgTypes,
+	}.Build()
+	File_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto = out.File
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_rawDesc = nil
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_goTypes = nil
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_entry_proto_depIdxs = nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Defining extensions is supported in proto2 syntax only.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: go.chromium.org/luci/server/auditlog/auditlogpb/options.proto
+
+package auditlogpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FieldAudit defines how a request field is recorded in the audit log.
+type FieldAudit int32
+
+const (
+	// The field is not recorded.
+	FieldAudit_FIELD_AUDIT_UNSPECIFIED FieldAudit = 0
+	// The field value is recorded as is.
+	FieldAudit_RECORD FieldAudit = 1
+	// The field is recorded only if it is set, with its value replaced by
+	// a placeholder. Also removes the field from messages recorded via RECORD.
+	FieldAudit_REDACT FieldAudit = 2
+	// The field value is recorded as the name of the accessed resource.
+	FieldAudit_RESOURCE_NAME FieldAudit = 3
+)
+
+// Enum value maps for FieldAudit.
+var (
+	FieldAudit_name = map[int32]string{
+		0: "FIELD_AUDIT_UNSPECIFIED",
+		1: "RECORD",
+		2: "REDACT",
+		3: "RESOURCE_NAME",
+	}
+	FieldAudit_value = map[string]int32{
+		"FIELD_AUDIT_UNSPECIFIED": 0,
+		"RECORD":                  1,
+		"REDACT":                  2,
+		"RESOURCE_NAME":           3,
+	}
+)
+
+func (x FieldAudit) Enum() *FieldAudit {
+	p := new(FieldAudit)
+	*p = x
+	return p
+}
+
+func (x FieldAudit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FieldAudit) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_enumTypes[0].Descriptor()
+}
+
+func (FieldAudit) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_enumTypes[0]
+}
+
+func (x FieldAudit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *FieldAudit) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = FieldAudit(num)
+	return nil
+}
+
+// Deprecated: Use FieldAudit.Descriptor instead.
+func (FieldAudit) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescGZIP(), []int{0}
+}
+
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         74900,
+		Name:          "luci.server.auditlog.audited",
+		Tag:           "varint,74900,opt,name=audited",
+		Filename:      "go.chromium.org/luci/server/auditlog/auditlogpb/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldAudit)(nil),
+		Field:         74900,
+		Name:          "luci.server.auditlog.field",
+		Tag:           "varint,74900,opt,name=field,enum=luci.server.auditlog.FieldAudit",
+		Filename:      "go.chromium.org/luci/server/auditlog/auditlogpb/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional bool audited = 74900;
+	E_Audited = &file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional luci.server.auditlog.FieldAudit field = 74900;
+	E_Field = &file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_extTypes[1]
+)
+
+var File_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70,
+	0x62, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x14, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x6c, 0x6f, 0x67, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0x54, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x49, 0x45, 0x4c, 0x44, 0x5f, 0x41,
+	0x55, 0x44, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x52, 0x45, 0x44, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x3a, 0x3a, 0x0a,
+	0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x94, 0xc9, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x61, 0x75, 0x64, 0x69, 0x74, 0x65, 0x64, 0x3a, 0x57, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x94, 0xc9, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75,
+	0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x6c, 0x6f, 0x67, 0x70, 0x62,
+}
+
+var (
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescData = file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDesc
+)
+
+func file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescData)
+	})
+	return file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_goTypes = []interface{}{
+	(FieldAudit)(0),                    // 0: luci.server.auditlog.FieldAudit
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),  // 2: google.protobuf.FieldOptions
+}
+var file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_depIdxs = []int32{
+	1, // 0: luci.server.auditlog.audited:extendee -> google.protobuf.MethodOptions
+	2, // 1: luci.server.auditlog.field:extendee -> google.protobuf.FieldOptions
+	0, // 2: luci.server.auditlog.field:type_name -> luci.server.auditlog.FieldAudit
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	2, // [2:3] is the sub-list for extension type_name
+	0, // [0:2] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_init() }
+func file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_init() {
+	if File_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_goTypes,
+		DependencyIndexes: file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_depIdxs,
+		EnumInfos:         file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_enumTypes,
+		ExtensionInfos:    file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_extTypes,
+	}.Build()
+	File_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto = out.File
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_rawDesc = nil
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_goTypes = nil
+	file_go_chromium_org_luci_server_auditlog_auditlogpb_options_proto_depIdxs = nil
+}
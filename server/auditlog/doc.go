@@ -0,0 +1,46 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package auditlog implements a server module that records who did what by
+// calling annotated RPC methods.
+//
+// Methods to audit are annotated in their service definition:
+//
+//	import "go.chromium.org/luci/server/auditlog/auditlogpb/options.proto";
+//
+//	service Things {
+//	  rpc DeleteThing(DeleteThingRequest) returns (google.protobuf.Empty) {
+//	    option (luci.server.auditlog.audited) = true;
+//	  };
+//	}
+//
+// Fields of request messages of such methods can be annotated to be recorded:
+//
+//	message DeleteThingRequest {
+//	  string name = 1 [(luci.server.auditlog.field) = RESOURCE_NAME];
+//	  string reason = 2 [(luci.server.auditlog.field) = RECORD];
+//	  string token = 3 [(luci.server.auditlog.field) = REDACT];
+//	}
+//
+// Fields without annotations are not recorded. Fields annotated with REDACT
+// are recorded only as a placeholder, and they are also removed from recorded
+// messages.
+//
+// Each call results in an auditlogpb.Entry with the caller identity (as
+// reported by go.chromium.org/luci/server/auth), the method, the resource name,
+// the recorded fields and the outcome of the call. Entries are sent to
+// BigQuery through go.chromium.org/luci/server/bqlog (in that case the bqlog
+// module must be enabled as well) or written to a local file as
+// newline-delimited JSON, see -auditlog-sink flag.
+package auditlog
@@ -0,0 +1,189 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"go.chromium.org/luci/common/proto/protowalk"
+	"go.chromium.org/luci/common/proto/reflectutil"
+
+	"go.chromium.org/luci/server/auditlog/auditlogpb"
+)
+
+// redactedValue replaces values of redacted fields.
+const redactedValue = "<redacted>"
+
+// auditedMethods caches results of isAudited: full method name => bool.
+var auditedMethods sync.Map
+
+// isAudited returns true if the method is annotated with
+// `option (luci.server.auditlog.audited) = true`.
+//
+// Takes the full gRPC method name, e.g. "/pkg.Service/Method". The method must
+// be registered in the global proto registry.
+func isAudited(fullMethod string) bool {
+	if audited, ok := auditedMethods.Load(fullMethod); ok {
+		return audited.(bool)
+	}
+	audited := false
+	name := strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+	if desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name)); err == nil {
+		if md, ok := desc.(protoreflect.MethodDescriptor); ok {
+			audited = proto.GetExtension(md.Options(), auditlogpb.E_Audited).(bool)
+		}
+	}
+	auditedMethods.Store(fullMethod, audited)
+	return audited
+}
+
+// extractFields returns the resource name and the request fields to record.
+//
+// Doesn't modify the request.
+func extractFields(req proto.Message) (resource string, fields []*auditlogpb.Entry_Field) {
+	// Redact fields first to make sure they don't show up in recorded messages.
+	req = proto.Clone(req)
+	for _, res := range protowalk.Fields(req, &redactProcessor{})[0] {
+		fields = append(fields, &auditlogpb.Entry_Field{
+			Path:     fieldPath(res.Path),
+			Value:    redactedValue,
+			Redacted: true,
+		})
+	}
+
+	results := protowalk.Fields(req, &recordProcessor{}, &resourceProcessor{})
+	for _, res := range results[0] {
+		fields = append(fields, &auditlogpb.Entry_Field{
+			Path:  fieldPath(res.Path),
+			Value: res.Data.Message,
+		})
+	}
+	if len(results[1]) != 0 {
+		resource = results[1][0].Data.Message
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Path < fields[j].Path })
+	return
+}
+
+// fieldPath formats a path to a field, e.g. "thing.tags[0]".
+func fieldPath(p reflectutil.Path) string {
+	return strings.TrimPrefix(p.String(), ".")
+}
+
+// recordProcessor renders fields annotated with RECORD.
+type recordProcessor struct{}
+
+// Process implements protowalk.FieldProcessor.
+func (recordProcessor) Process(field protoreflect.FieldDescriptor, msg protoreflect.Message) (data protowalk.ResultData, applied bool) {
+	return protowalk.ResultData{Message: formatValue(field, msg.Get(field))}, true
+}
+
+// resourceProcessor renders fields annotated with RESOURCE_NAME.
+type resourceProcessor struct{}
+
+// Process implements protowalk.FieldProcessor.
+func (resourceProcessor) Process(field protoreflect.FieldDescriptor, msg protoreflect.Message) (data protowalk.ResultData, applied bool) {
+	return protowalk.ResultData{Message: formatValue(field, msg.Get(field))}, true
+}
+
+// redactProcessor clears fields annotated with REDACT.
+type redactProcessor struct{}
+
+// Process implements protowalk.FieldProcessor.
+func (redactProcessor) Process(field protoreflect.FieldDescriptor, msg protoreflect.Message) (data protowalk.ResultData, applied bool) {
+	msg.Clear(field)
+	return protowalk.ResultData{Message: "redacted"}, true
+}
+
+// fieldAuditSelector returns a protowalk.FieldSelector that selects set fields
+// annotated with the given FieldAudit.
+func fieldAuditSelector(fa auditlogpb.FieldAudit) protowalk.FieldSelector {
+	return func(field protoreflect.FieldDescriptor) protowalk.ProcessAttr {
+		if fo := field.Options().(*descriptorpb.FieldOptions); fo != nil {
+			if proto.GetExtension(fo, auditlogpb.E_Field).(auditlogpb.FieldAudit) == fa {
+				return protowalk.ProcessIfSet
+			}
+		}
+		return protowalk.ProcessNever
+	}
+}
+
+// formatValue formats a field value for the log.
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch {
+	case fd.IsList():
+		l := v.List()
+		parts := make([]string, l.Len())
+		for i := range parts {
+			parts[i] = formatSingular(fd, l.Get(i))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	case fd.IsMap():
+		var parts []string
+		reflectutil.MapRangeSorted(v.Map(), fd.MapKey().Kind(), func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			parts = append(parts, k.String()+": "+formatSingular(fd.MapValue(), v))
+			return true
+		})
+		return "{" + strings.Join(parts, ", ") + "}"
+	default:
+		return formatSingular(fd, v)
+	}
+}
+
+// formatSingular formats a single value of a field.
+func formatSingular(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		blob, err := protojson.Marshal(v.Message().Interface())
+		if err != nil {
+			return fmt.Sprintf("<%s>", err)
+		}
+		// protojson output is unstable, make it compact.
+		buf := bytes.Buffer{}
+		if err := json.Compact(&buf, blob); err != nil {
+			return fmt.Sprintf("<%s>", err)
+		}
+		return buf.String()
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.String()
+	}
+}
+
+func init() {
+	protowalk.RegisterFieldProcessor(&recordProcessor{}, fieldAuditSelector(auditlogpb.FieldAudit_RECORD))
+	protowalk.RegisterFieldProcessor(&resourceProcessor{}, fieldAuditSelector(auditlogpb.FieldAudit_RESOURCE_NAME))
+	protowalk.RegisterFieldProcessor(&redactProcessor{}, fieldAuditSelector(auditlogpb.FieldAudit_REDACT))
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"context"
+	"strings"
+
+	codepb "google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/trace"
+
+	"go.chromium.org/luci/server/auditlog/auditlogpb"
+	"go.chromium.org/luci/server/auth"
+)
+
+// newInterceptor returns an interceptor that logs calls to audited methods.
+func newInterceptor(sink Sink) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		msg, ok := req.(proto.Message)
+		if !ok || !isAudited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := clock.Now(ctx)
+		entry := &auditlogpb.Entry{
+			Timestamp: start.UnixNano() / 1000,
+			RequestId: trace.SpanContext(ctx),
+		}
+		entry.Service, entry.Method = splitFullMethod(info.FullMethod)
+		if state := auth.GetState(ctx); state != nil {
+			entry.Caller = string(state.User().Identity)
+			entry.PeerIdentity = string(state.PeerIdentity())
+			if ip := state.PeerIP(); ip != nil {
+				entry.PeerIp = ip.String()
+			}
+		}
+
+		// Extract fields before the handler has a chance to modify the request.
+		entry.Resource, entry.Fields = extractFields(msg)
+
+		panicking := true
+		defer func() {
+			entry.ResponseTimeUsec = clock.Since(ctx, start).Microseconds()
+			switch {
+			case panicking:
+				entry.ResponseCode = "INTERNAL"
+				entry.ResponseErr = "panic"
+			case err == nil:
+				entry.ResponseCode = "OK"
+			default:
+				entry.ResponseCode = codepb.Code_name[int32(status.Code(err))]
+				entry.ResponseErr = err.Error()
+			}
+			sink.Log(ctx, entry)
+		}()
+
+		resp, err = handler(ctx, req)
+		panicking = false
+		return
+	}
+}
+
+// splitFullMethod splits "/pkg.Service/Method" into "pkg.Service" and
+// "Method".
+func splitFullMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if idx := strings.LastIndex(fullMethod, "/"); idx != -1 {
+		return fullMethod[:idx], fullMethod[idx+1:]
+	}
+	return "", fullMethod
+}
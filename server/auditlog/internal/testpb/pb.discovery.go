@@ -0,0 +1,498 @@
+// Code generated by cproto. DO NOT EDIT.
+
+package testpb
+
+import "go.chromium.org/luci/grpc/discovery"
+
+import "google.golang.org/protobuf/types/descriptorpb"
+
+func init() {
+	discovery.RegisterDescriptorSetCompressed(
+		[]string{
+			"luci.server.auditlog.test.Things",
+		},
+		[]byte{31, 139,
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 123, 75, 108, 27, 89,
+			186, 30, 171, 78, 241, 245, 83, 34, 75, 71, 178, 77, 211, 175,
+			50, 237, 238, 150, 221, 54, 101, 211, 111, 123, 122, 102, 40, 177,
+			164, 166, 91, 34, 149, 34, 101, 183, 59, 25, 20, 74, 228, 17,
+			85, 221, 100, 21, 167, 170, 104, 91, 19, 220, 193, 32, 201, 226,
+			6, 55, 23, 185, 23, 24, 4, 157, 0, 147, 4, 119, 51, 64,
+			112, 3, 4, 9, 16, 224, 46, 178, 8, 112, 23, 25, 32, 9,
+			146, 69, 128, 0, 89, 6, 152, 238, 32, 1, 178, 201, 226, 238,
+			58, 56, 143, 42, 22, 41, 201, 150, 123, 208, 23, 189, 24, 111,
+			204, 255, 245, 157, 255, 252, 231, 63, 143, 255, 212, 17, 252, 31,
+			19, 180, 190, 235, 246, 7, 100, 101, 228, 185, 129, 187, 59, 222,
+			91, 233, 17, 191, 235, 217, 163, 192, 245, 42, 140, 135, 11, 92,
+			163, 18, 106, 148, 183, 96, 97, 221, 30, 144, 122, 164, 216, 38,
+			1, 126, 8, 202, 158, 61, 32, 69, 73, 67, 203, 185, 234, 213,
+			202, 140, 81, 101, 218, 98, 155, 178, 13, 102, 81, 254, 50, 9,
+			139, 71, 72, 49, 6, 197, 177, 134, 20, 81, 90, 206, 26, 236,
+			55, 46, 66, 122, 100, 117, 191, 176, 250, 164, 40, 51, 118, 72,
+			226, 139, 0, 61, 50, 34, 78, 143, 56, 221, 131, 34, 210, 208,
+			114, 214, 136, 113, 240, 135, 176, 48, 26, 239, 14, 236, 174, 25,
+			83, 3, 13, 45, 39, 13, 149, 11, 234, 19, 229, 15, 160, 240,
+			138, 88, 95, 196, 85, 115, 76, 53, 79, 217, 49, 197, 53, 152,
+			27, 18, 223, 183, 250, 196, 12, 14, 70, 164, 168, 176, 222, 107,
+			135, 122, 63, 219, 243, 156, 176, 234, 28, 140, 8, 174, 65, 150,
+			56, 227, 33, 71, 72, 30, 19, 63, 221, 25, 15, 103, 81, 50,
+			212, 76, 64, 164, 125, 226, 189, 180, 187, 164, 152, 98, 0, 31,
+			28, 2, 104, 115, 249, 44, 70, 104, 135, 215, 32, 75, 94, 7,
+			196, 241, 109, 215, 41, 166, 25, 200, 123, 135, 64, 214, 109, 50,
+			232, 205, 66, 76, 236, 240, 125, 72, 187, 163, 192, 118, 29, 191,
+			152, 209, 164, 229, 92, 245, 252, 17, 16, 3, 210, 226, 58, 70,
+			168, 140, 27, 160, 250, 238, 216, 235, 18, 179, 235, 246, 136, 105,
+			59, 123, 110, 49, 203, 0, 46, 29, 2, 104, 51, 197, 53, 183,
+			71, 26, 206, 158, 107, 228, 253, 41, 26, 159, 134, 148, 127, 224,
+			4, 214, 235, 226, 28, 203, 16, 65, 225, 42, 164, 73, 207, 166,
+			237, 22, 243, 154, 180, 156, 175, 22, 15, 33, 235, 92, 110, 132,
+			138, 229, 191, 72, 65, 225, 36, 105, 249, 4, 146, 123, 52, 50,
+			69, 249, 93, 226, 198, 109, 166, 3, 159, 250, 150, 129, 175, 65,
+			206, 33, 126, 64, 122, 60, 139, 208, 9, 243, 16, 184, 209, 225,
+			52, 84, 190, 85, 26, 126, 10, 133, 200, 37, 211, 179, 156, 126,
+			152, 207, 43, 111, 243, 164, 162, 135, 118, 6, 53, 51, 242, 17,
+			14, 163, 113, 29, 192, 117, 136, 187, 103, 246, 72, 119, 80, 204,
+			28, 19, 165, 22, 85, 153, 117, 47, 203, 12, 235, 164, 59, 192,
+			143, 38, 233, 153, 62, 38, 187, 182, 248, 196, 60, 148, 161, 59,
+			144, 247, 8, 157, 43, 164, 39, 122, 150, 101, 78, 84, 222, 218,
+			51, 67, 152, 177, 142, 24, 243, 33, 10, 35, 241, 21, 136, 24,
+			38, 93, 225, 216, 146, 148, 53, 230, 66, 102, 211, 26, 146, 210,
+			207, 32, 63, 29, 30, 188, 4, 73, 63, 176, 188, 128, 45, 142,
+			73, 131, 19, 88, 5, 68, 156, 30, 91, 25, 147, 6, 253, 137,
+			127, 60, 233, 48, 98, 29, 126, 255, 144, 187, 211, 200, 179, 253,
+			46, 61, 128, 249, 169, 14, 156, 180, 233, 242, 127, 84, 224, 212,
+			145, 216, 248, 83, 88, 26, 59, 182, 19, 16, 111, 228, 17, 154,
+			178, 220, 197, 226, 87, 233, 99, 146, 110, 39, 174, 205, 81, 140,
+			197, 41, 8, 206, 196, 47, 32, 71, 243, 195, 242, 44, 74, 138,
+			217, 88, 61, 89, 151, 43, 245, 137, 229, 42, 250, 67, 73, 54,
+			226, 88, 248, 1, 100, 246, 136, 21, 140, 61, 226, 23, 171, 44,
+			148, 231, 14, 225, 174, 115, 133, 54, 9, 140, 72, 25, 15, 97,
+			238, 37, 241, 236, 61, 187, 203, 160, 217, 56, 228, 171, 15, 79,
+			232, 212, 179, 152, 105, 59, 176, 2, 242, 24, 118, 154, 207, 116,
+			163, 177, 222, 208, 235, 220, 205, 41, 248, 210, 47, 37, 200, 197,
+			122, 66, 151, 67, 103, 60, 220, 37, 158, 24, 47, 65, 225, 115,
+			144, 221, 27, 15, 6, 60, 233, 232, 176, 101, 141, 12, 101, 208,
+			132, 163, 91, 175, 88, 70, 40, 159, 253, 198, 37, 200, 132, 73,
+			89, 76, 106, 210, 114, 198, 136, 104, 46, 27, 17, 43, 32, 189,
+			98, 42, 148, 113, 250, 169, 146, 81, 212, 100, 249, 46, 44, 28,
+			234, 10, 46, 64, 174, 174, 175, 109, 214, 140, 90, 167, 209, 106,
+			170, 9, 156, 135, 88, 239, 84, 233, 122, 54, 243, 117, 90, 253,
+			197, 47, 126, 241, 11, 185, 252, 239, 83, 176, 116, 212, 34, 120,
+			228, 122, 60, 233, 52, 154, 234, 116, 13, 146, 3, 107, 151, 12,
+			138, 10, 27, 132, 15, 79, 180, 204, 86, 54, 169, 137, 193, 45,
+			241, 15, 69, 104, 104, 8, 242, 213, 235, 39, 67, 160, 235, 171,
+			8, 227, 57, 200, 210, 255, 121, 220, 83, 60, 238, 148, 193, 226,
+			94, 130, 12, 91, 247, 122, 36, 26, 147, 144, 166, 43, 69, 143,
+			236, 89, 227, 65, 96, 190, 180, 6, 99, 194, 86, 176, 172, 49,
+			39, 152, 207, 40, 15, 95, 130, 28, 91, 237, 76, 219, 233, 145,
+			215, 108, 11, 77, 26, 124, 229, 108, 80, 14, 29, 246, 207, 125,
+			215, 9, 215, 26, 138, 144, 161, 12, 214, 252, 131, 201, 106, 193,
+			119, 239, 11, 71, 119, 111, 118, 145, 192, 31, 64, 129, 105, 220,
+			17, 83, 217, 26, 20, 23, 88, 26, 228, 57, 187, 37, 184, 229,
+			127, 43, 131, 66, 131, 65, 135, 190, 243, 98, 91, 55, 235, 173,
+			157, 213, 77, 93, 149, 232, 208, 51, 198, 250, 102, 171, 214, 81,
+			229, 136, 110, 52, 59, 247, 239, 170, 40, 50, 216, 225, 12, 37,
+			174, 112, 167, 170, 38, 177, 10, 115, 140, 94, 111, 124, 170, 215,
+			239, 223, 85, 83, 211, 156, 59, 85, 53, 141, 231, 33, 203, 56,
+			171, 173, 214, 166, 154, 137, 48, 219, 29, 163, 209, 220, 80, 179,
+			17, 230, 134, 209, 218, 217, 86, 33, 66, 216, 210, 219, 237, 218,
+			134, 174, 230, 34, 141, 213, 23, 29, 189, 173, 206, 77, 185, 117,
+			167, 170, 206, 71, 77, 232, 205, 157, 45, 53, 143, 23, 96, 158,
+			145, 237, 208, 137, 194, 12, 235, 254, 93, 85, 157, 56, 194, 81,
+			22, 166, 24, 247, 239, 170, 184, 188, 6, 73, 150, 134, 24, 67,
+			126, 179, 182, 170, 111, 154, 173, 109, 58, 105, 106, 155, 170, 52,
+			225, 25, 250, 182, 94, 235, 232, 117, 21, 197, 121, 127, 99, 167,
+			97, 232, 117, 85, 46, 119, 97, 233, 168, 29, 242, 200, 41, 20,
+			203, 5, 249, 152, 92, 96, 88, 179, 185, 80, 254, 159, 50, 44,
+			30, 113, 74, 56, 178, 145, 31, 65, 146, 231, 50, 95, 169, 175,
+			29, 106, 130, 2, 177, 204, 158, 65, 51, 184, 93, 252, 188, 137,
+			142, 57, 111, 82, 136, 67, 9, 251, 147, 67, 187, 57, 63, 240,
+			220, 63, 210, 124, 166, 113, 198, 123, 183, 93, 61, 121, 196, 174,
+			254, 4, 22, 14, 1, 157, 120, 119, 253, 187, 18, 20, 143, 11,
+			206, 91, 150, 68, 121, 106, 73, 124, 50, 27, 193, 203, 71, 134,
+			128, 181, 115, 104, 172, 127, 45, 193, 233, 163, 235, 138, 35, 125,
+			248, 33, 164, 134, 36, 216, 119, 195, 115, 242, 225, 195, 200, 22,
+			19, 207, 96, 25, 194, 42, 126, 124, 67, 199, 28, 223, 132, 55,
+			135, 60, 253, 251, 50, 156, 58, 18, 252, 72, 71, 47, 0, 216,
+			206, 104, 28, 240, 179, 48, 13, 88, 214, 200, 50, 14, 91, 188,
+			232, 42, 59, 14, 34, 57, 98, 114, 224, 44, 166, 240, 112, 226,
+			168, 194, 28, 189, 120, 76, 79, 103, 253, 196, 183, 64, 237, 14,
+			108, 226, 4, 166, 31, 120, 196, 26, 218, 78, 159, 239, 182, 143,
+			147, 123, 214, 192, 39, 70, 129, 139, 219, 161, 148, 90, 176, 4,
+			242, 98, 22, 169, 41, 11, 46, 142, 44, 202, 255, 50, 11, 185,
+			88, 21, 134, 47, 195, 220, 231, 214, 75, 203, 12, 43, 107, 137,
+			85, 214, 57, 202, 219, 22, 213, 245, 45, 88, 162, 164, 233, 142,
+			3, 226, 153, 221, 129, 229, 251, 52, 80, 172, 200, 203, 26, 152,
+			202, 90, 84, 180, 22, 74, 240, 61, 88, 164, 92, 115, 56, 30,
+			4, 246, 104, 64, 76, 90, 235, 251, 69, 136, 123, 182, 64, 53,
+			182, 132, 2, 245, 200, 199, 117, 184, 64, 153, 102, 159, 56, 196,
+			179, 2, 98, 146, 159, 142, 173, 129, 111, 90, 78, 207, 220, 183,
+			252, 253, 226, 18, 5, 88, 149, 139, 146, 113, 150, 42, 110, 8,
+			61, 157, 169, 213, 156, 222, 199, 150, 191, 143, 31, 195, 105, 42,
+			164, 17, 177, 157, 190, 217, 221, 39, 221, 47, 204, 113, 176, 247,
+			176, 120, 46, 222, 62, 243, 176, 205, 116, 214, 168, 202, 78, 176,
+			247, 16, 183, 97, 142, 142, 221, 208, 254, 25, 49, 247, 92, 143,
+			237, 161, 249, 234, 181, 55, 213, 177, 149, 150, 48, 216, 114, 123,
+			228, 113, 178, 189, 173, 235, 117, 35, 23, 162, 172, 187, 30, 190,
+			0, 208, 119, 163, 0, 231, 88, 212, 178, 125, 55, 12, 239, 61,
+			88, 236, 118, 121, 159, 237, 174, 41, 42, 114, 191, 168, 78, 5,
+			171, 219, 101, 157, 181, 187, 34, 199, 125, 252, 8, 78, 77, 130,
+			21, 55, 92, 136, 27, 46, 70, 113, 138, 153, 222, 131, 197, 209,
+			193, 97, 67, 60, 213, 226, 232, 96, 214, 236, 61, 118, 203, 226,
+			145, 46, 61, 218, 21, 207, 196, 181, 99, 2, 92, 1, 181, 219,
+			53, 137, 99, 237, 14, 136, 105, 121, 196, 177, 252, 226, 37, 166,
+			172, 4, 222, 152, 24, 249, 110, 87, 103, 194, 26, 147, 225, 235,
+			176, 224, 238, 126, 222, 229, 137, 101, 142, 60, 178, 103, 191, 46,
+			94, 101, 81, 42, 80, 1, 75, 171, 109, 198, 198, 215, 64, 237,
+			250, 251, 150, 55, 98, 43, 171, 63, 178, 186, 164, 248, 30, 87,
+			229, 252, 102, 200, 166, 137, 237, 191, 178, 247, 130, 16, 241, 3,
+			166, 150, 99, 60, 129, 182, 12, 234, 104, 127, 52, 221, 240, 50,
+			83, 203, 143, 246, 71, 241, 118, 175, 192, 252, 104, 63, 222, 232,
+			53, 166, 54, 55, 218, 143, 181, 120, 23, 78, 83, 165, 33, 9,
+			172, 158, 21, 88, 49, 237, 27, 76, 123, 105, 180, 63, 218, 18,
+			194, 41, 63, 189, 241, 238, 65, 148, 31, 55, 153, 110, 142, 242,
+			194, 12, 249, 214, 229, 199, 119, 86, 108, 149, 31, 195, 92, 60,
+			239, 113, 22, 120, 230, 171, 18, 61, 4, 173, 181, 234, 186, 217,
+			110, 124, 166, 171, 50, 61, 70, 109, 54, 58, 186, 105, 236, 52,
+			59, 141, 45, 93, 69, 177, 131, 253, 83, 37, 115, 93, 253, 240,
+			169, 146, 121, 95, 253, 128, 133, 231, 80, 82, 150, 255, 31, 130,
+			252, 116, 89, 142, 127, 0, 103, 194, 123, 55, 159, 4, 230, 43,
+			219, 99, 147, 117, 104, 241, 141, 51, 74, 202, 37, 161, 213, 38,
+			193, 115, 219, 35, 235, 174, 55, 180, 2, 188, 9, 151, 28, 215,
+			244, 3, 203, 233, 89, 94, 207, 156, 220, 120, 154, 86, 183, 75,
+			124, 223, 245, 138, 114, 28, 229, 188, 227, 182, 133, 242, 100, 247,
+			168, 9, 213, 153, 57, 129, 142, 155, 19, 231, 32, 59, 180, 70,
+			38, 113, 2, 239, 128, 157, 221, 51, 70, 102, 104, 141, 116, 74,
+			227, 103, 240, 254, 68, 213, 28, 144, 190, 213, 61, 48, 233, 185,
+			220, 100, 119, 68, 102, 215, 117, 246, 6, 118, 55, 240, 139, 185,
+			104, 253, 43, 79, 44, 54, 153, 193, 83, 223, 117, 88, 137, 180,
+			22, 106, 79, 85, 173, 115, 223, 139, 180, 153, 30, 122, 69, 77,
+			62, 85, 50, 73, 53, 245, 84, 201, 164, 212, 244, 83, 37, 147,
+			81, 179, 79, 149, 76, 86, 133, 242, 175, 230, 97, 46, 94, 110,
+			224, 26, 36, 187, 108, 195, 165, 67, 156, 175, 94, 121, 99, 113,
+			82, 89, 163, 59, 241, 227, 20, 63, 219, 27, 220, 146, 158, 130,
+			232, 36, 35, 244, 4, 66, 235, 19, 65, 225, 13, 72, 125, 238,
+			83, 13, 86, 190, 230, 171, 87, 223, 140, 253, 180, 205, 192, 179,
+			79, 219, 102, 179, 101, 108, 213, 54, 13, 97, 142, 207, 130, 50,
+			176, 126, 118, 48, 189, 103, 51, 22, 174, 64, 97, 236, 240, 90,
+			157, 142, 49, 213, 42, 196, 181, 242, 19, 233, 38, 213, 63, 97,
+			94, 157, 5, 133, 94, 74, 79, 239, 172, 140, 133, 151, 97, 174,
+			71, 118, 199, 125, 211, 35, 61, 171, 27, 76, 239, 39, 57, 38,
+			50, 152, 4, 127, 2, 89, 58, 112, 14, 237, 30, 43, 221, 242,
+			213, 155, 111, 14, 129, 24, 226, 208, 200, 152, 216, 227, 143, 33,
+			29, 88, 94, 159, 4, 126, 113, 81, 67, 203, 249, 106, 229, 36,
+			80, 29, 102, 66, 227, 106, 132, 230, 248, 57, 168, 226, 42, 214,
+			20, 101, 174, 95, 92, 98, 235, 214, 141, 55, 67, 138, 155, 220,
+			58, 55, 50, 10, 100, 138, 158, 158, 23, 167, 222, 101, 94, 236,
+			64, 65, 252, 54, 253, 241, 104, 228, 122, 65, 241, 180, 38, 189,
+			221, 161, 16, 140, 219, 24, 249, 189, 41, 250, 187, 155, 110, 165,
+			207, 32, 63, 29, 140, 248, 69, 56, 58, 225, 69, 56, 94, 154,
+			20, 106, 116, 107, 226, 213, 87, 233, 31, 201, 144, 159, 238, 24,
+			222, 0, 44, 108, 76, 219, 9, 60, 183, 55, 238, 146, 94, 81,
+			122, 75, 59, 11, 194, 166, 17, 153, 196, 129, 98, 179, 64, 62,
+			33, 80, 125, 50, 63, 86, 96, 49, 4, 160, 96, 175, 44, 207,
+			161, 135, 106, 218, 245, 172, 129, 99, 162, 231, 92, 130, 107, 16,
+			166, 139, 233, 145, 161, 251, 146, 244, 138, 202, 91, 154, 205, 11,
+			3, 131, 235, 151, 87, 32, 201, 150, 31, 12, 32, 22, 32, 53,
+			129, 51, 160, 172, 181, 140, 186, 42, 209, 253, 144, 115, 205, 237,
+			134, 190, 166, 171, 114, 249, 30, 164, 248, 154, 66, 183, 206, 104,
+			85, 81, 19, 130, 20, 24, 82, 40, 221, 217, 90, 213, 13, 85,
+			46, 239, 64, 97, 102, 30, 226, 83, 176, 96, 232, 29, 189, 73,
+			47, 7, 204, 157, 230, 39, 205, 214, 115, 122, 179, 54, 197, 14,
+			247, 97, 9, 47, 129, 58, 97, 183, 91, 59, 6, 243, 230, 31,
+			200, 160, 206, 78, 74, 124, 6, 22, 59, 53, 99, 67, 239, 152,
+			236, 102, 98, 2, 189, 4, 106, 92, 176, 222, 96, 247, 57, 151,
+			224, 92, 156, 171, 127, 218, 209, 155, 109, 218, 138, 81, 107, 110,
+			208, 67, 193, 12, 94, 120, 197, 130, 104, 15, 226, 130, 245, 134,
+			190, 89, 87, 149, 89, 118, 171, 169, 183, 214, 213, 228, 108, 235,
+			236, 218, 37, 133, 75, 112, 122, 150, 107, 234, 205, 142, 241, 66,
+			77, 207, 54, 220, 214, 141, 103, 141, 53, 93, 205, 224, 211, 128,
+			227, 130, 45, 189, 243, 113, 171, 174, 102, 143, 218, 177, 176, 186,
+			88, 254, 115, 9, 230, 226, 87, 32, 83, 139, 138, 244, 125, 219,
+			108, 203, 255, 85, 134, 92, 236, 46, 132, 94, 21, 90, 131, 129,
+			251, 202, 180, 6, 182, 229, 139, 253, 16, 24, 171, 70, 57, 39,
+			221, 127, 78, 126, 116, 73, 125, 235, 163, 75, 250, 123, 120, 116,
+			73, 170, 169, 242, 127, 151, 65, 157, 189, 29, 153, 137, 155, 116,
+			92, 220, 226, 253, 147, 223, 165, 127, 179, 187, 58, 58, 118, 87,
+			63, 98, 179, 82, 190, 207, 155, 85, 60, 93, 255, 155, 4, 121,
+			81, 118, 134, 129, 141, 71, 172, 252, 46, 17, 155, 30, 145, 203,
+			199, 141, 200, 95, 75, 191, 254, 49, 130, 249, 169, 187, 159, 147,
+			122, 247, 83, 88, 176, 123, 100, 56, 114, 3, 250, 242, 192, 28,
+			144, 151, 100, 192, 194, 144, 175, 174, 188, 249, 118, 169, 210, 152,
+			216, 109, 82, 179, 199, 139, 141, 186, 190, 181, 221, 234, 232, 205,
+			181, 23, 225, 38, 97, 168, 49, 120, 166, 54, 53, 5, 175, 188,
+			75, 192, 191, 179, 72, 150, 183, 65, 157, 237, 13, 93, 208, 143,
+			232, 143, 154, 192, 139, 80, 104, 182, 204, 118, 163, 174, 155, 250,
+			250, 186, 190, 214, 105, 243, 15, 13, 145, 118, 71, 149, 227, 99,
+			243, 79, 16, 44, 30, 225, 9, 174, 137, 43, 66, 126, 107, 121,
+			243, 36, 222, 87, 104, 117, 191, 109, 121, 129, 184, 81, 188, 6,
+			52, 188, 78, 96, 239, 217, 196, 19, 31, 112, 16, 251, 128, 83,
+			152, 240, 217, 50, 130, 111, 0, 30, 185, 190, 29, 216, 47, 233,
+			67, 136, 240, 107, 15, 157, 184, 138, 161, 134, 146, 134, 19, 68,
+			218, 14, 233, 91, 51, 218, 180, 252, 64, 134, 26, 74, 34, 237,
+			203, 48, 215, 115, 199, 244, 86, 134, 163, 210, 37, 89, 50, 114,
+			156, 23, 169, 136, 107, 179, 201, 103, 166, 57, 35, 199, 121, 92,
+			229, 3, 40, 88, 253, 190, 71, 193, 67, 32, 126, 17, 152, 143,
+			216, 76, 177, 244, 20, 50, 97, 28, 232, 151, 39, 26, 9, 115,
+			196, 111, 183, 101, 250, 229, 201, 9, 133, 151, 97, 206, 246, 205,
+			232, 155, 127, 81, 214, 228, 229, 140, 145, 179, 253, 232, 171, 104,
+			249, 215, 0, 48, 73, 54, 252, 167, 18, 228, 249, 6, 51, 162,
+			87, 237, 78, 55, 44, 11, 143, 184, 169, 139, 172, 248, 153, 124,
+			91, 24, 172, 254, 232, 15, 37, 233, 75, 73, 249, 82, 146, 254,
+			76, 154, 199, 25, 253, 211, 237, 205, 198, 90, 163, 83, 252, 109,
+			154, 209, 141, 45, 65, 127, 149, 158, 150, 127, 157, 254, 215, 18,
+			202, 124, 157, 54, 230, 247, 226, 120, 120, 16, 127, 65, 33, 31,
+			87, 72, 78, 188, 209, 197, 187, 137, 213, 107, 204, 145, 20, 115,
+			36, 135, 83, 107, 155, 173, 182, 94, 103, 110, 100, 177, 210, 218,
+			214, 155, 197, 175, 194, 38, 39, 143, 45, 190, 148, 224, 76, 248,
+			149, 85, 236, 181, 196, 233, 186, 189, 240, 116, 155, 175, 222, 126,
+			83, 227, 134, 48, 101, 33, 209, 133, 225, 234, 205, 67, 33, 169,
+			53, 235, 194, 151, 28, 78, 109, 215, 214, 62, 209, 235, 19, 111,
+			78, 121, 71, 161, 224, 159, 67, 129, 222, 182, 210, 220, 176, 123,
+			236, 112, 93, 84, 142, 251, 94, 58, 241, 136, 94, 191, 62, 139,
+			44, 68, 80, 248, 232, 100, 177, 210, 108, 53, 245, 208, 13, 246,
+			1, 252, 197, 196, 141, 252, 120, 202, 20, 255, 28, 212, 240, 122,
+			40, 10, 73, 242, 184, 79, 190, 19, 7, 196, 37, 83, 20, 140,
+			247, 99, 30, 44, 225, 194, 166, 222, 220, 232, 124, 108, 110, 27,
+			58, 251, 114, 87, 252, 109, 216, 124, 97, 56, 109, 136, 255, 142,
+			4, 57, 126, 123, 195, 46, 156, 196, 165, 194, 251, 111, 234, 60,
+			59, 1, 49, 237, 213, 71, 172, 89, 20, 38, 196, 25, 140, 55,
+			245, 141, 218, 218, 11, 115, 85, 111, 119, 232, 74, 214, 50, 120,
+			142, 2, 78, 214, 54, 55, 91, 207, 39, 129, 128, 207, 35, 152,
+			242, 223, 130, 249, 169, 116, 167, 135, 98, 118, 152, 166, 61, 104,
+			235, 205, 181, 248, 33, 126, 14, 162, 244, 86, 37, 60, 7, 81,
+			242, 171, 50, 93, 70, 133, 3, 209, 183, 68, 84, 126, 0, 153,
+			48, 125, 233, 209, 156, 157, 176, 103, 10, 131, 12, 176, 220, 85,
+			37, 90, 6, 241, 156, 86, 229, 242, 51, 56, 117, 100, 234, 225,
+			43, 112, 41, 252, 126, 105, 114, 63, 245, 230, 90, 171, 222, 104,
+			110, 196, 48, 1, 68, 14, 114, 47, 195, 252, 84, 229, 114, 3,
+			242, 211, 9, 132, 207, 193, 153, 157, 206, 250, 67, 243, 89, 109,
+			179, 81, 175, 205, 20, 68, 0, 34, 139, 84, 153, 86, 102, 52,
+			187, 84, 84, 86, 50, 146, 42, 149, 219, 80, 152, 73, 5, 124,
+			30, 138, 162, 66, 57, 202, 171, 69, 152, 77, 14, 126, 9, 90,
+			215, 55, 27, 91, 13, 250, 65, 86, 46, 127, 12, 48, 25, 99,
+			186, 103, 61, 109, 183, 154, 230, 58, 45, 244, 58, 49, 168, 44,
+			240, 49, 85, 37, 90, 143, 28, 30, 120, 85, 190, 158, 162, 59,
+			214, 31, 53, 175, 167, 50, 127, 212, 84, 255, 132, 254, 255, 39,
+			77, 245, 79, 155, 79, 83, 153, 175, 210, 234, 215, 233, 242, 255,
+			69, 128, 39, 153, 21, 221, 121, 124, 10, 153, 232, 18, 133, 191,
+			210, 252, 193, 27, 18, 50, 52, 139, 177, 68, 181, 43, 36, 70,
+			132, 70, 43, 230, 161, 237, 216, 195, 241, 208, 20, 133, 240, 219,
+			43, 102, 97, 32, 104, 6, 97, 189, 158, 130, 72, 190, 21, 194,
+			122, 29, 131, 40, 253, 149, 4, 197, 227, 156, 253, 86, 151, 30,
+			77, 88, 114, 95, 18, 207, 179, 123, 244, 83, 133, 25, 29, 133,
+			148, 183, 31, 133, 22, 99, 134, 130, 237, 227, 85, 186, 99, 189,
+			38, 189, 9, 82, 242, 237, 72, 243, 204, 36, 196, 120, 74, 19,
+			148, 86, 31, 178, 138, 38, 231, 173, 242, 175, 101, 200, 79, 63,
+			139, 196, 117, 200, 12, 92, 241, 228, 136, 143, 246, 242, 91, 94,
+			82, 86, 54, 133, 190, 17, 89, 150, 254, 179, 4, 153, 144, 141,
+			79, 131, 50, 178, 130, 125, 246, 196, 55, 185, 42, 171, 146, 193,
+			104, 202, 247, 71, 150, 83, 148, 39, 124, 74, 211, 47, 53, 3,
+			98, 209, 149, 212, 236, 186, 195, 33, 113, 2, 95, 92, 187, 20,
+			4, 127, 77, 176, 233, 235, 220, 192, 179, 236, 193, 148, 174, 194,
+			116, 213, 80, 16, 41, 63, 134, 179, 33, 110, 143, 4, 86, 119,
+			159, 244, 38, 70, 244, 1, 101, 214, 56, 35, 20, 234, 66, 30,
+			218, 150, 255, 139, 12, 11, 225, 55, 195, 94, 20, 172, 45, 0,
+			203, 113, 220, 32, 30, 174, 195, 199, 188, 67, 118, 149, 90, 100,
+			100, 196, 0, 74, 255, 91, 2, 152, 136, 142, 141, 219, 37, 200,
+			137, 71, 175, 244, 219, 168, 184, 90, 3, 206, 90, 183, 7, 132,
+			222, 186, 237, 146, 190, 237, 136, 87, 76, 156, 8, 31, 3, 40,
+			209, 99, 0, 108, 64, 198, 39, 67, 203, 9, 236, 46, 75, 169,
+			124, 245, 254, 59, 57, 95, 105, 11, 107, 35, 194, 41, 47, 67,
+			38, 228, 70, 235, 99, 2, 167, 1, 181, 245, 142, 42, 209, 143,
+			61, 181, 205, 70, 173, 173, 202, 215, 127, 45, 67, 90, 204, 29,
+			186, 85, 232, 245, 198, 204, 82, 187, 8, 249, 144, 201, 215, 51,
+			245, 239, 165, 227, 204, 109, 163, 213, 105, 85, 213, 223, 30, 102,
+			222, 81, 191, 74, 227, 5, 152, 11, 153, 213, 91, 213, 59, 234,
+			215, 179, 172, 187, 234, 255, 98, 183, 58, 33, 235, 182, 217, 161,
+			235, 101, 171, 185, 249, 66, 149, 226, 130, 106, 76, 32, 227, 11,
+			112, 38, 20, 60, 122, 244, 232, 209, 131, 152, 240, 87, 127, 156,
+			154, 21, 63, 140, 137, 255, 233, 97, 241, 163, 152, 248, 159, 253,
+			113, 10, 47, 66, 46, 20, 111, 213, 62, 85, 191, 249, 230, 155,
+			111, 210, 171, 63, 135, 197, 174, 59, 156, 29, 154, 85, 117, 230,
+			73, 130, 255, 177, 244, 217, 77, 161, 212, 119, 7, 150, 211, 175,
+			184, 94, 127, 242, 66, 159, 126, 167, 240, 99, 239, 244, 71, 187,
+			127, 37, 73, 127, 38, 163, 141, 237, 213, 127, 33, 151, 54, 184,
+			225, 182, 208, 174, 24, 100, 111, 64, 186, 52, 17, 225, 95, 45,
+			193, 71, 125, 183, 210, 221, 247, 220, 161, 61, 30, 50, 212, 193,
+			184, 107, 175, 208, 47, 206, 196, 91, 177, 198, 61, 59, 24, 184,
+			253, 232, 199, 104, 119, 69, 60, 76, 16, 127, 11, 176, 68, 213,
+			43, 92, 189, 18, 106, 149, 222, 250, 55, 4, 215, 59, 0, 108,
+			179, 175, 81, 19, 186, 49, 243, 13, 190, 182, 83, 111, 116, 204,
+			157, 102, 123, 91, 95, 227, 111, 254, 18, 244, 172, 96, 232, 226,
+			162, 148, 253, 174, 215, 214, 232, 131, 176, 5, 152, 55, 116, 126,
+			69, 105, 54, 107, 244, 43, 226, 227, 199, 144, 102, 62, 144, 30,
+			126, 203, 3, 139, 226, 47, 127, 67, 231, 75, 198, 8, 13, 30,
+			63, 23, 79, 182, 241, 155, 223, 184, 9, 195, 124, 85, 171, 28,
+			213, 245, 202, 164, 87, 226, 57, 247, 234, 237, 207, 86, 222, 49,
+			198, 79, 191, 92, 128, 20, 86, 10, 137, 53, 9, 190, 81, 64,
+			154, 195, 168, 144, 192, 165, 71, 90, 157, 236, 217, 244, 74, 90,
+			139, 10, 35, 95, 179, 125, 77, 220, 229, 144, 158, 102, 59, 26,
+			139, 111, 85, 227, 143, 220, 53, 215, 25, 28, 84, 160, 250, 23,
+			138, 182, 230, 142, 14, 60, 187, 191, 31, 104, 213, 91, 213, 170,
+			214, 217, 39, 218, 230, 206, 90, 67, 171, 141, 131, 125, 215, 243,
+			43, 0, 218, 166, 221, 37, 142, 79, 122, 218, 216, 233, 17, 79,
+			11, 246, 137, 86, 27, 89, 93, 170, 201, 37, 55, 180, 103, 196,
+			163, 79, 82, 181, 106, 229, 150, 182, 76, 21, 202, 66, 84, 190,
+			246, 4, 180, 3, 119, 172, 13, 173, 3, 205, 113, 3, 109, 236,
+			19, 45, 216, 183, 125, 141, 174, 101, 26, 121, 221, 37, 163, 128,
+			250, 215, 117, 135, 163, 129, 109, 57, 93, 162, 189, 178, 131, 125,
+			45, 152, 192, 87, 64, 123, 33, 16, 220, 221, 192, 178, 29, 205,
+			210, 186, 238, 232, 64, 115, 247, 226, 106, 154, 21, 0, 104, 236,
+			223, 126, 16, 140, 30, 175, 172, 188, 122, 245, 170, 98, 49, 79,
+			121, 10, 115, 61, 127, 101, 179, 177, 166, 55, 219, 250, 205, 106,
+			229, 22, 128, 182, 227, 12, 136, 239, 107, 30, 249, 233, 216, 246,
+			72, 79, 219, 61, 208, 172, 209, 104, 96, 119, 233, 110, 174, 13,
+			172, 87, 154, 235, 105, 86, 223, 35, 164, 167, 5, 46, 245, 245,
+			149, 103, 7, 182, 211, 191, 161, 249, 238, 94, 240, 202, 242, 8,
+			104, 61, 155, 22, 190, 187, 227, 96, 42, 76, 161, 103, 182, 63,
+			165, 224, 58, 154, 229, 104, 229, 90, 91, 107, 180, 203, 218, 106,
+			173, 221, 104, 223, 0, 237, 121, 163, 243, 113, 107, 167, 163, 61,
+			175, 25, 70, 173, 217, 105, 232, 109, 173, 101, 104, 107, 173, 38,
+			95, 31, 218, 90, 107, 93, 171, 53, 95, 104, 159, 52, 154, 245,
+			27, 26, 177, 131, 125, 226, 105, 228, 53, 45, 107, 125, 205, 245,
+			52, 155, 6, 144, 244, 42, 160, 181, 9, 153, 106, 126, 207, 229,
+			163, 230, 143, 72, 151, 190, 0, 214, 232, 90, 49, 182, 250, 68,
+			235, 211, 243, 7, 75, 157, 17, 241, 134, 182, 79, 7, 209, 215,
+			44, 167, 7, 218, 192, 30, 218, 124, 99, 242, 15, 247, 168, 2,
+			144, 1, 73, 198, 104, 33, 113, 129, 254, 202, 96, 180, 152, 88,
+			135, 44, 200, 153, 92, 244, 19, 37, 48, 58, 149, 184, 14, 6,
+			200, 201, 4, 86, 138, 137, 178, 84, 90, 215, 38, 51, 65, 235,
+			209, 188, 37, 190, 182, 239, 190, 210, 44, 54, 0, 196, 15, 52,
+			54, 61, 104, 254, 122, 164, 235, 122, 61, 158, 190, 212, 125, 54,
+			37, 180, 129, 219, 175, 0, 0, 160, 100, 66, 194, 168, 152, 44,
+			192, 53, 80, 146, 9, 57, 129, 81, 73, 190, 88, 58, 207, 82,
+			56, 194, 160, 41, 23, 226, 84, 0, 230, 32, 73, 85, 37, 170,
+			123, 54, 164, 100, 140, 74, 231, 47, 192, 109, 6, 35, 97, 116,
+			94, 158, 47, 93, 141, 193, 176, 155, 139, 41, 135, 44, 58, 191,
+			34, 56, 137, 217, 100, 66, 74, 198, 232, 124, 110, 14, 126, 37,
+			49, 60, 25, 163, 75, 242, 124, 233, 31, 74, 211, 126, 69, 80,
+			116, 38, 106, 246, 158, 102, 7, 148, 237, 147, 224, 6, 207, 127,
+			59, 240, 69, 195, 30, 25, 13, 172, 46, 75, 76, 208, 44, 141,
+			17, 251, 238, 160, 71, 188, 138, 86, 27, 248, 174, 230, 177, 175,
+			73, 62, 27, 31, 222, 241, 61, 207, 29, 106, 162, 216, 140, 181,
+			245, 210, 182, 52, 190, 102, 70, 190, 203, 18, 245, 47, 244, 157,
+			121, 155, 155, 131, 109, 230, 58, 194, 232, 178, 188, 84, 90, 123,
+			91, 40, 104, 187, 142, 53, 36, 225, 124, 228, 143, 35, 72, 79,
+			243, 8, 63, 164, 68, 173, 33, 137, 66, 22, 66, 74, 198, 232,
+			50, 94, 132, 223, 200, 32, 165, 177, 178, 146, 184, 45, 149, 254,
+			157, 172, 241, 5, 249, 38, 187, 184, 212, 196, 182, 194, 51, 208,
+			15, 92, 151, 134, 97, 146, 13, 3, 183, 175, 241, 93, 70, 27,
+			186, 189, 241, 128, 208, 245, 106, 135, 254, 97, 198, 99, 190, 22,
+			216, 67, 122, 157, 173, 149, 127, 167, 237, 172, 252, 132, 131, 137,
+			151, 38, 90, 103, 223, 118, 250, 190, 246, 183, 197, 106, 227, 141,
+			186, 90, 157, 12, 72, 64, 152, 96, 57, 246, 219, 224, 57, 125,
+			77, 243, 72, 48, 246, 28, 95, 91, 158, 221, 68, 244, 225, 40,
+			56, 184, 22, 97, 105, 162, 199, 218, 242, 145, 219, 136, 216, 149,
+			174, 105, 31, 105, 129, 55, 38, 79, 132, 213, 31, 240, 31, 127,
+			0, 116, 6, 166, 19, 24, 221, 146, 53, 58, 69, 210, 9, 25,
+			163, 149, 244, 85, 254, 91, 161, 124, 224, 252, 36, 70, 183, 114,
+			5, 254, 91, 194, 232, 150, 122, 134, 255, 70, 24, 221, 42, 93,
+			130, 175, 16, 27, 146, 90, 98, 77, 42, 253, 15, 196, 231, 236,
+			239, 48, 34, 157, 125, 114, 160, 89, 30, 225, 11, 43, 95, 71,
+			195, 233, 30, 165, 169, 187, 167, 137, 238, 105, 67, 150, 2, 254,
+			13, 205, 118, 186, 131, 49, 173, 12, 52, 254, 7, 72, 16, 169,
+			127, 167, 3, 45, 26, 209, 14, 143, 100, 52, 80, 116, 185, 167,
+			110, 209, 188, 255, 72, 187, 173, 253, 205, 163, 7, 140, 205, 71,
+			58, 92, 83, 103, 146, 159, 60, 153, 70, 241, 136, 229, 187, 142,
+			246, 145, 86, 61, 1, 14, 157, 191, 179, 0, 129, 251, 5, 161,
+			246, 119, 78, 96, 79, 207, 73, 63, 153, 74, 24, 9, 163, 85,
+			153, 39, 9, 93, 211, 107, 233, 43, 252, 183, 66, 249, 60, 97,
+			164, 20, 70, 171, 185, 83, 252, 55, 213, 63, 125, 142, 255, 70,
+			24, 173, 94, 188, 2, 127, 121, 22, 126, 116, 162, 208, 179, 27,
+			112, 199, 26, 172, 4, 196, 15, 70, 187, 236, 63, 113, 104, 60,
+			123, 164, 231, 84, 161, 244, 187, 29, 71, 203, 63, 134, 84, 155,
+			116, 61, 18, 224, 37, 144, 109, 254, 206, 32, 187, 170, 252, 243,
+			223, 92, 149, 12, 217, 238, 97, 13, 50, 35, 203, 247, 95, 185,
+			30, 127, 240, 195, 101, 178, 17, 113, 203, 255, 70, 130, 36, 155,
+			209, 71, 190, 3, 190, 3, 202, 23, 182, 120, 120, 157, 175, 94,
+			58, 250, 8, 72, 59, 82, 249, 196, 118, 122, 6, 83, 166, 64,
+			129, 213, 247, 197, 223, 160, 178, 223, 248, 17, 164, 124, 230, 168,
+			120, 15, 124, 249, 13, 80, 188, 71, 134, 48, 160, 112, 187, 3,
+			119, 151, 21, 118, 115, 6, 251, 93, 254, 15, 8, 240, 206, 168,
+			103, 77, 231, 48, 46, 198, 187, 192, 186, 138, 68, 71, 126, 0,
+			201, 128, 246, 82, 124, 105, 60, 230, 48, 203, 154, 103, 136, 34,
+			134, 220, 8, 23, 227, 61, 18, 34, 222, 175, 231, 144, 98, 231,
+			9, 95, 252, 193, 224, 163, 55, 0, 31, 118, 184, 178, 201, 108,
+			217, 19, 56, 1, 43, 224, 240, 35, 17, 249, 228, 137, 34, 31,
+			250, 68, 77, 112, 9, 146, 108, 218, 20, 83, 177, 17, 231, 44,
+			252, 4, 210, 60, 172, 190, 248, 19, 215, 19, 12, 68, 104, 65,
+			255, 248, 216, 238, 59, 174, 71, 122, 226, 115, 71, 72, 150, 30,
+			65, 46, 214, 21, 90, 161, 127, 65, 14, 68, 38, 161, 47, 200,
+			193, 244, 251, 25, 36, 222, 207, 60, 150, 31, 74, 215, 223, 3,
+			133, 246, 128, 190, 99, 160, 7, 192, 153, 98, 40, 13, 104, 181,
+			177, 161, 74, 213, 255, 36, 65, 138, 133, 206, 199, 61, 200, 197,
+			66, 137, 111, 190, 83, 200, 75, 111, 29, 250, 50, 139, 37, 254,
+			9, 100, 54, 72, 240, 29, 53, 145, 88, 189, 255, 217, 221, 111,
+			179, 172, 60, 253, 115, 149, 150, 74, 249, 196, 67, 9, 254, 146,
+			151, 74, 249, 4, 254, 125, 189, 243, 251, 122, 231, 119, 172, 119,
+			212, 68, 89, 212, 59, 56, 241, 73, 88, 239, 136, 159, 180, 222,
+			89, 74, 108, 0, 128, 156, 74, 96, 229, 116, 226, 156, 68, 247,
+			200, 20, 173, 83, 78, 103, 242, 48, 7, 74, 138, 214, 41, 202,
+			25, 249, 44, 162, 103, 96, 74, 73, 24, 157, 73, 45, 132, 148,
+			140, 209, 25, 124, 53, 164, 16, 70, 103, 86, 238, 134, 148, 130,
+			81, 81, 185, 13, 5, 200, 48, 234, 151, 191, 17, 140, 28, 131,
+			101, 37, 205, 61, 161, 75, 43, 146, 82, 42, 31, 82, 180, 192,
+			41, 92, 14, 41, 132, 81, 233, 198, 109, 234, 37, 173, 202, 46,
+			36, 52, 41, 170, 166, 46, 36, 179, 144, 11, 171, 169, 139, 242,
+			25, 113, 80, 167, 162, 139, 50, 14, 41, 25, 163, 139, 167, 78,
+			11, 69, 86, 63, 132, 231, 123, 218, 238, 37, 57, 25, 82, 180,
+			154, 200, 100, 105, 75, 74, 2, 43, 229, 196, 123, 172, 37, 133,
+			194, 149, 51, 121, 10, 160, 208, 120, 160, 43, 242, 67, 106, 66,
+			137, 36, 70, 87, 228, 76, 72, 73, 24, 93, 201, 230, 66, 10,
+			97, 116, 37, 95, 8, 169, 12, 70, 87, 212, 7, 52, 28, 84,
+			150, 97, 225, 184, 178, 112, 95, 160, 74, 24, 93, 149, 127, 40,
+			116, 165, 36, 165, 66, 84, 234, 228, 213, 236, 66, 72, 33, 140,
+			174, 46, 157, 10, 169, 12, 70, 87, 79, 127, 36, 80, 37, 142,
+			122, 245, 204, 15, 88, 31, 36, 172, 124, 144, 184, 201, 251, 64,
+			65, 62, 200, 204, 179, 214, 36, 218, 135, 101, 30, 31, 69, 98,
+			125, 88, 22, 173, 73, 108, 128, 151, 179, 243, 33, 133, 48, 90,
+			86, 23, 132, 153, 132, 209, 53, 89, 21, 34, 122, 194, 186, 38,
+			167, 66, 138, 202, 210, 185, 80, 134, 48, 186, 150, 47, 8, 51,
+			25, 163, 235, 242, 57, 33, 146, 21, 74, 65, 72, 37, 49, 186,
+			158, 91, 8, 41, 9, 163, 235, 248, 116, 72, 33, 140, 174, 159,
+			45, 9, 16, 132, 209, 135, 242, 146, 16, 161, 20, 165, 66, 151,
+			105, 149, 246, 97, 182, 16, 82, 84, 19, 47, 10, 51, 5, 163,
+			27, 114, 136, 175, 36, 41, 149, 14, 41, 9, 163, 27, 153, 185,
+			144, 66, 24, 221, 40, 168, 44, 112, 50, 45, 238, 30, 242, 192,
+			209, 130, 115, 37, 195, 189, 144, 105, 224, 110, 201, 53, 102, 34,
+			179, 192, 221, 18, 94, 200, 44, 112, 183, 68, 224, 100, 22, 184,
+			91, 234, 66, 72, 101, 48, 186, 133, 127, 204, 134, 73, 14, 7,
+			255, 214, 226, 143, 4, 170, 132, 209, 109, 249, 177, 208, 165, 113,
+			189, 45, 156, 148, 89, 92, 111, 103, 66, 84, 9, 97, 116, 59,
+			66, 149, 50, 24, 221, 198, 143, 4, 170, 24, 252, 219, 139, 15,
+			5, 170, 140, 81, 85, 94, 19, 186, 52, 236, 85, 17, 118, 89,
+			150, 147, 24, 85, 69, 216, 101, 153, 118, 177, 42, 194, 46, 179,
+			176, 87, 207, 150, 66, 42, 131, 81, 245, 220, 170, 104, 67, 230,
+			109, 84, 207, 215, 68, 27, 8, 163, 59, 242, 199, 66, 151, 142,
+			202, 29, 49, 70, 50, 27, 149, 59, 167, 206, 133, 20, 213, 188,
+			120, 41, 164, 50, 24, 221, 209, 54, 4, 42, 226, 168, 119, 46,
+			175, 11, 84, 5, 163, 187, 98, 138, 201, 178, 146, 162, 84, 42,
+			164, 36, 140, 238, 138, 60, 147, 217, 160, 221, 21, 83, 76, 150,
+			149, 12, 70, 119, 197, 20, 163, 20, 67, 189, 43, 166, 24, 235,
+			243, 61, 249, 137, 208, 77, 50, 42, 28, 187, 164, 132, 209, 189,
+			108, 94, 140, 93, 18, 97, 116, 111, 1, 139, 177, 75, 102, 48,
+			186, 183, 248, 88, 160, 38, 185, 175, 247, 150, 30, 9, 212, 20,
+			70, 247, 229, 139, 66, 55, 165, 80, 42, 140, 114, 138, 202, 162,
+			40, 167, 36, 140, 238, 227, 179, 34, 202, 41, 132, 209, 253, 243,
+			23, 4, 72, 26, 163, 7, 242, 41, 1, 146, 78, 82, 42, 116,
+			45, 45, 97, 244, 32, 171, 10, 215, 210, 8, 163, 7, 139, 75,
+			187, 169, 145, 231, 6, 238, 157, 255, 63, 0, 133, 157, 155, 180,
+			233, 70, 0, 0},
+	)
+}
+
+// FileDescriptorSet returns a descriptor set for this proto package, which
+// includes all defined services, and all transitive dependencies.
+//
+// Will not return nil.
+//
+// Do NOT modify the returned descriptor.
+func FileDescriptorSet() *descriptorpb.FileDescriptorSet {
+	// We just need ONE of the service names to look up the FileDescriptorSet.
+	ret, err := discovery.GetDescriptorSet("luci.server.auditlog.test.Things")
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
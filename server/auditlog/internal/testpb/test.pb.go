@@ -0,0 +1,648 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: go.chromium.org/luci/server/auditlog/internal/testpb/test.proto
+
+package testpb
+
+import prpc "go.chromium.org/luci/grpc/prpc"
+
+import (
+	context "context"
+	_ "go.chromium.org/luci/server/auditlog/auditlogpb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Kind int32
+
+const (
+	Kind_KIND_UNSPECIFIED Kind = 0
+	Kind_BIG              Kind = 1
+)
+
+// Enum value maps for Kind.
+var (
+	Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "BIG",
+	}
+	Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"BIG":              1,
+	}
+)
+
+func (x Kind) Enum() *Kind {
+	p := new(Kind)
+	*p = x
+	return p
+}
+
+func (x Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_enumTypes[0].Descriptor()
+}
+
+func (Kind) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_enumTypes[0]
+}
+
+func (x Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Kind.Descriptor instead.
+func (Kind) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescGZIP(), []int{0}
+}
+
+type Secret struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *Secret) Reset() {
+	*x = Secret{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Secret) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Secret) ProtoMessage() {}
+
+func (x *Secret) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Secret) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Secret) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type Thing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind   Kind     `protobuf:"varint,2,opt,name=kind,proto3,enum=luci.server.auditlog.test.Kind" json:"kind,omitempty"`
+	Tags   []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Secret *Secret  `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Blob   []byte   `protobuf:"bytes,5,opt,name=blob,proto3" json:"blob,omitempty"`
+}
+
+func (x *Thing) Reset() {
+	*x = Thing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Thing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thing) ProtoMessage() {}
+
+func (x *Thing) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thing.ProtoReflect.Descriptor instead.
+func (*Thing) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Thing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Thing) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *Thing) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Thing) GetSecret() *Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *Thing) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+type UpdateThingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Thing   *Thing           `protobuf:"bytes,2,opt,name=thing,proto3" json:"thing,omitempty"`
+	Tags    []string         `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	Limits  map[string]int64 `protobuf:"bytes,4,rep,name=limits,proto3" json:"limits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Kind    Kind             `protobuf:"varint,5,opt,name=kind,proto3,enum=luci.server.auditlog.test.Kind" json:"kind,omitempty"`
+	Token   string           `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	Secrets []*Secret        `protobuf:"bytes,7,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	Ignored string           `protobuf:"bytes,8,opt,name=ignored,proto3" json:"ignored,omitempty"`
+}
+
+func (x *UpdateThingRequest) Reset() {
+	*x = UpdateThingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateThingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateThingRequest) ProtoMessage() {}
+
+func (x *UpdateThingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateThingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateThingRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateThingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateThingRequest) GetThing() *Thing {
+	if x != nil {
+		return x.Thing
+	}
+	return nil
+}
+
+func (x *UpdateThingRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateThingRequest) GetLimits() map[string]int64 {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *UpdateThingRequest) GetKind() Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Kind_KIND_UNSPECIFIED
+}
+
+func (x *UpdateThingRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateThingRequest) GetSecrets() []*Secret {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+func (x *UpdateThingRequest) GetIgnored() string {
+	if x != nil {
+		return x.Ignored
+	}
+	return ""
+}
+
+var File_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDesc = []byte{
+	0x0a, 0x3f, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x19, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x67, 0x6f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
+	0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c,
+	0x6f, 0x67, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x70, 0x62, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x06, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0xa0, 0xc9, 0x24, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xa0,
+	0xc9, 0x24, 0x02, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xb3, 0x01,
+	0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62,
+	0x6c, 0x6f, 0x62, 0x22, 0xc8, 0x03, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x68,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xa0, 0xc9, 0x24, 0x03, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x54, 0x68, 0x69, 0x6e, 0x67, 0x42, 0x04, 0xa0, 0xc9, 0x24, 0x01, 0x52, 0x05, 0x74, 0x68, 0x69,
+	0x6e, 0x67, 0x12, 0x18, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x04, 0xa0, 0xc9, 0x24, 0x01, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x57, 0x0a, 0x06,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6c,
+	0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xa0, 0xc9, 0x24, 0x01, 0x52, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x4b, 0x69, 0x6e, 0x64, 0x42, 0x04, 0xa0, 0xc9, 0x24, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x1a, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x04, 0xa0, 0xc9, 0x24, 0x02, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x07,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x07, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x25,
+	0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x42, 0x49, 0x47, 0x10, 0x01, 0x32, 0xcd, 0x01, 0x0a, 0x06, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x64, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x2d, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68, 0x69, 0x6e, 0x67,
+	0x22, 0x04, 0xa0, 0xc9, 0x24, 0x01, 0x12, 0x5d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x68, 0x69,
+	0x6e, 0x67, 0x12, 0x2d, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68,
+	0x69, 0x6e, 0x67, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f,
+	0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescData = file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDesc
+)
+
+func file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescData)
+	})
+	return file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_goTypes = []interface{}{
+	(Kind)(0),                  // 0: luci.server.auditlog.test.Kind
+	(*Secret)(nil),             // 1: luci.server.auditlog.test.Secret
+	(*Thing)(nil),              // 2: luci.server.auditlog.test.Thing
+	(*UpdateThingRequest)(nil), // 3: luci.server.auditlog.test.UpdateThingRequest
+	nil,                        // 4: luci.server.auditlog.test.UpdateThingRequest.LimitsEntry
+}
+var file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_depIdxs = []int32{
+	0, // 0: luci.server.auditlog.test.Thing.kind:type_name -> luci.server.auditlog.test.Kind
+	1, // 1: luci.server.auditlog.test.Thing.secret:type_name -> luci.server.auditlog.test.Secret
+	2, // 2: luci.server.auditlog.test.UpdateThingRequest.thing:type_name -> luci.server.auditlog.test.Thing
+	4, // 3: luci.server.auditlog.test.UpdateThingRequest.limits:type_name -> luci.server.auditlog.test.UpdateThingRequest.LimitsEntry
+	0, // 4: luci.server.auditlog.test.UpdateThingRequest.kind:type_name -> luci.server.auditlog.test.Kind
+	1, // 5: luci.server.auditlog.test.UpdateThingRequest.secrets:type_name -> luci.server.auditlog.test.Secret
+	3, // 6: luci.server.auditlog.test.Things.UpdateThing:input_type -> luci.server.auditlog.test.UpdateThingRequest
+	3, // 7: luci.server.auditlog.test.Things.GetThing:input_type -> luci.server.auditlog.test.UpdateThingRequest
+	2, // 8: luci.server.auditlog.test.Things.UpdateThing:output_type -> luci.server.auditlog.test.Thing
+	2, // 9: luci.server.auditlog.test.Things.GetThing:output_type -> luci.server.auditlog.test.Thing
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_init() }
+func file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_init() {
+	if File_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Thing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateThingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_goTypes,
+		DependencyIndexes: file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_depIdxs,
+		EnumInfos:         file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_enumTypes,
+		MessageInfos:      file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_msgTypes,
+	}.Build()
+	File_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto = out.File
+	file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_rawDesc = nil
+	file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_goTypes = nil
+	file_go_chromium_org_luci_server_auditlog_internal_testpb_test_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ThingsClient is the client API for Things service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ThingsClient interface {
+	UpdateThing(ctx context.Context, in *UpdateThingRequest, opts ...grpc.CallOption) (*Thing, error)
+	GetThing(ctx context.Context, in *UpdateThingRequest, opts ...grpc.CallOption) (*Thing, error)
+}
+type thingsPRPCClient struct {
+	client *prpc.Client
+}
+
+func NewThingsPRPCClient(client *prpc.Client) ThingsClient {
+	return &thingsPRPCClient{client}
+}
+
+func (c *thingsPRPCClient) UpdateThing(ctx context.Context, in *UpdateThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.auditlog.test.Things", "UpdateThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsPRPCClient) GetThing(ctx context.Context, in *UpdateThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.auditlog.test.Things", "GetThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type thingsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThingsClient(cc grpc.ClientConnInterface) ThingsClient {
+	return &thingsClient{cc}
+}
+
+func (c *thingsClient) UpdateThing(ctx context.Context, in *UpdateThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.auditlog.test.Things/UpdateThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsClient) GetThing(ctx context.Context, in *UpdateThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.auditlog.test.Things/GetThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThingsServer is the server API for Things service.
+type ThingsServer interface {
+	UpdateThing(context.Context, *UpdateThingRequest) (*Thing, error)
+	GetThing(context.Context, *UpdateThingRequest) (*Thing, error)
+}
+
+// UnimplementedThingsServer can be embedded to have forward compatible implementations.
+type UnimplementedThingsServer struct {
+}
+
+func (*UnimplementedThingsServer) UpdateThing(context.Context, *UpdateThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateThing not implemented")
+}
+func (*UnimplementedThingsServer) GetThing(context.Context, *UpdateThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThing not implemented")
+}
+
+func RegisterThingsServer(s prpc.Registrar, srv ThingsServer) {
+	s.RegisterService(&_Things_serviceDesc, srv)
+}
+
+func _Things_UpdateThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).UpdateThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.auditlog.test.Things/UpdateThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).UpdateThing(ctx, req.(*UpdateThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Things_GetThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).GetThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.auditlog.test.Things/GetThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).GetThing(ctx, req.(*UpdateThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Things_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "luci.server.auditlog.test.Things",
+	HandlerType: (*ThingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateThing",
+			Handler:    _Things_UpdateThing_Handler,
+		},
+		{
+			MethodName: "GetThing",
+			Handler:    _Things_GetThing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "go.chromium.org/luci/server/auditlog/internal/testpb/test.proto",
+}
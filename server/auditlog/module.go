@@ -0,0 +1,145 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/auditlog/auditlogpb"
+	"go.chromium.org/luci/server/bqlog"
+	"go.chromium.org/luci/server/module"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/server/auditlog")
+
+// ModuleOptions contain configuration of the auditlog server module.
+type ModuleOptions struct {
+	// Sink is where to send audit log entries.
+	//
+	// If set, SinkSpec and BigQueryTable are ignored.
+	Sink Sink
+
+	// SinkSpec defines where to send audit log entries.
+	//
+	// Either "bigquery" to send them to BigQuery through the bqlog module, or
+	// "file:<path>" to append them to a local file as newline-delimited JSON.
+	// The "bigquery" sink requires the bqlog module to be installed.
+	//
+	// Default is "bigquery".
+	SinkSpec string
+
+	// BigQueryTable is a name of the BigQuery table within the bqlog dataset.
+	//
+	// Default is "audit".
+	BigQueryTable string
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	if o.SinkSpec == "" {
+		o.SinkSpec = "bigquery"
+	}
+	if o.BigQueryTable == "" {
+		o.BigQueryTable = "audit"
+	}
+	f.StringVar(&o.SinkSpec, "auditlog-sink", o.SinkSpec,
+		`Where to send audit logs: either "bigquery" or "file:<path>".`)
+	f.StringVar(&o.BigQueryTable, "auditlog-bq-table", o.BigQueryTable,
+		`BigQuery table within -bqlog-dataset to send audit logs to, if -auditlog-sink is "bigquery".`)
+}
+
+// NewModule returns a server module that records calls to audited methods.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &auditlogModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// auditlogModule implements module.Module.
+type auditlogModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*auditlogModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*auditlogModule) Dependencies() []module.Dependency {
+	return []module.Dependency{
+		module.OptionalDependency(bqlog.ModuleName),
+	}
+}
+
+// Initialize is part of module.Module interface.
+func (m *auditlogModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	sink := m.opts.Sink
+	if sink == nil {
+		switch spec := m.opts.SinkSpec; {
+		case spec == "" || spec == "bigquery":
+			// The bqlog module, when installed, is initialized before us and always
+			// populates the dataset name (using a phony one in the dev mode). Without
+			// it bqlog.Default is never started and logging to it panics.
+			if bqlog.Default.Dataset == "" {
+				return nil, errors.Reason("-auditlog-sink \"bigquery\" requires module %q", bqlog.ModuleName).Err()
+			}
+			table := m.opts.BigQueryTable
+			if table == "" {
+				table = "audit"
+			}
+			bqlog.RegisterSink(bqlog.Sink{
+				Prototype: &auditlogpb.Entry{},
+				Table:     table,
+			})
+			sink = bqSink{bundler: &bqlog.Default}
+		case strings.HasPrefix(spec, "file:"):
+			path := strings.TrimPrefix(spec, "file:")
+			f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+			if err != nil {
+				return nil, errors.Annotate(err, "failed to open the audit log file").Err()
+			}
+			host.RegisterCleanup(func(ctx context.Context) {
+				if err := f.Close(); err != nil {
+					logging.Errorf(ctx, "Failed to close the audit log file: %s", err)
+				}
+			})
+			sink = &writerSink{w: f}
+		default:
+			return nil, errors.Reason("bad -auditlog-sink %q: expecting \"bigquery\" or \"file:<path>\"", spec).Err()
+		}
+	}
+	host.RegisterUnaryServerInterceptor(newInterceptor(sink))
+	return ctx, nil
+}
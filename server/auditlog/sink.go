@@ -0,0 +1,76 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditlog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/auditlog/auditlogpb"
+	"go.chromium.org/luci/server/bqlog"
+)
+
+// Sink receives audit log entries.
+//
+// Log is called synchronously at the end of each audited call. It must be
+// safe for concurrent use and it should not block for long.
+type Sink interface {
+	Log(ctx context.Context, entry *auditlogpb.Entry)
+}
+
+// bqSink sends entries to BigQuery through a bqlog bundler.
+type bqSink struct {
+	bundler *bqlog.Bundler
+}
+
+// Log implements Sink.
+func (s bqSink) Log(ctx context.Context, entry *auditlogpb.Entry) {
+	s.bundler.Log(ctx, entry)
+}
+
+// writerSink writes entries to an io.Writer as newline-delimited JSON.
+type writerSink struct {
+	m sync.Mutex
+	w io.Writer
+}
+
+// Log implements Sink.
+func (s *writerSink) Log(ctx context.Context, entry *auditlogpb.Entry) {
+	blob, err := protojson.Marshal(entry)
+	if err != nil {
+		logging.Errorf(ctx, "Failed to marshal audit log entry: %s", err)
+		return
+	}
+	// protojson output is unstable, make it one line.
+	buf := bytes.Buffer{}
+	if err := json.Compact(&buf, blob); err != nil {
+		logging.Errorf(ctx, "Failed to compact audit log entry: %s", err)
+		return
+	}
+	buf.WriteByte('\n')
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if _, err := s.w.Write(buf.Bytes()); err != nil {
+		logging.Errorf(ctx, "Failed to write audit log entry: %s", err)
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authdb
+
+import (
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	"go.chromium.org/luci/server/auth/authdb/internal/graph"
+	"go.chromium.org/luci/server/auth/authdb/internal/realmset"
+)
+
+// Grant is a permission granted to a principal in a realm.
+type Grant struct {
+	// Realm is a full realm name, e.g. "project:realm".
+	Realm string
+	// Permission is a permission name, e.g. "luci.dev.testing".
+	Permission string
+	// Principal is an identity or a glob, e.g. "user:someone@example.com".
+	//
+	// Groups are expanded into the identities and globs they include.
+	Principal string
+	// Conditions describe conditions of the binding that grants the permission.
+	//
+	// Empty for unconditional grants.
+	Conditions []string
+}
+
+// PermissionsDiff is a difference between permissions granted by two AuthDBs.
+type PermissionsDiff struct {
+	// Gained are grants present only in the new AuthDB.
+	Gained []Grant
+	// Lost are grants present only in the old AuthDB.
+	Lost []Grant
+}
+
+// DiffPermissions compares permissions granted by two AuthDB snapshots.
+//
+// It expands groups into identities and globs they include and reports which
+// of them gained or lost which permissions in which realms. This reflects the
+// effect of both realms.cfg and groups changes.
+//
+// Realms are compared as they are in the AuthDB, i.e. a removed realm
+// is reported as losing all its grants even though checks in it would now fall
+// back to the project's root realm.
+func DiffPermissions(old, new *protocol.AuthDB) (*PermissionsDiff, error) {
+	oldGrants, err := allGrants(old)
+	if err != nil {
+		return nil, errors.Annotate(err, "old AuthDB").Err()
+	}
+	newGrants, err := allGrants(new)
+	if err != nil {
+		return nil, errors.Annotate(err, "new AuthDB").Err()
+	}
+
+	diff := &PermissionsDiff{}
+	for key, grant := range newGrants {
+		if _, ok := oldGrants[key]; !ok {
+			diff.Gained = append(diff.Gained, grant)
+		}
+	}
+	for key, grant := range oldGrants {
+		if _, ok := newGrants[key]; !ok {
+			diff.Lost = append(diff.Lost, grant)
+		}
+	}
+	sortGrants(diff.Gained)
+	sortGrants(diff.Lost)
+	return diff, nil
+}
+
+// allGrants returns all grants in the AuthDB keyed by grantKey.
+func allGrants(authDB *protocol.AuthDB) (map[string]Grant, error) {
+	realmsDB := authDB.GetRealms()
+	if realmsDB == nil {
+		return nil, errors.Reason("Realms API is not available").Err()
+	}
+	if realmsDB.ApiVersion != realmset.ExpectedAPIVersion {
+		return nil, errors.Reason("Realms proto has api_version %d, expecting %d", realmsDB.ApiVersion, realmset.ExpectedAPIVersion).Err()
+	}
+	groups, err := graph.Build(authDB.GetGroups())
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to build groups graph").Err()
+	}
+
+	expanded := map[string][]string{}
+	expand := func(principal string) []string {
+		if !strings.HasPrefix(principal, "group:") {
+			return []string{principal}
+		}
+		if out, ok := expanded[principal]; ok {
+			return out
+		}
+		out := expandGroup(groups, strings.TrimPrefix(principal, "group:"))
+		expanded[principal] = out
+		return out
+	}
+
+	grants := map[string]Grant{}
+	for _, realm := range realmsDB.Realms {
+		for _, binding := range realm.Bindings {
+			var conds []string
+			for _, idx := range binding.Conditions {
+				if int(idx) >= len(realmsDB.Conditions) {
+					return nil, errors.Reason("binding in realm %q refers to an unknown condition %d", realm.Name, idx).Err()
+				}
+				conds = append(conds, describeCondition(realmsDB.Conditions[idx]))
+			}
+			sort.Strings(conds)
+
+			for _, permIdx := range binding.Permissions {
+				if int(permIdx) >= len(realmsDB.Permissions) {
+					return nil, errors.Reason("binding in realm %q refers to an unknown permission %d", realm.Name, permIdx).Err()
+				}
+				perm := realmsDB.Permissions[permIdx].Name
+				for _, principal := range binding.Principals {
+					for _, p := range expand(principal) {
+						grant := Grant{
+							Realm:      realm.Name,
+							Permission: perm,
+							Principal:  p,
+							Conditions: conds,
+						}
+						grants[grantKey(&grant)] = grant
+					}
+				}
+			}
+		}
+	}
+	return grants, nil
+}
+
+// expandGroup returns identities and globs included in the group, directly or
+// via nested groups. Unknown groups are considered empty.
+func expandGroup(groups *graph.Graph, name string) []string {
+	root := groups.NodeByName(name)
+	if root == nil {
+		return nil
+	}
+	out := stringset.New(0)
+	seen := map[graph.NodeIndex]bool{root.Index: true}
+	queue := []graph.NodeIndex{root.Index}
+	for len(queue) > 0 {
+		node := &groups.Nodes[queue[0]]
+		queue = queue[1:]
+		out.AddAll(node.Members)
+		out.AddAll(node.Globs)
+		for _, nested := range node.Nested {
+			if !seen[nested] {
+				seen[nested] = true
+				queue = append(queue, nested)
+			}
+		}
+	}
+	return out.ToSortedSlice()
+}
+
+func grantKey(g *Grant) string {
+	return strings.Join(append([]string{g.Realm, g.Permission, g.Principal}, g.Conditions...), "\x00")
+}
+
+func sortGrants(grants []Grant) {
+	sort.Slice(grants, func(i, j int) bool {
+		return grantKey(&grants[i]) < grantKey(&grants[j])
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authdb
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	"go.chromium.org/luci/server/auth/authdb/internal/realmset"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestDiffPermissions(t *testing.T) {
+	t.Parallel()
+
+	Convey("DiffPermissions", t, func() {
+		old := &protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{
+				{Name: "outer", Nested: []string{"inner"}},
+				{Name: "inner", Members: []string{"user:a@example.com"}},
+			},
+			Realms: &protocol.Realms{
+				ApiVersion: realmset.ExpectedAPIVersion,
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.p1"},
+					{Name: "luci.dev.p2"},
+				},
+				Conditions: []*protocol.Condition{
+					{
+						Op: &protocol.Condition_Restrict{
+							Restrict: &protocol.Condition_AttributeRestriction{
+								Attribute: "a",
+								Values:    []string{"x"},
+							},
+						},
+					},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "proj:realm",
+						Bindings: []*protocol.Binding{
+							{Permissions: []uint32{0}, Principals: []string{"group:outer"}},
+							{Permissions: []uint32{1}, Principals: []string{"user:b@example.com"}, Conditions: []uint32{0}},
+						},
+					},
+				},
+			},
+		}
+		new := proto.Clone(old).(*protocol.AuthDB)
+
+		Convey("No changes", func() {
+			diff, err := DiffPermissions(old, new)
+			So(err, ShouldBeNil)
+			So(diff.Gained, ShouldBeEmpty)
+			So(diff.Lost, ShouldBeEmpty)
+		})
+
+		Convey("Group membership change", func() {
+			new.Groups[1].Members = []string{"user:c@example.com"}
+			new.Groups[1].Globs = []string{"user:*@glob.example.com"}
+			diff, err := DiffPermissions(old, new)
+			So(err, ShouldBeNil)
+			So(diff.Gained, ShouldResemble, []Grant{
+				{Realm: "proj:realm", Permission: "luci.dev.p1", Principal: "user:*@glob.example.com"},
+				{Realm: "proj:realm", Permission: "luci.dev.p1", Principal: "user:c@example.com"},
+			})
+			So(diff.Lost, ShouldResemble, []Grant{
+				{Realm: "proj:realm", Permission: "luci.dev.p1", Principal: "user:a@example.com"},
+			})
+		})
+
+		Convey("Binding change", func() {
+			new.Realms.Realms[0].Bindings[1].Conditions = nil
+			new.Realms.Realms = append(new.Realms.Realms, &protocol.Realm{
+				Name: "proj:another",
+				Bindings: []*protocol.Binding{
+					{Permissions: []uint32{0, 1}, Principals: []string{"group:unknown", "user:d@example.com"}},
+				},
+			})
+			diff, err := DiffPermissions(old, new)
+			So(err, ShouldBeNil)
+			So(diff.Gained, ShouldResemble, []Grant{
+				{Realm: "proj:another", Permission: "luci.dev.p1", Principal: "user:d@example.com"},
+				{Realm: "proj:another", Permission: "luci.dev.p2", Principal: "user:d@example.com"},
+				{Realm: "proj:realm", Permission: "luci.dev.p2", Principal: "user:b@example.com"},
+			})
+			So(diff.Lost, ShouldResemble, []Grant{
+				{
+					Realm:      "proj:realm",
+					Permission: "luci.dev.p2",
+					Principal:  "user:b@example.com",
+					Conditions: []string{`attribute "a" in [x]`},
+				},
+			})
+		})
+
+		Convey("No realms", func() {
+			_, err := DiffPermissions(old, &protocol.AuthDB{})
+			So(err, ShouldErrLike, "new AuthDB: Realms API is not available")
+		})
+	})
+}
@@ -0,0 +1,326 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authdb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	"go.chromium.org/luci/server/auth/authdb/internal/graph"
+)
+
+// Explanation describes why an identity has or doesn't have a permission in
+// a realm.
+//
+// Roles are expanded into permissions when realms are compiled, so they are
+// not present in the AuthDB. Instead, each binding lists all permissions it
+// grants, which usually identifies the role.
+type Explanation struct {
+	// Granted is true if the identity has the permission.
+	//
+	// It matches what HasPermission returns for the same AuthDB.
+	Granted bool
+
+	// Realm is the realm whose bindings were checked.
+	//
+	// It is either the requested realm or its root realm if the requested realm
+	// doesn't exist. Empty if neither exists.
+	Realm string
+
+	// Notes are human readable remarks about the check, e.g. the fallback to
+	// the root realm.
+	Notes []string
+
+	// Bindings are all bindings in the realm that mention the permission.
+	Bindings []BindingExplanation
+}
+
+// BindingExplanation describes how a single binding applies to an identity.
+type BindingExplanation struct {
+	// Principals are the principals of the binding as they are in the AuthDB,
+	// e.g. "group:some-group" or "user:someone@example.com".
+	Principals []string
+
+	// Permissions are all permissions granted by the binding.
+	Permissions []string
+
+	// Conditions are the conditions of the binding, all must be satisfied for
+	// the binding to apply.
+	Conditions []ConditionExplanation
+
+	// Memberships describe how the identity matches the principals of the
+	// binding. Empty if it matches none of them.
+	Memberships []Membership
+
+	// Granted is true if the binding grants the permission to the identity.
+	Granted bool
+}
+
+// ConditionExplanation describes an evaluated condition of a binding.
+type ConditionExplanation struct {
+	// Description is a human readable description of the condition.
+	Description string
+
+	// Satisfied is true if the condition holds for the given attributes.
+	Satisfied bool
+}
+
+// Membership describes how an identity matches a principal of a binding.
+type Membership struct {
+	// Principal is the principal of the binding.
+	Principal string
+
+	// Groups is the chain of nested groups from the principal's group to the
+	// group which includes the identity directly or via a glob. Empty if the
+	// principal is the identity itself.
+	Groups []string
+
+	// Via is the member or the glob of the last group in Groups that matches
+	// the identity.
+	Via string
+}
+
+// Explainer explains permission checks against a single AuthDB snapshot.
+//
+// It is relatively expensive to construct, since it prepares all permissions
+// in the AuthDB for checks. It is supposed to be constructed once per AuthDB
+// revision and reused. Safe for concurrent use.
+type Explainer struct {
+	Rev int64 // revision of the AuthDB
+
+	db     *SnapshotDB                // performs the actual checks
+	realms *protocol.Realms           // realms as they are in the AuthDB
+	byName map[string]*protocol.Realm // realm name -> the realm
+	groups *graph.Graph               // used to find chains of nested groups
+}
+
+// NewExplainer prepares the AuthDB for explaining permission checks.
+//
+// Unlike checks done by SnapshotDB, permissions don't have to be registered in
+// the current process, which makes Explainer usable with any AuthDB snapshot.
+//
+// The AuthDB is assumed to be validated already.
+func NewExplainer(authDB *protocol.AuthDB, rev int64) (*Explainer, error) {
+	db, err := newSnapshotDB(authDB, "", rev, false, true)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := graph.Build(authDB.GetGroups())
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to build groups graph").Err()
+	}
+	realmsDB := authDB.GetRealms()
+	byName := make(map[string]*protocol.Realm, len(realmsDB.GetRealms()))
+	for _, r := range realmsDB.GetRealms() {
+		byName[r.Name] = r
+	}
+	return &Explainer{
+		Rev:    rev,
+		db:     db,
+		realms: realmsDB,
+		byName: byName,
+		groups: groups,
+	}, nil
+}
+
+// ExplainPermission explains whether the identity has the given permission in
+// the realm.
+//
+// It uses the same code path as SnapshotDB.HasPermission to decide whether
+// the permission is granted and additionally reports the bindings, conditions
+// and group memberships that are relevant to the check.
+//
+// Returns an error if the arguments are malformed or the AuthDB has no realms.
+func (e *Explainer) ExplainPermission(ctx context.Context, id identity.Identity, perm, realm string, attrs realms.Attrs) (*Explanation, error) {
+	if err := id.Validate(); err != nil {
+		return nil, err
+	}
+	if err := realms.ValidatePermissionName(perm); err != nil {
+		return nil, err
+	}
+	if err := realms.ValidateRealmName(realm, realms.GlobalScope); err != nil {
+		return nil, err
+	}
+	if e.db.realms == nil {
+		return nil, errors.Reason("Realms API is not available").Err()
+	}
+
+	ret := &Explanation{}
+
+	permIdx, ok := e.db.realms.PermissionIndexByName(perm)
+	if !ok {
+		ret.Notes = append(ret.Notes, fmt.Sprintf("permission %q is not present in the AuthDB", perm))
+		return ret, nil
+	}
+
+	target, note, err := e.db.resolveRealm(realm)
+	if err != nil {
+		return nil, err
+	}
+	if note != "" {
+		ret.Notes = append(ret.Notes, note)
+	}
+	if target == "" {
+		return ret, nil
+	}
+	ret.Realm = target
+	ret.Granted = e.db.checkBindings(ctx, id, permIdx, target, attrs)
+
+	for _, binding := range e.byName[target].GetBindings() {
+		if !hasPermissionIndex(binding.Permissions, uint32(permIdx)) {
+			continue
+		}
+		exp := BindingExplanation{
+			Principals:  binding.Principals,
+			Permissions: make([]string, 0, len(binding.Permissions)),
+		}
+		for _, idx := range binding.Permissions {
+			if int(idx) < len(e.realms.Permissions) {
+				exp.Permissions = append(exp.Permissions, e.realms.Permissions[idx].Name)
+			}
+		}
+
+		satisfied := true
+		for _, idx := range binding.Conditions {
+			if int(idx) >= len(e.realms.Conditions) {
+				return nil, errors.Reason("binding in realm %q refers to an unknown condition %d", target, idx).Err()
+			}
+			cond := explainCondition(e.realms.Conditions[idx], attrs)
+			satisfied = satisfied && cond.Satisfied
+			exp.Conditions = append(exp.Conditions, cond)
+		}
+
+		for _, principal := range binding.Principals {
+			if m, ok := explainPrincipal(e.groups, id, principal); ok {
+				exp.Memberships = append(exp.Memberships, m)
+			}
+		}
+
+		exp.Granted = satisfied && len(exp.Memberships) > 0
+		ret.Bindings = append(ret.Bindings, exp)
+	}
+	if len(ret.Bindings) == 0 {
+		ret.Notes = append(ret.Notes, fmt.Sprintf("no bindings in realm %q grant %q", target, perm))
+	}
+	return ret, nil
+}
+
+func hasPermissionIndex(perms []uint32, idx uint32) bool {
+	for _, p := range perms {
+		if p == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// explainCondition evaluates the condition the same way conds package does.
+func explainCondition(cond *protocol.Condition, attrs realms.Attrs) ConditionExplanation {
+	exp := ConditionExplanation{Description: describeCondition(cond)}
+	restrict := cond.GetRestrict()
+	if restrict == nil {
+		return exp
+	}
+	val, known := attrs[restrict.Attribute]
+	if !known {
+		exp.Description += " (attribute is not set)"
+		return exp
+	}
+	exp.Description += fmt.Sprintf(" (attribute is %q)", val)
+	for _, v := range restrict.Values {
+		if v == val {
+			exp.Satisfied = true
+			break
+		}
+	}
+	return exp
+}
+
+// describeCondition returns a human readable description of the condition.
+func describeCondition(cond *protocol.Condition) string {
+	restrict := cond.GetRestrict()
+	if restrict == nil {
+		return fmt.Sprintf("unrecognized condition %q", cond)
+	}
+	values := append([]string(nil), restrict.Values...)
+	sort.Strings(values)
+	return fmt.Sprintf("attribute %q in [%s]", restrict.Attribute, strings.Join(values, ", "))
+}
+
+// explainPrincipal checks if the identity matches the principal, returning the
+// shortest chain of nested groups that leads to the identity.
+func explainPrincipal(groups *graph.Graph, id identity.Identity, principal string) (Membership, bool) {
+	if !strings.HasPrefix(principal, "group:") {
+		if principal == string(id) {
+			return Membership{Principal: principal, Via: principal}, true
+		}
+		return Membership{}, false
+	}
+	root := groups.NodeByName(strings.TrimPrefix(principal, "group:"))
+	if root == nil {
+		return Membership{}, false // unknown groups are considered empty
+	}
+
+	// Breadth-first search through nested groups to find the shortest chain.
+	prev := map[graph.NodeIndex]graph.NodeIndex{root.Index: root.Index}
+	queue := []graph.NodeIndex{root.Index}
+	for len(queue) > 0 {
+		node := &groups.Nodes[queue[0]]
+		queue = queue[1:]
+		if via, ok := directMatch(node.AuthGroup, id); ok {
+			var chain []string
+			for idx := node.Index; ; idx = prev[idx] {
+				chain = append(chain, groups.Nodes[idx].Name)
+				if idx == root.Index {
+					break
+				}
+			}
+			for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+				chain[i], chain[j] = chain[j], chain[i]
+			}
+			return Membership{Principal: principal, Groups: chain, Via: via}, true
+		}
+		for _, nested := range node.Nested {
+			if _, seen := prev[nested]; !seen {
+				prev[nested] = node.Index
+				queue = append(queue, nested)
+			}
+		}
+	}
+	return Membership{}, false
+}
+
+// directMatch checks if the identity is a member of the group, not counting
+// nested groups. Returns the matching member or glob.
+func directMatch(group *protocol.AuthGroup, id identity.Identity) (string, bool) {
+	for _, m := range group.Members {
+		if m == string(id) {
+			return m, true
+		}
+	}
+	for _, g := range group.Globs {
+		if identity.Glob(g).Match(id) {
+			return g, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,210 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authdb
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/auth/service/protocol"
+
+	"go.chromium.org/luci/server/auth/authdb/internal/realmset"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestExplainPermission(t *testing.T) {
+	t.Parallel()
+
+	Convey("ExplainPermission", t, func() {
+		ctx := context.Background()
+
+		authDB := &protocol.AuthDB{
+			Groups: []*protocol.AuthGroup{
+				{
+					Name:   "outer",
+					Nested: []string{"middle"},
+				},
+				{
+					Name:   "middle",
+					Nested: []string{"inner", "globs"},
+				},
+				{
+					Name:    "inner",
+					Members: []string{"user:abc@example.com"},
+				},
+				{
+					Name:  "globs",
+					Globs: []string{"user:*@glob.example.com"},
+				},
+			},
+			Realms: &protocol.Realms{
+				ApiVersion: realmset.ExpectedAPIVersion,
+				Permissions: []*protocol.Permission{
+					{Name: "luci.dev.explain1"},
+					{Name: "luci.dev.explain2"},
+				},
+				Conditions: []*protocol.Condition{
+					{
+						Op: &protocol.Condition_Restrict{
+							Restrict: &protocol.Condition_AttributeRestriction{
+								Attribute: "a",
+								Values:    []string{"ok_2", "ok_1"},
+							},
+						},
+					},
+				},
+				Realms: []*protocol.Realm{
+					{
+						Name: "proj:@root",
+						Bindings: []*protocol.Binding{
+							{
+								Permissions: []uint32{0},
+								Principals:  []string{"user:root@example.com"},
+							},
+						},
+					},
+					{
+						Name: "proj:some/realm",
+						Bindings: []*protocol.Binding{
+							{
+								Permissions: []uint32{0, 1},
+								Principals:  []string{"user:direct@example.com", "group:outer"},
+							},
+							{
+								Conditions:  []uint32{0},
+								Permissions: []uint32{0},
+								Principals:  []string{"user:cond@example.com"},
+							},
+							{
+								Permissions: []uint32{1},
+								Principals:  []string{"user:other@example.com"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		explainer, err := NewExplainer(authDB, 123)
+		So(err, ShouldBeNil)
+		So(explainer.Rev, ShouldEqual, 123)
+
+		explain := func(id identity.Identity, perm, realm string, attrs realms.Attrs) *Explanation {
+			exp, err := explainer.ExplainPermission(ctx, id, perm, realm, attrs)
+			So(err, ShouldBeNil)
+			return exp
+		}
+
+		Convey("Direct binding", func() {
+			exp := explain("user:direct@example.com", "luci.dev.explain1", "proj:some/realm", nil)
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.Realm, ShouldEqual, "proj:some/realm")
+			So(exp.Bindings, ShouldHaveLength, 2)
+			So(exp.Bindings[0], ShouldResemble, BindingExplanation{
+				Principals:  []string{"user:direct@example.com", "group:outer"},
+				Permissions: []string{"luci.dev.explain1", "luci.dev.explain2"},
+				Memberships: []Membership{
+					{Principal: "user:direct@example.com", Via: "user:direct@example.com"},
+				},
+				Granted: true,
+			})
+			So(exp.Bindings[1].Granted, ShouldBeFalse)
+		})
+
+		Convey("Nested groups", func() {
+			exp := explain("user:abc@example.com", "luci.dev.explain1", "proj:some/realm", nil)
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.Bindings[0].Memberships, ShouldResemble, []Membership{
+				{
+					Principal: "group:outer",
+					Groups:    []string{"outer", "middle", "inner"},
+					Via:       "user:abc@example.com",
+				},
+			})
+
+			exp = explain("user:someone@glob.example.com", "luci.dev.explain1", "proj:some/realm", nil)
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.Bindings[0].Memberships, ShouldResemble, []Membership{
+				{
+					Principal: "group:outer",
+					Groups:    []string{"outer", "middle", "globs"},
+					Via:       "user:*@glob.example.com",
+				},
+			})
+		})
+
+		Convey("Conditions", func() {
+			exp := explain("user:cond@example.com", "luci.dev.explain1", "proj:some/realm", realms.Attrs{"a": "ok_1"})
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.Bindings[1].Conditions, ShouldResemble, []ConditionExplanation{
+				{Description: `attribute "a" in [ok_1, ok_2] (attribute is "ok_1")`, Satisfied: true},
+			})
+
+			exp = explain("user:cond@example.com", "luci.dev.explain1", "proj:some/realm", realms.Attrs{"a": "bad"})
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Bindings[1].Conditions[0].Satisfied, ShouldBeFalse)
+			So(exp.Bindings[1].Memberships, ShouldHaveLength, 1)
+
+			exp = explain("user:cond@example.com", "luci.dev.explain1", "proj:some/realm", nil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Bindings[1].Conditions[0].Description, ShouldEndWith, "(attribute is not set)")
+		})
+
+		Convey("Not granted", func() {
+			exp := explain("user:unknown@example.com", "luci.dev.explain1", "proj:some/realm", nil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Bindings, ShouldHaveLength, 2)
+			for _, b := range exp.Bindings {
+				So(b.Memberships, ShouldBeEmpty)
+			}
+		})
+
+		Convey("Root realm fallback", func() {
+			exp := explain("user:root@example.com", "luci.dev.explain1", "proj:unknown", nil)
+			So(exp.Granted, ShouldBeTrue)
+			So(exp.Realm, ShouldEqual, "proj:@root")
+			So(exp.Notes, ShouldResemble, []string{`non-existing realm "proj:unknown": falling back to the root realm "proj:@root"`})
+
+			exp = explain("user:root@example.com", "luci.dev.explain1", "unknown:realm", nil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Realm, ShouldEqual, "")
+			So(exp.Notes, ShouldHaveLength, 1)
+		})
+
+		Convey("Unknown permission", func() {
+			exp := explain("user:direct@example.com", "luci.dev.unknown", "proj:some/realm", nil)
+			So(exp.Granted, ShouldBeFalse)
+			So(exp.Notes, ShouldResemble, []string{`permission "luci.dev.unknown" is not present in the AuthDB`})
+		})
+
+		Convey("Bad arguments", func() {
+			_, err := explainer.ExplainPermission(ctx, "bad", "luci.dev.explain1", "proj:some/realm", nil)
+			So(err, ShouldNotBeNil)
+			_, err = explainer.ExplainPermission(ctx, "user:direct@example.com", "bad", "proj:some/realm", nil)
+			So(err, ShouldNotBeNil)
+			_, err = explainer.ExplainPermission(ctx, "user:direct@example.com", "luci.dev.explain1", "@root", nil)
+			So(err, ShouldErrLike, "bad global realm name")
+
+			empty, err := NewExplainer(&protocol.AuthDB{}, 0)
+			So(err, ShouldBeNil)
+			_, err = empty.ExplainPermission(ctx, "user:direct@example.com", "luci.dev.explain1", "proj:some/realm", nil)
+			So(err, ShouldErrLike, "Realms API is not available")
+		})
+	})
+}
@@ -119,7 +119,15 @@ type realmAndPerm struct {
 // It can be passed to Bindings(...). Returns (0, false) if there's no such
 // permission in the Realms DB.
 func (r *Realms) PermissionIndex(perm realms.Permission) (idx PermissionIndex, ok bool) {
-	idx, ok = r.perms[perm.Name()]
+	return r.PermissionIndexByName(perm.Name())
+}
+
+// PermissionIndexByName is like PermissionIndex, but accepts a permission name.
+//
+// Useful when checking permissions that are not registered in the process,
+// see BuildAll.
+func (r *Realms) PermissionIndexByName(perm string) (idx PermissionIndex, ok bool) {
+	idx, ok = r.perms[perm]
 	return
 }
 
@@ -177,6 +185,31 @@ func (r *Realms) QueryBindings(perm PermissionIndex) (map[string][]RealmBindings
 // Only registered permissions will be queriable. Bindings with all other
 // permissions will be ignored to save RAM.
 func Build(r *protocol.Realms, qg *graph.QueryableGraph, registered map[realms.Permission]realms.PermissionFlags) (*Realms, error) {
+	active := make(map[string]realms.PermissionFlags, len(registered))
+	for perm, flags := range registered {
+		active[perm.Name()] = flags
+	}
+	return build(r, qg, active)
+}
+
+// BuildAll is like Build, but makes all permissions in the proto queriable,
+// regardless of whether they are registered in the process or not.
+//
+// This is more expensive than Build. Used when inspecting arbitrary AuthDB
+// snapshots, e.g. in the Auth Service itself.
+func BuildAll(r *protocol.Realms, qg *graph.QueryableGraph) (*Realms, error) {
+	active := make(map[string]realms.PermissionFlags, len(r.Permissions))
+	for _, perm := range r.Permissions {
+		active[perm.Name] = 0
+	}
+	return build(r, qg, active)
+}
+
+// build implements Build and BuildAll.
+//
+// `active` is a mapping from names of permissions to make queriable to their
+// flags.
+func build(r *protocol.Realms, qg *graph.QueryableGraph, active map[string]realms.PermissionFlags) (*Realms, error) {
 	// Do not use realms.Realms we don't understand. Better to go offline
 	// completely than mistakenly allow access to something private by
 	// misinterpreting realm rules (e.g. if a new hypothetical DENY rule is
@@ -203,9 +236,9 @@ func Build(r *protocol.Realms, qg *graph.QueryableGraph, registered map[realms.P
 	// Build a set of permission indexes the process is interested in checking.
 	// All other permissions will simply be ignored to avoid wasting RAM on them
 	// (they won't be checked anyway).
-	activePerms := make(map[PermissionIndex]struct{}, len(registered))
-	for perm := range registered {
-		if idx, ok := perms[perm.Name()]; ok {
+	activePerms := make(map[PermissionIndex]struct{}, len(active))
+	for perm := range active {
+		if idx, ok := perms[perm]; ok {
 			activePerms[idx] = struct{}{}
 		}
 	}
@@ -328,10 +361,10 @@ func Build(r *protocol.Realms, qg *graph.QueryableGraph, registered map[realms.P
 	// realms that have this permission. This allows skipping unrelated realms
 	// in QueryRealms. Note that we'll reuse Bindings slices from `realmMap`, so
 	// we pay extra RAM only for actual mapping.
-	bindingsIdx := make(map[PermissionIndex]map[string][]RealmBindings, len(registered))
-	for perm, flags := range registered {
+	bindingsIdx := make(map[PermissionIndex]map[string][]RealmBindings, len(active))
+	for perm, flags := range active {
 		if flags&realms.UsedInQueryRealms != 0 {
-			if permIdx, ok := perms[perm.Name()]; ok {
+			if permIdx, ok := perms[perm]; ok {
 				bindingsIdx[permIdx] = map[string][]RealmBindings{}
 			}
 		}
@@ -226,6 +226,41 @@ func TestRealms(t *testing.T) {
 		So(ok, ShouldBeFalse)
 	})
 
+	Convey("BuildAll", t, func() {
+		r, err := BuildAll(&protocol.Realms{
+			ApiVersion: ExpectedAPIVersion,
+			Permissions: []*protocol.Permission{
+				{Name: "luci.dev.testing0"},
+				{Name: "luci.dev.not-registered"},
+			},
+			Realms: []*protocol.Realm{
+				{
+					Name: "proj:r1",
+					Bindings: []*protocol.Binding{
+						{
+							Permissions: []uint32{0, 1},
+							Principals:  []string{"group:g1", "user:u1@example.com"},
+						},
+					},
+				},
+			},
+		}, grp)
+		So(err, ShouldBeNil)
+
+		idx, ok := r.PermissionIndexByName("luci.dev.not-registered")
+		So(ok, ShouldBeTrue)
+		So(idx, ShouldEqual, 1)
+
+		bs := r.Bindings("proj:r1", idx)
+		So(bs, ShouldHaveLength, 1)
+		So(bs[0].Groups, ShouldResemble, indexes(grp, "g1"))
+		So(bs[0].Idents.ToSortedSlice(), ShouldResemble, []string{"user:u1@example.com"})
+
+		// Flags are not known, so nothing is indexed for QueryBindings.
+		_, ok = r.QueryBindings(0)
+		So(ok, ShouldBeFalse)
+	})
+
 	Convey("Conditional bindings", t, func() {
 		r, err := Build(&protocol.Realms{
 			ApiVersion: ExpectedAPIVersion,
@@ -16,6 +16,7 @@ package authdb
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net"
 	"strings"
@@ -97,6 +98,14 @@ func SnapshotDBFromTextProto(r io.Reader) (*SnapshotDB, error) {
 // If 'validate' is false, skips some expensive validation steps, assuming they
 // were performed before, when AuthDB was initially received.
 func NewSnapshotDB(authDB *protocol.AuthDB, authServiceURL string, rev int64, validate bool) (*SnapshotDB, error) {
+	return newSnapshotDB(authDB, authServiceURL, rev, validate, false)
+}
+
+// newSnapshotDB implements NewSnapshotDB.
+//
+// If 'allPerms' is true, all permissions in the AuthDB are made checkable, not
+// only ones registered in the process.
+func newSnapshotDB(authDB *protocol.AuthDB, authServiceURL string, rev int64, validate, allPerms bool) (*SnapshotDB, error) {
 	if validate {
 		if err := validateAuthDB(authDB); err != nil {
 			return nil, err
@@ -110,7 +119,11 @@ func NewSnapshotDB(authDB *protocol.AuthDB, authServiceURL string, rev int64, va
 
 	var realmSet *realmset.Realms
 	if authDB.Realms != nil {
-		realmSet, err = realmset.Build(authDB.Realms, groups, realms.RegisteredPermissions())
+		if allPerms {
+			realmSet, err = realmset.BuildAll(authDB.Realms, groups)
+		} else {
+			realmSet, err = realmset.Build(authDB.Realms, groups, realms.RegisteredPermissions())
+		}
 		if err != nil {
 			return nil, errors.Annotate(err, "failed to prepare Realms DB").Err()
 		}
@@ -229,31 +242,60 @@ func (db *SnapshotDB) HasPermission(ctx context.Context, id identity.Identity, p
 	}
 
 	// Verify such realm is defined in the DB or fallback to its @root.
-	if !db.realms.HasRealm(realm) {
-		if err := realms.ValidateRealmName(realm, realms.GlobalScope); err != nil {
-			return false, errors.Annotate(err, "when checking %q", perm).Err()
-		}
-		project, name := realms.Split(realm)
-		root := realms.Join(project, realms.RootRealm)
-		if realm == root {
-			logging.Warningf(ctx, "Checking %q in a non-existing root realm %q: denying", perm, realm)
-			return false, nil
-		}
-		if !db.realms.HasRealm(root) {
-			logging.Warningf(ctx, "Checking %q in a non-existing realm %q that doesn't have a root realm (no such project?): denying", perm, realm)
-			return false, nil
-		}
+	switch target, note, err := db.resolveRealm(realm); {
+	case err != nil:
+		return false, errors.Annotate(err, "when checking %q", perm).Err()
+	case target == "":
+		logging.Warningf(ctx, "Checking %q in a %s: denying", perm, note)
+		return false, nil
+	case target != realm:
 		// Don't log @legacy => @root fallbacks, they are semi-expected.
-		if name != realms.LegacyRealm {
-			logging.Warningf(ctx, "Checking %q in a non-existing realm %q: falling back to the root realm %q", perm, realm, root)
+		if _, name := realms.Split(realm); name != realms.LegacyRealm {
+			logging.Warningf(ctx, "Checking %q in a %s", perm, note)
 		}
-		realm = root
+		realm = target
+	}
+
+	return db.checkBindings(ctx, id, permIdx, realm, attrs), nil
+}
+
+// resolveRealm returns the realm whose bindings should be used for permission
+// checks in the given realm.
+//
+// It is either the realm itself or, if it doesn't exist, the root realm of its
+// project. Returns an empty string if neither exists. In that case and in case
+// of the fallback to the root realm also returns a note describing what
+// happened. Returns an error if the realm name is malformed.
+//
+// Must be called only if db.realms is not nil.
+func (db *SnapshotDB) resolveRealm(realm string) (target, note string, err error) {
+	if db.realms.HasRealm(realm) {
+		return realm, "", nil
+	}
+	if err := realms.ValidateRealmName(realm, realms.GlobalScope); err != nil {
+		return "", "", err
+	}
+	project, _ := realms.Split(realm)
+	root := realms.Join(project, realms.RootRealm)
+	switch {
+	case realm == root:
+		return "", fmt.Sprintf("non-existing root realm %q", realm), nil
+	case !db.realms.HasRealm(root):
+		return "", fmt.Sprintf("non-existing realm %q that doesn't have a root realm (no such project?)", realm), nil
+	default:
+		return root, fmt.Sprintf("non-existing realm %q: falling back to the root realm %q", realm, root), nil
 	}
+}
 
+// checkBindings returns true if any of the bindings for the permission in
+// the realm apply to the identity.
+//
+// The realm should already be resolved via resolveRealm.
+func (db *SnapshotDB) checkBindings(ctx context.Context, id identity.Identity, permIdx realmset.PermissionIndex, realm string, attrs realms.Attrs) bool {
 	// Grab the list of bindings for this permission and check if any applies to
 	// the `id` based on its group memberships.
 	q := db.groups.MembershipsQueryCache(id)
-	return db.realms.Bindings(realm, permIdx).Check(ctx, &q, attrs), nil
+	return db.realms.Bindings(realm, permIdx).Check(ctx, &q, attrs)
 }
 
 // QueryRealms returns a list of realms where the identity has the given
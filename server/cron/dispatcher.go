@@ -21,6 +21,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/errors"
@@ -39,7 +40,7 @@ var (
 		"Count of handled cron job invocations",
 		nil,
 		field.String("id"),     // cron handler ID
-		field.String("result"), // OK | transient | fatal | panic | no_handler | auth
+		field.String("result"), // OK | transient | fatal | panic | no_handler | auth | draining
 	)
 
 	callsDurationMS = metric.NewCumulativeDistribution(
@@ -83,6 +84,8 @@ type Dispatcher struct {
 
 	m sync.RWMutex
 	h map[string]Handler
+
+	draining int32 // 1 after Drain is called, accessed atomically
 }
 
 // handlerIDRe is used to validate handler IDs.
@@ -111,6 +114,15 @@ func (d *Dispatcher) RegisterHandler(id string, h Handler) {
 	d.h[id] = h
 }
 
+// Drain makes the dispatcher reject all new cron job invocations with HTTP 503.
+//
+// Cloud Scheduler retries rejected invocations according to the job's retry
+// config. Handlers that are already running are not affected. Intended to be
+// called when the process is about to shut down. There's no way to undo it.
+func (d *Dispatcher) Drain() {
+	atomic.StoreInt32(&d.draining, 1)
+}
+
 // InstallCronRoutes installs routes that handle requests from Cloud Scheduler.
 func (d *Dispatcher) InstallCronRoutes(r *router.Router, prefix string) {
 	if prefix == "" {
@@ -139,6 +151,11 @@ func (d *Dispatcher) InstallCronRoutes(r *router.Router, prefix string) {
 
 	r.GET(route, mw, func(c *router.Context) {
 		id := handlerID(c)
+		if atomic.LoadInt32(&d.draining) == 1 {
+			callsCounter.Add(c.Context, 1, id, "draining")
+			http.Error(c.Writer, "the server is draining", http.StatusServiceUnavailable)
+			return
+		}
 		if err := d.executeHandlerByID(c.Context, id); err != nil {
 			status := 0
 			if transient.Tag.In(err) {
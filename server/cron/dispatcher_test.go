@@ -111,6 +111,18 @@ func TestDispatcher(t *testing.T) {
 			So(metric(callsCounter, "unknown", "no_handler"), ShouldEqual, 1)
 		})
 
+		Convey("Draining", func() {
+			called := false
+			d.RegisterHandler("ok", func(ctx context.Context) error {
+				called = true
+				return nil
+			})
+			d.Drain()
+			So(call("/crons/ok"), ShouldEqual, 503)
+			So(called, ShouldBeFalse)
+			So(metric(callsCounter, "ok", "draining"), ShouldEqual, 1)
+		})
+
 		Convey("Panic", func() {
 			d.RegisterHandler("panic", func(ctx context.Context) error {
 				panic("boom")
@@ -119,6 +119,7 @@ func (m *cronModule) Initialize(ctx context.Context, host module.Host, opts modu
 	m.opts.Dispatcher.DisableAuth = !opts.Prod
 	m.opts.Dispatcher.AuthorizedCallers = m.opts.AuthorizedCallers
 	m.opts.Dispatcher.InstallCronRoutes(host.Routes(), m.opts.ServingPrefix)
+	host.RegisterDrain(func(context.Context) { m.opts.Dispatcher.Drain() })
 
 	return ctx, nil
 }
@@ -0,0 +1,30 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dschangefeed
+
+// Default is a feed installed into the server when using NewModule or
+// NewModuleFromFlags.
+//
+// The module takes care of installing it into the default tq.Dispatcher and
+// into the server's datastore.
+var Default = Feed{}
+
+// Subscribe registers a handler for changes of entities of the given kind in
+// the default feed.
+//
+// Should be called during the init time.
+func Subscribe(kind string, h Handler) {
+	Default.Subscribe(kind, h)
+}
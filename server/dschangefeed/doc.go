@@ -0,0 +1,44 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dschangefeed implements a change feed for datastore entities.
+//
+// It allows to subscribe to puts and deletes of entities of selected kinds
+// without polling the datastore with queries. Changes are recorded by
+// a datastore filter in a transactional outbox (server/tq transactional tasks)
+// and then delivered to the subscribed handlers along with the current state
+// of changed entities.
+//
+// Usage:
+//
+//	func init() {
+//	  dschangefeed.Subscribe("Build", func(ctx context.Context, changes []*dschangefeed.Change) error {
+//	    for _, c := range changes {
+//	      ...
+//	    }
+//	    return nil
+//	  })
+//	}
+//
+//	func main() {
+//	  modules := []module.Module{
+//	    gaeemulation.NewModuleFromFlags(),
+//	    tq.NewModuleFromFlags(),
+//	    dschangefeed.NewModuleFromFlags(),
+//	  }
+//	  server.Main(nil, modules, func(srv *server.Server) error {
+//	    ...
+//	  })
+//	}
+package dschangefeed
@@ -0,0 +1,287 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dschangefeed
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/retry/transient"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+
+	"go.chromium.org/luci/server/tq"
+
+	// Enable datastore transactional tasks support.
+	_ "go.chromium.org/luci/server/tq/txn/datastore"
+
+	"go.chromium.org/luci/server/dschangefeed/internal/tasks"
+)
+
+// Change is a committed put or delete of an entity.
+type Change struct {
+	// Key is the key of the changed entity.
+	Key *ds.Key
+
+	// Deleted is true if the entity was deleted and false if it was put.
+	Deleted bool
+
+	// Entity is the current state of the entity or nil if it doesn't exist.
+	//
+	// It is read when the change is delivered, not when it is committed. Thus it
+	// may reflect later changes (which will be delivered separately).
+	Entity ds.PropertyMap
+}
+
+// Handler is called with changes of entities of a subscribed kind.
+//
+// The context is in the namespace of the entities. All changes passed to
+// a single call are in the same namespace and have the same kind.
+//
+// Changes are delivered at least once, possibly out of order. If the handler
+// returns an error, all changes passed to it will be retried later (possibly
+// along with changes already processed by other handlers). Errors tagged with
+// tq.Fatal or tq.Ignore stop the retries.
+type Handler func(ctx context.Context, changes []*Change) error
+
+// Feed delivers changes of entities of subscribed kinds to handlers.
+//
+// Changes are recorded by the datastore filter installed with FilterRDS. Puts
+// and deletes done in a transaction are recorded in the same transaction as
+// transactional server/tq tasks (i.e. the task is submitted if and only if the
+// transaction lands). Mutations done outside of transactions are submitted as
+// regular tasks right after they succeed, so they can be lost if the process
+// crashes in between.
+//
+// Transactional tasks require the tq sweeper to be running, see server/tq
+// docs.
+type Feed struct {
+	// Queue is a name of the Cloud Tasks queue to use for delivering changes.
+	//
+	// If empty, "default" is used.
+	Queue string
+
+	m        sync.RWMutex
+	disp     *tq.Dispatcher
+	handlers map[string][]Handler // kind => handlers
+}
+
+// Subscribe registers a handler for changes of entities of the given kind.
+//
+// Only changes of kinds with handlers are recorded. Subscribe should be called
+// during the server initialization, before entities are changed.
+func (f *Feed) Subscribe(kind string, h Handler) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.handlers == nil {
+		f.handlers = map[string][]Handler{}
+	}
+	f.handlers[kind] = append(f.handlers[kind], h)
+}
+
+// Install registers task classes used to deliver changes in the dispatcher.
+//
+// Should be called once.
+func (f *Feed) Install(disp *tq.Dispatcher) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.disp != nil {
+		panic("dschangefeed.Feed is already installed")
+	}
+	f.disp = disp
+
+	queue := f.Queue
+	if queue == "" {
+		queue = "default"
+	}
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "dschangefeed-deliver-changes",
+		Prototype: &tasks.DeliverChanges{},
+		Kind:      tq.FollowsContext,
+		Queue:     queue,
+		Handler: func(ctx context.Context, payload proto.Message) error {
+			return f.deliver(ctx, payload.(*tasks.DeliverChanges))
+		},
+		Quiet: true,
+	})
+}
+
+// FilterRDS installs a datastore filter that records changes of subscribed
+// kinds.
+func (f *Feed) FilterRDS(ctx context.Context) context.Context {
+	return ds.AddRawFilters(ctx, func(ctx context.Context, inner ds.RawInterface) ds.RawInterface {
+		return &filteredDS{RawInterface: inner, ctx: ctx, feed: f}
+	})
+}
+
+// watched returns true if the kind has subscribed handlers.
+func (f *Feed) watched(kind string) bool {
+	f.m.RLock()
+	defer f.m.RUnlock()
+	return len(f.handlers[kind]) != 0
+}
+
+// record submits a task to deliver the changes.
+func (f *Feed) record(ctx context.Context, changes []*tasks.Change) error {
+	f.m.RLock()
+	disp := f.disp
+	f.m.RUnlock()
+	if disp == nil {
+		return errors.Reason("dschangefeed.Feed is not installed into a tq.Dispatcher").Err()
+	}
+	return disp.AddTask(ctx, &tq.Task{
+		Payload: &tasks.DeliverChanges{Changes: changes},
+		Title:   fmt.Sprintf("%d-changes", len(changes)),
+	})
+}
+
+// deliver reads the current state of changed entities and calls handlers.
+func (f *Feed) deliver(ctx context.Context, task *tasks.DeliverChanges) error {
+	type group struct {
+		ns, kind string
+	}
+	var order []group
+	grouped := map[group][]*Change{}
+	latest := map[string]*Change{} // the last change of each key
+
+	for _, c := range task.Changes {
+		key, err := ds.NewKeyEncoded(c.Key)
+		if err != nil {
+			return errors.Annotate(err, "bad key %q", c.Key).Tag(tq.Fatal).Err()
+		}
+		if change := latest[c.Key]; change != nil {
+			change.Deleted = c.Deleted
+			continue
+		}
+		change := &Change{Key: key, Deleted: c.Deleted}
+		latest[c.Key] = change
+		g := group{key.Namespace(), key.Kind()}
+		if _, ok := grouped[g]; !ok {
+			order = append(order, g)
+		}
+		grouped[g] = append(grouped[g], change)
+	}
+
+	var merr errors.MultiError
+	for _, g := range order {
+		f.m.RLock()
+		handlers := f.handlers[g.kind]
+		f.m.RUnlock()
+		if len(handlers) == 0 {
+			continue
+		}
+
+		ctx, err := info.Namespace(ctx, g.ns)
+		if err != nil {
+			return errors.Annotate(err, "bad namespace %q", g.ns).Tag(tq.Fatal).Err()
+		}
+		changes := grouped[g]
+		if err := readEntities(ctx, changes); err != nil {
+			return err
+		}
+		for _, h := range handlers {
+			if err := h(ctx, changes); err != nil {
+				merr = append(merr, errors.Annotate(err, "handler for kind %q", g.kind).Err())
+			}
+		}
+	}
+
+	switch {
+	case len(merr) == 0:
+		return nil
+	case len(merr) == 1:
+		return merr[0]
+	default:
+		return merr
+	}
+}
+
+// readEntities populates Entity field of the changes.
+func readEntities(ctx context.Context, changes []*Change) error {
+	pms := make([]ds.PropertyMap, len(changes))
+	for i, c := range changes {
+		pms[i] = ds.PropertyMap{}
+		pms[i].SetMeta("key", c.Key)
+	}
+	err := ds.Get(ctx, pms)
+	merr, _ := err.(errors.MultiError)
+	if err != nil && merr == nil {
+		return errors.Annotate(err, "failed to read changed entities").Tag(transient.Tag).Err()
+	}
+	for i, c := range changes {
+		switch {
+		case merr == nil || merr[i] == nil:
+			c.Entity = pms[i]
+		case merr[i] != ds.ErrNoSuchEntity:
+			return errors.Annotate(merr[i], "failed to read %s", c.Key).Tag(transient.Tag).Err()
+		}
+	}
+	return nil
+}
+
+// filteredDS records puts and deletes of watched kinds.
+type filteredDS struct {
+	ds.RawInterface
+
+	ctx  context.Context
+	feed *Feed
+}
+
+func (d *filteredDS) PutMulti(keys []*ds.Key, vals []ds.PropertyMap, cb ds.NewKeyCB) error {
+	var m sync.Mutex
+	var changes []*tasks.Change
+	err := d.RawInterface.PutMulti(keys, vals, func(i int, key *ds.Key, err error) {
+		if err == nil && d.feed.watched(key.Kind()) {
+			m.Lock()
+			changes = append(changes, &tasks.Change{Key: key.Encode()})
+			m.Unlock()
+		}
+		cb(i, key, err)
+	})
+	return d.recordChanges(changes, err)
+}
+
+func (d *filteredDS) DeleteMulti(keys []*ds.Key, cb ds.DeleteMultiCB) error {
+	var m sync.Mutex
+	var changes []*tasks.Change
+	err := d.RawInterface.DeleteMulti(keys, func(i int, err error) {
+		if err == nil && d.feed.watched(keys[i].Kind()) {
+			m.Lock()
+			changes = append(changes, &tasks.Change{Key: keys[i].Encode(), Deleted: true})
+			m.Unlock()
+		}
+		cb(i, err)
+	})
+	return d.recordChanges(changes, err)
+}
+
+// recordChanges records changes done by a successful mutation.
+//
+// In a transaction the returned error aborts the transaction. Outside of it,
+// the mutation has already happened, but the error is still returned to let
+// the caller retry it.
+func (d *filteredDS) recordChanges(changes []*tasks.Change, err error) error {
+	if err != nil || len(changes) == 0 {
+		return err
+	}
+	if err := d.feed.record(d.ctx, changes); err != nil {
+		return errors.Annotate(err, "failed to record changes in the change feed").Err()
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dschangefeed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	ds "go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/gae/service/info"
+
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+type watchedEnt struct {
+	ID    int64 `gae:"$id"`
+	Value string
+}
+
+type otherEnt struct {
+	ID int64 `gae:"$id"`
+}
+
+func TestFeed(t *testing.T) {
+	t.Parallel()
+
+	Convey("With feed", t, func() {
+		ctx := txndefer.FilterRDS(memory.Use(context.Background()))
+		ds.GetTestable(ctx).Consistent(true)
+		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if testclock.HasTags(t, tqtesting.ClockTag) {
+				tc.Add(d)
+			}
+		})
+
+		disp := &tq.Dispatcher{}
+		ctx, sched := tq.TestingContext(ctx, disp)
+
+		feed := &Feed{}
+		feed.Install(disp)
+		ctx = feed.FilterRDS(ctx)
+
+		var delivered []*Change
+		feed.Subscribe("watchedEnt", func(ctx context.Context, changes []*Change) error {
+			delivered = append(delivered, changes...)
+			return nil
+		})
+
+		run := func() {
+			sched.Run(ctx, tqtesting.StopWhenDrained())
+		}
+
+		Convey("Transactional changes", func() {
+			So(ds.RunInTransaction(ctx, func(ctx context.Context) error {
+				return ds.Put(ctx, &watchedEnt{ID: 1, Value: "a"}, &otherEnt{ID: 1})
+			}, nil), ShouldBeNil)
+			So(sched.Tasks(), ShouldHaveLength, 1)
+
+			run()
+			So(delivered, ShouldHaveLength, 1)
+			So(delivered[0].Key.IntID(), ShouldEqual, 1)
+			So(delivered[0].Deleted, ShouldBeFalse)
+			So(delivered[0].Entity.Slice("Value")[0].Value(), ShouldEqual, "a")
+		})
+
+		Convey("Failed transaction records nothing", func() {
+			err := ds.RunInTransaction(ctx, func(ctx context.Context) error {
+				if err := ds.Put(ctx, &watchedEnt{ID: 1}); err != nil {
+					return err
+				}
+				return errors.New("boom")
+			}, nil)
+			So(err, ShouldErrLike, "boom")
+			So(sched.Tasks(), ShouldBeEmpty)
+		})
+
+		Convey("Non-transactional changes", func() {
+			So(ds.Put(ctx, &watchedEnt{ID: 1, Value: "a"}), ShouldBeNil)
+			So(ds.Delete(ctx, &watchedEnt{ID: 1}), ShouldBeNil)
+			So(ds.Put(ctx, &otherEnt{ID: 1}), ShouldBeNil)
+			So(sched.Tasks(), ShouldHaveLength, 2)
+
+			run()
+			So(delivered, ShouldHaveLength, 2)
+			// Entities are read when delivering changes.
+			So(delivered[0].Deleted, ShouldBeFalse)
+			So(delivered[0].Entity, ShouldBeNil)
+			So(delivered[1].Deleted, ShouldBeTrue)
+			So(delivered[1].Entity, ShouldBeNil)
+		})
+
+		Convey("Multiple mutations in a transaction", func() {
+			So(ds.RunInTransaction(ctx, func(ctx context.Context) error {
+				if err := ds.Put(ctx, &watchedEnt{ID: 1}, &watchedEnt{ID: 2}); err != nil {
+					return err
+				}
+				return ds.Delete(ctx, &watchedEnt{ID: 1})
+			}, nil), ShouldBeNil)
+			run()
+			So(delivered, ShouldHaveLength, 3)
+		})
+
+		Convey("Namespaces", func() {
+			nsCtx := info.MustNamespace(ctx, "ns")
+			So(ds.Put(nsCtx, &watchedEnt{ID: 1, Value: "ns"}), ShouldBeNil)
+			run()
+			So(delivered, ShouldHaveLength, 1)
+			So(delivered[0].Key.Namespace(), ShouldEqual, "ns")
+			So(delivered[0].Entity.Slice("Value")[0].Value(), ShouldEqual, "ns")
+		})
+
+		Convey("Handler errors are retried", func() {
+			So(ds.Put(ctx, &watchedEnt{ID: 1}), ShouldBeNil)
+
+			calls := 0
+			feed.Subscribe("watchedEnt", func(ctx context.Context, changes []*Change) error {
+				if calls++; calls == 1 {
+					return errors.New("boom")
+				}
+				return nil
+			})
+			run()
+			So(calls, ShouldEqual, 2)
+			// The first handler saw the change twice.
+			So(delivered, ShouldHaveLength, 2)
+		})
+	})
+}
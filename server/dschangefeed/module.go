@@ -0,0 +1,92 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dschangefeed
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/server/gaeemulation"
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/tq"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/server/dschangefeed")
+
+// ModuleOptions contain configuration of the change feed server module.
+type ModuleOptions struct {
+	// Queue is a name of the Cloud Tasks queue to use for delivering changes.
+	//
+	// If empty, "default" is used.
+	Queue string
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	if o.Queue == "" {
+		o.Queue = "default"
+	}
+	f.StringVar(
+		&o.Queue,
+		"dschangefeed-queue",
+		o.Queue,
+		`Cloud Tasks queue to use for delivering datastore changes.`,
+	)
+}
+
+// NewModule returns a server module that installs the default change feed.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &serverModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// serverModule implements module.Module.
+type serverModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*serverModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*serverModule) Dependencies() []module.Dependency {
+	return []module.Dependency{
+		module.RequiredDependency(gaeemulation.ModuleName),
+		module.RequiredDependency(tq.ModuleName),
+	}
+}
+
+// Initialize is part of module.Module interface.
+func (m *serverModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	Default.Queue = m.opts.Queue
+	Default.Install(&tq.Default)
+	return Default.FilterRDS(ctx), nil
+}
@@ -41,6 +41,16 @@
 //  * The server ignores enabled experiments it doesn't know about. It
 //    simplifies adding and removing experiments.
 //  * There's better testing support.
+//
+// Experiments can also be rolled out gradually, without restarting the server.
+// Rollout rules (see Rollout) enable an experiment for a percentage of requests,
+// for particular identities or for particular LUCI projects. They are loaded
+// from a JSON file passed via `-experiments-rollout` server flag or, if the
+// flag is not set, read from the settings store and cached in memory for
+// a short time. Note that server.Server doesn't install a settings store by
+// itself. If the settings store is mutable (e.g. on GAE v1), the rules can be
+// adjusted through the admin portal page. Results of all checks are reported to
+// "luci/server/experiments/exposures" tsmon metric.
 package experiments
 
 import (
@@ -83,9 +93,14 @@ func (id ID) Valid() bool {
 // CLI flag.
 //
 // In tests an experiment can be enabled via Enable(ctx, id).
+//
+// An experiment not enabled explicitly may still be enabled by its rollout rule
+// (see Rollout).
 func (id ID) Enabled(ctx context.Context) bool {
 	cur, _ := ctx.Value(&ctxKey).(stringset.Set)
-	return cur.Has(id.name)
+	enabled := cur.Has(id.name) || rolledOut(ctx, id.name)
+	exposures.Add(ctx, 1, id.name, enabled)
+	return enabled
 }
 
 // Register is usually called during init() to declare some experiment.
@@ -119,6 +134,13 @@ func GetByName(name string) (id ID, ok bool) {
 	return ID{}, false
 }
 
+// registered returns names of all registered experiments, sorted.
+func registered() []string {
+	available.m.RLock()
+	defer available.m.RUnlock()
+	return available.exp.ToSortedSlice()
+}
+
 // Enable enables zero or more experiments.
 //
 // In other words Enable(ctx, exp) returns a context `ctx` such that
@@ -0,0 +1,255 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"strconv"
+	"strings"
+	"sync"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/portal"
+	"go.chromium.org/luci/server/settings"
+)
+
+// Suffixes of portal field IDs, the prefix is the experiment name.
+const (
+	percentField    = "/percent"
+	identitiesField = "/identities"
+	projectsField   = "/projects"
+)
+
+type rolloutPage struct {
+	portal.BasePage
+
+	m        sync.Mutex
+	readOnly *Rollout
+	banner   string // displayed on top if readOnly != nil
+}
+
+// PortalPage makes some aspects of the UI configurable by external packages.
+var PortalPage interface {
+	// SetReadOnlyRollout switches the portal page to always display the given
+	// rollout rules instead of attempting to fetch them from the settings store.
+	SetReadOnlyRollout(r *Rollout, banner string)
+} = &rolloutPage{}
+
+func (p *rolloutPage) SetReadOnlyRollout(r *Rollout, banner string) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.readOnly = r
+	p.banner = banner
+}
+
+// readOnlyBanner returns a non-empty banner if the page is read only.
+//
+// It is the case if rollout rules were loaded from a file or if there's no
+// mutable settings store in the server (e.g. outside of GAE v1), since edits
+// would not take effect.
+func (p *rolloutPage) readOnlyBanner(ctx context.Context) string {
+	p.m.Lock()
+	defer p.m.Unlock()
+	if p.readOnly != nil {
+		return p.banner
+	}
+	if s := settings.GetSettings(ctx); s == nil || !s.IsMutable() {
+		return "The settings store is not available in this server. " +
+			"Use -experiments-rollout flag to load rollout rules from a file instead."
+	}
+	return ""
+}
+
+func (p *rolloutPage) Title(ctx context.Context) (string, error) {
+	return "Experiments", nil
+}
+
+func (p *rolloutPage) Overview(ctx context.Context) (template.HTML, error) {
+	banner := p.readOnlyBanner(ctx)
+
+	buf := strings.Builder{}
+	buf.WriteString("<p>")
+	buf.WriteString("This page displays rollout rules of experiments registered in the server. ")
+	buf.WriteString("An experiment is enabled for a request if it is enabled via <b>-enable-experiment</b> ")
+	buf.WriteString("flag or if any of its rollout conditions match the request. Changes are applied ")
+	buf.WriteString("when the settings cache expires, usually within a minute.")
+	if banner != "" {
+		buf.WriteString(" Note that this page is <b>read only</b>. ")
+		buf.WriteString(template.HTMLEscapeString(banner))
+	}
+	buf.WriteString("</p>")
+
+	return template.HTML(buf.String()), nil
+}
+
+func (p *rolloutPage) Fields(ctx context.Context) ([]portal.Field, error) {
+	ro := p.readOnlyBanner(ctx) != ""
+
+	names := registered()
+	if len(names) == 0 {
+		return []portal.Field{
+			{
+				ID:    "none",
+				Title: "Experiments",
+				Type:  portal.FieldStatic,
+				Help:  "No experiments are registered in this server.",
+			},
+		}, nil
+	}
+
+	flags, _ := ctx.Value(&ctxKey).(stringset.Set)
+	fields := make([]portal.Field, 0, 3*len(names))
+	for _, name := range names {
+		help := template.HTML(fmt.Sprintf(
+			"Percentage of requests to enable <b>%s</b> for, in range [0, 100].",
+			template.HTMLEscapeString(name)))
+		if flags.Has(name) {
+			help += " The experiment is already enabled for all requests via <b>-enable-experiment</b> flag."
+		}
+		fields = append(fields,
+			portal.Field{
+				ID:        name + percentField,
+				Title:     name + ": percent",
+				Type:      portal.FieldText,
+				ReadOnly:  ro,
+				Validator: func(v string) error { _, err := parsePercent(v); return err },
+				Help:      help,
+			},
+			portal.Field{
+				ID:          name + identitiesField,
+				Title:       name + ": identities",
+				Type:        portal.FieldText,
+				ReadOnly:    ro,
+				Placeholder: "user:someone@example.com, ...",
+				Validator:   func(v string) error { _, err := parseIdentities(v); return err },
+				Help:        "Comma-separated list of identities to enable the experiment for.",
+			},
+			portal.Field{
+				ID:          name + projectsField,
+				Title:       name + ": projects",
+				Type:        portal.FieldText,
+				ReadOnly:    ro,
+				Placeholder: "project, ...",
+				Help:        "Comma-separated list of LUCI projects to enable the experiment for.",
+			},
+		)
+	}
+	return fields, nil
+}
+
+func (p *rolloutPage) ReadSettings(ctx context.Context) (map[string]string, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	r := &Rollout{}
+	if p.readOnly == nil {
+		if err := settings.GetUncached(ctx, settingsKey, r); err != nil && err != settings.ErrNoSettings {
+			return nil, err
+		}
+	} else {
+		r = p.readOnly
+	}
+
+	values := map[string]string{}
+	for _, name := range registered() {
+		rule := r.Rules[name]
+		if rule == nil {
+			rule = &Rule{}
+		}
+		values[name+percentField] = strconv.FormatFloat(rule.Percent, 'f', -1, 64)
+		values[name+identitiesField] = strings.Join(rule.Identities, ", ")
+		values[name+projectsField] = strings.Join(rule.Projects, ", ")
+	}
+	return values, nil
+}
+
+func (p *rolloutPage) WriteSettings(ctx context.Context, values map[string]string, who, why string) error {
+	if p.readOnlyBanner(ctx) != "" {
+		return fmt.Errorf("Can't modify read-only settings")
+	}
+
+	// Keep rules of experiments not registered in this server, they may be used
+	// by other versions of the server.
+	existing := &Rollout{}
+	if err := settings.GetUncached(ctx, settingsKey, existing); err != nil && err != settings.ErrNoSettings {
+		return err
+	}
+	modified := &Rollout{Rules: map[string]*Rule{}}
+	for name, rule := range existing.Rules {
+		if _, ok := GetByName(name); !ok && rule != nil {
+			modified.Rules[name] = rule
+		}
+	}
+
+	for _, name := range registered() {
+		rule := &Rule{}
+		var err error
+		if rule.Percent, err = parsePercent(values[name+percentField]); err != nil {
+			return err
+		}
+		if rule.Identities, err = parseIdentities(values[name+identitiesField]); err != nil {
+			return err
+		}
+		rule.Projects = splitList(values[name+projectsField])
+		if rule.Percent != 0 || len(rule.Identities) != 0 || len(rule.Projects) != 0 {
+			modified.Rules[name] = rule
+		}
+	}
+
+	return settings.SetIfChanged(ctx, settingsKey, modified, who, why)
+}
+
+// parsePercent parses a value of the percent field.
+func parsePercent(v string) (float64, error) {
+	if v = strings.TrimSpace(v); v == "" {
+		return 0, nil
+	}
+	pct, err := strconv.ParseFloat(v, 64)
+	if err != nil || pct < 0 || pct > 100 {
+		return 0, errors.Reason("expecting a number in range [0, 100]").Err()
+	}
+	return pct, nil
+}
+
+// parseIdentities parses a value of the identities field.
+func parseIdentities(v string) ([]string, error) {
+	ids := splitList(v)
+	for _, id := range ids {
+		if _, err := identity.MakeIdentity(id); err != nil {
+			return nil, err
+		}
+	}
+	return ids, nil
+}
+
+// splitList splits a comma-separated list, skipping empty elements.
+func splitList(v string) []string {
+	var out []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func init() {
+	portal.RegisterPage(settingsKey, PortalPage.(*rolloutPage))
+}
@@ -0,0 +1,235 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"os"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/settings"
+)
+
+// settingsKey is a key for Rollout in the settings store.
+//
+// See go.chromium.org/luci/server/settings.
+const settingsKey = "experiments"
+
+var exposures = metric.NewCounter(
+	"luci/server/experiments/exposures",
+	"Number of checks of an experiment, by the result of the check.",
+	nil,
+	field.String("experiment"), // name of the experiment
+	field.Bool("enabled"),      // true if the experiment was enabled
+)
+
+// Rollout defines how experiments are gradually enabled.
+//
+// It is usually stored in the settings store under "experiments" key or loaded
+// from a JSON file via LoadRollout.
+type Rollout struct {
+	// Rules is a mapping from an experiment name to its rollout rule.
+	//
+	// Experiments not mentioned here are enabled only via Enable(...).
+	Rules map[string]*Rule `json:"rules,omitempty"`
+}
+
+// Rule defines when an experiment is enabled for a request.
+//
+// An experiment is enabled if any of the conditions is true.
+type Rule struct {
+	// Percent is a percentage of request keys to enable the experiment for.
+	//
+	// A request key is hashed together with the experiment name to decide if
+	// the experiment is enabled. The same key always gets the same result as
+	// long as the percentage doesn't decrease. See WithRequestKey.
+	//
+	// Must be in range [0, 100], with granularity of 0.01.
+	Percent float64 `json:"percent,omitempty"`
+
+	// Identities is a list of identities to enable the experiment for, e.g.
+	// "user:someone@example.com".
+	//
+	// The identity is taken from auth.CurrentIdentity.
+	Identities []string `json:"identities,omitempty"`
+
+	// Projects is a list of LUCI projects to enable the experiment for.
+	//
+	// The project of a request is set via WithProject.
+	Projects []string `json:"projects,omitempty"`
+}
+
+// Validate returns an error if the rule is malformed.
+func (r *Rule) Validate() error {
+	if r.Percent < 0 || r.Percent > 100 {
+		return errors.Reason("percent must be in range [0, 100], got %v", r.Percent).Err()
+	}
+	for _, id := range r.Identities {
+		if _, err := identity.MakeIdentity(id); err != nil {
+			return err
+		}
+	}
+	for _, p := range r.Projects {
+		if p == "" {
+			return errors.Reason("project name can't be empty").Err()
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if some of the rules are malformed.
+func (r *Rollout) Validate() error {
+	for name, rule := range r.Rules {
+		if rule == nil {
+			continue
+		}
+		if err := rule.Validate(); err != nil {
+			return errors.Annotate(err, "bad rule for experiment %q", name).Err()
+		}
+	}
+	return nil
+}
+
+// LoadRollout loads and validates Rollout stored as JSON in a file.
+func LoadRollout(path string) (*Rollout, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read rollout file").Err()
+	}
+	r := &Rollout{}
+	if err := json.Unmarshal(blob, r); err != nil {
+		return nil, errors.Annotate(err, "failed to parse rollout file %q", path).Err()
+	}
+	if err := r.Validate(); err != nil {
+		return nil, errors.Annotate(err, "bad rollout file %q", path).Err()
+	}
+	return r, nil
+}
+
+// Context keys for the rollout state.
+var (
+	rolloutCtxKey    = "go.chromium.org/luci/server/experiments/rollout"
+	requestKeyCtxKey = "go.chromium.org/luci/server/experiments/request-key"
+	projectCtxKey    = "go.chromium.org/luci/server/experiments/project"
+)
+
+// WithRollout returns a context with the given rollout rules.
+//
+// They are used instead of the rules in the settings store. Passing nil
+// switches back to using the settings store.
+func WithRollout(ctx context.Context, r *Rollout) context.Context {
+	return context.WithValue(ctx, &rolloutCtxKey, r)
+}
+
+// WithRequestKey returns a context with the key used to decide if an
+// experiment with a percentage rollout is enabled.
+//
+// The same key always gets the same result, e.g. if the key is a user ID, the
+// user doesn't flip between the experimental and the default code paths between
+// requests. By default the key is the current identity, unless it is anonymous.
+// Anonymous requests without a key are enabled only by a 100% rollout.
+func WithRequestKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, &requestKeyCtxKey, key)
+}
+
+// WithProject returns a context with the LUCI project the request is
+// associated with, to be matched against Rule.Projects.
+func WithProject(ctx context.Context, project string) context.Context {
+	return context.WithValue(ctx, &projectCtxKey, project)
+}
+
+// currentRollout returns rollout rules to use in the context or nil if there
+// are none.
+func currentRollout(ctx context.Context) *Rollout {
+	if r, ok := ctx.Value(&rolloutCtxKey).(*Rollout); ok {
+		return r
+	}
+	r := &Rollout{}
+	switch err := settings.Get(ctx, settingsKey, r); {
+	case err == nil:
+		return r
+	case err == settings.ErrNoSettings:
+		return nil
+	default:
+		logging.Errorf(ctx, "Failed to fetch experiments rollout settings: %s", err)
+		return nil
+	}
+}
+
+// rolledOut returns true if the experiment is enabled by the rollout rules.
+func rolledOut(ctx context.Context, name string) bool {
+	r := currentRollout(ctx)
+	if r == nil {
+		return false
+	}
+	rule := r.Rules[name]
+	if rule == nil {
+		return false
+	}
+
+	if len(rule.Projects) != 0 {
+		if project, _ := ctx.Value(&projectCtxKey).(string); project != "" {
+			for _, p := range rule.Projects {
+				if p == project {
+					return true
+				}
+			}
+		}
+	}
+
+	ident := auth.CurrentIdentity(ctx)
+	for _, id := range rule.Identities {
+		if identity.Identity(id) == ident {
+			return true
+		}
+	}
+
+	switch {
+	case rule.Percent <= 0:
+		return false
+	case rule.Percent >= 100:
+		return true
+	}
+	key, _ := ctx.Value(&requestKeyCtxKey).(string)
+	if key == "" && ident != identity.AnonymousIdentity {
+		key = string(ident)
+	}
+	if key == "" {
+		return false
+	}
+	return bucket(name, key) < uint64(math.Round(rule.Percent*100))
+}
+
+// bucket maps a request key to a bucket in range [0, 10000).
+//
+// The experiment name is used as a salt to make sure different experiments
+// are enabled for different subsets of keys.
+func bucket(name, key string) uint64 {
+	h := sha256.New()
+	h.Write([]byte(name))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+	return binary.BigEndian.Uint64(h.Sum(nil)[:8]) % 10000
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.chromium.org/luci/common/tsmon"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/portal"
+	"go.chromium.org/luci/server/settings"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRollout(t *testing.T) {
+	t.Parallel()
+
+	Convey("With rollout", t, func() {
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		ctx = WithRollout(ctx, &Rollout{
+			Rules: map[string]*Rule{
+				"exp1": {
+					Percent:    10,
+					Identities: []string{"user:someone@example.com"},
+					Projects:   []string{"proj"},
+				},
+				"exp2": {Percent: 100},
+			},
+		})
+
+		Convey("By default", func() {
+			So(exp1.Enabled(ctx), ShouldBeFalse)
+			So(exp2.Enabled(ctx), ShouldBeTrue)
+			So(exposures.Get(ctx, "exp1", false), ShouldEqual, 1)
+			So(exposures.Get(ctx, "exp2", true), ShouldEqual, 1)
+		})
+
+		Convey("Enable overrides rollout", func() {
+			So(exp1.Enabled(Enable(ctx, exp1)), ShouldBeTrue)
+		})
+
+		Convey("By identity", func() {
+			ctx := auth.WithState(ctx, &authtest.FakeState{Identity: "user:someone@example.com"})
+			So(exp1.Enabled(ctx), ShouldBeTrue)
+			ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:lucky@example.com"})
+			So(exp1.Enabled(ctx), ShouldBeTrue) // lucky one, see bucket test below
+			ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:another@example.com"})
+			So(exp1.Enabled(ctx), ShouldBeFalse)
+		})
+
+		Convey("By project", func() {
+			So(exp1.Enabled(WithProject(ctx, "proj")), ShouldBeTrue)
+			So(exp1.Enabled(WithProject(ctx, "another")), ShouldBeFalse)
+		})
+
+		Convey("By percent", func() {
+			enabled := 0
+			for i := 0; i < 1000; i++ {
+				key := fmt.Sprintf("key-%d", i)
+				ctx := WithRequestKey(ctx, key)
+				on := exp1.Enabled(ctx)
+				So(exp1.Enabled(ctx), ShouldEqual, on) // sticky
+				if on {
+					enabled++
+				}
+			}
+			So(enabled, ShouldBeBetween, 50, 150)
+		})
+
+		Convey("Buckets", func() {
+			So(bucket("exp1", "user:lucky@example.com"), ShouldBeLessThan, 1000)
+			So(bucket("exp1", "user:another@example.com"), ShouldBeGreaterThanOrEqualTo, 1000)
+		})
+	})
+
+	Convey("With settings", t, func() {
+		ctx := settings.Use(context.Background(), settings.New(&settings.MemoryStorage{}))
+		So(exp2.Enabled(ctx), ShouldBeFalse)
+		So(settings.Set(ctx, settingsKey, &Rollout{
+			Rules: map[string]*Rule{"exp2": {Projects: []string{"proj"}}},
+		}, "who", "why"), ShouldBeNil)
+		ctx = settings.Use(context.Background(), settings.GetSettings(ctx)) // no cache
+		So(exp2.Enabled(WithProject(ctx, "proj")), ShouldBeTrue)
+	})
+
+	Convey("Percent is rounded", t, func() {
+		// Find a key that falls into the bucket 28.
+		key := ""
+		for i := 0; key == ""; i++ {
+			if k := fmt.Sprintf("key-%d", i); bucket("exp1", k) == 28 {
+				key = k
+			}
+		}
+		// 0.29*100 is 28.999999999999996 in floating point.
+		ctx, _ := tsmon.WithDummyInMemory(context.Background())
+		ctx = WithRollout(ctx, &Rollout{
+			Rules: map[string]*Rule{"exp1": {Percent: 0.29}},
+		})
+		So(exp1.Enabled(WithRequestKey(ctx, key)), ShouldBeTrue)
+	})
+
+	Convey("Validate", t, func() {
+		So((&Rule{Percent: 101}).Validate(), ShouldErrLike, "percent must be in range")
+		So((&Rule{Identities: []string{"bad"}}).Validate(), ShouldErrLike, "bad identity")
+		So((&Rule{Projects: []string{""}}).Validate(), ShouldErrLike, "can't be empty")
+		So((&Rule{Percent: 5, Identities: []string{"user:a@example.com"}}).Validate(), ShouldBeNil)
+	})
+
+	Convey("LoadRollout", t, func() {
+		path := filepath.Join(t.TempDir(), "rollout.json")
+
+		So(os.WriteFile(path, []byte(`{"rules": {"exp1": {"percent": 5}}}`), 0600), ShouldBeNil)
+		r, err := LoadRollout(path)
+		So(err, ShouldBeNil)
+		So(r.Rules["exp1"], ShouldResemble, &Rule{Percent: 5})
+
+		So(os.WriteFile(path, []byte(`{"rules": {"exp1": {"percent": -1}}}`), 0600), ShouldBeNil)
+		_, err = LoadRollout(path)
+		So(err, ShouldErrLike, `bad rule for experiment "exp1"`)
+	})
+}
+
+func TestPortalPage(t *testing.T) {
+	t.Parallel()
+
+	Convey("Read and write", t, func() {
+		ctx := settings.Use(context.Background(), settings.New(&settings.MemoryStorage{}))
+		So(settings.Set(ctx, settingsKey, &Rollout{
+			Rules: map[string]*Rule{"unknown": {Percent: 1}},
+		}, "who", "why"), ShouldBeNil)
+
+		p := &rolloutPage{}
+		values, err := p.ReadSettings(ctx)
+		So(err, ShouldBeNil)
+		So(values["exp1/percent"], ShouldEqual, "0")
+		So(values["exp1/identities"], ShouldEqual, "")
+
+		values["exp1/percent"] = "2.5"
+		values["exp1/identities"] = "user:a@example.com, ,user:b@example.com"
+		values["exp2/projects"] = "proj"
+		So(p.WriteSettings(ctx, values, "who", "why"), ShouldBeNil)
+
+		r := &Rollout{}
+		So(settings.GetUncached(ctx, settingsKey, r), ShouldBeNil)
+		So(r, ShouldResemble, &Rollout{
+			Rules: map[string]*Rule{
+				"exp1": {
+					Percent:    2.5,
+					Identities: []string{"user:a@example.com", "user:b@example.com"},
+				},
+				"exp2":    {Projects: []string{"proj"}},
+				"unknown": {Percent: 1},
+			},
+		})
+
+		values["exp1/percent"] = "abc"
+		So(p.WriteSettings(ctx, values, "who", "why"), ShouldErrLike, "expecting a number")
+
+		p.SetReadOnlyRollout(&Rollout{}, "banner")
+		So(p.WriteSettings(ctx, values, "who", "why"), ShouldErrLike, "read-only")
+	})
+
+	Convey("Read only without the settings store", t, func() {
+		ctx := context.Background()
+		p := &rolloutPage{}
+
+		overview, err := p.Overview(ctx)
+		So(err, ShouldBeNil)
+		So(string(overview), ShouldContainSubstring, "settings store is not available")
+
+		fields, err := p.Fields(ctx)
+		So(err, ShouldBeNil)
+		for _, f := range fields {
+			So(f.ReadOnly || f.Type == portal.FieldStatic, ShouldBeTrue)
+		}
+
+		So(p.WriteSettings(ctx, map[string]string{}, "who", "why"), ShouldErrLike, "read-only")
+	})
+}
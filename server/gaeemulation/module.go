@@ -43,12 +43,14 @@ import (
 	"os"
 
 	"cloud.google.com/go/datastore"
+	"cloud.google.com/go/firestore"
 	"google.golang.org/api/option"
 
 	"go.chromium.org/luci/appengine/gaesecrets"
 	"go.chromium.org/luci/gae/filter/dscache"
 	"go.chromium.org/luci/gae/filter/txndefer"
 	"go.chromium.org/luci/gae/impl/cloud"
+	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/grpc/grpcmon"
 
 	"go.chromium.org/luci/common/errors"
@@ -67,6 +69,8 @@ type ModuleOptions struct {
 	DSCache                  string // currently either "disable" (default) or "redis"
 	RandomSecretsInDatastore bool   // true to replace the random secrets store with the GAEv1-one
 	DSConnectionPoolSize     int    // passed to WithGRPCConnectionPool, if > 0.
+	DSBackend                string // either "datastore" (default), "firestore" or "file"
+	DSFile                   string // path to the journal file for "file" backend
 }
 
 // Register registers the command line flags.
@@ -90,6 +94,19 @@ func (o *ModuleOptions) Register(f *flag.FlagSet) {
 		o.DSConnectionPoolSize,
 		"If set, DS client is constructed with WithGRPCConnectionPool() and this value. ",
 	)
+	f.StringVar(
+		&o.DSBackend,
+		"ds-backend",
+		o.DSBackend,
+		`What implements the datastore API: "datastore" (Cloud Datastore, default), `+
+			`"firestore" (Firestore in Native mode) or "file" (in-memory datastore persisted in -ds-file, for local development only).`,
+	)
+	f.StringVar(
+		&o.DSFile,
+		"ds-file",
+		o.DSFile,
+		`Path to the file to persist the datastore in when using "-ds-backend file".`,
+	)
 }
 
 // NewModule returns a server module that adds implementation of
@@ -162,20 +179,52 @@ func (m *gaeModule) Initialize(ctx context.Context, host module.Host, opts modul
 		return nil, errors.Reason("-ds-connection-pool-size: must be >= 0, but %d", s).Err()
 	}
 
-	var client *datastore.Client
-	if opts.CloudProject != "" {
-		var err error
-		if client, err = m.initDSClient(ctx, host, opts.CloudProject, m.opts.DSConnectionPoolSize); err != nil {
-			return nil, err
-		}
-	}
 	cfg := &cloud.ConfigLite{
 		IsDev:     !opts.Prod,
 		ProjectID: opts.CloudProject,
-		DS:        client, // if nil, datastore calls will fail gracefully(-ish)
+	}
+
+	switch m.opts.DSBackend {
+	case "", "datastore":
+		if opts.CloudProject != "" {
+			var err error
+			if cfg.DS, err = m.initDSClient(ctx, host, opts.CloudProject, m.opts.DSConnectionPoolSize); err != nil {
+				return nil, err
+			}
+		} // if nil, datastore calls will fail gracefully(-ish)
+	case "firestore":
+		if opts.CloudProject == "" {
+			return nil, errors.Reason("`-ds-backend firestore` requires -cloud-project").Err()
+		}
+		var err error
+		if cfg.FS, err = m.initFSClient(ctx, host, opts.CloudProject); err != nil {
+			return nil, err
+		}
+	case "file":
+		if opts.Prod {
+			return nil, errors.Reason("`-ds-backend file` is not allowed in production").Err()
+		}
+		if m.opts.DSFile == "" {
+			return nil, errors.Reason("`-ds-backend file` requires -ds-file").Err()
+		}
+	default:
+		return nil, errors.Reason("unsupported -ds-backend %q", m.opts.DSBackend).Err()
 	}
 
 	ctx = cfg.Use(ctx)
+	if m.opts.DSBackend == "file" {
+		logging.Infof(ctx, "Using the datastore persisted in %q", m.opts.DSFile)
+		var journal *memory.Journal
+		var err error
+		if ctx, journal, err = memory.UseDatastoreWithJournal(ctx, m.opts.DSFile); err != nil {
+			return nil, err
+		}
+		host.RegisterCleanup(func(ctx context.Context) {
+			if err := journal.Close(); err != nil {
+				logging.Errorf(ctx, "Failed to close the datastore file - %s", err)
+			}
+		})
+	}
 	if cacheImpl != nil {
 		ctx = dscache.FilterRDS(ctx, cacheImpl)
 	}
@@ -218,3 +267,34 @@ func (m *gaeModule) initDSClient(ctx context.Context, host module.Host, cloudPro
 
 	return client, nil
 }
+
+// initFSClient sets up Firestore client that uses AsSelf server token source.
+func (m *gaeModule) initFSClient(ctx context.Context, host module.Host, cloudProject string) (*firestore.Client, error) {
+	logging.Infof(ctx, "Setting up firestore client for project %q", cloudProject)
+
+	// Enable auth only when using the real firestore.
+	var clientOpts []option.ClientOption
+	if addr := os.Getenv("FIRESTORE_EMULATOR_HOST"); addr == "" {
+		ts, err := auth.GetTokenSource(ctx, auth.AsSelf, auth.WithScopes(auth.CloudOAuthScopes...))
+		if err != nil {
+			return nil, errors.Annotate(err, "failed to initialize the token source").Err()
+		}
+		clientOpts = []option.ClientOption{
+			option.WithTokenSource(ts),
+			option.WithGRPCDialOption(grpcmon.WithClientRPCStatsMonitor()),
+		}
+	}
+
+	client, err := firestore.NewClient(ctx, cloudProject, clientOpts...)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to instantiate the firestore client").Err()
+	}
+
+	host.RegisterCleanup(func(ctx context.Context) {
+		if err := client.Close(); err != nil {
+			logging.Warningf(ctx, "Failed to close the firestore client - %s", err)
+		}
+	})
+
+	return client, nil
+}
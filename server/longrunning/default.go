@@ -0,0 +1,39 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package longrunning
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/protobuf/proto"
+)
+
+// Default is a manager initialized by the server module.
+var Default = Manager{}
+
+// RegisterKind adds the given operation kind to the internal registry.
+//
+// See Manager.RegisterKind for details.
+func RegisterKind(k Kind) {
+	Default.RegisterKind(k)
+}
+
+// Start creates a new operation and schedules its execution.
+//
+// See Manager.Start for details.
+func Start(ctx context.Context, kind string, req, metadata proto.Message) (*longrunning.Operation, error) {
+	return Default.Start(ctx, kind, req, metadata)
+}
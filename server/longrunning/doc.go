@@ -0,0 +1,50 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package longrunning implements google.longrunning.Operations service on top
+// of server/tq and the datastore.
+//
+// It allows any RPC handler to start a background operation and return it as
+// a google.longrunning.Operation to the caller. The operation is executed by
+// a TQ task that runs the handler registered for the operation's kind. The
+// handler can report its progress via the operation metadata and can be
+// cancelled by the caller. Once the handler finishes, its response (or error)
+// becomes the result of the operation.
+//
+// Clients can poll operations via the standard google.longrunning.Operations
+// service exposed by the server module (see NewModule) and wait for them to
+// finish using prpc.WaitOperation.
+//
+// Only datastore-backed storage is supported currently.
+//
+// Example:
+//
+//	func init() {
+//	  longrunning.RegisterKind(longrunning.Kind{
+//	    ID: "rebuild-index",
+//	    Handler: func(ctx context.Context, req proto.Message, exec *longrunning.Execution) (proto.Message, error) {
+//	      ...
+//	      if err := exec.SetMetadata(ctx, &pb.RebuildProgress{Done: 10}); err != nil {
+//	        return nil, err
+//	      }
+//	      ...
+//	      return &pb.RebuildIndexResponse{...}, nil
+//	    },
+//	  })
+//	}
+//
+//	func (s *Server) RebuildIndex(ctx context.Context, req *pb.RebuildIndexRequest) (*longrunningpb.Operation, error) {
+//	  return longrunning.Start(ctx, "rebuild-index", req, nil)
+//	}
+package longrunning
@@ -0,0 +1,367 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package longrunning
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/gae/filter/txndefer"
+	"go.chromium.org/luci/gae/impl/memory"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging/gologger"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/tq"
+	"go.chromium.org/luci/server/tq/tqtesting"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	Convey("With manager", t, func() {
+		ctx := txndefer.FilterRDS(memory.Use(context.Background()))
+		ctx = gologger.StdConfig.Use(ctx)
+		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+		tc.SetTimerCallback(func(d time.Duration, t clock.Timer) {
+			if testclock.HasTags(t, tqtesting.ClockTag) {
+				tc.Add(d)
+			}
+		})
+		ctx = auth.WithState(ctx, &authtest.FakeState{
+			Identity: "user:owner@example.com",
+		})
+
+		dispatcher := &tq.Dispatcher{}
+		ctx, sched := tq.TestingContext(ctx, dispatcher)
+
+		mgr := &Manager{Queue: "ops-queue"}
+		mgr.Install(dispatcher)
+
+		// handler is set by test cases.
+		var handler Handler
+		mgr.RegisterKind(Kind{
+			ID: "test-kind",
+			Handler: func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				return handler(ctx, req, exec)
+			},
+		})
+
+		runTasks := func() {
+			sched.Run(ctx, tqtesting.StopWhenDrained())
+		}
+
+		start := func() *longrunning.Operation {
+			op, err := mgr.Start(ctx, "test-kind", wrapperspb.String("request"), nil)
+			So(err, ShouldBeNil)
+			return op
+		}
+
+		get := func(name string) *longrunning.Operation {
+			op, err := mgr.Get(ctx, name)
+			So(err, ShouldBeNil)
+			return op
+		}
+
+		Convey("Success", func() {
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				So(exec.SetMetadata(ctx, wrapperspb.Int64(50)), ShouldBeNil)
+				op := get(exec.Name())
+				md, _ := op.Metadata.UnmarshalNew()
+				So(md, ShouldResembleProto, wrapperspb.Int64(50))
+				return wrapperspb.String(req.(*wrapperspb.StringValue).Value + " done"), nil
+			}
+
+			op := start()
+			So(op.Done, ShouldBeFalse)
+			So(get(op.Name), ShouldResembleProto, op)
+			So(sched.Tasks(), ShouldHaveLength, 1)
+			So(sched.Tasks()[0].Class, ShouldEqual, "longrunning-execute-operation")
+
+			runTasks()
+
+			op = get(op.Name)
+			So(op.Done, ShouldBeTrue)
+			resp, err := op.GetResponse().UnmarshalNew()
+			So(err, ShouldBeNil)
+			So(resp, ShouldResembleProto, wrapperspb.String("request done"))
+		})
+
+		Convey("Fatal error", func() {
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				return nil, status.Errorf(codes.FailedPrecondition, "boom")
+			}
+			op := start()
+			runTasks()
+
+			op = get(op.Name)
+			So(op.Done, ShouldBeTrue)
+			So(op.GetError().Code, ShouldEqual, int32(codes.FailedPrecondition))
+			So(op.GetError().Message, ShouldEqual, "boom")
+		})
+
+		Convey("Fatal non-status error", func() {
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				return nil, errors.Reason("secret details").Tag(grpcutil.NotFoundTag).Err()
+			}
+			op := start()
+			runTasks()
+
+			op = get(op.Name)
+			So(op.Done, ShouldBeTrue)
+			So(op.GetError().Code, ShouldEqual, int32(codes.NotFound))
+			So(op.GetError().Message, ShouldEqual, "the operation failed")
+		})
+
+		Convey("Start inside a transaction", func() {
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				return wrapperspb.String("ok"), nil
+			}
+
+			var op *longrunning.Operation
+			err := datastore.RunInTransaction(ctx, func(ctx context.Context) (err error) {
+				op, err = mgr.Start(ctx, "test-kind", wrapperspb.String("request"), nil)
+				return err
+			}, nil)
+			So(err, ShouldBeNil)
+			So(get(op.Name).Done, ShouldBeFalse)
+
+			runTasks()
+			So(get(op.Name).GetResponse(), ShouldNotBeNil)
+
+			Convey("Rolled back with the transaction", func() {
+				err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+					op, err = mgr.Start(ctx, "test-kind", wrapperspb.String("request"), nil)
+					So(err, ShouldBeNil)
+					return errors.New("rollback")
+				}, nil)
+				So(err, ShouldErrLike, "rollback")
+				_, err = mgr.Get(ctx, op.Name)
+				So(err, ShouldEqual, ErrNoSuchOperation)
+				So(sched.Tasks(), ShouldBeEmpty)
+			})
+		})
+
+		Convey("Transient error is retried", func() {
+			attempt := 0
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				if attempt++; attempt == 1 {
+					return nil, errors.New("flake", transient.Tag)
+				}
+				return wrapperspb.String("ok"), nil
+			}
+			op := start()
+			runTasks()
+
+			So(attempt, ShouldEqual, 2)
+			So(get(op.Name).GetResponse(), ShouldNotBeNil)
+		})
+
+		Convey("Cancel before start", func() {
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				panic("must not be called")
+			}
+			op := start()
+			So(mgr.Cancel(ctx, op.Name), ShouldBeNil)
+			runTasks()
+
+			So(get(op.Name).GetError().Code, ShouldEqual, int32(codes.Canceled))
+		})
+
+		Convey("Cancel while running", func() {
+			var name string
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				cancelled, err := exec.Cancelled(ctx)
+				So(err, ShouldBeNil)
+				So(cancelled, ShouldBeFalse)
+
+				So(mgr.Cancel(ctx, name), ShouldBeNil)
+
+				cancelled, err = exec.Cancelled(ctx)
+				So(err, ShouldBeNil)
+				So(cancelled, ShouldBeTrue)
+				return nil, status.Errorf(codes.Canceled, "stopped")
+			}
+			name = start().Name
+			runTasks()
+
+			So(get(name).GetError().Code, ShouldEqual, int32(codes.Canceled))
+		})
+
+		Convey("Delete", func() {
+			op := start()
+			So(mgr.Delete(ctx, op.Name), ShouldBeNil)
+			_, err := mgr.Get(ctx, op.Name)
+			So(err, ShouldEqual, ErrNoSuchOperation)
+			runTasks() // doesn't fail
+		})
+
+		Convey("Unknown operations", func() {
+			_, err := mgr.Get(ctx, "operations/missing")
+			So(err, ShouldEqual, ErrNoSuchOperation)
+			_, err = mgr.Get(ctx, "bad-name")
+			So(err, ShouldEqual, ErrNoSuchOperation)
+			So(mgr.Cancel(ctx, "operations/missing"), ShouldEqual, ErrNoSuchOperation)
+			So(mgr.Delete(ctx, "operations/missing"), ShouldEqual, ErrNoSuchOperation)
+		})
+
+		Convey("Unknown kind", func() {
+			_, err := mgr.Start(ctx, "unknown", wrapperspb.String("request"), nil)
+			So(err, ShouldErrLike, "unknown operation kind")
+		})
+
+		Convey("OperationsServer", func() {
+			srv := &OperationsServer{Manager: mgr, AdminGroup: "admins"}
+
+			asUser := func(id string, groups ...string) context.Context {
+				return auth.WithState(ctx, &authtest.FakeState{
+					Identity:       identityOf(id),
+					IdentityGroups: groups,
+				})
+			}
+			owner := asUser("owner")
+			stranger := asUser("stranger")
+			admin := asUser("admin", "admins")
+			anonymous := auth.WithState(ctx, &authtest.FakeState{Identity: identity.AnonymousIdentity})
+
+			handler = func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error) {
+				return wrapperspb.String("ok"), nil
+			}
+			op := start()
+
+			Convey("GetOperation", func() {
+				res, err := srv.GetOperation(owner, &longrunning.GetOperationRequest{Name: op.Name})
+				So(err, ShouldBeNil)
+				So(res, ShouldResembleProto, op)
+
+				_, err = srv.GetOperation(stranger, &longrunning.GetOperationRequest{Name: op.Name})
+				So(err, ShouldHaveGRPCStatus, codes.NotFound)
+
+				_, err = srv.GetOperation(admin, &longrunning.GetOperationRequest{Name: op.Name})
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Anonymous callers", func() {
+				anonOp, err := mgr.Start(anonymous, "test-kind", wrapperspb.String("request"), nil)
+				So(err, ShouldBeNil)
+
+				_, err = srv.GetOperation(anonymous, &longrunning.GetOperationRequest{Name: anonOp.Name})
+				So(err, ShouldHaveGRPCStatus, codes.Unauthenticated)
+				_, err = srv.CancelOperation(anonymous, &longrunning.CancelOperationRequest{Name: anonOp.Name})
+				So(err, ShouldHaveGRPCStatus, codes.Unauthenticated)
+				_, err = srv.ListOperations(anonymous, &longrunning.ListOperationsRequest{})
+				So(err, ShouldHaveGRPCStatus, codes.Unauthenticated)
+
+				_, err = srv.GetOperation(admin, &longrunning.GetOperationRequest{Name: anonOp.Name})
+				So(err, ShouldBeNil)
+			})
+
+			Convey("ListOperations", func() {
+				for i := 0; i < 2; i++ {
+					_, err := mgr.Start(stranger, "test-kind", wrapperspb.String("request"), nil)
+					So(err, ShouldBeNil)
+				}
+				datastore.GetTestable(ctx).CatchupIndexes()
+
+				list := func(ctx context.Context, pageSize int32) (names []string) {
+					token := ""
+					for {
+						res, err := srv.ListOperations(ctx, &longrunning.ListOperationsRequest{
+							PageSize:  pageSize,
+							PageToken: token,
+						})
+						So(err, ShouldBeNil)
+						for _, op := range res.Operations {
+							names = append(names, op.Name)
+						}
+						if token = res.NextPageToken; token == "" {
+							return
+						}
+					}
+				}
+
+				So(list(owner, 0), ShouldResemble, []string{op.Name})
+				So(list(stranger, 1), ShouldHaveLength, 2)
+				So(list(admin, 2), ShouldHaveLength, 3)
+
+				_, err := srv.ListOperations(owner, &longrunning.ListOperationsRequest{Filter: "done=true"})
+				So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+				_, err = srv.ListOperations(owner, &longrunning.ListOperationsRequest{PageToken: "zzz"})
+				So(err, ShouldHaveGRPCStatus, codes.InvalidArgument)
+			})
+
+			Convey("CancelOperation", func() {
+				_, err := srv.CancelOperation(stranger, &longrunning.CancelOperationRequest{Name: op.Name})
+				So(err, ShouldHaveGRPCStatus, codes.NotFound)
+				_, err = srv.CancelOperation(owner, &longrunning.CancelOperationRequest{Name: op.Name})
+				So(err, ShouldBeNil)
+				runTasks()
+				So(get(op.Name).GetError().Code, ShouldEqual, int32(codes.Canceled))
+			})
+
+			Convey("DeleteOperation", func() {
+				_, err := srv.DeleteOperation(stranger, &longrunning.DeleteOperationRequest{Name: op.Name})
+				So(err, ShouldHaveGRPCStatus, codes.NotFound)
+				_, err = srv.DeleteOperation(admin, &longrunning.DeleteOperationRequest{Name: op.Name})
+				So(err, ShouldBeNil)
+				_, err = srv.GetOperation(owner, &longrunning.GetOperationRequest{Name: op.Name})
+				So(err, ShouldHaveGRPCStatus, codes.NotFound)
+			})
+
+			Convey("WaitOperation", func() {
+				Convey("Timeout", func() {
+					tc.SetTimerCallback(func(d time.Duration, t clock.Timer) { tc.Add(d) })
+					res, err := srv.WaitOperation(owner, &longrunning.WaitOperationRequest{
+						Name:    op.Name,
+						Timeout: durationpb.New(5 * time.Second),
+					})
+					So(err, ShouldBeNil)
+					So(res.Done, ShouldBeFalse)
+				})
+
+				Convey("Done", func() {
+					runTasks()
+					res, err := srv.WaitOperation(owner, &longrunning.WaitOperationRequest{Name: op.Name})
+					So(err, ShouldBeNil)
+					So(res.Done, ShouldBeTrue)
+				})
+			})
+		})
+	})
+}
+
+func identityOf(name string) identity.Identity {
+	return identity.Identity(fmt.Sprintf("user:%s@example.com", name))
+}
@@ -0,0 +1,511 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package longrunning
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/gae/service/datastore"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/longrunning/internal/tasks"
+	"go.chromium.org/luci/server/tq"
+
+	// Enable datastore transactional tasks support.
+	_ "go.chromium.org/luci/server/tq/txn/datastore"
+)
+
+// ErrNoSuchOperation is returned when the requested operation doesn't exist.
+var ErrNoSuchOperation = errors.New("no such operation")
+
+// namePrefix is a prefix of all operation names.
+const namePrefix = "operations/"
+
+// kindIDRe is used to validate Kind.ID.
+var kindIDRe = regexp.MustCompile(`^[a-zA-Z0-9_\-.]{1,100}$`)
+
+// Handler executes a long-running operation.
+//
+// It receives the request passed to Manager.Start and an Execution that can be
+// used to report progress and to check if the operation was cancelled. The
+// returned response becomes the result of the operation.
+//
+// Errors tagged with transient.Tag cause a retry of the whole handler, so it
+// should be idempotent. gRPC status errors finish the operation with this
+// status as is, so handlers can use them to report errors to clients. All
+// other errors finish the operation with a status that has the error's gRPC
+// code (derived via grpcutil.Code) and a generic message. Such errors are
+// logged, but not exposed to clients.
+type Handler func(ctx context.Context, req proto.Message, exec *Execution) (proto.Message, error)
+
+// Kind describes a class of long-running operations.
+type Kind struct {
+	// ID identifies the kind. Must match `[a-zA-Z0-9_\-.]{1,100}`.
+	//
+	// It is stored in the datastore, don't change it once operations of this kind
+	// exist.
+	ID string
+
+	// Handler executes operations of this kind.
+	Handler Handler
+}
+
+// Manager creates long-running operations and executes them via TQ tasks.
+//
+// Operations are stored in the datastore and exposed via the standard
+// google.longrunning.Operations service (see NewOperationsServer).
+type Manager struct {
+	// Queue is a name of the Cloud Tasks queue to use for executing operations.
+	//
+	// If empty, "default" is used.
+	Queue string
+
+	m     sync.RWMutex
+	disp  *tq.Dispatcher
+	kinds map[string]*Kind
+}
+
+// Install registers task queue task classes with the dispatcher.
+//
+// Must be called only once.
+func (m *Manager) Install(disp *tq.Dispatcher) {
+	m.m.Lock()
+	defer m.m.Unlock()
+
+	if m.disp != nil {
+		panic("longrunning.Manager.Install must be called only once")
+	}
+	m.disp = disp
+
+	queue := m.Queue
+	if queue == "" {
+		queue = "default"
+	}
+
+	disp.RegisterTaskClass(tq.TaskClass{
+		ID:        "longrunning-execute-operation",
+		Prototype: &tasks.ExecuteOperation{},
+		Kind:      tq.Transactional,
+		Queue:     queue,
+		Handler: func(ctx context.Context, payload proto.Message) error {
+			return m.executeOperation(ctx, payload.(*tasks.ExecuteOperation).OperationId)
+		},
+	})
+}
+
+// tq returns a dispatcher set in Install or panics if not set yet.
+func (m *Manager) tq() *tq.Dispatcher {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	if m.disp == nil {
+		panic("longrunning.Manager wasn't installed into tq.Dispatcher yet")
+	}
+	return m.disp
+}
+
+// RegisterKind adds the given operation kind to the internal registry.
+//
+// Intended to be used during init() time or early during the process
+// initialization. Panics if the kind is invalid or a kind with such ID has
+// already been registered.
+func (m *Manager) RegisterKind(k Kind) {
+	if !kindIDRe.MatchString(k.ID) {
+		panic(fmt.Sprintf("bad operation kind ID %q", k.ID))
+	}
+	if k.Handler == nil {
+		panic(fmt.Sprintf("operation kind %q has no handler", k.ID))
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.kinds == nil {
+		m.kinds = make(map[string]*Kind, 1)
+	}
+	if _, ok := m.kinds[k.ID]; ok {
+		panic(fmt.Sprintf("operation kind %q is already registered", k.ID))
+	}
+	m.kinds[k.ID] = &k
+}
+
+// getKind returns a registered kind or nil.
+func (m *Manager) getKind(id string) *Kind {
+	m.m.RLock()
+	defer m.m.RUnlock()
+	return m.kinds[id]
+}
+
+// Start creates a new operation and schedules its execution.
+//
+// The operation is owned by the current caller identity. Its initial metadata
+// can be nil. Returns the operation as it should be returned by an RPC that
+// started it.
+//
+// Launches a datastore transaction inside. If the context is already
+// transactional, creates the operation as part of that transaction instead.
+func (m *Manager) Start(ctx context.Context, kind string, req, metadata proto.Message) (*longrunning.Operation, error) {
+	disp := m.tq()
+	if m.getKind(kind) == nil {
+		return nil, errors.Reason("unknown operation kind %q", kind).Err()
+	}
+
+	reqAny, err := anypb.New(req)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to serialize the request").Err()
+	}
+	op := &longrunning.Operation{Name: namePrefix + newOperationID()}
+	if metadata != nil {
+		if op.Metadata, err = anypb.New(metadata); err != nil {
+			return nil, errors.Annotate(err, "failed to serialize the metadata").Err()
+		}
+	}
+
+	now := clock.Now(ctx).UTC()
+	ent := &operation{
+		ID:        strings.TrimPrefix(op.Name, namePrefix),
+		Kind:      kind,
+		Owner:     auth.CurrentIdentity(ctx),
+		Created:   now,
+		Updated:   now,
+		Request:   reqAny,
+		Operation: op,
+	}
+
+	err = runTxn(ctx, func(ctx context.Context) error {
+		if err := datastore.Put(ctx, ent); err != nil {
+			return errors.Annotate(err, "failed to store the operation").Tag(transient.Tag).Err()
+		}
+		return disp.AddTask(ctx, &tq.Task{
+			Title:   fmt.Sprintf("%s:%s", kind, ent.ID),
+			Payload: &tasks.ExecuteOperation{OperationId: ent.ID},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(op).(*longrunning.Operation), nil
+}
+
+// Get returns the current state of an operation given its name.
+//
+// Returns ErrNoSuchOperation if there's no such operation. All other errors
+// are transient.
+func (m *Manager) Get(ctx context.Context, name string) (*longrunning.Operation, error) {
+	ent, err := getOperation(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return ent.Operation, nil
+}
+
+// Cancel requests cancellation of an operation.
+//
+// The operation's handler is expected to notice the request via
+// Execution.Cancelled and wrap up. If the operation hasn't started yet, it
+// finishes with CANCELLED status without running the handler. Does nothing if
+// the operation is already done.
+//
+// Returns ErrNoSuchOperation if there's no such operation.
+func (m *Manager) Cancel(ctx context.Context, name string) error {
+	id, err := operationID(name)
+	if err != nil {
+		return err
+	}
+	return runTxn(ctx, func(ctx context.Context) error {
+		ent := &operation{ID: id}
+		if err := getEntity(ctx, ent); err != nil {
+			return err
+		}
+		if ent.Operation.Done || ent.CancelRequested {
+			return nil
+		}
+		ent.CancelRequested = true
+		ent.Updated = clock.Now(ctx).UTC()
+		return transient.Tag.Apply(datastore.Put(ctx, ent))
+	})
+}
+
+// Delete deletes an operation.
+//
+// It doesn't cancel the operation if it is still running, but its result will
+// be discarded.
+//
+// Returns ErrNoSuchOperation if there's no such operation.
+func (m *Manager) Delete(ctx context.Context, name string) error {
+	ent, err := getOperation(ctx, name)
+	if err != nil {
+		return err
+	}
+	return transient.Tag.Apply(datastore.Delete(ctx, ent))
+}
+
+// List returns a page of operations, optionally only ones owned by the given
+// identity.
+//
+// Returns the operations and a cursor for the next page (or "" if this is the
+// last page). All errors are either transient or caused by a bad page token.
+func (m *Manager) List(ctx context.Context, owner identity.Identity, pageSize int32, pageToken string) ([]*longrunning.Operation, string, error) {
+	if pageSize <= 0 || pageSize > 1000 {
+		pageSize = 1000
+	}
+
+	q := datastore.NewQuery("longrunning.Operation").Limit(pageSize)
+	if owner != "" {
+		q = q.Eq("Owner", owner)
+	}
+	if pageToken != "" {
+		cursor, err := datastore.DecodeCursor(ctx, pageToken)
+		if err != nil {
+			return nil, "", errors.Annotate(err, "bad page token").Tag(grpcutil.InvalidArgumentTag).Err()
+		}
+		q = q.Start(cursor)
+	}
+
+	var ops []*longrunning.Operation
+	var next string
+	err := datastore.Run(ctx, q, func(ent *operation, cb datastore.CursorCB) error {
+		ops = append(ops, ent.Operation)
+		if len(ops) == int(pageSize) {
+			cursor, err := cb()
+			if err != nil {
+				return err
+			}
+			next = cursor.String()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", errors.Annotate(err, "failed to query operations").Tag(transient.Tag).Err()
+	}
+	return ops, next, nil
+}
+
+// executeOperation is a TQ task handler that runs an operation.
+func (m *Manager) executeOperation(ctx context.Context, id string) error {
+	ent := &operation{ID: id}
+	switch err := getEntity(ctx, ent); {
+	case err == ErrNoSuchOperation:
+		logging.Warningf(ctx, "Operation %q is gone", id)
+		return nil
+	case err != nil:
+		return err
+	case ent.Operation.Done:
+		return nil
+	case ent.CancelRequested:
+		return m.finish(ctx, id, nil, status.New(codes.Canceled, "the operation was cancelled"))
+	}
+
+	kind := m.getKind(ent.Kind)
+	if kind == nil {
+		return m.finish(ctx, id, nil, status.Newf(codes.Internal, "unknown operation kind %q", ent.Kind))
+	}
+	req, err := ent.Request.UnmarshalNew()
+	if err != nil {
+		logging.Errorf(ctx, "Failed to deserialize the request of operation %q: %s", id, err)
+		return m.finish(ctx, id, nil, status.New(codes.Internal, "internal error"))
+	}
+
+	ctx = logging.SetField(ctx, "operation", ent.ID)
+	resp, err := kind.Handler(ctx, req, &Execution{id: id})
+	if err == nil {
+		return m.finish(ctx, id, resp, nil)
+	}
+	if transient.Tag.In(err) {
+		return err
+	}
+	logging.Warningf(ctx, "Operation %q failed: %s", id, err)
+	if st, ok := status.FromError(err); ok {
+		return m.finish(ctx, id, nil, st)
+	}
+	return m.finish(ctx, id, nil, status.New(grpcutil.Code(err), "the operation failed"))
+}
+
+// finish marks the operation as done, storing its result.
+func (m *Manager) finish(ctx context.Context, id string, resp proto.Message, st *status.Status) error {
+	var result *anypb.Any
+	if st == nil {
+		var err error
+		if result, err = anypb.New(resp); err != nil {
+			logging.Errorf(ctx, "Failed to serialize the response of operation %q: %s", id, err)
+			st = status.New(codes.Internal, "internal error")
+		}
+	}
+	return runTxn(ctx, func(ctx context.Context) error {
+		ent := &operation{ID: id}
+		switch err := getEntity(ctx, ent); {
+		case err == ErrNoSuchOperation:
+			return nil // was deleted
+		case err != nil:
+			return err
+		case ent.Operation.Done:
+			return nil
+		}
+		ent.Operation.Done = true
+		if st != nil {
+			ent.Operation.Result = &longrunning.Operation_Error{Error: st.Proto()}
+		} else {
+			ent.Operation.Result = &longrunning.Operation_Response{Response: result}
+		}
+		ent.Updated = clock.Now(ctx).UTC()
+		return transient.Tag.Apply(datastore.Put(ctx, ent))
+	})
+}
+
+// Execution is passed to a running Handler.
+type Execution struct {
+	id string
+}
+
+// Name is the name of the running operation.
+func (e *Execution) Name() string {
+	return namePrefix + e.id
+}
+
+// SetMetadata updates the operation's metadata, e.g. to report the progress.
+//
+// The metadata is visible to clients polling the operation. Errors are
+// transient.
+func (e *Execution) SetMetadata(ctx context.Context, metadata proto.Message) error {
+	md, err := anypb.New(metadata)
+	if err != nil {
+		return errors.Annotate(err, "failed to serialize the metadata").Err()
+	}
+	return runTxn(ctx, func(ctx context.Context) error {
+		ent := &operation{ID: e.id}
+		if err := getEntity(ctx, ent); err != nil {
+			return transient.Tag.Apply(err)
+		}
+		if ent.Operation.Done {
+			return nil
+		}
+		ent.Operation.Metadata = md
+		ent.Updated = clock.Now(ctx).UTC()
+		return transient.Tag.Apply(datastore.Put(ctx, ent))
+	})
+}
+
+// Cancelled returns true if the cancellation of the operation was requested.
+//
+// Handlers should check it periodically and, once it returns true, stop and
+// return an error with codes.Canceled. Errors are transient.
+func (e *Execution) Cancelled(ctx context.Context) (bool, error) {
+	ent := &operation{ID: e.id}
+	if err := getEntity(ctx, ent); err != nil {
+		return false, transient.Tag.Apply(err)
+	}
+	return ent.CancelRequested, nil
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
+// operation is a datastore entity that stores a long-running operation.
+type operation struct {
+	_kind  string                `gae:"$kind,longrunning.Operation"`
+	_extra datastore.PropertyMap `gae:"-,extra"`
+
+	ID              string            `gae:"$id"`
+	Kind            string            `gae:",noindex"` // ID of the registered Kind
+	Owner           identity.Identity // who started the operation
+	Created         time.Time         `gae:",noindex"`
+	Updated         time.Time         `gae:",noindex"`
+	CancelRequested bool              `gae:",noindex"`
+
+	Request   *anypb.Any             `gae:",zstd"` // the request passed to the handler
+	Operation *longrunning.Operation `gae:",zstd"` // the state exposed via the API
+}
+
+// newOperationID generates a new random operation ID.
+func newOperationID() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// operationID extracts the operation ID from its name.
+func operationID(name string) (string, error) {
+	id := strings.TrimPrefix(name, namePrefix)
+	if id == name || id == "" || strings.Contains(id, "/") {
+		return "", ErrNoSuchOperation
+	}
+	return id, nil
+}
+
+// getOperation fetches the operation entity given the operation name.
+func getOperation(ctx context.Context, name string) (*operation, error) {
+	id, err := operationID(name)
+	if err != nil {
+		return nil, err
+	}
+	ent := &operation{ID: id}
+	if err := getEntity(ctx, ent); err != nil {
+		return nil, err
+	}
+	return ent, nil
+}
+
+// getEntity fetches the operation entity, converting errors.
+func getEntity(ctx context.Context, ent *operation) error {
+	switch err := datastore.Get(ctx, ent); {
+	case err == datastore.ErrNoSuchEntity:
+		return ErrNoSuchOperation
+	case err != nil:
+		return errors.Annotate(err, "failed to fetch the operation").Tag(transient.Tag).Err()
+	}
+	return nil
+}
+
+// runTxn runs a datastore transaction retrying the body on transient errors or
+// when encountering a commit conflict.
+//
+// If the context is already transactional, just calls the callback.
+func runTxn(ctx context.Context, cb func(context.Context) error) error {
+	if datastore.CurrentTransaction(ctx) != nil {
+		return cb(ctx)
+	}
+	var innerErr error
+	err := datastore.RunInTransaction(ctx, func(ctx context.Context) error {
+		innerErr = cb(ctx)
+		if transient.Tag.In(innerErr) {
+			return datastore.ErrConcurrentTransaction // causes a retry
+		}
+		return innerErr
+	}, nil)
+	if err != nil {
+		if innerErr != nil {
+			return innerErr
+		}
+		return transient.Tag.Apply(err)
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package longrunning
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/retry/transient"
+	"go.chromium.org/luci/grpc/grpcutil"
+
+	"go.chromium.org/luci/server/auth"
+)
+
+const (
+	// defaultWaitTimeout is used by WaitOperation if the timeout isn't given.
+	defaultWaitTimeout = 30 * time.Second
+	// maxWaitTimeout is the maximum timeout accepted by WaitOperation.
+	maxWaitTimeout = 5 * time.Minute
+	// waitPollInterval is how often WaitOperation checks the operation.
+	waitPollInterval = time.Second
+)
+
+// OperationsServer implements google.longrunning.Operations service on top of
+// a Manager.
+//
+// Callers can see and manage only operations they have started, unless they
+// are members of AdminGroup. Operations owned by someone else look as if they
+// don't exist. Anonymous callers have no access to any operations, since they
+// are indistinguishable from one another.
+type OperationsServer struct {
+	longrunning.UnimplementedOperationsServer
+
+	// Manager is the manager that owns the operations.
+	Manager *Manager
+
+	// AdminGroup is a group with access to all operations.
+	//
+	// If empty, only owners have access to operations.
+	AdminGroup string
+}
+
+// ListOperations implements the corresponding RPC method.
+func (s *OperationsServer) ListOperations(ctx context.Context, req *longrunning.ListOperationsRequest) (*longrunning.ListOperationsResponse, error) {
+	if req.Filter != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "filters are not supported")
+	}
+	if req.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "page_size must be non-negative")
+	}
+
+	admin, err := s.isAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var owner identity.Identity
+	if !admin {
+		if owner = auth.CurrentIdentity(ctx); owner == identity.AnonymousIdentity {
+			return nil, status.Errorf(codes.Unauthenticated, "anonymous callers have no access to operations")
+		}
+	}
+
+	ops, next, err := s.Manager.List(ctx, owner, req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &longrunning.ListOperationsResponse{
+		Operations:    ops,
+		NextPageToken: next,
+	}, nil
+}
+
+// GetOperation implements the corresponding RPC method.
+func (s *OperationsServer) GetOperation(ctx context.Context, req *longrunning.GetOperationRequest) (*longrunning.Operation, error) {
+	ent, err := s.checkAccess(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return ent.Operation, nil
+}
+
+// DeleteOperation implements the corresponding RPC method.
+func (s *OperationsServer) DeleteOperation(ctx context.Context, req *longrunning.DeleteOperationRequest) (*emptypb.Empty, error) {
+	if _, err := s.checkAccess(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.Manager.Delete(ctx, req.Name); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CancelOperation implements the corresponding RPC method.
+func (s *OperationsServer) CancelOperation(ctx context.Context, req *longrunning.CancelOperationRequest) (*emptypb.Empty, error) {
+	if _, err := s.checkAccess(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	if err := s.Manager.Cancel(ctx, req.Name); err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// WaitOperation implements the corresponding RPC method.
+//
+// Polls the operation until it is done or the timeout expires. Returns the
+// latest state of the operation in either case.
+func (s *OperationsServer) WaitOperation(ctx context.Context, req *longrunning.WaitOperationRequest) (*longrunning.Operation, error) {
+	timeout := defaultWaitTimeout
+	if req.Timeout != nil {
+		if err := req.Timeout.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "bad timeout: %s", err)
+		}
+		timeout = req.Timeout.AsDuration()
+	}
+	if timeout > maxWaitTimeout {
+		timeout = maxWaitTimeout
+	}
+	deadline := clock.Now(ctx).Add(timeout)
+
+	for {
+		ent, err := s.checkAccess(ctx, req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ent.Operation.Done || !clock.Now(ctx).Before(deadline) {
+			return ent.Operation, nil
+		}
+		if r := <-clock.After(ctx, waitPollInterval); r.Err != nil {
+			return ent.Operation, nil
+		}
+	}
+}
+
+// checkAccess fetches the operation if the caller has access to it.
+func (s *OperationsServer) checkAccess(ctx context.Context, name string) (*operation, error) {
+	ent, err := getOperation(ctx, name)
+	if err != nil {
+		return nil, toStatus(ctx, err)
+	}
+	caller := auth.CurrentIdentity(ctx)
+	if ent.Owner == caller && caller != identity.AnonymousIdentity {
+		return ent, nil
+	}
+	switch admin, err := s.isAdmin(ctx); {
+	case err != nil:
+		return nil, err
+	case admin:
+		return ent, nil
+	case caller == identity.AnonymousIdentity:
+		return nil, status.Errorf(codes.Unauthenticated, "anonymous callers have no access to operations")
+	default:
+		return nil, toStatus(ctx, ErrNoSuchOperation)
+	}
+}
+
+// isAdmin returns true if the caller is in AdminGroup.
+func (s *OperationsServer) isAdmin(ctx context.Context) (bool, error) {
+	if s.AdminGroup == "" {
+		return false, nil
+	}
+	switch yes, err := auth.IsMember(ctx, s.AdminGroup); {
+	case err != nil:
+		logging.Errorf(ctx, "Failed to check group membership: %s", err)
+		return false, status.Errorf(codes.Internal, "failed to check group membership")
+	default:
+		return yes, nil
+	}
+}
+
+// toStatus converts a Manager error to a gRPC status error.
+//
+// gRPC status errors are returned as is. Errors tagged with a gRPC code (e.g.
+// a bad page token) keep their code and message. All other errors are
+// internal.
+func toStatus(ctx context.Context, err error) error {
+	if errors.Contains(err, ErrNoSuchOperation) {
+		return status.Errorf(codes.NotFound, "no such operation or no access to it")
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if code := grpcutil.Code(err); code != codes.Unknown && !transient.Tag.In(err) {
+		return status.Errorf(code, "%s", err)
+	}
+	logging.Errorf(ctx, "Internal error: %s", err)
+	return status.Errorf(codes.Internal, "internal error")
+}
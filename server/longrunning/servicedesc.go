@@ -0,0 +1,91 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package longrunning
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// RegisterOperationsServer registers the google.longrunning.Operations service
+// implementation in a grpc.ServiceRegistrar.
+//
+// The generated longrunning.RegisterOperationsServer accepts only *grpc.Server
+// and the generated service descriptor is private, so we reconstruct it here.
+func RegisterOperationsServer(r grpc.ServiceRegistrar, srv longrunning.OperationsServer) {
+	r.RegisterService(&operationsServiceDesc, srv)
+}
+
+var operationsServiceDesc = grpc.ServiceDesc{
+	ServiceName: "google.longrunning.Operations",
+	HandlerType: (*longrunning.OperationsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("ListOperations",
+			func() proto.Message { return &longrunning.ListOperationsRequest{} },
+			func(ctx context.Context, srv longrunning.OperationsServer, req proto.Message) (interface{}, error) {
+				return srv.ListOperations(ctx, req.(*longrunning.ListOperationsRequest))
+			}),
+		unaryMethod("GetOperation",
+			func() proto.Message { return &longrunning.GetOperationRequest{} },
+			func(ctx context.Context, srv longrunning.OperationsServer, req proto.Message) (interface{}, error) {
+				return srv.GetOperation(ctx, req.(*longrunning.GetOperationRequest))
+			}),
+		unaryMethod("DeleteOperation",
+			func() proto.Message { return &longrunning.DeleteOperationRequest{} },
+			func(ctx context.Context, srv longrunning.OperationsServer, req proto.Message) (interface{}, error) {
+				return srv.DeleteOperation(ctx, req.(*longrunning.DeleteOperationRequest))
+			}),
+		unaryMethod("CancelOperation",
+			func() proto.Message { return &longrunning.CancelOperationRequest{} },
+			func(ctx context.Context, srv longrunning.OperationsServer, req proto.Message) (interface{}, error) {
+				return srv.CancelOperation(ctx, req.(*longrunning.CancelOperationRequest))
+			}),
+		unaryMethod("WaitOperation",
+			func() proto.Message { return &longrunning.WaitOperationRequest{} },
+			func(ctx context.Context, srv longrunning.OperationsServer, req proto.Message) (interface{}, error) {
+				return srv.WaitOperation(ctx, req.(*longrunning.WaitOperationRequest))
+			}),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "google/longrunning/operations.proto",
+}
+
+// unaryMethod constructs a grpc.MethodDesc the same way protoc-gen-go-grpc
+// does it.
+func unaryMethod(name string, newReq func() proto.Message, call func(context.Context, longrunning.OperationsServer, proto.Message) (interface{}, error)) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := newReq()
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(ctx, srv.(longrunning.OperationsServer), in)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/google.longrunning.Operations/" + name,
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(ctx, srv.(longrunning.OperationsServer), req.(proto.Message))
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
@@ -129,6 +129,21 @@ type Host interface {
 	// It receives the global server context.
 	RegisterCleanup(cb func(context.Context))
 
+	// RegisterHealthCheck registers a health check used by the server's
+	// liveness and readiness probes.
+	//
+	// See HealthCheck for details.
+	RegisterHealthCheck(check HealthCheck)
+
+	// RegisterDrain registers a callback that is run when the server starts
+	// draining in preparation for a shutdown (e.g. after receiving SIGTERM).
+	//
+	// It receives the global server context. The callback should make the
+	// module stop picking up new work (e.g. stop accepting task queue pushes or
+	// taking leases) and return quickly. The server keeps serving in-flight
+	// requests for some time after the drain starts.
+	RegisterDrain(cb func(context.Context))
+
 	// RegisterUnaryServerInterceptor registers an grpc.UnaryServerInterceptor
 	// applied to all unary RPCs that hit the server.
 	//
@@ -137,6 +152,14 @@ type Host interface {
 	// interceptor becomes the outermost.
 	RegisterUnaryServerInterceptor(intr grpc.UnaryServerInterceptor)
 
+	// RegisterStreamServerInterceptor registers an grpc.StreamServerInterceptor
+	// applied to all streaming RPCs that hit the server.
+	//
+	// Interceptors are chained in order they are registered, which matches
+	// the order of modules in the list of modules. The first registered
+	// interceptor becomes the outermost.
+	RegisterStreamServerInterceptor(intr grpc.StreamServerInterceptor)
+
 	// RegisterCookieAuth registers an implementation of a cookie-based
 	// authentication scheme.
 	//
@@ -144,3 +167,33 @@ type Host interface {
 	// start.
 	RegisterCookieAuth(method auth.Method)
 }
+
+// HealthCheck is a health check callback registered via Host.
+//
+// Health checks are called on every liveness or readiness probe request (in
+// parallel with each other), so they should be fast. Their context has
+// a deadline of a few seconds. Errors returned by checks are logged, but probe
+// responses contain only names of failed checks, since probe endpoints are
+// exposed on the public port.
+type HealthCheck struct {
+	// Name identifies the check in probe responses and logs.
+	Name string
+
+	// Check returns an error if the checked subsystem is unhealthy.
+	Check func(ctx context.Context) error
+
+	// Liveness, if true, makes the liveness probe fail when the check fails.
+	//
+	// Kubernetes restarts processes that fail their liveness probe, so this
+	// should be used only for failures that can't be recovered from without
+	// a restart. By default a failing check only makes the readiness probe fail,
+	// i.e. the process stops receiving new traffic until the check passes again.
+	Liveness bool
+
+	// Informational, if true, means the check never makes any probe fail.
+	//
+	// Its failures are only logged and listed as warnings in probe responses.
+	// Should be used for dependencies the server can function without (perhaps
+	// in a degraded mode), e.g. caches. Can't be combined with Liveness.
+	Informational bool
+}
@@ -0,0 +1,198 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/settings"
+)
+
+// historyLimit is how many most recent revisions to show on the history page.
+const historyLimit = 20
+
+// historyPage shows the history of settings changes and allows to roll them
+// back.
+//
+// Works only if the settings storage implements settings.VersionedStorage.
+type historyPage struct {
+	BasePage
+}
+
+var historyTmpl = template.Must(template.New("history").Parse(`
+<p>Recent changes of the settings stored in
+{{if .Versioned}}the versioned storage. Use the buttons below to see what
+changed in a version or to restore all settings to how they were at some
+version. A rollback creates a new version and, like any other change, it
+applies to all processes once their settings caches expire.{{else}}the
+storage that doesn't keep history, nothing to show here.{{end}}</p>
+{{if .Revisions}}
+<table class="table table-condensed">
+  <tr><th>Version</th><th>When</th><th>Who</th><th>Why</th></tr>
+  {{range .Revisions}}
+  <tr>
+    <td>{{.Version}}</td>
+    <td>{{.When.Format "2006-01-02 15:04:05 MST"}}</td>
+    <td>{{.Who}}</td>
+    <td>{{.Why}}</td>
+  </tr>
+  {{end}}
+</table>
+{{end}}
+`))
+
+var diffTmpl = template.Must(template.New("diff").Parse(`
+{{if .}}
+<table class="table table-condensed">
+  <tr><th>Key</th><th>Old</th><th>New</th></tr>
+  {{range .}}
+  <tr>
+    <td>{{.Key}}</td>
+    <td><pre>{{.Old}}</pre></td>
+    <td><pre>{{.New}}</pre></td>
+  </tr>
+  {{end}}
+</table>
+{{else}}
+<p>No changes.</p>
+{{end}}
+`))
+
+func (historyPage) Title(c context.Context) (string, error) {
+	return "Settings history", nil
+}
+
+func (historyPage) Overview(c context.Context) (template.HTML, error) {
+	s := settings.GetSettings(c)
+	if s == nil {
+		return "<p>Settings are not configured.</p>", nil
+	}
+	var revs []*settings.Revision
+	if s.IsVersioned() {
+		var err error
+		if revs, err = s.ListRevisions(c, historyLimit); err != nil {
+			return "", err
+		}
+	}
+	return render(historyTmpl, map[string]interface{}{
+		"Versioned": s.IsVersioned(),
+		"Revisions": revs,
+	})
+}
+
+func (historyPage) Actions(c context.Context) ([]Action, error) {
+	s := settings.GetSettings(c)
+	if s == nil || !s.IsVersioned() {
+		return nil, nil
+	}
+	revs, err := s.ListRevisions(c, historyLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []Action
+	for i, rev := range revs {
+		version := rev.Version
+		actions = append(actions, Action{
+			ID:            fmt.Sprintf("diff-%d", version),
+			Title:         fmt.Sprintf("Changes in v%d", version),
+			NoSideEffects: true,
+			Callback: func(c context.Context) (string, template.HTML, error) {
+				changes, err := diffWithPrevious(c, s, version)
+				if err != nil {
+					return "", "", err
+				}
+				body, err := renderDiff(changes)
+				return fmt.Sprintf("Changes in version %d", version), body, err
+			},
+		})
+		if i == 0 {
+			continue // can't roll back to the current version
+		}
+		actions = append(actions, Action{
+			ID:           fmt.Sprintf("rollback-%d", version),
+			Title:        fmt.Sprintf("Roll back to v%d", version),
+			Confirmation: fmt.Sprintf("Restore all settings to how they were at version %d?", version),
+			Callback: func(c context.Context) (string, template.HTML, error) {
+				why := fmt.Sprintf("rolled back to version %d via web UI", version)
+				if err := s.Rollback(c, version, auth.CurrentUser(c).Email, why); err != nil {
+					return "", "", errors.Annotate(err, "failed to roll back to version %d", version).Err()
+				}
+				return "Done", template.HTML(fmt.Sprintf(
+					"<p>Settings were restored to version %d. The change will apply to "+
+						"all processes once their settings caches expire.</p>", version)), nil
+			},
+		})
+	}
+	return actions, nil
+}
+
+// diffWithPrevious returns changes made in the given version.
+func diffWithPrevious(c context.Context, s *settings.Settings, version int) ([]settings.Change, error) {
+	cur, err := s.GetRevision(c, version)
+	if err != nil {
+		return nil, err
+	}
+	prev, err := s.GetRevision(c, version-1)
+	if err != nil && err != settings.ErrNoSuchRevision {
+		return nil, err
+	}
+	return settings.Diff(prev, cur), nil
+}
+
+// renderDiff renders changes as an HTML table.
+func renderDiff(changes []settings.Change) (template.HTML, error) {
+	type row struct {
+		Key      string
+		Old, New string
+	}
+	rows := make([]row, len(changes))
+	for i, ch := range changes {
+		rows[i] = row{Key: ch.Key, Old: prettyJSON(ch.Old), New: prettyJSON(ch.New)}
+	}
+	return render(diffTmpl, rows)
+}
+
+// prettyJSON formats a JSON value for display.
+func prettyJSON(raw *json.RawMessage) string {
+	if raw == nil {
+		return ""
+	}
+	buf := bytes.Buffer{}
+	if err := json.Indent(&buf, *raw, "", "  "); err != nil {
+		return string(*raw)
+	}
+	return buf.String()
+}
+
+// render executes a template, returning the result as HTML.
+func render(t *template.Template, data interface{}) (template.HTML, error) {
+	out := strings.Builder{}
+	if err := t.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return template.HTML(out.String()), nil
+}
+
+func init() {
+	RegisterPage("settings_history", historyPage{})
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portal
+
+import (
+	"context"
+	"testing"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/settings"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type testSettings struct {
+	Value string `json:"value"`
+}
+
+func TestHistoryPage(t *testing.T) {
+	t.Parallel()
+
+	Convey("With versioned settings", t, func() {
+		ctx := settings.Use(context.Background(), settings.New(&settings.MemoryStorage{}))
+		ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:admin@example.com"})
+
+		So(settings.Set(ctx, "key", &testSettings{"old"}, "who", "why"), ShouldBeNil)
+		So(settings.Set(ctx, "key", &testSettings{"new"}, "who", "why"), ShouldBeNil)
+
+		p := historyPage{}
+		overview, err := p.Overview(ctx)
+		So(err, ShouldBeNil)
+		So(string(overview), ShouldContainSubstring, "<td>2</td>")
+
+		actions, err := p.Actions(ctx)
+		So(err, ShouldBeNil)
+		ids := make([]string, len(actions))
+		for i, a := range actions {
+			ids[i] = a.ID
+		}
+		So(ids, ShouldResemble, []string{"diff-2", "diff-1", "rollback-1"})
+
+		_, body, err := actions[0].Callback(ctx)
+		So(err, ShouldBeNil)
+		So(string(body), ShouldContainSubstring, "&#34;value&#34;: &#34;new&#34;")
+
+		_, _, err = actions[2].Callback(ctx)
+		So(err, ShouldBeNil)
+		s := testSettings{}
+		So(settings.GetUncached(ctx, "key", &s), ShouldBeNil)
+		So(s.Value, ShouldEqual, "old")
+		rev, err := settings.GetSettings(ctx).GetRevision(ctx, 3)
+		So(err, ShouldBeNil)
+		So(rev.Who, ShouldEqual, "admin@example.com")
+	})
+
+	Convey("Without history", t, func() {
+		ctx := settings.Use(context.Background(), settings.New(&settings.ExternalStorage{}))
+		p := historyPage{}
+		overview, err := p.Overview(ctx)
+		So(err, ShouldBeNil)
+		So(string(overview), ShouldContainSubstring, "doesn't keep history")
+		actions, err := p.Actions(ctx)
+		So(err, ShouldBeNil)
+		So(actions, ShouldBeEmpty)
+	})
+}
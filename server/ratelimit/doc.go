@@ -0,0 +1,45 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ratelimit implements a server module that limits the rate of unary
+// RPCs across all replicas of a server.
+//
+// Limits are defined by token bucket rules (see Rule) that apply to some or all
+// methods. Requests matching a rule are split into buckets by the caller
+// identity, the peer IP, the method or not split at all. Each bucket is
+// refilled at the rule's QPS rate, up to the rule's burst size, and each allowed
+// request takes one token from each bucket it belongs to. A rejected request
+// takes no tokens. Buckets are stored in Redis, so the module depends on
+// go.chromium.org/luci/server/redisconn module.
+//
+// Requests that exceed a limit are rejected with RESOURCE_EXHAUSTED status
+// carrying google.rpc.RetryInfo details, and "retry-after" response header with
+// the delay in seconds. If Redis is unavailable, requests are allowed.
+//
+// Rules are read from the settings store under "ratelimit" key, if the settings
+// store is available and the stored rules are valid. Otherwise they are loaded from a JSON file passed via
+// -ratelimit-rules flag, e.g.
+//
+//	{
+//	  "rules": [
+//	    {
+//	      "name": "per-user-writes",
+//	      "methods": ["/pkg.Things/CreateThing", "/pkg.Things/DeleteThing"],
+//	      "key": "identity",
+//	      "qps": 1,
+//	      "burst": 10
+//	    }
+//	  ]
+//	}
+package ratelimit
@@ -0,0 +1,111 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+	"go.chromium.org/luci/grpc/prpc"
+)
+
+var requests = metric.NewCounter(
+	"luci/server/ratelimit/requests",
+	"Number of requests checked by a rate limiting rule, by the outcome.",
+	nil,
+	field.String("rule"),    // name of the rule
+	field.String("outcome"), // "allowed", "rejected" or "error"
+)
+
+// newInterceptor returns an interceptor that rejects requests exceeding rate
+// limits.
+//
+// Rules are fetched from the settings store, falling back to `static` if the
+// settings store is not available. Requests are allowed if Redis is
+// unavailable.
+func newInterceptor(static *Settings) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := check(ctx, fetchSettings(ctx, static), info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// check returns a gRPC error if the call exceeds any of the limits.
+func check(ctx context.Context, s *Settings, fullMethod string) error {
+	if s == nil {
+		return nil
+	}
+
+	// Check all matching rules at once, so that a request rejected by one rule
+	// doesn't consume tokens of the others.
+	var rules []*Rule
+	var keys []string
+	for _, r := range s.Rules {
+		if r.matches(fullMethod) {
+			rules = append(rules, r)
+			keys = append(keys, r.bucketKey(ctx, fullMethod))
+		}
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	rejectedBy, retryAfter, err := take(ctx, keys, rules)
+	switch {
+	case err != nil:
+		logging.Warningf(ctx, "Rate limit check failed, allowing the request: %s", err)
+		for _, r := range rules {
+			requests.Add(ctx, 1, r.Name, "error")
+		}
+		return nil
+	case rejectedBy != nil:
+		requests.Add(ctx, 1, rejectedBy.Name, "rejected")
+		return rejectionErr(ctx, rejectedBy, retryAfter)
+	default:
+		for _, r := range rules {
+			requests.Add(ctx, 1, r.Name, "allowed")
+		}
+		return nil
+	}
+}
+
+// rejectionErr returns RESOURCE_EXHAUSTED error with RetryInfo details and
+// sets "retry-after" response header with the delay in seconds.
+func rejectionErr(ctx context.Context, r *Rule, retryAfter time.Duration) error {
+	secs := int64(math.Ceil(retryAfter.Seconds()))
+	if err := prpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.FormatInt(secs, 10))); err != nil {
+		logging.Warningf(ctx, "Failed to set retry-after header: %s", err)
+	}
+	st, err := status.Newf(codes.ResourceExhausted, "rate limit %q exceeded", r.Name).
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	if err != nil {
+		return status.Errorf(codes.ResourceExhausted, "rate limit %q exceeded", r.Name)
+	}
+	return st.Err()
+}
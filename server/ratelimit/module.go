@@ -0,0 +1,101 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"flag"
+
+	"go.chromium.org/luci/common/errors"
+
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/server/ratelimit")
+
+// ModuleOptions contain configuration of the ratelimit server module.
+type ModuleOptions struct {
+	// Settings are rules to use if the settings store is not available.
+	//
+	// If nil and RulesFile is set, they are loaded from RulesFile.
+	Settings *Settings
+
+	// RulesFile is a path to a JSON file with Settings.
+	//
+	// Used only if Settings is nil.
+	RulesFile string
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	f.StringVar(&o.RulesFile, "ratelimit-rules", o.RulesFile,
+		`Path to a JSON file with rate limiting rules, used if the settings store is not available.`)
+}
+
+// NewModule returns a server module that enforces rate limits on unary RPCs.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &ratelimitModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// ratelimitModule implements module.Module.
+type ratelimitModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*ratelimitModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*ratelimitModule) Dependencies() []module.Dependency {
+	return []module.Dependency{
+		module.RequiredDependency(redisconn.ModuleName),
+	}
+}
+
+// Initialize is part of module.Module interface.
+func (m *ratelimitModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	static := m.opts.Settings
+	switch {
+	case static != nil:
+		if err := static.Validate(); err != nil {
+			return nil, errors.Annotate(err, "bad rate limiting rules").Err()
+		}
+	case m.opts.RulesFile != "":
+		var err error
+		if static, err = LoadSettings(m.opts.RulesFile); err != nil {
+			return nil, err
+		}
+	}
+	host.RegisterUnaryServerInterceptor(newInterceptor(static))
+	return ctx, nil
+}
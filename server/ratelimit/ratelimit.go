@@ -0,0 +1,310 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/redisconn"
+	"go.chromium.org/luci/server/settings"
+)
+
+// settingsKey is a key for Settings in the settings store.
+//
+// See go.chromium.org/luci/server/settings.
+const settingsKey = "ratelimit"
+
+func init() {
+	settings.RegisterValidator(settingsKey, func(ctx context.Context, value json.RawMessage) error {
+		s := &Settings{}
+		if err := json.Unmarshal(value, s); err != nil {
+			return err
+		}
+		return s.Validate()
+	})
+}
+
+// Kinds of keys to split requests into buckets by.
+const (
+	// KeyGlobal puts all requests matching a rule into one bucket.
+	KeyGlobal = "global"
+	// KeyIdentity uses a separate bucket per caller identity.
+	KeyIdentity = "identity"
+	// KeyPeer uses a separate bucket per peer IP address.
+	KeyPeer = "peer"
+	// KeyMethod uses a separate bucket per method.
+	KeyMethod = "method"
+)
+
+// Settings contain rate limiting rules.
+//
+// They are usually stored in the settings store under "ratelimit" key or
+// loaded from a JSON file via LoadSettings.
+type Settings struct {
+	// Rules is a list of rules to apply to requests.
+	//
+	// A request must be allowed by all rules that match it.
+	Rules []*Rule `json:"rules,omitempty"`
+}
+
+// Rule defines a token bucket limit for a set of methods.
+type Rule struct {
+	// Name identifies the rule in errors and in Redis keys. Required.
+	//
+	// Renaming a rule resets its buckets.
+	Name string `json:"name"`
+
+	// Methods is a list of methods the rule applies to.
+	//
+	// Each entry is either a full method name (e.g. "/pkg.Service/Method") or
+	// a wildcard matching all methods of a service (e.g. "/pkg.Service/*").
+	// If empty, the rule applies to all methods.
+	Methods []string `json:"methods,omitempty"`
+
+	// Key defines how to split requests into buckets.
+	//
+	// One of "global", "identity", "peer" or "method". Default is "global".
+	Key string `json:"key,omitempty"`
+
+	// QPS is how many requests per second are allowed on average per bucket.
+	//
+	// Required.
+	QPS float64 `json:"qps"`
+
+	// Burst is how many requests can be made at once per bucket.
+	//
+	// Default is QPS rounded up.
+	Burst int64 `json:"burst,omitempty"`
+}
+
+// Validate returns an error if the rule is malformed.
+func (r *Rule) Validate() error {
+	switch {
+	case r.Name == "":
+		return errors.Reason("name is required").Err()
+	case r.QPS <= 0:
+		return errors.Reason("qps must be positive, got %v", r.QPS).Err()
+	case r.Burst < 0:
+		return errors.Reason("burst must be non-negative, got %d", r.Burst).Err()
+	}
+	switch r.Key {
+	case "", KeyGlobal, KeyIdentity, KeyPeer, KeyMethod:
+	default:
+		return errors.Reason("unknown key %q", r.Key).Err()
+	}
+	for _, m := range r.Methods {
+		if !strings.HasPrefix(m, "/") || strings.Count(m, "/") != 2 {
+			return errors.Reason("bad method %q, expecting \"/<service>/<method>\" or \"/<service>/*\"", m).Err()
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if some of the rules are malformed.
+func (s *Settings) Validate() error {
+	seen := map[string]bool{}
+	for i, r := range s.Rules {
+		if err := r.Validate(); err != nil {
+			return errors.Annotate(err, "rule #%d", i+1).Err()
+		}
+		if seen[r.Name] {
+			return errors.Reason("rule #%d: duplicate name %q", i+1, r.Name).Err()
+		}
+		seen[r.Name] = true
+	}
+	return nil
+}
+
+// LoadSettings loads and validates Settings stored as JSON in a file.
+func LoadSettings(path string) (*Settings, error) {
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "failed to read rate limit rules").Err()
+	}
+	s := &Settings{}
+	if err := json.Unmarshal(blob, s); err != nil {
+		return nil, errors.Annotate(err, "failed to parse rate limit rules %q", path).Err()
+	}
+	if err := s.Validate(); err != nil {
+		return nil, errors.Annotate(err, "bad rate limit rules %q", path).Err()
+	}
+	return s, nil
+}
+
+// matches returns true if the rule applies to the method.
+func (r *Rule) matches(fullMethod string) bool {
+	if len(r.Methods) == 0 {
+		return true
+	}
+	for _, m := range r.Methods {
+		if m == fullMethod {
+			return true
+		}
+		if svc := strings.TrimSuffix(m, "*"); svc != m && strings.HasPrefix(fullMethod, svc) {
+			return true
+		}
+	}
+	return false
+}
+
+// burst returns the bucket capacity.
+func (r *Rule) burst() int64 {
+	if r.Burst > 0 {
+		return r.Burst
+	}
+	return int64(math.Ceil(r.QPS))
+}
+
+// bucketKey returns a Redis key of the bucket the request belongs to.
+func (r *Rule) bucketKey(ctx context.Context, fullMethod string) string {
+	var subject string
+	switch r.Key {
+	case KeyIdentity:
+		subject = string(auth.CurrentIdentity(ctx))
+	case KeyPeer:
+		if state := auth.GetState(ctx); state != nil && state.PeerIP() != nil {
+			subject = state.PeerIP().String()
+		}
+	case KeyMethod:
+		subject = fullMethod
+	}
+	h := sha256.Sum256([]byte(subject))
+	return "ratelimit:" + r.Name + ":" + hex.EncodeToString(h[:])
+}
+
+// fetchSettings returns rules to apply, preferring the settings store and
+// falling back to the given static settings.
+//
+// Stored rules are validated, since they may have been stored before the
+// validator was registered. Invalid rules are ignored in favor of the static
+// ones.
+func fetchSettings(ctx context.Context, static *Settings) *Settings {
+	s := &Settings{}
+	switch err := settings.Get(ctx, settingsKey, s); {
+	case err == settings.ErrNoSettings:
+		return static
+	case err != nil:
+		logging.Errorf(ctx, "Failed to fetch rate limit settings: %s", err)
+		return static
+	}
+	if err := s.Validate(); err != nil {
+		logging.Errorf(ctx, "Ignoring bad rate limit settings: %s", err)
+		return static
+	}
+	return s
+}
+
+// takeScript atomically takes one token from each of the given buckets if all
+// of them have a token, and takes nothing otherwise.
+//
+// KEYS are the bucket keys. ARGV[1] is the current time in milliseconds,
+// followed by the refill rate in tokens per second and the bucket capacity of
+// each bucket. Returns a pair {1-based index of the first bucket without
+// a token or 0 if the tokens were taken, retry after in milliseconds}.
+var takeScript = redis.NewScript(-1, `
+	local now = tonumber(ARGV[1])
+
+	local buckets = {}
+	local rejected = 0
+	local retry = 0
+	for i, key in ipairs(KEYS) do
+		local rate = tonumber(ARGV[2 * i])
+		local burst = tonumber(ARGV[2 * i + 1])
+
+		local state = redis.call("HMGET", key, "tokens", "updated")
+		local tokens = tonumber(state[1])
+		local updated = tonumber(state[2])
+		if tokens == nil or updated == nil then
+			tokens = burst
+			updated = now
+		end
+
+		-- Refill tokens since the last update, up to the capacity.
+		if now > updated then
+			tokens = math.min(burst, tokens + (now - updated) * rate / 1000)
+			updated = now
+		end
+
+		-- The request can be retried once all the buckets have a token.
+		if tokens < 1 then
+			if rejected == 0 then
+				rejected = i
+			end
+			retry = math.max(retry, math.ceil((1 - tokens) * 1000 / rate))
+		end
+
+		buckets[i] = {tokens = tokens, updated = updated, rate = rate, burst = burst}
+	end
+
+	for i, key in ipairs(KEYS) do
+		local b = buckets[i]
+		if rejected == 0 then
+			b.tokens = b.tokens - 1
+		end
+		redis.call("HMSET", key, "tokens", tostring(b.tokens), "updated", b.updated)
+		-- The bucket is full again after this time, no need to keep it.
+		redis.call("PEXPIRE", key, math.ceil(b.burst * 1000 / b.rate) + 1000)
+	end
+	return {rejected, retry}
+`)
+
+// take takes one token from the bucket of each rule if all of them have
+// a token.
+//
+// Returns nil if the request is allowed. If not, returns the first rule that
+// rejected it and how long to wait before retrying.
+func take(ctx context.Context, keys []string, rules []*Rule) (rejectedBy *Rule, retryAfter time.Duration, err error) {
+	conn, err := redisconn.Get(ctx)
+	if err != nil {
+		return nil, 0, errors.Annotate(err, "failed to connect to Redis").Err()
+	}
+	defer conn.Close()
+
+	now := clock.Now(ctx).UnixNano() / int64(time.Millisecond)
+	args := make([]interface{}, 0, 2+3*len(rules))
+	args = append(args, len(keys))
+	for _, key := range keys {
+		args = append(args, key)
+	}
+	args = append(args, now)
+	for _, r := range rules {
+		args = append(args, r.QPS, r.burst())
+	}
+	res, err := redis.Int64s(takeScript.Do(conn, args...))
+	if err != nil {
+		return nil, 0, errors.Annotate(err, "failed to run the token bucket script").Err()
+	}
+	if len(res) != 2 || res[0] < 0 || res[0] > int64(len(rules)) {
+		return nil, 0, errors.Reason("unexpected token bucket script result %v", res).Err()
+	}
+	if res[0] == 0 {
+		return nil, 0, nil
+	}
+	return rules[res[0]-1], time.Duration(res[1]) * time.Millisecond, nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gomodule/redigo/redis"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/tsmon"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/redisconn"
+	"go.chromium.org/luci/server/settings"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	Convey("With Redis", t, func() {
+		s, err := miniredis.Run()
+		So(err, ShouldBeNil)
+		defer s.Close()
+		addr := s.Addr()
+
+		ctx := redisconn.UsePool(context.Background(), &redis.Pool{
+			Dial: func() (redis.Conn, error) {
+				return redis.Dial("tcp", addr)
+			},
+		})
+		ctx, tc := testclock.UseTime(ctx, testclock.TestRecentTimeUTC)
+		ctx, _ = tsmon.WithDummyInMemory(ctx)
+
+		callAs := func(ctx context.Context, intr grpc.UnaryServerInterceptor, id, method string) error {
+			ctx = auth.WithState(ctx, &authtest.FakeState{Identity: identity.Identity("user:" + id + "@example.com")})
+			_, err := intr(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			return err
+		}
+
+		Convey("Per identity", func() {
+			intr := newInterceptor(&Settings{
+				Rules: []*Rule{
+					{
+						Name:    "writes",
+						Methods: []string{"/pkg.Things/Create", "/pkg.Other/*"},
+						Key:     KeyIdentity,
+						QPS:     1,
+						Burst:   2,
+					},
+				},
+			})
+
+			So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Other/Something"), ShouldBeNil)
+
+			err := callAs(ctx, intr, "a", "/pkg.Things/Create")
+			So(status.Code(err), ShouldEqual, codes.ResourceExhausted)
+			So(err, ShouldErrLike, `rate limit "writes" exceeded`)
+			details := status.Convert(err).Details()
+			So(details, ShouldHaveLength, 1)
+			So(details[0].(*errdetails.RetryInfo).RetryDelay.AsDuration(), ShouldEqual, time.Second)
+
+			// Other callers and methods are not affected.
+			So(callAs(ctx, intr, "b", "/pkg.Things/Create"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Things/Get"), ShouldBeNil)
+
+			// Refills with time.
+			tc.Add(500 * time.Millisecond)
+			err = callAs(ctx, intr, "a", "/pkg.Things/Create")
+			So(status.Code(err), ShouldEqual, codes.ResourceExhausted)
+			details = status.Convert(err).Details()
+			So(details[0].(*errdetails.RetryInfo).RetryDelay.AsDuration(), ShouldEqual, 500*time.Millisecond)
+			tc.Add(500 * time.Millisecond)
+			So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldBeNil)
+
+			// Doesn't exceed the burst size.
+			tc.Add(time.Hour)
+			So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldNotBeNil)
+		})
+
+		Convey("Global and per method", func() {
+			intr := newInterceptor(&Settings{
+				Rules: []*Rule{
+					{Name: "method", Key: KeyMethod, QPS: 1},
+					{Name: "global", QPS: 2},
+				},
+			})
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldBeNil)
+			So(callAs(ctx, intr, "b", "/pkg.Things/B"), ShouldBeNil)
+			So(callAs(ctx, intr, "c", "/pkg.Things/A"), ShouldErrLike, `rate limit "method" exceeded`)
+			So(callAs(ctx, intr, "c", "/pkg.Things/C"), ShouldErrLike, `rate limit "global" exceeded`)
+		})
+
+		Convey("Rejected requests don't consume tokens", func() {
+			intr := newInterceptor(&Settings{
+				Rules: []*Rule{
+					{Name: "global", QPS: 3},
+					{Name: "writes", Methods: []string{"/pkg.Things/Create"}, Key: KeyIdentity, QPS: 1},
+				},
+			})
+			So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldBeNil)
+			// Rejected by "writes", so "global" keeps its tokens.
+			for i := 0; i < 5; i++ {
+				So(callAs(ctx, intr, "a", "/pkg.Things/Create"), ShouldErrLike, `rate limit "writes" exceeded`)
+			}
+			So(callAs(ctx, intr, "b", "/pkg.Things/Get"), ShouldBeNil)
+			So(callAs(ctx, intr, "b", "/pkg.Things/Get"), ShouldBeNil)
+			So(callAs(ctx, intr, "b", "/pkg.Things/Get"), ShouldErrLike, `rate limit "global" exceeded`)
+		})
+
+		Convey("Retry after is the longest wait", func() {
+			intr := newInterceptor(&Settings{
+				Rules: []*Rule{
+					{Name: "fast", QPS: 1},
+					{Name: "slow", QPS: 0.25},
+				},
+			})
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldBeNil)
+			err := callAs(ctx, intr, "a", "/pkg.Things/A")
+			So(err, ShouldErrLike, `rate limit "fast" exceeded`)
+			details := status.Convert(err).Details()
+			So(details[0].(*errdetails.RetryInfo).RetryDelay.AsDuration(), ShouldEqual, 4*time.Second)
+		})
+
+		Convey("Settings store overrides static rules", func() {
+			ctx := settings.Use(ctx, settings.New(&settings.MemoryStorage{}))
+			So(settings.Set(ctx, settingsKey, &Settings{
+				Rules: []*Rule{{Name: "from-settings", QPS: 1}},
+			}, "who", "why"), ShouldBeNil)
+			ctx = settings.Use(ctx, settings.GetSettings(ctx)) // no cache
+
+			intr := newInterceptor(&Settings{Rules: []*Rule{{Name: "static", QPS: 100}}})
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldErrLike, `rate limit "from-settings" exceeded`)
+		})
+
+		Convey("Bad rules in the settings store are ignored", func() {
+			storage := &settings.MemoryStorage{}
+			So(storage.UpdateSetting(ctx, settingsKey, []byte(`{"rules": [{"name": "bad", "qps": 0}]}`), "who", "why"), ShouldBeNil)
+			ctx := settings.Use(ctx, settings.New(storage))
+
+			intr := newInterceptor(&Settings{Rules: []*Rule{{Name: "static", QPS: 1}}})
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldErrLike, `rate limit "static" exceeded`)
+
+			// Can't store them via the settings API.
+			So(settings.Set(ctx, settingsKey, &Settings{
+				Rules: []*Rule{{Name: "bad"}},
+			}, "who", "why"), ShouldErrLike, "qps must be positive")
+		})
+
+		Convey("Allows requests if Redis is down", func() {
+			intr := newInterceptor(&Settings{Rules: []*Rule{{Name: "rule", QPS: 1}}})
+			s.Close()
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldBeNil)
+			So(callAs(ctx, intr, "a", "/pkg.Things/A"), ShouldBeNil)
+		})
+	})
+
+	Convey("Validate", t, func() {
+		So((&Rule{QPS: 1}).Validate(), ShouldErrLike, "name is required")
+		So((&Rule{Name: "r"}).Validate(), ShouldErrLike, "qps must be positive")
+		So((&Rule{Name: "r", QPS: 1, Key: "zzz"}).Validate(), ShouldErrLike, "unknown key")
+		So((&Rule{Name: "r", QPS: 1, Methods: []string{"Method"}}).Validate(), ShouldErrLike, "bad method")
+		So((&Settings{Rules: []*Rule{{Name: "r", QPS: 1}, {Name: "r", QPS: 1}}}).Validate(), ShouldErrLike, "duplicate name")
+	})
+
+	Convey("LoadSettings", t, func() {
+		path := filepath.Join(t.TempDir(), "rules.json")
+		So(os.WriteFile(path, []byte(`{"rules": [{"name": "r", "key": "peer", "qps": 0.5}]}`), 0600), ShouldBeNil)
+		s, err := LoadSettings(path)
+		So(err, ShouldBeNil)
+		So(s.Rules, ShouldResemble, []*Rule{{Name: "r", Key: KeyPeer, QPS: 0.5}})
+		So(s.Rules[0].burst(), ShouldEqual, 1)
+	})
+}
@@ -19,6 +19,8 @@ import (
 	"flag"
 	"fmt"
 
+	"github.com/gomodule/redigo/redis"
+
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/tsmon"
 	"go.chromium.org/luci/server/caching"
@@ -113,6 +115,24 @@ func (m *redisModule) Initialize(ctx context.Context, host module.Host, opts mod
 		}
 	})
 
+	// Report if Redis is unreachable. This is informational only, since Redis is
+	// usually used as a cache and the server can function (perhaps slower)
+	// without it. Failing the readiness probe would take all replicas out of
+	// the load balancer at once.
+	host.RegisterHealthCheck(module.HealthCheck{
+		Name:          "redis",
+		Informational: true,
+		Check: func(ctx context.Context) error {
+			conn, err := pool.GetContext(ctx)
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			_, err = redis.DoContext(conn, ctx, "PING")
+			return err
+		},
+	})
+
 	// Populate pool metrics on tsmon flush.
 	tsmon.RegisterCallbackIn(ctx, func(ctx context.Context) {
 		ReportStats(ctx, pool, "default")
@@ -0,0 +1,54 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rpccache implements a server module that caches responses of unary
+// RPC methods.
+//
+// Caching is enabled per method, either by annotating the method in the proto
+// file:
+//
+//	import "go.chromium.org/luci/server/rpccache/rpccachepb/options.proto";
+//
+//	service Things {
+//	  rpc GetThing(GetThingRequest) returns (Thing) {
+//	    option (luci.server.rpccache.cache) = {
+//	      ttl_sec: 60
+//	      key_fields: "name"
+//	    };
+//	  };
+//	}
+//
+// Or by calling RegisterMethod in init():
+//
+//	func init() {
+//	  rpccache.RegisterMethod("/pkg.Things/GetThing", rpccache.MethodOptions{
+//	    TTL:       time.Minute,
+//	    KeyFields: []string{"name"},
+//	  })
+//	}
+//
+// Only successful responses are cached. By default they are cached separately
+// for each caller identity. They can also be shared by all callers (only for
+// public data), or by callers that have a permission in a realm specified in the
+// request (the permission is checked on every call).
+//
+// Responses are cached in the process cache and in the global cache, if it is
+// available (e.g. when using go.chromium.org/luci/server/redisconn module), see
+// go.chromium.org/luci/server/caching/layered. Since the global cache is
+// shared by all replicas, changes to response messages must be backward
+// compatible.
+//
+// Callers can skip the cache by sending "x-luci-cache-bypass" metadata with any
+// non-empty value.
+package rpccache
@@ -0,0 +1,180 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpccache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon/field"
+	"go.chromium.org/luci/common/tsmon/metric"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/caching"
+	"go.chromium.org/luci/server/caching/layered"
+)
+
+// BypassMetadataKey is a request metadata key that disables the cache.
+//
+// If a request has this metadata key with any non-empty value, the method is
+// called directly, and its response is not cached.
+const BypassMetadataKey = "x-luci-cache-bypass"
+
+// processCacheSize is the number of responses to keep in the process cache.
+const processCacheSize = 4096
+
+var lookups = metric.NewCounter(
+	"luci/server/rpccache/lookups",
+	"Number of calls to methods with cached responses, by the outcome.",
+	nil,
+	field.String("method"),  // full method name
+	field.String("outcome"), // "hit", "miss", "bypass" or "skip"
+)
+
+// cache holds serialized responses.
+var cache = layered.Cache{
+	ProcessLRUCache: caching.RegisterLRUCache(processCacheSize),
+	GlobalNamespace: "luci.rpccache.v1",
+	Marshal: func(item interface{}) ([]byte, error) {
+		return item.([]byte), nil
+	},
+	Unmarshal: func(blob []byte) (interface{}, error) {
+		return blob, nil
+	},
+	AllowNoProcessCacheFallback: true,
+}
+
+// newInterceptor returns an interceptor that caches responses of the given
+// methods.
+func newInterceptor(methods map[string]*cachedMethod) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		m := methods[info.FullMethod]
+		if m == nil {
+			return handler(ctx, req)
+		}
+		if bypassRequested(ctx) {
+			lookups.Add(ctx, 1, m.fullMethod, "bypass")
+			return handler(ctx, req)
+		}
+
+		key, err := m.cacheKey(ctx, req.(proto.Message))
+		switch {
+		case err != nil:
+			logging.Warningf(ctx, "Not using the response cache: %s", err)
+			lookups.Add(ctx, 1, m.fullMethod, "skip")
+			return handler(ctx, req)
+		case key == "":
+			lookups.Add(ctx, 1, m.fullMethod, "skip")
+			return handler(ctx, req)
+		}
+
+		// Responses are cached serialized, to give each caller its own copy.
+		var resp interface{}
+		called := false
+		blob, err := cache.GetOrCreate(ctx, key, func() (interface{}, time.Duration, error) {
+			called = true
+			var err error
+			if resp, err = handler(ctx, req); err != nil {
+				return nil, 0, err
+			}
+			blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(resp.(proto.Message))
+			if err != nil {
+				return nil, 0, errors.Annotate(err, "failed to marshal the response").Err()
+			}
+			return blob, m.opts.TTL, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if called && resp != nil {
+			lookups.Add(ctx, 1, m.fullMethod, "miss")
+			return resp, nil
+		}
+		lookups.Add(ctx, 1, m.fullMethod, "hit")
+
+		cached := m.response.New().Interface()
+		if err := proto.Unmarshal(blob.([]byte), cached); err != nil {
+			logging.Warningf(ctx, "Failed to unmarshal a cached response, calling the method: %s", err)
+			return handler(ctx, req)
+		}
+		return cached, nil
+	}
+}
+
+// bypassRequested returns true if the request has BypassMetadataKey.
+func bypassRequested(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, v := range md.Get(BypassMetadataKey) {
+		if v != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheKey returns a cache key for the request.
+//
+// Returns an empty key if the caller can't use the cache.
+func (m *cachedMethod) cacheKey(ctx context.Context, req proto.Message) (string, error) {
+	var scope string
+	switch m.opts.Scope {
+	case ScopeIdentity:
+		scope = "identity:" + string(auth.CurrentIdentity(ctx))
+	case ScopeGlobal:
+		scope = "global"
+	case ScopeRealm:
+		realm := stringField(req.ProtoReflect(), m.realmPath)
+		if realm == "" {
+			return "", nil
+		}
+		// Callers without the permission call the method directly to get a proper
+		// error from it.
+		switch ok, err := auth.HasPermission(ctx, m.opts.Permission, realm, nil); {
+		case err != nil:
+			return "", errors.Annotate(err, "failed to check %q in %q", m.opts.Permission, realm).Err()
+		case !ok:
+			return "", nil
+		}
+		scope = "realm:" + realm
+	}
+
+	if m.keyMask != nil {
+		req = proto.Clone(req)
+		if err := m.keyMask.Trim(protov1.MessageV1(req)); err != nil {
+			return "", errors.Annotate(err, "failed to trim the request").Err()
+		}
+	}
+	blob, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", errors.Annotate(err, "failed to marshal the request").Err()
+	}
+
+	h := sha256.New()
+	h.Write([]byte(m.fullMethod))
+	h.Write([]byte{0})
+	h.Write([]byte(scope))
+	h.Write([]byte{0})
+	h.Write(blob)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
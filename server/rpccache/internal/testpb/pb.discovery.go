@@ -0,0 +1,485 @@
+// Code generated by cproto. DO NOT EDIT.
+
+package testpb
+
+import "go.chromium.org/luci/grpc/discovery"
+
+import "google.golang.org/protobuf/types/descriptorpb"
+
+func init() {
+	discovery.RegisterDescriptorSetCompressed(
+		[]string{
+			"luci.server.rpccache.test.Things",
+		},
+		[]byte{31, 139,
+			8, 0, 0, 0, 0, 0, 0, 255, 236, 123, 79, 108, 27, 73,
+			214, 31, 251, 15, 255, 61, 74, 84, 171, 36, 91, 28, 217, 30,
+			151, 105, 123, 70, 246, 200, 148, 77, 255, 151, 253, 237, 126, 148,
+			216, 146, 169, 145, 72, 165, 73, 217, 227, 65, 62, 244, 180, 154,
+			37, 170, 103, 200, 110, 78, 119, 211, 178, 54, 248, 22, 131, 228,
+			75, 176, 193, 102, 129, 221, 32, 8, 38, 65, 118, 3, 236, 101,
+			128, 32, 185, 228, 144, 100, 143, 1, 114, 25, 32, 8, 146, 67,
+			128, 220, 114, 8, 176, 51, 193, 6, 8, 176, 73, 128, 189, 77,
+			80, 213, 213, 205, 38, 37, 217, 242, 44, 230, 195, 28, 214, 23,
+			243, 189, 122, 239, 87, 175, 94, 189, 170, 122, 175, 186, 4, 255,
+			75, 7, 220, 113, 156, 78, 151, 44, 245, 93, 199, 119, 118, 7,
+			123, 75, 109, 226, 153, 174, 213, 247, 29, 183, 196, 120, 104, 42,
+			144, 40, 133, 18, 197, 45, 152, 94, 179, 186, 164, 26, 9, 54,
+			137, 143, 30, 128, 188, 103, 117, 73, 65, 192, 210, 66, 174, 124,
+			165, 52, 166, 84, 26, 213, 216, 166, 108, 141, 105, 20, 63, 79,
+			194, 204, 49, 173, 8, 129, 108, 27, 61, 138, 40, 44, 100, 53,
+			246, 27, 21, 32, 221, 55, 204, 79, 140, 14, 41, 136, 140, 29,
+			146, 232, 109, 128, 54, 233, 19, 187, 77, 108, 243, 176, 32, 97,
+			105, 33, 171, 197, 56, 232, 61, 152, 238, 15, 118, 187, 150, 169,
+			199, 196, 0, 75, 11, 73, 77, 9, 26, 170, 67, 225, 119, 97,
+			234, 128, 24, 159, 196, 69, 115, 76, 52, 79, 217, 49, 193, 85,
+			152, 232, 17, 207, 51, 58, 68, 247, 15, 251, 164, 32, 179, 209,
+			227, 35, 163, 31, 31, 121, 142, 107, 181, 14, 251, 4, 85, 32,
+			75, 236, 65, 47, 64, 72, 158, 224, 63, 213, 30, 244, 198, 81,
+			50, 84, 141, 67, 164, 61, 226, 190, 176, 76, 82, 72, 49, 128,
+			119, 143, 0, 52, 131, 246, 113, 140, 80, 15, 173, 66, 150, 188,
+			244, 137, 237, 89, 142, 93, 72, 51, 144, 171, 71, 64, 214, 44,
+			210, 109, 143, 67, 12, 245, 208, 61, 72, 59, 125, 223, 114, 108,
+			175, 144, 193, 194, 66, 174, 124, 254, 24, 136, 46, 105, 4, 50,
+			90, 40, 140, 106, 160, 120, 206, 192, 53, 137, 110, 58, 109, 162,
+			91, 246, 158, 83, 200, 50, 128, 139, 71, 0, 154, 76, 112, 213,
+			105, 147, 154, 189, 231, 104, 121, 111, 132, 70, 103, 33, 229, 29,
+			218, 190, 241, 178, 48, 193, 34, 132, 83, 168, 12, 105, 210, 182,
+			104, 191, 133, 60, 22, 22, 242, 229, 194, 17, 100, 53, 104, 215,
+			66, 193, 226, 111, 82, 48, 117, 154, 176, 124, 4, 201, 61, 234,
+			153, 130, 248, 38, 126, 11, 116, 70, 29, 159, 250, 150, 142, 175,
+			64, 206, 38, 158, 79, 218, 65, 20, 73, 167, 140, 67, 8, 148,
+			142, 134, 161, 252, 173, 194, 240, 3, 152, 138, 76, 210, 93, 195,
+			238, 132, 241, 188, 244, 58, 75, 74, 106, 168, 167, 81, 53, 45,
+			31, 225, 48, 26, 85, 1, 28, 155, 56, 123, 122, 155, 152, 221,
+			66, 230, 4, 47, 53, 168, 200, 184, 121, 89, 166, 88, 37, 102,
+			23, 61, 28, 134, 103, 250, 132, 232, 218, 10, 22, 230, 145, 8,
+			221, 129, 188, 75, 232, 90, 33, 109, 62, 178, 44, 51, 162, 244,
+			218, 145, 105, 92, 141, 13, 68, 155, 12, 81, 24, 137, 46, 67,
+			196, 208, 233, 14, 199, 182, 164, 172, 54, 17, 50, 235, 70, 143,
+			204, 255, 8, 242, 163, 238, 65, 179, 144, 244, 124, 195, 245, 217,
+			230, 152, 212, 2, 2, 41, 32, 17, 187, 205, 118, 198, 164, 70,
+			127, 162, 63, 31, 14, 88, 98, 3, 126, 231, 136, 185, 163, 200,
+			227, 227, 158, 191, 15, 147, 35, 3, 56, 109, 215, 197, 255, 40,
+			195, 153, 99, 177, 209, 7, 48, 59, 176, 45, 219, 39, 110, 223,
+			37, 52, 100, 3, 19, 11, 95, 165, 79, 8, 186, 157, 184, 116,
+			128, 162, 205, 140, 64, 4, 76, 244, 28, 114, 52, 62, 12, 215,
+			160, 36, 95, 141, 229, 211, 13, 185, 84, 29, 106, 174, 72, 63,
+			17, 68, 45, 142, 133, 238, 67, 102, 143, 24, 254, 192, 37, 94,
+			161, 204, 92, 121, 238, 8, 238, 90, 32, 208, 36, 190, 22, 9,
+			163, 30, 76, 188, 32, 174, 181, 103, 153, 12, 154, 205, 67, 190,
+			252, 224, 148, 70, 61, 141, 169, 54, 125, 195, 39, 203, 176, 83,
+			127, 170, 106, 181, 181, 154, 90, 13, 204, 28, 129, 159, 255, 71,
+			2, 228, 98, 35, 161, 219, 161, 61, 232, 237, 18, 151, 207, 23,
+			167, 208, 57, 200, 238, 13, 186, 221, 32, 232, 232, 180, 101, 181,
+			12, 101, 208, 128, 163, 71, 47, 223, 70, 40, 159, 253, 70, 243,
+			144, 9, 131, 178, 144, 196, 194, 66, 70, 139, 232, 160, 173, 79,
+			12, 159, 180, 11, 169, 176, 45, 160, 55, 228, 140, 172, 36, 139,
+			119, 96, 250, 200, 80, 208, 20, 228, 170, 234, 234, 102, 69, 171,
+			180, 106, 141, 186, 146, 64, 121, 136, 141, 78, 17, 174, 103, 51,
+			95, 167, 149, 207, 62, 251, 236, 51, 177, 248, 239, 83, 48, 123,
+			220, 38, 120, 236, 126, 60, 28, 180, 52, 50, 232, 10, 36, 187,
+			198, 46, 233, 22, 100, 54, 9, 239, 157, 106, 155, 45, 109, 82,
+			21, 45, 208, 68, 63, 224, 174, 161, 46, 200, 151, 175, 159, 14,
+			129, 238, 175, 220, 141, 231, 32, 75, 255, 15, 252, 158, 10, 252,
+			78, 25, 204, 239, 243, 144, 97, 251, 94, 155, 68, 115, 18, 210,
+			116, 167, 104, 147, 61, 99, 208, 245, 245, 23, 70, 119, 64, 216,
+			14, 150, 213, 38, 56, 243, 41, 229, 161, 139, 144, 99, 187, 157,
+			110, 217, 109, 242, 146, 29, 161, 73, 45, 216, 57, 107, 148, 67,
+			167, 253, 99, 207, 177, 195, 189, 134, 34, 100, 40, 131, 117, 127,
+			127, 184, 91, 4, 167, 247, 133, 227, 135, 55, 190, 73, 160, 119,
+			97, 138, 73, 220, 230, 75, 217, 232, 22, 166, 89, 24, 228, 3,
+			118, 131, 115, 139, 255, 70, 4, 153, 58, 131, 78, 125, 235, 249,
+			182, 170, 87, 27, 59, 43, 155, 170, 34, 208, 169, 103, 140, 181,
+			205, 70, 165, 165, 136, 17, 93, 171, 183, 238, 221, 81, 164, 72,
+			97, 39, 96, 200, 113, 129, 219, 101, 37, 137, 20, 152, 96, 244,
+			90, 237, 3, 181, 122, 239, 142, 146, 26, 229, 220, 46, 43, 105,
+			52, 9, 89, 198, 89, 105, 52, 54, 149, 76, 132, 217, 108, 105,
+			181, 250, 186, 146, 141, 48, 215, 181, 198, 206, 182, 2, 17, 194,
+			150, 218, 108, 86, 214, 85, 37, 23, 73, 172, 60, 111, 169, 77,
+			101, 98, 196, 172, 219, 101, 101, 50, 234, 66, 173, 239, 108, 41,
+			121, 52, 13, 147, 140, 108, 134, 70, 76, 141, 177, 238, 221, 81,
+			148, 161, 33, 1, 202, 244, 8, 227, 222, 29, 5, 21, 87, 33,
+			201, 194, 16, 33, 200, 111, 86, 86, 212, 77, 189, 177, 77, 23,
+			77, 101, 83, 17, 134, 60, 77, 221, 86, 43, 45, 181, 170, 72,
+			113, 222, 223, 216, 169, 105, 106, 85, 17, 139, 38, 204, 30, 119,
+			66, 30, 187, 132, 98, 177, 32, 158, 16, 11, 12, 107, 60, 22,
+			138, 255, 67, 132, 153, 99, 178, 132, 99, 59, 249, 33, 36, 131,
+			88, 14, 118, 234, 107, 71, 186, 160, 64, 44, 178, 199, 208, 180,
+			64, 47, 158, 111, 74, 39, 228, 155, 20, 226, 72, 192, 254, 197,
+			145, 211, 60, 72, 120, 238, 29, 171, 62, 214, 57, 227, 189, 217,
+			169, 158, 60, 230, 84, 127, 4, 211, 71, 128, 78, 125, 186, 254,
+			29, 1, 10, 39, 57, 231, 53, 91, 162, 56, 178, 37, 62, 26,
+			247, 224, 165, 99, 93, 192, 250, 57, 50, 215, 95, 8, 112, 246,
+			248, 186, 226, 88, 27, 126, 0, 169, 30, 241, 247, 157, 48, 79,
+			62, 154, 140, 108, 177, 230, 49, 44, 141, 107, 197, 211, 55, 233,
+			132, 244, 141, 91, 115, 196, 210, 191, 47, 194, 153, 99, 193, 143,
+			53, 244, 2, 128, 101, 247, 7, 126, 144, 11, 83, 135, 101, 181,
+			44, 227, 176, 205, 139, 238, 178, 3, 63, 106, 151, 88, 59, 4,
+			44, 38, 240, 96, 104, 168, 204, 12, 125, 251, 132, 145, 142, 219,
+			137, 110, 130, 98, 118, 45, 98, 251, 186, 231, 187, 196, 232, 89,
+			118, 39, 56, 109, 151, 147, 123, 70, 215, 35, 218, 84, 208, 220,
+			12, 91, 169, 6, 11, 32, 55, 166, 145, 26, 209, 8, 154, 35,
+			141, 226, 191, 202, 66, 46, 86, 133, 161, 75, 48, 241, 177, 241,
+			194, 208, 195, 202, 90, 96, 149, 117, 142, 242, 182, 121, 117, 125,
+			19, 102, 41, 169, 59, 3, 159, 184, 186, 217, 53, 60, 143, 58,
+			138, 21, 121, 89, 13, 209, 182, 6, 109, 90, 13, 91, 208, 93,
+			152, 161, 92, 189, 55, 232, 250, 86, 191, 75, 116, 90, 235, 123,
+			5, 136, 91, 54, 77, 37, 182, 184, 0, 181, 200, 67, 85, 184,
+			64, 153, 122, 135, 216, 196, 53, 124, 162, 147, 79, 7, 70, 215,
+			211, 13, 187, 173, 239, 27, 222, 126, 97, 150, 2, 172, 136, 5,
+			65, 123, 139, 10, 174, 115, 57, 149, 137, 85, 236, 246, 19, 195,
+			219, 71, 203, 112, 150, 54, 82, 143, 88, 118, 71, 55, 247, 137,
+			249, 137, 62, 240, 247, 30, 20, 206, 197, 251, 103, 22, 54, 153,
+			204, 42, 21, 217, 241, 247, 30, 160, 38, 76, 208, 185, 235, 89,
+			63, 34, 250, 158, 227, 178, 51, 52, 95, 190, 246, 170, 58, 182,
+			212, 224, 10, 91, 78, 155, 44, 39, 155, 219, 170, 90, 213, 114,
+			33, 202, 154, 227, 162, 11, 0, 29, 39, 114, 112, 142, 121, 45,
+			219, 113, 66, 247, 222, 133, 25, 211, 12, 198, 108, 153, 58, 175,
+			200, 189, 130, 50, 226, 44, 211, 100, 131, 181, 76, 30, 227, 30,
+			122, 8, 103, 134, 206, 138, 43, 78, 199, 21, 103, 34, 63, 197,
+			84, 239, 194, 76, 255, 240, 168, 34, 26, 233, 177, 127, 56, 174,
+			118, 149, 221, 178, 184, 196, 164, 169, 93, 97, 46, 46, 29, 107,
+			64, 37, 80, 76, 83, 39, 182, 177, 219, 37, 186, 225, 18, 219,
+			240, 10, 23, 153, 176, 236, 187, 3, 162, 229, 77, 83, 101, 141,
+			21, 214, 134, 174, 195, 180, 179, 251, 177, 25, 4, 150, 222, 119,
+			201, 158, 245, 178, 112, 133, 121, 105, 138, 54, 176, 176, 218, 102,
+			108, 116, 13, 20, 211, 219, 55, 220, 62, 219, 89, 189, 190, 97,
+			146, 194, 213, 64, 52, 224, 215, 67, 54, 13, 108, 239, 192, 218,
+			243, 67, 196, 119, 153, 88, 142, 241, 56, 218, 2, 40, 253, 253,
+			254, 104, 199, 11, 76, 44, 223, 223, 239, 199, 251, 189, 12, 147,
+			253, 253, 120, 167, 215, 152, 216, 68, 127, 63, 214, 227, 29, 56,
+			75, 133, 122, 196, 55, 218, 134, 111, 196, 164, 23, 153, 244, 108,
+			127, 191, 191, 197, 27, 71, 236, 116, 7, 187, 135, 81, 124, 220,
+			96, 178, 57, 202, 11, 35, 228, 91, 151, 31, 223, 89, 177, 85,
+			92, 134, 137, 120, 220, 163, 44, 4, 145, 175, 8, 52, 9, 90,
+			109, 84, 85, 189, 89, 251, 80, 85, 68, 154, 70, 109, 214, 90,
+			170, 174, 237, 212, 91, 181, 45, 85, 145, 98, 137, 253, 134, 156,
+			185, 174, 188, 183, 33, 103, 222, 81, 222, 101, 238, 57, 18, 148,
+			197, 255, 43, 65, 126, 180, 44, 71, 143, 97, 46, 188, 119, 243,
+			136, 175, 31, 88, 46, 91, 172, 61, 35, 56, 56, 163, 160, 156,
+			229, 82, 77, 226, 63, 179, 92, 178, 230, 184, 61, 195, 71, 155,
+			112, 209, 118, 116, 207, 55, 236, 182, 225, 182, 245, 225, 141, 167,
+			110, 152, 38, 241, 60, 199, 45, 136, 113, 148, 243, 182, 211, 228,
+			194, 195, 211, 163, 194, 69, 199, 214, 132, 116, 210, 154, 56, 7,
+			217, 158, 209, 215, 137, 237, 187, 135, 44, 119, 207, 104, 153, 158,
+			209, 87, 41, 141, 158, 194, 59, 67, 81, 189, 75, 58, 134, 121,
+			168, 211, 188, 92, 103, 119, 68, 186, 233, 216, 123, 93, 203, 244,
+			189, 66, 46, 218, 255, 138, 67, 141, 77, 166, 176, 225, 57, 54,
+			43, 145, 86, 67, 233, 145, 170, 117, 226, 123, 17, 54, 163, 83,
+			47, 43, 201, 13, 57, 147, 84, 82, 27, 114, 38, 165, 164, 55,
+			228, 76, 70, 201, 110, 200, 153, 172, 2, 197, 95, 78, 194, 68,
+			188, 220, 64, 21, 72, 154, 236, 192, 165, 83, 156, 47, 95, 126,
+			101, 113, 82, 90, 165, 39, 241, 114, 42, 200, 237, 181, 64, 147,
+			102, 65, 116, 145, 17, 154, 129, 208, 250, 132, 83, 104, 29, 82,
+			31, 123, 84, 130, 149, 175, 249, 242, 149, 87, 99, 111, 52, 25,
+			120, 118, 163, 169, 215, 27, 218, 86, 101, 83, 227, 234, 232, 45,
+			144, 187, 198, 143, 14, 71, 207, 108, 198, 66, 37, 152, 26, 216,
+			65, 173, 78, 231, 152, 74, 77, 197, 165, 242, 195, 214, 77, 42,
+			127, 202, 184, 122, 11, 100, 122, 41, 61, 122, 178, 50, 22, 90,
+			128, 137, 54, 217, 29, 116, 116, 151, 180, 13, 211, 31, 61, 79,
+			114, 172, 73, 99, 45, 232, 125, 200, 210, 137, 179, 233, 240, 88,
+			233, 150, 47, 223, 120, 181, 11, 248, 20, 135, 74, 218, 80, 31,
+			61, 129, 180, 111, 184, 29, 226, 123, 133, 25, 44, 45, 228, 203,
+			165, 211, 64, 181, 152, 10, 245, 171, 22, 170, 163, 103, 160, 240,
+			171, 88, 157, 151, 185, 94, 97, 150, 237, 91, 139, 175, 134, 228,
+			55, 185, 213, 64, 73, 155, 34, 35, 244, 232, 186, 56, 243, 38,
+			235, 98, 7, 166, 248, 111, 221, 27, 244, 251, 142, 235, 23, 206,
+			98, 225, 245, 6, 133, 96, 129, 142, 150, 223, 27, 161, 191, 187,
+			229, 54, 255, 33, 228, 71, 157, 17, 191, 8, 151, 78, 121, 17,
+			142, 102, 135, 133, 26, 61, 154, 130, 234, 107, 254, 31, 139, 144,
+			31, 29, 24, 90, 7, 196, 117, 116, 203, 246, 93, 167, 61, 48,
+			73, 187, 32, 188, 166, 159, 105, 174, 83, 139, 84, 226, 64, 177,
+			85, 32, 158, 18, 168, 58, 92, 31, 75, 48, 19, 2, 80, 176,
+			3, 195, 181, 105, 82, 77, 135, 158, 213, 80, 172, 233, 89, 208,
+			130, 42, 16, 134, 139, 238, 146, 158, 243, 130, 180, 11, 242, 107,
+			186, 205, 115, 5, 45, 144, 47, 46, 65, 146, 109, 63, 8, 128,
+			111, 64, 74, 2, 101, 64, 94, 109, 104, 85, 69, 160, 231, 97,
+			192, 213, 183, 107, 234, 170, 170, 136, 197, 187, 144, 10, 246, 20,
+			122, 116, 70, 187, 138, 146, 224, 36, 199, 16, 194, 214, 157, 173,
+			21, 85, 83, 196, 226, 14, 76, 141, 173, 67, 116, 6, 166, 53,
+			181, 165, 214, 233, 229, 128, 190, 83, 127, 191, 222, 120, 70, 111,
+			214, 70, 216, 225, 57, 44, 160, 89, 80, 134, 236, 102, 99, 71,
+			99, 214, 252, 3, 17, 148, 241, 69, 137, 230, 96, 166, 85, 209,
+			214, 213, 150, 206, 110, 38, 134, 208, 179, 160, 196, 27, 214, 106,
+			236, 62, 231, 34, 156, 139, 115, 213, 15, 90, 106, 189, 73, 123,
+			209, 42, 245, 117, 154, 20, 140, 225, 133, 87, 44, 18, 29, 65,
+			188, 97, 173, 166, 110, 86, 21, 121, 156, 221, 168, 171, 141, 53,
+			37, 57, 222, 59, 187, 118, 73, 161, 121, 56, 59, 206, 213, 213,
+			122, 75, 123, 174, 164, 199, 59, 110, 170, 218, 211, 218, 170, 170,
+			100, 208, 89, 64, 241, 134, 45, 181, 245, 164, 81, 85, 178, 199,
+			157, 88, 72, 153, 41, 254, 75, 1, 38, 226, 87, 32, 35, 155,
+			138, 240, 125, 59, 108, 139, 255, 69, 132, 92, 236, 46, 132, 94,
+			21, 26, 221, 174, 115, 160, 27, 93, 203, 240, 248, 121, 8, 140,
+			85, 161, 156, 211, 158, 63, 167, 79, 93, 82, 223, 58, 117, 73,
+			127, 15, 83, 151, 164, 146, 42, 254, 55, 17, 148, 241, 219, 145,
+			49, 191, 9, 39, 249, 45, 62, 62, 241, 77, 198, 55, 126, 170,
+			75, 39, 158, 234, 199, 28, 86, 242, 247, 249, 176, 138, 135, 235,
+			127, 21, 32, 207, 203, 206, 208, 177, 113, 143, 21, 223, 196, 99,
+			163, 51, 114, 233, 164, 25, 249, 107, 25, 215, 63, 145, 96, 114,
+			228, 238, 231, 180, 214, 125, 10, 211, 86, 155, 244, 250, 142, 79,
+			95, 30, 232, 93, 242, 130, 116, 153, 27, 242, 229, 165, 87, 223,
+			46, 149, 106, 67, 189, 77, 170, 182, 60, 83, 171, 170, 91, 219,
+			141, 150, 90, 95, 125, 30, 30, 18, 154, 18, 131, 103, 98, 35,
+			75, 240, 242, 155, 56, 252, 59, 243, 100, 113, 27, 148, 241, 209,
+			208, 13, 253, 152, 241, 40, 9, 52, 3, 83, 245, 134, 222, 172,
+			85, 85, 93, 93, 91, 83, 87, 91, 205, 224, 67, 67, 36, 221,
+			82, 196, 248, 220, 252, 83, 9, 102, 142, 177, 4, 85, 248, 21,
+			97, 112, 107, 121, 227, 52, 214, 151, 104, 117, 191, 109, 184, 62,
+			191, 81, 188, 6, 212, 189, 182, 111, 237, 89, 196, 229, 31, 112,
+			36, 246, 1, 103, 106, 200, 103, 219, 8, 90, 4, 212, 119, 60,
+			203, 183, 94, 208, 135, 16, 225, 215, 30, 186, 112, 101, 77, 9,
+			91, 106, 182, 31, 73, 219, 164, 99, 140, 73, 211, 242, 67, 210,
+			148, 176, 37, 146, 190, 4, 19, 109, 103, 64, 111, 101, 2, 84,
+			186, 37, 11, 90, 46, 224, 69, 34, 252, 218, 108, 248, 153, 105,
+			66, 203, 5, 188, 64, 228, 93, 152, 50, 58, 29, 151, 130, 135,
+			64, 193, 69, 96, 62, 98, 51, 193, 249, 13, 200, 132, 126, 160,
+			95, 158, 168, 39, 244, 126, 112, 187, 45, 210, 47, 79, 118, 216,
+			120, 9, 38, 44, 79, 143, 190, 249, 23, 68, 44, 46, 100, 180,
+			156, 229, 69, 95, 69, 139, 95, 0, 192, 48, 216, 208, 47, 4,
+			200, 7, 7, 76, 159, 94, 181, 219, 102, 88, 22, 30, 115, 83,
+			23, 105, 5, 57, 249, 54, 87, 88, 249, 225, 79, 4, 225, 115,
+			65, 254, 92, 16, 126, 45, 76, 162, 140, 250, 193, 246, 102, 109,
+			181, 214, 42, 252, 54, 205, 232, 218, 22, 167, 191, 74, 143, 182,
+			127, 157, 254, 215, 130, 148, 249, 58, 173, 77, 238, 197, 241, 80,
+			55, 254, 130, 66, 60, 169, 144, 28, 90, 163, 242, 119, 19, 43,
+			215, 152, 33, 41, 102, 72, 14, 165, 86, 55, 27, 77, 181, 202,
+			204, 200, 34, 185, 177, 173, 214, 11, 95, 133, 93, 14, 31, 91,
+			124, 46, 192, 92, 248, 149, 149, 159, 181, 196, 54, 157, 118, 152,
+			221, 230, 203, 183, 94, 213, 185, 198, 85, 153, 75, 84, 174, 184,
+			114, 227, 136, 75, 42, 245, 42, 183, 37, 135, 82, 219, 149, 213,
+			247, 213, 234, 208, 154, 51, 238, 113, 40, 232, 199, 48, 69, 111,
+			91, 105, 108, 88, 109, 150, 92, 23, 228, 147, 190, 151, 14, 45,
+			162, 215, 175, 79, 35, 13, 238, 148, 96, 118, 178, 72, 174, 55,
+			234, 106, 104, 6, 251, 0, 254, 124, 104, 70, 126, 48, 162, 138,
+			126, 12, 74, 120, 61, 20, 185, 36, 121, 210, 39, 223, 161, 1,
+			252, 146, 41, 114, 198, 59, 49, 11, 102, 209, 212, 166, 90, 95,
+			111, 61, 209, 183, 53, 149, 125, 185, 43, 252, 54, 236, 126, 170,
+			55, 170, 136, 254, 182, 0, 185, 224, 246, 134, 93, 56, 241, 75,
+			133, 119, 94, 53, 120, 150, 1, 49, 233, 149, 135, 172, 91, 41,
+			12, 136, 57, 132, 54, 213, 245, 202, 234, 115, 125, 69, 109, 182,
+			232, 78, 214, 208, 130, 24, 5, 148, 172, 108, 110, 54, 158, 13,
+			29, 1, 31, 71, 48, 197, 191, 9, 147, 35, 225, 78, 147, 98,
+			150, 76, 211, 17, 52, 213, 250, 106, 60, 137, 159, 128, 40, 188,
+			21, 1, 77, 64, 20, 252, 138, 72, 183, 81, 110, 64, 244, 45,
+			81, 42, 222, 135, 76, 24, 190, 52, 53, 103, 25, 246, 88, 97,
+			144, 1, 22, 187, 138, 64, 203, 160, 32, 166, 21, 177, 248, 20,
+			206, 28, 27, 122, 232, 50, 92, 12, 191, 95, 234, 129, 157, 106,
+			125, 181, 81, 173, 213, 215, 99, 152, 0, 60, 6, 3, 43, 195,
+			248, 84, 196, 98, 13, 242, 163, 1, 132, 206, 193, 220, 78, 107,
+			237, 129, 254, 180, 178, 89, 171, 86, 198, 10, 34, 0, 30, 69,
+			138, 72, 43, 51, 26, 93, 138, 84, 148, 51, 130, 34, 20, 155,
+			48, 53, 22, 10, 232, 60, 20, 120, 133, 114, 156, 85, 51, 48,
+			30, 28, 193, 37, 104, 85, 221, 172, 109, 213, 232, 7, 89, 177,
+			248, 4, 96, 56, 199, 244, 204, 218, 104, 54, 234, 250, 26, 45,
+			244, 90, 49, 168, 44, 4, 115, 170, 8, 180, 30, 57, 58, 241,
+			138, 120, 61, 69, 79, 172, 159, 214, 175, 167, 50, 63, 173, 43,
+			63, 167, 255, 255, 188, 174, 252, 162, 190, 145, 202, 124, 149, 86,
+			190, 78, 23, 255, 183, 4, 104, 24, 89, 209, 157, 199, 7, 144,
+			137, 46, 81, 130, 87, 154, 143, 95, 17, 144, 161, 90, 140, 197,
+			171, 93, 222, 162, 69, 104, 180, 98, 238, 89, 182, 213, 27, 244,
+			116, 94, 8, 191, 190, 98, 230, 10, 156, 102, 16, 198, 203, 17,
+			136, 228, 107, 33, 140, 151, 49, 136, 249, 63, 8, 80, 56, 201,
+			216, 111, 117, 233, 81, 135, 89, 231, 5, 113, 93, 171, 77, 63,
+			85, 232, 81, 42, 36, 191, 62, 21, 154, 137, 41, 114, 182, 135,
+			86, 232, 137, 245, 146, 180, 135, 72, 201, 215, 35, 77, 50, 149,
+			16, 99, 131, 6, 40, 173, 62, 68, 69, 26, 230, 91, 197, 47,
+			68, 200, 143, 62, 139, 68, 85, 200, 116, 29, 254, 228, 40, 152,
+			237, 133, 215, 188, 164, 44, 109, 114, 121, 45, 210, 156, 255, 79,
+			2, 100, 66, 54, 58, 11, 114, 223, 240, 247, 217, 19, 223, 228,
+			138, 168, 8, 26, 163, 41, 223, 235, 27, 118, 65, 28, 242, 41,
+			77, 191, 212, 116, 137, 65, 119, 82, 221, 116, 122, 61, 98, 251,
+			30, 191, 118, 153, 226, 252, 85, 206, 166, 175, 115, 125, 215, 176,
+			186, 35, 178, 50, 147, 85, 194, 134, 72, 120, 25, 222, 10, 113,
+			219, 196, 55, 204, 125, 210, 30, 42, 209, 7, 148, 89, 109, 142,
+			11, 84, 121, 123, 168, 91, 252, 207, 34, 76, 135, 223, 12, 219,
+			145, 179, 182, 0, 12, 219, 118, 252, 184, 187, 142, 166, 121, 71,
+			244, 74, 149, 72, 73, 139, 1, 204, 255, 78, 0, 24, 54, 157,
+			232, 183, 139, 144, 227, 143, 94, 233, 183, 81, 126, 181, 6, 1,
+			107, 205, 234, 18, 122, 235, 182, 75, 58, 150, 205, 95, 49, 5,
+			68, 248, 24, 64, 142, 30, 3, 32, 13, 50, 30, 233, 25, 182,
+			111, 153, 44, 164, 242, 229, 123, 111, 100, 124, 169, 201, 181, 181,
+			8, 167, 184, 0, 153, 144, 27, 237, 143, 9, 148, 6, 169, 169,
+			182, 20, 129, 126, 236, 169, 108, 214, 42, 77, 69, 188, 254, 133,
+			8, 105, 190, 118, 232, 81, 161, 86, 107, 99, 91, 237, 12, 228,
+			67, 102, 176, 159, 41, 127, 149, 142, 51, 183, 181, 70, 171, 81,
+			86, 126, 123, 148, 121, 91, 249, 42, 141, 166, 97, 34, 100, 150,
+			111, 150, 111, 43, 95, 143, 179, 238, 40, 255, 147, 221, 234, 132,
+			172, 91, 122, 139, 238, 151, 141, 250, 230, 115, 69, 136, 55, 148,
+			99, 13, 34, 186, 0, 115, 97, 195, 195, 135, 15, 31, 222, 143,
+			53, 254, 242, 103, 169, 241, 230, 7, 177, 230, 95, 29, 109, 126,
+			24, 107, 254, 231, 63, 75, 161, 25, 200, 133, 205, 91, 149, 15,
+			148, 111, 190, 249, 230, 155, 244, 202, 143, 97, 198, 116, 122, 227,
+			83, 179, 162, 140, 61, 73, 240, 158, 8, 31, 222, 224, 66, 29,
+			167, 107, 216, 157, 146, 227, 118, 134, 47, 244, 233, 119, 10, 47,
+			246, 78, 191, 191, 251, 7, 65, 248, 181, 40, 173, 111, 175, 252,
+			11, 113, 126, 61, 80, 220, 230, 210, 37, 141, 236, 117, 137, 73,
+			3, 17, 254, 221, 28, 252, 89, 199, 41, 153, 251, 174, 211, 179,
+			6, 61, 134, 218, 29, 152, 214, 18, 253, 226, 76, 220, 37, 183,
+			111, 154, 134, 185, 79, 162, 31, 253, 221, 37, 254, 48, 129, 255,
+			45, 192, 44, 21, 47, 5, 226, 165, 80, 106, 254, 181, 127, 67,
+			80, 252, 127, 2, 76, 172, 82, 217, 176, 232, 157, 131, 180, 239,
+			119, 117, 143, 152, 44, 109, 151, 180, 148, 239, 119, 155, 196, 164,
+			95, 202, 63, 33, 135, 65, 62, 75, 47, 70, 232, 106, 206, 126,
+			66, 14, 89, 174, 224, 161, 31, 64, 210, 51, 29, 254, 232, 34,
+			95, 94, 40, 29, 103, 80, 41, 222, 85, 169, 73, 229, 181, 64,
+			141, 222, 122, 185, 196, 232, 246, 130, 14, 216, 34, 202, 106, 192,
+			88, 172, 7, 250, 119, 4, 125, 226, 246, 44, 207, 11, 207, 160,
+			172, 22, 227, 20, 23, 33, 201, 0, 105, 246, 81, 171, 210, 11,
+			213, 214, 243, 32, 157, 88, 223, 108, 172, 176, 231, 88, 89, 72,
+			106, 106, 101, 115, 75, 17, 151, 63, 132, 36, 115, 36, 122, 205,
+			3, 144, 194, 175, 190, 164, 166, 228, 202, 197, 215, 15, 72, 11,
+			32, 87, 110, 125, 184, 244, 134, 147, 185, 241, 111, 103, 32, 133,
+			228, 169, 196, 134, 0, 223, 200, 32, 76, 32, 105, 42, 129, 230,
+			31, 226, 42, 217, 179, 232, 221, 55, 142, 42, 48, 15, 91, 30,
+			230, 151, 70, 164, 141, 45, 27, 179, 137, 44, 227, 224, 53, 61,
+			118, 236, 238, 97, 9, 202, 191, 145, 241, 170, 211, 63, 116, 173,
+			206, 190, 143, 203, 55, 203, 101, 220, 218, 39, 120, 115, 103, 181,
+			134, 43, 3, 127, 223, 113, 189, 18, 0, 222, 180, 76, 98, 123,
+			164, 141, 7, 118, 155, 184, 216, 223, 39, 184, 210, 167, 182, 133,
+			45, 139, 248, 41, 113, 169, 195, 113, 185, 116, 19, 47, 80, 129,
+			34, 111, 42, 94, 123, 4, 248, 208, 25, 224, 158, 113, 136, 109,
+			199, 199, 3, 143, 96, 127, 223, 242, 48, 221, 52, 49, 121, 105,
+			146, 190, 79, 237, 51, 157, 94, 191, 107, 25, 182, 73, 240, 129,
+			229, 239, 99, 127, 8, 95, 2, 252, 156, 35, 56, 187, 190, 97,
+			217, 216, 192, 166, 211, 63, 196, 206, 94, 92, 12, 27, 62, 0,
+			102, 255, 246, 125, 191, 191, 188, 180, 116, 112, 112, 80, 50, 152,
+			165, 193, 90, 9, 228, 188, 165, 205, 218, 170, 90, 111, 170, 55,
+			202, 165, 155, 0, 120, 199, 238, 18, 207, 195, 46, 249, 116, 96,
+			185, 164, 141, 119, 15, 177, 209, 239, 119, 45, 147, 166, 13, 184,
+			107, 28, 96, 199, 197, 70, 199, 37, 164, 141, 125, 135, 218, 122,
+			224, 90, 190, 101, 119, 22, 177, 231, 236, 249, 7, 134, 75, 0,
+			183, 45, 90, 97, 239, 14, 252, 17, 55, 133, 150, 89, 222, 136,
+			128, 99, 99, 195, 198, 197, 74, 19, 215, 154, 69, 188, 82, 105,
+			214, 154, 139, 128, 159, 213, 90, 79, 26, 59, 45, 252, 172, 162,
+			105, 149, 122, 171, 166, 54, 113, 67, 195, 171, 141, 122, 176, 17,
+			53, 113, 99, 13, 87, 234, 207, 241, 251, 181, 122, 117, 17, 19,
+			203, 223, 39, 46, 38, 47, 105, 253, 236, 97, 199, 197, 22, 117,
+			32, 105, 151, 0, 55, 9, 25, 233, 126, 207, 9, 102, 205, 235,
+			19, 147, 62, 53, 198, 116, 83, 26, 24, 29, 130, 59, 52, 209,
+			97, 161, 51, 92, 35, 30, 54, 236, 54, 224, 174, 213, 179, 130,
+			19, 208, 59, 58, 162, 18, 64, 6, 4, 17, 73, 211, 137, 11,
+			244, 87, 6, 73, 51, 137, 53, 200, 130, 152, 201, 69, 63, 165,
+			4, 146, 206, 36, 174, 195, 58, 136, 114, 2, 201, 133, 196, 3,
+			97, 254, 17, 142, 175, 5, 220, 166, 145, 75, 240, 190, 115, 128,
+			93, 226, 245, 29, 219, 35, 30, 157, 86, 3, 7, 111, 198, 176,
+			225, 18, 204, 194, 191, 93, 2, 0, 144, 228, 132, 128, 164, 66,
+			102, 22, 30, 131, 44, 39, 40, 236, 188, 88, 148, 230, 75, 152,
+			173, 106, 142, 231, 225, 131, 125, 7, 155, 134, 141, 61, 66, 104,
+			176, 48, 128, 168, 135, 18, 192, 4, 36, 169, 182, 128, 164, 249,
+			244, 4, 108, 64, 138, 82, 98, 2, 73, 231, 229, 233, 249, 71,
+			88, 139, 108, 25, 246, 143, 61, 210, 167, 175, 178, 73, 247, 144,
+			57, 148, 24, 230, 62, 54, 141, 110, 151, 184, 56, 184, 3, 242,
+			15, 75, 0, 121, 72, 7, 88, 2, 5, 155, 24, 210, 34, 146,
+			206, 79, 41, 208, 224, 125, 9, 72, 122, 91, 158, 154, 255, 243,
+			177, 190, 232, 19, 24, 30, 131, 221, 46, 135, 247, 74, 120, 199,
+			35, 108, 205, 178, 158, 131, 191, 119, 194, 244, 133, 74, 172, 67,
+			129, 33, 198, 104, 17, 73, 111, 79, 230, 225, 51, 129, 247, 40,
+			34, 233, 146, 156, 159, 255, 244, 196, 30, 121, 111, 216, 223, 55,
+			124, 188, 111, 188, 32, 248, 163, 97, 84, 124, 68, 3, 159, 6,
+			17, 219, 108, 33, 140, 165, 96, 115, 249, 40, 182, 41, 127, 20,
+			46, 75, 186, 160, 136, 231, 199, 76, 20, 5, 106, 66, 118, 72,
+			83, 147, 38, 38, 161, 66, 231, 146, 122, 255, 170, 120, 97, 254,
+			14, 126, 226, 28, 224, 174, 99, 119, 232, 106, 99, 190, 31, 198,
+			198, 34, 237, 205, 35, 166, 99, 183, 189, 18, 214, 248, 146, 13,
+			39, 84, 76, 200, 20, 35, 162, 146, 72, 186, 154, 83, 66, 74,
+			64, 210, 213, 233, 66, 72, 73, 72, 186, 122, 238, 60, 252, 61,
+			129, 245, 45, 32, 233, 154, 120, 105, 254, 16, 111, 27, 254, 62,
+			139, 64, 110, 61, 102, 99, 226, 62, 225, 87, 125, 135, 124, 120,
+			65, 140, 44, 98, 82, 234, 148, 112, 209, 54, 122, 164, 136, 29,
+			23, 112, 209, 223, 183, 236, 78, 137, 49, 74, 184, 182, 135, 73,
+			175, 239, 31, 46, 50, 45, 10, 224, 70, 190, 161, 187, 244, 192,
+			139, 13, 64, 144, 169, 33, 17, 149, 68, 210, 181, 220, 116, 72,
+			81, 35, 209, 249, 144, 146, 144, 116, 237, 34, 134, 21, 102, 191,
+			136, 164, 69, 241, 220, 252, 93, 252, 236, 149, 129, 143, 121, 61,
+			69, 251, 13, 79, 191, 168, 111, 81, 166, 32, 17, 149, 66, 210,
+			98, 228, 60, 58, 115, 139, 211, 103, 67, 74, 66, 210, 226, 91,
+			243, 96, 179, 190, 37, 36, 221, 18, 139, 243, 6, 174, 96, 154,
+			23, 83, 231, 25, 152, 238, 134, 118, 39, 26, 39, 243, 98, 176,
+			177, 27, 152, 5, 11, 166, 238, 9, 125, 199, 56, 69, 122, 214,
+			132, 83, 138, 173, 61, 204, 142, 124, 106, 42, 59, 142, 35, 59,
+			37, 153, 118, 24, 81, 73, 36, 221, 138, 124, 36, 9, 72, 186,
+			133, 46, 132, 20, 53, 13, 95, 130, 127, 24, 76, 178, 140, 164,
+			251, 226, 165, 249, 191, 18, 112, 37, 182, 221, 177, 105, 225, 235,
+			184, 55, 240, 120, 228, 199, 131, 157, 198, 97, 135, 248, 71, 253,
+			185, 8, 220, 126, 254, 214, 169, 196, 38, 222, 43, 117, 136, 127,
+			218, 193, 200, 204, 168, 136, 74, 34, 233, 126, 52, 24, 89, 64,
+			210, 253, 104, 194, 101, 9, 73, 247, 47, 98, 248, 157, 8, 66,
+			26, 201, 79, 18, 27, 194, 252, 127, 23, 113, 144, 135, 220, 96,
+			223, 19, 48, 207, 246, 130, 253, 218, 243, 29, 135, 173, 107, 58,
+			192, 48, 139, 192, 212, 84, 58, 82, 167, 61, 232, 18, 106, 228,
+			14, 253, 123, 169, 229, 224, 228, 180, 122, 244, 43, 19, 46, 254,
+			81, 89, 102, 241, 81, 0, 198, 157, 130, 91, 204, 41, 248, 111,
+			241, 179, 217, 237, 155, 120, 157, 248, 140, 187, 16, 254, 160, 211,
+			78, 60, 255, 26, 118, 137, 63, 112, 109, 15, 47, 176, 246, 107,
+			145, 22, 230, 99, 195, 11, 199, 38, 88, 204, 144, 107, 248, 207,
+			98, 242, 24, 243, 12, 117, 25, 223, 187, 25, 227, 14, 211, 211,
+			101, 190, 104, 163, 198, 191, 124, 4, 35, 63, 254, 18, 232, 137,
+			150, 78, 32, 169, 38, 190, 67, 79, 159, 116, 66, 68, 210, 147,
+			244, 149, 224, 183, 76, 249, 16, 240, 83, 72, 170, 229, 230, 130,
+			223, 2, 146, 106, 133, 11, 193, 111, 9, 73, 53, 124, 21, 254,
+			15, 130, 31, 158, 202, 169, 236, 75, 132, 109, 116, 151, 124, 226,
+			249, 253, 93, 246, 31, 79, 222, 223, 58, 118, 228, 84, 96, 254,
+			143, 43, 11, 138, 23, 194, 164, 120, 22, 146, 108, 41, 242, 55,
+			196, 1, 81, 60, 128, 169, 177, 121, 58, 246, 213, 117, 1, 210,
+			188, 140, 231, 181, 112, 72, 162, 123, 241, 172, 159, 254, 93, 227,
+			137, 35, 25, 201, 246, 139, 119, 33, 201, 194, 224, 228, 238, 6,
+			212, 93, 172, 59, 73, 11, 201, 242, 23, 50, 164, 152, 158, 135,
+			246, 32, 19, 154, 142, 174, 191, 162, 219, 177, 241, 205, 191, 202,
+			68, 6, 93, 156, 248, 253, 151, 87, 50, 153, 199, 40, 248, 62,
+			180, 15, 249, 117, 226, 111, 179, 131, 249, 187, 232, 45, 243, 251,
+			47, 175, 200, 153, 199, 5, 1, 253, 93, 1, 38, 215, 137, 175,
+			209, 153, 249, 46, 122, 122, 231, 247, 95, 94, 41, 102, 30, 23,
+			196, 98, 142, 77, 66, 137, 197, 192, 245, 41, 166, 55, 220, 223,
+			80, 27, 16, 51, 163, 99, 121, 62, 113, 73, 251, 187, 176, 37,
+			129, 254, 2, 114, 59, 253, 182, 225, 147, 239, 4, 126, 229, 222,
+			135, 119, 190, 205, 170, 220, 248, 103, 57, 90, 136, 229, 19, 143,
+			5, 248, 15, 65, 33, 150, 79, 160, 63, 85, 83, 127, 170, 166,
+			254, 200, 106, 74, 73, 20, 121, 53, 133, 18, 239, 135, 213, 20,
+			255, 73, 171, 169, 217, 196, 58, 0, 136, 169, 4, 146, 207, 38,
+			110, 8, 244, 136, 73, 209, 130, 232, 108, 38, 15, 19, 32, 167,
+			18, 98, 2, 201, 115, 226, 121, 9, 38, 32, 73, 41, 1, 73,
+			115, 169, 124, 72, 137, 72, 154, 155, 122, 59, 164, 36, 36, 205,
+			93, 43, 193, 100, 64, 201, 72, 46, 200, 231, 82, 160, 64, 134,
+			145, 191, 250, 50, 228, 4, 192, 2, 146, 47, 136, 197, 16, 152,
+			22, 27, 23, 82, 179, 33, 37, 34, 233, 194, 153, 43, 33, 37,
+			33, 233, 194, 210, 29, 14, 44, 200, 72, 126, 91, 190, 20, 2,
+			11, 1, 48, 227, 4, 192, 34, 146, 47, 139, 215, 67, 96, 154,
+			104, 94, 78, 205, 132, 148, 136, 164, 203, 179, 151, 67, 74, 66,
+			210, 229, 210, 109, 14, 44, 202, 72, 190, 34, 95, 11, 129, 197,
+			0, 152, 113, 114, 12, 88, 66, 210, 123, 226, 99, 174, 75, 19,
+			195, 247, 82, 133, 144, 18, 145, 244, 222, 91, 11, 33, 69, 37,
+			111, 63, 224, 106, 44, 11, 190, 203, 155, 104, 10, 182, 152, 154,
+			14, 41, 154, 102, 211, 63, 75, 8, 218, 104, 22, 188, 120, 139,
+			78, 8, 173, 67, 151, 18, 183, 132, 168, 66, 93, 202, 76, 82,
+			56, 90, 104, 32, 233, 166, 56, 195, 115, 184, 68, 146, 82, 153,
+			144, 18, 144, 116, 51, 155, 15, 41, 9, 73, 55, 167, 17, 131,
+			19, 144, 124, 59, 113, 63, 128, 163, 174, 190, 157, 153, 99, 112,
+			2, 133, 187, 35, 34, 166, 34, 48, 184, 59, 28, 78, 96, 112,
+			119, 178, 147, 33, 37, 33, 233, 142, 50, 205, 213, 4, 36, 221,
+			21, 207, 240, 38, 33, 73, 169, 80, 141, 226, 223, 205, 42, 33,
+			37, 33, 233, 238, 204, 44, 87, 19, 145, 116, 47, 234, 77, 76,
+			81, 42, 29, 82, 2, 146, 238, 101, 194, 222, 68, 9, 73, 247,
+			148, 105, 102, 188, 136, 228, 135, 116, 103, 164, 198, 211, 233, 124,
+			200, 125, 33, 82, 227, 151, 57, 156, 200, 140, 95, 230, 86, 136,
+			204, 248, 101, 110, 188, 200, 140, 95, 230, 198, 179, 158, 30, 137,
+			179, 188, 137, 26, 255, 136, 91, 33, 178, 154, 232, 81, 102, 42,
+			164, 36, 36, 61, 66, 51, 187, 169, 190, 235, 248, 206, 237, 255,
+			63, 0, 154, 23, 73, 22, 32, 69, 0, 0},
+	)
+}
+
+// FileDescriptorSet returns a descriptor set for this proto package, which
+// includes all defined services, and all transitive dependencies.
+//
+// Will not return nil.
+//
+// Do NOT modify the returned descriptor.
+func FileDescriptorSet() *descriptorpb.FileDescriptorSet {
+	// We just need ONE of the service names to look up the FileDescriptorSet.
+	ret, err := discovery.GetDescriptorSet("luci.server.rpccache.test.Things")
+	if err != nil {
+		panic(err)
+	}
+	return ret
+}
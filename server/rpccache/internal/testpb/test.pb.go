@@ -0,0 +1,650 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: go.chromium.org/luci/server/rpccache/internal/testpb/test.proto
+
+package testpb
+
+import prpc "go.chromium.org/luci/grpc/prpc"
+
+import (
+	context "context"
+	_ "go.chromium.org/luci/server/rpccache/rpccachepb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Scope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Realm string `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+}
+
+func (x *Scope) Reset() {
+	*x = Scope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scope) ProtoMessage() {}
+
+func (x *Scope) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scope.ProtoReflect.Descriptor instead.
+func (*Scope) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Scope) GetRealm() string {
+	if x != nil {
+		return x.Realm
+	}
+	return ""
+}
+
+type GetThingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Comment string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Scope   *Scope `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (x *GetThingRequest) Reset() {
+	*x = GetThingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetThingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThingRequest) ProtoMessage() {}
+
+func (x *GetThingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThingRequest.ProtoReflect.Descriptor instead.
+func (*GetThingRequest) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetThingRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetThingRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GetThingRequest) GetScope() *Scope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+type Thing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Counter int64  `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
+}
+
+func (x *Thing) Reset() {
+	*x = Thing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Thing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thing) ProtoMessage() {}
+
+func (x *Thing) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thing.ProtoReflect.Descriptor instead.
+func (*Thing) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Thing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Thing) GetCounter() int64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
+}
+
+var File_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDesc = []byte{
+	0x0a, 0x3f, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x70,
+	0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x19, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72,
+	0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x67, 0x6f,
+	0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75,
+	0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x70, 0x62, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1d, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x22, 0x77, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x75, 0x63,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x32, 0xa7, 0x04, 0x0a, 0x06, 0x54,
+	0x68, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x66, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x68, 0x69, 0x6e,
+	0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x22,
+	0x0c, 0xf2, 0xc9, 0x24, 0x08, 0x08, 0x3c, 0x12, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x68, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12,
+	0x2a, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70,
+	0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x75,
+	0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x08, 0xf2,
+	0xc9, 0x24, 0x04, 0x08, 0x3c, 0x18, 0x01, 0x12, 0x85, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x75, 0x63, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x22, 0x26, 0xf2, 0xc9, 0x24, 0x22, 0x08, 0x3c, 0x18,
+	0x02, 0x22, 0x0b, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x2e, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x2a, 0x0f,
+	0x6c, 0x75, 0x63, 0x69, 0x2e, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x67, 0x65, 0x74, 0x12,
+	0x64, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68,
+	0x69, 0x6e, 0x67, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x68, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72,
+	0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x68, 0x69,
+	0x6e, 0x67, 0x22, 0x00, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d,
+	0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescData = file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDesc
+)
+
+func file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescData)
+	})
+	return file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_goTypes = []interface{}{
+	(*Scope)(nil),           // 0: luci.server.rpccache.test.Scope
+	(*GetThingRequest)(nil), // 1: luci.server.rpccache.test.GetThingRequest
+	(*Thing)(nil),           // 2: luci.server.rpccache.test.Thing
+}
+var file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_depIdxs = []int32{
+	0, // 0: luci.server.rpccache.test.GetThingRequest.scope:type_name -> luci.server.rpccache.test.Scope
+	1, // 1: luci.server.rpccache.test.Things.GetThing:input_type -> luci.server.rpccache.test.GetThingRequest
+	1, // 2: luci.server.rpccache.test.Things.GetPublicThing:input_type -> luci.server.rpccache.test.GetThingRequest
+	1, // 3: luci.server.rpccache.test.Things.GetRealmThing:input_type -> luci.server.rpccache.test.GetThingRequest
+	1, // 4: luci.server.rpccache.test.Things.GetRegisteredThing:input_type -> luci.server.rpccache.test.GetThingRequest
+	1, // 5: luci.server.rpccache.test.Things.UpdateThing:input_type -> luci.server.rpccache.test.GetThingRequest
+	2, // 6: luci.server.rpccache.test.Things.GetThing:output_type -> luci.server.rpccache.test.Thing
+	2, // 7: luci.server.rpccache.test.Things.GetPublicThing:output_type -> luci.server.rpccache.test.Thing
+	2, // 8: luci.server.rpccache.test.Things.GetRealmThing:output_type -> luci.server.rpccache.test.Thing
+	2, // 9: luci.server.rpccache.test.Things.GetRegisteredThing:output_type -> luci.server.rpccache.test.Thing
+	2, // 10: luci.server.rpccache.test.Things.UpdateThing:output_type -> luci.server.rpccache.test.Thing
+	6, // [6:11] is the sub-list for method output_type
+	1, // [1:6] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_init() }
+func file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_init() {
+	if File_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Thing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_goTypes,
+		DependencyIndexes: file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_depIdxs,
+		MessageInfos:      file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_msgTypes,
+	}.Build()
+	File_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto = out.File
+	file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_rawDesc = nil
+	file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_goTypes = nil
+	file_go_chromium_org_luci_server_rpccache_internal_testpb_test_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ThingsClient is the client API for Things service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ThingsClient interface {
+	GetThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error)
+	GetPublicThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error)
+	GetRealmThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error)
+	GetRegisteredThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error)
+	UpdateThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error)
+}
+type thingsPRPCClient struct {
+	client *prpc.Client
+}
+
+func NewThingsPRPCClient(client *prpc.Client) ThingsClient {
+	return &thingsPRPCClient{client}
+}
+
+func (c *thingsPRPCClient) GetThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.rpccache.test.Things", "GetThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsPRPCClient) GetPublicThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.rpccache.test.Things", "GetPublicThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsPRPCClient) GetRealmThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.rpccache.test.Things", "GetRealmThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsPRPCClient) GetRegisteredThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.rpccache.test.Things", "GetRegisteredThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsPRPCClient) UpdateThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.client.Call(ctx, "luci.server.rpccache.test.Things", "UpdateThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type thingsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThingsClient(cc grpc.ClientConnInterface) ThingsClient {
+	return &thingsClient{cc}
+}
+
+func (c *thingsClient) GetThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.rpccache.test.Things/GetThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsClient) GetPublicThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.rpccache.test.Things/GetPublicThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsClient) GetRealmThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.rpccache.test.Things/GetRealmThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsClient) GetRegisteredThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.rpccache.test.Things/GetRegisteredThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thingsClient) UpdateThing(ctx context.Context, in *GetThingRequest, opts ...grpc.CallOption) (*Thing, error) {
+	out := new(Thing)
+	err := c.cc.Invoke(ctx, "/luci.server.rpccache.test.Things/UpdateThing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThingsServer is the server API for Things service.
+type ThingsServer interface {
+	GetThing(context.Context, *GetThingRequest) (*Thing, error)
+	GetPublicThing(context.Context, *GetThingRequest) (*Thing, error)
+	GetRealmThing(context.Context, *GetThingRequest) (*Thing, error)
+	GetRegisteredThing(context.Context, *GetThingRequest) (*Thing, error)
+	UpdateThing(context.Context, *GetThingRequest) (*Thing, error)
+}
+
+// UnimplementedThingsServer can be embedded to have forward compatible implementations.
+type UnimplementedThingsServer struct {
+}
+
+func (*UnimplementedThingsServer) GetThing(context.Context, *GetThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThing not implemented")
+}
+func (*UnimplementedThingsServer) GetPublicThing(context.Context, *GetThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicThing not implemented")
+}
+func (*UnimplementedThingsServer) GetRealmThing(context.Context, *GetThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRealmThing not implemented")
+}
+func (*UnimplementedThingsServer) GetRegisteredThing(context.Context, *GetThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegisteredThing not implemented")
+}
+func (*UnimplementedThingsServer) UpdateThing(context.Context, *GetThingRequest) (*Thing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateThing not implemented")
+}
+
+func RegisterThingsServer(s prpc.Registrar, srv ThingsServer) {
+	s.RegisterService(&_Things_serviceDesc, srv)
+}
+
+func _Things_GetThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).GetThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.rpccache.test.Things/GetThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).GetThing(ctx, req.(*GetThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Things_GetPublicThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).GetPublicThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.rpccache.test.Things/GetPublicThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).GetPublicThing(ctx, req.(*GetThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Things_GetRealmThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).GetRealmThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.rpccache.test.Things/GetRealmThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).GetRealmThing(ctx, req.(*GetThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Things_GetRegisteredThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).GetRegisteredThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.rpccache.test.Things/GetRegisteredThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).GetRegisteredThing(ctx, req.(*GetThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Things_UpdateThing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThingsServer).UpdateThing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/luci.server.rpccache.test.Things/UpdateThing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThingsServer).UpdateThing(ctx, req.(*GetThingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Things_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "luci.server.rpccache.test.Things",
+	HandlerType: (*ThingsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetThing",
+			Handler:    _Things_GetThing_Handler,
+		},
+		{
+			MethodName: "GetPublicThing",
+			Handler:    _Things_GetPublicThing_Handler,
+		},
+		{
+			MethodName: "GetRealmThing",
+			Handler:    _Things_GetRealmThing_Handler,
+		},
+		{
+			MethodName: "GetRegisteredThing",
+			Handler:    _Things_GetRegisteredThing_Handler,
+		},
+		{
+			MethodName: "UpdateThing",
+			Handler:    _Things_UpdateThing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "go.chromium.org/luci/server/rpccache/internal/testpb/test.proto",
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpccache
+
+import (
+	"context"
+	"flag"
+	"sort"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	"go.chromium.org/luci/server/module"
+	"go.chromium.org/luci/server/redisconn"
+)
+
+// ModuleName can be used to refer to this module when declaring dependencies.
+var ModuleName = module.RegisterName("go.chromium.org/luci/server/rpccache")
+
+// ModuleOptions contain configuration of the rpccache server module.
+type ModuleOptions struct {
+	// Disabled is true to call methods directly, ignoring caching options.
+	Disabled bool
+}
+
+// Register registers the command line flags.
+func (o *ModuleOptions) Register(f *flag.FlagSet) {
+	f.BoolVar(&o.Disabled, "rpccache-disable", o.Disabled,
+		`Disable caching of RPC responses.`)
+}
+
+// NewModule returns a server module that caches responses of unary RPCs.
+func NewModule(opts *ModuleOptions) module.Module {
+	if opts == nil {
+		opts = &ModuleOptions{}
+	}
+	return &rpccacheModule{opts: opts}
+}
+
+// NewModuleFromFlags is a variant of NewModule that initializes options through
+// command line flags.
+//
+// Calling this function registers flags in flag.CommandLine. They are usually
+// parsed in server.Main(...).
+func NewModuleFromFlags() module.Module {
+	opts := &ModuleOptions{}
+	opts.Register(flag.CommandLine)
+	return NewModule(opts)
+}
+
+// rpccacheModule implements module.Module.
+type rpccacheModule struct {
+	opts *ModuleOptions
+}
+
+// Name is part of module.Module interface.
+func (*rpccacheModule) Name() module.Name {
+	return ModuleName
+}
+
+// Dependencies is part of module.Module interface.
+func (*rpccacheModule) Dependencies() []module.Dependency {
+	return []module.Dependency{
+		module.OptionalDependency(redisconn.ModuleName),
+	}
+}
+
+// Initialize is part of module.Module interface.
+func (m *rpccacheModule) Initialize(ctx context.Context, host module.Host, opts module.HostOptions) (context.Context, error) {
+	if m.opts.Disabled {
+		logging.Infof(ctx, "Caching of RPC responses is disabled")
+		return ctx, nil
+	}
+	methods, err := cachedMethods()
+	if err != nil {
+		return nil, errors.Annotate(err, "bad caching options").Err()
+	}
+	names := make([]string, 0, len(methods))
+	for name := range methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		logging.Infof(ctx, "Caching responses of %s for %s", name, methods[name].opts.TTL)
+	}
+	host.RegisterUnaryServerInterceptor(newInterceptor(methods))
+	return ctx, nil
+}
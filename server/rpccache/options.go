@@ -0,0 +1,284 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpccache
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	protov1 "github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/proto/mask"
+
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/rpccache/rpccachepb"
+)
+
+// Scope defines who can see a cached response.
+type Scope int
+
+const (
+	// ScopeIdentity caches responses separately for each caller identity.
+	ScopeIdentity Scope = iota
+	// ScopeGlobal shares responses between all callers.
+	//
+	// Use it only for public data.
+	ScopeGlobal
+	// ScopeRealm shares responses between callers that have the permission in
+	// the realm specified in the request.
+	ScopeRealm
+)
+
+// MethodOptions define how responses of a method are cached.
+type MethodOptions struct {
+	// TTL is how long to cache responses. Required.
+	TTL time.Duration
+
+	// KeyFields are paths of request fields that identify the response, e.g.
+	// "name" or "thing.name".
+	//
+	// If empty, the entire request is used.
+	KeyFields []string
+
+	// Scope defines who can see a cached response. Default is ScopeIdentity.
+	Scope Scope
+
+	// RealmField is a path of a string request field with a realm name.
+	//
+	// Required if Scope is ScopeRealm.
+	RealmField string
+
+	// Permission is a permission the caller must have in the realm to get
+	// a cached response.
+	//
+	// Required if Scope is ScopeRealm.
+	Permission realms.Permission
+}
+
+var registry struct {
+	m       sync.Mutex
+	methods map[string]MethodOptions
+}
+
+// RegisterMethod enables caching of responses of a unary method.
+//
+// Takes the full gRPC method name, e.g. "/pkg.Service/Method". The method and
+// its request and response messages must be registered in the global proto
+// registry.
+//
+// It is an alternative to annotating the method with
+// `option (luci.server.rpccache.cache)` in the proto file, and it takes
+// precedence over the annotation. Must be called before the server starts, e.g.
+// in init(). Panics if the method is already registered.
+func RegisterMethod(fullMethod string, opts MethodOptions) {
+	registry.m.Lock()
+	defer registry.m.Unlock()
+	if _, ok := registry.methods[fullMethod]; ok {
+		panic(fmt.Sprintf("method %q is already registered", fullMethod))
+	}
+	if registry.methods == nil {
+		registry.methods = map[string]MethodOptions{}
+	}
+	registry.methods[fullMethod] = opts
+}
+
+// cachedMethod is a method with caching enabled.
+type cachedMethod struct {
+	fullMethod string
+	opts       MethodOptions
+	keyMask    *mask.Mask // nil to use the entire request as the key
+	realmPath  []protoreflect.Name
+	response   protoreflect.MessageType
+}
+
+// cachedMethods returns all cached methods, keyed by full method name.
+//
+// Collects methods registered via RegisterMethod and methods annotated with
+// `option (luci.server.rpccache.cache)`. Registers permissions used in
+// annotations, thus must be called before the server starts.
+func cachedMethods() (map[string]*cachedMethod, error) {
+	registry.m.Lock()
+	all := make(map[string]MethodOptions, len(registry.methods))
+	for name, opts := range registry.methods {
+		all[name] = opts
+	}
+	registry.m.Unlock()
+
+	var merr errors.MultiError
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			methods := services.Get(i).Methods()
+			for j := 0; j < methods.Len(); j++ {
+				md := methods.Get(j)
+				if !proto.HasExtension(md.Options(), rpccachepb.E_Cache) {
+					continue
+				}
+				fullMethod := fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name())
+				if _, ok := all[fullMethod]; ok {
+					continue
+				}
+				opts, err := fromProto(proto.GetExtension(md.Options(), rpccachepb.E_Cache).(*rpccachepb.CacheOptions))
+				if err != nil {
+					merr = append(merr, errors.Annotate(err, "method %q", fullMethod).Err())
+					continue
+				}
+				all[fullMethod] = opts
+			}
+		}
+		return true
+	})
+	if len(merr) != 0 {
+		return nil, merr
+	}
+
+	methods := make(map[string]*cachedMethod, len(all))
+	for fullMethod, opts := range all {
+		m, err := newCachedMethod(fullMethod, opts)
+		if err != nil {
+			return nil, errors.Annotate(err, "method %q", fullMethod).Err()
+		}
+		methods[fullMethod] = m
+	}
+	return methods, nil
+}
+
+// fromProto converts CacheOptions to MethodOptions.
+func fromProto(pb *rpccachepb.CacheOptions) (MethodOptions, error) {
+	opts := MethodOptions{
+		TTL:        time.Duration(pb.GetTtlSec()) * time.Second,
+		KeyFields:  pb.GetKeyFields(),
+		RealmField: pb.GetRealmField(),
+	}
+	switch pb.GetScope() {
+	case rpccachepb.CacheOptions_IDENTITY:
+		opts.Scope = ScopeIdentity
+	case rpccachepb.CacheOptions_GLOBAL:
+		opts.Scope = ScopeGlobal
+	case rpccachepb.CacheOptions_REALM:
+		opts.Scope = ScopeRealm
+	default:
+		return opts, errors.Reason("unknown scope %s", pb.GetScope()).Err()
+	}
+	if perm := pb.GetPermission(); perm != "" {
+		if err := realms.ValidatePermissionName(perm); err != nil {
+			return opts, err
+		}
+		opts.Permission = realms.RegisterPermission(perm)
+	}
+	return opts, nil
+}
+
+// newCachedMethod validates options and resolves the method messages.
+func newCachedMethod(fullMethod string, opts MethodOptions) (*cachedMethod, error) {
+	if opts.TTL <= 0 {
+		return nil, errors.Reason("TTL must be positive").Err()
+	}
+
+	name := strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", ".")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, errors.Annotate(err, "unknown method").Err()
+	}
+	md, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, errors.Reason("%q is not a method", name).Err()
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return nil, errors.Reason("streaming methods can't be cached").Err()
+	}
+	request, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, errors.Annotate(err, "unknown request type").Err()
+	}
+	response, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, errors.Annotate(err, "unknown response type").Err()
+	}
+
+	m := &cachedMethod{
+		fullMethod: fullMethod,
+		opts:       opts,
+		response:   response,
+	}
+	if len(opts.KeyFields) != 0 {
+		m.keyMask, err = mask.FromFieldMask(&fieldmaskpb.FieldMask{Paths: opts.KeyFields}, protov1.MessageV1(request.New().Interface()), false, false)
+		if err != nil {
+			return nil, errors.Annotate(err, "bad key fields").Err()
+		}
+	}
+
+	switch opts.Scope {
+	case ScopeIdentity, ScopeGlobal:
+	case ScopeRealm:
+		if opts.Permission.Name() == "" {
+			return nil, errors.Reason("permission is required for the realm scope").Err()
+		}
+		if m.realmPath, err = stringFieldPath(request.Descriptor(), opts.RealmField); err != nil {
+			return nil, errors.Annotate(err, "bad realm field").Err()
+		}
+	default:
+		return nil, errors.Reason("unknown scope %d", opts.Scope).Err()
+	}
+	return m, nil
+}
+
+// stringFieldPath parses a dotted path to a singular string field.
+func stringFieldPath(md protoreflect.MessageDescriptor, path string) ([]protoreflect.Name, error) {
+	if path == "" {
+		return nil, errors.Reason("empty path").Err()
+	}
+	var names []protoreflect.Name
+	for i, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil, errors.Reason("%q is not a message field", names[i-1]).Err()
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		switch {
+		case fd == nil:
+			return nil, errors.Reason("no field %q in %s", name, md.FullName()).Err()
+		case fd.Cardinality() == protoreflect.Repeated:
+			return nil, errors.Reason("%q is repeated", name).Err()
+		}
+		names = append(names, fd.Name())
+		md = fd.Message()
+		if md == nil && fd.Kind() != protoreflect.StringKind {
+			return nil, errors.Reason("%q is not a string field", name).Err()
+		}
+	}
+	if md != nil {
+		return nil, errors.Reason("%q is not a string field", path).Err()
+	}
+	return names, nil
+}
+
+// stringField returns a value of a string field given its path.
+func stringField(msg protoreflect.Message, path []protoreflect.Name) string {
+	for _, name := range path[:len(path)-1] {
+		fd := msg.Descriptor().Fields().ByName(name)
+		if !msg.Has(fd) {
+			return ""
+		}
+		msg = msg.Get(fd).Message()
+	}
+	return msg.Get(msg.Descriptor().Fields().ByName(path[len(path)-1])).String()
+}
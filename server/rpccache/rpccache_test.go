@@ -0,0 +1,206 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpccache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/auth/identity"
+	"go.chromium.org/luci/common/clock/testclock"
+	"go.chromium.org/luci/common/data/caching/lru"
+
+	"go.chromium.org/luci/server/auth"
+	"go.chromium.org/luci/server/auth/authtest"
+	"go.chromium.org/luci/server/auth/realms"
+	"go.chromium.org/luci/server/caching"
+	"go.chromium.org/luci/server/caching/cachingtest"
+	"go.chromium.org/luci/server/rpccache/internal/testpb"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+var permThingsGet = realms.RegisterPermission("luci.things.get")
+
+// testMethods are collected once, since cachedMethods registers permissions
+// and it is forbidden after the first permission check.
+var (
+	testMethods    map[string]*cachedMethod
+	testMethodsErr error
+)
+
+func init() {
+	RegisterMethod("/luci.server.rpccache.test.Things/GetRegisteredThing", MethodOptions{
+		TTL:       time.Minute,
+		KeyFields: []string{"name"},
+		Scope:     ScopeGlobal,
+	})
+	testMethods, testMethodsErr = cachedMethods()
+}
+
+func TestInterceptor(t *testing.T) {
+	t.Parallel()
+
+	Convey("With interceptor", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), testclock.TestRecentTimeUTC)
+		ctx = caching.WithEmptyProcessCache(ctx)
+		ctx = cachingtest.WithGlobalCache(ctx, map[string]caching.BlobCache{
+			"luci.rpccache.v1": &cachingtest.BlobCache{LRU: lru.New(0)},
+		})
+
+		So(testMethodsErr, ShouldBeNil)
+		So(testMethods, ShouldHaveLength, 4)
+		intr := newInterceptor(testMethods)
+
+		calls := 0
+		var callErr error
+		call := func(ctx context.Context, id identity.Identity, method string, req *testpb.GetThingRequest) (*testpb.Thing, error) {
+			ctx = auth.WithState(ctx, &authtest.FakeState{
+				Identity: id,
+				IdentityPermissions: []authtest.RealmPermission{
+					{Realm: "proj:realm", Permission: permThingsGet},
+				},
+			})
+			resp, err := intr(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/luci.server.rpccache.test.Things/" + method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					calls++
+					if callErr != nil {
+						return nil, callErr
+					}
+					return &testpb.Thing{Name: req.(*testpb.GetThingRequest).Name, Counter: int64(calls)}, nil
+				})
+			if err != nil {
+				return nil, err
+			}
+			return resp.(*testpb.Thing), nil
+		}
+
+		counter := func(id identity.Identity, method string, req *testpb.GetThingRequest) int64 {
+			resp, err := call(ctx, id, method, req)
+			So(err, ShouldBeNil)
+			So(resp.Name, ShouldEqual, req.Name)
+			return resp.Counter
+		}
+
+		Convey("Identity scope", func() {
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			// Not a key field.
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1", Comment: "zzz"}), ShouldEqual, 1)
+			// Different key.
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "2"}), ShouldEqual, 2)
+			// Different caller.
+			So(counter("user:b@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 3)
+
+			// Expires.
+			tc.Add(61 * time.Second)
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 4)
+
+			// Uses the global cache if the process cache is empty.
+			ctx = caching.WithEmptyProcessCache(ctx)
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 4)
+		})
+
+		Convey("Global scope", func() {
+			So(counter("user:a@example.com", "GetPublicThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			So(counter("user:b@example.com", "GetPublicThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			// The entire request is the key.
+			So(counter("user:b@example.com", "GetPublicThing", &testpb.GetThingRequest{Name: "1", Comment: "zzz"}), ShouldEqual, 2)
+		})
+
+		Convey("Realm scope", func() {
+			req := &testpb.GetThingRequest{Name: "1", Scope: &testpb.Scope{Realm: "proj:realm"}}
+			So(counter("user:a@example.com", "GetRealmThing", req), ShouldEqual, 1)
+			So(counter("user:b@example.com", "GetRealmThing", req), ShouldEqual, 1)
+
+			// Callers without the permission call the method.
+			ctx = auth.WithState(ctx, &authtest.FakeState{Identity: "user:c@example.com"})
+			resp, err := intr(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/luci.server.rpccache.test.Things/GetRealmThing"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, status.Errorf(codes.PermissionDenied, "denied")
+				})
+			So(resp, ShouldBeNil)
+			So(status.Code(err), ShouldEqual, codes.PermissionDenied)
+
+			// Requests without a realm are not cached.
+			So(counter("user:a@example.com", "GetRealmThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 2)
+			So(counter("user:a@example.com", "GetRealmThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 3)
+		})
+
+		Convey("Registered method", func() {
+			So(counter("user:a@example.com", "GetRegisteredThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			So(counter("user:b@example.com", "GetRegisteredThing", &testpb.GetThingRequest{Name: "1", Comment: "z"}), ShouldEqual, 1)
+		})
+
+		Convey("Not cached method", func() {
+			So(counter("user:a@example.com", "UpdateThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			So(counter("user:a@example.com", "UpdateThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 2)
+		})
+
+		Convey("Bypass", func() {
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+			bypassCtx := metadata.NewIncomingContext(ctx, metadata.Pairs(BypassMetadataKey, "1"))
+			resp, err := call(bypassCtx, "user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"})
+			So(err, ShouldBeNil)
+			So(resp.Counter, ShouldEqual, 2)
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 1)
+		})
+
+		Convey("Errors are not cached", func() {
+			callErr = status.Errorf(codes.Internal, "boom")
+			_, err := call(ctx, "user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"})
+			So(err, ShouldErrLike, "boom")
+			callErr = nil
+			So(counter("user:a@example.com", "GetThing", &testpb.GetThingRequest{Name: "1"}), ShouldEqual, 2)
+		})
+	})
+}
+
+func TestOptions(t *testing.T) {
+	t.Parallel()
+
+	Convey("Validation", t, func() {
+		const method = "/luci.server.rpccache.test.Things/UpdateThing"
+
+		_, err := newCachedMethod(method, MethodOptions{})
+		So(err, ShouldErrLike, "TTL must be positive")
+
+		_, err = newCachedMethod("/unknown.Service/Method", MethodOptions{TTL: time.Second})
+		So(err, ShouldErrLike, "unknown method")
+
+		_, err = newCachedMethod(method, MethodOptions{TTL: time.Second, KeyFields: []string{"zzz"}})
+		So(err, ShouldErrLike, "bad key fields")
+
+		_, err = newCachedMethod(method, MethodOptions{TTL: time.Second, Scope: ScopeRealm, RealmField: "name"})
+		So(err, ShouldErrLike, "permission is required")
+
+		for _, field := range []string{"", "zzz", "scope", "name.zzz"} {
+			_, err = newCachedMethod(method, MethodOptions{
+				TTL:        time.Second,
+				Scope:      ScopeRealm,
+				RealmField: field,
+				Permission: permThingsGet,
+			})
+			So(err, ShouldErrLike, "bad realm field")
+		}
+	})
+}
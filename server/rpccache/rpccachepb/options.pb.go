@@ -0,0 +1,318 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Defining extensions is supported in proto2 syntax only.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: go.chromium.org/luci/server/rpccache/rpccachepb/options.proto
+
+package rpccachepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Scope defines who can see a cached response.
+type CacheOptions_Scope int32
+
+const (
+	// Responses are cached separately for each caller identity.
+	CacheOptions_IDENTITY CacheOptions_Scope = 0
+	// Responses are shared by all callers. Use only for public data.
+	CacheOptions_GLOBAL CacheOptions_Scope = 1
+	// Responses are shared by callers that have `permission` in the realm
+	// specified in `realm_field` of the request.
+	CacheOptions_REALM CacheOptions_Scope = 2
+)
+
+// Enum value maps for CacheOptions_Scope.
+var (
+	CacheOptions_Scope_name = map[int32]string{
+		0: "IDENTITY",
+		1: "GLOBAL",
+		2: "REALM",
+	}
+	CacheOptions_Scope_value = map[string]int32{
+		"IDENTITY": 0,
+		"GLOBAL":   1,
+		"REALM":    2,
+	}
+)
+
+func (x CacheOptions_Scope) Enum() *CacheOptions_Scope {
+	p := new(CacheOptions_Scope)
+	*p = x
+	return p
+}
+
+func (x CacheOptions_Scope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CacheOptions_Scope) Descriptor() protoreflect.EnumDescriptor {
+	return file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_enumTypes[0].Descriptor()
+}
+
+func (CacheOptions_Scope) Type() protoreflect.EnumType {
+	return &file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_enumTypes[0]
+}
+
+func (x CacheOptions_Scope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *CacheOptions_Scope) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = CacheOptions_Scope(num)
+	return nil
+}
+
+// Deprecated: Use CacheOptions_Scope.Descriptor instead.
+func (CacheOptions_Scope) EnumDescriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// CacheOptions define how responses of a method are cached.
+type CacheOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long to cache responses, in seconds. Required.
+	TtlSec *int64 `protobuf:"varint,1,opt,name=ttl_sec,json=ttlSec" json:"ttl_sec,omitempty"`
+	// Paths of request fields that identify the response, e.g. "name" or
+	// "thing.name". If empty, the entire request is used.
+	KeyFields []string `protobuf:"bytes,2,rep,name=key_fields,json=keyFields" json:"key_fields,omitempty"`
+	// Who can see a cached response. Default is IDENTITY.
+	Scope *CacheOptions_Scope `protobuf:"varint,3,opt,name=scope,enum=luci.server.rpccache.CacheOptions_Scope" json:"scope,omitempty"`
+	// A path of a string request field with a realm name, e.g. "realm".
+	//
+	// Required if scope is REALM.
+	RealmField *string `protobuf:"bytes,4,opt,name=realm_field,json=realmField" json:"realm_field,omitempty"`
+	// A permission the caller must have in the realm to get a cached response,
+	// e.g. "service.things.get".
+	//
+	// Required if scope is REALM.
+	Permission *string `protobuf:"bytes,5,opt,name=permission" json:"permission,omitempty"`
+}
+
+func (x *CacheOptions) Reset() {
+	*x = CacheOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheOptions) ProtoMessage() {}
+
+func (x *CacheOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheOptions.ProtoReflect.Descriptor instead.
+func (*CacheOptions) Descriptor() ([]byte, []int) {
+	return file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CacheOptions) GetTtlSec() int64 {
+	if x != nil && x.TtlSec != nil {
+		return *x.TtlSec
+	}
+	return 0
+}
+
+func (x *CacheOptions) GetKeyFields() []string {
+	if x != nil {
+		return x.KeyFields
+	}
+	return nil
+}
+
+func (x *CacheOptions) GetScope() CacheOptions_Scope {
+	if x != nil && x.Scope != nil {
+		return *x.Scope
+	}
+	return CacheOptions_IDENTITY
+}
+
+func (x *CacheOptions) GetRealmField() string {
+	if x != nil && x.RealmField != nil {
+		return *x.RealmField
+	}
+	return ""
+}
+
+func (x *CacheOptions) GetPermission() string {
+	if x != nil && x.Permission != nil {
+		return *x.Permission
+	}
+	return ""
+}
+
+var file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*CacheOptions)(nil),
+		Field:         74910,
+		Name:          "luci.server.rpccache.cache",
+		Tag:           "bytes,74910,opt,name=cache",
+		Filename:      "go.chromium.org/luci/server/rpccache/rpccachepb/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional luci.server.rpccache.CacheOptions cache = 74910;
+	E_Cache = &file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_extTypes[0]
+)
+
+var File_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto protoreflect.FileDescriptor
+
+var file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x67, 0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72,
+	0x67, 0x2f, 0x6c, 0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x70,
+	0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x70,
+	0x62, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x14, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70, 0x63,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x74, 0x6c, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x74, 0x6c, 0x53, 0x65,
+	0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x12, 0x3e, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x28, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72, 0x70,
+	0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x2c, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4c, 0x4f, 0x42,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x4c, 0x4d, 0x10, 0x02, 0x3a,
+	0x5a, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x9e, 0xc9, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6c, 0x75, 0x63, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x72,
+	0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x6f, 0x2e, 0x63, 0x68, 0x72, 0x6f, 0x6d, 0x69, 0x75, 0x6d, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x6c,
+	0x75, 0x63, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x2f, 0x72, 0x70, 0x63, 0x63, 0x61, 0x63, 0x68, 0x65, 0x70, 0x62,
+}
+
+var (
+	file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescOnce sync.Once
+	file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescData = file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDesc
+)
+
+func file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescGZIP() []byte {
+	file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescOnce.Do(func() {
+		file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescData)
+	})
+	return file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDescData
+}
+
+var file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_goTypes = []interface{}{
+	(CacheOptions_Scope)(0),            // 0: luci.server.rpccache.CacheOptions.Scope
+	(*CacheOptions)(nil),               // 1: luci.server.rpccache.CacheOptions
+	(*descriptorpb.MethodOptions)(nil), // 2: google.protobuf.MethodOptions
+}
+var file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_depIdxs = []int32{
+	0, // 0: luci.server.rpccache.CacheOptions.scope:type_name -> luci.server.rpccache.CacheOptions.Scope
+	2, // 1: luci.server.rpccache.cache:extendee -> google.protobuf.MethodOptions
+	1, // 2: luci.server.rpccache.cache:type_name -> luci.server.rpccache.CacheOptions
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	2, // [2:3] is the sub-list for extension type_name
+	1, // [1:2] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_init() }
+func file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_init() {
+	if File_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_goTypes,
+		DependencyIndexes: file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_depIdxs,
+		EnumInfos:         file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_enumTypes,
+		MessageInfos:      file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_msgTypes,
+		ExtensionInfos:    file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_extTypes,
+	}.Build()
+	File_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto = out.File
+	file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_rawDesc = nil
+	file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_goTypes = nil
+	file_go_chromium_org_luci_server_rpccache_rpccachepb_options_proto_depIdxs = nil
+}
@@ -23,7 +23,8 @@
 // • go.chromium.org/luci/common/trace: Tracing via Google Cloud Trace and
 // profiling Google Cloud Profiler.
 //
-// • go.chromium.org/luci/server/tsmon: monitoring metrics via ProdX.
+// • go.chromium.org/luci/server/tsmon: monitoring metrics via ProdX,
+// OpenTelemetry collectors or Prometheus scrapers.
 //
 // • go.chromium.org/luci/server/auth: sending and receiving RPCs authenticated
 // with Google OAuth2 or OpenID tokens. Support for authorization via LUCI
@@ -150,6 +151,34 @@
 // authenticate requests using OAuth2 access tokens. Modules can add more
 // interceptors to the default interceptor chain.
 //
+// # Health checks and draining
+//
+// Every port exposes two probe endpoints intended to be used as Kubernetes
+// liveness and readiness probes:
+//
+//   - "/healthz" is the liveness probe. It fails only if some health check
+//     registered with Liveness flag fails, indicating the process is broken
+//     and needs to be restarted.
+//   - "/readyz" is the readiness probe. It fails if any registered health check
+//     fails or if the server is draining, indicating the load balancer should
+//     stop sending new traffic to this process.
+//
+// Health checks are registered via RegisterHealthCheck (or the corresponding
+// module.Host method). They are called on every probe request, in parallel,
+// and thus should be fast. Checks of dependencies the server can function
+// without (e.g. caches) should be marked as Informational: their failures are
+// reported, but don't fail any probes. Probe responses contain only names of
+// failed checks, errors themselves are logged.
+//
+// Upon receiving SIGTERM the server enters the draining phase (see Drain):
+// the readiness probe starts failing and callbacks registered via
+// RegisterDrain are called to let modules stop picking up new work (e.g.
+// server/tq and server/cron start rejecting new pushes, which also stops
+// server/dsmapper and TQ sweeps that run on top of them). The server then waits
+// until the traffic stops flowing (see -shutdown-delay) and shuts down,
+// rejecting new unary and streaming RPCs and waiting for in-flight requests to
+// finish.
+//
 // # Security considerations
 //
 // The expected deployment environments are Kubernetes, Google App Engine and
@@ -192,6 +221,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/pprof"
+	"net/url"
 	"os"
 	"runtime"
 	"strings"
@@ -206,6 +236,8 @@ import (
 	"google.golang.org/api/option"
 	credentialspb "google.golang.org/genproto/googleapis/iam/credentials/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"contrib.go.opencensus.io/exporter/stackdriver"
 	"go.opencensus.io/exporter/stackdriver/propagation"
@@ -254,8 +286,12 @@ import (
 )
 
 const (
-	// Path of the health check endpoint.
+	// Path of the health check (aka liveness probe) endpoint.
 	healthEndpoint = "/healthz"
+	// Path of the readiness probe endpoint.
+	readinessEndpoint = "/readyz"
+	// How long to wait for a health check callback before declaring it failed.
+	healthCheckTimeout = 5 * time.Second
 	// Log a warning if health check is slower than this.
 	healthTimeLogThreshold    = 50 * time.Millisecond
 	defaultTsMonFlushInterval = 60 * time.Second
@@ -364,13 +400,15 @@ type Options struct {
 	TsMonJobName       string        // job name of tsmon target
 	TsMonFlushInterval time.Duration // how often to flush metrics
 	TsMonFlushTimeout  time.Duration // timeout for flushing
+	TsMonOTLPEndpoint  string        // OTLP/HTTP endpoint to flush metrics to instead of ProdX
 
 	ProfilingDisable   bool   // set to true to explicitly disable Stackdriver Profiler
 	ProfilingServiceID string // service name to associated with profiles in Stackdriver Profiler
 
 	ContainerImageID string // ID of the container image with this binary, for logs (optional)
 
-	EnableExperiments []string // names of go.chromium.org/luci/server/experiments to enable
+	EnableExperiments  []string // names of go.chromium.org/luci/server/experiments to enable
+	ExperimentsRollout string   // path to a JSON file with experiments rollout rules
 
 	CloudErrorReporting bool // set to true to enable Cloud Error Reporting
 
@@ -506,6 +544,12 @@ func (o *Options) Register(f *flag.FlagSet) {
 		o.TsMonFlushTimeout,
 		fmt.Sprintf("Timeout for tsmon flush. Default to %s if < 1s or unset. Must be shorter than --ts-mon-flush-interval.", o.TsMonFlushTimeout),
 	)
+	f.StringVar(
+		&o.TsMonOTLPEndpoint,
+		"ts-mon-otlp-endpoint",
+		o.TsMonOTLPEndpoint,
+		"Flush tsmon metrics to this OpenTelemetry collector URL (e.g. 'http://localhost:4318/v1/metrics') instead of ProdX. -ts-mon-account is not required in this case.",
+	)
 	f.BoolVar(
 		&o.ProfilingDisable,
 		"profiling-disable",
@@ -534,6 +578,12 @@ func (o *Options) Register(f *flag.FlagSet) {
 	// See go.chromium.org/luci/server/experiments.
 	f.Var(luciflag.StringSlice(&o.EnableExperiments), "enable-experiment",
 		`A name of the experiment to enable. May be repeated.`)
+	f.StringVar(
+		&o.ExperimentsRollout,
+		"experiments-rollout",
+		o.ExperimentsRollout,
+		`Path to a JSON file with rollout rules of experiments. If not set, the rules are read from the settings store, if available.`,
+	)
 }
 
 // FromGAEEnv uses the GAE_* env vars to configure the server for the GAE
@@ -705,8 +755,10 @@ type Server struct {
 	ready   chan struct{} // closed right before starting the serving loop
 	done    chan struct{} // closed after Shutdown returns
 
-	// See RegisterUnaryServerInterceptor and Serve.
-	unaryInterceptors []grpc.UnaryServerInterceptor
+	// See RegisterUnaryServerInterceptor, RegisterStreamServerInterceptor and
+	// Serve.
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
 
 	rndM sync.Mutex // protects rnd
 	rnd  *rand.Rand // used to generate trace and operation IDs
@@ -720,6 +772,14 @@ type Server struct {
 	cleanupM sync.Mutex // protects 'cleanup' and the actual cleanup critical section
 	cleanup  []func(context.Context)
 
+	healthM sync.Mutex // protects 'health'
+	health  []module.HealthCheck
+
+	drainM   sync.Mutex // protects 'drain' and the actual drain critical section
+	drain    []func(context.Context)
+	draining int32 // 1 after Drain is called, accessed atomically
+	stopping int32 // 1 after Shutdown is called, accessed atomically
+
 	tsmon   *tsmon.State    // manages flushing of tsmon metrics
 	sampler octrace.Sampler // trace sampler to use for top level spans
 
@@ -781,11 +841,26 @@ func (h *moduleHostImpl) RegisterCleanup(cb func(context.Context)) {
 	h.srv.RegisterCleanup(cb)
 }
 
+func (h *moduleHostImpl) RegisterHealthCheck(check module.HealthCheck) {
+	h.panicIfInvalid()
+	h.srv.RegisterHealthCheck(check)
+}
+
+func (h *moduleHostImpl) RegisterDrain(cb func(context.Context)) {
+	h.panicIfInvalid()
+	h.srv.RegisterDrain(cb)
+}
+
 func (h *moduleHostImpl) RegisterUnaryServerInterceptor(intr grpc.UnaryServerInterceptor) {
 	h.panicIfInvalid()
 	h.srv.RegisterUnaryServerInterceptor(intr)
 }
 
+func (h *moduleHostImpl) RegisterStreamServerInterceptor(intr grpc.StreamServerInterceptor) {
+	h.panicIfInvalid()
+	h.srv.RegisterStreamServerInterceptor(intr)
+}
+
 func (h *moduleHostImpl) RegisterCookieAuth(method auth.Method) {
 	h.panicIfInvalid()
 	h.cookieAuth = method
@@ -883,6 +958,14 @@ func New(ctx context.Context, opts Options, mods []module.Module) (srv *Server,
 		}
 	}
 	srv.Context = experiments.Enable(srv.Context, exps...)
+	if opts.ExperimentsRollout != "" {
+		rollout, err := experiments.LoadRollout(opts.ExperimentsRollout)
+		if err != nil {
+			return srv, errors.Annotate(err, "failed to load experiments rollout").Err()
+		}
+		srv.Context = experiments.WithRollout(srv.Context, rollout)
+		experiments.PortalPage.SetReadOnlyRollout(rollout, "Rollout rules are loaded from "+opts.ExperimentsRollout+".")
+	}
 
 	// Configure base server subsystems by injecting them into the root context
 	// inherited later by all requests.
@@ -1045,9 +1128,12 @@ func (s *Server) newRouter(opts PortOptions) *router.Router {
 	r := router.New()
 	r.Use(mw)
 
-	// Mandatory health check/readiness probe endpoint.
+	// Mandatory liveness and readiness probe endpoints.
 	r.GET(healthEndpoint, nil, func(c *router.Context) {
-		c.Writer.Write([]byte(s.healthResponse(c.Context)))
+		s.serveProbe(c, true)
+	})
+	r.GET(readinessEndpoint, nil, func(c *router.Context) {
+		s.serveProbe(c, false)
 	})
 
 	// Add NotFound handler wrapped in our middlewares so that unrecognized
@@ -1127,6 +1213,29 @@ func (s *Server) RegisterUnaryServerInterceptor(intr grpc.UnaryServerInterceptor
 	s.unaryInterceptors = append(s.unaryInterceptors, intr)
 }
 
+// RegisterStreamServerInterceptor registers an grpc.StreamServerInterceptor
+// applied to all streaming RPCs that hit the server.
+//
+// Interceptors are chained in order they are registered, i.e. the first
+// registered interceptor becomes the outermost. The initial chain already
+// contains some base interceptors (e.g. for monitoring) and all interceptors
+// registered by server modules. RegisterStreamServerInterceptor extends this
+// chain.
+//
+// An interceptor set in server.PRPC.StreamServerInterceptor (if any) is
+// automatically registered as the last (innermost) one right before the server
+// starts serving requests in Serve.
+//
+// Should be called before Serve (panics otherwise).
+func (s *Server) RegisterStreamServerInterceptor(intr grpc.StreamServerInterceptor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		s.Fatal(errors.Reason("the server has already been started").Err())
+	}
+	s.streamInterceptors = append(s.streamInterceptors, intr)
+}
+
 // Serve launches the serving loop.
 //
 // Blocks forever or until the server is stopped via Shutdown (from another
@@ -1145,22 +1254,33 @@ func (s *Server) Serve() error {
 
 	ports := append(make([]*Port, 0, len(s.ports)), s.ports...)
 
-	// Assemble the interceptor chain. Put our base interceptors in front of
+	// Assemble the interceptor chains. Put our base interceptors in front of
 	// whatever interceptors were installed by modules and by the user of Server
-	// via public s.PRPC.UnaryServerInterceptor.
+	// via public s.PRPC.UnaryServerInterceptor and s.PRPC.StreamServerInterceptor.
 	interceptors := []grpc.UnaryServerInterceptor{
 		grpcmon.UnaryServerInterceptor,
 		grpcutil.UnaryServerPanicCatcherInterceptor,
+		s.rejectWhenStopping,
 	}
 	interceptors = append(interceptors, s.unaryInterceptors...)
 	if s.PRPC.UnaryServerInterceptor != nil {
 		interceptors = append(interceptors, s.PRPC.UnaryServerInterceptor)
 	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpcmon.StreamServerInterceptor,
+		grpcutil.StreamServerPanicCatcherInterceptor,
+		s.rejectStreamWhenStopping,
+	}
+	streamInterceptors = append(streamInterceptors, s.streamInterceptors...)
+	if s.PRPC.StreamServerInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, s.PRPC.StreamServerInterceptor)
+	}
 
 	s.mu.Unlock()
 
-	// Install the interceptor chain.
+	// Install the interceptor chains.
 	s.PRPC.UnaryServerInterceptor = grpcutil.ChainUnaryServerInterceptors(interceptors...)
+	s.PRPC.StreamServerInterceptor = grpcutil.ChainStreamServerInterceptors(streamInterceptors...)
 
 	// Run registered best-effort warmup callbacks right before serving.
 	s.runWarmup()
@@ -1173,7 +1293,11 @@ func (s *Server) Serve() error {
 	// each other, we want Endpoints list updates to win, i.e. we want the pod to
 	// actually be fully alive as long as it is still referenced in Endpoints
 	// list. We can't guarantee this, but we can improve chances.
+	//
+	// Before that, switch into the draining mode to let the load balancer and
+	// modules know the server is going away.
 	stop := signals.HandleInterrupt(func() {
+		s.Drain()
 		if s.Options.Prod {
 			s.waitUntilNotServing()
 		}
@@ -1225,8 +1349,14 @@ func (s *Server) Serve() error {
 
 // Shutdown gracefully stops the server if it was running.
 //
+// Drains the server first if it wasn't drained yet (see Drain), then rejects
+// all new RPCs and waits for in-flight requests to finish.
+//
 // Blocks until the server is stopped. Can be called multiple times.
 func (s *Server) Shutdown() {
+	s.Drain()
+	atomic.StoreInt32(&s.stopping, 1)
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	if s.stopped {
@@ -1267,19 +1397,168 @@ func (s *Server) Fatal(err error) {
 	os.Exit(3)
 }
 
+// Drain switches the server into the draining mode in preparation for
+// a shutdown.
+//
+// The readiness probe starts failing, so load balancers stop routing new
+// traffic to the server, and all callbacks registered via RegisterDrain are
+// called, so modules can stop picking up new work. The server keeps serving
+// requests until Shutdown is called.
+//
+// Blocks until all drain callbacks return. Can be called multiple times.
+func (s *Server) Drain() {
+	s.drainM.Lock()
+	defer s.drainM.Unlock()
+	if atomic.LoadInt32(&s.draining) == 1 {
+		return
+	}
+	logging.Infof(s.Context, "Draining the server...")
+	atomic.StoreInt32(&s.draining, 1)
+	ctx := logging.SetField(s.Context, "activity", "luci.drain")
+	for _, cb := range s.drain {
+		cb(ctx)
+	}
+}
+
+// RegisterDrain registers a callback that is run when the server enters the
+// draining mode (see Drain).
+//
+// It receives the global server context. Intended for stopping acceptance of
+// new background work (e.g. task queue pushes or leases) while letting
+// in-flight work to finish. Callbacks are run sequentially in registration
+// order and should return quickly.
+//
+// Registering a new drain callback from within a drain callback causes
+// a deadlock, don't do that.
+func (s *Server) RegisterDrain(cb func(context.Context)) {
+	s.drainM.Lock()
+	defer s.drainM.Unlock()
+	s.drain = append(s.drain, cb)
+}
+
+// RegisterHealthCheck registers a callback used by the liveness and readiness
+// probes.
+//
+// See module.HealthCheck for details.
+func (s *Server) RegisterHealthCheck(check module.HealthCheck) {
+	if check.Name == "" || check.Check == nil {
+		panic("a health check must have a name and a callback")
+	}
+	if check.Liveness && check.Informational {
+		panic("a health check can't be both Liveness and Informational")
+	}
+	s.healthM.Lock()
+	defer s.healthM.Unlock()
+	s.health = append(s.health, check)
+}
+
+// checkHealth runs registered health checks in parallel and returns names of
+// failed checks.
+//
+// Failures of informational checks are returned as warnings, all other failures
+// are returned as problems. Errors are logged, but not returned, since they may
+// contain details that should not be exposed on the public port.
+//
+// If `liveness` is true, runs only checks with Liveness flag. Otherwise runs
+// all checks and additionally reports if the server is draining.
+func (s *Server) checkHealth(ctx context.Context, liveness bool) (problems, warnings []string) {
+	if !liveness && atomic.LoadInt32(&s.draining) == 1 {
+		problems = append(problems, "draining")
+	}
+
+	s.healthM.Lock()
+	checks := append([]module.HealthCheck(nil), s.health...)
+	s.healthM.Unlock()
+
+	ctx, cancel := clock.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	errs := make([]error, len(checks))
+	wg := sync.WaitGroup{}
+	for i, check := range checks {
+		if liveness && !check.Liveness {
+			continue
+		}
+		i, check := i, check
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					errs[i] = errors.Reason("panic: %s", p).Err()
+				}
+			}()
+			errs[i] = check.Check(ctx)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			continue
+		case checks[i].Informational:
+			logging.Warningf(ctx, "Informational health check %q failed: %s", checks[i].Name, err)
+			warnings = append(warnings, checks[i].Name)
+		default:
+			logging.Warningf(ctx, "Health check %q failed: %s", checks[i].Name, err)
+			problems = append(problems, checks[i].Name)
+		}
+	}
+	return
+}
+
+// serveProbe runs health checks and writes the response of a liveness or
+// readiness probe.
+//
+// Replies with HTTP 503 if there are problems.
+func (s *Server) serveProbe(c *router.Context, liveness bool) {
+	problems, warnings := s.checkHealth(c.Context, liveness)
+	c.Writer.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(problems) != 0 {
+		c.Writer.WriteHeader(http.StatusServiceUnavailable)
+	}
+	c.Writer.Write([]byte(s.healthResponse(c.Context, problems, warnings)))
+}
+
+// rejectWhenStopping is a grpc.UnaryServerInterceptor that rejects new RPCs
+// once the server is shutting down.
+func (s *Server) rejectWhenStopping(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if atomic.LoadInt32(&s.stopping) == 1 {
+		return nil, status.Errorf(codes.Unavailable, "the server is shutting down")
+	}
+	return handler(ctx, req)
+}
+
+// rejectStreamWhenStopping is a grpc.StreamServerInterceptor that rejects new
+// streaming RPCs once the server is shutting down.
+func (s *Server) rejectStreamWhenStopping(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if atomic.LoadInt32(&s.stopping) == 1 {
+		return status.Errorf(codes.Unavailable, "the server is shutting down")
+	}
+	return handler(srv, ss)
+}
+
 // healthResponse prepares text/plan response for the health check endpoints.
 //
 // It additionally contains some easy to obtain information that may help in
 // debugging deployments.
-func (s *Server) healthResponse(c context.Context) string {
+func (s *Server) healthResponse(c context.Context, problems, warnings []string) string {
 	maybeEmpty := func(s string) string {
 		if s == "" {
 			return "<unknown>"
 		}
 		return s
 	}
+	verdict := "OK"
+	if len(problems) != 0 {
+		verdict = "FAIL\n  " + strings.Join(problems, "\n  ")
+	}
+	if len(warnings) != 0 {
+		verdict += "\nWARN\n  " + strings.Join(warnings, "\n  ")
+	}
 	return strings.Join([]string{
-		"OK",
+		verdict,
 		"",
 		"uptime:  " + clock.Now(c).Sub(s.startTime).String(),
 		"image:   " + maybeEmpty(s.Options.ContainerImageID),
@@ -1888,10 +2167,26 @@ func (s *Server) initTSMon() error {
 	// are set) so that tsmon's in-process store is populated, and metrics there
 	// can be examined via /admin/tsmon. This is useful when developing/debugging
 	// tsmon metrics.
+	//
+	// Metrics are also always available in the Prometheus format via /metrics
+	// endpoint on the admin port.
 	var customMonitor monitor.Monitor
-	if s.Options.TsMonAccount == "" || s.Options.TsMonServiceName == "" || s.Options.TsMonJobName == "" {
+	var settingsNote string
+	switch {
+	case s.Options.TsMonOTLPEndpoint != "":
+		endpoint, err := url.Parse(s.Options.TsMonOTLPEndpoint)
+		if err != nil {
+			return errors.Annotate(err, "bad -ts-mon-otlp-endpoint").Err()
+		}
+		logging.Infof(s.Context, "tsmon metrics are flushed to the OTLP endpoint %s", endpoint)
+		customMonitor = monitor.NewOTLPMonitor(s.Context, &http.Client{}, endpoint)
+		settingsNote = "Settings are controlled through -ts-mon-* command line flags. Metrics are flushed to " + endpoint.String() + "."
+	case s.Options.TsMonAccount == "" || s.Options.TsMonServiceName == "" || s.Options.TsMonJobName == "":
 		logging.Infof(s.Context, "tsmon is in the debug mode: metrics are collected, but flushed to /dev/null (pass -ts-mon-* flags to start uploading metrics)")
 		customMonitor = monitor.NewNilMonitor()
+		settingsNote = "Running in the debug mode. Pass all -ts-mon-* command line flags to start uploading metrics."
+	default:
+		settingsNote = "Settings are controlled through -ts-mon-* command line flags."
 	}
 
 	interval := int(s.Options.TsMonFlushInterval.Seconds())
@@ -1928,13 +2223,7 @@ func (s *Server) initTSMon() error {
 			}
 		},
 	}
-	if customMonitor != nil {
-		tsmon.PortalPage.SetReadOnlySettings(s.tsmon.Settings,
-			"Running in the debug mode. Pass all -ts-mon-* command line flags to start uploading metrics.")
-	} else {
-		tsmon.PortalPage.SetReadOnlySettings(s.tsmon.Settings,
-			"Settings are controlled through -ts-mon-* command line flags.")
-	}
+	tsmon.PortalPage.SetReadOnlySettings(s.tsmon.Settings, settingsNote)
 
 	// Enable this configuration in s.Context so all transports created during
 	// the server startup have tsmon instrumentation.
@@ -2172,6 +2461,9 @@ func (s *Server) initAdminPort() error {
 	})
 	portal.InstallHandlers(routes, withAdminSecret, portal.AssumeTrustedPort)
 
+	// Expose tsmon metrics to Prometheus scrapers.
+	routes.GET("/metrics", nil, tsmon.ServePrometheus)
+
 	// Install pprof endpoints on the admin port. Note that they must not be
 	// exposed via the main serving port, since they do no authentication and
 	// may leak internal information. Also note that pprof handlers rely on
@@ -2415,7 +2707,7 @@ func getRequestURL(r *http.Request) string {
 // isHealthCheckRequest is true if the request appears to be coming from
 // a known health check probe.
 func isHealthCheckRequest(r *http.Request) bool {
-	if r.URL.Path == healthEndpoint {
+	if r.URL.Path == healthEndpoint || r.URL.Path == readinessEndpoint {
 		switch ua := r.UserAgent(); {
 		case strings.HasPrefix(ua, "kube-probe/"): // Kubernetes
 			return true
@@ -32,6 +32,10 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"go.chromium.org/luci/gae/impl/memory"
 	"go.chromium.org/luci/gae/service/datastore"
 
@@ -244,6 +248,108 @@ func TestServer(t *testing.T) {
 			So(cleanups, ShouldResemble, []string{"b", "a"})
 		})
 
+		Convey("Health checks and draining", func() {
+			var healthy int32 = 1
+			srv.RegisterHealthCheck(module.HealthCheck{
+				Name:     "fine",
+				Check:    func(context.Context) error { return nil },
+				Liveness: true,
+			})
+			srv.RegisterHealthCheck(module.HealthCheck{
+				Name:          "cache",
+				Check:         func(context.Context) error { return errors.New("secret details") },
+				Informational: true,
+			})
+			srv.RegisterHealthCheck(module.HealthCheck{
+				Name: "flaky",
+				Check: func(context.Context) error {
+					if atomic.LoadInt32(&healthy) == 1 {
+						return nil
+					}
+					return errors.New("boom")
+				},
+			})
+
+			var drained []string
+			srv.RegisterDrain(func(ctx context.Context) {
+				drained = append(drained, "a")
+			})
+
+			srv.ServeInBackground()
+
+			// Informational checks don't fail the probe, errors are not exposed.
+			resp, err := srv.GetMain(readinessEndpoint, nil)
+			So(err, ShouldBeNil)
+			So(resp, ShouldStartWith, "OK\nWARN\n  cache\n")
+			So(resp, ShouldNotContainSubstring, "secret details")
+
+			// A failing check affects only the readiness probe.
+			atomic.StoreInt32(&healthy, 0)
+			_, err = srv.GetMain(readinessEndpoint, nil)
+			So(err, ShouldErrLike, "unexpected status 503")
+			_, err = srv.GetMain(healthEndpoint, nil)
+			So(err, ShouldBeNil)
+			problems, warnings := srv.checkHealth(srv.Context, false)
+			So(problems, ShouldResemble, []string{"flaky"})
+			So(warnings, ShouldResemble, []string{"cache"})
+
+			// Draining makes the readiness probe fail.
+			atomic.StoreInt32(&healthy, 1)
+			srv.Drain()
+			srv.Drain()
+			So(drained, ShouldResemble, []string{"a"})
+			_, err = srv.GetAdmin(readinessEndpoint, nil)
+			So(err, ShouldErrLike, "unexpected status 503")
+			_, err = srv.GetAdmin(healthEndpoint, nil)
+			So(err, ShouldBeNil)
+
+			// RPCs are still served while draining, but not after the shutdown.
+			rpc := func() error {
+				_, err := srv.rejectWhenStopping(srv.Context, nil, &grpc.UnaryServerInfo{},
+					func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+				return err
+			}
+			stream := func() error {
+				return srv.rejectStreamWhenStopping(nil, &testServerStream{ctx: srv.Context}, &grpc.StreamServerInfo{},
+					func(interface{}, grpc.ServerStream) error { return nil })
+			}
+			So(rpc(), ShouldBeNil)
+			So(stream(), ShouldBeNil)
+			So(srv.StopBackgroundServing(), ShouldBeNil)
+			So(status.Code(rpc()), ShouldEqual, codes.Unavailable)
+			So(status.Code(stream()), ShouldEqual, codes.Unavailable)
+		})
+
+		Convey("Stream interceptors", func() {
+			var calls []string
+			srv.RegisterStreamServerInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				calls = append(calls, "module")
+				return handler(srv, ss)
+			})
+			srv.PRPC.StreamServerInterceptor = func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				calls = append(calls, "prpc")
+				return handler(srv, ss)
+			}
+
+			srv.ServeInBackground()
+
+			stream := &testServerStream{ctx: srv.Context}
+			info := &grpc.StreamServerInfo{FullMethod: "/service/Stream", IsServerStream: true}
+
+			err := srv.PRPC.StreamServerInterceptor(nil, stream, info, func(interface{}, grpc.ServerStream) error {
+				calls = append(calls, "handler")
+				return nil
+			})
+			So(err, ShouldBeNil)
+			So(calls, ShouldResemble, []string{"module", "prpc", "handler"})
+
+			// Panics are caught.
+			err = srv.PRPC.StreamServerInterceptor(nil, stream, info, func(interface{}, grpc.ServerStream) error {
+				panic("boom")
+			})
+			So(status.Code(err), ShouldEqual, codes.Internal)
+		})
+
 		Convey("RunInBackground", func() {
 			// Queue one activity before starting the serving loop to verify this code
 			// path works.
@@ -625,6 +731,13 @@ func BenchmarkServer(b *testing.B) {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
 type testServer struct {
 	*Server
 
@@ -0,0 +1,211 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNoHistory is returned if the storage doesn't keep history of changes.
+	ErrNoHistory = errors.New("settings: the storage doesn't keep history")
+	// ErrNoSuchRevision is returned by GetRevision for unknown versions.
+	ErrNoSuchRevision = errors.New("settings: no such revision")
+)
+
+// Revision is a snapshot of all settings as they were at some version.
+type Revision struct {
+	Version int                         // monotonically increasing, starting from 1
+	Values  map[string]*json.RawMessage // all settings at this version
+	Who     string                      // who made the change
+	Why     string                      // why the change was made
+	When    time.Time                   // when the change was made
+}
+
+// VersionedStorage is MutableStorage that keeps the history of all changes.
+type VersionedStorage interface {
+	MutableStorage
+
+	// ListRevisions returns up to 'limit' most recent revisions.
+	//
+	// The most recent revision (i.e. the current settings) comes first.
+	ListRevisions(c context.Context, limit int) ([]*Revision, error)
+
+	// GetRevision returns a revision with the given version.
+	//
+	// Returns ErrNoSuchRevision if there's no such revision.
+	GetRevision(c context.Context, version int) (*Revision, error)
+
+	// RestoreRevision makes values from the given revision current.
+	//
+	// Creates a new revision with a copy of values from the given one. Returns
+	// ErrNoSuchRevision if there's no such revision.
+	RestoreRevision(c context.Context, version int, who, why string) error
+}
+
+// Change describes how a single setting changed between two revisions.
+type Change struct {
+	Key string
+	Old *json.RawMessage // nil if the setting was added
+	New *json.RawMessage // nil if the setting was removed
+}
+
+// Diff returns settings that differ between two revisions, sorted by key.
+//
+// Either revision can be nil, meaning "no settings at all".
+func Diff(old, new *Revision) []Change {
+	var oldV, newV map[string]*json.RawMessage
+	if old != nil {
+		oldV = old.Values
+	}
+	if new != nil {
+		newV = new.Values
+	}
+
+	var changes []Change
+	added, removed, same := diffKeys(newV, oldV)
+	for _, k := range added {
+		changes = append(changes, Change{Key: k, New: newV[k]})
+	}
+	for _, k := range removed {
+		changes = append(changes, Change{Key: k, Old: oldV[k]})
+	}
+	for _, k := range same {
+		if !bytes.Equal(rawBytes(oldV[k]), rawBytes(newV[k])) {
+			changes = append(changes, Change{Key: k, Old: oldV[k], New: newV[k]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+func rawBytes(r *json.RawMessage) []byte {
+	if r == nil {
+		return nil
+	}
+	return *r
+}
+
+// Validator checks a candidate value of some setting.
+//
+// It receives the value as a JSON blob. Returns an error if the value is not
+// acceptable.
+type Validator func(c context.Context, value json.RawMessage) error
+
+var validators struct {
+	m sync.RWMutex
+	v map[string]Validator
+}
+
+// RegisterValidator registers a callback that checks new values of the setting
+// at the given key before they are stored.
+//
+// Validators are called by Set, SetIfChanged and Rollback before changing the
+// storage, so invalid values never reach any process. They can also be called
+// explicitly via Validate to check a candidate value without storing it.
+//
+// Should be called during init() time. Panics if the key already has
+// a validator.
+func RegisterValidator(key string, v Validator) {
+	validators.m.Lock()
+	defer validators.m.Unlock()
+	if _, ok := validators.v[key]; ok {
+		panic(fmt.Sprintf("settings validator for %q is already registered", key))
+	}
+	if validators.v == nil {
+		validators.v = make(map[string]Validator, 1)
+	}
+	validators.v[key] = v
+}
+
+// Validate checks a candidate value of the setting at the given key using
+// a validator registered via RegisterValidator.
+//
+// Returns nil if there's no validator for this key.
+func Validate(c context.Context, key string, value json.RawMessage) error {
+	validators.m.RLock()
+	v := validators.v[key]
+	validators.m.RUnlock()
+	if v == nil {
+		return nil
+	}
+	if err := v(c, value); err != nil {
+		return fmt.Errorf("settings: bad value for %q: %w", key, err)
+	}
+	return nil
+}
+
+// IsVersioned returns true if the storage supports VersionedStorage interface.
+func (s *Settings) IsVersioned() bool {
+	_, ok := s.storage.(VersionedStorage)
+	return ok
+}
+
+// ListRevisions returns up to 'limit' most recent revisions of settings, the
+// current one first.
+//
+// Returns ErrNoHistory if the storage doesn't keep history.
+func (s *Settings) ListRevisions(c context.Context, limit int) ([]*Revision, error) {
+	if !s.IsVersioned() {
+		return nil, ErrNoHistory
+	}
+	return s.storage.(VersionedStorage).ListRevisions(c, limit)
+}
+
+// GetRevision returns a revision of settings with the given version.
+//
+// Returns ErrNoHistory if the storage doesn't keep history and
+// ErrNoSuchRevision if there's no such revision.
+func (s *Settings) GetRevision(c context.Context, version int) (*Revision, error) {
+	if !s.IsVersioned() {
+		return nil, ErrNoHistory
+	}
+	return s.storage.(VersionedStorage).GetRevision(c, version)
+}
+
+// Rollback makes settings from the given revision current.
+//
+// All values of the revision are checked by registered validators first. Like
+// with Set, new settings will apply only when existing in-memory cache expires.
+//
+// Returns ErrNoHistory if the storage doesn't keep history.
+func (s *Settings) Rollback(c context.Context, version int, who, why string) error {
+	if !s.IsVersioned() {
+		return ErrNoHistory
+	}
+	storage := s.storage.(VersionedStorage)
+	rev, err := storage.GetRevision(c, version)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(rev.Values))
+	for k := range rev.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := Validate(c, k, json.RawMessage(rawBytes(rev.Values[k]))); err != nil {
+			return err
+		}
+	}
+	return storage.RestoreRevision(c, version, who, why)
+}
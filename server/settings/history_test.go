@@ -0,0 +1,138 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/clock/testclock"
+
+	. "github.com/smartystreets/goconvey/convey"
+	. "go.chromium.org/luci/common/testing/assertions"
+)
+
+func init() {
+	RegisterValidator("validated", func(c context.Context, value json.RawMessage) error {
+		s := exampleSettings{}
+		if err := json.Unmarshal(value, &s); err != nil {
+			return err
+		}
+		if s.Greetings == "" {
+			return errors.New("greetings are required")
+		}
+		return nil
+	})
+}
+
+func TestHistory(t *testing.T) {
+	Convey("With in-memory settings", t, func() {
+		ctx, tc := testclock.UseTime(context.Background(), time.Unix(1444945245, 0).UTC())
+		settings := New(&MemoryStorage{})
+		So(settings.IsVersioned(), ShouldBeTrue)
+
+		versions := func() (out []int) {
+			revs, err := settings.ListRevisions(ctx, 10)
+			So(err, ShouldBeNil)
+			for _, r := range revs {
+				out = append(out, r.Version)
+			}
+			return
+		}
+
+		Convey("History and rollback", func() {
+			So(versions(), ShouldBeEmpty)
+
+			So(settings.Set(ctx, "key", &exampleSettings{"1"}, "who1", "why1"), ShouldBeNil)
+			tc.Add(time.Minute)
+			So(settings.Set(ctx, "another", &exampleSettings{"a"}, "who2", "why2"), ShouldBeNil)
+			tc.Add(time.Minute)
+			So(settings.Set(ctx, "key", &exampleSettings{"2"}, "who3", "why3"), ShouldBeNil)
+			So(versions(), ShouldResemble, []int{3, 2, 1})
+
+			rev, err := settings.GetRevision(ctx, 2)
+			So(err, ShouldBeNil)
+			So(rev.Who, ShouldEqual, "who2")
+			So(rev.When, ShouldResemble, time.Unix(1444945245, 0).UTC().Add(time.Minute))
+			_, err = settings.GetRevision(ctx, 4)
+			So(err, ShouldEqual, ErrNoSuchRevision)
+
+			So(settings.Rollback(ctx, 1, "rollbacker", "oops"), ShouldBeNil)
+			So(versions(), ShouldResemble, []int{4, 3, 2, 1})
+
+			s := exampleSettings{}
+			So(settings.GetUncached(ctx, "key", &s), ShouldBeNil)
+			So(s, ShouldResemble, exampleSettings{"1"})
+			So(settings.GetUncached(ctx, "another", &s), ShouldEqual, ErrNoSettings)
+
+			// Modifying returned revisions doesn't affect the storage.
+			rev, _ = settings.GetRevision(ctx, 2)
+			delete(rev.Values, "key")
+			rev, _ = settings.GetRevision(ctx, 2)
+			So(rev.Values, ShouldContainKey, "key")
+		})
+
+		Convey("Diff", func() {
+			So(settings.Set(ctx, "key", &exampleSettings{"1"}, "who", "why"), ShouldBeNil)
+			So(settings.Set(ctx, "same", &exampleSettings{"1"}, "who", "why"), ShouldBeNil)
+			So(settings.Set(ctx, "removed", &exampleSettings{"1"}, "who", "why"), ShouldBeNil)
+			old, _ := settings.GetRevision(ctx, 3)
+
+			So(settings.Rollback(ctx, 2, "who", "why"), ShouldBeNil)
+			So(settings.Set(ctx, "key", &exampleSettings{"2"}, "who", "why"), ShouldBeNil)
+			So(settings.Set(ctx, "added", &exampleSettings{"1"}, "who", "why"), ShouldBeNil)
+			cur, _ := settings.GetRevision(ctx, 6)
+
+			raw := func(s string) *json.RawMessage {
+				r := json.RawMessage(s)
+				return &r
+			}
+			So(Diff(old, cur), ShouldResemble, []Change{
+				{Key: "added", New: raw(`{"greetings":"1"}`)},
+				{Key: "key", Old: raw(`{"greetings":"1"}`), New: raw(`{"greetings":"2"}`)},
+				{Key: "removed", Old: raw(`{"greetings":"1"}`)},
+			})
+			So(Diff(cur, cur), ShouldBeEmpty)
+			So(Diff(nil, old), ShouldHaveLength, 3)
+		})
+
+		Convey("Validation", func() {
+			So(settings.Set(ctx, "validated", &exampleSettings{}, "who", "why"), ShouldErrLike,
+				`bad value for "validated": greetings are required`)
+			So(versions(), ShouldBeEmpty)
+
+			So(Validate(ctx, "validated", json.RawMessage(`{"greetings": "hi"}`)), ShouldBeNil)
+			So(Validate(ctx, "unvalidated", json.RawMessage(`{}`)), ShouldBeNil)
+
+			// Rollback to a revision that is no longer valid is rejected.
+			So(settings.GetStorage().(MutableStorage).UpdateSetting(ctx, "validated", json.RawMessage(`{}`), "who", "why"), ShouldBeNil)
+			So(settings.Set(ctx, "validated", &exampleSettings{"hi"}, "who", "why"), ShouldBeNil)
+			So(settings.Rollback(ctx, 1, "who", "why"), ShouldErrLike, "greetings are required")
+			So(versions(), ShouldResemble, []int{2, 1})
+		})
+	})
+
+	Convey("Not versioned storage", t, func() {
+		ctx := context.Background()
+		settings := New(&ExternalStorage{})
+		So(settings.IsVersioned(), ShouldBeFalse)
+		_, err := settings.ListRevisions(ctx, 10)
+		So(err, ShouldEqual, ErrNoHistory)
+		So(settings.Rollback(ctx, 1, "who", "why"), ShouldEqual, ErrNoHistory)
+	})
+}
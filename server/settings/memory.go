@@ -19,31 +19,27 @@ import (
 	"encoding/json"
 	"sync"
 	"time"
+
+	"go.chromium.org/luci/common/clock"
 )
 
 // MemoryStorage implements Storage interface, using memory as a backend. Useful
 // in unit tests.
+//
+// It also implements VersionedStorage, keeping the history of all changes.
 type MemoryStorage struct {
 	Expiration time.Duration // default expiration time of in-memory cache
 
-	lock   sync.Mutex
-	values map[string]*json.RawMessage
+	lock    sync.Mutex
+	values  map[string]*json.RawMessage
+	history []*Revision // oldest first, the last one matches 'values'
 }
 
 // FetchAllSettings fetches all latest settings at once.
 func (m *MemoryStorage) FetchAllSettings(c context.Context) (*Bundle, time.Duration, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	values := make(map[string]*json.RawMessage, len(m.values))
-	for k, v := range m.values {
-		if v == nil {
-			values[k] = v
-		} else {
-			raw := append(json.RawMessage(nil), *v...)
-			values[k] = &raw
-		}
-	}
-	return &Bundle{Values: values}, m.Expiration, nil
+	return &Bundle{Values: copyValues(m.values)}, m.Expiration, nil
 }
 
 // UpdateSetting updates a setting at the given key.
@@ -55,5 +51,69 @@ func (m *MemoryStorage) UpdateSetting(c context.Context, key string, value json.
 	}
 	cpy := append(json.RawMessage(nil), value...)
 	m.values[key] = &cpy
+	m.commitLocked(c, who, why)
+	return nil
+}
+
+// ListRevisions returns up to 'limit' most recent revisions.
+func (m *MemoryStorage) ListRevisions(c context.Context, limit int) ([]*Revision, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	var out []*Revision
+	for i := len(m.history) - 1; i >= 0 && len(out) < limit; i-- {
+		out = append(out, copyRevision(m.history[i]))
+	}
+	return out, nil
+}
+
+// GetRevision returns a revision with the given version.
+func (m *MemoryStorage) GetRevision(c context.Context, version int) (*Revision, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if version < 1 || version > len(m.history) {
+		return nil, ErrNoSuchRevision
+	}
+	return copyRevision(m.history[version-1]), nil
+}
+
+// RestoreRevision makes values from the given revision current.
+func (m *MemoryStorage) RestoreRevision(c context.Context, version int, who, why string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if version < 1 || version > len(m.history) {
+		return ErrNoSuchRevision
+	}
+	m.values = copyValues(m.history[version-1].Values)
+	m.commitLocked(c, who, why)
 	return nil
 }
+
+// commitLocked appends the current values to the history.
+func (m *MemoryStorage) commitLocked(c context.Context, who, why string) {
+	m.history = append(m.history, &Revision{
+		Version: len(m.history) + 1,
+		Values:  copyValues(m.values),
+		Who:     who,
+		Why:     why,
+		When:    clock.Now(c).UTC(),
+	})
+}
+
+func copyValues(v map[string]*json.RawMessage) map[string]*json.RawMessage {
+	out := make(map[string]*json.RawMessage, len(v))
+	for k, v := range v {
+		if v == nil {
+			out[k] = v
+		} else {
+			raw := append(json.RawMessage(nil), *v...)
+			out[k] = &raw
+		}
+	}
+	return out
+}
+
+func copyRevision(r *Revision) *Revision {
+	cpy := *r
+	cpy.Values = copyValues(r.Values)
+	return &cpy
+}
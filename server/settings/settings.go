@@ -213,6 +213,9 @@ func (s *Settings) GetUncached(c context.Context, key string, value interface{})
 
 // Set changes a setting value for the given key.
 //
+// The value is checked by a validator registered via RegisterValidator (if
+// any) before it is stored.
+//
 // New settings will apply only when existing in-memory cache expires.
 // In particular, Get() right after Set() may still return old value.
 func (s *Settings) Set(c context.Context, key string, value interface{}, who, why string) error {
@@ -223,6 +226,9 @@ func (s *Settings) Set(c context.Context, key string, value interface{}, who, wh
 	if err != nil {
 		return err
 	}
+	if err := Validate(c, key, blob); err != nil {
+		return err
+	}
 	return s.storage.(MutableStorage).UpdateSetting(c, key, json.RawMessage(blob), who, why)
 }
 
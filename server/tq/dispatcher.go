@@ -171,6 +171,8 @@ type Dispatcher struct {
 	mu       sync.RWMutex
 	clsByID  map[string]*taskClassImpl
 	clsByTyp map[protoreflect.MessageType]*taskClassImpl
+
+	draining int32 // 1 after Drain is called, accessed atomically
 }
 
 // Sweeper knows how sweep transaction tasks reminders.
@@ -423,6 +425,7 @@ var (
 	httpStatusKey = errors.NewTagKey("http status override")
 	httpStatus404 = errors.TagValue{Key: httpStatusKey, Value: 404}
 	httpStatus400 = errors.TagValue{Key: httpStatusKey, Value: 400}
+	httpStatus503 = errors.TagValue{Key: httpStatusKey, Value: 503}
 )
 
 // quietOnError is an error tag used to implement TaskClass.QuietOnError.
@@ -738,6 +741,15 @@ func (d *Dispatcher) InstallTasksRoutes(r *router.Router, prefix string) {
 	})
 }
 
+// Drain makes the dispatcher reject all new task pushes with HTTP 503.
+//
+// Cloud Tasks retries rejected tasks later, likely hitting another process.
+// Tasks that are already being handled are not affected. Intended to be called
+// when the process is about to shut down. There's no way to undo it.
+func (d *Dispatcher) Drain() {
+	atomic.StoreInt32(&d.draining, 1)
+}
+
 // InstallSweepRoute installs a route that initiates a sweep.
 //
 // It may be called periodically (e.g. by Cloud Scheduler) to launch sweeps.
@@ -1122,6 +1134,11 @@ func isValidTopic(t string) bool {
 // Returns errors annotated in the same style as errors from Handler, see its
 // doc.
 func (d *Dispatcher) handlePush(ctx context.Context, body []byte, info ExecutionInfo) error {
+	if atomic.LoadInt32(&d.draining) == 1 {
+		metrics.ServerRejectedCount.Add(ctx, 1, "draining")
+		return errors.Reason("the server is draining, try another one").Tag(httpStatus503).Err()
+	}
+
 	// See taskClassImpl.serialize().
 	env := envelope{}
 	if err := json.Unmarshal(body, &env); err != nil {
@@ -393,6 +393,11 @@ func TestPushHandler(t *testing.T) {
 			So(call(`{"class": "test-1", "body": {}}`, nil), ShouldEqual, 404)
 		})
 
+		Convey("Draining", func() {
+			d.Drain()
+			So(call(`{"class": "test-1", "body": {}}`, nil), ShouldEqual, 503)
+		})
+
 		Convey("Metrics work", func() {
 			callWithHeaders := func(headers map[string]string) {
 				hdr := make(http.Header)
@@ -123,7 +123,7 @@ var (
 		"tq/server/rejected",
 		"Count of rejected (e.g. malformed) task pushes",
 		nil,
-		field.String("reason"), // auth | bad_request | unknown_class | no_handler | bad_payload
+		field.String("reason"), // auth | bad_request | unknown_class | no_handler | bad_payload | draining
 	)
 
 	ServerHandledCount = metric.NewCounter(
@@ -371,6 +371,7 @@ func (m *tqModule) initDispatching(ctx context.Context, host module.Host, opts m
 			return nil, errors.Reason(`-tq-serving-prefix must start with "/internal/", got %q`, m.opts.ServingPrefix).Err()
 		}
 		disp.InstallTasksRoutes(host.Routes(), m.opts.ServingPrefix)
+		host.RegisterDrain(func(context.Context) { disp.Drain() })
 	}
 
 	return submitter, nil
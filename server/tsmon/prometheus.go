@@ -0,0 +1,37 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsmon
+
+import (
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/server/router"
+)
+
+// ServePrometheus serves metrics from the tsmon store of the context in the
+// Prometheus text exposition format.
+//
+// Metrics computed by tsmon callbacks have values as of the last flush.
+//
+// Does no authentication, must be installed only on trusted ports (like the
+// server's admin port).
+func ServePrometheus(c *router.Context) {
+	cells := tsmon.Store(c.Context).GetAll(c.Context) // note: it is a mutable copy
+	c.Writer.Header().Set("Content-Type", monitor.PrometheusContentType)
+	if err := monitor.WritePrometheus(c.Writer, cells); err != nil {
+		logging.WithError(err).Warningf(c.Context, "Failed to write Prometheus metrics")
+	}
+}
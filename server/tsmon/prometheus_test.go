@@ -0,0 +1,47 @@
+// Copyright 2022 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsmon
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.chromium.org/luci/common/tsmon"
+	"go.chromium.org/luci/common/tsmon/monitor"
+	"go.chromium.org/luci/server/router"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestServePrometheus(t *testing.T) {
+	t.Parallel()
+
+	Convey("Serves metrics", t, func() {
+		c, _ := tsmon.WithDummyInMemory(context.Background())
+		tsmon.Store(c).Incr(c, testMetric, time.Time{}, []interface{}{}, int64(5))
+
+		rec := httptest.NewRecorder()
+		ServePrometheus(&router.Context{Context: c, Writer: rec, Request: &http.Request{}})
+
+		So(rec.Code, ShouldEqual, http.StatusOK)
+		So(rec.Header().Get("Content-Type"), ShouldEqual, monitor.PrometheusContentType)
+		So(rec.Body.String(), ShouldContainSubstring, "# TYPE test_metric counter\n")
+		So(rec.Body.String(), ShouldContainSubstring, "test_metric{")
+		So(rec.Body.String(), ShouldContainSubstring, "} 5\n")
+	})
+}